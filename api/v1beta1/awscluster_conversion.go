@@ -48,13 +48,39 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	if restored.Status.Bastion != nil {
 		dst.Status.Bastion.InstanceMetadataOptions = restored.Status.Bastion.InstanceMetadataOptions
 		dst.Status.Bastion.PlacementGroupName = restored.Status.Bastion.PlacementGroupName
+		dst.Status.Bastion.CPUOptions = restored.Status.Bastion.CPUOptions
+		dst.Status.Bastion.EnclaveOptions = restored.Status.Bastion.EnclaveOptions
+		dst.Status.Bastion.DisableAPITermination = restored.Status.Bastion.DisableAPITermination
+		dst.Status.Bastion.PrivateDNSName = restored.Status.Bastion.PrivateDNSName
+		dst.Status.Bastion.MaintenanceOptions = restored.Status.Bastion.MaintenanceOptions
+		dst.Status.Bastion.SourceDestCheck = restored.Status.Bastion.SourceDestCheck
 	}
 	dst.Spec.Partition = restored.Spec.Partition
+	dst.Spec.MachineLabelToTag = restored.Spec.MachineLabelToTag
+	dst.Spec.InstanceNameTemplate = restored.Spec.InstanceNameTemplate
+	dst.Spec.NodeNameFromPrivateDNSName = restored.Spec.NodeNameFromPrivateDNSName
+	dst.Spec.OnExternalInstanceDeletion = restored.Spec.OnExternalInstanceDeletion
+	dst.Spec.PodCIDRBlocks = restored.Spec.PodCIDRBlocks
+	dst.Spec.EFS = restored.Spec.EFS
+	dst.Status.EFS = restored.Status.EFS
+	dst.Spec.GlobalAcceleratorEndpointGroupARN = restored.Spec.GlobalAcceleratorEndpointGroupARN
+	dst.Spec.IdentitySelector = restored.Spec.IdentitySelector
+	dst.Spec.EBSEncryptionKeyARN = restored.Spec.EBSEncryptionKeyARN
+	dst.Spec.ManagedEncryptionKey = restored.Spec.ManagedEncryptionKey
+	dst.Status.EBSEncryptionKeyGrantID = restored.Status.EBSEncryptionKeyGrantID
+	dst.Status.EBSEncryptionKeyEC2GrantID = restored.Status.EBSEncryptionKeyEC2GrantID
+	dst.Status.ManagedEncryptionKeyARN = restored.Status.ManagedEncryptionKeyARN
+	dst.Spec.PublicDNS = restored.Spec.PublicDNS
+	dst.Spec.ReconcileMode = restored.Spec.ReconcileMode
 
 	for role, sg := range restored.Status.Network.SecurityGroups {
 		dst.Status.Network.SecurityGroups[role] = sg
 	}
 	dst.Status.Network.NatGatewaysIPs = restored.Status.Network.NatGatewaysIPs
+	dst.Status.Network.InstanceConnectEndpointID = restored.Status.Network.InstanceConnectEndpointID
+	dst.Status.Network.Subnets = restored.Status.Network.Subnets
+	dst.Status.Network.KarpenterDiscoveryTagValue = restored.Status.Network.KarpenterDiscoveryTagValue
+	dst.Status.Network.FlowLogID = restored.Status.Network.FlowLogID
 
 	if restored.Spec.NetworkSpec.VPC.IPAMPool != nil {
 		if dst.Spec.NetworkSpec.VPC.IPAMPool == nil {
@@ -73,6 +99,18 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.NetworkSpec.AdditionalControlPlaneIngressRules = restored.Spec.NetworkSpec.AdditionalControlPlaneIngressRules
+	dst.Spec.NetworkSpec.SubnetCidrSizes = restored.Spec.NetworkSpec.SubnetCidrSizes
+	dst.Spec.NetworkSpec.AdditionalRoutes = restored.Spec.NetworkSpec.AdditionalRoutes
+	dst.Spec.NetworkSpec.TransitGatewayAttachment = restored.Spec.NetworkSpec.TransitGatewayAttachment
+	dst.Spec.NetworkSpec.VPCPeerings = restored.Spec.NetworkSpec.VPCPeerings
+	dst.Spec.NetworkSpec.NetworkACL = restored.Spec.NetworkSpec.NetworkACL
+	dst.Spec.NetworkSpec.InstanceConnectEndpoint = restored.Spec.NetworkSpec.InstanceConnectEndpoint
+	dst.Spec.NetworkSpec.SecurityGroupReconcileStrategies = restored.Spec.NetworkSpec.SecurityGroupReconcileStrategies
+	dst.Spec.NetworkSpec.SecurityGroupEgressRules = restored.Spec.NetworkSpec.SecurityGroupEgressRules
+	dst.Spec.NetworkSpec.SubnetAvailableIPAddressThreshold = restored.Spec.NetworkSpec.SubnetAvailableIPAddressThreshold
+	dst.Spec.NetworkSpec.KarpenterDiscovery = restored.Spec.NetworkSpec.KarpenterDiscovery
+	dst.Spec.NetworkSpec.NatGatewayStrategy = restored.Spec.NetworkSpec.NatGatewayStrategy
+	dst.Spec.NetworkSpec.FlowLogs = restored.Spec.NetworkSpec.FlowLogs
 
 	if restored.Spec.NetworkSpec.VPC.IPAMPool != nil {
 		if dst.Spec.NetworkSpec.VPC.IPAMPool == nil {
@@ -92,14 +130,15 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.NetworkSpec.VPC.EmptyRoutesDefaultVPCSecurityGroup = restored.Spec.NetworkSpec.VPC.EmptyRoutesDefaultVPCSecurityGroup
 
-	// Restore SubnetSpec.ResourceID field, if any.
+	// Restore SubnetSpec.ResourceID and SubnetSpec.ExistingRouteTableID fields, if any.
 	for _, subnet := range restored.Spec.NetworkSpec.Subnets {
-		if len(subnet.ResourceID) == 0 {
+		if len(subnet.ResourceID) == 0 && subnet.ExistingRouteTableID == nil {
 			continue
 		}
 		for i, dstSubnet := range dst.Spec.NetworkSpec.Subnets {
 			if dstSubnet.ID == subnet.ID {
 				dstSubnet.ResourceID = subnet.ResourceID
+				dstSubnet.ExistingRouteTableID = subnet.ExistingRouteTableID
 				dstSubnet.DeepCopyInto(&dst.Spec.NetworkSpec.Subnets[i])
 			}
 		}
@@ -112,6 +151,7 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 // Assumes restored and dst are non-nil.
 func restoreControlPlaneLoadBalancerStatus(restored, dst *infrav2.LoadBalancer) {
 	dst.ARN = restored.ARN
+	dst.CanonicalHostedZoneID = restored.CanonicalHostedZoneID
 	dst.LoadBalancerType = restored.LoadBalancerType
 	dst.ELBAttributes = restored.ELBAttributes
 	dst.ELBListeners = restored.ELBListeners
@@ -134,6 +174,11 @@ func restoreControlPlaneLoadBalancer(restored, dst *infrav2.AWSLoadBalancerSpec)
 	dst.DisableHostsRewrite = restored.DisableHostsRewrite
 	dst.PreserveClientIP = restored.PreserveClientIP
 	dst.IngressRules = restored.IngressRules
+	dst.AllowedCIDRBlocks = restored.AllowedCIDRBlocks
+	dst.SSLCertificateARN = restored.SSLCertificateARN
+	dst.SSLPolicy = restored.SSLPolicy
+	dst.Port = restored.Port
+	dst.IdleTimeout = restored.IdleTimeout
 	dst.AdditionalListeners = restored.AdditionalListeners
 }
 
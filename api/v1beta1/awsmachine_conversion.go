@@ -38,6 +38,20 @@ func (src *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Ignition = restored.Spec.Ignition
 	dst.Spec.InstanceMetadataOptions = restored.Spec.InstanceMetadataOptions
 	dst.Spec.PlacementGroupName = restored.Spec.PlacementGroupName
+	dst.Spec.CPUOptions = restored.Spec.CPUOptions
+	dst.Spec.EnclaveOptions = restored.Spec.EnclaveOptions
+	dst.Spec.PrivateDNSName = restored.Spec.PrivateDNSName
+	dst.Spec.FallbackInstanceTypes = restored.Spec.FallbackInstanceTypes
+	dst.Spec.AdditionalBootstrapParameters = restored.Spec.AdditionalBootstrapParameters
+	dst.Spec.EnableDeletionProtection = restored.Spec.EnableDeletionProtection
+	dst.Spec.MaintenanceOptions = restored.Spec.MaintenanceOptions
+	dst.Spec.SourceDestCheck = restored.Spec.SourceDestCheck
+	dst.Spec.OnExternalInstanceDeletion = restored.Spec.OnExternalInstanceDeletion
+	dst.Spec.AMI.Architecture = restored.Spec.AMI.Architecture
+	dst.Status.InstanceType = restored.Status.InstanceType
+	dst.Status.InstanceCreationTime = restored.Status.InstanceCreationTime
+	dst.Status.ObservedGeneration = restored.Status.ObservedGeneration
+	dst.Status.LastFullReconcileTime = restored.Status.LastFullReconcileTime
 
 	return nil
 }
@@ -85,6 +99,16 @@ func (r *AWSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Template.Spec.Ignition = restored.Spec.Template.Spec.Ignition
 	dst.Spec.Template.Spec.InstanceMetadataOptions = restored.Spec.Template.Spec.InstanceMetadataOptions
 	dst.Spec.Template.Spec.PlacementGroupName = restored.Spec.Template.Spec.PlacementGroupName
+	dst.Spec.Template.Spec.CPUOptions = restored.Spec.Template.Spec.CPUOptions
+	dst.Spec.Template.Spec.EnclaveOptions = restored.Spec.Template.Spec.EnclaveOptions
+	dst.Spec.Template.Spec.PrivateDNSName = restored.Spec.Template.Spec.PrivateDNSName
+	dst.Spec.Template.Spec.FallbackInstanceTypes = restored.Spec.Template.Spec.FallbackInstanceTypes
+	dst.Spec.Template.Spec.AdditionalBootstrapParameters = restored.Spec.Template.Spec.AdditionalBootstrapParameters
+	dst.Spec.Template.Spec.EnableDeletionProtection = restored.Spec.Template.Spec.EnableDeletionProtection
+	dst.Spec.Template.Spec.MaintenanceOptions = restored.Spec.Template.Spec.MaintenanceOptions
+	dst.Spec.Template.Spec.SourceDestCheck = restored.Spec.Template.Spec.SourceDestCheck
+	dst.Spec.Template.Spec.OnExternalInstanceDeletion = restored.Spec.Template.Spec.OnExternalInstanceDeletion
+	dst.Spec.Template.Spec.AMI.Architecture = restored.Spec.Template.Spec.AMI.Architecture
 
 	return nil
 }
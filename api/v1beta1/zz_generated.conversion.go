@@ -45,11 +45,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.AMIReference)(nil), (*AMIReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_AMIReference_To_v1beta1_AMIReference(a.(*v1beta2.AMIReference), b.(*AMIReference), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*AWSCluster)(nil), (*v1beta2.AWSCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AWSCluster_To_v1beta2_AWSCluster(a.(*AWSCluster), b.(*v1beta2.AWSCluster), scope)
 	}); err != nil {
@@ -170,11 +165,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.AWSClusterStaticIdentitySpec)(nil), (*AWSClusterStaticIdentitySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_AWSClusterStaticIdentitySpec_To_v1beta1_AWSClusterStaticIdentitySpec(a.(*v1beta2.AWSClusterStaticIdentitySpec), b.(*AWSClusterStaticIdentitySpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*AWSClusterStatus)(nil), (*v1beta2.AWSClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AWSClusterStatus_To_v1beta2_AWSClusterStatus(a.(*AWSClusterStatus), b.(*v1beta2.AWSClusterStatus), scope)
 	}); err != nil {
@@ -265,11 +255,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.AWSMachineStatus)(nil), (*AWSMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(a.(*v1beta2.AWSMachineStatus), b.(*AWSMachineStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*AWSMachineTemplate)(nil), (*v1beta2.AWSMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AWSMachineTemplate_To_v1beta2_AWSMachineTemplate(a.(*AWSMachineTemplate), b.(*v1beta2.AWSMachineTemplate), scope)
 	}); err != nil {
@@ -330,11 +315,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.AWSRoleSpec)(nil), (*AWSRoleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_AWSRoleSpec_To_v1beta1_AWSRoleSpec(a.(*v1beta2.AWSRoleSpec), b.(*AWSRoleSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*AllowedNamespaces)(nil), (*v1beta2.AllowedNamespaces)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AllowedNamespaces_To_v1beta2_AllowedNamespaces(a.(*AllowedNamespaces), b.(*v1beta2.AllowedNamespaces), scope)
 	}); err != nil {
@@ -540,11 +520,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.AMIReference)(nil), (*AMIReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AMIReference_To_v1beta1_AMIReference(a.(*v1beta2.AMIReference), b.(*AMIReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.AWSClusterSpec)(nil), (*AWSClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_AWSClusterSpec_To_v1beta1_AWSClusterSpec(a.(*v1beta2.AWSClusterSpec), b.(*AWSClusterSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.AWSClusterStaticIdentitySpec)(nil), (*AWSClusterStaticIdentitySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AWSClusterStaticIdentitySpec_To_v1beta1_AWSClusterStaticIdentitySpec(a.(*v1beta2.AWSClusterStaticIdentitySpec), b.(*AWSClusterStaticIdentitySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.AWSLoadBalancerSpec)(nil), (*AWSLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_AWSLoadBalancerSpec_To_v1beta1_AWSLoadBalancerSpec(a.(*v1beta2.AWSLoadBalancerSpec), b.(*AWSLoadBalancerSpec), scope)
 	}); err != nil {
@@ -555,6 +545,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.AWSMachineStatus)(nil), (*AWSMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(a.(*v1beta2.AWSMachineStatus), b.(*AWSMachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.AWSRoleSpec)(nil), (*AWSRoleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AWSRoleSpec_To_v1beta1_AWSRoleSpec(a.(*v1beta2.AWSRoleSpec), b.(*AWSRoleSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.IPv6)(nil), (*IPv6)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IPv6_To_v1beta1_IPv6(a.(*v1beta2.IPv6), b.(*IPv6), scope)
 	}); err != nil {
@@ -617,14 +617,10 @@ func Convert_v1beta1_AMIReference_To_v1beta2_AMIReference(in *AMIReference, out
 func autoConvert_v1beta2_AMIReference_To_v1beta1_AMIReference(in *v1beta2.AMIReference, out *AMIReference, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.EKSOptimizedLookupType = (*EKSAMILookupType)(unsafe.Pointer(in.EKSOptimizedLookupType))
+	// WARNING: in.Architecture requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_AMIReference_To_v1beta1_AMIReference is an autogenerated conversion function.
-func Convert_v1beta2_AMIReference_To_v1beta1_AMIReference(in *v1beta2.AMIReference, out *AMIReference, s conversion.Scope) error {
-	return autoConvert_v1beta2_AMIReference_To_v1beta1_AMIReference(in, out, s)
-}
-
 func autoConvert_v1beta1_AWSCluster_To_v1beta2_AWSCluster(in *AWSCluster, out *v1beta2.AWSCluster, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_AWSClusterSpec_To_v1beta2_AWSClusterSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -819,7 +815,17 @@ func Convert_v1beta2_AWSClusterRoleIdentity_To_v1beta1_AWSClusterRoleIdentity(in
 
 func autoConvert_v1beta1_AWSClusterRoleIdentityList_To_v1beta2_AWSClusterRoleIdentityList(in *AWSClusterRoleIdentityList, out *v1beta2.AWSClusterRoleIdentityList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1beta2.AWSClusterRoleIdentity)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.AWSClusterRoleIdentity, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_AWSClusterRoleIdentity_To_v1beta2_AWSClusterRoleIdentity(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -830,7 +836,17 @@ func Convert_v1beta1_AWSClusterRoleIdentityList_To_v1beta2_AWSClusterRoleIdentit
 
 func autoConvert_v1beta2_AWSClusterRoleIdentityList_To_v1beta1_AWSClusterRoleIdentityList(in *v1beta2.AWSClusterRoleIdentityList, out *AWSClusterRoleIdentityList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]AWSClusterRoleIdentity)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSClusterRoleIdentity, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_AWSClusterRoleIdentity_To_v1beta1_AWSClusterRoleIdentity(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -923,6 +939,10 @@ func autoConvert_v1beta2_AWSClusterSpec_To_v1beta1_AWSClusterSpec(in *v1beta2.AW
 	out.SSHKeyName = (*string)(unsafe.Pointer(in.SSHKeyName))
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	out.AdditionalTags = *(*Tags)(unsafe.Pointer(&in.AdditionalTags))
+	// WARNING: in.MachineLabelToTag requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceNameTemplate requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeNameFromPrivateDNSName requires manual conversion: does not exist in peer-type
+	// WARNING: in.OnExternalInstanceDeletion requires manual conversion: does not exist in peer-type
 	if in.ControlPlaneLoadBalancer != nil {
 		in, out := &in.ControlPlaneLoadBalancer, &out.ControlPlaneLoadBalancer
 		*out = new(AWSLoadBalancerSpec)
@@ -939,6 +959,7 @@ func autoConvert_v1beta2_AWSClusterSpec_To_v1beta1_AWSClusterSpec(in *v1beta2.AW
 		return err
 	}
 	out.IdentityRef = (*AWSIdentityReference)(unsafe.Pointer(in.IdentityRef))
+	// WARNING: in.IdentitySelector requires manual conversion: does not exist in peer-type
 	if in.S3Bucket != nil {
 		in, out := &in.S3Bucket, &out.S3Bucket
 		*out = new(S3Bucket)
@@ -948,6 +969,13 @@ func autoConvert_v1beta2_AWSClusterSpec_To_v1beta1_AWSClusterSpec(in *v1beta2.AW
 	} else {
 		out.S3Bucket = nil
 	}
+	// WARNING: in.PodCIDRBlocks requires manual conversion: does not exist in peer-type
+	// WARNING: in.EFS requires manual conversion: does not exist in peer-type
+	// WARNING: in.GlobalAcceleratorEndpointGroupARN requires manual conversion: does not exist in peer-type
+	// WARNING: in.EBSEncryptionKeyARN requires manual conversion: does not exist in peer-type
+	// WARNING: in.ManagedEncryptionKey requires manual conversion: does not exist in peer-type
+	// WARNING: in.PublicDNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReconcileMode requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -979,7 +1007,17 @@ func Convert_v1beta2_AWSClusterStaticIdentity_To_v1beta1_AWSClusterStaticIdentit
 
 func autoConvert_v1beta1_AWSClusterStaticIdentityList_To_v1beta2_AWSClusterStaticIdentityList(in *AWSClusterStaticIdentityList, out *v1beta2.AWSClusterStaticIdentityList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1beta2.AWSClusterStaticIdentity)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.AWSClusterStaticIdentity, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_AWSClusterStaticIdentity_To_v1beta2_AWSClusterStaticIdentity(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -990,7 +1028,17 @@ func Convert_v1beta1_AWSClusterStaticIdentityList_To_v1beta2_AWSClusterStaticIde
 
 func autoConvert_v1beta2_AWSClusterStaticIdentityList_To_v1beta1_AWSClusterStaticIdentityList(in *v1beta2.AWSClusterStaticIdentityList, out *AWSClusterStaticIdentityList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]AWSClusterStaticIdentity)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSClusterStaticIdentity, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_AWSClusterStaticIdentity_To_v1beta1_AWSClusterStaticIdentity(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -1017,14 +1065,10 @@ func autoConvert_v1beta2_AWSClusterStaticIdentitySpec_To_v1beta1_AWSClusterStati
 		return err
 	}
 	out.SecretRef = in.SecretRef
+	// WARNING: in.SecretNamespace requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_AWSClusterStaticIdentitySpec_To_v1beta1_AWSClusterStaticIdentitySpec is an autogenerated conversion function.
-func Convert_v1beta2_AWSClusterStaticIdentitySpec_To_v1beta1_AWSClusterStaticIdentitySpec(in *v1beta2.AWSClusterStaticIdentitySpec, out *AWSClusterStaticIdentitySpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_AWSClusterStaticIdentitySpec_To_v1beta1_AWSClusterStaticIdentitySpec(in, out, s)
-}
-
 func autoConvert_v1beta1_AWSClusterStatus_To_v1beta2_AWSClusterStatus(in *AWSClusterStatus, out *v1beta2.AWSClusterStatus, s conversion.Scope) error {
 	out.Ready = in.Ready
 	if err := Convert_v1beta1_NetworkStatus_To_v1beta2_NetworkStatus(&in.Network, &out.Network, s); err != nil {
@@ -1064,15 +1108,13 @@ func autoConvert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus(in *v1beta
 	} else {
 		out.Bastion = nil
 	}
+	// WARNING: in.EFS requires manual conversion: does not exist in peer-type
+	// WARNING: in.EBSEncryptionKeyGrantID requires manual conversion: does not exist in peer-type
+	// WARNING: in.ManagedEncryptionKeyARN requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus is an autogenerated conversion function.
-func Convert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus(in *v1beta2.AWSClusterStatus, out *AWSClusterStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_AWSClusterTemplate_To_v1beta2_AWSClusterTemplate(in *AWSClusterTemplate, out *v1beta2.AWSClusterTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_AWSClusterTemplateSpec_To_v1beta2_AWSClusterTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1231,15 +1273,20 @@ func Convert_v1beta1_AWSLoadBalancerSpec_To_v1beta2_AWSLoadBalancerSpec(in *AWSL
 func autoConvert_v1beta2_AWSLoadBalancerSpec_To_v1beta1_AWSLoadBalancerSpec(in *v1beta2.AWSLoadBalancerSpec, out *AWSLoadBalancerSpec, s conversion.Scope) error {
 	out.Name = (*string)(unsafe.Pointer(in.Name))
 	out.Scheme = (*ClassicELBScheme)(unsafe.Pointer(in.Scheme))
+	// WARNING: in.Port requires manual conversion: does not exist in peer-type
 	out.CrossZoneLoadBalancing = in.CrossZoneLoadBalancing
+	// WARNING: in.IdleTimeout requires manual conversion: does not exist in peer-type
 	out.Subnets = *(*[]string)(unsafe.Pointer(&in.Subnets))
 	out.HealthCheckProtocol = (*ClassicELBProtocol)(unsafe.Pointer(in.HealthCheckProtocol))
 	out.AdditionalSecurityGroups = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroups))
 	// WARNING: in.AdditionalListeners requires manual conversion: does not exist in peer-type
 	// WARNING: in.IngressRules requires manual conversion: does not exist in peer-type
+	// WARNING: in.AllowedCIDRBlocks requires manual conversion: does not exist in peer-type
 	// WARNING: in.LoadBalancerType requires manual conversion: does not exist in peer-type
 	// WARNING: in.DisableHostsRewrite requires manual conversion: does not exist in peer-type
 	// WARNING: in.PreserveClientIP requires manual conversion: does not exist in peer-type
+	// WARNING: in.SSLCertificateARN requires manual conversion: does not exist in peer-type
+	// WARNING: in.SSLPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1411,6 +1458,15 @@ func autoConvert_v1beta2_AWSMachineSpec_To_v1beta1_AWSMachineSpec(in *v1beta2.AW
 	out.SpotMarketOptions = (*SpotMarketOptions)(unsafe.Pointer(in.SpotMarketOptions))
 	// WARNING: in.PlacementGroupName requires manual conversion: does not exist in peer-type
 	out.Tenancy = in.Tenancy
+	// WARNING: in.CPUOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.EnclaveOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrivateDNSName requires manual conversion: does not exist in peer-type
+	// WARNING: in.FallbackInstanceTypes requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalBootstrapParameters requires manual conversion: does not exist in peer-type
+	// WARNING: in.EnableDeletionProtection requires manual conversion: does not exist in peer-type
+	// WARNING: in.MaintenanceOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.SourceDestCheck requires manual conversion: does not exist in peer-type
+	// WARNING: in.OnExternalInstanceDeletion requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1435,17 +1491,16 @@ func autoConvert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(in *v1beta
 	out.Interruptible = in.Interruptible
 	out.Addresses = *(*[]apiv1beta1.MachineAddress)(unsafe.Pointer(&in.Addresses))
 	out.InstanceState = (*InstanceState)(unsafe.Pointer(in.InstanceState))
+	// WARNING: in.InstanceType requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceCreationTime requires manual conversion: does not exist in peer-type
 	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
 	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
 	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.ObservedGeneration requires manual conversion: does not exist in peer-type
+	// WARNING: in.LastFullReconcileTime requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus is an autogenerated conversion function.
-func Convert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(in *v1beta2.AWSMachineStatus, out *AWSMachineStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_AWSMachineTemplate_To_v1beta2_AWSMachineTemplate(in *AWSMachineTemplate, out *v1beta2.AWSMachineTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_AWSMachineTemplateSpec_To_v1beta2_AWSMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1628,14 +1683,11 @@ func autoConvert_v1beta2_AWSRoleSpec_To_v1beta1_AWSRoleSpec(in *v1beta2.AWSRoleS
 	out.DurationSeconds = in.DurationSeconds
 	out.InlinePolicy = in.InlinePolicy
 	out.PolicyARNs = *(*[]string)(unsafe.Pointer(&in.PolicyARNs))
+	// WARNING: in.SessionTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.TransitiveTagKeys requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_AWSRoleSpec_To_v1beta1_AWSRoleSpec is an autogenerated conversion function.
-func Convert_v1beta2_AWSRoleSpec_To_v1beta1_AWSRoleSpec(in *v1beta2.AWSRoleSpec, out *AWSRoleSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_AWSRoleSpec_To_v1beta1_AWSRoleSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_AllowedNamespaces_To_v1beta2_AllowedNamespaces(in *AllowedNamespaces, out *v1beta2.AllowedNamespaces, s conversion.Scope) error {
 	out.NamespaceList = *(*[]string)(unsafe.Pointer(&in.NamespaceList))
 	out.Selector = in.Selector
@@ -2008,8 +2060,14 @@ func autoConvert_v1beta2_Instance_To_v1beta1_Instance(in *v1beta2.Instance, out
 	out.SpotMarketOptions = (*SpotMarketOptions)(unsafe.Pointer(in.SpotMarketOptions))
 	// WARNING: in.PlacementGroupName requires manual conversion: does not exist in peer-type
 	out.Tenancy = in.Tenancy
+	// WARNING: in.CPUOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.EnclaveOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.DisableAPITermination requires manual conversion: does not exist in peer-type
 	out.VolumeIDs = *(*[]string)(unsafe.Pointer(&in.VolumeIDs))
 	// WARNING: in.InstanceMetadataOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrivateDNSName requires manual conversion: does not exist in peer-type
+	// WARNING: in.MaintenanceOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.SourceDestCheck requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2055,7 +2113,19 @@ func autoConvert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(in *v1beta2.NetworkS
 	}
 	out.CNI = (*CNISpec)(unsafe.Pointer(in.CNI))
 	out.SecurityGroupOverrides = *(*map[SecurityGroupRole]string)(unsafe.Pointer(&in.SecurityGroupOverrides))
+	// WARNING: in.SecurityGroupReconcileStrategies requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecurityGroupEgressRules requires manual conversion: does not exist in peer-type
 	// WARNING: in.AdditionalControlPlaneIngressRules requires manual conversion: does not exist in peer-type
+	// WARNING: in.SubnetCidrSizes requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalRoutes requires manual conversion: does not exist in peer-type
+	// WARNING: in.TransitGatewayAttachment requires manual conversion: does not exist in peer-type
+	// WARNING: in.VPCPeerings requires manual conversion: does not exist in peer-type
+	// WARNING: in.NetworkACL requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceConnectEndpoint requires manual conversion: does not exist in peer-type
+	// WARNING: in.SubnetAvailableIPAddressThreshold requires manual conversion: does not exist in peer-type
+	// WARNING: in.KarpenterDiscovery requires manual conversion: does not exist in peer-type
+	// WARNING: in.NatGatewayStrategy requires manual conversion: does not exist in peer-type
+	// WARNING: in.FlowLogs requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2102,6 +2172,10 @@ func autoConvert_v1beta2_NetworkStatus_To_v1beta1_NetworkStatus(in *v1beta2.Netw
 		return err
 	}
 	// WARNING: in.NatGatewaysIPs requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceConnectEndpointID requires manual conversion: does not exist in peer-type
+	// WARNING: in.Subnets requires manual conversion: does not exist in peer-type
+	// WARNING: in.KarpenterDiscoveryTagValue requires manual conversion: does not exist in peer-type
+	// WARNING: in.FlowLogID requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2141,6 +2215,9 @@ func autoConvert_v1beta2_S3Bucket_To_v1beta1_S3Bucket(in *v1beta2.S3Bucket, out
 	out.ControlPlaneIAMInstanceProfile = in.ControlPlaneIAMInstanceProfile
 	out.NodesIAMInstanceProfiles = *(*[]string)(unsafe.Pointer(&in.NodesIAMInstanceProfiles))
 	// WARNING: in.PresignedURLDuration requires manual conversion: does not exist in peer-type
+	// WARNING: in.EncryptionContext requires manual conversion: does not exist in peer-type
+	// WARNING: in.Region requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootDiagnostics requires manual conversion: does not exist in peer-type
 	out.Name = in.Name
 	return nil
 }
@@ -2238,6 +2315,7 @@ func autoConvert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(in *v1beta2.SubnetSpec
 	out.IsPublic = in.IsPublic
 	out.IsIPv6 = in.IsIPv6
 	out.RouteTableID = (*string)(unsafe.Pointer(in.RouteTableID))
+	// WARNING: in.ExistingRouteTableID requires manual conversion: does not exist in peer-type
 	out.NatGatewayID = (*string)(unsafe.Pointer(in.NatGatewayID))
 	out.Tags = *(*Tags)(unsafe.Pointer(&in.Tags))
 	return nil
@@ -56,6 +56,39 @@ type AWSClusterSpec struct {
 	// +optional
 	AdditionalTags Tags `json:"additionalTags,omitempty"`
 
+	// MachineLabelToTag is an optional mapping of Machine label keys to the tag keys they should be
+	// propagated to on the EC2 instances of the cluster. Only the labels listed here are propagated.
+	// Tags added by the AWS provider, and tags specified in additionalTags, take precedence on conflict.
+	// +optional
+	MachineLabelToTag map[string]string `json:"machineLabelToTag,omitempty"`
+
+	// InstanceNameTemplate is an optional Go template rendering the Name tag of the EC2 instances of the
+	// cluster, instead of the name of the AWSMachine. It is rendered with .Machine, .AWSMachine and .Cluster,
+	// the Cluster API objects of the instance, and .Role, either control-plane or node, e.g.
+	// {{ .Cluster.Name }}-{{ .Role }}-{{ .Machine.Name }}. The name of the AWSMachine is used if the template
+	// fails to render, or renders an empty name or a name longer than 255 characters. Existing instances are found
+	// by their MachineName tag, so changing the template doesn't orphan them. See also: https://golang.org/pkg/text/template/
+	// +optional
+	InstanceNameTemplate string `json:"instanceNameTemplate,omitempty"`
+
+	// NodeNameFromPrivateDNSName, when true, sets the nodeRegistration.name of the kubeadm configuration of
+	// the instances of the cluster to their EC2 private DNS name, so their nodes are named after it whatever
+	// the hostname type of the instances. The name is resolved by cloud-init on boot, and passed by kubeadm to
+	// the kubelet as its --hostname-override argument. Requires the cloud-config bootstrap data of the kubeadm
+	// bootstrap provider, it is not supported when the bootstrap data format is ignition, so it can't be set
+	// along with the S3Bucket. Bootstrap data which isn't such a cloud-config is left unchanged. The instances of
+	// the AWSMachinePools of the cluster are named after their ip-name private DNS name.
+	// +optional
+	NodeNameFromPrivateDNSName bool `json:"nodeNameFromPrivateDNSName,omitempty"`
+
+	// OnExternalInstanceDeletion is the default OnExternalInstanceDeletion of the AWSMachines of the cluster,
+	// used by the AWSMachines which don't set their own. Recreate is not applied to control plane machines,
+	// which are failed instead, as a new instance with the bootstrap data of the original one can't rejoin the
+	// control plane.
+	// +kubebuilder:validation:Enum:=Recreate;FailMachine
+	// +optional
+	OnExternalInstanceDeletion OnExternalInstanceDeletion `json:"onExternalInstanceDeletion,omitempty"`
+
 	// ControlPlaneLoadBalancer is optional configuration for customizing control plane behavior.
 	// +optional
 	ControlPlaneLoadBalancer *AWSLoadBalancerSpec `json:"controlPlaneLoadBalancer,omitempty"`
@@ -95,12 +128,75 @@ type AWSClusterSpec struct {
 	// +optional
 	IdentityRef *AWSIdentityReference `json:"identityRef,omitempty"`
 
+	// IdentitySelector selects the identity to be used when reconciling this cluster by label.
+	// It must match exactly one AWSClusterRoleIdentity or AWSClusterStaticIdentity.
+	// IdentityRef takes precedence over IdentitySelector.
+	// +optional
+	IdentitySelector *metav1.LabelSelector `json:"identitySelector,omitempty"`
+
 	// S3Bucket contains options to configure a supporting S3 bucket for this
 	// cluster - currently used for nodes requiring Ignition
 	// (https://coreos.github.io/ignition/) for bootstrapping (requires
 	// BootstrapFormatIgnition feature flag to be enabled).
 	// +optional
 	S3Bucket *S3Bucket `json:"s3Bucket,omitempty"`
+
+	// PodCIDRBlocks are the CIDR blocks used by the CNI for pod IPs, from which the intra-cluster
+	// CNI ingress rules of the control plane and node security groups are opened in addition to the
+	// control plane and node security groups. This is needed for CNIs routing pod traffic between nodes
+	// without encapsulation, with pod IPs outside of the VPC CIDR.
+	// +optional
+	PodCIDRBlocks []string `json:"podCIDRBlocks,omitempty"`
+
+	// EFS contains options to configure an EFS file system for the EFS CSI driver, along with its
+	// mount targets in the node subnets and a security group allowing NFS traffic from the nodes.
+	// +optional
+	EFS *EFSSpec `json:"efs,omitempty"`
+
+	// GlobalAcceleratorEndpointGroupARN is the ARN of an AWS Global Accelerator endpoint group, in the
+	// region of the cluster, to register the control plane with. Network and application load balancers
+	// are registered as endpoints, while classic load balancers aren't supported by Global Accelerator,
+	// so the control plane instances are registered instead.
+	// +optional
+	GlobalAcceleratorEndpointGroupARN string `json:"globalAcceleratorEndpointGroupARN,omitempty"`
+
+	// EBSEncryptionKeyARN is the ARN of a customer managed KMS key owned by another AWS account, used to
+	// encrypt the encrypted EBS volumes of the cluster instances which don't set their own encryption key. A grant allowing the Auto Scaling service-linked
+	// role of the cluster account to use the key is created, and revoked when the cluster is deleted.
+	// +optional
+	EBSEncryptionKeyARN string `json:"ebsEncryptionKeyARN,omitempty"`
+
+	// ManagedEncryptionKey, when true, has CAPA create a customer managed KMS key dedicated to the cluster, with
+	// automatic rotation enabled and the alias alias/<cluster name>-ebs. The key encrypts the encrypted EBS volumes
+	// of the cluster instances which don't set their own encryption key. The deletion of the key is scheduled when
+	// the cluster is deleted. It can't be combined with EBSEncryptionKeyARN.
+	// +optional
+	ManagedEncryptionKey bool `json:"managedEncryptionKey,omitempty"`
+
+	// PublicDNS configures an alias record in a Route53 public hosted zone pointing at the control plane
+	// load balancer. When set, the record name is used as the host of the control plane endpoint.
+	// +optional
+	PublicDNS *PublicDNS `json:"publicDNS,omitempty"`
+
+	// ReconcileMode sets how the AWS resources of the cluster are reconciled. In the full mode, the resources
+	// are created and updated to match the spec. In the detectOnly mode, no AWS API operation changing a
+	// resource is called, for the cluster nor its machines, and the operations that would have been called
+	// are reported by the ResourcesInSync condition and by events instead. Defaults to full.
+	// +kubebuilder:validation:Enum=full;detectOnly
+	// +optional
+	ReconcileMode ReconcileMode `json:"reconcileMode,omitempty"`
+}
+
+// PublicDNS defines a DNS record in a Route53 public hosted zone for the control plane endpoint.
+type PublicDNS struct {
+	// HostedZoneID is the ID of the Route53 public hosted zone to create the record in.
+	// +kubebuilder:validation:MinLength:=1
+	HostedZoneID string `json:"hostedZoneID"`
+
+	// RecordName is the fully qualified domain name of the record, e.g. api.mycluster.example.com.
+	// It must be within the domain of the hosted zone.
+	// +kubebuilder:validation:MinLength:=1
+	RecordName string `json:"recordName"`
 }
 
 // AWSIdentityKind defines allowed AWS identity types.
@@ -182,6 +278,15 @@ type AWSLoadBalancerSpec struct {
 	// +optional
 	Scheme *ELBScheme `json:"scheme,omitempty"`
 
+	// Port sets the port the control plane load balancer listens on and forwards to on the control plane
+	// instances, which is also the port of the control plane endpoint. It takes precedence over the API
+	// server port of the cluster network of the Cluster. Must be between 1 and 65535. Once set, the value
+	// cannot be changed.
+	//
+	// Defaults to 6443.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
 	// CrossZoneLoadBalancing enables the classic ELB cross availability zone balancing.
 	//
 	// With cross-zone load balancing, each load balancer node for your Classic Load Balancer
@@ -193,6 +298,14 @@ type AWSLoadBalancerSpec struct {
 	// +optional
 	CrossZoneLoadBalancing bool `json:"crossZoneLoadBalancing"`
 
+	// IdleTimeout sets the time that a connection to the classic ELB is allowed to be idle
+	// before it is closed by the load balancer. Must be between 1 and 4000 seconds.
+	// This is only applicable to classic load balancers.
+	//
+	// Defaults to 10 minutes.
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+
 	// Subnets sets the subnets that should be applied to the control plane load balancer (defaults to discovered subnets for managed VPCs or an empty set for unmanaged VPCs)
 	// +optional
 	Subnets []string `json:"subnets,omitempty"`
@@ -219,6 +332,13 @@ type AWSLoadBalancerSpec struct {
 	// +optional
 	IngressRules []IngressRule `json:"ingressRules,omitempty"`
 
+	// AllowedCIDRBlocks is a list of CIDR blocks allowed to access the API server through the
+	// control plane load balancer security group. For Network Load Balancers, the security group
+	// is only attached when the load balancer is created.
+	// Cannot be used together with IngressRules.
+	// +optional
+	AllowedCIDRBlocks []string `json:"allowedCIDRBlocks,omitempty"`
+
 	// LoadBalancerType sets the type for a load balancer. The default type is classic.
 	// +kubebuilder:default=classic
 	// +kubebuilder:validation:Enum:=classic;elb;alb;nlb
@@ -231,6 +351,26 @@ type AWSLoadBalancerSpec struct {
 	// PreserveClientIP lets the user control if preservation of client ips must be retained or not.
 	// If this is enabled 6443 will be opened to 0.0.0.0/0.
 	PreserveClientIP bool `json:"preserveClientIP,omitempty"`
+
+	// SSLCertificateARN sets the ARN of the ACM certificate used to terminate TLS on the API server listener.
+	// When set, the listener and its target group use the HTTPS protocol.
+	// This is only applicable to Application Load Balancer (ALB) types. The certificate can be replaced, but not
+	// added or removed once the cluster is created.
+	// +optional
+	SSLCertificateARN *string `json:"sslCertificateARN,omitempty"`
+
+	// SSLPolicy sets the security policy of the HTTPS listener when SSLCertificateARN is set.
+	// Defaults to ELBSecurityPolicy-TLS13-1-2-2021-06.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+}
+
+// TargetPort returns the port the load balancer forwards the API server traffic to on the control plane instances.
+func (s *AWSLoadBalancerSpec) TargetPort() int64 {
+	if s != nil && s.Port != nil {
+		return *s.Port
+	}
+	return DefaultAPIServerPort
 }
 
 // AdditionalListenerSpec defines the desired state of an
@@ -254,7 +394,15 @@ type AWSClusterStatus struct {
 	Network        NetworkStatus            `json:"networkStatus,omitempty"`
 	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
 	Bastion        *Instance                `json:"bastion,omitempty"`
-	Conditions     clusterv1.Conditions     `json:"conditions,omitempty"`
+	EFS            *EFSStatus               `json:"efs,omitempty"`
+	// EBSEncryptionKeyGrantID is the ID of the KMS grant created for EBSEncryptionKeyARN.
+	EBSEncryptionKeyGrantID string `json:"ebsEncryptionKeyGrantID,omitempty"`
+	// EBSEncryptionKeyEC2GrantID is the ID of the KMS grant created for EBSEncryptionKeyARN allowing the controller
+	// identity to launch instances with volumes encrypted with the key.
+	EBSEncryptionKeyEC2GrantID string `json:"ebsEncryptionKeyEC2GrantID,omitempty"`
+	// ManagedEncryptionKeyARN is the ARN of the KMS key created for ManagedEncryptionKey.
+	ManagedEncryptionKeyARN string               `json:"managedEncryptionKeyARN,omitempty"`
+	Conditions              clusterv1.Conditions `json:"conditions,omitempty"`
 }
 
 type S3Bucket struct {
@@ -277,6 +425,21 @@ type S3Bucket struct {
 	// +optional
 	PresignedURLDuration *metav1.Duration `json:"presignedURLDuration,omitempty"`
 
+	// EncryptionContext is the KMS encryption context set on the bootstrap data objects
+	// uploaded to the S3 Bucket. S3 stores the encryption context with the object and
+	// supplies it to KMS when the object is read, so no configuration is needed on the instances.
+	// +optional
+	EncryptionContext map[string]string `json:"encryptionContext,omitempty"`
+
+	// Region is the region of the S3 Bucket. Defaults to the region of the cluster.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// BootDiagnostics enables archiving the console output and screenshot of control plane instances
+	// that don't become nodes within a grace period to the S3 Bucket, under boot-diagnostics/<machine name>/.
+	// +optional
+	BootDiagnostics *BootDiagnostics `json:"bootDiagnostics,omitempty"`
+
 	// Name defines name of S3 Bucket to be created.
 	// +kubebuilder:validation:MinLength:=3
 	// +kubebuilder:validation:MaxLength:=63
@@ -284,6 +447,67 @@ type S3Bucket struct {
 	Name string `json:"name"`
 }
 
+// BootDiagnostics configures the capture of the boot diagnostics of control plane instances.
+type BootDiagnostics struct {
+	// GracePeriod is how long a control plane instance has to become a node before its
+	// boot diagnostics are captured. Defaults to 15 minutes.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// EFSPerformanceMode defines the performance mode of an EFS file system.
+type EFSPerformanceMode string
+
+const (
+	// EFSPerformanceModeGeneralPurpose is the general purpose performance mode.
+	EFSPerformanceModeGeneralPurpose = EFSPerformanceMode("generalPurpose")
+
+	// EFSPerformanceModeMaxIO is the max I/O performance mode.
+	EFSPerformanceModeMaxIO = EFSPerformanceMode("maxIO")
+)
+
+// EFSThroughputMode defines the throughput mode of an EFS file system.
+type EFSThroughputMode string
+
+const (
+	// EFSThroughputModeBursting is the bursting throughput mode.
+	EFSThroughputModeBursting = EFSThroughputMode("bursting")
+
+	// EFSThroughputModeElastic is the elastic throughput mode.
+	EFSThroughputModeElastic = EFSThroughputMode("elastic")
+)
+
+// EFSSpec defines the EFS file system of a cluster.
+type EFSSpec struct {
+	// FileSystemID is the ID of an existing EFS file system to use. When not set, a file system is
+	// created, and deleted along with the cluster.
+	// +optional
+	FileSystemID string `json:"fileSystemID,omitempty"`
+
+	// PerformanceMode is the performance mode of the created file system.
+	// +kubebuilder:validation:Enum=generalPurpose;maxIO
+	// +kubebuilder:default=generalPurpose
+	// +optional
+	PerformanceMode EFSPerformanceMode `json:"performanceMode,omitempty"`
+
+	// ThroughputMode is the throughput mode of the created file system.
+	// +kubebuilder:validation:Enum=bursting;elastic
+	// +kubebuilder:default=bursting
+	// +optional
+	ThroughputMode EFSThroughputMode `json:"throughputMode,omitempty"`
+
+	// Encrypted defines whether the created file system is encrypted at rest.
+	// +kubebuilder:default=true
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+}
+
+// EFSStatus defines the observed state of the EFS file system of a cluster.
+type EFSStatus struct {
+	// FileSystemID is the ID of the EFS file system.
+	FileSystemID string `json:"fileSystemID"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=awsclusters,scope=Namespaced,categories=cluster-api,shortName=awsc
 // +kubebuilder:storageversion
@@ -18,11 +18,18 @@ package v1beta2
 
 import (
 	"fmt"
+	"net"
+	"regexp"
 	"strings"
+	"text/template"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/google/go-cmp/cmp"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -47,6 +54,8 @@ func (r *AWSCluster) SetupWebhookWithManager(mgr ctrl.Manager) error {
 var (
 	_ webhook.Validator = &AWSCluster{}
 	_ webhook.Defaulter = &AWSCluster{}
+
+	hostedZoneIDRegex = regexp.MustCompile(`^(/hostedzone/)?[A-Z0-9]+$`)
 )
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
@@ -56,9 +65,18 @@ func (r *AWSCluster) ValidateCreate() (admission.Warnings, error) {
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.validateSSHKeyName()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, ValidateTagReconcilePolicyAnnotation(r.GetAnnotations())...)
+	allErrs = append(allErrs, r.validateMachineLabelToTag()...)
+	allErrs = append(allErrs, r.validateInstanceNameTemplate()...)
+	allErrs = append(allErrs, r.validateNodeNameFromPrivateDNSName()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
 	allErrs = append(allErrs, r.validateNetwork()...)
 	allErrs = append(allErrs, r.validateControlPlaneLB()...)
+	allErrs = append(allErrs, r.validatePodCIDRBlocks()...)
+	allErrs = append(allErrs, r.validateGlobalAccelerator()...)
+	allErrs = append(allErrs, r.validateIdentitySelector()...)
+	allErrs = append(allErrs, r.validateEBSEncryptionKey()...)
+	allErrs = append(allErrs, r.validatePublicDNS()...)
 
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -132,6 +150,22 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) (admission.Warnings, err
 		)
 	}
 
+	if !cmp.Equal(newLoadBalancer.Port, existingLoadBalancer.Port) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "port"),
+				newLoadBalancer.Port, "field is immutable"),
+		)
+	}
+
+	// The certificate sets the protocol of the listener and its target group, which can't be changed in place, so it
+	// can only be replaced once set.
+	if (newLoadBalancer.SSLCertificateARN == nil) != (existingLoadBalancer.SSLCertificateARN == nil) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "sslCertificateARN"),
+				newLoadBalancer.SSLCertificateARN, "field can't be added or removed once the cluster is created"),
+		)
+	}
+
 	if !cmp.Equal(oldC.Spec.ControlPlaneEndpoint, clusterv1.APIEndpoint{}) &&
 		!cmp.Equal(r.Spec.ControlPlaneEndpoint, oldC.Spec.ControlPlaneEndpoint) {
 		allErrs = append(allErrs,
@@ -152,14 +186,84 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) (admission.Warnings, err
 		}
 	}
 
-	// If a identityRef is already set, do not allow removal of it.
-	if oldC.Spec.IdentityRef != nil && r.Spec.IdentityRef == nil {
+	// If a identityRef is already set, do not allow removal of it, unless the identity is selected by label instead.
+	if oldC.Spec.IdentityRef != nil && r.Spec.IdentityRef == nil && r.Spec.IdentitySelector == nil {
 		allErrs = append(allErrs,
 			field.Invalid(field.NewPath("spec", "identityRef"),
 				r.Spec.IdentityRef, "field cannot be set to nil"),
 		)
 	}
 
+	// The transit gateway attachment cannot be moved or removed, as the existing attachment would be left behind.
+	if oldAttachment := oldC.Spec.NetworkSpec.TransitGatewayAttachment; oldAttachment != nil {
+		newAttachment := r.Spec.NetworkSpec.TransitGatewayAttachment
+		attachmentPath := field.NewPath("spec", "network", "transitGatewayAttachment")
+		switch {
+		case newAttachment == nil:
+			allErrs = append(allErrs, field.Invalid(attachmentPath, newAttachment, "field cannot be removed once set"))
+		case newAttachment.TransitGatewayID != oldAttachment.TransitGatewayID:
+			allErrs = append(allErrs, field.Invalid(attachmentPath.Child("transitGatewayId"), newAttachment.TransitGatewayID, "field is immutable"))
+		case !cmp.Equal(newAttachment.SubnetIDs, oldAttachment.SubnetIDs):
+			allErrs = append(allErrs, field.Invalid(attachmentPath.Child("subnetIds"), newAttachment.SubnetIDs, "field is immutable"))
+		}
+	}
+
+	// The EFS file system cannot be changed or removed, as the existing file system and mount targets would be left behind.
+	if oldC.Spec.EFS != nil {
+		efsPath := field.NewPath("spec", "efs")
+		switch {
+		case r.Spec.EFS == nil:
+			allErrs = append(allErrs, field.Invalid(efsPath, r.Spec.EFS, "field cannot be removed once set"))
+		case !cmp.Equal(r.Spec.EFS, oldC.Spec.EFS):
+			allErrs = append(allErrs, field.Invalid(efsPath, r.Spec.EFS, "field is immutable"))
+		}
+	}
+
+	// The Global Accelerator endpoint group cannot be changed or removed, as the existing endpoints would be left behind.
+	if oldC.Spec.GlobalAcceleratorEndpointGroupARN != "" && r.Spec.GlobalAcceleratorEndpointGroupARN != oldC.Spec.GlobalAcceleratorEndpointGroupARN {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "globalAcceleratorEndpointGroupARN"), r.Spec.GlobalAcceleratorEndpointGroupARN, "field is immutable once set"),
+		)
+	}
+
+	// The EBS encryption key cannot be changed or removed, as the existing grant would be left behind.
+	if oldC.Spec.EBSEncryptionKeyARN != "" && r.Spec.EBSEncryptionKeyARN != oldC.Spec.EBSEncryptionKeyARN {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "ebsEncryptionKeyARN"), r.Spec.EBSEncryptionKeyARN, "field is immutable once set"),
+		)
+	}
+
+	// Disabling the managed EBS encryption key would leave the key behind, while the volumes encrypted with it
+	// would become unusable if it were deleted.
+	if oldC.Spec.ManagedEncryptionKey && !r.Spec.ManagedEncryptionKey {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "managedEncryptionKey"), r.Spec.ManagedEncryptionKey, "field cannot be disabled once enabled"),
+		)
+	}
+
+	// The public DNS record is the host of the control plane endpoint, which cannot be changed once set.
+	if !cmp.Equal(oldC.Spec.ControlPlaneEndpoint, clusterv1.APIEndpoint{}) && !cmp.Equal(r.Spec.PublicDNS, oldC.Spec.PublicDNS) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "publicDNS"), r.Spec.PublicDNS, "field is immutable once the control plane endpoint is set"),
+		)
+	}
+
+	// VPC peerings cannot be changed or removed once created, as the existing peering connections would be left behind.
+	newPeerings := make(map[string]VPCPeeringSpec, len(r.Spec.NetworkSpec.VPCPeerings))
+	for _, peering := range r.Spec.NetworkSpec.VPCPeerings {
+		newPeerings[peering.PeerVPCID] = peering
+	}
+	for _, oldPeering := range oldC.Spec.NetworkSpec.VPCPeerings {
+		newPeering, ok := newPeerings[oldPeering.PeerVPCID]
+		peeringsPath := field.NewPath("spec", "network", "vpcPeerings")
+		switch {
+		case !ok:
+			allErrs = append(allErrs, field.Invalid(peeringsPath, r.Spec.NetworkSpec.VPCPeerings, fmt.Sprintf("peering to %q cannot be removed once set", oldPeering.PeerVPCID)))
+		case !cmp.Equal(newPeering.PeerOwnerID, oldPeering.PeerOwnerID) || newPeering.PeerRegion != oldPeering.PeerRegion:
+			allErrs = append(allErrs, field.Invalid(peeringsPath, r.Spec.NetworkSpec.VPCPeerings, fmt.Sprintf("peerOwnerId and peerRegion of the peering to %q are immutable", oldPeering.PeerVPCID)))
+		}
+	}
+
 	if annotations.IsExternallyManaged(oldC) && !annotations.IsExternallyManaged(r) {
 		allErrs = append(allErrs,
 			field.Invalid(field.NewPath("metadata", "annotations"),
@@ -169,7 +273,25 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) (admission.Warnings, err
 
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, ValidateTagReconcilePolicyAnnotation(r.GetAnnotations())...)
+	allErrs = append(allErrs, r.validateMachineLabelToTag()...)
+	allErrs = append(allErrs, r.validateInstanceNameTemplate()...)
+	allErrs = append(allErrs, r.validateNodeNameFromPrivateDNSName()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
+	allErrs = append(allErrs, r.validateAdditionalRoutes()...)
+	networkPath := field.NewPath("spec", "network")
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateExistingRouteTables(networkPath)...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateTransitGatewayAttachment(networkPath)...)
+	allErrs = append(allErrs, r.validateVPCPeerings()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateNetworkACL(networkPath)...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateFlowLogs(networkPath)...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateInstanceConnectEndpoint(networkPath)...)
+	allErrs = append(allErrs, r.validateControlPlaneLB()...)
+	allErrs = append(allErrs, r.validatePodCIDRBlocks()...)
+	allErrs = append(allErrs, r.validateGlobalAccelerator()...)
+	allErrs = append(allErrs, r.validateIdentitySelector()...)
+	allErrs = append(allErrs, r.validateEBSEncryptionKey()...)
+	allErrs = append(allErrs, r.validatePublicDNS()...)
 
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -219,6 +341,44 @@ func (r *AWSCluster) validateSSHKeyName() field.ErrorList {
 	return validateSSHKeyName(r.Spec.SSHKeyName)
 }
 
+func (r *AWSCluster) validateMachineLabelToTag() field.ErrorList {
+	var allErrs field.ErrorList
+	fldPath := field.NewPath("spec", "machineLabelToTag")
+	for label, tagKey := range r.Spec.MachineLabelToTag {
+		switch {
+		case tagKey == "":
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(label), tagKey, "tag key cannot be empty"))
+		case len(tagKey) > 128:
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(label), tagKey, "tag key cannot be longer than 128 characters"))
+		case wrongUserTagNomenclature(tagKey):
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(label), tagKey, "tag key cannot have prefix aws:"))
+		}
+	}
+	return allErrs
+}
+
+func (r *AWSCluster) validateInstanceNameTemplate() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.InstanceNameTemplate == "" {
+		return allErrs
+	}
+	if _, err := template.New("instanceName").Parse(r.Spec.InstanceNameTemplate); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "instanceNameTemplate"), r.Spec.InstanceNameTemplate, fmt.Sprintf("failed to parse template: %v", err)))
+	}
+	return allErrs
+}
+
+// validateNodeNameFromPrivateDNSName rejects naming nodes after their private DNS name in clusters bootstrapping
+// instances with ignition, which requires the S3 bucket, as only the node name of cloud-config bootstrap data can be set.
+func (r *AWSCluster) validateNodeNameFromPrivateDNSName() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.NodeNameFromPrivateDNSName && r.Spec.S3Bucket != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "nodeNameFromPrivateDNSName"),
+			"can't be set along with spec.s3Bucket, the node name of ignition bootstrap data can't be set"))
+	}
+	return allErrs
+}
+
 func (r *AWSCluster) validateNetwork() field.ErrorList {
 	var allErrs field.ErrorList
 	if r.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
@@ -243,6 +403,155 @@ func (r *AWSCluster) validateNetwork() field.ErrorList {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("additionalControlPlaneIngressRules"), r.Spec.NetworkSpec.AdditionalControlPlaneIngressRules, "CIDR blocks and security group IDs or security group roles cannot be used together"))
 		}
 	}
+
+	allErrs = append(allErrs, r.validateSubnetCidrSizes()...)
+	allErrs = append(allErrs, r.validateAdditionalRoutes()...)
+	networkPath := field.NewPath("spec", "network")
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateExistingRouteTables(networkPath)...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateTransitGatewayAttachment(networkPath)...)
+	allErrs = append(allErrs, r.validateVPCPeerings()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateNetworkACL(networkPath)...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateFlowLogs(networkPath)...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateInstanceConnectEndpoint(networkPath)...)
+	allErrs = append(allErrs, r.validateSecurityGroupEgressRules()...)
+
+	return allErrs
+}
+
+// validateSecurityGroupEgressRules checks the destinations and port ranges of the egress rules of every security group role,
+// and that the destination security group roles have a security group in the cluster.
+func (r *AWSCluster) validateSecurityGroupEgressRules() field.ErrorList {
+	var allErrs field.ErrorList
+
+	egressPath := field.NewPath("spec", "network", "securityGroupEgressRules")
+	for role, rules := range r.Spec.NetworkSpec.SecurityGroupEgressRules {
+		for i := range rules {
+			rulePath := egressPath.Key(string(role)).Index(i)
+			allErrs = append(allErrs, rules[i].Validate(rulePath)...)
+			for j, destinationRole := range rules[i].DestinationSecurityGroupRoles {
+				if !r.hasSecurityGroupRole(destinationRole) {
+					allErrs = append(allErrs, field.Invalid(rulePath.Child("destinationSecurityGroupRoles").Index(j), destinationRole, "the cluster has no security group with this role"))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// hasSecurityGroupRole returns whether a security group with the given role is reconciled for the cluster.
+func (r *AWSCluster) hasSecurityGroupRole(role SecurityGroupRole) bool {
+	switch role {
+	case SecurityGroupBastion:
+		return r.Spec.Bastion.Enabled
+	case SecurityGroupEFS:
+		return r.Spec.EFS != nil
+	case SecurityGroupInstanceConnectEndpoint:
+		return r.Spec.NetworkSpec.InstanceConnectEndpoint != nil
+	case SecurityGroupEKSNodeAdditional:
+		return false
+	default:
+		return true
+	}
+}
+
+// validateVPCPeerings checks that every VPC peering has a unique peer VPC.
+func (r *AWSCluster) validateVPCPeerings() field.ErrorList {
+	var allErrs field.ErrorList
+
+	peeringsPath := field.NewPath("spec", "network", "vpcPeerings")
+	peerVPCIDs := make(map[string]struct{}, len(r.Spec.NetworkSpec.VPCPeerings))
+	for i, peering := range r.Spec.NetworkSpec.VPCPeerings {
+		peeringPath := peeringsPath.Index(i)
+		if peering.PeerVPCID == "" {
+			allErrs = append(allErrs, field.Required(peeringPath.Child("peerVpcId"), "peerVpcId is required"))
+			continue
+		}
+		if _, ok := peerVPCIDs[peering.PeerVPCID]; ok {
+			allErrs = append(allErrs, field.Duplicate(peeringPath.Child("peerVpcId"), peering.PeerVPCID))
+		}
+		peerVPCIDs[peering.PeerVPCID] = struct{}{}
+		if peering.PeerVPCID == r.Spec.NetworkSpec.VPC.ID {
+			allErrs = append(allErrs, field.Invalid(peeringPath.Child("peerVpcId"), peering.PeerVPCID, "a VPC cannot be peered with itself"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateAdditionalRoutes checks that every additional route has a unique, non default
+// IPv4 destination and exactly one target.
+func (r *AWSCluster) validateAdditionalRoutes() field.ErrorList {
+	var allErrs field.ErrorList
+
+	routesPath := field.NewPath("spec", "network", "additionalRoutes")
+	destinations := make(map[string]struct{}, len(r.Spec.NetworkSpec.AdditionalRoutes))
+	for i, route := range r.Spec.NetworkSpec.AdditionalRoutes {
+		routePath := routesPath.Index(i)
+
+		_, destination, err := net.ParseCIDR(route.DestinationCidrBlock)
+		switch {
+		case err != nil || destination.IP.To4() == nil:
+			allErrs = append(allErrs, field.Invalid(routePath.Child("destinationCidrBlock"), route.DestinationCidrBlock, "must be a valid IPv4 CIDR block"))
+		case destination.String() == "0.0.0.0/0":
+			allErrs = append(allErrs, field.Invalid(routePath.Child("destinationCidrBlock"), route.DestinationCidrBlock, "the default route is managed by the provider and cannot be overridden"))
+		default:
+			if _, ok := destinations[destination.String()]; ok {
+				allErrs = append(allErrs, field.Duplicate(routePath.Child("destinationCidrBlock"), route.DestinationCidrBlock))
+			}
+			destinations[destination.String()] = struct{}{}
+		}
+
+		targets := 0
+		for _, target := range []*string{route.TransitGatewayID, route.VpcPeeringConnectionID, route.NetworkInterfaceID} {
+			if target != nil && *target != "" {
+				targets++
+			}
+		}
+		if targets != 1 {
+			allErrs = append(allErrs, field.Invalid(routePath, route, "exactly one of transitGatewayId, vpcPeeringConnectionId or networkInterfaceId must be set"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateSubnetCidrSizes checks that one public and one private subnet of the requested
+// sizes fit in the VPC CIDR for each availability zone that may be used.
+func (r *AWSCluster) validateSubnetCidrSizes() field.ErrorList {
+	var allErrs field.ErrorList
+
+	sizes := r.Spec.NetworkSpec.SubnetCidrSizes
+	if sizes == nil || r.Spec.NetworkSpec.VPC.CidrBlock == "" {
+		return allErrs
+	}
+
+	_, vpcCidr, err := net.ParseCIDR(r.Spec.NetworkSpec.VPC.CidrBlock)
+	if err != nil || vpcCidr.IP.To4() == nil {
+		return allErrs
+	}
+	vpcPrefixLength, addrLen := vpcCidr.Mask.Size()
+
+	sizesPath := field.NewPath("spec", "network", "subnetCidrSizes")
+	if sizes.PublicPrefixLength < vpcPrefixLength {
+		allErrs = append(allErrs, field.Invalid(sizesPath.Child("publicPrefixLength"), sizes.PublicPrefixLength, fmt.Sprintf("must not be smaller than the VPC CIDR prefix length %d", vpcPrefixLength)))
+	}
+	if sizes.PrivatePrefixLength < vpcPrefixLength {
+		allErrs = append(allErrs, field.Invalid(sizesPath.Child("privatePrefixLength"), sizes.PrivatePrefixLength, fmt.Sprintf("must not be smaller than the VPC CIDR prefix length %d", vpcPrefixLength)))
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	zones := 3
+	if r.Spec.NetworkSpec.VPC.AvailabilityZoneUsageLimit != nil {
+		zones = *r.Spec.NetworkSpec.VPC.AvailabilityZoneUsageLimit
+	}
+	perZone := uint64(1)<<uint(addrLen-sizes.PublicPrefixLength) + uint64(1)<<uint(addrLen-sizes.PrivatePrefixLength)
+	if uint64(zones)*perZone > uint64(1)<<uint(addrLen-vpcPrefixLength) {
+		allErrs = append(allErrs, field.Invalid(sizesPath, sizes, fmt.Sprintf("subnets of the requested sizes for %d availability zones do not fit in VPC CIDR %s", zones, r.Spec.NetworkSpec.VPC.CidrBlock)))
+	}
+
 	return allErrs
 }
 
@@ -266,5 +575,129 @@ func (r *AWSCluster) validateControlPlaneLB() field.ErrorList {
 		}
 	}
 
+	if len(r.Spec.ControlPlaneLoadBalancer.AllowedCIDRBlocks) > 0 && len(r.Spec.ControlPlaneLoadBalancer.IngressRules) > 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "controlPlaneLoadBalancer", "allowedCIDRBlocks"), "cannot be set if spec.controlPlaneLoadBalancer.ingressRules is set"))
+	}
+
+	if r.Spec.ControlPlaneLoadBalancer.SSLCertificateARN != nil {
+		if r.Spec.ControlPlaneLoadBalancer.LoadBalancerType != LoadBalancerTypeALB {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "sslCertificateARN"), r.Spec.ControlPlaneLoadBalancer.SSLCertificateARN, "SSL certificates are only supported for ALB load balancers"))
+		}
+		if !isCertificateARN(*r.Spec.ControlPlaneLoadBalancer.SSLCertificateARN) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "sslCertificateARN"), r.Spec.ControlPlaneLoadBalancer.SSLCertificateARN, "must be a valid ACM certificate ARN"))
+		}
+	}
+
+	if r.Spec.ControlPlaneLoadBalancer.SSLPolicy != nil && r.Spec.ControlPlaneLoadBalancer.SSLCertificateARN == nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "controlPlaneLoadBalancer", "sslPolicy"), "cannot be set if spec.controlPlaneLoadBalancer.sslCertificateARN is not set"))
+	}
+
+	if r.Spec.ControlPlaneLoadBalancer.IdleTimeout != nil {
+		if r.Spec.ControlPlaneLoadBalancer.LoadBalancerType != LoadBalancerTypeClassic && r.Spec.ControlPlaneLoadBalancer.LoadBalancerType != "" {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "idleTimeout"), r.Spec.ControlPlaneLoadBalancer.IdleTimeout.Duration.String(), "idle timeout is only supported for classic load balancers"))
+		}
+		if timeout := r.Spec.ControlPlaneLoadBalancer.IdleTimeout.Duration; timeout < time.Second || timeout > 4000*time.Second {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "idleTimeout"), timeout.String(), "must be between 1 and 4000 seconds"))
+		}
+	}
+
+	if port := r.Spec.ControlPlaneLoadBalancer.Port; port != nil && (*port < 1 || *port > 65535) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "port"), *port, "must be between 1 and 65535"))
+	}
+
+	for i, cidr := range r.Spec.ControlPlaneLoadBalancer.AllowedCIDRBlocks {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "allowedCIDRBlocks").Index(i), cidr, "must be a valid CIDR block"))
+		}
+	}
+
 	return allErrs
 }
+
+func (r *AWSCluster) validatePodCIDRBlocks() field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, cidr := range r.Spec.PodCIDRBlocks {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "podCIDRBlocks").Index(i), cidr, "must be a valid CIDR block"))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validateIdentitySelector() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.IdentitySelector == nil {
+		return allErrs
+	}
+	if _, err := metav1.LabelSelectorAsSelector(r.Spec.IdentitySelector); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "identitySelector"), r.Spec.IdentitySelector, err.Error()))
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validateGlobalAccelerator() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.GlobalAcceleratorEndpointGroupARN != "" && !isEndpointGroupARN(r.Spec.GlobalAcceleratorEndpointGroupARN) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "globalAcceleratorEndpointGroupARN"), r.Spec.GlobalAcceleratorEndpointGroupARN, "must be a valid Global Accelerator endpoint group ARN"))
+	}
+
+	return allErrs
+}
+
+// isCertificateARN returns true if the given string is an ACM certificate ARN.
+func isCertificateARN(s string) bool {
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return false
+	}
+	return parsed.Service == "acm" && strings.HasPrefix(parsed.Resource, "certificate/")
+}
+
+func (r *AWSCluster) validateEBSEncryptionKey() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.EBSEncryptionKeyARN == "" {
+		return allErrs
+	}
+	if r.Spec.ManagedEncryptionKey {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "managedEncryptionKey"), "cannot be set together with ebsEncryptionKeyARN"))
+	}
+	// Grants can only be created for keys identified by their ARN, as the key is in another account.
+	parsed, err := arn.Parse(r.Spec.EBSEncryptionKeyARN)
+	if err != nil || parsed.Service != "kms" || !strings.HasPrefix(parsed.Resource, "key/") {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "ebsEncryptionKeyARN"), r.Spec.EBSEncryptionKeyARN, "must be a valid KMS key ARN"))
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validatePublicDNS() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.PublicDNS == nil {
+		return allErrs
+	}
+
+	if !hostedZoneIDRegex.MatchString(r.Spec.PublicDNS.HostedZoneID) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "publicDNS", "hostedZoneID"), r.Spec.PublicDNS.HostedZoneID, "must be a valid Route53 hosted zone ID"))
+	}
+	for _, msg := range validation.IsDNS1123Subdomain(strings.TrimSuffix(r.Spec.PublicDNS.RecordName, ".")) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "publicDNS", "recordName"), r.Spec.PublicDNS.RecordName, msg))
+	}
+
+	return allErrs
+}
+
+// isEndpointGroupARN returns true if the given string is a Global Accelerator endpoint group ARN.
+func isEndpointGroupARN(s string) bool {
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return false
+	}
+	return parsed.Service == "globalaccelerator" && strings.Contains(parsed.Resource, "/endpoint-group/")
+}
@@ -39,6 +39,10 @@ func TestAWSClusterDefault(t *testing.T) {
 	cluster.Default()
 	g := NewWithT(t)
 	g.Expect(cluster.Spec.IdentityRef).NotTo(BeNil())
+
+	cluster = &AWSCluster{Spec: AWSClusterSpec{IdentitySelector: &metav1.LabelSelector{MatchLabels: map[string]string{"account": "production"}}}}
+	cluster.Default()
+	g.Expect(cluster.Spec.IdentityRef).To(BeNil())
 }
 
 func TestAWSClusterValidateCreate(t *testing.T) {
@@ -75,141 +79,222 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "accepts bucket name with acceptable characters",
+			name: "accepts additional routes with a single target",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					S3Bucket: &S3Bucket{
-						Name:                           "abcdefghijklmnoprstuwxyz-0123456789",
-						ControlPlaneIAMInstanceProfile: "control-plane.cluster-api-provider-aws.sigs.k8s.io",
-						NodesIAMInstanceProfiles:       []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"},
+					NetworkSpec: NetworkSpec{
+						AdditionalRoutes: []RouteSpec{
+							{
+								DestinationCidrBlock: "10.0.0.0/8",
+								TransitGatewayID:     aws.String("tgw-01"),
+							},
+							{
+								DestinationCidrBlock:   "192.168.0.0/16",
+								VpcPeeringConnectionID: aws.String("pcx-01"),
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "rejects empty bucket name",
+			name: "rejects additional routes without a target",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					S3Bucket: &S3Bucket{},
+					NetworkSpec: NetworkSpec{
+						AdditionalRoutes: []RouteSpec{
+							{
+								DestinationCidrBlock: "10.0.0.0/8",
+							},
+						},
+					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "rejects bucket name shorter than 3 characters",
+			name: "rejects additional routes with multiple targets",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					S3Bucket: &S3Bucket{
-						Name: "fo",
+					NetworkSpec: NetworkSpec{
+						AdditionalRoutes: []RouteSpec{
+							{
+								DestinationCidrBlock: "10.0.0.0/8",
+								TransitGatewayID:     aws.String("tgw-01"),
+								NetworkInterfaceID:   aws.String("eni-01"),
+							},
+						},
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "rejects bucket name longer than 63 characters",
+			name: "rejects additional routes overriding the default route",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					S3Bucket: &S3Bucket{
-						Name: strings.Repeat("a", 64),
+					NetworkSpec: NetworkSpec{
+						AdditionalRoutes: []RouteSpec{
+							{
+								DestinationCidrBlock: "0.0.0.0/0",
+								TransitGatewayID:     aws.String("tgw-01"),
+							},
+						},
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "rejects bucket name starting with not letter or number",
+			name: "rejects additional routes with duplicate destinations",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					S3Bucket: &S3Bucket{
-						Name: "-foo",
+					NetworkSpec: NetworkSpec{
+						AdditionalRoutes: []RouteSpec{
+							{
+								DestinationCidrBlock: "10.0.0.0/8",
+								TransitGatewayID:     aws.String("tgw-01"),
+							},
+							{
+								DestinationCidrBlock:   "10.0.0.0/8",
+								VpcPeeringConnectionID: aws.String("pcx-01"),
+							},
+						},
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "rejects bucket name ending with not letter or number",
+			name: "accepts a transit gateway attachment propagating to a route table",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					S3Bucket: &S3Bucket{
-						Name: "foo-",
+					NetworkSpec: NetworkSpec{
+						TransitGatewayAttachment: &TransitGatewayAttachmentSpec{
+							TransitGatewayID:            "tgw-01",
+							RouteTableID:                aws.String("tgw-rtb-01"),
+							EnableRouteTablePropagation: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "rejects a transit gateway attachment without a transit gateway id",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						TransitGatewayAttachment: &TransitGatewayAttachmentSpec{},
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "rejects bucket name formatted as IP address",
+			name: "rejects a transit gateway attachment propagation without a route table",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					S3Bucket: &S3Bucket{
-						Name: "8.8.8.8",
+					NetworkSpec: NetworkSpec{
+						TransitGatewayAttachment: &TransitGatewayAttachmentSpec{
+							TransitGatewayID:            "tgw-01",
+							EnableRouteTablePropagation: true,
+						},
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "requires bucket control plane IAM instance profile to be not empty",
+			name: "rejects vpc peerings without a peer vpc id",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					S3Bucket: &S3Bucket{
-						Name:                           "foo",
-						ControlPlaneIAMInstanceProfile: "",
+					NetworkSpec: NetworkSpec{
+						VPCPeerings: []VPCPeeringSpec{{}},
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "requires at least one bucket node IAM instance profile",
+			name: "rejects vpc peerings with duplicate peer vpcs",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					S3Bucket: &S3Bucket{
-						Name:                           "foo",
-						ControlPlaneIAMInstanceProfile: "foo",
+					NetworkSpec: NetworkSpec{
+						VPCPeerings: []VPCPeeringSpec{
+							{PeerVPCID: "vpc-peer"},
+							{PeerVPCID: "vpc-peer", AutoAccept: true},
+						},
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "requires all bucket node IAM instance profiles to be not empty",
+			name: "accepts a valid network acl",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					S3Bucket: &S3Bucket{
-						Name:                           "foo",
-						ControlPlaneIAMInstanceProfile: "foo",
-						NodesIAMInstanceProfiles:       []string{""},
+					NetworkSpec: NetworkSpec{
+						NetworkACL: &NetworkACLSpec{
+							Ingress: []NetworkACLEntry{
+								{RuleNumber: 100, Protocol: NetworkACLProtocolTCP, RuleAction: NetworkACLRuleActionAllow, CidrBlock: "10.0.0.0/16", FromPort: aws.Int64(443), ToPort: aws.Int64(443)},
+								{RuleNumber: 200, Protocol: NetworkACLProtocolAll, RuleAction: NetworkACLRuleActionDeny, CidrBlock: "0.0.0.0/0"},
+							},
+							Egress: []NetworkACLEntry{
+								{RuleNumber: 100, Protocol: NetworkACLProtocolAll, RuleAction: NetworkACLRuleActionAllow, CidrBlock: "0.0.0.0/0"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "accepts flow logs delivered to cloudwatch logs",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						FlowLogs: &FlowLogsSpec{
+							LogGroupName: "flow-logs",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "rejects flow logs delivered to cloudwatch logs without a log group",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						FlowLogs: &FlowLogsSpec{
+							DestinationType: FlowLogsDestinationTypeCloudWatchLogs,
+							IAMRoleARN:      "arn:aws:iam::123456789012:role/flow-logs",
+						},
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "does not return error when all IAM instance profiles are populated",
+			name: "accepts flow logs delivered to s3",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					S3Bucket: &S3Bucket{
-						Name:                           "foo",
-						ControlPlaneIAMInstanceProfile: "foo",
-						NodesIAMInstanceProfiles:       []string{"bar"},
+					NetworkSpec: NetworkSpec{
+						FlowLogs: &FlowLogsSpec{
+							DestinationType: FlowLogsDestinationTypeS3,
+							BucketARN:       "arn:aws:s3:::flow-logs/cluster/",
+							TrafficType:     FlowLogsTrafficTypeReject,
+						},
 					},
 				},
 			},
-			wantErr: false,
 		},
 		{
-			name: "rejects ipv6",
+			name: "rejects flow logs delivered to s3 with an invalid bucket arn",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
 					NetworkSpec: NetworkSpec{
-						VPC: VPCSpec{
-							IPv6: &IPv6{
-								CidrBlock: "2001:2345:5678::/64",
-								PoolID:    "pool-id",
-							},
+						FlowLogs: &FlowLogsSpec{
+							DestinationType: FlowLogsDestinationTypeS3,
+							BucketARN:       "flow-logs",
 						},
 					},
 				},
@@ -217,17 +302,14 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "rejects ipv6 enabled subnet",
+			name: "rejects network acl rules with duplicate rule numbers",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
 					NetworkSpec: NetworkSpec{
-						Subnets: []SubnetSpec{
-							{
-								ID:     "sub-1",
-								IsIPv6: true,
-							},
-							{
-								ID: "sub-2",
+						NetworkACL: &NetworkACLSpec{
+							Ingress: []NetworkACLEntry{
+								{RuleNumber: 100, Protocol: NetworkACLProtocolAll, RuleAction: NetworkACLRuleActionAllow, CidrBlock: "10.0.0.0/16"},
+								{RuleNumber: 100, Protocol: NetworkACLProtocolAll, RuleAction: NetworkACLRuleActionDeny, CidrBlock: "0.0.0.0/0"},
 							},
 						},
 					},
@@ -236,14 +318,13 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "rejects ipv6 cidr block for subnets",
+			name: "rejects network acl rules without a port range for tcp",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
 					NetworkSpec: NetworkSpec{
-						Subnets: []SubnetSpec{
-							{
-								ID:            "sub-1",
-								IPv6CidrBlock: "2022:1234:5678:9101::/64",
+						NetworkACL: &NetworkACLSpec{
+							Egress: []NetworkACLEntry{
+								{RuleNumber: 100, Protocol: NetworkACLProtocolTCP, RuleAction: NetworkACLRuleActionAllow, CidrBlock: "0.0.0.0/0"},
 							},
 						},
 					},
@@ -252,15 +333,13 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "rejects ingress rules with cidr block and source security group id",
+			name: "rejects network acl rules with an invalid cidr block",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
-						IngressRules: []IngressRule{
-							{
-								Protocol:               SecurityGroupProtocolTCP,
-								CidrBlocks:             []string{"test"},
-								SourceSecurityGroupIDs: []string{"test"},
+					NetworkSpec: NetworkSpec{
+						NetworkACL: &NetworkACLSpec{
+							Ingress: []NetworkACLEntry{
+								{RuleNumber: 100, Protocol: NetworkACLProtocolAll, RuleAction: NetworkACLRuleActionAllow, CidrBlock: "2001:db8::/32"},
 							},
 						},
 					},
@@ -269,16 +348,28 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "rejects ingress rules with cidr block and source security group id and role",
+			name: "accepts network acl rules with an ipv6 cidr block",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
-						IngressRules: []IngressRule{
-							{
-								Protocol:                 SecurityGroupProtocolTCP,
-								IPv6CidrBlocks:           []string{"test"},
-								SourceSecurityGroupIDs:   []string{"test"},
-								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
+					NetworkSpec: NetworkSpec{
+						NetworkACL: &NetworkACLSpec{
+							Ingress: []NetworkACLEntry{
+								{RuleNumber: 100, Protocol: NetworkACLProtocolAll, RuleAction: NetworkACLRuleActionAllow, CidrBlock: "10.0.0.0/16"},
+								{RuleNumber: 101, Protocol: NetworkACLProtocolAll, RuleAction: NetworkACLRuleActionAllow, IPv6CidrBlock: "2001:db8::/32"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "rejects network acl rules with an invalid ipv6 cidr block",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						NetworkACL: &NetworkACLSpec{
+							Ingress: []NetworkACLEntry{
+								{RuleNumber: 100, Protocol: NetworkACLProtocolAll, RuleAction: NetworkACLRuleActionAllow, IPv6CidrBlock: "10.0.0.0/16"},
 							},
 						},
 					},
@@ -287,75 +378,73 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "accepts ingress rules with cidr block",
+			name: "rejects network acl rules with both an ipv4 and an ipv6 cidr block",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
-						IngressRules: []IngressRule{
-							{
-								Protocol:   SecurityGroupProtocolTCP,
-								CidrBlocks: []string{"test"},
+					NetworkSpec: NetworkSpec{
+						NetworkACL: &NetworkACLSpec{
+							Ingress: []NetworkACLEntry{
+								{RuleNumber: 100, Protocol: NetworkACLProtocolAll, RuleAction: NetworkACLRuleActionAllow, CidrBlock: "10.0.0.0/16", IPv6CidrBlock: "2001:db8::/32"},
 							},
 						},
 					},
 				},
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
-			name: "accepts ingress rules with source security group role",
+			name: "rejects network acl rules without a cidr block",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
-						IngressRules: []IngressRule{
-							{
-								Protocol:                 SecurityGroupProtocolTCP,
-								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
+					NetworkSpec: NetworkSpec{
+						NetworkACL: &NetworkACLSpec{
+							Egress: []NetworkACLEntry{
+								{RuleNumber: 100, Protocol: NetworkACLProtocolAll, RuleAction: NetworkACLRuleActionAllow},
 							},
 						},
 					},
 				},
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
-			name: "accepts ingress rules with source security group id and role",
+			name: "accepts valid security group egress rules",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
-						IngressRules: []IngressRule{
-							{
-								Protocol:                 SecurityGroupProtocolTCP,
-								SourceSecurityGroupIDs:   []string{"test"},
-								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupNode: {
+								{Description: "HTTPS", Protocol: SecurityGroupProtocolTCP, FromPort: 443, ToPort: 443, CidrBlocks: []string{"10.0.0.0/8"}},
+								{Description: "All IPv6", Protocol: SecurityGroupProtocolAll, FromPort: -1, ToPort: -1, IPv6CidrBlocks: []string{"2001:db8::/32"}},
 							},
 						},
 					},
 				},
 			},
-			wantErr: false,
 		},
 		{
-			name: "rejects ipamPool if id or name not set",
+			name: "accepts security group egress rules to a security group role",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
 					NetworkSpec: NetworkSpec{
-						VPC: VPCSpec{
-							IPAMPool: &IPAMPool{},
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupAPIServerLB: {
+								{Description: "Kubernetes API", Protocol: SecurityGroupProtocolTCP, FromPort: 6443, ToPort: 6443, DestinationSecurityGroupRoles: []SecurityGroupRole{SecurityGroupControlPlane}},
+							},
 						},
 					},
 				},
 			},
-			wantErr: true,
 		},
 		{
-			name: "rejects cidrBlock and ipamPool if set together",
+			name: "rejects security group egress rules to a security group role the cluster doesn't have",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
 					NetworkSpec: NetworkSpec{
-						VPC: VPCSpec{
-							CidrBlock: "10.0.0.0/16",
-							IPAMPool:  &IPAMPool{},
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupAPIServerLB: {
+								{Description: "SSH", Protocol: SecurityGroupProtocolTCP, FromPort: 22, ToPort: 22, DestinationSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion}},
+							},
 						},
 					},
 				},
@@ -363,32 +452,28 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "accepts CP ingress rules with source security group id and role",
+			name: "accepts security group egress rules to the bastion security group when the bastion is enabled",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
+					Bastion: Bastion{Enabled: true},
 					NetworkSpec: NetworkSpec{
-						AdditionalControlPlaneIngressRules: []IngressRule{
-							{
-								Protocol:                 SecurityGroupProtocolTCP,
-								SourceSecurityGroupIDs:   []string{"test"},
-								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupAPIServerLB: {
+								{Description: "SSH", Protocol: SecurityGroupProtocolTCP, FromPort: 22, ToPort: 22, DestinationSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion}},
 							},
 						},
 					},
 				},
 			},
-			wantErr: false,
 		},
 		{
-			name: "rejects CP ingress rules with cidr block and source security group id",
+			name: "rejects security group egress rules without a destination",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
 					NetworkSpec: NetworkSpec{
-						AdditionalControlPlaneIngressRules: []IngressRule{
-							{
-								Protocol:               SecurityGroupProtocolTCP,
-								CidrBlocks:             []string{"test"},
-								SourceSecurityGroupIDs: []string{"test"},
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupNode: {
+								{Description: "HTTPS", Protocol: SecurityGroupProtocolTCP, FromPort: 443, ToPort: 443},
 							},
 						},
 					},
@@ -397,16 +482,13 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "rejects CP ingress rules with cidr block and source security group id and role",
+			name: "rejects security group egress rules with an invalid cidr block",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
 					NetworkSpec: NetworkSpec{
-						AdditionalControlPlaneIngressRules: []IngressRule{
-							{
-								Protocol:                 SecurityGroupProtocolTCP,
-								IPv6CidrBlocks:           []string{"test"},
-								SourceSecurityGroupIDs:   []string{"test"},
-								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupNode: {
+								{Description: "HTTPS", Protocol: SecurityGroupProtocolTCP, FromPort: 443, ToPort: 443, CidrBlocks: []string{"10.0.0.0/33"}},
 							},
 						},
 					},
@@ -415,37 +497,937 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "accepts CP ingress rules with cidr block",
+			name: "rejects security group egress rules with an invalid port range",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
 					NetworkSpec: NetworkSpec{
-						AdditionalControlPlaneIngressRules: []IngressRule{
-							{
-								Protocol:   SecurityGroupProtocolTCP,
-								CidrBlocks: []string{"test"},
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupControlPlane: {
+								{Description: "HTTPS", Protocol: SecurityGroupProtocolTCP, FromPort: 443, ToPort: 80, CidrBlocks: []string{"10.0.0.0/8"}},
 							},
 						},
 					},
 				},
 			},
+			wantErr: true,
+		},
+		{
+			name: "accepts valid machine label to tag mappings",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					MachineLabelToTag: map[string]string{
+						"team": "Team",
+					},
+				},
+			},
+		},
+		{
+			name: "rejects machine label to tag mappings with an empty tag key",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					MachineLabelToTag: map[string]string{
+						"team": "",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects machine label to tag mappings with a reserved tag key prefix",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					MachineLabelToTag: map[string]string{
+						"team": "aws:team",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts a valid instance name template",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					InstanceNameTemplate: "{{ .Cluster.Name }}-{{ .Role }}-{{ .Machine.Name }}",
+				},
+			},
+		},
+		{
+			name: "rejects an instance name template that doesn't parse",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					InstanceNameTemplate: "{{ .Cluster.Name }-{{ .Role }}",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts naming nodes after their private DNS name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NodeNameFromPrivateDNSName: true,
+				},
+			},
+		},
+		{
+			name: "rejects naming nodes after their private DNS name along with the ignition bucket",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NodeNameFromPrivateDNSName: true,
+					S3Bucket: &S3Bucket{
+						Name:                           "abcdefghijklmnoprstuwxyz-0123456789",
+						ControlPlaneIAMInstanceProfile: "control-plane.cluster-api-provider-aws.sigs.k8s.io",
+						NodesIAMInstanceProfiles:       []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts bucket name with acceptable characters",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name:                           "abcdefghijklmnoprstuwxyz-0123456789",
+						ControlPlaneIAMInstanceProfile: "control-plane.cluster-api-provider-aws.sigs.k8s.io",
+						NodesIAMInstanceProfiles:       []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"},
+					},
+				},
+			},
+		},
+		{
+			name: "rejects empty bucket name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects bucket name shorter than 3 characters",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name: "fo",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects bucket name longer than 63 characters",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name: strings.Repeat("a", 64),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects bucket name starting with not letter or number",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name: "-foo",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects bucket name ending with not letter or number",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name: "foo-",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects bucket name formatted as IP address",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name: "8.8.8.8",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "requires bucket control plane IAM instance profile to be not empty",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name:                           "foo",
+						ControlPlaneIAMInstanceProfile: "",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "requires at least one bucket node IAM instance profile",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name:                           "foo",
+						ControlPlaneIAMInstanceProfile: "foo",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "requires all bucket node IAM instance profiles to be not empty",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name:                           "foo",
+						ControlPlaneIAMInstanceProfile: "foo",
+						NodesIAMInstanceProfiles:       []string{""},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "does not return error when all IAM instance profiles are populated",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name:                           "foo",
+						ControlPlaneIAMInstanceProfile: "foo",
+						NodesIAMInstanceProfiles:       []string{"bar"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "accepts bucket encryption context",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name:                           "foo",
+						ControlPlaneIAMInstanceProfile: "foo",
+						NodesIAMInstanceProfiles:       []string{"bar"},
+						EncryptionContext:              map[string]string{"purpose": "bootstrap"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects bucket encryption context with empty key",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name:                           "foo",
+						ControlPlaneIAMInstanceProfile: "foo",
+						NodesIAMInstanceProfiles:       []string{"bar"},
+						EncryptionContext:              map[string]string{"": "bootstrap"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects bucket encryption context with empty value",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					S3Bucket: &S3Bucket{
+						Name:                           "foo",
+						ControlPlaneIAMInstanceProfile: "foo",
+						NodesIAMInstanceProfiles:       []string{"bar"},
+						EncryptionContext:              map[string]string{"purpose": ""},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects ipv6",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							IPv6: &IPv6{
+								CidrBlock: "2001:2345:5678::/64",
+								PoolID:    "pool-id",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects ipv6 enabled subnet",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: []SubnetSpec{
+							{
+								ID:     "sub-1",
+								IsIPv6: true,
+							},
+							{
+								ID: "sub-2",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects ipv6 cidr block for subnets",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: []SubnetSpec{
+							{
+								ID:            "sub-1",
+								IPv6CidrBlock: "2022:1234:5678:9101::/64",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts an existing route table for a private subnet",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: []SubnetSpec{
+							{
+								ID:                   "sub-1",
+								ExistingRouteTableID: aws.String("rtb-0123456789abcdef0"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects an existing route table for a public subnet",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: []SubnetSpec{
+							{
+								ID:                   "sub-1",
+								IsPublic:             true,
+								ExistingRouteTableID: aws.String("rtb-0123456789abcdef0"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects an existing route table with an invalid id",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: []SubnetSpec{
+							{
+								ID:                   "sub-1",
+								ExistingRouteTableID: aws.String("igw-0123456789abcdef0"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts an instance connect endpoint in a private subnet",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: []SubnetSpec{
+							{
+								ID: "sub-1",
+							},
+						},
+						InstanceConnectEndpoint: &InstanceConnectEndpointSpec{
+							SubnetID: aws.String("sub-1"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects an instance connect endpoint in a public subnet",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: []SubnetSpec{
+							{
+								ID:       "sub-1",
+								IsPublic: true,
+							},
+						},
+						InstanceConnectEndpoint: &InstanceConnectEndpointSpec{
+							SubnetID: aws.String("sub-1"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects ingress rules with cidr block and source security group id",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						IngressRules: []IngressRule{
+							{
+								Protocol:               SecurityGroupProtocolTCP,
+								CidrBlocks:             []string{"test"},
+								SourceSecurityGroupIDs: []string{"test"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects ingress rules with cidr block and source security group id and role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						IngressRules: []IngressRule{
+							{
+								Protocol:                 SecurityGroupProtocolTCP,
+								IPv6CidrBlocks:           []string{"test"},
+								SourceSecurityGroupIDs:   []string{"test"},
+								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts ingress rules with cidr block",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						IngressRules: []IngressRule{
+							{
+								Protocol:   SecurityGroupProtocolTCP,
+								CidrBlocks: []string{"test"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "accepts ingress rules with source security group role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						IngressRules: []IngressRule{
+							{
+								Protocol:                 SecurityGroupProtocolTCP,
+								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "accepts ingress rules with source security group id and role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						IngressRules: []IngressRule{
+							{
+								Protocol:                 SecurityGroupProtocolTCP,
+								SourceSecurityGroupIDs:   []string{"test"},
+								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "accepts control plane load balancer allowed CIDR blocks",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType:  LoadBalancerTypeNLB,
+						AllowedCIDRBlocks: []string{"192.168.0.0/16"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects invalid control plane load balancer allowed CIDR blocks",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType:  LoadBalancerTypeNLB,
+						AllowedCIDRBlocks: []string{"192.168.0.0"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects control plane load balancer allowed CIDR blocks together with ingress rules",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						AllowedCIDRBlocks: []string{"192.168.0.0/16"},
+						IngressRules: []IngressRule{
+							{
+								Protocol:   SecurityGroupProtocolTCP,
+								CidrBlocks: []string{"10.0.0.0/16"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts an ACM certificate for an ALB control plane load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType:  LoadBalancerTypeALB,
+						SSLCertificateARN: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects an ACM certificate for a non ALB control plane load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType:  LoadBalancerTypeNLB,
+						SSLCertificateARN: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects an invalid ACM certificate ARN",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType:  LoadBalancerTypeALB,
+						SSLCertificateARN: aws.String("arn:aws:iam::123456789012:role/abc"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects an SSL policy without an ACM certificate",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeALB,
+						SSLPolicy:        aws.String(DefaultLoadBalancerSSLPolicy),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts an idle timeout on a classic load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeClassic,
+						IdleTimeout:      &metav1.Duration{Duration: 5 * time.Minute},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects an idle timeout above 4000 seconds",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeClassic,
+						IdleTimeout:      &metav1.Duration{Duration: 4001 * time.Second},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects an idle timeout below 1 second",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeClassic,
+						IdleTimeout:      &metav1.Duration{Duration: 500 * time.Millisecond},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects an idle timeout on a non-classic load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						IdleTimeout:      &metav1.Duration{Duration: 5 * time.Minute},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts a control plane load balancer port",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Port: aws.Int64(8443),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects a control plane load balancer port above 65535",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Port: aws.Int64(65536),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a control plane load balancer port of 0",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Port: aws.Int64(0),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects ipamPool if id or name not set",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							IPAMPool: &IPAMPool{},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects cidrBlock and ipamPool if set together",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							CidrBlock: "10.0.0.0/16",
+							IPAMPool:  &IPAMPool{},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts subnet cidr sizes that fit in the VPC CIDR",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							CidrBlock: "10.0.0.0/16",
+						},
+						SubnetCidrSizes: &SubnetCidrSizes{
+							PublicPrefixLength:  24,
+							PrivatePrefixLength: 18,
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects subnet cidr sizes that do not fit in the VPC CIDR for the availability zone count",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							CidrBlock:                  "10.0.0.0/16",
+							AvailabilityZoneUsageLimit: aws.Int(3),
+						},
+						SubnetCidrSizes: &SubnetCidrSizes{
+							PublicPrefixLength:  24,
+							PrivatePrefixLength: 17,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects subnet cidr sizes larger than the VPC CIDR",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							CidrBlock: "10.0.0.0/20",
+						},
+						SubnetCidrSizes: &SubnetCidrSizes{
+							PublicPrefixLength:  24,
+							PrivatePrefixLength: 19,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts CP ingress rules with source security group id and role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalControlPlaneIngressRules: []IngressRule{
+							{
+								Protocol:                 SecurityGroupProtocolTCP,
+								SourceSecurityGroupIDs:   []string{"test"},
+								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects CP ingress rules with cidr block and source security group id",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalControlPlaneIngressRules: []IngressRule{
+							{
+								Protocol:               SecurityGroupProtocolTCP,
+								CidrBlocks:             []string{"test"},
+								SourceSecurityGroupIDs: []string{"test"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects CP ingress rules with cidr block and source security group id and role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalControlPlaneIngressRules: []IngressRule{
+							{
+								Protocol:                 SecurityGroupProtocolTCP,
+								IPv6CidrBlocks:           []string{"test"},
+								SourceSecurityGroupIDs:   []string{"test"},
+								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts CP ingress rules with cidr block",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalControlPlaneIngressRules: []IngressRule{
+							{
+								Protocol:   SecurityGroupProtocolTCP,
+								CidrBlocks: []string{"test"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "accepts CP ingress rules with source security group id and role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalControlPlaneIngressRules: []IngressRule{
+							{
+								Protocol:                 SecurityGroupProtocolTCP,
+								SourceSecurityGroupIDs:   []string{"test"},
+								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "accepts valid pod CIDR blocks",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PodCIDRBlocks: []string{"100.64.0.0/16", "2001:db8::/56"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects invalid pod CIDR blocks",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PodCIDRBlocks: []string{"100.64.0.0/16", "100.64.0.0"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts a valid Global Accelerator endpoint group ARN",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					GlobalAcceleratorEndpointGroupARN: "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh/listener/0123vxyz/endpoint-group/098765zyxwvu",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects an invalid Global Accelerator endpoint group ARN",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					GlobalAcceleratorEndpointGroupARN: "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts a valid EBS encryption key ARN",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					EBSEncryptionKeyARN: "arn:aws:kms:us-east-1:222222222222:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects an EBS encryption key alias",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					EBSEncryptionKeyARN: "arn:aws:kms:us-east-1:222222222222:alias/ebs",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a managed encryption key together with an EBS encryption key ARN",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					EBSEncryptionKeyARN:  "arn:aws:kms:us-east-1:222222222222:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+					ManagedEncryptionKey: true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts a valid public DNS record",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PublicDNS: &PublicDNS{
+						HostedZoneID: "Z0123456789ABCDEFGHIJ",
+						RecordName:   "api.mycluster.example.com",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects an invalid public DNS record name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PublicDNS: &PublicDNS{
+						HostedZoneID: "Z0123456789ABCDEFGHIJ",
+						RecordName:   "api_mycluster.example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects an invalid public DNS hosted zone ID",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PublicDNS: &PublicDNS{
+						HostedZoneID: "example.com",
+						RecordName:   "api.mycluster.example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts an identity selector",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					IdentitySelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"account": "production"},
+					},
+				},
+			},
 			wantErr: false,
 		},
 		{
-			name: "accepts CP ingress rules with source security group id and role",
+			name: "rejects an invalid identity selector",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					NetworkSpec: NetworkSpec{
-						AdditionalControlPlaneIngressRules: []IngressRule{
-							{
-								Protocol:                 SecurityGroupProtocolTCP,
-								SourceSecurityGroupIDs:   []string{"test"},
-								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
-							},
+					IdentitySelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "account", Operator: "Unknown", Values: []string{"production"}},
 						},
 					},
 				},
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -506,6 +1488,222 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "vpc peerings cannot be removed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPCPeerings: []VPCPeeringSpec{{PeerVPCID: "vpc-peer"}},
+					},
+				},
+			},
+			newCluster: &AWSCluster{},
+			wantErr:    true,
+		},
+		{
+			name: "vpc peerings can be added and auto-accepted",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPCPeerings: []VPCPeeringSpec{{PeerVPCID: "vpc-peer"}},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPCPeerings: []VPCPeeringSpec{
+							{PeerVPCID: "vpc-peer", AutoAccept: true},
+							{PeerVPCID: "vpc-other", PeerOwnerID: aws.String("222222222222")},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "vpc peering peerOwnerId is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPCPeerings: []VPCPeeringSpec{{PeerVPCID: "vpc-peer"}},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPCPeerings: []VPCPeeringSpec{{PeerVPCID: "vpc-peer", PeerOwnerID: aws.String("222222222222")}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "transit gateway attachment cannot be removed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						TransitGatewayAttachment: &TransitGatewayAttachmentSpec{
+							TransitGatewayID: "tgw-01",
+						},
+					},
+				},
+			},
+			newCluster: &AWSCluster{},
+			wantErr:    true,
+		},
+		{
+			name: "transit gateway attachment transitGatewayId is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						TransitGatewayAttachment: &TransitGatewayAttachmentSpec{
+							TransitGatewayID: "tgw-01",
+						},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						TransitGatewayAttachment: &TransitGatewayAttachmentSpec{
+							TransitGatewayID: "tgw-02",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "transit gateway attachment route table can be changed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						TransitGatewayAttachment: &TransitGatewayAttachmentSpec{
+							TransitGatewayID: "tgw-01",
+						},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						TransitGatewayAttachment: &TransitGatewayAttachmentSpec{
+							TransitGatewayID: "tgw-01",
+							RouteTableID:     aws.String("tgw-rtb-01"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:       "global accelerator endpoint group can be added",
+			oldCluster: &AWSCluster{},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					GlobalAcceleratorEndpointGroupARN: "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd/listener/0123vxyz/endpoint-group/098765zyxwvu",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "global accelerator endpoint group is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					GlobalAcceleratorEndpointGroupARN: "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd/listener/0123vxyz/endpoint-group/098765zyxwvu",
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					GlobalAcceleratorEndpointGroupARN: "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd/listener/0123vxyz/endpoint-group/567890abcdef",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "managed encryption key can be enabled",
+			oldCluster: &AWSCluster{},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ManagedEncryptionKey: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "managed encryption key cannot be disabled",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ManagedEncryptionKey: true,
+				},
+			},
+			newCluster: &AWSCluster{},
+			wantErr:    true,
+		},
+		{
+			name: "public DNS is immutable once the control plane endpoint is set",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneEndpoint: clusterv1.APIEndpoint{
+						Host: "api.mycluster.example.com",
+						Port: 6443,
+					},
+					PublicDNS: &PublicDNS{
+						HostedZoneID: "Z0123456789ABCDEFGHIJ",
+						RecordName:   "api.mycluster.example.com",
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneEndpoint: clusterv1.APIEndpoint{
+						Host: "api.mycluster.example.com",
+						Port: 6443,
+					},
+					PublicDNS: &PublicDNS{
+						HostedZoneID: "Z0123456789ABCDEFGHIJ",
+						RecordName:   "kube.mycluster.example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "efs can be added",
+			oldCluster: &AWSCluster{},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					EFS: &EFSSpec{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "efs cannot be removed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					EFS: &EFSSpec{},
+				},
+			},
+			newCluster: &AWSCluster{},
+			wantErr:    true,
+		},
+		{
+			name: "efs is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					EFS: &EFSSpec{PerformanceMode: EFSPerformanceModeGeneralPurpose},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					EFS: &EFSSpec{PerformanceMode: EFSPerformanceModeMaxIO},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "controlPlaneLoadBalancer name is immutable",
 			oldCluster: &AWSCluster{
@@ -767,6 +1965,82 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should fail if controlPlaneLoadBalancer port is updated",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Port: aws.Int64(8443),
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Port: aws.Int64(443),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail if a certificate is added to the controlPlaneLoadBalancer",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeALB,
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType:  LoadBalancerTypeALB,
+						SSLCertificateARN: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail if the certificate of the controlPlaneLoadBalancer is removed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType:  LoadBalancerTypeALB,
+						SSLCertificateARN: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeALB,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should pass if the certificate of the controlPlaneLoadBalancer is replaced",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType:  LoadBalancerTypeALB,
+						SSLCertificateARN: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType:  LoadBalancerTypeALB,
+						SSLCertificateARN: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/def"),
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "correct GC tasks annotation",
 			oldCluster: &AWSCluster{
@@ -795,6 +2069,30 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "identityRef can be replaced by an identity selector",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					IdentityRef: &AWSIdentityReference{Kind: ClusterRoleIdentityKind, Name: "production"},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					IdentitySelector: &metav1.LabelSelector{MatchLabels: map[string]string{"account": "production"}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "identity selector can be removed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					IdentitySelector: &metav1.LabelSelector{MatchLabels: map[string]string{"account": "production"}},
+				},
+			},
+			newCluster: &AWSCluster{},
+			wantErr:    false,
+		},
 		{
 			name: "incorrect GC tasks annotation",
 			oldCluster: &AWSCluster{
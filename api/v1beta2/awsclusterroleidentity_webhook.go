@@ -60,6 +60,10 @@ func (r *AWSClusterRoleIdentity) ValidateCreate() (admission.Warnings, error) {
 		}
 	}
 
+	if errs := r.Spec.validateSessionTags(); len(errs) > 0 {
+		return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, errs)
+	}
+
 	return nil, nil
 }
 
@@ -89,9 +93,27 @@ func (r *AWSClusterRoleIdentity) ValidateUpdate(old runtime.Object) (admission.W
 		}
 	}
 
+	if errs := r.Spec.validateSessionTags(); len(errs) > 0 {
+		return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, errs)
+	}
+
 	return nil, nil
 }
 
+// validateSessionTags validates the session tags like other tags, and that the transitive tag keys are keys of the
+// session tags, see https://docs.aws.amazon.com/IAM/latest/UserGuide/id_session-tags.html#id_session-tags_know.
+func (s *AWSRoleSpec) validateSessionTags() field.ErrorList {
+	errs := Tags(s.SessionTags).ValidateWithPath(field.NewPath("spec", "sessionTags"))
+
+	for i, key := range s.TransitiveTagKeys {
+		if _, ok := s.SessionTags[key]; !ok {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "transitiveTagKeys").Index(i), key, "must be a key of spec.sessionTags"))
+		}
+	}
+
+	return errs
+}
+
 // Default will set default values for the AWSClusterRoleIdentity.
 func (r *AWSClusterRoleIdentity) Default() {
 	SetDefaults_Labels(&r.ObjectMeta)
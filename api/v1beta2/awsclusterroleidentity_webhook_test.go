@@ -56,6 +56,141 @@ func TestAWSClusterRoleValidateCreate(t *testing.T) {
 			},
 			wantError: false,
 		},
+		{
+			name: "successfully create AWSClusterRoleIdentity with session tags",
+			identity: &AWSClusterRoleIdentity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "role-with-session-tags",
+				},
+				Spec: AWSClusterRoleIdentitySpec{
+					AWSRoleSpec: AWSRoleSpec{
+						RoleArn:           "arn:aws:iam::123456789012:role/capa",
+						SessionTags:       map[string]string{"team": "platform", "cost-center": "1234"},
+						TransitiveTagKeys: []string{"team"},
+					},
+					SourceIdentityRef: &AWSIdentityReference{
+						Name: "another-role",
+						Kind: ClusterRoleIdentityKind,
+					},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "do not allow session tags with the aws: prefix",
+			identity: &AWSClusterRoleIdentity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "role-with-aws-session-tag",
+				},
+				Spec: AWSClusterRoleIdentitySpec{
+					AWSRoleSpec: AWSRoleSpec{
+						RoleArn:     "arn:aws:iam::123456789012:role/capa",
+						SessionTags: map[string]string{"aws:team": "platform"},
+					},
+					SourceIdentityRef: &AWSIdentityReference{
+						Name: "another-role",
+						Kind: ClusterRoleIdentityKind,
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "do not allow session tag values with invalid characters",
+			identity: &AWSClusterRoleIdentity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "role-with-invalid-session-tag",
+				},
+				Spec: AWSClusterRoleIdentitySpec{
+					AWSRoleSpec: AWSRoleSpec{
+						RoleArn:     "arn:aws:iam::123456789012:role/capa",
+						SessionTags: map[string]string{"team": "platform;admin"},
+					},
+					SourceIdentityRef: &AWSIdentityReference{
+						Name: "another-role",
+						Kind: ClusterRoleIdentityKind,
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "do not allow transitive tag keys which aren't session tags",
+			identity: &AWSClusterRoleIdentity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "role-with-unknown-transitive-tag",
+				},
+				Spec: AWSClusterRoleIdentitySpec{
+					AWSRoleSpec: AWSRoleSpec{
+						RoleArn:           "arn:aws:iam::123456789012:role/capa",
+						SessionTags:       map[string]string{"team": "platform"},
+						TransitiveTagKeys: []string{"project"},
+					},
+					SourceIdentityRef: &AWSIdentityReference{
+						Name: "another-role",
+						Kind: ClusterRoleIdentityKind,
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "successfully create AWSClusterRoleIdentity with a custom session duration",
+			identity: &AWSClusterRoleIdentity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "role-with-long-session",
+				},
+				Spec: AWSClusterRoleIdentitySpec{
+					AWSRoleSpec: AWSRoleSpec{
+						RoleArn:         "arn:aws:iam::123456789012:role/capa",
+						DurationSeconds: 43200,
+					},
+					SourceIdentityRef: &AWSIdentityReference{
+						Name: "another-role",
+						Kind: ClusterRoleIdentityKind,
+					},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "do not allow a session duration shorter than 900 seconds",
+			identity: &AWSClusterRoleIdentity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "role-with-short-session",
+				},
+				Spec: AWSClusterRoleIdentitySpec{
+					AWSRoleSpec: AWSRoleSpec{
+						RoleArn:         "arn:aws:iam::123456789012:role/capa",
+						DurationSeconds: 899,
+					},
+					SourceIdentityRef: &AWSIdentityReference{
+						Name: "another-role",
+						Kind: ClusterRoleIdentityKind,
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "do not allow a session duration longer than 43200 seconds",
+			identity: &AWSClusterRoleIdentity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "role-with-too-long-session",
+				},
+				Spec: AWSClusterRoleIdentitySpec{
+					AWSRoleSpec: AWSRoleSpec{
+						RoleArn:         "arn:aws:iam::123456789012:role/capa",
+						DurationSeconds: 43201,
+					},
+					SourceIdentityRef: &AWSIdentityReference{
+						Name: "another-role",
+						Kind: ClusterRoleIdentityKind,
+					},
+				},
+			},
+			wantError: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -75,6 +75,11 @@ func (r *AWSClusterStaticIdentity) ValidateUpdate(old runtime.Object) (admission
 			r.Spec.SecretRef, "field cannot be updated")
 	}
 
+	if oldP.Spec.SecretNamespace != r.Spec.SecretNamespace {
+		return nil, field.Invalid(field.NewPath("spec", "secretNamespace"),
+			r.Spec.SecretNamespace, "field cannot be updated")
+	}
+
 	// Validate selector parses as Selector
 	if r.Spec.AllowedNamespaces != nil {
 		_, err := metav1.LabelSelectorAsSelector(&r.Spec.AllowedNamespaces.Selector)
@@ -109,6 +109,15 @@ func TestAWSClusterStaticValidateUpdate(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "do not allow changing the secret namespace",
+			identity: func() *AWSClusterStaticIdentity {
+				identity := staticIdentity.DeepCopy()
+				identity.Spec.SecretNamespace = "credentials"
+				return identity
+			}(),
+			wantError: true,
+		},
 		{
 			name:      "no error when updating the same object",
 			identity:  staticIdentity,
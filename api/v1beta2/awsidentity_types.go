@@ -57,6 +57,8 @@ type AWSRoleSpec struct {
 	// An identifier for the assumed role session
 	SessionName string `json:"sessionName,omitempty"`
 	// The duration, in seconds, of the role session before it is renewed.
+	// It can't exceed the maximum session duration configured for the role, and sessions of roles assumed
+	// by role chaining are limited to one hour. Defaults to 900 seconds if not set.
 	// +kubebuilder:validation:Minimum:=900
 	// +kubebuilder:validation:Maximum:=43200
 	DurationSeconds int32 `json:"durationSeconds,omitempty"`
@@ -67,6 +69,17 @@ type AWSRoleSpec struct {
 	// to use as managed session policies.
 	// The policies must exist in the same account as the role.
 	PolicyARNs []string `json:"policyARNs,omitempty"`
+
+	// SessionTags are the tags to pass to the assumed role session, for attribute-based access control.
+	// The trust policy of the role must allow the sts:TagSession action.
+	// +optional
+	SessionTags map[string]string `json:"sessionTags,omitempty"`
+
+	// TransitiveTagKeys are the keys of the session tags which persist when the assumed role session
+	// is used to assume another role, that is when this identity is the source identity of another one.
+	// Each key must be a key of SessionTags.
+	// +optional
+	TransitiveTagKeys []string `json:"transitiveTagKeys,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -103,6 +116,11 @@ type AWSClusterStaticIdentitySpec struct {
 	//  SecretAccessKey: wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY
 	//  SessionToken: Optional
 	SecretRef string `json:"secretRef"`
+	// SecretNamespace is the namespace of the secret referenced by SecretRef. Defaults to the namespace
+	// of the controller. Secrets in other namespaces can only be read if the controller allows the namespace
+	// with the --static-identity-secret-namespaces flag.
+	// +optional
+	SecretNamespace string `json:"secretNamespace,omitempty"`
 }
 
 // +kubebuilder:object:root=true
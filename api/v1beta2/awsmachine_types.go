@@ -90,7 +90,8 @@ type AWSMachineSpec struct {
 	// +optional
 	AdditionalTags Tags `json:"additionalTags,omitempty"`
 
-	// IAMInstanceProfile is a name of an IAM instance profile to assign to the instance
+	// IAMInstanceProfile is the name or the ARN of an IAM instance profile to assign to the instance.
+	// The ARN must be used to reference an instance profile managed in another AWS account.
 	// +optional
 	IAMInstanceProfile string `json:"iamInstanceProfile,omitempty"`
 
@@ -160,6 +161,66 @@ type AWSMachineSpec struct {
 	// +optional
 	// +kubebuilder:validation:Enum:=default;dedicated;host
 	Tenancy string `json:"tenancy,omitempty"`
+
+	// CPUOptions configures the number of CPU cores and threads per core of the instance,
+	// e.g. to disable hyperthreading by setting threadsPerCore to 1.
+	// The values must be supported by the instance type.
+	// +optional
+	CPUOptions *CPUOptions `json:"cpuOptions,omitempty"`
+
+	// EnclaveOptions enables AWS Nitro Enclaves on the instance.
+	// The instance type must support Nitro Enclaves.
+	// +optional
+	EnclaveOptions *bool `json:"enclaveOptions,omitempty"`
+
+	// PrivateDNSName configures the hostname type of the instance and the DNS records answering
+	// queries for it, instead of the defaults of the subnet.
+	// +optional
+	PrivateDNSName *PrivateDNSName `json:"privateDnsName,omitempty"`
+
+	// FallbackInstanceTypes is an ordered list of instance types to try when there is not
+	// enough capacity for InstanceType in any of the candidate subnets, or it is not supported
+	// in their availability zones.
+	// The instance types must be compatible with the AMI of the machine, which is looked up for InstanceType.
+	// +optional
+	FallbackInstanceTypes []string `json:"fallbackInstanceTypes,omitempty"`
+
+	// AdditionalBootstrapParameters is a map of environment variable names to the
+	// Secrets Manager ARNs, SSM parameter ARNs or SSM parameter paths the bootstrap
+	// process should read them from. Only the references are written to the user data;
+	// the secret values are resolved on the instance.
+	// Not supported when the bootstrap data format is ignition.
+	// +optional
+	AdditionalBootstrapParameters map[string]string `json:"additionalBootstrapParameters,omitempty"`
+
+	// EnableDeletionProtection enables the termination protection of the instance, so that it can't be
+	// terminated through the EC2 API until the protection is removed. The protection is removed by the
+	// controller right before it terminates the instance of a deleted machine.
+	// Only supported for control plane machines, it is ignored for the other machines.
+	// +optional
+	EnableDeletionProtection *bool `json:"enableDeletionProtection,omitempty"`
+
+	// MaintenanceOptions configures the maintenance options of the instance, e.g. to disable the simplified
+	// automatic recovery of the instance after a failure of its underlying host.
+	// +optional
+	MaintenanceOptions *MaintenanceOptions `json:"maintenanceOptions,omitempty"`
+
+	// SourceDestCheck configures the source/destination check of the instance, which must be disabled for
+	// instances routing traffic they aren't the source or destination of, e.g. NAT or network appliances.
+	// It is applied once the instance is running and re-applied when it is changed outside of Cluster API.
+	// When not set, the default of EC2, which enables the check, is left unchanged.
+	// +optional
+	SourceDestCheck *bool `json:"sourceDestCheck,omitempty"`
+
+	// OnExternalInstanceDeletion defines what happens when the instance recorded for the machine was deleted
+	// outside of Cluster API, i.e. it is terminated or no longer exists. Recreate creates a new instance for the
+	// machine, with the bootstrap data of the machine, while FailMachine marks the machine as failed so it is
+	// replaced by its owner, e.g. the control plane provider. Recreate is not applied to control plane machines,
+	// which are failed instead. When not set, the OnExternalInstanceDeletion of the AWSCluster applies, and if
+	// it isn't set either the instance is reported as not found and a terminated instance fails the machine.
+	// +kubebuilder:validation:Enum:=Recreate;FailMachine
+	// +optional
+	OnExternalInstanceDeletion OnExternalInstanceDeletion `json:"onExternalInstanceDeletion,omitempty"`
 }
 
 // CloudInit defines options related to the bootstrapping systems where
@@ -217,6 +278,17 @@ type AWSMachineStatus struct {
 	// +optional
 	InstanceState *InstanceState `json:"instanceState,omitempty"`
 
+	// InstanceType is the type of the AWS instance for this machine, which is one of the
+	// FallbackInstanceTypes when InstanceType was unavailable.
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// InstanceCreationTime is the time the AWS instance for this machine was created by the controller.
+	// An instance the EC2 APIs don't return yet isn't considered deleted until the instance not found
+	// grace period of the controller has passed since this time.
+	// +optional
+	InstanceCreationTime *metav1.Time `json:"instanceCreationTime,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
@@ -258,6 +330,15 @@ type AWSMachineStatus struct {
 	// Conditions defines current service state of the AWSMachine.
 	// +optional
 	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the generation of the AWSMachine last reconciled successfully.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastFullReconcileTime is the time of the last full reconcile of the AWSMachine against the AWS APIs
+	// while in steady state. It is only set when the steady state fast path of the controller is enabled.
+	// +optional
+	LastFullReconcileTime *metav1.Time `json:"lastFullReconcileTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
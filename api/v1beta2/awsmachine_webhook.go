@@ -17,6 +17,9 @@ limitations under the License.
 package v1beta2
 
 import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -55,8 +58,14 @@ func (r *AWSMachine) ValidateCreate() (admission.Warnings, error) {
 	allErrs = append(allErrs, r.validateRootVolume()...)
 	allErrs = append(allErrs, r.validateNonRootVolumes()...)
 	allErrs = append(allErrs, r.validateSSHKeyName()...)
+	allErrs = append(allErrs, r.validateIAMInstanceProfile()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
+	allErrs = append(allErrs, r.validateAdditionalBootstrapParameters()...)
+	allErrs = append(allErrs, r.Spec.InstanceMetadataOptions.Validate(field.NewPath("spec", "instanceMetadataOptions"))...)
+	allErrs = append(allErrs, r.Spec.PrivateDNSName.Validate(field.NewPath("spec", "privateDnsName"))...)
+	allErrs = append(allErrs, r.Spec.MaintenanceOptions.Validate(field.NewPath("spec", "maintenanceOptions"))...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, ValidateTagReconcilePolicyAnnotation(r.GetAnnotations())...)
 
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -79,8 +88,11 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) (admission.Warnings, err
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, r.validateCloudInitSecret()...)
+	allErrs = append(allErrs, r.validateRootVolume()...)
+	allErrs = append(allErrs, r.validateNonRootVolumes()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, ValidateTagReconcilePolicyAnnotation(r.GetAnnotations())...)
 
 	newAWSMachineSpec := newAWSMachine["spec"].(map[string]interface{})
 	oldAWSMachineSpec := oldAWSMachine["spec"].(map[string]interface{})
@@ -101,6 +113,17 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) (admission.Warnings, err
 	delete(oldAWSMachineSpec, "additionalSecurityGroups")
 	delete(newAWSMachineSpec, "additionalSecurityGroups")
 
+	// allow changes to sourceDestCheck, which is modified in place
+	delete(oldAWSMachineSpec, "sourceDestCheck")
+	delete(newAWSMachineSpec, "sourceDestCheck")
+
+	// allow changes to the size, type, IOPS and throughput of the volumes, which are modified in place
+	if oldMachine, ok := old.(*AWSMachine); ok {
+		allErrs = append(allErrs, r.validateVolumesUpdate(oldMachine)...)
+	}
+	deleteModifiableVolumeFields(oldAWSMachineSpec)
+	deleteModifiableVolumeFields(newAWSMachineSpec)
+
 	// allow changes to secretPrefix, secretCount, and secureSecretsBackend
 	if cloudInit, ok := oldAWSMachineSpec["cloudInit"].(map[string]interface{}); ok {
 		delete(cloudInit, "secretPrefix")
@@ -121,6 +144,44 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) (admission.Warnings, err
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
+// modifiableVolumeFields are the fields of a volume which can be modified without replacing the instance.
+var modifiableVolumeFields = []string{"size", "type", "iops", "throughput"}
+
+// deleteModifiableVolumeFields deletes the fields of the volumes which can be modified in place from an unstructured
+// AWSMachine spec.
+func deleteModifiableVolumeFields(spec map[string]interface{}) {
+	if rootVolume, ok := spec["rootVolume"].(map[string]interface{}); ok {
+		for _, f := range modifiableVolumeFields {
+			delete(rootVolume, f)
+		}
+	}
+	if nonRootVolumes, ok := spec["nonRootVolumes"].([]interface{}); ok {
+		for _, v := range nonRootVolumes {
+			if volume, ok := v.(map[string]interface{}); ok {
+				for _, f := range modifiableVolumeFields {
+					delete(volume, f)
+				}
+			}
+		}
+	}
+}
+
+// validateVolumesUpdate checks that the volumes are not shrunk, as EBS volumes can only grow.
+func (r *AWSMachine) validateVolumesUpdate(old *AWSMachine) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.RootVolume != nil && old.Spec.RootVolume != nil && r.Spec.RootVolume.Size < old.Spec.RootVolume.Size {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "rootVolume", "size"), r.Spec.RootVolume.Size, "cannot be decreased"))
+	}
+	for i, volume := range r.Spec.NonRootVolumes {
+		if i < len(old.Spec.NonRootVolumes) && volume.Size < old.Spec.NonRootVolumes[i].Size {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "nonRootVolumes").Index(i).Child("size"), volume.Size, "cannot be decreased"))
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSMachine) validateCloudInitSecret() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -258,6 +319,30 @@ func (r *AWSMachine) validateAdditionalSecurityGroups() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachine) validateAdditionalBootstrapParameters() field.ErrorList {
+	return validateAdditionalBootstrapParameters(r.Spec.AdditionalBootstrapParameters, r.ignitionEnabled(), field.NewPath("spec", "additionalBootstrapParameters"))
+}
+
 func (r *AWSMachine) validateSSHKeyName() field.ErrorList {
 	return validateSSHKeyName(r.Spec.SSHKeyName)
 }
+
+func (r *AWSMachine) validateIAMInstanceProfile() field.ErrorList {
+	return validateIAMInstanceProfile(r.Spec.IAMInstanceProfile, field.NewPath("spec", "iamInstanceProfile"))
+}
+
+// validateIAMInstanceProfile checks that an instance profile given by its ARN references an IAM instance profile.
+// Any other value is used as the name of the instance profile.
+func validateIAMInstanceProfile(profile string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !strings.HasPrefix(profile, "arn:") {
+		return allErrs
+	}
+	parsed, err := arn.Parse(profile)
+	if err != nil || parsed.Service != "iam" || parsed.AccountID == "" || !strings.HasPrefix(parsed.Resource, "instance-profile/") || strings.HasSuffix(parsed.Resource, "/") {
+		allErrs = append(allErrs, field.Invalid(fldPath, profile, "must be the name or a valid ARN of an IAM instance profile"))
+	}
+
+	return allErrs
+}
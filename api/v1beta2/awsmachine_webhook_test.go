@@ -43,6 +43,81 @@ func TestAWSMachineCreate(t *testing.T) {
 		machine *AWSMachine
 		wantErr bool
 	}{
+		{
+			name: "instance metadata tags cannot be enabled when the metadata endpoint is disabled",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					InstanceMetadataOptions: &InstanceMetadataOptions{
+						HTTPEndpoint:         InstanceMetadataEndpointStateDisabled,
+						InstanceMetadataTags: InstanceMetadataEndpointStateEnabled,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "instance metadata tags can be enabled when the metadata endpoint is enabled",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					InstanceMetadataOptions: &InstanceMetadataOptions{
+						HTTPEndpoint:         InstanceMetadataEndpointStateEnabled,
+						InstanceMetadataTags: InstanceMetadataEndpointStateEnabled,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "private dns name with a valid hostname type",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					PrivateDNSName: &PrivateDNSName{
+						HostnameType:                 ptr.To[HostnameType](HostnameTypeResourceName),
+						EnableResourceNameDNSARecord: ptr.To[bool](true),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "private dns name with an invalid hostname type",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					PrivateDNSName: &PrivateDNSName{
+						HostnameType: ptr.To[HostnameType]("dns-name"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "maintenance options with auto recovery disabled",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					MaintenanceOptions: &MaintenanceOptions{
+						AutoRecovery: AutoRecoveryDisabled,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "maintenance options with an invalid auto recovery setting",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					MaintenanceOptions: &MaintenanceOptions{
+						AutoRecovery: "enabled",
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "ensure IOPS exists if type equal to io1",
 			machine: &AWSMachine{
@@ -215,6 +290,30 @@ func TestAWSMachineCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "additional bootstrap parameters referencing secrets are accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AdditionalBootstrapParameters: map[string]string{
+						"JOIN_TOKEN": "/cluster/join-token",
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "additional bootstrap parameters with literal values are not allowed",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AdditionalBootstrapParameters: map[string]string{
+						"JOIN_TOKEN": "abcdef.0123456789abcdef",
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "empty instance type not allowed",
 			machine: &AWSMachine{
@@ -233,6 +332,46 @@ func TestAWSMachineCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "instance profile can be given by its name",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:       "test",
+					IAMInstanceProfile: "nodes.cluster-api-provider-aws.sigs.k8s.io",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "instance profile can be given by its ARN",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:       "test",
+					IAMInstanceProfile: "arn:aws:iam::123456789012:instance-profile/path/nodes",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "instance profile ARN must reference an IAM instance profile",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:       "test",
+					IAMInstanceProfile: "arn:aws:iam::123456789012:role/nodes",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "instance profile ARN must be valid",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:       "test",
+					IAMInstanceProfile: "arn:aws:iam:instance-profile/nodes",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid tags return error",
 			machine: &AWSMachine{
@@ -302,6 +441,21 @@ func TestAWSMachineUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "change in source/destination check",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:    "test",
+					SourceDestCheck: ptr.To[bool](false),
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "change in fields other than providerid, tags and securitygroups",
 			oldMachine: &AWSMachine{
@@ -356,6 +510,86 @@ func TestAWSMachineUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "change in the size, type, iops and throughput of the volumes",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					RootVolume: &Volume{
+						Size: 20,
+						Type: VolumeTypeGP2,
+					},
+					NonRootVolumes: []Volume{
+						{
+							DeviceName: "/dev/sdb",
+							Size:       50,
+							Type:       VolumeTypeGP2,
+						},
+					},
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					RootVolume: &Volume{
+						Size:       40,
+						Type:       VolumeTypeGP3,
+						IOPS:       4000,
+						Throughput: ptr.To[int64](250),
+					},
+					NonRootVolumes: []Volume{
+						{
+							DeviceName: "/dev/sdb",
+							Size:       100,
+							Type:       VolumeTypeIO2,
+							IOPS:       5000,
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "decrease in the size of the root volume",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					RootVolume: &Volume{
+						Size: 40,
+					},
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					RootVolume: &Volume{
+						Size: 20,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "change in the encryption of the root volume",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					RootVolume: &Volume{
+						Size: 20,
+					},
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					RootVolume: &Volume{
+						Size:      20,
+						Encrypted: ptr.To[bool](true),
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		ctx := context.TODO()
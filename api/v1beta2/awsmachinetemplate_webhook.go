@@ -171,10 +171,18 @@ func (r *AWSMachineTemplate) validateIgnitionAndCloudInit() field.ErrorList {
 
 	return allErrs
 }
+func (r *AWSMachineTemplate) validateAdditionalBootstrapParameters() field.ErrorList {
+	return validateAdditionalBootstrapParameters(r.Spec.Template.Spec.AdditionalBootstrapParameters, r.ignitionEnabled(), field.NewPath("spec", "template", "spec", "additionalBootstrapParameters"))
+}
+
 func (r *AWSMachineTemplate) validateSSHKeyName() field.ErrorList {
 	return validateSSHKeyName(r.Spec.Template.Spec.SSHKeyName)
 }
 
+func (r *AWSMachineTemplate) validateIAMInstanceProfile() field.ErrorList {
+	return validateIAMInstanceProfile(r.Spec.Template.Spec.IAMInstanceProfile, field.NewPath("spec", "template", "spec", "iamInstanceProfile"))
+}
+
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *AWSMachineTemplateWebhook) ValidateCreate(_ context.Context, raw runtime.Object) (admission.Warnings, error) {
 	var allErrs field.ErrorList
@@ -202,7 +210,12 @@ func (r *AWSMachineTemplateWebhook) ValidateCreate(_ context.Context, raw runtim
 	allErrs = append(allErrs, obj.validateRootVolume()...)
 	allErrs = append(allErrs, obj.validateNonRootVolumes()...)
 	allErrs = append(allErrs, obj.validateSSHKeyName()...)
+	allErrs = append(allErrs, obj.validateIAMInstanceProfile()...)
 	allErrs = append(allErrs, obj.validateAdditionalSecurityGroups()...)
+	allErrs = append(allErrs, obj.validateAdditionalBootstrapParameters()...)
+	allErrs = append(allErrs, spec.InstanceMetadataOptions.Validate(field.NewPath("spec", "template", "spec", "instanceMetadataOptions"))...)
+	allErrs = append(allErrs, spec.PrivateDNSName.Validate(field.NewPath("spec", "template", "spec", "privateDnsName"))...)
+	allErrs = append(allErrs, spec.MaintenanceOptions.Validate(field.NewPath("spec", "template", "spec", "maintenanceOptions"))...)
 	allErrs = append(allErrs, obj.Spec.Template.Spec.AdditionalTags.Validate()...)
 
 	return nil, aggregateObjErrors(obj.GroupVersionKind().GroupKind(), obj.Name, allErrs)
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	bootstrapParameterNameRegex     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	secretsManagerSecretARNRegex    = regexp.MustCompile(`^arn:aws[a-z-]*:secretsmanager:[a-z0-9-]+:[0-9]{12}:secret:[A-Za-z0-9/_+=.@-]+$`)
+	ssmParameterARNRegex            = regexp.MustCompile(`^arn:aws[a-z-]*:ssm:[a-z0-9-]+:[0-9]{12}:parameter/[A-Za-z0-9/_.-]+$`)
+	ssmParameterPathRegex           = regexp.MustCompile(`^(/[A-Za-z0-9_.-]+)+$`)
+	bootstrapParameterReferenceDesc = "must be a Secrets Manager secret ARN, an SSM parameter ARN or an SSM parameter path"
+)
+
+// validateAdditionalBootstrapParameters ensures that the parameter names are valid environment
+// variable names and that every value references a secret rather than containing one.
+func validateAdditionalBootstrapParameters(params map[string]string, ignition bool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(params) == 0 {
+		return allErrs
+	}
+
+	if ignition {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot be set if ignition is set"))
+	}
+
+	for name, ref := range params {
+		if !bootstrapParameterNameRegex.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath, name, "key must be a valid environment variable name"))
+		}
+		if !isBootstrapParameterReference(ref) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(name), ref, bootstrapParameterReferenceDesc))
+		}
+	}
+
+	return allErrs
+}
+
+func isBootstrapParameterReference(ref string) bool {
+	return secretsManagerSecretARNRegex.MatchString(ref) ||
+		ssmParameterARNRegex.MatchString(ref) ||
+		ssmParameterPathRegex.MatchString(ref)
+}
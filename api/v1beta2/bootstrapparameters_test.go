@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateAdditionalBootstrapParameters(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   map[string]string
+		ignition bool
+		wantErr  bool
+	}{
+		{
+			name:    "no parameters is valid",
+			params:  nil,
+			wantErr: false,
+		},
+		{
+			name: "Secrets Manager ARN is valid",
+			params: map[string]string{
+				"JOIN_TOKEN": "arn:aws:secretsmanager:us-east-1:123456789012:secret:cluster/join-token-AbCdEf",
+			},
+			wantErr: false,
+		},
+		{
+			name: "SSM parameter ARN is valid",
+			params: map[string]string{
+				"JOIN_TOKEN": "arn:aws-us-gov:ssm:us-gov-west-1:123456789012:parameter/cluster/join-token",
+			},
+			wantErr: false,
+		},
+		{
+			name: "SSM parameter path is valid",
+			params: map[string]string{
+				"_JOIN_TOKEN": "/cluster/join-token",
+			},
+			wantErr: false,
+		},
+		{
+			name: "literal secret value is not valid",
+			params: map[string]string{
+				"JOIN_TOKEN": "abcdef.0123456789abcdef",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ARN of another service is not valid",
+			params: map[string]string{
+				"JOIN_TOKEN": "arn:aws:s3:::bucket/join-token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "path with shell metacharacters is not valid",
+			params: map[string]string{
+				"JOIN_TOKEN": "/cluster/'$(reboot)'",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid environment variable name is not valid",
+			params: map[string]string{
+				"1JOIN-TOKEN": "/cluster/join-token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "parameters with ignition are not valid",
+			params: map[string]string{
+				"JOIN_TOKEN": "/cluster/join-token",
+			},
+			ignition: true,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateAdditionalBootstrapParameters(tt.params, tt.ignition, field.NewPath("spec", "additionalBootstrapParameters"))
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateAdditionalBootstrapParameters() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
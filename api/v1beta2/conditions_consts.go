@@ -34,6 +34,11 @@ const (
 	// SourcePrincipalUsageUnauthorizedReason used when AWSCluster is not in the intersection of source identity allowed namespaces
 	// and allowed namespaces of the identities that source identity depends to.
 	SourcePrincipalUsageUnauthorizedReason = "SourcePrincipalUsageUnauthorized"
+	// SecretNamespaceNotAllowedReason used when the secret of an AWSClusterStaticIdentity is in a namespace
+	// the controller isn't allowed to read static identity secrets from.
+	SecretNamespaceNotAllowedReason = "SecretNamespaceNotAllowed"
+	// IdentitySelectionFailedReason used when the identity selector of an AWSCluster doesn't match exactly one identity.
+	IdentitySelectionFailedReason = "IdentitySelectionFailed"
 )
 
 const (
@@ -53,6 +58,14 @@ const (
 	SubnetsReconciliationFailedReason = "SubnetsReconciliationFailed"
 )
 
+const (
+	// SubnetsAvailableIPAddressesCondition reports on whether the subnets of the cluster have more available IP addresses
+	// than the threshold of the network spec. It is only set when the threshold is set.
+	SubnetsAvailableIPAddressesCondition clusterv1.ConditionType = "SubnetsAvailableIPAddresses"
+	// SubnetsLowOnIPAddressesReason used when some subnets have fewer available IP addresses than the threshold.
+	SubnetsLowOnIPAddressesReason = "SubnetsLowOnIPAddresses"
+)
+
 const (
 	// InternetGatewayReadyCondition reports on the successful reconciliation of internet gateways.
 	// Only applicable to managed clusters.
@@ -87,6 +100,20 @@ const (
 	RouteTableReconciliationFailedReason = "RouteTableReconciliationFailed"
 )
 
+const (
+	// NetworkACLReadyCondition reports successful reconciliation of the network ACL of the managed subnets.
+	// Only applicable to managed clusters.
+	NetworkACLReadyCondition clusterv1.ConditionType = "NetworkACLReady"
+	// NetworkACLReconciliationFailedReason used when any errors occur during reconciliation of the network ACL.
+	NetworkACLReconciliationFailedReason = "NetworkACLReconciliationFailed"
+
+	// FlowLogsReadyCondition reports successful reconciliation of the flow log of the managed VPC.
+	// Only applicable to managed clusters.
+	FlowLogsReadyCondition clusterv1.ConditionType = "FlowLogsReady"
+	// FlowLogsReconciliationFailedReason used when any errors occur during reconciliation of the flow log.
+	FlowLogsReconciliationFailedReason = "FlowLogsReconciliationFailed"
+)
+
 const (
 	// VpcEndpointsReadyCondition reports successful reconciliation of vpc endpoints.
 	// Only applicable to managed clusters.
@@ -95,6 +122,31 @@ const (
 	VpcEndpointsReconciliationFailedReason = "VpcEndpointsReconciliationFailed"
 )
 
+const (
+	// TransitGatewayAttachmentReadyCondition reports successful reconciliation of the transit gateway attachment.
+	// Only applicable to managed clusters.
+	TransitGatewayAttachmentReadyCondition clusterv1.ConditionType = "TransitGatewayAttachmentReady"
+	// TransitGatewayAttachmentReconciliationFailedReason used when any errors occur during reconciliation of the transit gateway attachment.
+	TransitGatewayAttachmentReconciliationFailedReason = "TransitGatewayAttachmentReconciliationFailed"
+)
+
+const (
+	// InstanceConnectEndpointReadyCondition reports successful reconciliation of the EC2 Instance Connect Endpoint.
+	InstanceConnectEndpointReadyCondition clusterv1.ConditionType = "InstanceConnectEndpointReady"
+	// InstanceConnectEndpointFailedReason used when any errors occur during reconciliation of the EC2 Instance Connect Endpoint.
+	InstanceConnectEndpointFailedReason = "InstanceConnectEndpointFailed"
+)
+
+const (
+	// VpcPeeringsReadyCondition reports successful reconciliation of the VPC peering connections.
+	// Only applicable to managed clusters.
+	VpcPeeringsReadyCondition clusterv1.ConditionType = "VpcPeeringsReady"
+	// VpcPeeringsReconciliationFailedReason used when any errors occur during reconciliation of the VPC peering connections.
+	VpcPeeringsReconciliationFailedReason = "VpcPeeringsReconciliationFailed"
+	// VpcPeeringsPendingAcceptanceReason used when a VPC peering connection is waiting to be accepted by the owner of the peer VPC.
+	VpcPeeringsPendingAcceptanceReason = "VpcPeeringsPendingAcceptance"
+)
+
 const (
 	// SecondaryCidrsReadyCondition reports successful reconciliation of secondary CIDR blocks.
 	// Only applicable to managed clusters.
@@ -139,6 +191,8 @@ const (
 	InstanceNotFoundReason = "InstanceNotFound"
 	// InstanceTerminatedReason instance is in a terminated state.
 	InstanceTerminatedReason = "InstanceTerminated"
+	// InstanceDeletedExternallyReason instance was deleted outside of Cluster API.
+	InstanceDeletedExternallyReason = "InstanceDeletedExternally"
 	// InstanceStoppedReason instance is in a stopped state.
 	InstanceStoppedReason = "InstanceStopped"
 	// InstanceNotReadyReason used when the instance is in a pending state.
@@ -147,12 +201,32 @@ const (
 	InstanceProvisionStartedReason = "InstanceProvisionStarted"
 	// InstanceProvisionFailedReason used for failures during instance provisioning.
 	InstanceProvisionFailedReason = "InstanceProvisionFailed"
+	// UserDataTooLargeReason used when the instance can't be provisioned because its user data exceeds the EC2 limit
+	// and isn't offloaded to AWS Secrets Manager or S3.
+	UserDataTooLargeReason = "UserDataTooLarge"
+	// InstanceProvisionSkippedReason used when the instance isn't provisioned as the cluster is in the detectOnly
+	// reconcile mode.
+	InstanceProvisionSkippedReason = "InstanceProvisionSkipped"
 	// WaitingForClusterInfrastructureReason used when machine is waiting for cluster infrastructure to be ready before proceeding.
 	WaitingForClusterInfrastructureReason = "WaitingForClusterInfrastructure"
 	// WaitingForBootstrapDataReason used when machine is waiting for bootstrap data to be ready before proceeding.
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
 )
 
+const (
+	// InstancePlacementCondition reports whether the instance was launched in its preferred subnet and with its
+	// preferred instance type. It is set to true when it was, and to false when a fallback was used because of
+	// insufficient capacity or an unsupported instance type.
+	InstancePlacementCondition clusterv1.ConditionType = "InstancePlacement"
+
+	// InsufficientInstanceCapacityReason used when the instance was launched in a fallback subnet or with a
+	// fallback instance type because of insufficient capacity.
+	InsufficientInstanceCapacityReason = "InsufficientInstanceCapacity"
+	// InstanceTypeUnsupportedReason used when the instance was launched in a fallback subnet or with a
+	// fallback instance type because its preferred instance type is not supported in the availability zone.
+	InstanceTypeUnsupportedReason = "InstanceTypeUnsupported"
+)
+
 const (
 	// SecurityGroupsReadyCondition indicates the security groups are up to date on the AWSMachine.
 	SecurityGroupsReadyCondition clusterv1.ConditionType = "SecurityGroupsReady"
@@ -161,6 +235,37 @@ const (
 	SecurityGroupsFailedReason = "SecurityGroupsSyncFailed"
 )
 
+const (
+	// VolumesReadyCondition indicates the size, type, IOPS and throughput of the EBS volumes of the instance match the
+	// AWSMachine spec.
+	VolumesReadyCondition clusterv1.ConditionType = "VolumesReady"
+
+	// VolumesModifyingReason used while the EBS volumes of the instance are being modified.
+	VolumesModifyingReason = "VolumesModifying"
+	// VolumesModificationFailedReason used when the EBS volumes of the instance could not be modified.
+	VolumesModificationFailedReason = "VolumesModificationFailed"
+)
+
+const (
+	// InstanceTagsSyncedCondition reports whether the tags of the instance match the desired tags. It is set to
+	// false when tags were removed or changed outside of Cluster API and the tag reconcile policy is observe.
+	InstanceTagsSyncedCondition clusterv1.ConditionType = "InstanceTagsSynced"
+
+	// InstanceTagsDriftedReason used when tags of the instance were removed or changed outside of Cluster API.
+	InstanceTagsDriftedReason = "InstanceTagsDrifted"
+)
+
+const (
+	// ResourceTagsSyncedCondition reports whether the tags of the network, security group and load balancer
+	// resources of the cluster match the desired tags. It is set to false when tags were removed or changed outside
+	// of Cluster API and the tag reconcile policy is observe.
+	ResourceTagsSyncedCondition clusterv1.ConditionType = "ResourceTagsSynced"
+
+	// ResourceTagsDriftedReason used when tags of resources of the cluster were removed or changed outside of
+	// Cluster API.
+	ResourceTagsDriftedReason = "ResourceTagsDrifted"
+)
+
 const (
 	// ELBAttachedCondition will report true when a control plane is successfully registered with an ELB.
 	// When set to false, severity can be an Error if the subnet is not found or unavailable in the instance's AZ.
@@ -172,6 +277,22 @@ const (
 	ELBAttachFailedReason = "ELBAttachFailed"
 	// ELBDetachFailedReason used when a control plane node fails to detach from an ELB.
 	ELBDetachFailedReason = "ELBDetachFailed"
+	// DeregisteringFromLoadBalancerReason used when a control plane node is waiting for the deregistration
+	// delay of the load balancer's target groups to elapse before being terminated.
+	DeregisteringFromLoadBalancerReason = "DeregisteringFromLoadBalancer"
+)
+
+const (
+	// BootDiagnosticsCapturedCondition reports whether the console output and screenshot of a control plane
+	// instance that didn't become a node within the boot diagnostics grace period were uploaded to the S3 bucket.
+	// When true, its message holds the location of the diagnostics.
+	// Only applicable to control plane machines when boot diagnostics are enabled.
+	BootDiagnosticsCapturedCondition clusterv1.ConditionType = "BootDiagnosticsCaptured"
+
+	// BootDiagnosticsUploadedReason used when the boot diagnostics of an instance were uploaded to the S3 bucket.
+	BootDiagnosticsUploadedReason = "BootDiagnosticsUploaded"
+	// BootDiagnosticsCaptureFailedReason used when the boot diagnostics of an instance couldn't be captured or uploaded.
+	BootDiagnosticsCaptureFailedReason = "BootDiagnosticsCaptureFailed"
 )
 
 const (
@@ -181,3 +302,66 @@ const (
 	// S3BucketFailedReason is used when any errors occur during reconciliation of an S3 bucket.
 	S3BucketFailedReason = "S3BucketCreationFailed"
 )
+
+const (
+	// EFSReadyCondition indicates the EFS file system and its mount targets are available.
+	EFSReadyCondition clusterv1.ConditionType = "EFSReady"
+
+	// EFSFailedReason is used when any errors occur during reconciliation of the EFS file system.
+	EFSFailedReason = "EFSReconciliationFailed"
+	// EFSCreatingReason is used while the EFS file system is being created.
+	EFSCreatingReason = "EFSCreating"
+)
+
+const (
+	// EBSEncryptionKeyGrantReadyCondition indicates the KMS grants for the cross-account EBS encryption key exist.
+	EBSEncryptionKeyGrantReadyCondition clusterv1.ConditionType = "EBSEncryptionKeyGrantReady"
+
+	// EBSEncryptionKeyGrantFailedReason is used when any errors occur during reconciliation of the KMS grant.
+	EBSEncryptionKeyGrantFailedReason = "EBSEncryptionKeyGrantFailed"
+	// EBSEncryptionKeyGrantPermissionDeniedReason is used when the controller isn't allowed to create or list grants for the key.
+	EBSEncryptionKeyGrantPermissionDeniedReason = "EBSEncryptionKeyGrantPermissionDenied"
+)
+
+const (
+	// ManagedEncryptionKeyReadyCondition indicates the KMS key created for the cluster and its alias exist.
+	ManagedEncryptionKeyReadyCondition clusterv1.ConditionType = "ManagedEncryptionKeyReady"
+
+	// ManagedEncryptionKeyFailedReason is used when any errors occur during reconciliation of the managed KMS key.
+	ManagedEncryptionKeyFailedReason = "ManagedEncryptionKeyFailed"
+)
+
+const (
+	// PublicDNSReadyCondition indicates the Route53 record of the control plane endpoint points at the load balancer.
+	PublicDNSReadyCondition clusterv1.ConditionType = "PublicDNSReady"
+
+	// PublicDNSFailedReason is used when any errors occur during reconciliation of the Route53 record.
+	PublicDNSFailedReason = "PublicDNSFailed"
+)
+
+const (
+	// NetworkPausedCondition indicates the reconciliation of the network is paused by the PauseNetworkAnnotation
+	// annotation. The condition is removed when the annotation is removed.
+	NetworkPausedCondition clusterv1.ConditionType = "NetworkPaused"
+
+	// LoadBalancerPausedCondition indicates the reconciliation of the control plane load balancers is paused by
+	// the PauseLoadBalancerAnnotation annotation. The condition is removed when the annotation is removed.
+	LoadBalancerPausedCondition clusterv1.ConditionType = "LoadBalancerPaused"
+
+	// MachinePausedCondition indicates the reconciliation of an AWSMachine or an AWSMachinePool is paused by the
+	// PauseMachinesAnnotation annotation of its cluster. The condition is removed when the annotation is removed.
+	MachinePausedCondition clusterv1.ConditionType = "MachinePaused"
+)
+
+const (
+	// ResourcesInSyncCondition reports whether the AWS resources of the cluster match the spec when the cluster is
+	// in the detectOnly reconcile mode. It is removed in the full reconcile mode.
+	ResourcesInSyncCondition clusterv1.ConditionType = "ResourcesInSync"
+
+	// ResourcesDriftedReason used when operations changing AWS resources were skipped in the detectOnly reconcile
+	// mode.
+	ResourcesDriftedReason = "ResourcesDrifted"
+	// DriftDetectionFailedReason used when the AWS resources could not be compared with the spec in the detectOnly
+	// reconcile mode.
+	DriftDetectionFailedReason = "DriftDetectionFailed"
+)
@@ -55,7 +55,7 @@ func SetDefaults_NetworkSpec(obj *NetworkSpec) { //nolint:golint,stylecheck
 
 // SetDefaults_AWSClusterSpec is used by defaulter-gen.
 func SetDefaults_AWSClusterSpec(s *AWSClusterSpec) { //nolint:golint,stylecheck
-	if s.IdentityRef == nil {
+	if s.IdentityRef == nil && s.IdentitySelector == nil {
 		s.IdentityRef = &AWSIdentityReference{
 			Kind: ControllerIdentityKind,
 			Name: AWSClusterControllerIdentityName,
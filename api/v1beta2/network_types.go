@@ -18,8 +18,13 @@ package v1beta2
 
 import (
 	"fmt"
+	"net"
 	"sort"
+	"strings"
 	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 const (
@@ -39,6 +44,32 @@ type NetworkStatus struct {
 
 	// NatGatewaysIPs contains the public IPs of the NAT Gateways
 	NatGatewaysIPs []string `json:"natGatewaysIPs,omitempty"`
+
+	// InstanceConnectEndpointID is the ID of the EC2 Instance Connect Endpoint of the cluster, if any.
+	// +optional
+	InstanceConnectEndpointID string `json:"instanceConnectEndpointID,omitempty"`
+
+	// Subnets is the observed state of the subnets of the cluster.
+	// +optional
+	Subnets []SubnetStatus `json:"subnets,omitempty"`
+
+	// KarpenterDiscoveryTagValue is the value of the karpenter.sh/discovery tag added to the private subnets and
+	// node security group, so it can be removed when it is disabled or changed.
+	// +optional
+	KarpenterDiscoveryTagValue string `json:"karpenterDiscoveryTagValue,omitempty"`
+
+	// FlowLogID is the ID of the flow log of the managed VPC.
+	// +optional
+	FlowLogID string `json:"flowLogID,omitempty"`
+}
+
+// SubnetStatus is the observed state of a subnet of the cluster.
+type SubnetStatus struct {
+	// ID is the AWS identifier of the subnet.
+	ID string `json:"id"`
+
+	// AvailableIPAddressCount is the number of unused private IPv4 addresses in the subnet.
+	AvailableIPAddressCount int64 `json:"availableIPAddressCount"`
 }
 
 // ELBScheme defines the scheme of a load balancer.
@@ -115,6 +146,9 @@ var (
 	LoadBalancerAttributeIdleTimeDefaultTimeoutSecondsInSeconds = "60"
 )
 
+// DefaultLoadBalancerSSLPolicy is the security policy used by HTTPS listeners when none is specified.
+const DefaultLoadBalancerSSLPolicy = "ELBSecurityPolicy-TLS13-1-2-2021-06"
+
 // TargetGroupSpec specifies target group settings for a given listener.
 // This is created first, and the ARN is then passed to the listener.
 type TargetGroupSpec struct {
@@ -134,6 +168,12 @@ type Listener struct {
 	Protocol    ELBProtocol     `json:"protocol"`
 	Port        int64           `json:"port"`
 	TargetGroup TargetGroupSpec `json:"targetGroup"`
+	// CertificateARN is the ARN of the certificate used by HTTPS listeners.
+	// +optional
+	CertificateARN string `json:"certificateArn,omitempty"`
+	// SSLPolicy is the security policy used by HTTPS listeners.
+	// +optional
+	SSLPolicy string `json:"sslPolicy,omitempty"`
 }
 
 // LoadBalancer defines an AWS load balancer.
@@ -149,6 +189,10 @@ type LoadBalancer struct {
 	// DNSName is the dns name of the load balancer.
 	DNSName string `json:"dnsName,omitempty"`
 
+	// CanonicalHostedZoneID is the ID of the Route53 hosted zone of the load balancer's DNS name.
+	// +optional
+	CanonicalHostedZoneID string `json:"canonicalHostedZoneID,omitempty"`
+
 	// Scheme is the load balancer scheme, either internet-facing or private.
 	Scheme ELBScheme `json:"scheme,omitempty"`
 
@@ -241,9 +285,347 @@ type NetworkSpec struct {
 	// +optional
 	SecurityGroupOverrides map[SecurityGroupRole]string `json:"securityGroupOverrides,omitempty"`
 
+	// SecurityGroupReconcileStrategies configures, per security group role, how the ingress rules of the
+	// security groups managed by the AWS provider are reconciled. Defaults to managed for all roles.
+	// +optional
+	SecurityGroupReconcileStrategies map[SecurityGroupRole]SecurityGroupRoleReconcileStrategy `json:"securityGroupReconcileStrategies,omitempty"`
+
+	// SecurityGroupEgressRules configures, per security group role, the egress rules of the security groups
+	// managed by the AWS provider. When rules are set for a role, they replace the default egress rule of the
+	// security group allowing all outbound traffic. Roles without rules keep the default, which is restored when
+	// the rules of a role are removed.
+	// +optional
+	SecurityGroupEgressRules map[SecurityGroupRole]EgressRules `json:"securityGroupEgressRules,omitempty"`
+
 	// AdditionalControlPlaneIngressRules is an optional set of ingress rules to add to the control plane
 	// +optional
 	AdditionalControlPlaneIngressRules []IngressRule `json:"additionalControlPlaneIngressRules,omitempty"`
+
+	// SubnetCidrSizes configures the size of the subnets that are carved out of the VPC CIDR
+	// when subnets are not specified and the VPC is managed. By default the VPC CIDR
+	// is split evenly between the public and private subnets.
+	// +optional
+	SubnetCidrSizes *SubnetCidrSizes `json:"subnetCidrSizes,omitempty"`
+
+	// AdditionalRoutes is an optional set of routes to add to the private route tables managed by the
+	// AWS provider, e.g. to route traffic for a peered network through a transit gateway.
+	// The default routes of the route tables are left untouched.
+	// +optional
+	AdditionalRoutes []RouteSpec `json:"additionalRoutes,omitempty"`
+
+	// TransitGatewayAttachment configures an attachment of the VPC to a transit gateway,
+	// which is created and deleted along with the managed VPC.
+	// +optional
+	TransitGatewayAttachment *TransitGatewayAttachmentSpec `json:"transitGatewayAttachment,omitempty"`
+
+	// VPCPeerings configures peering connections between the VPC and other VPCs,
+	// which are created and deleted along with the managed VPC. The CIDR blocks of the peer VPCs
+	// can't be the destination of an additional route.
+	// +optional
+	VPCPeerings []VPCPeeringSpec `json:"vpcPeerings,omitempty"`
+
+	// NetworkACL configures a network ACL that is associated with the subnets of the managed VPC,
+	// instead of the default network ACL of the VPC. It is created and deleted along with the managed VPC.
+	// Removing it reverts the subnets to the default network ACL of the VPC and deletes the network ACL.
+	// +optional
+	NetworkACL *NetworkACLSpec `json:"networkACL,omitempty"`
+
+	// InstanceConnectEndpoint configures an EC2 Instance Connect Endpoint in a private subnet, allowing SSH
+	// to the instances of the cluster without public IP addresses or a bastion host.
+	// +optional
+	InstanceConnectEndpoint *InstanceConnectEndpointSpec `json:"instanceConnectEndpoint,omitempty"`
+
+	// SubnetAvailableIPAddressThreshold is the number of available IP addresses below which a subnet of the
+	// cluster is reported as running low on IP addresses by the SubnetsAvailableIPAddresses condition.
+	// When not set, the available IP addresses of the subnets are only reported in the status.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	SubnetAvailableIPAddressThreshold *int64 `json:"subnetAvailableIPAddressThreshold,omitempty"`
+
+	// KarpenterDiscovery configures the tag Karpenter uses to discover the private subnets and the node security
+	// group of the cluster. The tag is only added to the resources of a managed VPC.
+	// +optional
+	KarpenterDiscovery *KarpenterDiscoverySpec `json:"karpenterDiscovery,omitempty"`
+
+	// NatGatewayStrategy controls how many NAT gateways are created for the private subnets of a managed VPC.
+	// PerZone creates a NAT gateway in every public subnet and routes the private subnets through the gateway of
+	// their availability zone. Single creates one NAT gateway shared by all the private subnets, which is cheaper
+	// but makes the egress traffic of the cluster depend on a single availability zone.
+	// Defaults to PerZone.
+	// +optional
+	// +kubebuilder:validation:Enum=PerZone;Single
+	NatGatewayStrategy NatGatewayStrategy `json:"natGatewayStrategy,omitempty"`
+
+	// FlowLogs configures a flow log capturing the IP traffic of a managed VPC.
+	// +optional
+	FlowLogs *FlowLogsSpec `json:"flowLogs,omitempty"`
+}
+
+// FlowLogsDestinationType defines where the records of a flow log are delivered.
+type FlowLogsDestinationType string
+
+const (
+	// FlowLogsDestinationTypeCloudWatchLogs delivers the records to a CloudWatch Logs log group.
+	FlowLogsDestinationTypeCloudWatchLogs = FlowLogsDestinationType("cloud-watch-logs")
+
+	// FlowLogsDestinationTypeS3 delivers the records to an S3 bucket.
+	FlowLogsDestinationTypeS3 = FlowLogsDestinationType("s3")
+)
+
+// FlowLogsTrafficType defines the traffic captured by a flow log.
+type FlowLogsTrafficType string
+
+const (
+	// FlowLogsTrafficTypeAccept captures the accepted traffic.
+	FlowLogsTrafficTypeAccept = FlowLogsTrafficType("ACCEPT")
+
+	// FlowLogsTrafficTypeReject captures the rejected traffic.
+	FlowLogsTrafficTypeReject = FlowLogsTrafficType("REJECT")
+
+	// FlowLogsTrafficTypeAll captures all the traffic.
+	FlowLogsTrafficTypeAll = FlowLogsTrafficType("ALL")
+)
+
+// FlowLogsSpec defines the flow log of a managed VPC.
+// Flow logs can't be modified, the flow log is replaced when the spec changes.
+type FlowLogsSpec struct {
+	// DestinationType is where the records of the flow log are delivered.
+	// Defaults to cloud-watch-logs.
+	// +optional
+	// +kubebuilder:validation:Enum=cloud-watch-logs;s3
+	DestinationType FlowLogsDestinationType `json:"destinationType,omitempty"`
+
+	// LogGroupName is the name of the CloudWatch Logs log group the records are delivered to.
+	// Required for the cloud-watch-logs destination type.
+	// +optional
+	LogGroupName string `json:"logGroupName,omitempty"`
+
+	// BucketARN is the ARN of the S3 bucket the records are delivered to, optionally followed by
+	// the folder of the records, e.g. arn:aws:s3:::my-bucket/my-folder/.
+	// Required for the s3 destination type.
+	// +optional
+	BucketARN string `json:"bucketARN,omitempty"`
+
+	// TrafficType is the traffic captured by the flow log.
+	// Defaults to ALL.
+	// +optional
+	// +kubebuilder:validation:Enum=ACCEPT;REJECT;ALL
+	TrafficType FlowLogsTrafficType `json:"trafficType,omitempty"`
+
+	// IAMRoleARN is the ARN of the IAM role allowing the flow log to publish to CloudWatch Logs.
+	// When not set for the cloud-watch-logs destination type, a role is created for the cluster and
+	// deleted along with the flow log. Not used for the s3 destination type.
+	// +optional
+	IAMRoleARN string `json:"iamRoleARN,omitempty"`
+}
+
+// GetDestinationType returns the destination type of the flow log, defaulting to cloud-watch-logs.
+func (f *FlowLogsSpec) GetDestinationType() FlowLogsDestinationType {
+	if f.DestinationType == "" {
+		return FlowLogsDestinationTypeCloudWatchLogs
+	}
+	return f.DestinationType
+}
+
+// GetTrafficType returns the traffic captured by the flow log, defaulting to ALL.
+func (f *FlowLogsSpec) GetTrafficType() FlowLogsTrafficType {
+	if f.TrafficType == "" {
+		return FlowLogsTrafficTypeAll
+	}
+	return f.TrafficType
+}
+
+// NatGatewayStrategy defines how many NAT gateways are created for a managed VPC.
+type NatGatewayStrategy string
+
+const (
+	// NatGatewayStrategyPerZone creates a NAT gateway in every public subnet.
+	NatGatewayStrategyPerZone = NatGatewayStrategy("PerZone")
+
+	// NatGatewayStrategySingle creates a single NAT gateway shared by all the private subnets.
+	NatGatewayStrategySingle = NatGatewayStrategy("Single")
+)
+
+// KarpenterDiscoverySpec configures the karpenter.sh/discovery tag of the managed subnets and node security group.
+type KarpenterDiscoverySpec struct {
+	// Value is the value of the karpenter.sh/discovery tag.
+	// Defaults to the name of the Kubernetes cluster.
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// TagValue returns the value of the karpenter.sh/discovery tag, or an empty string if the tag is disabled.
+func (k *KarpenterDiscoverySpec) TagValue(clusterName string) string {
+	if k == nil {
+		return ""
+	}
+	if k.Value != "" {
+		return k.Value
+	}
+	return clusterName
+}
+
+// InstanceConnectEndpointSpec defines the EC2 Instance Connect Endpoint of the cluster.
+type InstanceConnectEndpointSpec struct {
+	// SubnetID is the ID of the private subnet to create the endpoint in.
+	// Defaults to the first private subnet of the cluster.
+	// +optional
+	SubnetID *string `json:"subnetID,omitempty"`
+}
+
+// NetworkACLSpec defines the rules of the network ACL associated with the managed subnets.
+// Traffic that matches none of the rules is denied.
+type NetworkACLSpec struct {
+	// Ingress are the rules for inbound traffic.
+	// +optional
+	Ingress []NetworkACLEntry `json:"ingress,omitempty"`
+
+	// Egress are the rules for outbound traffic.
+	// +optional
+	Egress []NetworkACLEntry `json:"egress,omitempty"`
+}
+
+// NetworkACLEntry defines a rule of a network ACL.
+type NetworkACLEntry struct {
+	// RuleNumber is the number of the rule. Rules are evaluated in ascending order of their numbers,
+	// and the first rule that matches the traffic is applied.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int64 `json:"ruleNumber"`
+
+	// Protocol is the protocol of the traffic the rule matches.
+	Protocol NetworkACLProtocol `json:"protocol"`
+
+	// RuleAction is whether the rule allows or denies the matching traffic.
+	RuleAction NetworkACLRuleAction `json:"ruleAction"`
+
+	// CidrBlock is the IPv4 CIDR block of the traffic the rule matches.
+	// Exactly one of CidrBlock and IPv6CidrBlock must be set.
+	// +optional
+	CidrBlock string `json:"cidrBlock,omitempty"`
+
+	// IPv6CidrBlock is the IPv6 CIDR block of the traffic the rule matches.
+	// Exactly one of CidrBlock and IPv6CidrBlock must be set.
+	// +optional
+	IPv6CidrBlock string `json:"ipv6CidrBlock,omitempty"`
+
+	// FromPort is the first port of the port range the rule matches.
+	// Required for the tcp and udp protocols.
+	// +optional
+	FromPort *int64 `json:"fromPort,omitempty"`
+
+	// ToPort is the last port of the port range the rule matches.
+	// Required for the tcp and udp protocols.
+	// +optional
+	ToPort *int64 `json:"toPort,omitempty"`
+}
+
+// NetworkACLProtocol defines the protocol of a network ACL rule.
+// +kubebuilder:validation:Enum="-1";tcp;udp;icmp
+type NetworkACLProtocol string
+
+const (
+	// NetworkACLProtocolAll matches all protocols.
+	NetworkACLProtocolAll = NetworkACLProtocol("-1")
+
+	// NetworkACLProtocolTCP matches the TCP protocol.
+	NetworkACLProtocolTCP = NetworkACLProtocol("tcp")
+
+	// NetworkACLProtocolUDP matches the UDP protocol.
+	NetworkACLProtocolUDP = NetworkACLProtocol("udp")
+
+	// NetworkACLProtocolICMP matches all types and codes of the ICMP protocol.
+	NetworkACLProtocolICMP = NetworkACLProtocol("icmp")
+)
+
+// NetworkACLRuleAction defines whether a network ACL rule allows or denies traffic.
+// +kubebuilder:validation:Enum=allow;deny
+type NetworkACLRuleAction string
+
+const (
+	// NetworkACLRuleActionAllow allows the matching traffic.
+	NetworkACLRuleActionAllow = NetworkACLRuleAction("allow")
+
+	// NetworkACLRuleActionDeny denies the matching traffic.
+	NetworkACLRuleActionDeny = NetworkACLRuleAction("deny")
+)
+
+// VPCPeeringSpec defines a peering connection between the VPC and a peer VPC.
+// Routes to the peer VPC are added to the route tables managed by the AWS provider once the
+// peering connection is active. When the peer VPC is in the same account and region, routes back
+// to the VPC are added to the route tables of the peer VPC as well.
+type VPCPeeringSpec struct {
+	// PeerVPCID is the ID of the VPC to peer with.
+	PeerVPCID string `json:"peerVpcId"`
+
+	// PeerOwnerID is the ID of the AWS account that owns the peer VPC.
+	// Defaults to the account of the cluster.
+	// +optional
+	PeerOwnerID *string `json:"peerOwnerId,omitempty"`
+
+	// PeerRegion is the region of the peer VPC.
+	// Defaults to the region of the cluster.
+	// +optional
+	PeerRegion string `json:"peerRegion,omitempty"`
+
+	// AutoAccept accepts the peering connection on behalf of the peer VPC.
+	// This is only possible when the peer VPC is in the same account and region as the cluster,
+	// otherwise the peering connection must be accepted by the owner of the peer VPC.
+	// +optional
+	AutoAccept bool `json:"autoAccept,omitempty"`
+}
+
+// TransitGatewayAttachmentSpec defines the attachment of the VPC to a transit gateway.
+type TransitGatewayAttachmentSpec struct {
+	// TransitGatewayID is the ID of the transit gateway to attach the VPC to.
+	TransitGatewayID string `json:"transitGatewayId"`
+
+	// SubnetIDs are the IDs of the subnets the attachment is placed in, at most one per availability zone.
+	// When omitted, the private subnets of the cluster are used.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// RouteTableID is the ID of the transit gateway route table to associate the attachment with.
+	// When omitted, the attachment is left with the default association of the transit gateway.
+	// +optional
+	RouteTableID *string `json:"routeTableId,omitempty"`
+
+	// EnableRouteTablePropagation propagates the routes of the VPC to the transit gateway route table
+	// specified in RouteTableID.
+	// +optional
+	EnableRouteTablePropagation bool `json:"enableRouteTablePropagation,omitempty"`
+}
+
+// RouteSpec defines a route to add to the managed private route tables.
+// Exactly one target must be specified.
+type RouteSpec struct {
+	// DestinationCidrBlock is the IPv4 CIDR block used for the destination match of the route.
+	DestinationCidrBlock string `json:"destinationCidrBlock"`
+
+	// TransitGatewayID is the ID of the transit gateway to route the traffic to.
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// VpcPeeringConnectionID is the ID of the VPC peering connection to route the traffic to.
+	// +optional
+	VpcPeeringConnectionID *string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// NetworkInterfaceID is the ID of the network interface to route the traffic to.
+	// +optional
+	NetworkInterfaceID *string `json:"networkInterfaceId,omitempty"`
+}
+
+// SubnetCidrSizes defines the prefix lengths of the default subnets created in each availability zone.
+type SubnetCidrSizes struct {
+	// PublicPrefixLength is the prefix length of each public subnet, e.g. 24 for a /24 subnet.
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=28
+	PublicPrefixLength int `json:"publicPrefixLength"`
+
+	// PrivatePrefixLength is the prefix length of each private subnet, e.g. 19 for a /19 subnet.
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=28
+	PrivatePrefixLength int `json:"privatePrefixLength"`
 }
 
 // IPv6 contains ipv6 specific settings for the network.
@@ -399,6 +781,13 @@ type SubnetSpec struct {
 	// +optional
 	RouteTableID *string `json:"routeTableId,omitempty"`
 
+	// ExistingRouteTableID is the ID of an existing route table to associate the private subnet with,
+	// instead of a route table created by the provider. The provider does not manage the routes or tags
+	// of the route table, and does not delete it when the cluster is deleted.
+	// Ignored unless the VPC is managed by the provider.
+	// +optional
+	ExistingRouteTableID *string `json:"existingRouteTableId,omitempty"`
+
 	// NatGatewayID is the NAT gateway id associated with the subnet.
 	// Ignored unless the subnet is managed by the provider, in which case this is set on the public subnet where the NAT gateway resides. It is then used to determine routes for private subnets in the same AZ as the public subnet.
 	// +optional
@@ -537,7 +926,7 @@ type RouteTable struct {
 }
 
 // SecurityGroupRole defines the unique role of a security group.
-// +kubebuilder:validation:Enum=bastion;node;controlplane;apiserver-lb;lb;node-eks-additional
+// +kubebuilder:validation:Enum=bastion;node;controlplane;apiserver-lb;lb;node-eks-additional;efs;instance-connect-endpoint
 type SecurityGroupRole string
 
 var (
@@ -558,6 +947,12 @@ var (
 
 	// SecurityGroupLB defines a container for the cloud provider to inject its load balancer ingress rules.
 	SecurityGroupLB = SecurityGroupRole("lb")
+
+	// SecurityGroupEFS defines the role of the EFS mount targets, allowing NFS traffic from the nodes.
+	SecurityGroupEFS = SecurityGroupRole("efs")
+
+	// SecurityGroupInstanceConnectEndpoint defines the role of the EC2 Instance Connect Endpoint, allowing SSH to the nodes.
+	SecurityGroupInstanceConnectEndpoint = SecurityGroupRole("instance-connect-endpoint")
 )
 
 // SecurityGroup defines an AWS security group.
@@ -607,6 +1002,21 @@ var (
 	SecurityGroupProtocolESP = SecurityGroupProtocol("50")
 )
 
+// SecurityGroupRoleReconcileStrategy defines how the ingress rules of a security group are reconciled.
+// +kubebuilder:validation:Enum=managed;additive
+type SecurityGroupRoleReconcileStrategy string
+
+const (
+	// SecurityGroupRoleReconcileStrategyManaged makes the ingress rules of the security group match the
+	// rules defined by the AWS provider, rules added by other means are revoked.
+	SecurityGroupRoleReconcileStrategyManaged = SecurityGroupRoleReconcileStrategy("managed")
+
+	// SecurityGroupRoleReconcileStrategyAdditive only ensures the rules defined by the AWS provider exist
+	// in the security group. Rules added by other means are left untouched, only rules tagged as created
+	// by the AWS provider are revoked once they are no longer needed.
+	SecurityGroupRoleReconcileStrategyAdditive = SecurityGroupRoleReconcileStrategy("additive")
+)
+
 // IngressRule defines an AWS ingress rule for security groups.
 type IngressRule struct {
 	// Description provides extended information about the ingress rule.
@@ -666,6 +1076,44 @@ func (i IngressRules) Difference(o IngressRules) (out IngressRules) {
 	return
 }
 
+// Expand returns the rules of this slice split into one rule per source CIDR block, IPv6 CIDR block or
+// source security group, which is how EC2 reports the rules of a security group. Rules without any source
+// are kept as they are. Source security group roles are expected to be resolved into source security group IDs beforehand.
+func (i IngressRules) Expand() (out IngressRules) {
+	for index := range i {
+		rule := i[index]
+		if len(rule.CidrBlocks)+len(rule.IPv6CidrBlocks)+len(rule.SourceSecurityGroupIDs) == 0 {
+			out = append(out, rule)
+			continue
+		}
+
+		base := IngressRule{
+			Description: rule.Description,
+			Protocol:    rule.Protocol,
+			FromPort:    rule.FromPort,
+			ToPort:      rule.ToPort,
+		}
+
+		for _, cidr := range rule.CidrBlocks {
+			expanded := base
+			expanded.CidrBlocks = []string{cidr}
+			out = append(out, expanded)
+		}
+		for _, cidr := range rule.IPv6CidrBlocks {
+			expanded := base
+			expanded.IPv6CidrBlocks = []string{cidr}
+			out = append(out, expanded)
+		}
+		for _, groupID := range rule.SourceSecurityGroupIDs {
+			expanded := base
+			expanded.SourceSecurityGroupIDs = []string{groupID}
+			out = append(out, expanded)
+		}
+	}
+
+	return
+}
+
 // Equals returns true if two IngressRule are equal.
 func (i *IngressRule) Equals(o *IngressRule) bool {
 	// ipv4
@@ -727,3 +1175,219 @@ func (i *IngressRule) Equals(o *IngressRule) bool {
 
 	return true
 }
+
+// EgressRule defines an AWS egress rule for security groups.
+type EgressRule struct {
+	// Description provides extended information about the egress rule.
+	Description string `json:"description"`
+	// Protocol is the protocol for the egress rule. Accepted values are "-1" (all), "4" (IP in IP),"tcp", "udp", "icmp", and "58" (ICMPv6), "50" (ESP).
+	// +kubebuilder:validation:Enum="-1";"4";tcp;udp;icmp;"58";"50"
+	Protocol SecurityGroupProtocol `json:"protocol"`
+	// FromPort is the start of port range.
+	FromPort int64 `json:"fromPort"`
+	// ToPort is the end of port range.
+	ToPort int64 `json:"toPort"`
+
+	// List of CIDR blocks to allow access to.
+	// +optional
+	CidrBlocks []string `json:"cidrBlocks,omitempty"`
+
+	// List of IPv6 CIDR blocks to allow access to.
+	// +optional
+	IPv6CidrBlocks []string `json:"ipv6CidrBlocks,omitempty"`
+
+	// The security group roles to allow access to, for instance the control plane security group
+	// to restrict the egress of the API server load balancer to the control plane instances.
+	// The cluster must have a security group for each of the roles.
+	// +optional
+	DestinationSecurityGroupRoles []SecurityGroupRole `json:"destinationSecurityGroupRoles,omitempty"`
+}
+
+// ValidateExistingRouteTables checks that the existing route tables are route table IDs, and only set on private subnets.
+func (n *NetworkSpec) ValidateExistingRouteTables(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, subnet := range n.Subnets {
+		if subnet.ExistingRouteTableID == nil {
+			continue
+		}
+		routeTablePath := fldPath.Child("subnets").Index(i).Child("existingRouteTableId")
+		switch {
+		case subnet.IsPublic:
+			allErrs = append(allErrs, field.Invalid(routeTablePath, *subnet.ExistingRouteTableID, "existing route tables can only be used with private subnets"))
+		case !strings.HasPrefix(*subnet.ExistingRouteTableID, "rtb-"):
+			allErrs = append(allErrs, field.Invalid(routeTablePath, *subnet.ExistingRouteTableID, "must be a route table ID starting with rtb-"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateTransitGatewayAttachment checks that the transit gateway attachment has a transit gateway, and a route
+// table when route table propagation is enabled.
+func (n *NetworkSpec) ValidateTransitGatewayAttachment(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	attachment := n.TransitGatewayAttachment
+	if attachment == nil {
+		return allErrs
+	}
+
+	attachmentPath := fldPath.Child("transitGatewayAttachment")
+	if attachment.TransitGatewayID == "" {
+		allErrs = append(allErrs, field.Required(attachmentPath.Child("transitGatewayId"), "transitGatewayId is required"))
+	}
+	if attachment.EnableRouteTablePropagation && attachment.RouteTableID == nil {
+		allErrs = append(allErrs, field.Invalid(attachmentPath.Child("enableRouteTablePropagation"), attachment.EnableRouteTablePropagation, "routeTableId is required to enable route table propagation"))
+	}
+
+	return allErrs
+}
+
+// ValidateNetworkACL checks that the rules of the network ACL have unique numbers per direction,
+// either a valid IPv4 or IPv6 CIDR block, and port ranges when the protocol requires them.
+func (n *NetworkSpec) ValidateNetworkACL(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	nacl := n.NetworkACL
+	if nacl == nil {
+		return allErrs
+	}
+
+	naclPath := fldPath.Child("networkACL")
+	for _, direction := range []struct {
+		path    *field.Path
+		entries []NetworkACLEntry
+	}{
+		{path: naclPath.Child("ingress"), entries: nacl.Ingress},
+		{path: naclPath.Child("egress"), entries: nacl.Egress},
+	} {
+		ruleNumbers := make(map[int64]struct{}, len(direction.entries))
+		for i, entry := range direction.entries {
+			entryPath := direction.path.Index(i)
+			if _, ok := ruleNumbers[entry.RuleNumber]; ok {
+				allErrs = append(allErrs, field.Duplicate(entryPath.Child("ruleNumber"), entry.RuleNumber))
+			}
+			ruleNumbers[entry.RuleNumber] = struct{}{}
+
+			switch {
+			case entry.CidrBlock == "" && entry.IPv6CidrBlock == "":
+				allErrs = append(allErrs, field.Required(entryPath, "one of cidrBlock and ipv6CidrBlock is required"))
+			case entry.CidrBlock != "" && entry.IPv6CidrBlock != "":
+				allErrs = append(allErrs, field.Invalid(entryPath, entry, "only one of cidrBlock and ipv6CidrBlock can be set"))
+			case entry.CidrBlock != "":
+				if _, cidr, err := net.ParseCIDR(entry.CidrBlock); err != nil || cidr.IP.To4() == nil {
+					allErrs = append(allErrs, field.Invalid(entryPath.Child("cidrBlock"), entry.CidrBlock, "must be a valid IPv4 CIDR block"))
+				}
+			default:
+				if _, cidr, err := net.ParseCIDR(entry.IPv6CidrBlock); err != nil || cidr.IP.To4() != nil {
+					allErrs = append(allErrs, field.Invalid(entryPath.Child("ipv6CidrBlock"), entry.IPv6CidrBlock, "must be a valid IPv6 CIDR block"))
+				}
+			}
+
+			if entry.Protocol != NetworkACLProtocolTCP && entry.Protocol != NetworkACLProtocolUDP {
+				continue
+			}
+			switch {
+			case entry.FromPort == nil || entry.ToPort == nil:
+				allErrs = append(allErrs, field.Required(entryPath, "fromPort and toPort are required for the tcp and udp protocols"))
+			case *entry.FromPort < 0 || *entry.ToPort > 65535 || *entry.FromPort > *entry.ToPort:
+				allErrs = append(allErrs, field.Invalid(entryPath, entry, "fromPort and toPort must be a valid port range"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateFlowLogs checks that the flow log has the destination required by its destination type.
+func (n *NetworkSpec) ValidateFlowLogs(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	flowLogs := n.FlowLogs
+	if flowLogs == nil {
+		return allErrs
+	}
+
+	flowLogsPath := fldPath.Child("flowLogs")
+	switch flowLogs.GetDestinationType() {
+	case FlowLogsDestinationTypeCloudWatchLogs:
+		if flowLogs.LogGroupName == "" {
+			allErrs = append(allErrs, field.Required(flowLogsPath.Child("logGroupName"), "logGroupName is required for the cloud-watch-logs destination type"))
+		}
+		if flowLogs.IAMRoleARN != "" {
+			if _, err := arn.Parse(flowLogs.IAMRoleARN); err != nil {
+				allErrs = append(allErrs, field.Invalid(flowLogsPath.Child("iamRoleARN"), flowLogs.IAMRoleARN, "must be a valid IAM role ARN"))
+			}
+		}
+	case FlowLogsDestinationTypeS3:
+		if flowLogs.BucketARN == "" {
+			allErrs = append(allErrs, field.Required(flowLogsPath.Child("bucketARN"), "bucketARN is required for the s3 destination type"))
+		} else if parsed, err := arn.Parse(flowLogs.BucketARN); err != nil || parsed.Service != "s3" {
+			allErrs = append(allErrs, field.Invalid(flowLogsPath.Child("bucketARN"), flowLogs.BucketARN, "must be a valid S3 bucket ARN"))
+		}
+		if flowLogs.LogGroupName != "" || flowLogs.IAMRoleARN != "" {
+			allErrs = append(allErrs, field.Invalid(flowLogsPath, flowLogs, "logGroupName and iamRoleARN can only be set for the cloud-watch-logs destination type"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateInstanceConnectEndpoint checks that the subnet of the EC2 Instance Connect Endpoint, when it is one of the
+// subnets of the spec, is private.
+func (n *NetworkSpec) ValidateInstanceConnectEndpoint(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	endpoint := n.InstanceConnectEndpoint
+	if endpoint == nil || endpoint.SubnetID == nil {
+		return allErrs
+	}
+
+	subnetPath := fldPath.Child("instanceConnectEndpoint", "subnetID")
+	if *endpoint.SubnetID == "" {
+		allErrs = append(allErrs, field.Invalid(subnetPath, *endpoint.SubnetID, "must not be empty"))
+		return allErrs
+	}
+	if subnet := n.Subnets.FindByID(*endpoint.SubnetID); subnet != nil && subnet.IsPublic {
+		allErrs = append(allErrs, field.Invalid(subnetPath, *endpoint.SubnetID, "must be a private subnet"))
+	}
+
+	return allErrs
+}
+
+// EgressRules is a slice of AWS egress rules for security groups.
+type EgressRules []EgressRule
+
+// Validate checks that the egress rule has valid destination CIDR blocks or security group roles, and a valid
+// port range for the protocols using ports.
+func (e *EgressRule) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(e.CidrBlocks) == 0 && len(e.IPv6CidrBlocks) == 0 && len(e.DestinationSecurityGroupRoles) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one of cidrBlocks, ipv6CidrBlocks or destinationSecurityGroupRoles is required"))
+	}
+	for i, cidr := range e.CidrBlocks {
+		if _, ipNet, err := net.ParseCIDR(cidr); err != nil || ipNet.IP.To4() == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cidrBlocks").Index(i), cidr, "must be a valid IPv4 CIDR block"))
+		}
+	}
+	for i, cidr := range e.IPv6CidrBlocks {
+		if _, ipNet, err := net.ParseCIDR(cidr); err != nil || ipNet.IP.To4() != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ipv6CidrBlocks").Index(i), cidr, "must be a valid IPv6 CIDR block"))
+		}
+	}
+
+	if e.Protocol == SecurityGroupProtocolTCP || e.Protocol == SecurityGroupProtocolUDP {
+		if e.FromPort < 0 || e.ToPort > 65535 || e.FromPort > e.ToPort {
+			allErrs = append(allErrs, field.Invalid(fldPath, e.String(), "fromPort and toPort must be a valid port range"))
+		}
+	}
+
+	return allErrs
+}
+
+// String returns a string representation of the egress rule.
+func (e EgressRule) String() string {
+	return fmt.Sprintf("protocol=%s/range=[%d-%d]/description=%s", e.Protocol, e.FromPort, e.ToPort, e.Description)
+}
@@ -105,3 +105,111 @@ func TestSGDifference(t *testing.T) {
 		})
 	}
 }
+
+func TestSGExpand(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    IngressRules
+		expected IngressRules
+	}{
+		{
+			name:     "input is nil",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name: "rule without source is kept",
+			input: IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+				},
+			},
+			expected: IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+				},
+			},
+		},
+		{
+			name: "rule with multiple source security groups",
+			input: IngressRules{
+				{
+					Description:            "Kubelet API",
+					Protocol:               SecurityGroupProtocolTCP,
+					FromPort:               10250,
+					ToPort:                 10250,
+					SourceSecurityGroupIDs: []string{"sg-source-1", "sg-source-2"},
+				},
+			},
+			expected: IngressRules{
+				{
+					Description:            "Kubelet API",
+					Protocol:               SecurityGroupProtocolTCP,
+					FromPort:               10250,
+					ToPort:                 10250,
+					SourceSecurityGroupIDs: []string{"sg-source-1"},
+				},
+				{
+					Description:            "Kubelet API",
+					Protocol:               SecurityGroupProtocolTCP,
+					FromPort:               10250,
+					ToPort:                 10250,
+					SourceSecurityGroupIDs: []string{"sg-source-2"},
+				},
+			},
+		},
+		{
+			name: "rule with cidr blocks and source security groups",
+			input: IngressRules{
+				{
+					Description:              "SSH",
+					Protocol:                 SecurityGroupProtocolTCP,
+					FromPort:                 22,
+					ToPort:                   22,
+					CidrBlocks:               []string{"10.0.0.0/16"},
+					IPv6CidrBlocks:           []string{"2001:db8::/56"},
+					SourceSecurityGroupIDs:   []string{"sg-source-1"},
+					SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupNode},
+				},
+			},
+			expected: IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+				{
+					Description:    "SSH",
+					Protocol:       SecurityGroupProtocolTCP,
+					FromPort:       22,
+					ToPort:         22,
+					IPv6CidrBlocks: []string{"2001:db8::/56"},
+				},
+				{
+					Description:            "SSH",
+					Protocol:               SecurityGroupProtocolTCP,
+					FromPort:               22,
+					ToPort:                 22,
+					SourceSecurityGroupIDs: []string{"sg-source-1"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			out := tc.input.Expand()
+
+			g.Expect(out).To(Equal(tc.expected))
+		})
+	}
+}
@@ -66,6 +66,16 @@ func (b *S3Bucket) Validate() []*field.Error {
 		errs = append(errs, validateS3BucketName(b.Name)...)
 	}
 
+	for k, v := range b.EncryptionContext {
+		path := field.NewPath("spec", "s3Bucket", "encryptionContext")
+		if k == "" {
+			errs = append(errs, field.Invalid(path, k, "keys can't be empty"))
+		}
+		if v == "" {
+			errs = append(errs, field.Required(path.Key(k), "can't be empty"))
+		}
+	}
+
 	return errs
 }
 
@@ -75,6 +75,12 @@ func (t Tags) Merge(other Tags) {
 // Tag's key cannot have prefix "aws:".
 // Max count of User tags for a specific resource can be 50.
 func (t Tags) Validate() []*field.Error {
+	return t.ValidateWithPath(field.NewPath("spec", "additionalTags"))
+}
+
+// ValidateWithPath checks if tags are valid for the AWS API/Resources like Validate, reporting the errors at the
+// given path.
+func (t Tags) ValidateWithPath(fldPath *field.Path) []*field.Error {
 	// Defines the maximum number of user tags which can be created for a specific resource
 	const maxUserTagsAllowed = 50
 	var errs field.ErrorList
@@ -84,41 +90,41 @@ func (t Tags) Validate() []*field.Error {
 	for k, v := range t {
 		if len(k) < 1 {
 			errs = append(errs,
-				field.Invalid(field.NewPath("spec", "additionalTags"), k, "key cannot be empty"),
+				field.Invalid(fldPath, k, "key cannot be empty"),
 			)
 		}
 		if len(k) > 128 {
 			errs = append(errs,
-				field.Invalid(field.NewPath("spec", "additionalTags"), k, "key cannot be longer than 128 characters"),
+				field.Invalid(fldPath, k, "key cannot be longer than 128 characters"),
 			)
 		}
 		if len(v) > 256 {
 			errs = append(errs,
-				field.Invalid(field.NewPath("spec", "additionalTags"), v, "value cannot be longer than 256 characters"),
+				field.Invalid(fldPath, v, "value cannot be longer than 256 characters"),
 			)
 		}
 		if wrongUserTagNomenclature(k) {
 			errs = append(errs,
-				field.Invalid(field.NewPath("spec", "additionalTags"), k, "user created tag's key cannot have prefix aws:"),
+				field.Invalid(fldPath, k, "user created tag's key cannot have prefix aws:"),
 			)
 		}
 		val := re.MatchString(k)
 		if !val {
 			errs = append(errs,
-				field.Invalid(field.NewPath("spec", "additionalTags"), k, "key cannot have characters other than alphabets, numbers, spaces and _ . : / = + - @ ."),
+				field.Invalid(fldPath, k, "key cannot have characters other than alphabets, numbers, spaces and _ . : / = + - @ ."),
 			)
 		}
 		val = re.MatchString(v)
 		if !val {
 			errs = append(errs,
-				field.Invalid(field.NewPath("spec", "additionalTags"), v, "value cannot have characters other than alphabets, numbers, spaces and _ . : / = + - @ ."),
+				field.Invalid(fldPath, v, "value cannot have characters other than alphabets, numbers, spaces and _ . : / = + - @ ."),
 			)
 		}
 	}
 
 	if userTagCount > maxUserTagsAllowed {
 		errs = append(errs,
-			field.Invalid(field.NewPath("spec", "additionalTags"), t, "user created tags cannot be more than 50"),
+			field.Invalid(fldPath, t, "user created tags cannot be more than 50"),
 		)
 	}
 
@@ -190,6 +196,15 @@ const (
 
 	// MachineNameTagKey is the key for machine name.
 	MachineNameTagKey = "MachineName"
+
+	// MachineSetNameTagKey is the key for the name of the MachineSet owning a machine.
+	MachineSetNameTagKey = "MachineSetName"
+
+	// MachineDeploymentNameTagKey is the key for the name of the MachineDeployment owning a machine.
+	MachineDeploymentNameTagKey = "MachineDeploymentName"
+
+	// KarpenterDiscoveryTagKey is the key of the tag Karpenter uses to discover the subnets and security groups of a cluster.
+	KarpenterDiscoveryTagKey = "karpenter.sh/discovery"
 )
 
 // ClusterTagKey generates the key for resources associated with a cluster.
@@ -18,6 +18,7 @@ package v1beta2
 
 import (
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
@@ -49,6 +50,13 @@ type AMIReference struct {
 	// +kubebuilder:validation:Enum:=AmazonLinux;AmazonLinuxGPU
 	// +optional
 	EKSOptimizedLookupType *EKSAMILookupType `json:"eksLookupType,omitempty"`
+
+	// Architecture is the architecture of the image. When set, it is used to look up the image instead of
+	// the architecture supported by the instance type, and must be supported by the instance type. It is
+	// used as is when the instance type is not known or can't be described.
+	// +kubebuilder:validation:Enum:=x86_64;arm64
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
 }
 
 // Filter is a filter used to identify an AWS resource.
@@ -80,6 +88,46 @@ const (
 	ExternalResourceGCTasksAnnotation = "aws.cluster.x-k8s.io/external-resource-tasks-gc"
 )
 
+const (
+	// PauseNetworkAnnotation is the name of an annotation that pauses the reconciliation of the network and the
+	// security groups of the cluster, while the other resources of the cluster are still reconciled. Unlike the
+	// cluster-wide pause of Cluster API, it doesn't pause the deletion of the network.
+	PauseNetworkAnnotation = "aws.cluster.x-k8s.io/pause-network"
+
+	// PauseLoadBalancerAnnotation is the name of an annotation that pauses the reconciliation of the control
+	// plane load balancers of the cluster and of the registration of the control plane instances with them, while
+	// the other resources of the cluster are still reconciled. Unlike the cluster-wide pause of Cluster API, it
+	// doesn't pause the deletion of the load balancers.
+	PauseLoadBalancerAnnotation = "aws.cluster.x-k8s.io/pause-loadbalancer"
+
+	// PauseMachinesAnnotation is the name of an annotation that pauses the reconciliation of the instances of the
+	// AWSMachines and of the Auto Scaling groups of the AWSMachinePools of the cluster, while the other resources
+	// of the cluster are still reconciled. Unlike the cluster-wide pause of Cluster API, it doesn't pause the
+	// deletion of the machines.
+	PauseMachinesAnnotation = "aws.cluster.x-k8s.io/pause-machines"
+)
+
+// TagReconcilePolicyAnnotation is the name of an annotation that sets the TagReconcilePolicy of the instance of
+// an AWSMachine. Set on an AWSCluster or an AWSManagedControlPlane, it sets the TagReconcilePolicy of the network,
+// security group and load balancer resources of the cluster. When it isn't set, the tags are enforced.
+const TagReconcilePolicyAnnotation = "aws.cluster.x-k8s.io/tag-reconcile-policy"
+
+const (
+	// GPURequiredLabel is the name of a label that indicates that the instances of a machine or machine pool
+	// must have GPUs. When it is set to "true", instances are not created with an instance type without GPUs.
+	GPURequiredLabel = "aws.cluster.x-k8s.io/gpu-required"
+)
+
+// IsGPURequired returns whether the GPURequiredLabel is set to "true" in any of the given labels.
+func IsGPURequired(labels ...map[string]string) bool {
+	for _, l := range labels {
+		if l[GPURequiredLabel] == "true" {
+			return true
+		}
+	}
+	return false
+}
+
 type GCTask string
 
 var (
@@ -225,6 +273,18 @@ type Instance struct {
 	// +optional
 	Tenancy string `json:"tenancy,omitempty"`
 
+	// CPUOptions are the CPU options of the instance.
+	// +optional
+	CPUOptions *CPUOptions `json:"cpuOptions,omitempty"`
+
+	// EnclaveOptions indicates whether AWS Nitro Enclaves are enabled on the instance.
+	// +optional
+	EnclaveOptions *bool `json:"enclaveOptions,omitempty"`
+
+	// DisableAPITermination indicates whether the instance was launched with termination protection.
+	// +optional
+	DisableAPITermination *bool `json:"disableApiTermination,omitempty"`
+
 	// IDs of the instance's volumes
 	// +optional
 	VolumeIDs []string `json:"volumeIDs,omitempty"`
@@ -232,6 +292,154 @@ type Instance struct {
 	// InstanceMetadataOptions is the metadata options for the EC2 instance.
 	// +optional
 	InstanceMetadataOptions *InstanceMetadataOptions `json:"instanceMetadataOptions,omitempty"`
+
+	// PrivateDNSName is the hostname type of the instance and the DNS records answering queries for it.
+	// +optional
+	PrivateDNSName *PrivateDNSName `json:"privateDnsName,omitempty"`
+
+	// MaintenanceOptions are the maintenance options of the instance.
+	// +optional
+	MaintenanceOptions *MaintenanceOptions `json:"maintenanceOptions,omitempty"`
+
+	// SourceDestCheck indicates whether the source/destination check of the instance is enabled.
+	// +optional
+	SourceDestCheck *bool `json:"sourceDestCheck,omitempty"`
+}
+
+const (
+	// AutoRecoveryDefault enables the simplified automatic recovery of an instance, when supported by its instance type.
+	AutoRecoveryDefault = "default"
+
+	// AutoRecoveryDisabled disables the simplified automatic recovery of an instance.
+	AutoRecoveryDisabled = "disabled"
+)
+
+// MaintenanceOptions describes the maintenance options of an instance.
+type MaintenanceOptions struct {
+	// AutoRecovery configures the simplified automatic recovery of the instance, which recovers the instance
+	// on another host when its underlying host fails. The default value enables it for the instance types
+	// supporting it.
+	// +optional
+	// +kubebuilder:validation:Enum:=default;disabled
+	AutoRecovery string `json:"autoRecovery,omitempty"`
+}
+
+// Validate checks that the auto recovery setting is one supported by EC2.
+func (obj *MaintenanceOptions) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if obj == nil {
+		return allErrs
+	}
+	switch obj.AutoRecovery {
+	case "", AutoRecoveryDefault, AutoRecoveryDisabled:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("autoRecovery"), obj.AutoRecovery, []string{AutoRecoveryDefault, AutoRecoveryDisabled}))
+	}
+	return allErrs
+}
+
+// ReconcileMode describes how the AWS resources of a cluster are reconciled.
+type ReconcileMode string
+
+const (
+	// ReconcileModeFull creates and updates the AWS resources to match the spec.
+	ReconcileModeFull = ReconcileMode("full")
+
+	// ReconcileModeDetectOnly only reports the AWS resources that don't match the spec, without changing them.
+	ReconcileModeDetectOnly = ReconcileMode("detectOnly")
+)
+
+// TagReconcilePolicy describes how the tags of a resource changed outside of Cluster API are reconciled.
+// The ownership tags Cluster API relies on to find its resources are always reconciled.
+type TagReconcilePolicy string
+
+const (
+	// TagReconcilePolicyEnforce re-applies the desired tags that were removed or changed outside of Cluster API.
+	TagReconcilePolicyEnforce = TagReconcilePolicy("enforce")
+
+	// TagReconcilePolicyObserve only reports the desired tags that were removed or changed outside of Cluster API,
+	// without re-applying them.
+	TagReconcilePolicyObserve = TagReconcilePolicy("observe")
+)
+
+// GetTagReconcilePolicy returns the TagReconcilePolicy set by the TagReconcilePolicyAnnotation of the given
+// annotations, which defaults to enforce.
+func GetTagReconcilePolicy(annotations map[string]string) TagReconcilePolicy {
+	if policy, ok := annotations[TagReconcilePolicyAnnotation]; ok {
+		return TagReconcilePolicy(policy)
+	}
+	return TagReconcilePolicyEnforce
+}
+
+// ValidateTagReconcilePolicyAnnotation checks that the TagReconcilePolicyAnnotation of the given annotations, when
+// set, is a known policy.
+func ValidateTagReconcilePolicyAnnotation(annotations map[string]string) field.ErrorList {
+	value, ok := annotations[TagReconcilePolicyAnnotation]
+	if !ok {
+		return nil
+	}
+	switch TagReconcilePolicy(value) {
+	case TagReconcilePolicyEnforce, TagReconcilePolicyObserve:
+		return nil
+	}
+	return field.ErrorList{
+		field.NotSupported(field.NewPath("metadata", "annotations").Key(TagReconcilePolicyAnnotation), value,
+			[]string{string(TagReconcilePolicyEnforce), string(TagReconcilePolicyObserve)}),
+	}
+}
+
+// OnExternalInstanceDeletion describes what happens when the instance of a machine is deleted outside of Cluster API.
+type OnExternalInstanceDeletion string
+
+const (
+	// OnExternalInstanceDeletionRecreate creates a new instance for the machine.
+	OnExternalInstanceDeletionRecreate = OnExternalInstanceDeletion("Recreate")
+
+	// OnExternalInstanceDeletionFailMachine marks the machine as failed.
+	OnExternalInstanceDeletionFailMachine = OnExternalInstanceDeletion("FailMachine")
+)
+
+// HostnameType describes the type of the hostname of an instance.
+type HostnameType string
+
+var (
+	// HostnameTypeIPName uses the private IPv4 address of the instance in its hostname, e.g. ip-10-0-0-1.
+	HostnameTypeIPName = HostnameType("ip-name")
+
+	// HostnameTypeResourceName uses the ID of the instance in its hostname, e.g. i-0123456789abcdef0.
+	HostnameTypeResourceName = HostnameType("resource-name")
+)
+
+// PrivateDNSName describes the hostname of an instance and the DNS records answering queries for it.
+type PrivateDNSName struct {
+	// HostnameType is the type of hostname to assign to the instance.
+	// +optional
+	// +kubebuilder:validation:Enum:=ip-name;resource-name
+	HostnameType *HostnameType `json:"hostnameType,omitempty"`
+
+	// EnableResourceNameDNSARecord indicates whether to respond to DNS queries for the resource name
+	// hostname of the instance with DNS A records.
+	// +optional
+	EnableResourceNameDNSARecord *bool `json:"enableResourceNameDnsARecord,omitempty"`
+
+	// EnableResourceNameDNSAAAARecord indicates whether to respond to DNS queries for the resource name
+	// hostname of the instance with DNS AAAA records.
+	// +optional
+	EnableResourceNameDNSAAAARecord *bool `json:"enableResourceNameDnsAAAARecord,omitempty"`
+}
+
+// Validate checks that the hostname type is one supported by EC2.
+func (obj *PrivateDNSName) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if obj == nil || obj.HostnameType == nil {
+		return allErrs
+	}
+	switch *obj.HostnameType {
+	case HostnameTypeIPName, HostnameTypeResourceName:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("hostnameType"), *obj.HostnameType, []string{string(HostnameTypeIPName), string(HostnameTypeResourceName)}))
+	}
+	return allErrs
 }
 
 // InstanceMetadataState describes the state of InstanceMetadataOptions.HttpEndpoint and InstanceMetadataOptions.InstanceMetadataTags
@@ -324,6 +532,19 @@ func (obj *InstanceMetadataOptions) SetDefaults() {
 	}
 }
 
+// Validate checks that the metadata options are consistent with each other. Instance tags
+// are served through the metadata endpoint, so they cannot be enabled when it is disabled.
+func (obj *InstanceMetadataOptions) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if obj == nil {
+		return allErrs
+	}
+	if obj.InstanceMetadataTags == InstanceMetadataEndpointStateEnabled && obj.HTTPEndpoint == InstanceMetadataEndpointStateDisabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("instanceMetadataTags"), "instance metadata tags cannot be enabled when httpEndpoint is disabled"))
+	}
+	return allErrs
+}
+
 // Volume encapsulates the configuration options for the storage device.
 type Volume struct {
 	// Device name
@@ -388,6 +609,21 @@ var (
 	)
 )
 
+// CPUOptions defines the number of CPU cores and threads per core of an instance.
+// Fields that are omitted default to the values of the instance type.
+type CPUOptions struct {
+	// CoreCount is the number of CPU cores of the instance.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CoreCount *int64 `json:"coreCount,omitempty"`
+
+	// ThreadsPerCore is the number of threads per CPU core. Set it to 1 to disable hyperthreading.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2
+	// +optional
+	ThreadsPerCore *int64 `json:"threadsPerCore,omitempty"`
+}
+
 // SpotMarketOptions defines the options available to a user when configuring
 // Machines to run on Spot instances.
 // Most users should provide an empty struct.
@@ -303,6 +303,13 @@ func (in *AWSClusterSpec) DeepCopyInto(out *AWSClusterSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.MachineLabelToTag != nil {
+		in, out := &in.MachineLabelToTag, &out.MachineLabelToTag
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.ControlPlaneLoadBalancer != nil {
 		in, out := &in.ControlPlaneLoadBalancer, &out.ControlPlaneLoadBalancer
 		*out = new(AWSLoadBalancerSpec)
@@ -314,11 +321,31 @@ func (in *AWSClusterSpec) DeepCopyInto(out *AWSClusterSpec) {
 		*out = new(AWSIdentityReference)
 		**out = **in
 	}
+	if in.IdentitySelector != nil {
+		in, out := &in.IdentitySelector, &out.IdentitySelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.S3Bucket != nil {
 		in, out := &in.S3Bucket, &out.S3Bucket
 		*out = new(S3Bucket)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodCIDRBlocks != nil {
+		in, out := &in.PodCIDRBlocks, &out.PodCIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EFS != nil {
+		in, out := &in.EFS, &out.EFS
+		*out = new(EFSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicDNS != nil {
+		in, out := &in.PublicDNS, &out.PublicDNS
+		*out = new(PublicDNS)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterSpec.
@@ -421,6 +448,11 @@ func (in *AWSClusterStatus) DeepCopyInto(out *AWSClusterStatus) {
 		*out = new(Instance)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EFS != nil {
+		in, out := &in.EFS, &out.EFS
+		*out = new(EFSStatus)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -559,6 +591,16 @@ func (in *AWSLoadBalancerSpec) DeepCopyInto(out *AWSLoadBalancerSpec) {
 		*out = new(ELBScheme)
 		**out = **in
 	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.Subnets != nil {
 		in, out := &in.Subnets, &out.Subnets
 		*out = make([]string, len(*in))
@@ -586,6 +628,21 @@ func (in *AWSLoadBalancerSpec) DeepCopyInto(out *AWSLoadBalancerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AllowedCIDRBlocks != nil {
+		in, out := &in.AllowedCIDRBlocks, &out.AllowedCIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSLCertificateARN != nil {
+		in, out := &in.SSLCertificateARN, &out.SSLCertificateARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSLPolicy != nil {
+		in, out := &in.SSLPolicy, &out.SSLPolicy
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLoadBalancerSpec.
@@ -738,6 +795,48 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 		*out = new(SpotMarketOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnclaveOptions != nil {
+		in, out := &in.EnclaveOptions, &out.EnclaveOptions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(PrivateDNSName)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FallbackInstanceTypes != nil {
+		in, out := &in.FallbackInstanceTypes, &out.FallbackInstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalBootstrapParameters != nil {
+		in, out := &in.AdditionalBootstrapParameters, &out.AdditionalBootstrapParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EnableDeletionProtection != nil {
+		in, out := &in.EnableDeletionProtection, &out.EnableDeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaintenanceOptions != nil {
+		in, out := &in.MaintenanceOptions, &out.MaintenanceOptions
+		*out = new(MaintenanceOptions)
+		**out = **in
+	}
+	if in.SourceDestCheck != nil {
+		in, out := &in.SourceDestCheck, &out.SourceDestCheck
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineSpec.
@@ -763,6 +862,10 @@ func (in *AWSMachineStatus) DeepCopyInto(out *AWSMachineStatus) {
 		*out = new(InstanceState)
 		**out = **in
 	}
+	if in.InstanceCreationTime != nil {
+		in, out := &in.InstanceCreationTime, &out.InstanceCreationTime
+		*out = (*in).DeepCopy()
+	}
 	if in.FailureReason != nil {
 		in, out := &in.FailureReason, &out.FailureReason
 		*out = new(errors.MachineStatusError)
@@ -780,6 +883,10 @@ func (in *AWSMachineStatus) DeepCopyInto(out *AWSMachineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastFullReconcileTime != nil {
+		in, out := &in.LastFullReconcileTime, &out.LastFullReconcileTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineStatus.
@@ -1038,6 +1145,18 @@ func (in *AWSRoleSpec) DeepCopyInto(out *AWSRoleSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SessionTags != nil {
+		in, out := &in.SessionTags, &out.SessionTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TransitiveTagKeys != nil {
+		in, out := &in.TransitiveTagKeys, &out.TransitiveTagKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSRoleSpec.
@@ -1106,6 +1225,26 @@ func (in *Bastion) DeepCopy() *Bastion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootDiagnostics) DeepCopyInto(out *BootDiagnostics) {
+	*out = *in
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootDiagnostics.
+func (in *BootDiagnostics) DeepCopy() *BootDiagnostics {
+	if in == nil {
+		return nil
+	}
+	out := new(BootDiagnostics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildParams) DeepCopyInto(out *BuildParams) {
 	*out = *in
@@ -1192,6 +1331,31 @@ func (in *CNISpec) DeepCopy() *CNISpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUOptions) DeepCopyInto(out *CPUOptions) {
+	*out = *in
+	if in.CoreCount != nil {
+		in, out := &in.CoreCount, &out.CoreCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ThreadsPerCore != nil {
+		in, out := &in.ThreadsPerCore, &out.ThreadsPerCore
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUOptions.
+func (in *CPUOptions) DeepCopy() *CPUOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClassicELBAttributes) DeepCopyInto(out *ClassicELBAttributes) {
 	*out = *in
@@ -1252,6 +1416,92 @@ func (in *CloudInit) DeepCopy() *CloudInit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFSSpec) DeepCopyInto(out *EFSSpec) {
+	*out = *in
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFSSpec.
+func (in *EFSSpec) DeepCopy() *EFSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EFSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFSStatus) DeepCopyInto(out *EFSStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFSStatus.
+func (in *EFSStatus) DeepCopy() *EFSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EFSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressRule) DeepCopyInto(out *EgressRule) {
+	*out = *in
+	if in.CidrBlocks != nil {
+		in, out := &in.CidrBlocks, &out.CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6CidrBlocks != nil {
+		in, out := &in.IPv6CidrBlocks, &out.IPv6CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationSecurityGroupRoles != nil {
+		in, out := &in.DestinationSecurityGroupRoles, &out.DestinationSecurityGroupRoles
+		*out = make([]SecurityGroupRole, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRule.
+func (in *EgressRule) DeepCopy() *EgressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in EgressRules) DeepCopyInto(out *EgressRules) {
+	{
+		in := &in
+		*out = make(EgressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRules.
+func (in EgressRules) DeepCopy() EgressRules {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressRules)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Filter) DeepCopyInto(out *Filter) {
 	*out = *in
@@ -1272,6 +1522,21 @@ func (in *Filter) DeepCopy() *Filter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowLogsSpec) DeepCopyInto(out *FlowLogsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogsSpec.
+func (in *FlowLogsSpec) DeepCopy() *FlowLogsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowLogsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMPool) DeepCopyInto(out *IPAMPool) {
 	*out = *in
@@ -1455,6 +1720,21 @@ func (in *Instance) DeepCopyInto(out *Instance) {
 		*out = new(SpotMarketOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnclaveOptions != nil {
+		in, out := &in.EnclaveOptions, &out.EnclaveOptions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableAPITermination != nil {
+		in, out := &in.DisableAPITermination, &out.DisableAPITermination
+		*out = new(bool)
+		**out = **in
+	}
 	if in.VolumeIDs != nil {
 		in, out := &in.VolumeIDs, &out.VolumeIDs
 		*out = make([]string, len(*in))
@@ -1465,6 +1745,21 @@ func (in *Instance) DeepCopyInto(out *Instance) {
 		*out = new(InstanceMetadataOptions)
 		**out = **in
 	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(PrivateDNSName)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceOptions != nil {
+		in, out := &in.MaintenanceOptions, &out.MaintenanceOptions
+		*out = new(MaintenanceOptions)
+		**out = **in
+	}
+	if in.SourceDestCheck != nil {
+		in, out := &in.SourceDestCheck, &out.SourceDestCheck
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
@@ -1477,6 +1772,26 @@ func (in *Instance) DeepCopy() *Instance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceConnectEndpointSpec) DeepCopyInto(out *InstanceConnectEndpointSpec) {
+	*out = *in
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceConnectEndpointSpec.
+func (in *InstanceConnectEndpointSpec) DeepCopy() *InstanceConnectEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceConnectEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceMetadataOptions) DeepCopyInto(out *InstanceMetadataOptions) {
 	*out = *in
@@ -1492,6 +1807,21 @@ func (in *InstanceMetadataOptions) DeepCopy() *InstanceMetadataOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KarpenterDiscoverySpec) DeepCopyInto(out *KarpenterDiscoverySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterDiscoverySpec.
+func (in *KarpenterDiscoverySpec) DeepCopy() *KarpenterDiscoverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KarpenterDiscoverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Listener) DeepCopyInto(out *Listener) {
 	*out = *in
@@ -1579,6 +1909,75 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceOptions) DeepCopyInto(out *MaintenanceOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceOptions.
+func (in *MaintenanceOptions) DeepCopy() *MaintenanceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntry) DeepCopyInto(out *NetworkACLEntry) {
+	*out = *in
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntry.
+func (in *NetworkACLEntry) DeepCopy() *NetworkACLEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLSpec) DeepCopyInto(out *NetworkACLSpec) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLSpec.
+func (in *NetworkACLSpec) DeepCopy() *NetworkACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
@@ -1602,6 +2001,31 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.SecurityGroupReconcileStrategies != nil {
+		in, out := &in.SecurityGroupReconcileStrategies, &out.SecurityGroupReconcileStrategies
+		*out = make(map[SecurityGroupRole]SecurityGroupRoleReconcileStrategy, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecurityGroupEgressRules != nil {
+		in, out := &in.SecurityGroupEgressRules, &out.SecurityGroupEgressRules
+		*out = make(map[SecurityGroupRole]EgressRules, len(*in))
+		for key, val := range *in {
+			var outVal []EgressRule
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make(EgressRules, len(*in))
+				for i := range *in {
+					(*in)[i].DeepCopyInto(&(*out)[i])
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.AdditionalControlPlaneIngressRules != nil {
 		in, out := &in.AdditionalControlPlaneIngressRules, &out.AdditionalControlPlaneIngressRules
 		*out = make([]IngressRule, len(*in))
@@ -1609,6 +2033,55 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SubnetCidrSizes != nil {
+		in, out := &in.SubnetCidrSizes, &out.SubnetCidrSizes
+		*out = new(SubnetCidrSizes)
+		**out = **in
+	}
+	if in.AdditionalRoutes != nil {
+		in, out := &in.AdditionalRoutes, &out.AdditionalRoutes
+		*out = make([]RouteSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TransitGatewayAttachment != nil {
+		in, out := &in.TransitGatewayAttachment, &out.TransitGatewayAttachment
+		*out = new(TransitGatewayAttachmentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCPeerings != nil {
+		in, out := &in.VPCPeerings, &out.VPCPeerings
+		*out = make([]VPCPeeringSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkACL != nil {
+		in, out := &in.NetworkACL, &out.NetworkACL
+		*out = new(NetworkACLSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceConnectEndpoint != nil {
+		in, out := &in.InstanceConnectEndpoint, &out.InstanceConnectEndpoint
+		*out = new(InstanceConnectEndpointSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetAvailableIPAddressThreshold != nil {
+		in, out := &in.SubnetAvailableIPAddressThreshold, &out.SubnetAvailableIPAddressThreshold
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KarpenterDiscovery != nil {
+		in, out := &in.KarpenterDiscovery, &out.KarpenterDiscovery
+		*out = new(KarpenterDiscoverySpec)
+		**out = **in
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(FlowLogsSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
@@ -1637,6 +2110,11 @@ func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]SubnetStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
@@ -1649,6 +2127,81 @@ func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateDNSName) DeepCopyInto(out *PrivateDNSName) {
+	*out = *in
+	if in.HostnameType != nil {
+		in, out := &in.HostnameType, &out.HostnameType
+		*out = new(HostnameType)
+		**out = **in
+	}
+	if in.EnableResourceNameDNSARecord != nil {
+		in, out := &in.EnableResourceNameDNSARecord, &out.EnableResourceNameDNSARecord
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableResourceNameDNSAAAARecord != nil {
+		in, out := &in.EnableResourceNameDNSAAAARecord, &out.EnableResourceNameDNSAAAARecord
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSName.
+func (in *PrivateDNSName) DeepCopy() *PrivateDNSName {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateDNSName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicDNS) DeepCopyInto(out *PublicDNS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicDNS.
+func (in *PublicDNS) DeepCopy() *PublicDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
+	*out = *in
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VpcPeeringConnectionID != nil {
+		in, out := &in.VpcPeeringConnectionID, &out.VpcPeeringConnectionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
+func (in *RouteSpec) DeepCopy() *RouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
@@ -1677,6 +2230,18 @@ func (in *S3Bucket) DeepCopyInto(out *S3Bucket) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.EncryptionContext != nil {
+		in, out := &in.EncryptionContext, &out.EncryptionContext
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BootDiagnostics != nil {
+		in, out := &in.BootDiagnostics, &out.BootDiagnostics
+		*out = new(BootDiagnostics)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Bucket.
@@ -1738,6 +2303,21 @@ func (in *SpotMarketOptions) DeepCopy() *SpotMarketOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetCidrSizes) DeepCopyInto(out *SubnetCidrSizes) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetCidrSizes.
+func (in *SubnetCidrSizes) DeepCopy() *SubnetCidrSizes {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetCidrSizes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	*out = *in
@@ -1746,6 +2326,11 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ExistingRouteTableID != nil {
+		in, out := &in.ExistingRouteTableID, &out.ExistingRouteTableID
+		*out = new(string)
+		**out = **in
+	}
 	if in.NatGatewayID != nil {
 		in, out := &in.NatGatewayID, &out.NatGatewayID
 		*out = new(string)
@@ -1770,6 +2355,21 @@ func (in *SubnetSpec) DeepCopy() *SubnetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatus.
+func (in *SubnetStatus) DeepCopy() *SubnetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Subnets) DeepCopyInto(out *Subnets) {
 	{
@@ -1877,6 +2477,51 @@ func (in *TargetGroupSpec) DeepCopy() *TargetGroupSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayAttachmentSpec) DeepCopyInto(out *TransitGatewayAttachmentSpec) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteTableID != nil {
+		in, out := &in.RouteTableID, &out.RouteTableID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayAttachmentSpec.
+func (in *TransitGatewayAttachmentSpec) DeepCopy() *TransitGatewayAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringSpec) DeepCopyInto(out *VPCPeeringSpec) {
+	*out = *in
+	if in.PeerOwnerID != nil {
+		in, out := &in.PeerOwnerID, &out.PeerOwnerID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringSpec.
+func (in *VPCPeeringSpec) DeepCopy() *VPCPeeringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 	*out = *in
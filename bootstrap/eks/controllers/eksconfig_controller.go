@@ -265,7 +265,8 @@ func (r *EKSConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1
 	}
 
 	// we don't want to override any manually set configuration options.
-	if config.Spec.ServiceIPV6Cidr == nil && controlPlane.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
+	// An IPv4 cluster can use an IPv6 VPC, so the IP family of the cluster is checked rather than the VPC.
+	if config.Spec.ServiceIPV6Cidr == nil && controlPlane.Spec.IsIPv6() && controlPlane.Spec.NetworkSpec.VPC.IPv6 != nil {
 		log.Info("Adding ipv6 data to userdata....")
 		nodeInput.ServiceIPV6Cidr = ptr.To[string](controlPlane.Spec.NetworkSpec.VPC.IPv6.CidrBlock)
 		nodeInput.IPFamily = ptr.To[string]("ipv6")
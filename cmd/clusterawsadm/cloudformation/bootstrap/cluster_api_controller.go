@@ -27,7 +27,9 @@ import (
 )
 
 const (
-	eksClusterPolicyName = "AmazonEKSClusterPolicy"
+	eksClusterPolicyName   = "AmazonEKSClusterPolicy"
+	ebsCSIDriverPolicyName = "service-role/AmazonEBSCSIDriverPolicy"
+	efsCSIDriverPolicyName = "service-role/AmazonEFSCSIDriverPolicy"
 )
 
 func (t Template) controllersPolicyGroups() []string {
@@ -85,14 +87,18 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:AllocateIpamPoolCidr",
 				"ec2:AttachNetworkInterface",
 				"ec2:DetachNetworkInterface",
+				"ec2:AcceptVpcPeeringConnection",
 				"ec2:AllocateAddress",
 				"ec2:AssignIpv6Addresses",
 				"ec2:AssignPrivateIpAddresses",
 				"ec2:UnassignPrivateIpAddresses",
 				"ec2:AssociateRouteTable",
+				"ec2:AssociateTransitGatewayRouteTable",
 				"ec2:AttachInternetGateway",
 				"ec2:AuthorizeSecurityGroupIngress",
+				"ec2:AuthorizeSecurityGroupEgress",
 				"ec2:CreateInternetGateway",
+				"ec2:CreateInstanceConnectEndpoint",
 				"ec2:CreateEgressOnlyInternetGateway",
 				"ec2:CreateNatGateway",
 				"ec2:CreateNetworkInterface",
@@ -101,23 +107,42 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:CreateSecurityGroup",
 				"ec2:CreateSubnet",
 				"ec2:CreateTags",
+				"ec2:CreateTransitGatewayVpcAttachment",
 				"ec2:CreateVpc",
 				"ec2:CreateVpcEndpoint",
+				"ec2:CreateVpcPeeringConnection",
 				"ec2:ModifyVpcAttribute",
 				"ec2:ModifyVpcEndpoint",
 				"ec2:DeleteInternetGateway",
+				"ec2:DeleteInstanceConnectEndpoint",
 				"ec2:DeleteEgressOnlyInternetGateway",
 				"ec2:DeleteNatGateway",
+				"ec2:DeleteNetworkInterface",
+				"ec2:DeleteRoute",
 				"ec2:DeleteRouteTable",
 				"ec2:ReplaceRoute",
+				"ec2:ReplaceRouteTableAssociation",
+				"ec2:CreateNetworkAcl",
+				"ec2:CreateNetworkAclEntry",
+				"ec2:DeleteNetworkAcl",
+				"ec2:DeleteNetworkAclEntry",
+				"ec2:DescribeNetworkAcls",
+				"ec2:ReplaceNetworkAclAssociation",
+				"ec2:ReplaceNetworkAclEntry",
+				"ec2:CreateFlowLogs",
+				"ec2:DeleteFlowLogs",
+				"ec2:DescribeFlowLogs",
 				"ec2:DeleteSecurityGroup",
 				"ec2:DeleteSubnet",
 				"ec2:DeleteTags",
+				"ec2:DeleteTransitGatewayVpcAttachment",
 				"ec2:DeleteVpc",
 				"ec2:DeleteVpcEndpoints",
+				"ec2:DeleteVpcPeeringConnection",
 				"ec2:DescribeAccountAttributes",
 				"ec2:DescribeAddresses",
 				"ec2:DescribeAvailabilityZones",
+				"ec2:DescribeInstanceConnectEndpoints",
 				"ec2:DescribeInstances",
 				"ec2:DescribeInstanceTypes",
 				"ec2:DescribeInternetGateways",
@@ -129,20 +154,31 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DescribeNetworkInterfaceAttribute",
 				"ec2:DescribeRouteTables",
 				"ec2:DescribeSecurityGroups",
+				"ec2:DescribeSecurityGroupRules",
 				"ec2:DescribeSubnets",
 				"ec2:DescribeVpcs",
 				"ec2:DescribeVpcAttribute",
 				"ec2:DescribeVpcEndpoints",
+				"ec2:DescribeVpcPeeringConnections",
+				"ec2:DescribeTransitGatewayAttachments",
+				"ec2:DescribeTransitGatewayVpcAttachments",
 				"ec2:DescribeVolumes",
 				"ec2:DescribeTags",
 				"ec2:DetachInternetGateway",
 				"ec2:DisassociateRouteTable",
+				"ec2:DisassociateTransitGatewayRouteTable",
 				"ec2:DisassociateAddress",
+				"ec2:EnableTransitGatewayRouteTablePropagation",
+				"ec2:GetConsoleOutput",
+				"ec2:GetConsoleScreenshot",
+				"ec2:GetSpotPlacementScores",
+				"ec2:GetTransitGatewayAttachmentPropagations",
 				"ec2:ModifyInstanceAttribute",
 				"ec2:ModifyNetworkInterfaceAttribute",
 				"ec2:ModifySubnetAttribute",
 				"ec2:ReleaseAddress",
 				"ec2:RevokeSecurityGroupIngress",
+				"ec2:RevokeSecurityGroupEgress",
 				"ec2:RunInstances",
 				"ec2:TerminateInstances",
 				"tag:GetResources",
@@ -161,15 +197,42 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"elasticloadbalancing:DeregisterInstancesFromLoadBalancer",
 				"elasticloadbalancing:RemoveTags",
 				"elasticloadbalancing:SetSubnets",
+				"elasticloadbalancing:SetSecurityGroups",
 				"elasticloadbalancing:ModifyTargetGroupAttributes",
 				"elasticloadbalancing:CreateTargetGroup",
 				"elasticloadbalancing:DescribeListeners",
 				"elasticloadbalancing:CreateListener",
+				"elasticloadbalancing:ModifyListener",
 				"elasticloadbalancing:DescribeTargetHealth",
 				"elasticloadbalancing:RegisterTargets",
 				"elasticloadbalancing:DeleteListener",
+				"elasticfilesystem:CreateFileSystem",
+				"elasticfilesystem:DeleteFileSystem",
+				"elasticfilesystem:DescribeFileSystems",
+				"elasticfilesystem:CreateMountTarget",
+				"elasticfilesystem:DeleteMountTarget",
+				"elasticfilesystem:DescribeMountTargets",
+				"elasticfilesystem:DescribeMountTargetSecurityGroups",
+				"elasticfilesystem:TagResource",
+				"globalaccelerator:AddEndpoints",
+				"globalaccelerator:RemoveEndpoints",
+				"globalaccelerator:DescribeEndpointGroup",
+				"kms:CreateGrant",
+				"kms:ListGrants",
+				"kms:RevokeGrant",
+				"kms:CreateKey",
+				"kms:CreateAlias",
+				"kms:DeleteAlias",
+				"kms:DescribeKey",
+				"kms:EnableKeyRotation",
+				"kms:ScheduleKeyDeletion",
+				"kms:TagResource",
+				"route53:ChangeResourceRecordSets",
+				"route53:ListResourceRecordSets",
 				"autoscaling:DescribeAutoScalingGroups",
 				"autoscaling:DescribeInstanceRefreshes",
+				"autoscaling:DescribeAutoScalingInstances",
+				"autoscaling:DescribeLifecycleHooks",
 				"ec2:CreateLaunchTemplate",
 				"ec2:CreateLaunchTemplateVersion",
 				"ec2:DescribeLaunchTemplates",
@@ -178,6 +241,8 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DeleteLaunchTemplateVersions",
 				"ec2:DescribeKeyPairs",
 				"ec2:ModifyInstanceMetadataOptions",
+				"ec2:ModifyVolume",
+				"ec2:DescribeVolumesModifications",
 			},
 		},
 		{
@@ -192,6 +257,9 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"autoscaling:StartInstanceRefresh",
 				"autoscaling:DeleteAutoScalingGroup",
 				"autoscaling:DeleteTags",
+				"autoscaling:PutLifecycleHook",
+				"autoscaling:DeleteLifecycleHook",
+				"autoscaling:TerminateInstanceInAutoScalingGroup",
 			},
 		},
 		{
@@ -237,6 +305,32 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"iam:PassRole",
 			},
 		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"arn:*:iam::*:role/*-flow-logs",
+			},
+			Action: iamv1.Actions{
+				"iam:CreateRole",
+				"iam:DeleteRole",
+				"iam:DeleteRolePolicy",
+				"iam:GetRole",
+				"iam:PutRolePolicy",
+				"iam:TagRole",
+			},
+		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"arn:*:iam::*:role/*",
+			},
+			Action: iamv1.Actions{
+				"iam:PassRole",
+			},
+			Condition: iamv1.Conditions{
+				iamv1.StringEquals: map[string]string{"iam:PassedToService": "vpc-flow-logs.amazonaws.com"},
+			},
+		},
 	}
 	for _, secureSecretBackend := range t.Spec.SecureSecretsBackends {
 		switch secureSecretBackend {
@@ -289,6 +383,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"s3:DeleteObject",
 				"s3:PutBucketPolicy",
 				"s3:PutBucketTagging",
+				"s3:ListBucket",
 			},
 		})
 	}
@@ -328,6 +423,9 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 		"iam:GetRole",
 		"iam:ListAttachedRolePolicies",
 	}
+	allowedPolicies := iamv1.Resources{
+		t.generateAWSManagedPolicyARN(eksClusterPolicyName),
+	}
 	statement = append(statement, iamv1.StatementEntry{
 		Effect: iamv1.EffectAllow,
 		Resource: iamv1.Resources{
@@ -385,6 +483,11 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 			"iam:TagRole",
 			"iam:AttachRolePolicy",
 		}...)
+		// The roles of the EBS and EFS CSI driver addons are created when not specified.
+		allowedPolicies = append(allowedPolicies,
+			t.generateAWSManagedPolicyARN(ebsCSIDriverPolicyName),
+			t.generateAWSManagedPolicyARN(efsCSIDriverPolicyName),
+		)
 
 		statement = append(statement, iamv1.StatementEntry{
 			Action: iamv1.Actions{
@@ -395,6 +498,8 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 				"iam:UpdateOpenIDConnectProviderThumbprint",
 				"iam:DeleteOpenIDConnectProvider",
 				"iam:TagOpenIDConnectProvider",
+				"iam:UntagOpenIDConnectProvider",
+				"iam:ListOpenIDConnectProviderTags",
 			},
 			Resource: iamv1.Resources{
 				"*",
@@ -413,10 +518,8 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 			Action: iamv1.Actions{
 				"iam:GetPolicy",
 			},
-			Resource: iamv1.Resources{
-				t.generateAWSManagedPolicyARN(eksClusterPolicyName),
-			},
-			Effect: iamv1.EffectAllow,
+			Resource: allowedPolicies,
+			Effect:   iamv1.EffectAllow,
 		}, {
 			Action: iamv1.Actions{
 				"eks:DescribeCluster",
@@ -457,6 +560,11 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 				"eks:DescribeFargateProfile",
 				"eks:CreateFargateProfile",
 				"eks:DeleteFargateProfile",
+				"eks:ListPodIdentityAssociations",
+				"eks:DescribePodIdentityAssociation",
+				"eks:CreatePodIdentityAssociation",
+				"eks:UpdatePodIdentityAssociation",
+				"eks:DeletePodIdentityAssociation",
 			},
 			Resource: iamv1.Resources{
 				"*",
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -44,12 +45,16 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/efs"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/elb"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/gc"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/route53"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/securitygroup"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	infrautilconditions "sigs.k8s.io/cluster-api-provider-aws/v2/util/conditions"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -59,6 +64,9 @@ import (
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
 
+// DefaultClusterReconcilerRequeue is the default value for the retry of an AWSCluster waiting for its load balancer.
+const DefaultClusterReconcilerRequeue = 15 * time.Second
+
 var defaultAWSSecurityGroupRoles = []infrav1.SecurityGroupRole{
 	infrav1.SecurityGroupAPIServerLB,
 	infrav1.SecurityGroupLB,
@@ -79,6 +87,21 @@ type AWSClusterReconciler struct {
 	ExternalResourceGC           bool
 	AlternativeGCStrategy        bool
 	TagUnmanagedNetworkResources bool
+
+	// UseBlockingWaiters makes the reconcile wait for the NAT gateways to be available and the bastion to be
+	// terminated, instead of requeuing until they are.
+	UseBlockingWaiters bool
+
+	// RequeueInterval is the interval after which an AWSCluster waiting for the DNS name of its load balancer, or
+	// for a long-running AWS operation, is reconciled again. DefaultClusterReconcilerRequeue is used when zero.
+	RequeueInterval time.Duration
+}
+
+func (r *AWSClusterReconciler) requeueInterval() time.Duration {
+	if r.RequeueInterval > 0 {
+		return r.RequeueInterval
+	}
+	return DefaultClusterReconcilerRequeue
 }
 
 // getEC2Service factory func is added for testing purpose so that we can inject mocked EC2Service to the AWSClusterReconciler.
@@ -114,6 +137,12 @@ func securityGroupRolesForCluster(scope scope.ClusterScope) []infrav1.SecurityGr
 	if scope.Bastion().Enabled {
 		roles = append(roles, infrav1.SecurityGroupBastion)
 	}
+	if scope.EFS() != nil {
+		roles = append(roles, infrav1.SecurityGroupEFS)
+	}
+	if scope.InstanceConnectEndpoint() != nil {
+		roles = append(roles, infrav1.SecurityGroupInstanceConnectEndpoint)
+	}
 	return roles
 }
 
@@ -178,6 +207,7 @@ func (r *AWSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		ControllerName:               "awscluster",
 		Endpoints:                    r.Endpoints,
 		TagUnmanagedNetworkResources: r.TagUnmanagedNetworkResources,
+		UseBlockingWaiters:           r.UseBlockingWaiters,
 	})
 	if err != nil {
 		return reconcile.Result{}, errors.Errorf("failed to create scope: %+v", err)
@@ -192,7 +222,14 @@ func (r *AWSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	// Handle deleted clusters
 	if !awsCluster.DeletionTimestamp.IsZero() {
-		return ctrl.Result{}, r.reconcileDelete(ctx, clusterScope)
+		if err := r.reconcileDelete(ctx, clusterScope); err != nil {
+			if wait.IsPending(err) {
+				clusterScope.Info("Waiting for AWS resources to be deleted", "reason", err.Error())
+				return ctrl.Result{RequeueAfter: r.requeueInterval()}, nil
+			}
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
 	}
 
 	// Handle non-deleted clusters
@@ -212,6 +249,10 @@ func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 	networkSvc := r.getNetworkService(*clusterScope)
 	sgService := r.getSecurityGroupService(*clusterScope)
 	s3Service := s3.NewService(clusterScope)
+	efsService := efs.NewService(clusterScope)
+	kmsService := kms.NewService(clusterScope)
+	route53Service := route53.NewService(clusterScope)
+	instanceConnectEndpointService := ec2.NewService(clusterScope)
 
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
 		instancestateSvc := instancestate.NewService(clusterScope)
@@ -234,14 +275,43 @@ func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 		allErrs = append(allErrs, errors.Wrapf(err, "error deleting S3 Bucket"))
 	}
 
-	if err := elbsvc.DeleteLoadbalancers(); err != nil {
+	// The public DNS record is only deleted while it points at the control plane load balancer,
+	// so the load balancer is kept until the record is gone.
+	if err := route53Service.DeletePublicDNSRecord(); err != nil {
+		allErrs = append(allErrs, errors.Wrap(err, "error deleting public DNS record"))
+	} else if err := elbsvc.DeleteLoadbalancers(); err != nil {
 		allErrs = append(allErrs, errors.Wrapf(err, "error deleting load balancers"))
 	}
 
 	if err := ec2svc.DeleteBastion(); err != nil {
+		// The security groups and the network of the bastion can't be deleted until it is terminated.
+		if wait.IsPending(err) {
+			return err
+		}
 		allErrs = append(allErrs, errors.Wrapf(err, "error deleting bastion"))
 	}
 
+	// The instance connect endpoint uses its security group, so it is deleted first.
+	if err := instanceConnectEndpointService.DeleteInstanceConnectEndpoint(); err != nil {
+		allErrs = append(allErrs, errors.Wrap(err, "error deleting instance connect endpoint"))
+	}
+
+	// The mount targets of the EFS file system use the EFS security group, so they are deleted first.
+	if err := efsService.DeleteEFS(); err != nil {
+		if wait.IsPending(err) {
+			return err
+		}
+		allErrs = append(allErrs, errors.Wrap(err, "error deleting EFS file system"))
+	}
+
+	if err := kmsService.DeleteEBSEncryptionKeyGrant(); err != nil {
+		allErrs = append(allErrs, errors.Wrap(err, "error revoking EBS encryption key grant"))
+	}
+
+	if err := kmsService.DeleteManagedEncryptionKey(); err != nil {
+		allErrs = append(allErrs, errors.Wrap(err, "error deleting managed EBS encryption key"))
+	}
+
 	if err := sgService.DeleteSecurityGroups(); err != nil {
 		allErrs = append(allErrs, errors.Wrap(err, "error deleting security groups"))
 	}
@@ -263,6 +333,7 @@ func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 
 	// Cluster is deleted so remove the finalizer.
 	controllerutil.RemoveFinalizer(clusterScope.AWSCluster, infrav1.ClusterFinalizer)
+	scope.DeleteConcurrencyGate(clusterScope)
 	return nil
 }
 
@@ -284,8 +355,32 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 	networkSvc := r.getNetworkService(*clusterScope)
 	sgService := r.getSecurityGroupService(*clusterScope)
 	s3Service := s3.NewService(clusterScope)
+	efsService := efs.NewService(clusterScope)
+	kmsService := kms.NewService(clusterScope)
+	route53Service := route53.NewService(clusterScope)
+	instanceConnectEndpointService := ec2.NewService(clusterScope)
+
+	if clusterScope.ReconcileMode() == infrav1.ReconcileModeDetectOnly {
+		return reconcile.Result{}, scope.DetectDrift(clusterScope, []scope.ResourceReconciler{
+			{Name: "network", Reconcile: networkSvc.ReconcileNetwork},
+			{Name: "security groups", Reconcile: sgService.ReconcileSecurityGroups},
+			{Name: "bastion", Reconcile: ec2Service.ReconcileBastion},
+			{Name: "instance connect endpoint", Reconcile: instanceConnectEndpointService.ReconcileInstanceConnectEndpoint},
+			{Name: "load balancers", Reconcile: elbService.ReconcileLoadbalancers},
+			{Name: "S3 bucket", Reconcile: s3Service.ReconcileBucket},
+			{Name: "EFS file system", Reconcile: efsService.ReconcileEFS},
+			{Name: "managed EBS encryption key", Reconcile: kmsService.ReconcileManagedEncryptionKey},
+			{Name: "EBS encryption key grant", Reconcile: kmsService.ReconcileEBSEncryptionKeyGrant},
+			{Name: "public DNS record", Reconcile: route53Service.ReconcilePublicDNSRecord},
+		})
+	}
+	conditions.Delete(awsCluster, infrav1.ResourcesInSyncCondition)
 
 	if err := networkSvc.ReconcileNetwork(); err != nil {
+		if wait.IsPending(err) {
+			clusterScope.Info("Waiting for network resources to be available", "reason", err.Error())
+			return reconcile.Result{RequeueAfter: r.requeueInterval()}, nil
+		}
 		clusterScope.Error(err, "failed to reconcile network")
 		return reconcile.Result{}, err
 	}
@@ -297,11 +392,21 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 	}
 
 	if err := ec2Service.ReconcileBastion(); err != nil {
+		if wait.IsPending(err) {
+			clusterScope.Info("Waiting for bastion host to be terminated", "reason", err.Error())
+			return reconcile.Result{RequeueAfter: r.requeueInterval()}, nil
+		}
 		conditions.MarkFalse(awsCluster, infrav1.BastionHostReadyCondition, infrav1.BastionHostFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), err.Error())
 		clusterScope.Error(err, "failed to reconcile bastion host")
 		return reconcile.Result{}, err
 	}
 
+	if err := instanceConnectEndpointService.ReconcileInstanceConnectEndpoint(); err != nil {
+		conditions.MarkFalse(awsCluster, infrav1.InstanceConnectEndpointReadyCondition, infrav1.InstanceConnectEndpointFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), err.Error())
+		clusterScope.Error(err, "failed to reconcile instance connect endpoint")
+		return reconcile.Result{}, err
+	}
+
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
 		instancestateSvc := instancestate.NewService(clusterScope)
 		if err := instancestateSvc.ReconcileEC2Events(); err != nil {
@@ -315,16 +420,50 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 		conditions.MarkFalse(awsCluster, infrav1.LoadBalancerReadyCondition, infrav1.LoadBalancerFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), err.Error())
 		return reconcile.Result{}, err
 	}
+	scope.MarkResourceTagsSynced(clusterScope)
 
 	if err := s3Service.ReconcileBucket(); err != nil {
 		conditions.MarkFalse(awsCluster, infrav1.S3BucketReadyCondition, infrav1.S3BucketFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile S3 Bucket for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
 	}
 
+	if err := efsService.ReconcileEFS(); err != nil {
+		if wait.IsPending(err) {
+			clusterScope.Info("Waiting for EFS file system to be available", "reason", err.Error())
+			conditions.MarkFalse(awsCluster, infrav1.EFSReadyCondition, infrav1.EFSCreatingReason, clusterv1.ConditionSeverityInfo, err.Error())
+			return reconcile.Result{RequeueAfter: r.requeueInterval()}, nil
+		}
+		conditions.MarkFalse(awsCluster, infrav1.EFSReadyCondition, infrav1.EFSFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile EFS file system for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+	if clusterScope.EFS() != nil {
+		conditions.MarkTrue(awsCluster, infrav1.EFSReadyCondition)
+	}
+
+	if err := kmsService.ReconcileManagedEncryptionKey(); err != nil {
+		conditions.MarkFalse(awsCluster, infrav1.ManagedEncryptionKeyReadyCondition, infrav1.ManagedEncryptionKeyFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile managed EBS encryption key for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+	if clusterScope.ManagedEncryptionKey() {
+		conditions.MarkTrue(awsCluster, infrav1.ManagedEncryptionKeyReadyCondition)
+	}
+
+	if err := kmsService.ReconcileEBSEncryptionKeyGrant(); err != nil {
+		reason := infrav1.EBSEncryptionKeyGrantFailedReason
+		if kms.IsPermissionDenied(err) {
+			reason = infrav1.EBSEncryptionKeyGrantPermissionDeniedReason
+		}
+		conditions.MarkFalse(awsCluster, infrav1.EBSEncryptionKeyGrantReadyCondition, reason, clusterv1.ConditionSeverityError, err.Error())
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile EBS encryption key grant for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+	if clusterScope.EBSEncryptionKeyARN() != "" {
+		conditions.MarkTrue(awsCluster, infrav1.EBSEncryptionKeyGrantReadyCondition)
+	}
+
 	if awsCluster.Status.Network.APIServerELB.DNSName == "" {
 		conditions.MarkFalse(awsCluster, infrav1.LoadBalancerReadyCondition, infrav1.WaitForDNSNameReason, clusterv1.ConditionSeverityInfo, "")
 		clusterScope.Info("Waiting on API server ELB DNS name")
-		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		return reconcile.Result{RequeueAfter: r.requeueInterval()}, nil
 	}
 
 	clusterScope.Debug("looking up IP address for DNS", "dns", awsCluster.Status.Network.APIServerELB.DNSName)
@@ -332,12 +471,20 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 		clusterScope.Error(err, "failed to get IP address for dns name", "dns", awsCluster.Status.Network.APIServerELB.DNSName)
 		conditions.MarkFalse(awsCluster, infrav1.LoadBalancerReadyCondition, infrav1.WaitForDNSNameResolveReason, clusterv1.ConditionSeverityInfo, "")
 		clusterScope.Info("Waiting on API server ELB DNS name to resolve")
-		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		return reconcile.Result{RequeueAfter: r.requeueInterval()}, nil
 	}
 	conditions.MarkTrue(awsCluster, infrav1.LoadBalancerReadyCondition)
 
+	if err := route53Service.ReconcilePublicDNSRecord(); err != nil {
+		conditions.MarkFalse(awsCluster, infrav1.PublicDNSReadyCondition, infrav1.PublicDNSFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile public DNS record for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+	if clusterScope.PublicDNS() != nil {
+		conditions.MarkTrue(awsCluster, infrav1.PublicDNSReadyCondition)
+	}
+
 	awsCluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{
-		Host: awsCluster.Status.Network.APIServerELB.DNSName,
+		Host: controlPlaneEndpointHost(awsCluster),
 		Port: clusterScope.APIServerPort(),
 	}
 
@@ -359,6 +506,15 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 	return reconcile.Result{}, nil
 }
 
+// controlPlaneEndpointHost returns the record name of the public DNS record if configured,
+// the DNS name of the control plane load balancer otherwise.
+func controlPlaneEndpointHost(awsCluster *infrav1.AWSCluster) string {
+	if awsCluster.Spec.PublicDNS != nil {
+		return strings.TrimSuffix(awsCluster.Spec.PublicDNS.RecordName, ".")
+	}
+	return awsCluster.Status.Network.APIServerELB.DNSName
+}
+
 func (r *AWSClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
 	log := logger.FromContext(ctx)
 	controller, err := ctrl.NewControllerManagedBy(mgr).
@@ -830,6 +830,21 @@ func mockedDeleteVPCCalls(m *mocks.MockEC2APIMockRecorder) {
 	m.ReleaseAddressWithContext(context.TODO(), &ec2.ReleaseAddressInput{
 		AllocationId: aws.String("1234"),
 	})
+	m.DescribeNetworkInterfacesPagesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{"vpc-exists"}),
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: aws.StringSlice([]string{"owned"}),
+			},
+			{
+				Name:   aws.String("status"),
+				Values: aws.StringSlice([]string{ec2.NetworkInterfaceStatusAvailable}),
+			},
+		}}), gomock.Any()).Return(nil)
 	m.DescribeVpcsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcsInput{
 		VpcIds: []*string{
 			aws.String("vpc-exists"),
@@ -1039,4 +1054,7 @@ func mockedCreateSGCalls(recordLBV2 bool, m *mocks.MockEC2APIMockRecorder) {
 			Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil).
 			After(securityGroupNode).Times(1)
 	}
+	// The egress rules of the security groups are described to check whether their default egress rules need to be restored.
+	m.DescribeSecurityGroupsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+		Return(&ec2.DescribeSecurityGroupsOutput{}, nil).AnyTimes()
 }
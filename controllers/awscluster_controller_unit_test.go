@@ -23,6 +23,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -35,12 +37,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestAWSClusterReconcilerReconcile(t *testing.T) {
@@ -247,6 +252,85 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				expectAWSClusterConditions(g, cs.AWSCluster, []conditionAssertion{{infrav1.LoadBalancerReadyCondition, corev1.ConditionTrue, "", ""}})
 				g.Expect(awsCluster.GetFinalizers()).To(ContainElement(infrav1.ClusterFinalizer))
 			})
+			t.Run("Should set the port of the control plane endpoint to the port of the control plane load balancer", func(t *testing.T) {
+				g := NewWithT(t)
+				awsCluster := getAWSCluster("test", "test")
+				awsCluster.Spec.ControlPlaneLoadBalancer = &infrav1.AWSLoadBalancerSpec{Port: aws.Int64(8443)}
+				csClient := setup(t, &awsCluster)
+				defer teardown()
+				ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+				elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
+				networkSvc.EXPECT().ReconcileNetwork().Return(nil)
+				sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
+				cs, err := scope.NewClusterScope(
+					scope.ClusterScopeParams{
+						Client:     csClient,
+						Cluster:    &clusterv1.Cluster{},
+						AWSCluster: &awsCluster,
+					},
+				)
+				g.Expect(err).To(BeNil())
+				awsCluster.Status.Network.APIServerELB.DNSName = DNSName
+				_, err = reconciler.reconcileNormal(cs)
+				g.Expect(err).To(BeNil())
+				g.Expect(awsCluster.Spec.ControlPlaneEndpoint.Port).To(Equal(int32(8443)))
+			})
+			t.Run("Should report the drifted resources of an AWSCluster in the detectOnly reconcile mode", func(t *testing.T) {
+				g := NewWithT(t)
+				awsCluster := getAWSCluster("test", "test")
+				awsCluster.Spec.ReconcileMode = infrav1.ReconcileModeDetectOnly
+				csClient := setup(t, &awsCluster)
+				defer teardown()
+				cs, err := scope.NewClusterScope(
+					scope.ClusterScopeParams{
+						Client:     csClient,
+						Cluster:    &clusterv1.Cluster{},
+						AWSCluster: &awsCluster,
+					},
+				)
+				g.Expect(err).To(BeNil())
+				// The skipped operations are recorded by the AWS clients of the services.
+				networkSvc.EXPECT().ReconcileNetwork().DoAndReturn(func() error {
+					cs.RecordDrift("ec2 ModifyVpcAttribute")
+					cs.RecordDrift("ec2 CreateTags")
+					cs.RecordDrift("ec2 CreateTags")
+					cs.RecordDrift("ec2 CreateNatGateway")
+					return fmt.Errorf("failed to create nat gateway: %w", awserr.New(awserrors.DetectOnly, "ec2 CreateNatGateway is not called in the detectOnly reconcile mode", nil))
+				})
+				sgSvc.EXPECT().ReconcileSecurityGroups().DoAndReturn(func() error {
+					cs.RecordDrift("ec2 AuthorizeSecurityGroupIngress")
+					return nil
+				})
+				ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+				elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
+				_, err = reconciler.reconcileNormal(cs)
+				g.Expect(err).To(BeNil())
+				expectAWSClusterConditions(g, cs.AWSCluster, []conditionAssertion{{infrav1.ResourcesInSyncCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityWarning, infrav1.ResourcesDriftedReason}})
+				g.Expect(conditions.GetMessage(cs.AWSCluster, infrav1.ResourcesInSyncCondition)).To(Equal("network: ec2 CreateNatGateway, ec2 CreateTags, ec2 ModifyVpcAttribute; security groups: ec2 AuthorizeSecurityGroupIngress"))
+				g.Expect(conditions.Has(cs.AWSCluster, infrav1.LoadBalancerReadyCondition)).To(BeFalse())
+			})
+			t.Run("Should mark the resources of an AWSCluster in sync in the detectOnly reconcile mode when nothing drifted", func(t *testing.T) {
+				g := NewWithT(t)
+				awsCluster := getAWSCluster("test", "test")
+				awsCluster.Spec.ReconcileMode = infrav1.ReconcileModeDetectOnly
+				csClient := setup(t, &awsCluster)
+				defer teardown()
+				networkSvc.EXPECT().ReconcileNetwork().Return(nil)
+				sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
+				ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+				elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
+				cs, err := scope.NewClusterScope(
+					scope.ClusterScopeParams{
+						Client:     csClient,
+						Cluster:    &clusterv1.Cluster{},
+						AWSCluster: &awsCluster,
+					},
+				)
+				g.Expect(err).To(BeNil())
+				_, err = reconciler.reconcileNormal(cs)
+				g.Expect(err).To(BeNil())
+				expectAWSClusterConditions(g, cs.AWSCluster, []conditionAssertion{{infrav1.ResourcesInSyncCondition, corev1.ConditionTrue, "", ""}})
+			})
 		})
 		t.Run("Reconcile failure", func(t *testing.T) {
 			expectedErr := errors.New("failed to get resource")
@@ -270,6 +354,27 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				_, err = reconciler.reconcileNormal(cs)
 				g.Expect(err).Should(Equal(expectedErr))
 			})
+			t.Run("Should requeue AWSCluster create while network resources are pending", func(t *testing.T) {
+				g := NewWithT(t)
+				awsCluster := getAWSCluster("test", "test")
+				runningCluster := func() {
+					networkSvc.EXPECT().ReconcileNetwork().Return(wait.NewPending("NAT gateways nat-1 are not available yet"))
+				}
+				csClient := setup(t, &awsCluster)
+				defer teardown()
+				runningCluster()
+				cs, err := scope.NewClusterScope(
+					scope.ClusterScopeParams{
+						Client:     csClient,
+						Cluster:    &clusterv1.Cluster{},
+						AWSCluster: &awsCluster,
+					},
+				)
+				g.Expect(err).To(BeNil())
+				result, err := reconciler.reconcileNormal(cs)
+				g.Expect(err).To(BeNil())
+				g.Expect(result.RequeueAfter).To(Equal(DefaultClusterReconcilerRequeue))
+			})
 			t.Run("Should fail AWSCluster create with ClusterSecurityGroupsReadyCondition status false", func(t *testing.T) {
 				g := NewWithT(t)
 				awsCluster := getAWSCluster("test", "test")
@@ -363,6 +468,35 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g.Expect(err).To(BeNil())
 				expectAWSClusterConditions(g, cs.AWSCluster, []conditionAssertion{{infrav1.LoadBalancerReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrav1.WaitForDNSNameReason}})
 			})
+			t.Run("Should requeue AWSCluster waiting for the DNS name of the LoadBalancer after the requeue interval", func(t *testing.T) {
+				g := NewWithT(t)
+				awsCluster := getAWSCluster("test", "test")
+				runningCluster := func() {
+					networkSvc.EXPECT().ReconcileNetwork().Return(nil).Times(2)
+					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil).Times(2)
+					ec2Svc.EXPECT().ReconcileBastion().Return(nil).Times(2)
+					elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil).Times(2)
+				}
+				csClient := setup(t, &awsCluster)
+				defer teardown()
+				runningCluster()
+				cs, err := scope.NewClusterScope(
+					scope.ClusterScopeParams{
+						Client:     csClient,
+						Cluster:    &clusterv1.Cluster{},
+						AWSCluster: &awsCluster,
+					},
+				)
+				g.Expect(err).To(BeNil())
+				res, err := reconciler.reconcileNormal(cs)
+				g.Expect(err).To(BeNil())
+				g.Expect(res.RequeueAfter).To(Equal(DefaultClusterReconcilerRequeue))
+
+				reconciler.RequeueInterval = time.Minute
+				res, err = reconciler.reconcileNormal(cs)
+				g.Expect(err).To(BeNil())
+				g.Expect(res.RequeueAfter).To(Equal(time.Minute))
+			})
 			t.Run("Should fail AWSCluster create with LoadBalancer reconcile failure with WaitForDNSNameResolve condition as false", func(t *testing.T) {
 				g := NewWithT(t)
 				awsCluster := getAWSCluster("test", "test")
@@ -644,6 +778,8 @@ func TestSecurityGroupRolesForCluster(t *testing.T) {
 	tests := []struct {
 		name           string
 		bastionEnabled bool
+		efs            *infrav1.EFSSpec
+		endpoint       *infrav1.InstanceConnectEndpointSpec
 		want           []infrav1.SecurityGroupRole
 	}{
 		{
@@ -656,6 +792,18 @@ func TestSecurityGroupRolesForCluster(t *testing.T) {
 			bastionEnabled: false,
 			want:           defaultAWSSecurityGroupRoles,
 		},
+		{
+			name:           "Should use EFS security group when EFS is configured",
+			bastionEnabled: false,
+			efs:            &infrav1.EFSSpec{},
+			want:           append(defaultAWSSecurityGroupRoles, infrav1.SecurityGroupEFS),
+		},
+		{
+			name:           "Should use instance connect endpoint security group when the endpoint is configured",
+			bastionEnabled: false,
+			endpoint:       &infrav1.InstanceConnectEndpointSpec{},
+			want:           append(defaultAWSSecurityGroupRoles, infrav1.SecurityGroupInstanceConnectEndpoint),
+		},
 	}
 
 	for _, tt := range tests {
@@ -664,6 +812,8 @@ func TestSecurityGroupRolesForCluster(t *testing.T) {
 
 			c := getAWSCluster("test", "test")
 			c.Spec.Bastion.Enabled = tt.bastionEnabled
+			c.Spec.EFS = tt.efs
+			c.Spec.NetworkSpec.InstanceConnectEndpoint = tt.endpoint
 			s, err := getClusterScope(c)
 			g.Expect(err).To(BeNil(), "failed to create cluster scope for test")
 
@@ -672,3 +822,36 @@ func TestSecurityGroupRolesForCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestControlPlaneEndpointHost(t *testing.T) {
+	tests := []struct {
+		name      string
+		publicDNS *infrav1.PublicDNS
+		want      string
+	}{
+		{
+			name: "Should use the load balancer DNS name when no public DNS is configured",
+			want: "test-apiserver.elb.us-east-1.amazonaws.com",
+		},
+		{
+			name: "Should use the public DNS record name when public DNS is configured",
+			publicDNS: &infrav1.PublicDNS{
+				HostedZoneID: "Z0123456789ABCDEFGHIJ",
+				RecordName:   "api.mycluster.example.com.",
+			},
+			want: "api.mycluster.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := getAWSCluster("test", "test")
+			c.Spec.PublicDNS = tt.publicDNS
+			c.Status.Network.APIServerELB.DNSName = "test-apiserver.elb.us-east-1.amazonaws.com"
+
+			g.Expect(controlPlaneEndpointHost(&c)).To(Equal(tt.want))
+		})
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
@@ -47,9 +48,12 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
+	capaannotations "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	asg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/elb"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
@@ -72,6 +76,14 @@ const (
 
 	// DefaultReconcilerRequeue is the default value for the reconcile retry.
 	DefaultReconcilerRequeue = 30 * time.Second
+
+	// DefaultInstanceNotFoundGracePeriod is the default period after the creation of an instance during which it
+	// not being returned by the EC2 APIs is not considered a deletion.
+	DefaultInstanceNotFoundGracePeriod = 5 * time.Minute
+
+	// DefaultBootDiagnosticsGracePeriod is the default period a control plane instance has to become a node before its
+	// boot diagnostics are captured, when enabled.
+	DefaultBootDiagnosticsGracePeriod = 15 * time.Minute
 )
 
 // AWSMachineReconciler reconciles a AwsMachine object.
@@ -80,6 +92,7 @@ type AWSMachineReconciler struct {
 	Log                          logr.Logger
 	Recorder                     record.EventRecorder
 	ec2ServiceFactory            func(scope.EC2Scope) services.EC2Interface
+	asgServiceFactory            func(cloud.ClusterScoper) services.ASGInterface
 	elbServiceFactory            func(scope.ELBScope) services.ELBInterface
 	secretsManagerServiceFactory func(cloud.ClusterScoper) services.SecretInterface
 	SSMServiceFactory            func(cloud.ClusterScoper) services.SecretInterface
@@ -87,6 +100,19 @@ type AWSMachineReconciler struct {
 	Endpoints                    []scope.ServiceEndpoint
 	WatchFilterValue             string
 	TagUnmanagedNetworkResources bool
+
+	// RequeueInterval is the interval after which an AWSMachine waiting for its instance to be running is
+	// reconciled again. DefaultReconcilerRequeue is used when zero.
+	RequeueInterval time.Duration
+
+	// FullReconcilePeriod enables a fast path for AWSMachines in steady state, skipping the calls to the AWS APIs until
+	// the AWSMachine changes or its last full reconcile is older than the period. The fast path is disabled when zero.
+	FullReconcilePeriod time.Duration
+
+	// InstanceNotFoundGracePeriod is the period after the creation of an instance during which the EC2 APIs not
+	// returning it is attributed to their eventual consistency, and the AWSMachine is requeued instead of considering
+	// the instance deleted. A missing instance is never waited for when zero.
+	InstanceNotFoundGracePeriod time.Duration
 }
 
 const (
@@ -94,6 +120,13 @@ const (
 	AWSManagedControlPlaneRefKind = "AWSManagedControlPlane"
 )
 
+func (r *AWSMachineReconciler) requeueInterval() time.Duration {
+	if r.RequeueInterval > 0 {
+		return r.RequeueInterval
+	}
+	return DefaultReconcilerRequeue
+}
+
 func (r *AWSMachineReconciler) getEC2Service(scope scope.EC2Scope) services.EC2Interface {
 	if r.ec2ServiceFactory != nil {
 		return r.ec2ServiceFactory(scope)
@@ -102,6 +135,14 @@ func (r *AWSMachineReconciler) getEC2Service(scope scope.EC2Scope) services.EC2I
 	return ec2.NewService(scope)
 }
 
+func (r *AWSMachineReconciler) getASGService(scope cloud.ClusterScoper) services.ASGInterface {
+	if r.asgServiceFactory != nil {
+		return r.asgServiceFactory(scope)
+	}
+
+	return asg.NewService(scope)
+}
+
 func (r *AWSMachineReconciler) getSecretsManagerService(scope cloud.ClusterScoper) services.SecretInterface {
 	if r.secretsManagerServiceFactory != nil {
 		return r.secretsManagerServiceFactory(scope)
@@ -307,6 +348,10 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 	}
 
 	instance, err := r.findInstance(machineScope, ec2Service)
+	if errors.Is(err, errInstanceNotFoundInGracePeriod) {
+		machineScope.Info("Recently created EC2 instance not found yet, requeueing", "instance-id", *machineScope.GetInstanceID())
+		return ctrl.Result{RequeueAfter: r.requeueInterval()}, nil
+	}
 	if err != nil && err != ec2.ErrInstanceNotFoundByID {
 		machineScope.Error(err, "query to find instance failed")
 		return ctrl.Result{}, err
@@ -337,7 +382,20 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 		}
 	}
 
-	if machineScope.IsControlPlane() {
+	if machineScope.IsControlPlane() && !capaannotations.Has(elbScope.InfraCluster(), infrav1.PauseLoadBalancerAnnotation) {
+		// In-flight requests to the API server would fail if the instance was terminated while
+		// the load balancer is still draining connections to it, so wait for the deregistration to complete.
+		draining, err := r.isInstanceDrainingFromLB(machineScope, elbScope, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if draining {
+			machineScope.Info("Waiting for EC2 instance to be deregistered from load balancer before terminating it", "instance-id", instance.ID)
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.ELBAttachedCondition, infrav1.DeregisteringFromLoadBalancerReason, clusterv1.ConditionSeverityInfo,
+				"Waiting for the deregistration delay of the load balancer target groups to elapse")
+			return ctrl.Result{RequeueAfter: r.requeueInterval()}, nil
+		}
+
 		conditions.MarkFalse(machineScope.AWSMachine, infrav1.ELBAttachedCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
 	}
 
@@ -369,7 +427,32 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 			return ctrl.Result{}, err
 		}
 
-		if err := ec2Service.TerminateInstance(instance.ID); err != nil {
+		if machineScope.IsControlPlane() && aws.BoolValue(machineScope.AWSMachine.Spec.EnableDeletionProtection) {
+			if err := ec2Service.DisableInstanceDeletionProtection(instance.ID); err != nil {
+				machineScope.Error(err, "failed to disable instance deletion protection")
+				conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+				r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedTerminate", "Failed to disable deletion protection of instance %q: %v", instance.ID, err)
+				return ctrl.Result{}, err
+			}
+		}
+
+		// An instance launched by an ASG, like the instances of a machine pool, is terminated through the ASG with
+		// its desired capacity decremented, otherwise the ASG would immediately launch an instance replacing it.
+		// The instance is terminated directly when it is no longer part of the ASG, for example because it was
+		// detached from it.
+		terminateInstance := ec2Service.TerminateInstance
+		if asgName := instance.Tags[asg.GroupNameTagKey]; asgName != "" {
+			machineScope.Info("Terminating EC2 instance through its AutoScalingGroup", "instance-id", instance.ID, "asg", asgName)
+			terminateInstance = func(instanceID string) error {
+				err := r.getASGService(clusterScope).TerminateInstanceAndDecrementCapacity(instanceID)
+				if awserrors.IsNotFound(err) {
+					machineScope.Info("EC2 instance is no longer part of its AutoScalingGroup, terminating it directly", "instance-id", instanceID, "asg", asgName)
+					return ec2Service.TerminateInstance(instanceID)
+				}
+				return err
+			}
+		}
+		if err := terminateInstance(instance.ID); err != nil {
 			machineScope.Error(err, "failed to terminate instance")
 			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
 			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedTerminate", "Failed to terminate instance %q: %v", instance.ID, err)
@@ -414,6 +497,20 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 	}
 }
 
+// errInstanceNotFoundInGracePeriod is returned by findInstance when the instance of the AWSMachine can't be found
+// within the instance not found grace period after its creation.
+var errInstanceNotFoundInGracePeriod = errors.New("recently created instance not found")
+
+// instanceInCreationGracePeriod returns true if the instance of the AWSMachine was created less than the instance not
+// found grace period ago, in which case the EC2 APIs may not return it yet because of their eventual consistency.
+func (r *AWSMachineReconciler) instanceInCreationGracePeriod(machineScope *scope.MachineScope) bool {
+	creationTime := machineScope.AWSMachine.Status.InstanceCreationTime
+	if r.InstanceNotFoundGracePeriod <= 0 || creationTime == nil || machineScope.GetInstanceID() == nil {
+		return false
+	}
+	return time.Since(creationTime.Time) < r.InstanceNotFoundGracePeriod
+}
+
 // findInstance queries the EC2 apis and retrieves the instance if it exists.
 // If providerID is empty, finds instance by tags and if it cannot be found, returns empty instance with nil error.
 // If providerID is set, either finds the instance by ID or returns error.
@@ -433,11 +530,17 @@ func (r *AWSMachineReconciler) findInstance(machineScope *scope.MachineScope, ec
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to query AWSMachine instance by tags")
 		}
+		if instance == nil && r.instanceInCreationGracePeriod(machineScope) {
+			return nil, errInstanceNotFoundInGracePeriod
+		}
 	} else {
 		// If the ProviderID is populated, describe the instance using the ID.
 		// InstanceIfExists() returns error (ErrInstanceNotFoundByID or ErrDescribeInstance) if the instance could not be found.
 		//nolint:staticcheck
 		instance, err = ec2svc.InstanceIfExists(ptr.To[string](pid.ID()))
+		if errors.Is(err, ec2.ErrInstanceNotFoundByID) && r.instanceInCreationGracePeriod(machineScope) {
+			return nil, errInstanceNotFoundInGracePeriod
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -447,6 +550,41 @@ func (r *AWSMachineReconciler) findInstance(machineScope *scope.MachineScope, ec
 	return instance, nil
 }
 
+// instanceDeletedExternally returns whether the instance recorded for the AWSMachine was deleted outside of Cluster API,
+// given the result of findInstance.
+func instanceDeletedExternally(instance *infrav1.Instance, findErr error) bool {
+	if errors.Is(findErr, ec2.ErrInstanceNotFoundByID) {
+		return true
+	}
+	return findErr == nil && instance != nil && instance.State == infrav1.InstanceStateTerminated
+}
+
+// recreateExternallyDeletedInstance forgets the instance recorded for the AWSMachine, so that a new instance is created.
+func (r *AWSMachineReconciler) recreateExternallyDeletedInstance(machineScope *scope.MachineScope) {
+	instanceID := ptr.Deref(machineScope.GetInstanceID(), "")
+	machineScope.Info("EC2 instance was deleted externally, creating a new instance", "instance-id", instanceID)
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "InstanceDeletedExternally", "EC2 instance %q was deleted externally, creating a new instance", instanceID)
+
+	machineScope.AWSMachine.Spec.ProviderID = nil
+	machineScope.AWSMachine.Spec.InstanceID = nil
+	machineScope.AWSMachine.Status.InstanceState = nil
+	machineScope.AWSMachine.Status.Addresses = nil
+	machineScope.SetNotReady()
+}
+
+// failExternallyDeletedInstance marks the AWSMachine as failed, so that it is replaced by its owner.
+func (r *AWSMachineReconciler) failExternallyDeletedInstance(machineScope *scope.MachineScope) {
+	instanceID := ptr.Deref(machineScope.GetInstanceID(), "")
+	machineScope.Info("EC2 instance was deleted externally, failing the machine", "instance-id", instanceID)
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "InstanceDeletedExternally", "EC2 instance %q was deleted externally", instanceID)
+
+	err := errors.Errorf("EC2 instance %q was deleted externally", instanceID)
+	machineScope.SetNotReady()
+	machineScope.SetFailureReason(capierrors.UpdateMachineError)
+	machineScope.SetFailureMessage(err)
+	conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceDeletedExternallyReason, clusterv1.ConditionSeverityError, err.Error())
+}
+
 func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope, elbScope scope.ELBScope, objectStoreScope scope.S3Scope) (ctrl.Result, error) {
 	machineScope.Trace("Reconciling AWSMachine")
 
@@ -463,6 +601,13 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 		return ctrl.Result{}, nil
 	}
 
+	if capaannotations.Has(clusterScope.InfraCluster(), infrav1.PauseMachinesAnnotation) {
+		machineScope.Info("Reconciliation of the machines is paused", "annotation", infrav1.PauseMachinesAnnotation)
+		conditions.MarkTrue(machineScope.AWSMachine, infrav1.MachinePausedCondition)
+		return ctrl.Result{}, nil
+	}
+	conditions.Delete(machineScope.AWSMachine, infrav1.MachinePausedCondition)
+
 	if !machineScope.Cluster.Status.InfrastructureReady {
 		machineScope.Info("Cluster infrastructure is not ready yet")
 		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.WaitingForClusterInfrastructureReason, clusterv1.ConditionSeverityInfo, "")
@@ -476,10 +621,36 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 		return ctrl.Result{}, nil
 	}
 
+	if requeueAfter, ok := r.steadyStateRequeueAfter(machineScope); ok {
+		machineScope.Debug("AWSMachine is in steady state, skipping full reconcile", "next-full-reconcile", requeueAfter)
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
 	ec2svc := r.getEC2Service(ec2Scope)
 
 	// Find existing instance
 	instance, err := r.findInstance(machineScope, ec2svc)
+	if errors.Is(err, errInstanceNotFoundInGracePeriod) {
+		machineScope.Info("Recently created EC2 instance not found yet, requeueing", "instance-id", *machineScope.GetInstanceID())
+		return ctrl.Result{RequeueAfter: r.requeueInterval()}, nil
+	}
+	if instanceDeletedExternally(instance, err) {
+		switch machineScope.OnExternalInstanceDeletion() {
+		case infrav1.OnExternalInstanceDeletionRecreate:
+			// A new instance with the bootstrap data of the original one can't rejoin the control plane, e.g. its
+			// join token may have expired, so control plane machines are left to the control plane provider.
+			if machineScope.IsControlPlane() {
+				r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "InstanceRecreateRefused", "Not recreating the EC2 instance of control plane machine %q", machineScope.Name())
+				r.failExternallyDeletedInstance(machineScope)
+				return ctrl.Result{}, nil
+			}
+			r.recreateExternallyDeletedInstance(machineScope)
+			instance, err = nil, nil
+		case infrav1.OnExternalInstanceDeletionFailMachine:
+			r.failExternallyDeletedInstance(machineScope)
+			return ctrl.Result{}, nil
+		}
+	}
 	if err != nil {
 		machineScope.Error(err, "unable to find instance")
 		conditions.MarkUnknown(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceNotFoundReason, err.Error())
@@ -497,8 +668,14 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 
 	// Create new instance since providerId is nil and instance could not be found by tags.
 	if instance == nil {
+		if clusterScope.ReconcileMode() == infrav1.ReconcileModeDetectOnly {
+			machineScope.Info("Not creating the EC2 instance of the AWSMachine in the detectOnly reconcile mode")
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceProvisionSkippedReason, clusterv1.ConditionSeverityInfo, "the cluster is in the detectOnly reconcile mode")
+			return ctrl.Result{}, nil
+		}
+
 		// Avoid a flickering condition between InstanceProvisionStarted and InstanceProvisionFailed if there's a persistent failure with createInstance
-		if conditions.GetReason(machineScope.AWSMachine, infrav1.InstanceReadyCondition) != infrav1.InstanceProvisionFailedReason {
+		if reason := conditions.GetReason(machineScope.AWSMachine, infrav1.InstanceReadyCondition); reason != infrav1.InstanceProvisionFailedReason && reason != infrav1.UserDataTooLargeReason {
 			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceProvisionStartedReason, clusterv1.ConditionSeverityInfo, "")
 			if patchErr := machineScope.PatchObject(); err != nil {
 				machineScope.Error(patchErr, "failed to patch conditions")
@@ -515,9 +692,13 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 		instance, err = r.createInstance(ec2svc, machineScope, clusterScope, objectStoreSvc)
 		if err != nil {
 			machineScope.Error(err, "unable to create instance")
-			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceProvisionFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			// Keep the more actionable reason the EC2 service set when the user data is too large.
+			if conditions.GetReason(machineScope.AWSMachine, infrav1.InstanceReadyCondition) != infrav1.UserDataTooLargeReason {
+				conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceProvisionFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			}
 			return ctrl.Result{}, err
 		}
+		machineScope.SetInstanceCreationTime(metav1.Now())
 	}
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
 		instancestateSvc := instancestate.NewService(ec2Scope)
@@ -536,6 +717,7 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 
 	existingInstanceState := machineScope.GetInstanceState()
 	machineScope.SetInstanceState(instance.State)
+	machineScope.SetInstanceType(instance.Type)
 
 	// Proceed to reconcile the AWSMachine state.
 	if existingInstanceState == nil || *existingInstanceState != instance.State {
@@ -581,12 +763,21 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 
 	// tasks that can take place during all known instance states
 	if machineScope.InstanceIsInKnownState() {
-		_, err = r.ensureTags(ec2svc, machineScope.AWSMachine, machineScope.GetInstanceID(), machineScope.AdditionalTags())
+		tagsUpdated, err := r.ensureTags(ec2svc, machineScope.AWSMachine, machineScope.GetInstanceID(), machineScope.AdditionalTags())
 		if err != nil {
 			machineScope.Error(err, "failed to ensure tags")
 			return ctrl.Result{}, err
 		}
 
+		// The tags of the instance are only compared with the desired tags once the changes of the
+		// additional tags were applied, as the instance was described before they were.
+		if instance != nil && !tagsUpdated {
+			if err := r.reconcileTagDrift(ec2svc, machineScope, instance, ec2Scope.KubernetesClusterName()); err != nil {
+				machineScope.Error(err, "failed to reconcile tag drift")
+				return ctrl.Result{}, err
+			}
+		}
+
 		if instance != nil {
 			r.ensureStorageTags(ec2svc, instance, machineScope.AWSMachine, machineScope.AdditionalTags())
 		}
@@ -605,14 +796,126 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 		}
 	}
 
+	bootDiagnosticsRequeueAfter := r.reconcileBootDiagnostics(ec2svc, machineScope, objectStoreScope)
+
+	if conditions.GetReason(machineScope.AWSMachine, infrav1.VolumesReadyCondition) == infrav1.VolumesModifyingReason {
+		machineScope.Debug("volumes are being modified, requeue", "instance", instance.ID)
+		return ctrl.Result{RequeueAfter: r.requeueInterval()}, nil
+	}
+
 	machineScope.Debug("done reconciling instance", "instance", instance)
+	machineScope.AWSMachine.Status.ObservedGeneration = machineScope.AWSMachine.Generation
 	if shouldRequeue {
 		machineScope.Debug("but find the instance is pending, requeue", "instance", instance.ID)
-		return ctrl.Result{RequeueAfter: DefaultReconcilerRequeue}, nil
+		return ctrl.Result{RequeueAfter: r.requeueInterval()}, nil
+	}
+	if bootDiagnosticsRequeueAfter > 0 {
+		return ctrl.Result{RequeueAfter: bootDiagnosticsRequeueAfter}, nil
+	}
+	if r.FullReconcilePeriod > 0 && machineIsInSteadyState(machineScope) {
+		now := metav1.Now()
+		machineScope.AWSMachine.Status.LastFullReconcileTime = &now
+		return ctrl.Result{RequeueAfter: r.FullReconcilePeriod}, nil
 	}
 	return ctrl.Result{}, nil
 }
 
+// machineIsInSteadyState returns true if the AWSMachine is ready with a running instance and a node. Requiring the node
+// ensures the bootstrap data has been cleaned up before the full reconcile can be skipped.
+func machineIsInSteadyState(machineScope *scope.MachineScope) bool {
+	state := machineScope.GetInstanceState()
+	return machineScope.AWSMachine.Status.Ready &&
+		state != nil && *state == infrav1.InstanceStateRunning &&
+		conditions.IsTrue(machineScope.AWSMachine, infrav1.InstanceReadyCondition) &&
+		machineScope.GetInstanceID() != nil &&
+		machineScope.Machine.Status.NodeRef != nil
+}
+
+// steadyStateRequeueAfter returns true if the full reconcile of the AWSMachine can be skipped, along with the time
+// until the next full reconcile is due. This is the case when the fast path is enabled, the AWSMachine is still in the
+// steady state it was in at its last full reconcile, and its spec hasn't changed since. Changes to the instance outside
+// of the AWSMachine spec are only caught by the next full reconcile.
+func (r *AWSMachineReconciler) steadyStateRequeueAfter(machineScope *scope.MachineScope) (time.Duration, bool) {
+	status := machineScope.AWSMachine.Status
+	if r.FullReconcilePeriod <= 0 || status.LastFullReconcileTime == nil {
+		return 0, false
+	}
+	if status.ObservedGeneration != machineScope.AWSMachine.Generation || !machineIsInSteadyState(machineScope) {
+		return 0, false
+	}
+
+	requeueAfter := r.FullReconcilePeriod - time.Since(status.LastFullReconcileTime.Time)
+	if requeueAfter <= 0 {
+		return 0, false
+	}
+	return requeueAfter, true
+}
+
+// reconcileBootDiagnostics captures the console output and screenshot of a control plane instance that hasn't become a
+// node within the boot diagnostics grace period, and uploads them to the S3 bucket. It returns the time left until the
+// grace period expires while the capture is pending, or zero. Failures are reported on the BootDiagnosticsCaptured
+// condition and retried on the next reconcile, without failing the reconcile of the machine.
+func (r *AWSMachineReconciler) reconcileBootDiagnostics(ec2svc services.EC2Interface, machineScope *scope.MachineScope, objectStoreScope scope.S3Scope) time.Duration {
+	if objectStoreScope == nil || objectStoreScope.Bucket() == nil || objectStoreScope.Bucket().BootDiagnostics == nil {
+		return 0
+	}
+	if !machineScope.IsControlPlane() || machineScope.Machine.Status.NodeRef != nil || !machineScope.InstanceIsOperational() {
+		return 0
+	}
+	if conditions.IsTrue(machineScope.AWSMachine, infrav1.BootDiagnosticsCapturedCondition) {
+		return 0
+	}
+
+	gracePeriod := DefaultBootDiagnosticsGracePeriod
+	if p := objectStoreScope.Bucket().BootDiagnostics.GracePeriod; p != nil {
+		gracePeriod = p.Duration
+	}
+	startTime := machineScope.AWSMachine.CreationTimestamp.Time
+	if creationTime := machineScope.AWSMachine.Status.InstanceCreationTime; creationTime != nil {
+		startTime = creationTime.Time
+	}
+	if remaining := gracePeriod - time.Since(startTime); remaining > 0 {
+		return remaining
+	}
+
+	instanceID := *machineScope.GetInstanceID()
+	machineScope.Info("Control plane instance didn't become a node within the grace period, capturing boot diagnostics", "instance-id", instanceID, "grace-period", gracePeriod)
+
+	output, screenshot, err := ec2svc.GetConsoleDiagnostics(instanceID)
+	if err != nil {
+		r.markBootDiagnosticsFailed(machineScope, err)
+		return 0
+	}
+	files := map[string][]byte{
+		"console-output.txt": output,
+	}
+	if screenshot != nil {
+		files["console-screenshot.jpg"] = screenshot
+	}
+
+	location, err := r.getObjectStoreService(objectStoreScope).CreateBootDiagnostics(machineScope, files)
+	if err != nil {
+		r.markBootDiagnosticsFailed(machineScope, err)
+		return 0
+	}
+
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulCaptureBootDiagnostics", "Uploaded boot diagnostics of instance %s to %s", instanceID, location)
+	conditions.Set(machineScope.AWSMachine, &clusterv1.Condition{
+		Type:     infrav1.BootDiagnosticsCapturedCondition,
+		Status:   corev1.ConditionTrue,
+		Severity: clusterv1.ConditionSeverityNone,
+		Reason:   infrav1.BootDiagnosticsUploadedReason,
+		Message:  location,
+	})
+	return 0
+}
+
+func (r *AWSMachineReconciler) markBootDiagnosticsFailed(machineScope *scope.MachineScope, err error) {
+	machineScope.Error(err, "failed to capture boot diagnostics")
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedCaptureBootDiagnostics", "Failed to capture boot diagnostics: %v", err)
+	conditions.MarkFalse(machineScope.AWSMachine, infrav1.BootDiagnosticsCapturedCondition, infrav1.BootDiagnosticsCaptureFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+}
+
 func (r *AWSMachineReconciler) reconcileOperationalState(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
 	machineScope.SetAddresses(instance.Addresses)
 
@@ -637,6 +940,46 @@ func (r *AWSMachineReconciler) reconcileOperationalState(ec2svc services.EC2Inte
 		return err
 	}
 
+	if err := r.ensureSourceDestCheck(ec2svc, instance, machineScope.AWSMachine); err != nil {
+		machineScope.Error(err, "failed to ensure source/destination check")
+		return err
+	}
+
+	if err := r.reconcileVolumes(ec2svc, machineScope, instance); err != nil {
+		machineScope.Error(err, "failed to reconcile volumes")
+		return err
+	}
+
+	return nil
+}
+
+// reconcileVolumes modifies the EBS volumes of the instance in place when their size, type, IOPS or throughput in the
+// spec changed. The volumes are only checked when the spec changed since the last reconcile or while they are being
+// modified, to avoid describing them on every reconcile.
+func (r *AWSMachineReconciler) reconcileVolumes(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
+	machine := machineScope.AWSMachine
+	if machine.Spec.RootVolume == nil && len(machine.Spec.NonRootVolumes) == 0 {
+		return nil
+	}
+	if machine.Status.ObservedGeneration == machine.Generation && !conditions.IsFalse(machine, infrav1.VolumesReadyCondition) {
+		return nil
+	}
+
+	modifying, err := ec2svc.ModifyInstanceVolumes(instance.ID, machine.Spec.RootVolume, machine.Spec.NonRootVolumes)
+	if err != nil {
+		r.Recorder.Eventf(machine, corev1.EventTypeWarning, "FailedModifyVolumes", "Failed to modify the volumes of the instance: %v", err)
+		conditions.MarkFalse(machine, infrav1.VolumesReadyCondition, infrav1.VolumesModificationFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return err
+	}
+	if modifying {
+		if conditions.GetReason(machine, infrav1.VolumesReadyCondition) != infrav1.VolumesModifyingReason {
+			r.Recorder.Eventf(machine, corev1.EventTypeNormal, "ModifyingVolumes", "Modifying the volumes of instance %q", instance.ID)
+		}
+		conditions.MarkFalse(machine, infrav1.VolumesReadyCondition, infrav1.VolumesModifyingReason, clusterv1.ConditionSeverityInfo, "")
+		return nil
+	}
+	conditions.MarkTrue(machine, infrav1.VolumesReadyCondition)
+
 	return nil
 }
 
@@ -696,6 +1039,11 @@ func (r *AWSMachineReconciler) resolveUserData(machineScope *scope.MachineScope,
 		return nil, "", err
 	}
 
+	userData, err = machineScope.TemplateBootstrapParameters(userData, userDataFormat)
+	if err != nil {
+		return nil, "", err
+	}
+
 	if machineScope.UseSecretsManager(userDataFormat) {
 		userData, err = r.cloudInitUserData(machineScope, clusterScope, userData)
 	}
@@ -858,12 +1206,21 @@ func (r *AWSMachineReconciler) reconcileLBAttachment(machineScope *scope.Machine
 		return nil
 	}
 
+	// The instances are neither registered nor deregistered while the reconciliation of the load balancers is paused.
+	if capaannotations.Has(elbScope.InfraCluster(), infrav1.PauseLoadBalancerAnnotation) {
+		machineScope.Debug("Reconciliation of the load balancers is paused, skipping load balancer attachment", "annotation", infrav1.PauseLoadBalancerAnnotation)
+		return nil
+	}
+
 	elbsvc := r.getELBService(elbScope)
 
 	// In order to prevent sending request to a "not-ready" control plane machines, it is required to remove the machine
 	// from the ELB as soon as the machine or infra machine gets deleted or when the machine is in a not running state.
 	if machineScope.AWSMachineIsDeleted() || machineScope.MachineIsDeleted() || !machineScope.InstanceIsRunning() {
 		if elbScope.ControlPlaneLoadBalancer().LoadBalancerType == infrav1.LoadBalancerTypeClassic {
+			if err := r.deregisterInstanceFromGlobalAccelerator(machineScope, elbScope, elbsvc, i); err != nil {
+				return err
+			}
 			machineScope.Debug("deregistering from classic load balancer")
 			return r.deregisterInstanceFromClassicLB(machineScope, elbsvc, i)
 		}
@@ -876,7 +1233,11 @@ func (r *AWSMachineReconciler) reconcileLBAttachment(machineScope *scope.Machine
 		fallthrough
 	case "":
 		machineScope.Debug("registering to classic load balancer")
-		return r.registerInstanceToClassicLB(machineScope, elbsvc, i)
+		if err := r.registerInstanceToClassicLB(machineScope, elbsvc, i); err != nil {
+			return err
+		}
+		// Classic load balancers can't be Global Accelerator endpoints, so the instances are registered instead.
+		return r.registerInstanceToGlobalAccelerator(machineScope, elbScope, elbsvc, i)
 
 	case infrav1.LoadBalancerTypeELB:
 		fallthrough
@@ -988,6 +1349,59 @@ func (r *AWSMachineReconciler) deregisterInstanceFromV2LB(machineScope *scope.Ma
 	return nil
 }
 
+// isInstanceDrainingFromLB returns true if the instance is still being deregistered from the target groups
+// of a v2 control plane load balancer. Classic load balancers and instances that are already shutting down
+// are never waited for.
+func (r *AWSMachineReconciler) isInstanceDrainingFromLB(machineScope *scope.MachineScope, elbScope scope.ELBScope, i *infrav1.Instance) (bool, error) {
+	switch elbScope.ControlPlaneLoadBalancer().LoadBalancerType {
+	case infrav1.LoadBalancerTypeClassic, "":
+		return false, nil
+	}
+
+	if i.State == infrav1.InstanceStateShuttingDown || i.State == infrav1.InstanceStateTerminated {
+		return false, nil
+	}
+
+	draining, err := r.getELBService(elbScope).IsInstanceDrainingFromAPIServerLB(i)
+	if err != nil {
+		// We are tolerating AccessDenied error, so this won't block for users with older version of IAM.
+		if elb.IsAccessDenied(err) || elb.IsNotFound(err) {
+			return false, nil
+		}
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedDetachControlPlaneELB",
+			"Failed to deregister control plane instance %q from load balancer: failed to determine deregistration status: %v", i.ID, err)
+		return false, errors.Wrapf(err, "could not determine whether control plane instance %q is deregistered from load balancer", i.ID)
+	}
+
+	return draining, nil
+}
+
+func (r *AWSMachineReconciler) registerInstanceToGlobalAccelerator(machineScope *scope.MachineScope, elbScope scope.ELBScope, elbsvc services.ELBInterface, i *infrav1.Instance) error {
+	if elbScope.GlobalAcceleratorEndpointGroupARN() == "" {
+		return nil
+	}
+
+	if err := elbsvc.RegisterInstanceWithGlobalAccelerator(i); err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedAttachGlobalAccelerator",
+			"Failed to register control plane instance %q with Global Accelerator endpoint group: %v", i.ID, err)
+		return errors.Wrapf(err, "could not register control plane instance %q with Global Accelerator endpoint group", i.ID)
+	}
+	return nil
+}
+
+func (r *AWSMachineReconciler) deregisterInstanceFromGlobalAccelerator(machineScope *scope.MachineScope, elbScope scope.ELBScope, elbsvc services.ELBInterface, i *infrav1.Instance) error {
+	if elbScope.GlobalAcceleratorEndpointGroupARN() == "" {
+		return nil
+	}
+
+	if err := elbsvc.DeregisterInstanceFromGlobalAccelerator(i); err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedDetachGlobalAccelerator",
+			"Failed to deregister control plane instance %q from Global Accelerator endpoint group: %v", i.ID, err)
+		return errors.Wrapf(err, "could not deregister control plane instance %q from Global Accelerator endpoint group", i.ID)
+	}
+	return nil
+}
+
 // AWSClusterToAWSMachines is a handler.ToRequestsFunc to be used to enqeue requests for reconciliation
 // of AWSMachines.
 func (r *AWSMachineReconciler) AWSClusterToAWSMachines(log logger.Wrapper) handler.MapFunc {
@@ -1154,10 +1568,9 @@ func (r *AWSMachineReconciler) ensureStorageTags(ec2svc services.EC2Interface, i
 			}
 			annotations[volumeID] = newAnnotation
 		} else {
-			newAnnotation, err := r.ensureVolumeTags(ec2svc, aws.String(volumeID), make(map[string]interface{}), additionalTags)
-			if err != nil {
-				r.Log.Error(err, "Failed to fetch the changed volume tags in EC2 instance")
-			}
+			// Volumes are tagged with the additional tags when the instance is created,
+			// so the tags only need to be recorded the first time the volume is seen.
+			_, _, _, newAnnotation := r.tagsChanged(make(map[string]interface{}), additionalTags)
 			annotations[volumeID] = newAnnotation
 		}
 	}
@@ -1178,3 +1591,16 @@ func (r *AWSMachineReconciler) ensureInstanceMetadataOptions(ec2svc services.EC2
 
 	return ec2svc.ModifyInstanceMetadataOptions(instance.ID, machine.Spec.InstanceMetadataOptions)
 }
+
+// ensureSourceDestCheck applies the source/destination check of the spec to a running instance, leaving the EC2 default
+// unchanged when it isn't set.
+func (r *AWSMachineReconciler) ensureSourceDestCheck(ec2svc services.EC2Interface, instance *infrav1.Instance, machine *infrav1.AWSMachine) error {
+	if machine.Spec.SourceDestCheck == nil || instance.State != infrav1.InstanceStateRunning {
+		return nil
+	}
+	if instance.SourceDestCheck != nil && *instance.SourceDestCheck == *machine.Spec.SourceDestCheck {
+		return nil
+	}
+
+	return ec2svc.ModifyInstanceSourceDestCheck(instance.ID, *machine.Spec.SourceDestCheck)
+}
@@ -539,8 +539,8 @@ func mockedCreateInstanceCalls(m *mocks.MockEC2APIMockRecorder) {
 				Values: aws.StringSlice([]string{"owned"}),
 			},
 			{
-				Name:   aws.String("tag:Name"),
-				Values: aws.StringSlice([]string{"test"}),
+				Name:   aws.String("tag:MachineName"),
+				Values: aws.StringSlice([]string{"/test"}),
 			},
 			{
 				Name:   aws.String("instance-state-name"),
@@ -44,6 +44,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
 	ec2Service "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
@@ -54,6 +55,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	capierrors "sigs.k8s.io/cluster-api/errors"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 const providerID = "aws:////myMachine"
@@ -65,6 +67,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 		ms             *scope.MachineScope
 		mockCtrl       *gomock.Controller
 		ec2Svc         *mock_services.MockEC2Interface
+		asgSvc         *mock_services.MockASGInterface
 		elbSvc         *mock_services.MockELBInterface
 		secretSvc      *mock_services.MockSecretInterface
 		objectStoreSvc *mock_services.MockObjectStoreInterface
@@ -173,17 +176,28 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 		mockCtrl = gomock.NewController(t)
 		ec2Svc = mock_services.NewMockEC2Interface(mockCtrl)
+		asgSvc = mock_services.NewMockASGInterface(mockCtrl)
 		secretSvc = mock_services.NewMockSecretInterface(mockCtrl)
 		elbSvc = mock_services.NewMockELBInterface(mockCtrl)
 		objectStoreSvc = mock_services.NewMockObjectStoreInterface(mockCtrl)
 
+		// The instances of the tests don't have the ownership tags of the cluster, which are re-applied when the
+		// tag drift of the instances is reconciled.
+		ec2Svc.EXPECT().UpdateResourceTags(gomock.Any(), map[string]string{
+			infrav1.ClusterTagKey(""):                 string(infrav1.ResourceLifecycleOwned),
+			infrav1.ClusterAWSCloudProviderTagKey(""): string(infrav1.ResourceLifecycleOwned),
+		}, nil).Return(nil).AnyTimes()
+
 		// If your test hangs for 9 minutes, increase the value here to the number of events during a reconciliation loop
-		recorder = record.NewFakeRecorder(2)
+		recorder = record.NewFakeRecorder(4)
 
 		reconciler = AWSMachineReconciler{
 			ec2ServiceFactory: func(scope.EC2Scope) services.EC2Interface {
 				return ec2Svc
 			},
+			asgServiceFactory: func(cloud.ClusterScoper) services.ASGInterface {
+				return asgSvc
+			},
 			secretsManagerServiceFactory: func(cloud.ClusterScoper) services.SecretInterface {
 				return secretSvc
 			},
@@ -376,6 +390,20 @@ func TestAWSMachineReconciler(t *testing.T) {
 					g.Expect(ms.AWSMachine.Spec.ProviderID).To(PointTo(Equal(providerID)))
 				})
 
+				t.Run("should record the instance type in status", func(t *testing.T) {
+					g := NewWithT(t)
+					awsMachine := getAWSMachine()
+					setup(t, g, awsMachine)
+					defer teardown(t, g)
+					instanceCreate(t, g)
+					getInstanceSecurityGroups(t, g)
+
+					secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+					instance.Type = "m5a.large"
+					_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+					g.Expect(ms.AWSMachine.Status.InstanceType).To(Equal("m5a.large"))
+				})
+
 				t.Run("should set instance to pending", func(t *testing.T) {
 					g := NewWithT(t)
 					awsMachine := getAWSMachine()
@@ -481,7 +509,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 					}
 				})
 
-				t.Run("should tag instances and volumes with machine and cluster tags", func(t *testing.T) {
+				t.Run("should tag instances with machine and cluster tags", func(t *testing.T) {
 					g := NewWithT(t)
 					awsMachine := getAWSMachine()
 					setup(t, g, awsMachine)
@@ -491,11 +519,12 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 					ms.AWSMachine.Spec.AdditionalTags = infrav1.Tags{"kind": "alicorn", "colour": "pink"} // takes precedence
 					cs.AWSCluster.Spec.AdditionalTags = infrav1.Tags{"colour": "lavender", "shape": "round"}
+					// the last applied tags are only recorded on an AWSMachine which already has annotations
+					ms.AWSMachine.Annotations = map[string]string{}
 
 					ec2Svc.EXPECT().GetAdditionalSecurityGroupsIDs(gomock.Any()).Return(nil, nil)
 
-					// expect one call first to tag the instance and two calls for tagging each of two volumes
-					// the volumes get the tags from the AWSCluster _and_ the AWSMachine
+					// expect one call to tag the instance, the volumes are tagged when the instance is created
 
 					ec2Svc.EXPECT().UpdateResourceTags(
 						PointsTo("myMachine"),
@@ -507,18 +536,12 @@ func TestAWSMachineReconciler(t *testing.T) {
 						map[string]string{},
 					).Return(nil)
 
-					ec2Svc.EXPECT().UpdateResourceTags(
-						gomock.Any(),
-						map[string]string{
-							"colour": "pink",
-							"shape":  "round",
-							"kind":   "alicorn",
-						},
-						map[string]string{},
-					).Return(nil).Times(2)
+					ec2Svc.EXPECT().UpdateResourceTags(PointsTo("volume-1"), gomock.Any(), gomock.Any()).Times(0)
+					ec2Svc.EXPECT().UpdateResourceTags(PointsTo("volume-2"), gomock.Any(), gomock.Any()).Times(0)
 
 					_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
 					g.Expect(err).To(BeNil())
+					g.Expect(ms.AWSMachine.Annotations).To(HaveKey(VolumeTagsLastAppliedAnnotation))
 				})
 				t.Run("should tag instances volume tags", func(t *testing.T) {
 					g := NewWithT(t)
@@ -538,7 +561,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 							"rootDeviceSize": "30",
 						},
 						map[string]string{},
-					).Return(nil).Times(3)
+					).Return(nil).Times(1)
 
 					_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
 					g.Expect(err).To(BeNil())
@@ -771,6 +794,25 @@ func TestAWSMachineReconciler(t *testing.T) {
 				g.Expect(ms.AWSMachine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
 				expectConditions(g, ms.AWSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrav1.InstanceProvisionFailedReason}})
 			})
+			t.Run("should keep the condition reason when the userdata is too large", func(t *testing.T) {
+				expectedError := "user data is 16385 bytes, which exceeds the EC2 limit of 16384 bytes"
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				ms.AWSMachine.Spec.CloudInit.InsecureSkipSecretsManager = true
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil)
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(machineScope *scope.MachineScope, _ []byte, _ string) (*infrav1.Instance, error) {
+					conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.UserDataTooLargeReason, clusterv1.ConditionSeverityError, expectedError)
+					return nil, errors.New(expectedError)
+				})
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(err).To(MatchError(ContainSubstring(expectedError)))
+				expectConditions(g, ms.AWSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrav1.UserDataTooLargeReason}})
+				g.Expect(conditions.GetMessage(ms.AWSMachine, infrav1.InstanceReadyCondition)).To(Equal(expectedError))
+			})
 			t.Run("should fail to determine the registration status of control plane ELB", func(t *testing.T) {
 				g := NewWithT(t)
 				awsMachine := getAWSMachine()
@@ -995,6 +1037,332 @@ func TestAWSMachineReconciler(t *testing.T) {
 		})
 	})
 
+	t.Run("Reconciling an AWSMachine in steady state", func(t *testing.T) {
+		id := providerID
+		steadyState := func(t *testing.T, g *WithT) {
+			t.Helper()
+
+			reconciler.FullReconcilePeriod = time.Hour
+			ms.AWSMachine.Generation = 1
+			ms.AWSMachine.Spec.ProviderID = &id
+			ms.AWSMachine.Status.ObservedGeneration = 1
+			ms.AWSMachine.Status.LastFullReconcileTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+			ms.SetReady()
+			ms.SetInstanceState(infrav1.InstanceStateRunning)
+			conditions.MarkTrue(ms.AWSMachine, infrav1.InstanceReadyCondition)
+			ms.Machine.Status.NodeRef = &corev1.ObjectReference{Name: "node"}
+		}
+
+		t.Run("should skip the AWS calls when nothing changed since the last full reconcile", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			steadyState(t, g)
+
+			res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(res.RequeueAfter).To(BeNumerically(">", 58*time.Minute))
+			g.Expect(res.RequeueAfter).To(BeNumerically("<=", 59*time.Minute))
+		})
+
+		t.Run("should run a full reconcile when the fast path is disabled", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			steadyState(t, g)
+			reconciler.FullReconcilePeriod = 0
+
+			expectedErr := errors.New("no connection available ")
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(nil, expectedErr)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+		})
+
+		t.Run("should run a full reconcile when the generation changed", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			steadyState(t, g)
+			ms.AWSMachine.Generation = 2
+
+			expectedErr := errors.New("no connection available ")
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(nil, expectedErr)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+		})
+
+		t.Run("should run a full reconcile when the last full reconcile is older than the period", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			steadyState(t, g)
+			ms.AWSMachine.Status.LastFullReconcileTime = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+
+			expectedErr := errors.New("no connection available ")
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(nil, expectedErr)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+		})
+
+		t.Run("should record the full reconcile of a machine in steady state", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			steadyState(t, g)
+			ms.AWSMachine.Generation = 2
+			lastFullReconcileTime := ms.AWSMachine.Status.LastFullReconcileTime
+
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(&infrav1.Instance{
+				ID:    "myMachine",
+				State: infrav1.InstanceStateRunning,
+			}, nil)
+			secretSvc.EXPECT().Delete(gomock.Any()).Return(nil)
+			ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(map[string][]string{"eid": {}}, nil)
+			ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{}, nil)
+			ec2Svc.EXPECT().GetAdditionalSecurityGroupsIDs(gomock.Any()).Return(nil, nil)
+
+			res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(res.RequeueAfter).To(Equal(time.Hour))
+			g.Expect(ms.AWSMachine.Status.ObservedGeneration).To(BeEquivalentTo(2))
+			g.Expect(ms.AWSMachine.Status.LastFullReconcileTime.After(lastFullReconcileTime.Time)).To(BeTrue())
+		})
+	})
+
+	t.Run("Reconciling an AWSMachine shortly after its instance was created", func(t *testing.T) {
+		t.Run("should requeue instead of recreating an instance not found yet within the grace period", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			reconciler.InstanceNotFoundGracePeriod = time.Minute
+
+			ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil)
+			ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(&infrav1.Instance{
+				ID:    "myMachine",
+				State: infrav1.InstanceStatePending,
+			}, nil).Times(1)
+			ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(nil, errors.New("stop here"))
+			secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil)
+
+			_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(ms.AWSMachine.Status.InstanceCreationTime).ToNot(BeNil())
+
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(nil, ec2Service.ErrInstanceNotFoundByID)
+
+			res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(res.RequeueAfter).To(Equal(DefaultReconcilerRequeue))
+			g.Expect(ms.AWSMachine.Spec.InstanceID).To(PointTo(Equal("myMachine")))
+		})
+
+		t.Run("should fail when the instance isn't found after the grace period", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			reconciler.InstanceNotFoundGracePeriod = time.Minute
+			ms.SetProviderID("myMachine", "us-east-1a")
+			ms.SetInstanceID("myMachine")
+			ms.SetInstanceCreationTime(metav1.NewTime(time.Now().Add(-2 * time.Minute)))
+
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(nil, ec2Service.ErrInstanceNotFoundByID)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(err).To(MatchError(ec2Service.ErrInstanceNotFoundByID))
+		})
+
+		t.Run("should keep the finalizer of a deleted AWSMachine whose instance isn't found yet", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			reconciler.InstanceNotFoundGracePeriod = time.Minute
+			ms.AWSMachine.Finalizers = []string{infrav1.MachineFinalizer}
+			ms.SetProviderID("myMachine", "us-east-1a")
+			ms.SetInstanceID("myMachine")
+			ms.SetInstanceCreationTime(metav1.Now())
+
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(nil, ec2Service.ErrInstanceNotFoundByID)
+			secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+			res, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(res.RequeueAfter).To(Equal(DefaultReconcilerRequeue))
+			g.Expect(ms.AWSMachine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
+		})
+	})
+
+	t.Run("Reconciling an AWSMachine whose instance was deleted externally", func(t *testing.T) {
+		expectInstanceCreation := func() {
+			ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(&infrav1.Instance{
+				ID:    "myNewMachine",
+				State: infrav1.InstanceStatePending,
+			}, nil).Times(1)
+			ec2Svc.EXPECT().GetInstanceSecurityGroups(gomock.Any()).Return(nil, errors.New("stop here"))
+			secretSvc.EXPECT().UserData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			secretSvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return("test", int32(1), nil)
+		}
+
+		t.Run("should create a new instance when the instance isn't found and the policy is Recreate", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			awsMachine.Spec.OnExternalInstanceDeletion = infrav1.OnExternalInstanceDeletionRecreate
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			ms.SetProviderID("myMachine", "us-east-1a")
+			ms.SetInstanceID("myMachine")
+
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(nil, ec2Service.ErrInstanceNotFoundByID)
+			expectInstanceCreation()
+
+			_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(ms.AWSMachine.Spec.InstanceID).To(PointTo(Equal("myNewMachine")))
+			g.Expect(ms.AWSMachine.Status.FailureReason).To(BeNil())
+			g.Eventually(recorder.Events).Should(Receive(ContainSubstring("InstanceDeletedExternally")))
+		})
+
+		t.Run("should create a new instance when the instance is terminated and the policy is Recreate", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			awsMachine.Spec.OnExternalInstanceDeletion = infrav1.OnExternalInstanceDeletionRecreate
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			ms.SetProviderID("myMachine", "us-east-1a")
+			ms.SetInstanceID("myMachine")
+
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(&infrav1.Instance{
+				ID:    "myMachine",
+				State: infrav1.InstanceStateTerminated,
+			}, nil)
+			expectInstanceCreation()
+
+			_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(ms.AWSMachine.Spec.InstanceID).To(PointTo(Equal("myNewMachine")))
+			g.Expect(ms.AWSMachine.Status.FailureReason).To(BeNil())
+		})
+
+		t.Run("should fail the machine when the instance isn't found and the policy is FailMachine", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			awsMachine.Spec.OnExternalInstanceDeletion = infrav1.OnExternalInstanceDeletionFailMachine
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			ms.SetProviderID("myMachine", "us-east-1a")
+			ms.SetInstanceID("myMachine")
+
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(nil, ec2Service.ErrInstanceNotFoundByID)
+			ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(ms.AWSMachine.Spec.InstanceID).To(PointTo(Equal("myMachine")))
+			g.Expect(ms.AWSMachine.Status.FailureMessage).To(PointTo(Equal("EC2 instance \"myMachine\" was deleted externally")))
+			expectConditions(g, ms.AWSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrav1.InstanceDeletedExternallyReason}})
+			g.Eventually(recorder.Events).Should(Receive(ContainSubstring("InstanceDeletedExternally")))
+		})
+
+		t.Run("should fail a control plane machine instead of recreating its instance", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			awsMachine.Spec.OnExternalInstanceDeletion = infrav1.OnExternalInstanceDeletionRecreate
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			ms.Machine.Labels = map[string]string{clusterv1.MachineControlPlaneLabel: ""}
+			ms.SetProviderID("myMachine", "us-east-1a")
+			ms.SetInstanceID("myMachine")
+
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(nil, ec2Service.ErrInstanceNotFoundByID)
+			ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(ms.AWSMachine.Spec.InstanceID).To(PointTo(Equal("myMachine")))
+			g.Expect(ms.AWSMachine.Status.FailureMessage).To(PointTo(Equal("EC2 instance \"myMachine\" was deleted externally")))
+			g.Eventually(recorder.Events).Should(Receive(ContainSubstring("InstanceRecreateRefused")))
+		})
+
+		t.Run("should apply the policy of the cluster when the machine has none", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			cs.AWSCluster.Spec.OnExternalInstanceDeletion = infrav1.OnExternalInstanceDeletionRecreate
+			ms.SetProviderID("myMachine", "us-east-1a")
+			ms.SetInstanceID("myMachine")
+
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(nil, ec2Service.ErrInstanceNotFoundByID)
+			expectInstanceCreation()
+
+			_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(ms.AWSMachine.Spec.InstanceID).To(PointTo(Equal("myNewMachine")))
+			g.Expect(ms.AWSMachine.Status.FailureReason).To(BeNil())
+		})
+
+		t.Run("should prefer the policy of the machine over the one of the cluster", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			awsMachine.Spec.OnExternalInstanceDeletion = infrav1.OnExternalInstanceDeletionFailMachine
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			cs.AWSCluster.Spec.OnExternalInstanceDeletion = infrav1.OnExternalInstanceDeletionRecreate
+			ms.SetProviderID("myMachine", "us-east-1a")
+			ms.SetInstanceID("myMachine")
+
+			ec2Svc.EXPECT().InstanceIfExists(PointsTo("myMachine")).Return(nil, ec2Service.ErrInstanceNotFoundByID)
+			ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(ms.AWSMachine.Status.FailureMessage).To(PointTo(Equal("EC2 instance \"myMachine\" was deleted externally")))
+		})
+	})
+
+	t.Run("should not create an instance when the cluster is in the detectOnly reconcile mode", func(t *testing.T) {
+		g := NewWithT(t)
+		awsMachine := getAWSMachine()
+		setup(t, g, awsMachine)
+		defer teardown(t, g)
+		cs.AWSCluster.Spec.ReconcileMode = infrav1.ReconcileModeDetectOnly
+
+		ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil)
+		ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+		g.Expect(err).To(BeNil())
+		g.Expect(ms.AWSMachine.Status.FailureReason).To(BeNil())
+		expectConditions(g, ms.AWSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrav1.InstanceProvisionSkippedReason}})
+	})
+
+	t.Run("should not reconcile the instance while the machines of the cluster are paused", func(t *testing.T) {
+		g := NewWithT(t)
+		awsMachine := getAWSMachine()
+		setup(t, g, awsMachine)
+		defer teardown(t, g)
+		cs.AWSCluster.Annotations = map[string]string{infrav1.PauseMachinesAnnotation: ""}
+
+		_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+		g.Expect(err).To(BeNil())
+		g.Expect(conditions.IsTrue(ms.AWSMachine, infrav1.MachinePausedCondition)).To(BeTrue())
+
+		// The instance is reconciled again once the annotation is removed.
+		delete(cs.AWSCluster.Annotations, infrav1.PauseMachinesAnnotation)
+		ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, errors.New("reconciling the instance"))
+
+		_, err = reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(conditions.Has(ms.AWSMachine, infrav1.MachinePausedCondition)).To(BeFalse())
+	})
+
 	t.Run("Secrets management lifecycle", func(t *testing.T) {
 		t.Run("Secrets management lifecycle when creating EC2 instances", func(t *testing.T) {
 			var instance *infrav1.Instance
@@ -1596,6 +1964,28 @@ func TestAWSMachineReconciler(t *testing.T) {
 			g.Expect(err).To(BeNil())
 			g.Expect(buf.String()).To(ContainSubstring("EC2 instance is shutting down or already terminated"))
 		})
+		t.Run("should requeue instances in shutting down state after a minute", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			finalizer(t, g)
+
+			ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{
+				State: infrav1.InstanceStateShuttingDown,
+			}, nil).Times(2)
+			secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+			res, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(res.RequeueAfter).To(Equal(time.Minute))
+
+			// the requeue interval only applies to instances which aren't running yet
+			reconciler.RequeueInterval = 5 * time.Minute
+			res, err = reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(res.RequeueAfter).To(Equal(time.Minute))
+		})
 		t.Run("should ignore instances in terminated state", func(t *testing.T) {
 			g := NewWithT(t)
 			awsMachine := getAWSMachine()
@@ -1822,6 +2212,225 @@ func TestAWSMachineReconciler(t *testing.T) {
 				g.Expect(ms.AWSMachine.Finalizers).To(ContainElement(metav1.FinalizerDeleteDependents))
 				expectConditions(g, ms.AWSMachine, []conditionAssertion{{infrav1.ELBAttachedCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityWarning, "DeletingFailed"}})
 			})
+			t.Run("should wait for the instance to be deregistered from the target groups before terminating it", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				finalizer(t, g)
+				ms.Machine.Labels = map[string]string{clusterv1.MachineControlPlaneLabel: ""}
+				cs.AWSCluster.Spec.ControlPlaneLoadBalancer = &infrav1.AWSLoadBalancerSpec{LoadBalancerType: infrav1.LoadBalancerTypeNLB}
+				reconciler.elbServiceFactory = func(elbScope scope.ELBScope) services.ELBInterface {
+					return elbSvc
+				}
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStateRunning,
+				}, nil)
+				elbSvc.EXPECT().IsInstanceRegisteredWithAPIServerLB(gomock.Any()).Return([]string{"target-group-arn"}, true, nil)
+				elbSvc.EXPECT().DeregisterInstanceFromAPIServerLB("target-group-arn", gomock.Any()).Return(nil)
+				elbSvc.EXPECT().IsInstanceDrainingFromAPIServerLB(gomock.Any()).Return(true, nil)
+				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Times(0)
+				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+				result, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+				g.Expect(result.RequeueAfter).ToNot(BeZero())
+				g.Expect(ms.AWSMachine.Finalizers).To(ContainElement(metav1.FinalizerDeleteDependents))
+				g.Eventually(recorder.Events).Should(Receive(ContainSubstring("SuccessfulDetachControlPlaneELB")))
+				expectConditions(g, ms.AWSMachine, []conditionAssertion{{infrav1.ELBAttachedCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrav1.DeregisteringFromLoadBalancerReason}})
+			})
+			t.Run("should not deregister the instance from the load balancer while its reconciliation is paused", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				finalizer(t, g)
+				ms.Machine.Labels = map[string]string{clusterv1.MachineControlPlaneLabel: ""}
+				cs.AWSCluster.Annotations = map[string]string{infrav1.PauseLoadBalancerAnnotation: ""}
+				cs.AWSCluster.Spec.ControlPlaneLoadBalancer = &infrav1.AWSLoadBalancerSpec{LoadBalancerType: infrav1.LoadBalancerTypeNLB}
+				reconciler.elbServiceFactory = func(elbScope scope.ELBScope) services.ELBInterface {
+					return elbSvc
+				}
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStateRunning,
+				}, nil)
+				elbSvc.EXPECT().DeregisterInstanceFromAPIServerLB(gomock.Any(), gomock.Any()).Times(0)
+				elbSvc.EXPECT().IsInstanceDrainingFromAPIServerLB(gomock.Any()).Times(0)
+				ec2Svc.EXPECT().TerminateInstance("myMachine").Return(nil)
+				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+				_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+			})
+			t.Run("should terminate the instance once it is deregistered from the target groups", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				finalizer(t, g)
+				ms.Machine.Labels = map[string]string{clusterv1.MachineControlPlaneLabel: ""}
+				cs.AWSCluster.Spec.ControlPlaneLoadBalancer = &infrav1.AWSLoadBalancerSpec{LoadBalancerType: infrav1.LoadBalancerTypeNLB}
+				reconciler.elbServiceFactory = func(elbScope scope.ELBScope) services.ELBInterface {
+					return elbSvc
+				}
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStateRunning,
+				}, nil)
+				elbSvc.EXPECT().IsInstanceRegisteredWithAPIServerLB(gomock.Any()).Return(nil, false, nil)
+				elbSvc.EXPECT().IsInstanceDrainingFromAPIServerLB(gomock.Any()).Return(false, nil)
+				ec2Svc.EXPECT().TerminateInstance("myMachine").Return(nil)
+				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+				_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+				expectConditions(g, ms.AWSMachine, []conditionAssertion{
+					{infrav1.ELBAttachedCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, clusterv1.DeletedReason},
+					{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, clusterv1.DeletedReason},
+				})
+			})
+			t.Run("should terminate an instance launched by an AutoScalingGroup through the AutoScalingGroup", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				finalizer(t, g)
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStateRunning,
+					Tags:  map[string]string{"aws:autoscaling:groupName": "pool-asg"},
+				}, nil)
+				asgSvc.EXPECT().TerminateInstanceAndDecrementCapacity("myMachine").Return(nil)
+				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Times(0)
+				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+				_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+				expectConditions(g, ms.AWSMachine, []conditionAssertion{
+					{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, clusterv1.DeletedReason},
+				})
+			})
+			t.Run("should terminate an instance directly when it is no longer part of its AutoScalingGroup", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				finalizer(t, g)
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStateRunning,
+					Tags:  map[string]string{"aws:autoscaling:groupName": "pool-asg"},
+				}, nil)
+				asgSvc.EXPECT().TerminateInstanceAndDecrementCapacity("myMachine").Return(awserrors.NewNotFound("instance is not part of an AutoScalingGroup"))
+				ec2Svc.EXPECT().TerminateInstance("myMachine").Return(nil)
+				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+				_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+				expectConditions(g, ms.AWSMachine, []conditionAssertion{
+					{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, clusterv1.DeletedReason},
+				})
+			})
+			t.Run("should return an error when an instance can't be terminated through its AutoScalingGroup", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				finalizer(t, g)
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStateRunning,
+					Tags:  map[string]string{"aws:autoscaling:groupName": "pool-asg"},
+				}, nil)
+				asgSvc.EXPECT().TerminateInstanceAndDecrementCapacity("myMachine").Return(errors.New("throttled"))
+				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Times(0)
+
+				_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+				g.Expect(err).To(HaveOccurred())
+			})
+			t.Run("should terminate a standalone instance directly", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				finalizer(t, g)
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStateRunning,
+				}, nil)
+				ec2Svc.EXPECT().TerminateInstance("myMachine").Return(nil)
+				asgSvc.EXPECT().TerminateInstanceAndDecrementCapacity(gomock.Any()).Times(0)
+				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+				_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+			})
+			t.Run("should disable the deletion protection of a control plane instance before terminating it", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				finalizer(t, g)
+				ms.Machine.Labels = map[string]string{clusterv1.MachineControlPlaneLabel: ""}
+				ms.AWSMachine.Spec.EnableDeletionProtection = aws.Bool(true)
+				cs.AWSCluster.Spec.ControlPlaneLoadBalancer = &infrav1.AWSLoadBalancerSpec{LoadBalancerType: infrav1.LoadBalancerTypeNLB}
+				reconciler.elbServiceFactory = func(elbScope scope.ELBScope) services.ELBInterface {
+					return elbSvc
+				}
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStateRunning,
+				}, nil)
+				elbSvc.EXPECT().IsInstanceRegisteredWithAPIServerLB(gomock.Any()).Return(nil, false, nil)
+				elbSvc.EXPECT().IsInstanceDrainingFromAPIServerLB(gomock.Any()).Return(false, nil)
+				gomock.InOrder(
+					ec2Svc.EXPECT().DisableInstanceDeletionProtection("myMachine").Return(nil),
+					ec2Svc.EXPECT().TerminateInstance("myMachine").Return(nil),
+				)
+				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+				_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+			})
+			t.Run("should not terminate a control plane instance whose deletion protection can't be disabled", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				finalizer(t, g)
+				ms.Machine.Labels = map[string]string{clusterv1.MachineControlPlaneLabel: ""}
+				ms.AWSMachine.Spec.EnableDeletionProtection = aws.Bool(true)
+				cs.AWSCluster.Spec.ControlPlaneLoadBalancer = &infrav1.AWSLoadBalancerSpec{LoadBalancerType: infrav1.LoadBalancerTypeNLB}
+				reconciler.elbServiceFactory = func(elbScope scope.ELBScope) services.ELBInterface {
+					return elbSvc
+				}
+
+				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{
+					ID:    "myMachine",
+					State: infrav1.InstanceStateRunning,
+				}, nil)
+				elbSvc.EXPECT().IsInstanceRegisteredWithAPIServerLB(gomock.Any()).Return(nil, false, nil)
+				elbSvc.EXPECT().IsInstanceDrainingFromAPIServerLB(gomock.Any()).Return(false, nil)
+				ec2Svc.EXPECT().DisableInstanceDeletionProtection("myMachine").Return(errors.New("unauthorized"))
+				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Times(0)
+				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+				_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+				g.Expect(err).ToNot(BeNil())
+				g.Eventually(recorder.Events).Should(Receive(ContainSubstring("FailedTerminate")))
+				expectConditions(g, ms.AWSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityWarning, "DeletingFailed"}})
+			})
 			t.Run("should fail if secretPrefix present, but secretCount is not set", func(t *testing.T) {
 				g := NewWithT(t)
 				awsMachine := getAWSMachine()
@@ -2672,6 +3281,355 @@ func createObject(g *WithT, obj client.Object, namespace string) {
 	}
 }
 
+func TestAWSMachineReconcilerSecurityGroupsChanged(t *testing.T) {
+	testCases := []struct {
+		name        string
+		core        []string
+		additional  []string
+		existing    map[string][]string
+		wantChanged bool
+		wantIDs     []string
+	}{
+		{
+			name:        "core security groups come first, followed by the additional ones in spec order",
+			core:        []string{"sg-control-plane", "sg-node", "sg-lb"},
+			additional:  []string{"sg-z", "sg-a", "sg-m"},
+			existing:    map[string][]string{"eni-1": {"sg-control-plane", "sg-node", "sg-lb"}},
+			wantChanged: true,
+			wantIDs:     []string{"sg-control-plane", "sg-node", "sg-lb", "sg-z", "sg-a", "sg-m"},
+		},
+		{
+			name:        "security groups listed twice are only attached once",
+			core:        []string{"sg-node", "sg-lb"},
+			additional:  []string{"sg-a", "sg-node"},
+			existing:    map[string][]string{"eni-1": {"sg-node", "sg-lb"}},
+			wantChanged: true,
+			wantIDs:     []string{"sg-node", "sg-lb", "sg-a"},
+		},
+		{
+			name:        "attached security groups in a different order are not changed",
+			core:        []string{"sg-node", "sg-lb"},
+			additional:  []string{"sg-z", "sg-a"},
+			existing:    map[string][]string{"eni-1": {"sg-a", "sg-lb", "sg-z", "sg-node"}},
+			wantChanged: false,
+			wantIDs:     []string{"sg-node", "sg-lb", "sg-z", "sg-a"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			r := &AWSMachineReconciler{}
+
+			changed, ids := r.securityGroupsChanged(nil, tc.core, tc.additional, tc.existing)
+			g.Expect(changed).To(Equal(tc.wantChanged))
+			g.Expect(ids).To(Equal(tc.wantIDs))
+		})
+	}
+}
+
+func TestAWSMachineReconcilerReconcileBootDiagnostics(t *testing.T) {
+	gracePeriod := 10 * time.Minute
+	testCases := []struct {
+		name             string
+		controlPlane     bool
+		nodeRef          *corev1.ObjectReference
+		instanceAge      time.Duration
+		bootDiagnostics  *infrav1.BootDiagnostics
+		captured         bool
+		expect           func(ec2Svc *mock_services.MockEC2InterfaceMockRecorder, objectStoreSvc *mock_services.MockObjectStoreInterfaceMockRecorder)
+		wantRequeue      bool
+		wantConditionSet bool
+	}{
+		{
+			name:            "does nothing within the grace period",
+			controlPlane:    true,
+			instanceAge:     time.Minute,
+			bootDiagnostics: &infrav1.BootDiagnostics{GracePeriod: &metav1.Duration{Duration: gracePeriod}},
+			wantRequeue:     true,
+		},
+		{
+			name:            "captures and uploads the diagnostics after the grace period",
+			controlPlane:    true,
+			instanceAge:     gracePeriod + time.Minute,
+			bootDiagnostics: &infrav1.BootDiagnostics{GracePeriod: &metav1.Duration{Duration: gracePeriod}},
+			expect: func(ec2Svc *mock_services.MockEC2InterfaceMockRecorder, objectStoreSvc *mock_services.MockObjectStoreInterfaceMockRecorder) {
+				ec2Svc.GetConsoleDiagnostics("i-12345").Return([]byte("kernel panic"), []byte("jpeg"), nil)
+				objectStoreSvc.CreateBootDiagnostics(gomock.Any(), map[string][]byte{
+					"console-output.txt":     []byte("kernel panic"),
+					"console-screenshot.jpg": []byte("jpeg"),
+				}).Return("s3://bucket/boot-diagnostics/test/", nil)
+			},
+			wantConditionSet: true,
+		},
+		{
+			name:            "uses the default grace period",
+			controlPlane:    true,
+			instanceAge:     gracePeriod + time.Minute,
+			bootDiagnostics: &infrav1.BootDiagnostics{},
+			wantRequeue:     true,
+		},
+		{
+			name:            "does nothing once the diagnostics were captured",
+			controlPlane:    true,
+			instanceAge:     gracePeriod + time.Minute,
+			bootDiagnostics: &infrav1.BootDiagnostics{GracePeriod: &metav1.Duration{Duration: gracePeriod}},
+			captured:        true,
+		},
+		{
+			name:            "does nothing for a control plane machine with a node",
+			controlPlane:    true,
+			nodeRef:         &corev1.ObjectReference{Name: "node"},
+			instanceAge:     gracePeriod + time.Minute,
+			bootDiagnostics: &infrav1.BootDiagnostics{GracePeriod: &metav1.Duration{Duration: gracePeriod}},
+		},
+		{
+			name:            "does nothing for a worker machine",
+			instanceAge:     gracePeriod + time.Minute,
+			bootDiagnostics: &infrav1.BootDiagnostics{GracePeriod: &metav1.Duration{Duration: gracePeriod}},
+		},
+		{
+			name:         "does nothing when boot diagnostics are disabled",
+			controlPlane: true,
+			instanceAge:  gracePeriod + time.Minute,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			objectStoreSvc := mock_services.NewMockObjectStoreInterface(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Svc.EXPECT(), objectStoreSvc.EXPECT())
+			}
+
+			awsMachine := getAWSMachine()
+			awsMachine.Spec.ProviderID = aws.String("aws:///us-east-1a/i-12345")
+			awsMachine.Status.InstanceState = &infrav1.InstanceStateRunning
+			awsMachine.Status.InstanceCreationTime = &metav1.Time{Time: time.Now().Add(-tc.instanceAge)}
+			if tc.captured {
+				conditions.MarkTrue(awsMachine, infrav1.BootDiagnosticsCapturedCondition)
+			}
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Labels: map[string]string{}},
+				Status:     clusterv1.MachineStatus{NodeRef: tc.nodeRef},
+			}
+			if tc.controlPlane {
+				machine.Labels[clusterv1.MachineControlPlaneLabel] = ""
+			}
+
+			client := fake.NewClientBuilder().WithObjects(awsMachine).WithStatusSubresource(awsMachine).Build()
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:  client,
+				Cluster: &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						S3Bucket: &infrav1.S3Bucket{Name: "bucket", BootDiagnostics: tc.bootDiagnostics},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			ms, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       client,
+				Cluster:      &clusterv1.Cluster{},
+				Machine:      machine,
+				InfraCluster: cs,
+				AWSMachine:   awsMachine,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &AWSMachineReconciler{
+				objectStoreServiceFactory: func(cloud.ClusterScoper) services.ObjectStoreInterface {
+					return objectStoreSvc
+				},
+				Recorder: record.NewFakeRecorder(1),
+			}
+
+			requeueAfter := r.reconcileBootDiagnostics(ec2Svc, ms, cs)
+			g.Expect(requeueAfter > 0).To(Equal(tc.wantRequeue))
+			if tc.wantConditionSet {
+				g.Expect(conditions.IsTrue(awsMachine, infrav1.BootDiagnosticsCapturedCondition)).To(BeTrue())
+				g.Expect(conditions.GetMessage(awsMachine, infrav1.BootDiagnosticsCapturedCondition)).To(Equal("s3://bucket/boot-diagnostics/test/"))
+			} else if !tc.captured {
+				g.Expect(conditions.Has(awsMachine, infrav1.BootDiagnosticsCapturedCondition)).To(BeFalse())
+			}
+		})
+	}
+}
+
+func TestAWSMachineReconcilerReconcileTagDrift(t *testing.T) {
+	ownershipTags := infrav1.Tags{
+		infrav1.ClusterTagKey("test-cluster"):                 string(infrav1.ResourceLifecycleOwned),
+		infrav1.ClusterAWSCloudProviderTagKey("test-cluster"): string(infrav1.ResourceLifecycleOwned),
+	}
+	syncedTags := ownershipTags.DeepCopy()
+	syncedTags["team"] = "a"
+	testCases := []struct {
+		name          string
+		policy        infrav1.TagReconcilePolicy
+		instanceTags  infrav1.Tags
+		expect        func(ec2Svc *mock_services.MockEC2InterfaceMockRecorder)
+		wantCondition *conditionAssertion
+	}{
+		{
+			name:         "re-applies the drifted tags without a policy",
+			instanceTags: ownershipTags.DeepCopy(),
+			expect: func(ec2Svc *mock_services.MockEC2InterfaceMockRecorder) {
+				ec2Svc.UpdateResourceTags(PointsTo("i-12345"), map[string]string{"team": "a"}, nil).Return(nil)
+			},
+			wantCondition: &conditionAssertion{conditionType: infrav1.InstanceTagsSyncedCondition, status: corev1.ConditionTrue},
+		},
+		{
+			name:         "re-applies the drifted tags when the policy is enforce",
+			policy:       infrav1.TagReconcilePolicyEnforce,
+			instanceTags: ownershipTags.DeepCopy(),
+			expect: func(ec2Svc *mock_services.MockEC2InterfaceMockRecorder) {
+				ec2Svc.UpdateResourceTags(PointsTo("i-12345"), map[string]string{"team": "a"}, nil).Return(nil)
+			},
+			wantCondition: &conditionAssertion{conditionType: infrav1.InstanceTagsSyncedCondition, status: corev1.ConditionTrue},
+		},
+		{
+			name:         "only reports the drifted tags when the policy is observe",
+			policy:       infrav1.TagReconcilePolicyObserve,
+			instanceTags: ownershipTags.DeepCopy(),
+			wantCondition: &conditionAssertion{
+				conditionType: infrav1.InstanceTagsSyncedCondition,
+				status:        corev1.ConditionFalse,
+				severity:      clusterv1.ConditionSeverityWarning,
+				reason:        infrav1.InstanceTagsDriftedReason,
+			},
+		},
+		{
+			name:         "re-applies the ownership tags when the policy is observe",
+			policy:       infrav1.TagReconcilePolicyObserve,
+			instanceTags: infrav1.Tags{"team": "a"},
+			expect: func(ec2Svc *mock_services.MockEC2InterfaceMockRecorder) {
+				ec2Svc.UpdateResourceTags(PointsTo("i-12345"), map[string]string(ownershipTags), nil).Return(nil)
+			},
+			wantCondition: &conditionAssertion{conditionType: infrav1.InstanceTagsSyncedCondition, status: corev1.ConditionTrue},
+		},
+		{
+			name:          "reports the tags as synced when there is no drift",
+			policy:        infrav1.TagReconcilePolicyObserve,
+			instanceTags:  syncedTags,
+			wantCondition: &conditionAssertion{conditionType: infrav1.InstanceTagsSyncedCondition, status: corev1.ConditionTrue},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Svc.EXPECT())
+			}
+
+			awsMachine := getAWSMachine()
+			awsMachine.Spec.ProviderID = aws.String("aws:///us-east-1a/i-12345")
+			awsMachine.Spec.AdditionalTags = infrav1.Tags{"team": "a"}
+			if tc.policy != "" {
+				awsMachine.Annotations = map[string]string{infrav1.TagReconcilePolicyAnnotation: string(tc.policy)}
+			}
+
+			client := fake.NewClientBuilder().WithObjects(awsMachine).WithStatusSubresource(awsMachine).Build()
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"}},
+				AWSCluster: &infrav1.AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			ms, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       client,
+				Cluster:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"}},
+				Machine:      &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+				InfraCluster: cs,
+				AWSMachine:   awsMachine,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &AWSMachineReconciler{Recorder: record.NewFakeRecorder(1)}
+
+			instance := &infrav1.Instance{ID: "i-12345", Tags: tc.instanceTags}
+			g.Expect(r.reconcileTagDrift(ec2Svc, ms, instance, cs.KubernetesClusterName())).To(Succeed())
+			if tc.wantCondition == nil {
+				g.Expect(conditions.Has(awsMachine, infrav1.InstanceTagsSyncedCondition)).To(BeFalse())
+				return
+			}
+			expectConditions(g, awsMachine, []conditionAssertion{*tc.wantCondition})
+		})
+	}
+}
+
+func TestAWSMachineReconcilerEnsureSourceDestCheck(t *testing.T) {
+	testCases := []struct {
+		name            string
+		sourceDestCheck *bool
+		instanceState   infrav1.InstanceState
+		instanceCheck   *bool
+		expect          func(ec2Svc *mock_services.MockEC2InterfaceMockRecorder)
+	}{
+		{
+			name:          "leaves the default when the source/destination check isn't set",
+			instanceState: infrav1.InstanceStateRunning,
+			instanceCheck: aws.Bool(true),
+		},
+		{
+			name:            "disables the source/destination check when it is false",
+			sourceDestCheck: aws.Bool(false),
+			instanceState:   infrav1.InstanceStateRunning,
+			instanceCheck:   aws.Bool(true),
+			expect: func(ec2Svc *mock_services.MockEC2InterfaceMockRecorder) {
+				ec2Svc.ModifyInstanceSourceDestCheck("i-12345", false).Return(nil)
+			},
+		},
+		{
+			name:            "re-enables the source/destination check when it drifted",
+			sourceDestCheck: aws.Bool(true),
+			instanceState:   infrav1.InstanceStateRunning,
+			instanceCheck:   aws.Bool(false),
+			expect: func(ec2Svc *mock_services.MockEC2InterfaceMockRecorder) {
+				ec2Svc.ModifyInstanceSourceDestCheck("i-12345", true).Return(nil)
+			},
+		},
+		{
+			name:            "does nothing when the source/destination check is already applied",
+			sourceDestCheck: aws.Bool(false),
+			instanceState:   infrav1.InstanceStateRunning,
+			instanceCheck:   aws.Bool(false),
+		},
+		{
+			name:            "waits for the instance to be running",
+			sourceDestCheck: aws.Bool(false),
+			instanceState:   infrav1.InstanceStatePending,
+			instanceCheck:   aws.Bool(true),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Svc.EXPECT())
+			}
+
+			awsMachine := getAWSMachine()
+			awsMachine.Spec.SourceDestCheck = tc.sourceDestCheck
+
+			r := &AWSMachineReconciler{}
+			instance := &infrav1.Instance{ID: "i-12345", State: tc.instanceState, SourceDestCheck: tc.instanceCheck}
+			g.Expect(r.ensureSourceDestCheck(ec2Svc, instance, awsMachine)).To(Succeed())
+		})
+	}
+}
+
 func cleanupObject(g *WithT, obj client.Object) {
 	if obj.DeepCopyObject() != nil {
 		g.Expect(testEnv.Cleanup(ctx, obj)).To(Succeed())
@@ -77,42 +77,34 @@ func (r *AWSMachineReconciler) ensureSecurityGroups(ec2svc service.EC2Interface,
 }
 
 // securityGroupsChanged determines which security groups to delete and which to add.
-func (r *AWSMachineReconciler) securityGroupsChanged(annotation map[string]interface{}, core []string, additional []string, existing map[string][]string) (bool, []string) {
-	state := map[string]bool{}
-	for _, s := range additional {
-		state[s] = true
-	}
-
-	// Loop over `annotation`, checking the state for things that were deleted since last time.
-	// If we find something in the `annotation`, but not in the state, we flag it as `false` (not found, deleted).
-	for groupID := range annotation {
-		if _, ok := state[groupID]; !ok {
-			state[groupID] = false
-		}
-	}
-
-	// add (or add back) the core security groups
-	for _, s := range core {
-		state[s] = true
-	}
-
+// The returned list is ordered deterministically: the core security groups first, starting with the primary
+// group of the machine, followed by the additional security groups in the order of the spec.
+// The security groups that were attached by a previous reconcile but are no longer listed are left out of it.
+// Only the set of security groups is compared to the existing ones, so a different order doesn't cause an update.
+func (r *AWSMachineReconciler) securityGroupsChanged(_ map[string]interface{}, core []string, additional []string, existing map[string][]string) (bool, []string) {
 	// Build the security group list.
 	res := []string{}
-	for id, keep := range state {
-		if keep {
-			res = append(res, id)
+	seen := map[string]bool{}
+	for _, ids := range [][]string{core, additional} {
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				res = append(res, id)
+			}
 		}
 	}
 
+	sorted := append([]string{}, res...)
+	sort.Strings(sorted)
 	for _, actual := range existing {
-		if len(actual) != len(res) {
+		if len(actual) != len(sorted) {
 			return true, res
 		}
 
 		// Length is the same, check if the ids are the same too.
+		actual = append([]string{}, actual...)
 		sort.Strings(actual)
-		sort.Strings(res)
-		for i, id := range res {
+		for i, id := range sorted {
 			if actual[i] != id {
 				return true, res
 			}
@@ -17,8 +17,16 @@ limitations under the License.
 package controllers
 
 import (
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	service "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 const (
@@ -66,6 +74,53 @@ func (r *AWSMachineReconciler) ensureTags(svc service.EC2Interface, machine *inf
 	return changed, nil
 }
 
+// reconcileTagDrift compares the tags of the instance with the desired tags. The ownership tags of the cluster are
+// always re-applied when they are missing, the additional tags are only re-applied when the tag reconcile policy of
+// the machine is enforce and are otherwise reported by the InstanceTagsSynced condition.
+func (r *AWSMachineReconciler) reconcileTagDrift(svc service.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance, clusterName string) error {
+	policy := infrav1.GetTagReconcilePolicy(machineScope.AWSMachine.GetAnnotations())
+	switch policy {
+	case infrav1.TagReconcilePolicyEnforce, infrav1.TagReconcilePolicyObserve:
+	default:
+		machineScope.Info("Ignoring unknown tag reconcile policy", "policy", policy)
+		return nil
+	}
+
+	required := infrav1.Tags{
+		infrav1.ClusterTagKey(clusterName):                 string(infrav1.ResourceLifecycleOwned),
+		infrav1.ClusterAWSCloudProviderTagKey(clusterName): string(infrav1.ResourceLifecycleOwned),
+	}.Difference(instance.Tags)
+	drifted := infrav1.Tags(machineScope.AdditionalTags()).Difference(instance.Tags)
+
+	if policy == infrav1.TagReconcilePolicyEnforce {
+		required.Merge(drifted)
+	}
+	if len(required) > 0 {
+		if err := svc.UpdateResourceTags(machineScope.GetInstanceID(), required, nil); err != nil {
+			return err
+		}
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "InstanceTagsReapplied", "Re-applied tags %s to instance", sortedTagKeys(required))
+	}
+
+	if policy == infrav1.TagReconcilePolicyObserve && len(drifted) > 0 {
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceTagsSyncedCondition, infrav1.InstanceTagsDriftedReason, clusterv1.ConditionSeverityWarning,
+			"Tags %s of the instance were removed or changed outside of Cluster API", sortedTagKeys(drifted))
+		return nil
+	}
+	conditions.MarkTrue(machineScope.AWSMachine, infrav1.InstanceTagsSyncedCondition)
+
+	return nil
+}
+
+func sortedTagKeys(tags infrav1.Tags) string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ", ")
+}
+
 // Ensure that the tags of the volumes in the machine are correct
 // Returns tags which are being created/updated/deleted and error.
 func (r *AWSMachineReconciler) ensureVolumeTags(svc service.EC2Interface, volumeID *string, annotation map[string]interface{}, additionalTags map[string]string) (map[string]interface{}, error) {
@@ -180,6 +180,7 @@ func getClusterScope(awsCluster infrav1.AWSCluster) (*scope.ClusterScope, error)
 			},
 			AWSCluster:                   &awsCluster,
 			TagUnmanagedNetworkResources: true,
+			UseBlockingWaiters:           true,
 		},
 	)
 }
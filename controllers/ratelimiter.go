@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+)
+
+const (
+	// DefaultErrorBackoff is the default initial delay before an object that failed to reconcile is reconciled again.
+	DefaultErrorBackoff = 5 * time.Millisecond
+
+	// maxErrorBackoff is the maximum delay before an object that failed to reconcile is reconciled again.
+	maxErrorBackoff = 1000 * time.Second
+)
+
+// NewErrorBackoffRateLimiter returns the rate limiter of the controller-runtime controllers, with the initial delay
+// before an object that failed to reconcile is reconciled again set to errorBackoff. The delay doubles on every
+// consecutive failure, up to a maximum of 1000s. DefaultErrorBackoff is used when errorBackoff is zero.
+func NewErrorBackoffRateLimiter(errorBackoff time.Duration) ratelimiter.RateLimiter {
+	if errorBackoff <= 0 {
+		errorBackoff = DefaultErrorBackoff
+	}
+	return workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(errorBackoff, maxErrorBackoff),
+		// 10 qps, 100 bucket size, as for the default controller-runtime rate limiter.
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewErrorBackoffRateLimiter(t *testing.T) {
+	tests := []struct {
+		name         string
+		errorBackoff time.Duration
+		expected     []time.Duration
+	}{
+		{
+			name:         "uses the default error backoff when unset",
+			errorBackoff: 0,
+			expected:     []time.Duration{5 * time.Millisecond, 10 * time.Millisecond, 20 * time.Millisecond},
+		},
+		{
+			name:         "uses the configured error backoff",
+			errorBackoff: time.Second,
+			expected:     []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		{
+			name:         "caps the error backoff",
+			errorBackoff: 600 * time.Second,
+			expected:     []time.Duration{600 * time.Second, 1000 * time.Second, 1000 * time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			limiter := NewErrorBackoffRateLimiter(tt.errorBackoff)
+			for _, expected := range tt.expected {
+				g.Expect(limiter.When("item")).To(Equal(expected))
+			}
+
+			limiter.Forget("item")
+			g.Expect(limiter.When("item")).To(Equal(tt.expected[0]))
+		})
+	}
+}
@@ -40,6 +40,17 @@ func (r *AWSManagedControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	dst.Spec.VpcCni.Disable = r.Spec.DisableVPCCNI
 	dst.Spec.Partition = restored.Spec.Partition
+	dst.Spec.VpcCni.CustomNetworking = restored.Spec.VpcCni.CustomNetworking
+	dst.Spec.ExternalManaged = restored.Spec.ExternalManaged
+	dst.Status.Version = restored.Status.Version
+	dst.Spec.PodIdentityAssociations = restored.Spec.PodIdentityAssociations
+	dst.Spec.KubeNetwork = restored.Spec.KubeNetwork
+	dst.Spec.DefaultAddons = restored.Spec.DefaultAddons
+	dst.Spec.IPFamily = restored.Spec.IPFamily
+	dst.Spec.NodeEgressRules = restored.Spec.NodeEgressRules
+	dst.Spec.ManagedEncryptionKey = restored.Spec.ManagedEncryptionKey
+	dst.Spec.ReconcileMode = restored.Spec.ReconcileMode
+	dst.Status.ManagedEncryptionKeyARN = restored.Status.ManagedEncryptionKeyARN
 
 	return nil
 }
@@ -116,3 +127,8 @@ func Convert_v1beta2_VpcCni_To_v1beta1_VpcCni(in *ekscontrolplanev1.VpcCni, out
 func Convert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControlPlaneSpec(in *ekscontrolplanev1.AWSManagedControlPlaneSpec, out *AWSManagedControlPlaneSpec, scope apiconversion.Scope) error {
 	return autoConvert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControlPlaneSpec(in, out, scope)
 }
+
+// Convert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPlaneStatus is a conversion function.
+func Convert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPlaneStatus(in *ekscontrolplanev1.AWSManagedControlPlaneStatus, out *AWSManagedControlPlaneStatus, scope apiconversion.Scope) error {
+	return autoConvert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPlaneStatus(in, out, scope)
+}
@@ -40,6 +40,15 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	EKSClusterName string `json:"eksClusterName,omitempty"`
 
+	// ExternalManaged indicates that the EKS cluster named EKSClusterName already exists and is
+	// managed outside of CAPA, for example by Terraform. The cluster is adopted: its endpoint,
+	// certificate authority, version, OIDC provider, VPC and subnets are read from AWS, but CAPA
+	// never creates, updates or deletes the EKS cluster, its IAM role, its OIDC provider, its
+	// network or its security groups. Node groups, addons and the kubeconfig are still managed
+	// by CAPA.
+	// +optional
+	ExternalManaged bool `json:"externalManaged,omitempty"`
+
 	// IdentityRef is a reference to a identity to be used when reconciling the managed control plane.
 	// +optional
 	IdentityRef *infrav1.AWSIdentityReference `json:"identityRef,omitempty"`
@@ -52,6 +61,16 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	SecondaryCidrBlock *string `json:"secondaryCidrBlock,omitempty"`
 
+	// NodeEgressRules are egress rules added to the security group of the managed nodes, which EKS creates
+	// along with the cluster, for instance to only let the nodes reach an egress proxy. When rules are set, the
+	// default egress rule of the security group allowing all outbound traffic is revoked, and it is restored
+	// once the rules are removed. A rule allowing all outbound traffic to the security group itself is kept, so
+	// the nodes can still reach each other and the control plane, which uses the same security group. The rules
+	// previously added by CAPA and removed from the list are revoked, the other rules of the security group are
+	// left untouched.
+	// +optional
+	NodeEgressRules infrav1.EgressRules `json:"nodeEgressRules,omitempty"`
+
 	// The AWS Region the cluster lives in.
 	Region string `json:"region,omitempty"`
 
@@ -95,6 +114,23 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	EncryptionConfig *EncryptionConfig `json:"encryptionConfig,omitempty"`
 
+	// ManagedEncryptionKey, when true, has CAPA create a customer managed KMS key dedicated to the cluster, with
+	// automatic rotation enabled and the alias alias/<cluster name>-ebs. The key encrypts the Kubernetes secrets
+	// of the cluster when EncryptionConfig doesn't set its own provider, and the encrypted EBS volumes of the
+	// cluster instances which don't set their own encryption key. The deletion of the key is scheduled when the
+	// cluster is deleted. It can't be disabled once enabled.
+	// +optional
+	ManagedEncryptionKey bool `json:"managedEncryptionKey,omitempty"`
+
+	// ReconcileMode sets how the AWS resources of the cluster are reconciled. In the full mode, the resources
+	// are created and updated to match the spec. In the detectOnly mode, no AWS API operation changing a
+	// resource is called, for the control plane nor its node groups and Fargate profiles, the resources of
+	// the workload cluster are not reconciled, and the operations that would have been called are reported
+	// by the ResourcesInSync condition and by events instead. Defaults to full.
+	// +kubebuilder:validation:Enum=full;detectOnly
+	// +optional
+	ReconcileMode infrav1.ReconcileMode `json:"reconcileMode,omitempty"`
+
 	// AdditionalTags is an optional set of tags to add to AWS resources managed by the AWS provider, in addition to the
 	// ones added by default.
 	// +optional
@@ -162,6 +198,12 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	Addons *[]Addon `json:"addons,omitempty"`
 
+	// PodIdentityAssociations defines the EKS Pod Identity associations between Kubernetes
+	// service accounts and IAM roles. The eks-pod-identity-agent addon is installed when
+	// associations are specified.
+	// +optional
+	PodIdentityAssociations []PodIdentityAssociation `json:"podIdentityAssociations,omitempty"`
+
 	// IdentityProviderconfig is used to specify the oidc provider config
 	// to be attached with this eks cluster
 	// +optional
@@ -173,6 +215,35 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 
 	// KubeProxy defines managed attributes of the kube-proxy daemonset
 	KubeProxy KubeProxy `json:"kubeProxy,omitempty"`
+
+	// DefaultAddons defines how the addons EKS installs into the cluster by default,
+	// coredns and kube-proxy, are managed.
+	// +optional
+	DefaultAddons DefaultAddons `json:"defaultAddons,omitempty"`
+
+	// IPFamily is the IP family of the pod and service addresses of the EKS cluster. If ipv6 is
+	// selected, the VPC and subnets of the cluster must have IPv6 CIDR blocks; for a managed VPC
+	// they are assigned by AWS unless specified. If not set, the cluster is ipv6 when IPv6 is
+	// enabled for the VPC, and ipv4 otherwise. The IP family can't be changed after the cluster
+	// has been created.
+	// +kubebuilder:validation:Enum=ipv4;ipv6
+	// +optional
+	IPFamily IPFamily `json:"ipFamily,omitempty"`
+
+	// KubeNetwork defines the Kubernetes network configuration of the EKS cluster.
+	// +optional
+	KubeNetwork KubeNetwork `json:"kubeNetwork,omitempty"`
+}
+
+// KubeNetwork specifies the Kubernetes network configuration of the EKS cluster.
+type KubeNetwork struct {
+	// ServiceCIDR is the IPv4 CIDR block Kubernetes service IP addresses are assigned from.
+	// It must be within the 10.0.0.0/8, 172.16.0.0/12 or 192.168.0.0/16 ranges, be between
+	// a /12 and /24 netmask and not overlap with the CIDR blocks of the VPC. If not set, the
+	// services CIDR of the cluster network, or else the EKS default, is used.
+	// The service CIDR can't be changed after the cluster has been created.
+	// +optional
+	ServiceCIDR string `json:"serviceCIDR,omitempty"`
 }
 
 // KubeProxy specifies how the kube-proxy daemonset is managed.
@@ -198,6 +269,50 @@ type VpcCni struct {
 	// Env defines a list of environment variables to apply to the `aws-node` DaemonSet
 	// +optional
 	Env []corev1.EnvVar `json:"env,omitempty"`
+	// CustomNetworking configures the Amazon VPC CNI to assign pod IP addresses from
+	// subnets other than the subnets of the nodes.
+	// +optional
+	CustomNetworking *VpcCniCustomNetworking `json:"customNetworking,omitempty"`
+}
+
+// VpcCniCustomNetworking specifies the custom networking configuration of the VPC CNI.
+type VpcCniCustomNetworking struct {
+	// Enabled indicates that custom networking should be enabled in the VPC CNI. When enabled the
+	// `aws-node` DaemonSet is configured to use the ENIConfig named after the availability zone
+	// of each node, and an ENIConfig is created in the workload cluster for every availability zone.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled"`
+	// ENIConfigs defines the subnet and security groups of the pod network interfaces in each
+	// availability zone. If empty, the secondary subnets of the cluster are used with the node
+	// security group.
+	// +optional
+	ENIConfigs []VpcCniENIConfig `json:"eniConfigs,omitempty"`
+}
+
+// VpcCniENIConfig specifies the pod network interface configuration of an availability zone.
+type VpcCniENIConfig struct {
+	// AvailabilityZone is the availability zone the configuration applies to.
+	AvailabilityZone string `json:"availabilityZone"`
+	// SubnetID is the ID of the subnet the pod network interfaces are created in.
+	SubnetID string `json:"subnetId"`
+	// SecurityGroupIDs are the IDs of the security groups attached to the pod network interfaces.
+	// If empty, the node security group is used.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+}
+
+// IsCustomNetworkingEnabled returns whether custom networking is enabled in the VPC CNI.
+func (v VpcCni) IsCustomNetworkingEnabled() bool {
+	return v.CustomNetworking != nil && v.CustomNetworking.Enabled
+}
+
+// IsIPv6 returns whether the pods and services of the EKS cluster have IPv6 addresses. If the IP family isn't set,
+// the cluster is IPv6 when IPv6 is enabled for the VPC.
+func (s *AWSManagedControlPlaneSpec) IsIPv6() bool {
+	if s.IPFamily != "" {
+		return s.IPFamily == IPFamilyIPv6
+	}
+	return s.NetworkSpec.VPC.IsIPv6Enabled()
 }
 
 // EndpointAccess specifies how control plane endpoints are accessible.
@@ -221,6 +336,11 @@ type EncryptionConfig struct {
 	Resources []*string `json:"resources,omitempty"`
 }
 
+// HasEncryptionProvider returns whether the encryption configuration sets the KMS key encrypting the secrets.
+func (s *AWSManagedControlPlaneSpec) HasEncryptionProvider() bool {
+	return s.EncryptionConfig != nil && s.EncryptionConfig.Provider != nil && *s.EncryptionConfig.Provider != ""
+}
+
 // OIDCProviderStatus holds the status of the AWS OIDC identity provider.
 type OIDCProviderStatus struct {
 	// ARN holds the ARN of the provider
@@ -255,6 +375,9 @@ type AWSManagedControlPlaneStatus struct {
 	// is managed by an external service such as AKS, EKS, GKE, etc.
 	// +kubebuilder:default=true
 	ExternalManagedControlPlane *bool `json:"externalManagedControlPlane,omitempty"`
+	// Version is the Kubernetes version of the EKS control plane.
+	// +optional
+	Version *string `json:"version,omitempty"`
 	// Initialized denotes whether or not the control plane has the
 	// uploaded kubernetes config-map.
 	// +optional
@@ -276,6 +399,9 @@ type AWSManagedControlPlaneStatus struct {
 	// associated identity provider
 	// +optional
 	IdentityProviderStatus IdentityProviderStatus `json:"identityProviderStatus,omitempty"`
+	// ManagedEncryptionKeyARN is the ARN of the KMS key created for ManagedEncryptionKey.
+	// +optional
+	ManagedEncryptionKeyARN string `json:"managedEncryptionKeyARN,omitempty"`
 }
 
 // +kubebuilder:object:root=true
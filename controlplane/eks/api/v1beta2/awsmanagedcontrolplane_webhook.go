@@ -21,6 +21,7 @@ import (
 	"net"
 
 	"github.com/apparentlymart/go-cidr/cidr"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,20 +37,24 @@ import (
 )
 
 const (
-	minAddonVersion         = "v1.18.0"
-	minKubeVersionForIPv6   = "v1.21.0"
-	minVpcCniVersionForIPv6 = "1.10.2"
-	maxClusterNameLength    = 100
+	minAddonVersion              = "v1.18.0"
+	minKubeVersionForIPv6        = "v1.21.0"
+	minVpcCniVersionForIPv6      = "1.10.2"
+	minKubeVersionForPodIdentity = "v1.24.0"
+	maxClusterNameLength         = 100
 )
 
 // log is for logging in this package.
 var mcpLog = ctrl.Log.WithName("awsmanagedcontrolplane-resource")
 
 const (
-	cidrSizeMax    = 65536
-	cidrSizeMin    = 16
-	vpcCniAddon    = "vpc-cni"
-	kubeProxyAddon = "kube-proxy"
+	cidrSizeMax          = 65536
+	cidrSizeMin          = 16
+	serviceCIDRMinPrefix = 12
+	serviceCIDRMaxPrefix = 24
+	vpcCniAddon          = "vpc-cni"
+	kubeProxyAddon       = "kube-proxy"
+	coreDNSAddon         = "coredns"
 )
 
 // SetupWebhookWithManager will setup the webhooks for the AWSManagedControlPlane.
@@ -88,11 +93,18 @@ func (r *AWSManagedControlPlane) ValidateCreate() (admission.Warnings, error) {
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.validateIAMAuthConfig()...)
 	allErrs = append(allErrs, r.validateSecondaryCIDR()...)
+	allErrs = append(allErrs, r.validateServiceCIDR()...)
 	allErrs = append(allErrs, r.validateEKSAddons()...)
 	allErrs = append(allErrs, r.validateDisableVPCCNI()...)
+	allErrs = append(allErrs, r.validateVpcCniCustomNetworking()...)
+	allErrs = append(allErrs, r.validatePodIdentityAssociations()...)
 	allErrs = append(allErrs, r.validateKubeProxy()...)
+	allErrs = append(allErrs, r.validateDefaultAddons()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, infrav1.ValidateTagReconcilePolicyAnnotation(r.GetAnnotations())...)
 	allErrs = append(allErrs, r.validateNetwork()...)
+	allErrs = append(allErrs, r.validateNetworkSpec()...)
+	allErrs = append(allErrs, r.validateNodeEgressRules()...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -122,10 +134,29 @@ func (r *AWSManagedControlPlane) ValidateUpdate(old runtime.Object) (admission.W
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.validateIAMAuthConfig()...)
 	allErrs = append(allErrs, r.validateSecondaryCIDR()...)
+	allErrs = append(allErrs, r.validateServiceCIDR()...)
 	allErrs = append(allErrs, r.validateEKSAddons()...)
 	allErrs = append(allErrs, r.validateDisableVPCCNI()...)
+	allErrs = append(allErrs, r.validateVpcCniCustomNetworking()...)
+	allErrs = append(allErrs, r.validatePodIdentityAssociations()...)
 	allErrs = append(allErrs, r.validateKubeProxy()...)
+	allErrs = append(allErrs, r.validateDefaultAddons()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, infrav1.ValidateTagReconcilePolicyAnnotation(r.GetAnnotations())...)
+	allErrs = append(allErrs, r.validateNetworkSpec()...)
+	allErrs = append(allErrs, r.validateNodeEgressRules()...)
+
+	if r.Spec.ExternalManaged != oldAWSManagedControlplane.Spec.ExternalManaged {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "externalManaged"), r.Spec.ExternalManaged, "field is immutable"),
+		)
+	}
+
+	if r.Spec.KubeNetwork.ServiceCIDR != oldAWSManagedControlplane.Spec.KubeNetwork.ServiceCIDR {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "kubeNetwork", "serviceCIDR"), r.Spec.KubeNetwork.ServiceCIDR, "field is immutable"),
+		)
+	}
 
 	if r.Spec.Region != oldAWSManagedControlplane.Spec.Region {
 		allErrs = append(allErrs,
@@ -151,6 +182,20 @@ func (r *AWSManagedControlPlane) ValidateUpdate(old runtime.Object) (admission.W
 		)
 	}
 
+	if oldAWSManagedControlplane.Spec.ManagedEncryptionKey && !r.Spec.ManagedEncryptionKey {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "managedEncryptionKey"), r.Spec.ManagedEncryptionKey, "field cannot be disabled once enabled"),
+		)
+	}
+
+	// The secrets of a cluster with a managed key and no provider are encrypted with the managed key, which can't
+	// be changed to another provider.
+	if oldAWSManagedControlplane.Spec.ManagedEncryptionKey && !oldAWSManagedControlplane.Spec.HasEncryptionProvider() && r.Spec.HasEncryptionProvider() {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "encryptionConfig", "provider"), r.Spec.EncryptionConfig.Provider, "changing EKS encryption is not allowed after it has been enabled with the managed encryption key"),
+		)
+	}
+
 	// If a identityRef is already set, do not allow removal of it.
 	if oldAWSManagedControlplane.Spec.IdentityRef != nil && r.Spec.IdentityRef == nil {
 		allErrs = append(allErrs,
@@ -159,6 +204,11 @@ func (r *AWSManagedControlPlane) ValidateUpdate(old runtime.Object) (admission.W
 		)
 	}
 
+	if oldAWSManagedControlplane.Spec.IPFamily != r.Spec.IPFamily {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "ipFamily"), r.Spec.IPFamily, "changing IP family is not allowed after it has been set"))
+	}
+
 	if oldAWSManagedControlplane.Spec.NetworkSpec.VPC.IsIPv6Enabled() != r.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
 		allErrs = append(allErrs,
 			field.Invalid(field.NewPath("spec", "networkSpec", "vpc", "enableIPv6"), r.Spec.NetworkSpec.VPC.IsIPv6Enabled(), "changing IP family is not allowed after it has been set"))
@@ -221,7 +271,7 @@ func (r *AWSManagedControlPlane) validateEKSVersion(old *AWSManagedControlPlane)
 		}
 	}
 
-	if r.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
+	if r.Spec.IsIPv6() {
 		minIPv6, _ := version.ParseSemantic(minKubeVersionForIPv6)
 		if v.LessThan(minIPv6) {
 			allErrs = append(allErrs, field.Invalid(path, *r.Spec.Version, fmt.Sprintf("IPv6 requires Kubernetes %s or greater", minKubeVersionForIPv6)))
@@ -233,7 +283,7 @@ func (r *AWSManagedControlPlane) validateEKSVersion(old *AWSManagedControlPlane)
 func (r *AWSManagedControlPlane) validateEKSAddons() field.ErrorList {
 	var allErrs field.ErrorList
 
-	if !r.Spec.NetworkSpec.VPC.IsIPv6Enabled() && (r.Spec.Addons == nil || len(*r.Spec.Addons) == 0) {
+	if !r.Spec.IsIPv6() && (r.Spec.Addons == nil || len(*r.Spec.Addons) == 0) {
 		return allErrs
 	}
 
@@ -259,7 +309,7 @@ func (r *AWSManagedControlPlane) validateEKSAddons() field.ErrorList {
 	// validations for IPv6:
 	// - addons have to be defined in case IPv6 is enabled
 	// - minimum version requirement for VPC-CNI using IPv6 ipFamily is 1.10.2
-	if r.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
+	if r.Spec.IsIPv6() {
 		if r.Spec.Addons == nil || len(*r.Spec.Addons) == 0 {
 			allErrs = append(allErrs, field.Invalid(addonsPath, "", "addons are required to be set explicitly if IPv6 is enabled"))
 			return allErrs
@@ -345,6 +395,54 @@ func (r *AWSManagedControlPlane) validateSecondaryCIDR() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSManagedControlPlane) validateServiceCIDR() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.KubeNetwork.ServiceCIDR == "" {
+		return nil
+	}
+
+	cidrField := field.NewPath("spec", "kubeNetwork", "serviceCIDR")
+	serviceCIDR := r.Spec.KubeNetwork.ServiceCIDR
+	if r.Spec.IsIPv6() {
+		allErrs = append(allErrs, field.Invalid(cidrField, serviceCIDR, "cannot be set if IPv6 is enabled"))
+	}
+
+	_, ipv4Net, err := net.ParseCIDR(serviceCIDR)
+	if err != nil || ipv4Net.IP.To4() == nil {
+		allErrs = append(allErrs, field.Invalid(cidrField, serviceCIDR, "must be valid IPv4 CIDR range"))
+		return allErrs
+	}
+
+	if prefix, _ := ipv4Net.Mask.Size(); prefix < serviceCIDRMinPrefix || prefix > serviceCIDRMaxPrefix {
+		allErrs = append(allErrs, field.Invalid(cidrField, serviceCIDR, "CIDR block sizes must be between a /12 netmask and /24 netmask"))
+	}
+
+	start, end := cidr.AddressRange(ipv4Net)
+	if !start.IsPrivate() || !end.IsPrivate() {
+		allErrs = append(allErrs, field.Invalid(cidrField, serviceCIDR, "must be within the 10.0.0.0/8, 172.16.0.0/12 or 192.168.0.0/16 range"))
+	}
+
+	vpcCIDRs := []string{r.Spec.NetworkSpec.VPC.CidrBlock}
+	if r.Spec.SecondaryCidrBlock != nil {
+		vpcCIDRs = append(vpcCIDRs, *r.Spec.SecondaryCidrBlock)
+	}
+	for _, vpcCIDR := range vpcCIDRs {
+		_, vpcNet, err := net.ParseCIDR(vpcCIDR)
+		if err != nil {
+			continue
+		}
+		if vpcNet.Contains(ipv4Net.IP) || ipv4Net.Contains(vpcNet.IP) {
+			allErrs = append(allErrs, field.Invalid(cidrField, serviceCIDR, fmt.Sprintf("must not overlap with the VPC CIDR block %s", vpcCIDR)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return allErrs
+}
+
 func (r *AWSManagedControlPlane) validateKubeProxy() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -367,6 +465,44 @@ func (r *AWSManagedControlPlane) validateKubeProxy() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSManagedControlPlane) validateDefaultAddons() field.ErrorList {
+	var allErrs field.ErrorList
+
+	defaultAddonsPath := field.NewPath("spec", "defaultAddons")
+	allErrs = append(allErrs, r.validateDefaultAddon(defaultAddonsPath.Child("coreDNS"), coreDNSAddon, r.Spec.DefaultAddons.CoreDNS)...)
+	allErrs = append(allErrs, r.validateDefaultAddon(defaultAddonsPath.Child("kubeProxy"), kubeProxyAddon, r.Spec.DefaultAddons.KubeProxy)...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return allErrs
+}
+
+func (r *AWSManagedControlPlane) validateDefaultAddon(path *field.Path, name string, defaultAddon DefaultAddon) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !defaultAddon.IsManaged() && defaultAddon.Remove {
+		allErrs = append(allErrs, field.Invalid(path.Child("remove"), defaultAddon.Remove, "cannot remove an addon that isn't managed"))
+	}
+
+	if r.Spec.Addons != nil {
+		for _, addon := range *r.Spec.Addons {
+			if addon.Name != name {
+				continue
+			}
+			if defaultAddon.Remove {
+				allErrs = append(allErrs, field.Invalid(path.Child("remove"), defaultAddon.Remove, fmt.Sprintf("cannot remove the %s addon if it is specified in the addons", name)))
+			}
+			if !defaultAddon.IsManaged() {
+				allErrs = append(allErrs, field.Invalid(path.Child("manage"), *defaultAddon.Manage, fmt.Sprintf("cannot stop managing the %s addon if it is specified in the addons", name)))
+			}
+			break
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSManagedControlPlane) validateDisableVPCCNI() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -389,6 +525,76 @@ func (r *AWSManagedControlPlane) validateDisableVPCCNI() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSManagedControlPlane) validateVpcCniCustomNetworking() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !r.Spec.VpcCni.IsCustomNetworkingEnabled() {
+		return nil
+	}
+
+	customNetworkingField := field.NewPath("spec", "vpcCni", "customNetworking")
+	if r.Spec.VpcCni.Disable {
+		allErrs = append(allErrs, field.Invalid(customNetworkingField.Child("enabled"), r.Spec.VpcCni.CustomNetworking.Enabled, "cannot enable custom networking if the vpc cni is disabled"))
+	}
+
+	availabilityZones := map[string]bool{}
+	for i, eniConfig := range r.Spec.VpcCni.CustomNetworking.ENIConfigs {
+		eniConfigField := customNetworkingField.Child("eniConfigs").Index(i)
+		if eniConfig.AvailabilityZone == "" {
+			allErrs = append(allErrs, field.Required(eniConfigField.Child("availabilityZone"), "availabilityZone is required"))
+		} else if availabilityZones[eniConfig.AvailabilityZone] {
+			allErrs = append(allErrs, field.Duplicate(eniConfigField.Child("availabilityZone"), eniConfig.AvailabilityZone))
+		}
+		availabilityZones[eniConfig.AvailabilityZone] = true
+
+		if eniConfig.SubnetID == "" {
+			allErrs = append(allErrs, field.Required(eniConfigField.Child("subnetId"), "subnetId is required"))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return allErrs
+}
+
+func (r *AWSManagedControlPlane) validatePodIdentityAssociations() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(r.Spec.PodIdentityAssociations) == 0 {
+		return nil
+	}
+
+	associationsField := field.NewPath("spec", "podIdentityAssociations")
+	if r.Spec.Version != nil {
+		v, err := parseEKSVersion(*r.Spec.Version)
+		minVersion, _ := version.ParseSemantic(minKubeVersionForPodIdentity)
+		if err == nil && v.LessThan(minVersion) {
+			message := fmt.Sprintf("pod identity associations require Kubernetes %s or greater", minKubeVersionForPodIdentity)
+			allErrs = append(allErrs, field.Invalid(associationsField, *r.Spec.Version, message))
+		}
+	}
+
+	serviceAccounts := map[string]bool{}
+	for i, association := range r.Spec.PodIdentityAssociations {
+		associationField := associationsField.Index(i)
+		serviceAccount := association.ServiceAccountNamespace + "/" + association.ServiceAccountName
+		if serviceAccounts[serviceAccount] {
+			allErrs = append(allErrs, field.Duplicate(associationField.Child("serviceAccountName"), serviceAccount))
+		}
+		serviceAccounts[serviceAccount] = true
+
+		if _, err := arn.Parse(association.RoleARN); err != nil {
+			allErrs = append(allErrs, field.Invalid(associationField.Child("roleARN"), association.RoleARN, "roleARN must be a valid IAM role ARN"))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return allErrs
+}
+
 func (r *AWSManagedControlPlane) validateNetwork() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -412,14 +618,75 @@ func (r *AWSManagedControlPlane) validateNetwork() field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(ipamPoolField, r.Spec.NetworkSpec.VPC.IPv6.IPAMPool, "ipamPool must have either id or name"))
 	}
 
+	egressPath := field.NewPath("spec", "networkSpec", "securityGroupEgressRules")
+	for role, rules := range r.Spec.NetworkSpec.SecurityGroupEgressRules {
+		for i := range rules {
+			allErrs = append(allErrs, r.validateEgressRule(&rules[i], egressPath.Key(string(role)).Index(i))...)
+		}
+	}
+
 	return allErrs
 }
 
+// validateNetworkSpec checks the network resources shared with unmanaged clusters.
+func (r *AWSManagedControlPlane) validateNetworkSpec() field.ErrorList {
+	var allErrs field.ErrorList
+
+	networkPath := field.NewPath("spec", "network")
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateExistingRouteTables(networkPath)...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateTransitGatewayAttachment(networkPath)...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateNetworkACL(networkPath)...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateFlowLogs(networkPath)...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateInstanceConnectEndpoint(networkPath)...)
+
+	return allErrs
+}
+
+// validateNodeEgressRules checks the destinations and port ranges of the egress rules of the node security group.
+func (r *AWSManagedControlPlane) validateNodeEgressRules() field.ErrorList {
+	var allErrs field.ErrorList
+
+	egressPath := field.NewPath("spec", "nodeEgressRules")
+	for i := range r.Spec.NodeEgressRules {
+		allErrs = append(allErrs, r.validateEgressRule(&r.Spec.NodeEgressRules[i], egressPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+// validateEgressRule checks the destinations and port range of an egress rule, and that its destination security
+// group roles have a security group in the cluster.
+func (r *AWSManagedControlPlane) validateEgressRule(rule *infrav1.EgressRule, rulePath *field.Path) field.ErrorList {
+	allErrs := rule.Validate(rulePath)
+	for j, destinationRole := range rule.DestinationSecurityGroupRoles {
+		if !r.hasSecurityGroupRole(destinationRole) {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("destinationSecurityGroupRoles").Index(j), destinationRole, "the cluster has no security group with this role"))
+		}
+	}
+	return allErrs
+}
+
+// hasSecurityGroupRole returns whether a security group with the given role is reconciled for the cluster. The node
+// security group is the cluster security group EKS creates.
+func (r *AWSManagedControlPlane) hasSecurityGroupRole(role infrav1.SecurityGroupRole) bool {
+	switch role {
+	case infrav1.SecurityGroupNode, infrav1.SecurityGroupEKSNodeAdditional:
+		return true
+	case infrav1.SecurityGroupBastion:
+		return r.Spec.Bastion.Enabled
+	case infrav1.SecurityGroupInstanceConnectEndpoint:
+		return r.Spec.NetworkSpec.InstanceConnectEndpoint != nil
+	default:
+		return false
+	}
+}
+
 // Default will set default values for the AWSManagedControlPlane.
 func (r *AWSManagedControlPlane) Default() {
 	mcpLog.Info("AWSManagedControlPlane setting defaults", "control-plane", klog.KObj(r))
 
-	if r.Spec.EKSClusterName == "" {
+	// The name of an externally managed cluster cannot be generated, it must be given.
+	if r.Spec.EKSClusterName == "" && !r.Spec.ExternalManaged {
 		mcpLog.Info("EKSClusterName is empty, generating name")
 		name, err := eks.GenerateEKSName(r.Name, r.Namespace, maxClusterNameLength)
 		if err != nil {
@@ -438,6 +705,11 @@ func (r *AWSManagedControlPlane) Default() {
 		}
 	}
 
+	// An IPv6 cluster needs an IPv6 VPC, AWS assigns its CIDR block unless one is given.
+	if r.Spec.IPFamily == IPFamilyIPv6 && r.Spec.NetworkSpec.VPC.IPv6 == nil {
+		r.Spec.NetworkSpec.VPC.IPv6 = &infrav1.IPv6{}
+	}
+
 	infrav1.SetDefaults_Bastion(&r.Spec.Bastion)
 	infrav1.SetDefaults_NetworkSpec(&r.Spec.NetworkSpec)
 }
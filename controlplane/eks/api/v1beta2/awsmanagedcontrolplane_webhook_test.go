@@ -35,6 +35,7 @@ var (
 	vV1_17_1 = "v1.17.1"
 	vV1_17   = "v1.17"
 	vV1_16   = "v1.16"
+	vV1_22   = "v1.22"
 )
 
 func TestDefaultingWebhook(t *testing.T) {
@@ -70,6 +71,10 @@ func TestDefaultingWebhook(t *testing.T) {
 		},
 	}
 
+	ipv6VPCSpec := *defaultVPCSpec.DeepCopy()
+	ipv6VPCSpec.IPv6 = &infrav1.IPv6{}
+	ipv6Addons := []Addon{{Name: vpcCniAddon, Version: "v1.11.0", ConflictResolution: (*AddonResolution)(ptr.To(string(AddonResolutionOverwrite)))}}
+
 	tests := []struct {
 		name         string
 		resourceName string
@@ -124,6 +129,23 @@ func TestDefaultingWebhook(t *testing.T) {
 			spec:         AWSManagedControlPlaneSpec{NetworkSpec: infrav1.NetworkSpec{CNI: &infrav1.CNISpec{}}},
 			expectSpec:   AWSManagedControlPlaneSpec{EKSClusterName: "default_cluster1", IdentityRef: defaultIdentityRef, Bastion: defaultTestBastion, NetworkSpec: infrav1.NetworkSpec{CNI: &infrav1.CNISpec{}, VPC: defaultVPCSpec}, TokenMethod: &EKSTokenMethodIAMAuthenticator},
 		},
+		{
+			name:         "ipv6 ip family",
+			resourceName: "cluster1",
+			resourceNS:   "default",
+			expectHash:   false,
+			spec:         AWSManagedControlPlaneSpec{IPFamily: IPFamilyIPv6, Version: &vV1_22, Addons: &ipv6Addons},
+			expectSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				IPFamily:       IPFamilyIPv6,
+				Version:        &vV1_22,
+				Addons:         &ipv6Addons,
+				IdentityRef:    defaultIdentityRef,
+				Bastion:        defaultTestBastion,
+				NetworkSpec:    infrav1.NetworkSpec{CNI: defaultNetworkSpec.CNI, VPC: ipv6VPCSpec},
+				TokenMethod:    &EKSTokenMethodIAMAuthenticator,
+			},
+		},
 		{
 			name:         "secondary CIDR",
 			resourceName: "cluster1",
@@ -167,15 +189,22 @@ func TestDefaultingWebhook(t *testing.T) {
 
 func TestWebhookCreate(t *testing.T) {
 	tests := []struct { //nolint:maligned
-		name           string
-		eksClusterName string
-		expectError    bool
-		eksVersion     string
-		hasAddons      bool
-		vpcCNI         VpcCni
-		additionalTags infrav1.Tags
-		secondaryCidr  *string
-		kubeProxy      KubeProxy
+		name            string
+		eksClusterName  string
+		expectError     bool
+		eksVersion      string
+		hasAddons       bool
+		vpcCNI          VpcCni
+		additionalTags  infrav1.Tags
+		secondaryCidr   *string
+		kubeProxy       KubeProxy
+		defaultAddons   DefaultAddons
+		externalManaged bool
+		podIdentity     []PodIdentityAssociation
+		serviceCIDR     string
+		vpcCIDR         string
+		ipFamily        IPFamily
+		nodeEgressRules infrav1.EgressRules
 	}{
 		{
 			name:           "ekscluster specified",
@@ -310,6 +339,215 @@ func TestWebhookCreate(t *testing.T) {
 				Disable: true,
 			},
 		},
+		{
+			name:           "remove kube-proxy and coredns allowed with no addons",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.19",
+			expectError:    false,
+			hasAddons:      false,
+			defaultAddons: DefaultAddons{
+				CoreDNS:   DefaultAddon{Remove: true},
+				KubeProxy: DefaultAddon{Remove: true},
+			},
+		},
+		{
+			name:           "remove kube-proxy not allowed with kube-proxy addon",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.19",
+			expectError:    true,
+			hasAddons:      true,
+			defaultAddons: DefaultAddons{
+				KubeProxy: DefaultAddon{Remove: true},
+			},
+		},
+		{
+			name:           "unmanaged kube-proxy not allowed with kube-proxy addon",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.19",
+			expectError:    true,
+			hasAddons:      true,
+			defaultAddons: DefaultAddons{
+				KubeProxy: DefaultAddon{Manage: aws.Bool(false)},
+			},
+		},
+		{
+			name:           "remove unmanaged coredns not allowed",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.19",
+			expectError:    true,
+			hasAddons:      false,
+			defaultAddons: DefaultAddons{
+				CoreDNS: DefaultAddon{Manage: aws.Bool(false), Remove: true},
+			},
+		},
+		{
+			name:           "custom networking with eni configs allowed",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.19",
+			expectError:    false,
+			vpcCNI: VpcCni{
+				CustomNetworking: &VpcCniCustomNetworking{
+					Enabled: true,
+					ENIConfigs: []VpcCniENIConfig{
+						{AvailabilityZone: "us-east-1a", SubnetID: "subnet-1"},
+						{AvailabilityZone: "us-east-1b", SubnetID: "subnet-2", SecurityGroupIDs: []string{"sg-1"}},
+					},
+				},
+			},
+		},
+		{
+			name:           "custom networking not allowed with vpc cni disabled",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.19",
+			expectError:    true,
+			vpcCNI: VpcCni{
+				Disable:          true,
+				CustomNetworking: &VpcCniCustomNetworking{Enabled: true},
+			},
+		},
+		{
+			name:           "custom networking eni configs require a subnet",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.19",
+			expectError:    true,
+			vpcCNI: VpcCni{
+				CustomNetworking: &VpcCniCustomNetworking{
+					Enabled:    true,
+					ENIConfigs: []VpcCniENIConfig{{AvailabilityZone: "us-east-1a"}},
+				},
+			},
+		},
+		{
+			name:           "custom networking eni configs must have unique availability zones",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.19",
+			expectError:    true,
+			vpcCNI: VpcCni{
+				CustomNetworking: &VpcCniCustomNetworking{
+					Enabled: true,
+					ENIConfigs: []VpcCniENIConfig{
+						{AvailabilityZone: "us-east-1a", SubnetID: "subnet-1"},
+						{AvailabilityZone: "us-east-1a", SubnetID: "subnet-2"},
+					},
+				},
+			},
+		},
+		{
+			name:            "externally managed ekscluster specified",
+			eksClusterName:  "default_cluster1",
+			expectError:     false,
+			externalManaged: true,
+		},
+		{
+			name:            "externally managed ekscluster NOT specified",
+			eksClusterName:  "",
+			expectError:     true,
+			externalManaged: true,
+		},
+		{
+			name:           "valid pod identity associations",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.28",
+			expectError:    false,
+			podIdentity: []PodIdentityAssociation{
+				{ServiceAccountNamespace: "kube-system", ServiceAccountName: "sa-1", RoleARN: "arn:aws:iam::123456789012:role/role"},
+				{ServiceAccountNamespace: "kube-system", ServiceAccountName: "sa-2", RoleARN: "arn:aws:iam::123456789012:role/role"},
+			},
+		},
+		{
+			name:           "pod identity associations not allowed with old k8s version",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.23",
+			expectError:    true,
+			podIdentity: []PodIdentityAssociation{
+				{ServiceAccountNamespace: "kube-system", ServiceAccountName: "sa", RoleARN: "arn:aws:iam::123456789012:role/role"},
+			},
+		},
+		{
+			name:           "pod identity associations must have unique service accounts",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.28",
+			expectError:    true,
+			podIdentity: []PodIdentityAssociation{
+				{ServiceAccountNamespace: "kube-system", ServiceAccountName: "sa", RoleARN: "arn:aws:iam::123456789012:role/role-1"},
+				{ServiceAccountNamespace: "kube-system", ServiceAccountName: "sa", RoleARN: "arn:aws:iam::123456789012:role/role-2"},
+			},
+		},
+		{
+			name:           "pod identity association with invalid role arn",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.28",
+			expectError:    true,
+			podIdentity: []PodIdentityAssociation{
+				{ServiceAccountNamespace: "kube-system", ServiceAccountName: "sa", RoleARN: "role"},
+			},
+		},
+		{
+			name:           "valid service cidr",
+			eksClusterName: "default_cluster1",
+			expectError:    false,
+			serviceCIDR:    "172.20.0.0/16",
+			vpcCIDR:        "10.0.0.0/16",
+		},
+		{
+			name:           "invalid service cidr",
+			eksClusterName: "default_cluster1",
+			expectError:    true,
+			serviceCIDR:    "172.20.0.0",
+		},
+		{
+			name:           "service cidr must be private",
+			eksClusterName: "default_cluster1",
+			expectError:    true,
+			serviceCIDR:    "100.64.0.0/16",
+		},
+		{
+			name:           "service cidr must be at most a /24",
+			eksClusterName: "default_cluster1",
+			expectError:    true,
+			serviceCIDR:    "172.20.0.0/28",
+		},
+		{
+			name:           "ipv6 ip family requires addons",
+			eksClusterName: "default_cluster1",
+			eksVersion:     "v1.22",
+			expectError:    true,
+			ipFamily:       IPFamilyIPv6,
+		},
+		{
+			name:           "service cidr must not overlap with the vpc",
+			eksClusterName: "default_cluster1",
+			expectError:    true,
+			serviceCIDR:    "10.0.0.0/12",
+			vpcCIDR:        "10.0.0.0/16",
+		},
+		{
+			name:           "node egress rules to the proxy endpoints",
+			eksClusterName: "default_cluster1",
+			expectError:    false,
+			nodeEgressRules: infrav1.EgressRules{
+				{
+					Description: "Egress proxy",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    3128,
+					ToPort:      3128,
+					CidrBlocks:  []string{"10.1.0.10/32"},
+				},
+			},
+		},
+		{
+			name:           "node egress rules need a destination",
+			eksClusterName: "default_cluster1",
+			expectError:    true,
+			nodeEgressRules: infrav1.EgressRules{
+				{
+					Description: "Egress proxy",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    3128,
+					ToPort:      3128,
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -323,10 +561,17 @@ func TestWebhookCreate(t *testing.T) {
 					Namespace:    "default",
 				},
 				Spec: AWSManagedControlPlaneSpec{
-					EKSClusterName: tc.eksClusterName,
-					KubeProxy:      tc.kubeProxy,
-					AdditionalTags: tc.additionalTags,
-					VpcCni:         tc.vpcCNI,
+					EKSClusterName:          tc.eksClusterName,
+					KubeProxy:               tc.kubeProxy,
+					DefaultAddons:           tc.defaultAddons,
+					AdditionalTags:          tc.additionalTags,
+					VpcCni:                  tc.vpcCNI,
+					ExternalManaged:         tc.externalManaged,
+					PodIdentityAssociations: tc.podIdentity,
+					KubeNetwork:             KubeNetwork{ServiceCIDR: tc.serviceCIDR},
+					IPFamily:                tc.ipFamily,
+					NetworkSpec:             infrav1.NetworkSpec{VPC: infrav1.VPCSpec{CidrBlock: tc.vpcCIDR}},
+					NodeEgressRules:         tc.nodeEgressRules,
 				},
 			}
 			if tc.eksVersion != "" {
@@ -365,9 +610,26 @@ func TestWebhookCreateIPv6Details(t *testing.T) {
 		name        string
 		addons      *[]Addon
 		kubeVersion string
+		ipFamily    IPFamily
 		networkSpec infrav1.NetworkSpec
 		err         string
 	}{
+		{
+			name:        "ipv4 cluster in an ipv6 vpc",
+			kubeVersion: "v1.18",
+			ipFamily:    IPFamilyIPv4,
+			networkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					IPv6: &infrav1.IPv6{},
+				},
+			},
+		},
+		{
+			name:        "ipv6 ip family with lower cluster version",
+			kubeVersion: "v1.18",
+			ipFamily:    IPFamilyIPv6,
+			err:         fmt.Sprintf("IPv6 requires Kubernetes %s or greater", minKubeVersionForIPv6),
+		},
 		{
 			name:        "ipv6 with lower cluster version",
 			kubeVersion: "v1.18",
@@ -485,6 +747,7 @@ func TestWebhookCreateIPv6Details(t *testing.T) {
 				Spec: AWSManagedControlPlaneSpec{
 					EKSClusterName: "test-cluster",
 					Addons:         tc.addons,
+					IPFamily:       tc.ipFamily,
 					NetworkSpec:    tc.networkSpec,
 					Version:        aws.String(tc.kubeVersion),
 				},
@@ -531,6 +794,41 @@ func TestWebhookUpdate(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "externally managed changed",
+			oldClusterSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+			},
+			newClusterSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName:  "default_cluster1",
+				ExternalManaged: true,
+			},
+			expectError: true,
+		},
+		{
+			name: "service cidr changed",
+			oldClusterSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				KubeNetwork:    KubeNetwork{ServiceCIDR: "172.20.0.0/16"},
+			},
+			newClusterSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				KubeNetwork:    KubeNetwork{ServiceCIDR: "172.21.0.0/16"},
+			},
+			expectError: true,
+		},
+		{
+			name: "ip family changed",
+			oldClusterSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				IPFamily:       IPFamilyIPv4,
+			},
+			newClusterSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				IPFamily:       IPFamilyIPv6,
+			},
+			expectError: true,
+		},
 		{
 			name: "old ekscluster specified, no new cluster name",
 			oldClusterSpec: AWSManagedControlPlaneSpec{
@@ -639,6 +937,49 @@ func TestWebhookUpdate(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "managed encryption key disabled",
+			oldClusterSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName:       "default_cluster1",
+				ManagedEncryptionKey: true,
+			},
+			newClusterSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+			},
+			expectError: true,
+		},
+		{
+			name: "encryption provider set after the secrets are encrypted with the managed encryption key",
+			oldClusterSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName:       "default_cluster1",
+				ManagedEncryptionKey: true,
+			},
+			newClusterSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName:       "default_cluster1",
+				ManagedEncryptionKey: true,
+				EncryptionConfig: &EncryptionConfig{
+					Provider: ptr.To[string]("provider"),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "managed encryption key enabled with an encryption provider",
+			oldClusterSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				EncryptionConfig: &EncryptionConfig{
+					Provider: ptr.To[string]("provider"),
+				},
+			},
+			newClusterSpec: AWSManagedControlPlaneSpec{
+				EKSClusterName:       "default_cluster1",
+				ManagedEncryptionKey: true,
+				EncryptionConfig: &EncryptionConfig{
+					Provider: ptr.To[string]("provider"),
+				},
+			},
+			expectError: false,
+		},
 		{
 			name: "ekscluster specified, same name, invalid tags",
 			oldClusterSpec: AWSManagedControlPlaneSpec{
@@ -866,3 +1207,165 @@ func TestValidatingWebhookUpdateSecondaryCidr(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatingWebhookNetworkSpec(t *testing.T) {
+	tests := []struct {
+		name        string
+		networkSpec infrav1.NetworkSpec
+		expectError bool
+	}{
+		{
+			name: "valid network resources",
+			networkSpec: infrav1.NetworkSpec{
+				Subnets: infrav1.Subnets{{ID: "subnet-1", ExistingRouteTableID: aws.String("rtb-0123456789abcdef0")}},
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentSpec{
+					TransitGatewayID: "tgw-01",
+				},
+				NetworkACL: &infrav1.NetworkACLSpec{
+					Ingress: []infrav1.NetworkACLEntry{
+						{RuleNumber: 100, Protocol: infrav1.NetworkACLProtocolAll, RuleAction: infrav1.NetworkACLRuleActionAllow, CidrBlock: "10.0.0.0/16"},
+						{RuleNumber: 101, Protocol: infrav1.NetworkACLProtocolAll, RuleAction: infrav1.NetworkACLRuleActionAllow, IPv6CidrBlock: "2001:db8::/56"},
+					},
+				},
+				FlowLogs: &infrav1.FlowLogsSpec{
+					DestinationType: infrav1.FlowLogsDestinationTypeCloudWatchLogs,
+					LogGroupName:    "flow-logs",
+				},
+			},
+		},
+		{
+			name: "existing route table on a public subnet",
+			networkSpec: infrav1.NetworkSpec{
+				Subnets: infrav1.Subnets{{ID: "subnet-1", IsPublic: true, ExistingRouteTableID: aws.String("rtb-0123456789abcdef0")}},
+			},
+			expectError: true,
+		},
+		{
+			name: "transit gateway attachment without transit gateway",
+			networkSpec: infrav1.NetworkSpec{
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentSpec{},
+			},
+			expectError: true,
+		},
+		{
+			name: "network ACL entries with the same rule number",
+			networkSpec: infrav1.NetworkSpec{
+				NetworkACL: &infrav1.NetworkACLSpec{
+					Ingress: []infrav1.NetworkACLEntry{
+						{RuleNumber: 100, Protocol: infrav1.NetworkACLProtocolAll, RuleAction: infrav1.NetworkACLRuleActionAllow, CidrBlock: "10.0.0.0/16"},
+						{RuleNumber: 100, Protocol: infrav1.NetworkACLProtocolAll, RuleAction: infrav1.NetworkACLRuleActionDeny, CidrBlock: "0.0.0.0/0"},
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "network ACL entry with an invalid IPv6 CIDR block",
+			networkSpec: infrav1.NetworkSpec{
+				NetworkACL: &infrav1.NetworkACLSpec{
+					Egress: []infrav1.NetworkACLEntry{
+						{RuleNumber: 100, Protocol: infrav1.NetworkACLProtocolAll, RuleAction: infrav1.NetworkACLRuleActionAllow, IPv6CidrBlock: "2001:db8::1"},
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "flow logs without log group",
+			networkSpec: infrav1.NetworkSpec{
+				FlowLogs: &infrav1.FlowLogsSpec{
+					DestinationType: infrav1.FlowLogsDestinationTypeCloudWatchLogs,
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					NetworkSpec:    tc.networkSpec,
+				},
+			}
+			_, err := mcp.ValidateCreate()
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+
+			_, err = mcp.ValidateUpdate(mcp.DeepCopy())
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookEgressRuleDestinationRoles(t *testing.T) {
+	tests := []struct {
+		name        string
+		role        infrav1.SecurityGroupRole
+		bastion     bool
+		expectError bool
+	}{
+		{
+			name: "node security group",
+			role: infrav1.SecurityGroupNode,
+		},
+		{
+			name:    "bastion security group of a cluster with a bastion",
+			role:    infrav1.SecurityGroupBastion,
+			bastion: true,
+		},
+		{
+			name:        "bastion security group of a cluster without bastion",
+			role:        infrav1.SecurityGroupBastion,
+			expectError: true,
+		},
+		{
+			name:        "control plane security group, which EKS clusters don't have",
+			role:        infrav1.SecurityGroupControlPlane,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rule := infrav1.EgressRule{
+				Protocol:                      infrav1.SecurityGroupProtocolTCP,
+				FromPort:                      443,
+				ToPort:                        443,
+				DestinationSecurityGroupRoles: []infrav1.SecurityGroupRole{tc.role},
+			}
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName:  "default_cluster1",
+					Bastion:         infrav1.Bastion{Enabled: tc.bastion},
+					NodeEgressRules: infrav1.EgressRules{rule},
+					NetworkSpec: infrav1.NetworkSpec{
+						SecurityGroupEgressRules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+							infrav1.SecurityGroupEKSNodeAdditional: {rule},
+						},
+					},
+				},
+			}
+			_, err := mcp.ValidateCreate()
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring("spec.nodeEgressRules[0].destinationSecurityGroupRoles[0]"))
+				g.Expect(err.Error()).To(ContainSubstring("securityGroupEgressRules[node-eks-additional][0].destinationSecurityGroupRoles[0]"))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
@@ -29,6 +29,17 @@ const (
 	EKSControlPlaneUpdatingCondition clusterv1.ConditionType = "EKSControlPlaneUpdating"
 	// EKSControlPlaneReconciliationFailedReason used to report failures while reconciling EKS control plane.
 	EKSControlPlaneReconciliationFailedReason = "EKSControlPlaneReconciliationFailed"
+	// WaitingForEKSControlPlaneActiveReason used to report that the eks control plane is being created or updated,
+	// and the reconcile is requeued until it is active.
+	WaitingForEKSControlPlaneActiveReason = "WaitingForEKSControlPlaneActive"
+)
+
+const (
+	// ControlPlaneUpgradingCondition condition reports on whether the eks control plane is upgrading
+	// to a new Kubernetes version. Node groups are not upgraded while it is true.
+	ControlPlaneUpgradingCondition clusterv1.ConditionType = "ControlPlaneUpgrading"
+	// ControlPlaneUpgradedReason used to report the eks control plane is active at the Kubernetes version of the spec.
+	ControlPlaneUpgradedReason = "ControlPlaneUpgraded"
 )
 
 const (
@@ -43,6 +54,17 @@ const (
 	IAMAuthenticatorConfiguredCondition clusterv1.ConditionType = "IAMAuthenticatorConfigured"
 	// IAMAuthenticatorConfigurationFailedReason used to report failures while reconciling the aws-iam-authenticator config.
 	IAMAuthenticatorConfigurationFailedReason = "IAMAuthenticatorConfigurationFailed"
+	// IAMAuthenticatorDriftCorrectedReason used to report that mappings changed outside of CAPA were restored to the declared ones.
+	IAMAuthenticatorDriftCorrectedReason = "IAMAuthenticatorDriftCorrected"
+)
+
+const (
+	// WorkloadClusterReachableCondition condition reports on whether the API server of the workload cluster could be
+	// reached to reconcile the resources in the workload cluster, like the aws-auth config.
+	WorkloadClusterReachableCondition clusterv1.ConditionType = "WorkloadClusterReachable"
+	// WaitingForWorkloadClusterReason used to report that the API server of the workload cluster is temporarily
+	// unreachable, for instance while the control plane is upgraded, and that reconciliation is requeued.
+	WaitingForWorkloadClusterReason = "WaitingForWorkloadCluster"
 )
 
 const (
@@ -50,6 +72,19 @@ const (
 	EKSAddonsConfiguredCondition clusterv1.ConditionType = "EKSAddonsConfigured"
 	// EKSAddonsConfiguredFailedReason used to report failures while reconciling the EKS addons.
 	EKSAddonsConfiguredFailedReason = "EKSAddonsConfiguredFailed"
+	// EKSAddonServiceAccountRolesMissingReason used to report that addons need an IAM role for their service accounts
+	// which can't be created, because EKS IAM or the OIDC provider are disabled.
+	EKSAddonServiceAccountRolesMissingReason = "EKSAddonServiceAccountRolesMissing"
+)
+
+const (
+	// EKSPodIdentityAssociationsConfiguredCondition condition reports on the successful reconciliation of EKS Pod Identity associations.
+	EKSPodIdentityAssociationsConfiguredCondition clusterv1.ConditionType = "EKSPodIdentityAssociationsConfigured"
+	// EKSPodIdentityAssociationsConfiguredFailedReason used to report failures while reconciling the EKS Pod Identity associations.
+	EKSPodIdentityAssociationsConfiguredFailedReason = "EKSPodIdentityAssociationsConfiguredFailed"
+	// EKSPodIdentityAssociationsConflictReason used to report that associations were skipped as their service accounts
+	// already have an association not created by CAPA.
+	EKSPodIdentityAssociationsConflictReason = "EKSPodIdentityAssociationsConflict"
 )
 
 const (
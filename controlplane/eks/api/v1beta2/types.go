@@ -143,6 +143,63 @@ type Addon struct {
 	ServiceAccountRoleArn *string `json:"serviceAccountRoleARN,omitempty"`
 }
 
+// DefaultAddons specifies how the addons EKS installs into the cluster by default are managed.
+type DefaultAddons struct {
+	// CoreDNS specifies how the coredns addon is managed.
+	// +optional
+	CoreDNS DefaultAddon `json:"coreDNS,omitempty"`
+	// KubeProxy specifies how the kube-proxy addon is managed.
+	// +optional
+	KubeProxy DefaultAddon `json:"kubeProxy,omitempty"`
+}
+
+// DefaultAddon specifies how an addon EKS installs into the cluster by default is managed.
+type DefaultAddon struct {
+	// Manage indicates whether the EKS addon is managed. An installed EKS addon that isn't
+	// specified in the addons is deleted if it is managed, and left untouched otherwise.
+	// Defaults to true.
+	// +optional
+	Manage *bool `json:"manage,omitempty"`
+	// Remove indicates that the addon should be removed from the cluster, e.g. when it is
+	// replaced by an alternative. Both the EKS addon and the self-managed workload EKS installs
+	// by default are deleted, and neither is recreated. You cannot set this to true if the addon
+	// is specified in the addons or isn't managed.
+	// +optional
+	Remove bool `json:"remove,omitempty"`
+}
+
+// IsManaged returns whether the EKS addon is managed.
+func (a DefaultAddon) IsManaged() bool {
+	return a.Manage == nil || *a.Manage
+}
+
+// IPFamily is the IP family of the pod and service addresses of an EKS cluster.
+type IPFamily string
+
+var (
+	// IPFamilyIPv4 assigns IPv4 addresses to pods and services.
+	IPFamilyIPv4 = IPFamily("ipv4")
+	// IPFamilyIPv6 assigns IPv6 addresses to pods and services.
+	IPFamilyIPv6 = IPFamily("ipv6")
+)
+
+// PodIdentityAssociation represents an EKS Pod Identity association, which grants the pods
+// using a Kubernetes service account the permissions of an IAM role.
+type PodIdentityAssociation struct {
+	// ServiceAccountNamespace is the namespace of the service account
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:Required
+	ServiceAccountNamespace string `json:"serviceAccountNamespace"`
+	// ServiceAccountName is the name of the service account
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:Required
+	ServiceAccountName string `json:"serviceAccountName"`
+	// RoleARN is the ARN of the IAM role the pods using the service account assume
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:Required
+	RoleARN string `json:"roleARN"`
+}
+
 // AddonResolution defines the method for resolving parameter conflicts.
 type AddonResolution string
 
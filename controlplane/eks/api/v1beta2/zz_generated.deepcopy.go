@@ -100,6 +100,13 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 		*out = new(string)
 		**out = **in
 	}
+	if in.NodeEgressRules != nil {
+		in, out := &in.NodeEgressRules, &out.NodeEgressRules
+		*out = make(apiv1beta2.EgressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SSHKeyName != nil {
 		in, out := &in.SSHKeyName, &out.SSHKeyName
 		*out = new(string)
@@ -165,6 +172,11 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 			}
 		}
 	}
+	if in.PodIdentityAssociations != nil {
+		in, out := &in.PodIdentityAssociations, &out.PodIdentityAssociations
+		*out = make([]PodIdentityAssociation, len(*in))
+		copy(*out, *in)
+	}
 	if in.OIDCIdentityProviderConfig != nil {
 		in, out := &in.OIDCIdentityProviderConfig, &out.OIDCIdentityProviderConfig
 		*out = new(OIDCIdentityProviderConfig)
@@ -172,6 +184,8 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 	}
 	in.VpcCni.DeepCopyInto(&out.VpcCni)
 	out.KubeProxy = in.KubeProxy
+	in.DefaultAddons.DeepCopyInto(&out.DefaultAddons)
+	out.KubeNetwork = in.KubeNetwork
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSManagedControlPlaneSpec.
@@ -206,6 +220,11 @@ func (in *AWSManagedControlPlaneStatus) DeepCopyInto(out *AWSManagedControlPlane
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
 	if in.FailureMessage != nil {
 		in, out := &in.FailureMessage, &out.FailureMessage
 		*out = new(string)
@@ -342,6 +361,43 @@ func (in *ControlPlaneLoggingSpec) DeepCopy() *ControlPlaneLoggingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultAddon) DeepCopyInto(out *DefaultAddon) {
+	*out = *in
+	if in.Manage != nil {
+		in, out := &in.Manage, &out.Manage
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultAddon.
+func (in *DefaultAddon) DeepCopy() *DefaultAddon {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultAddon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultAddons) DeepCopyInto(out *DefaultAddons) {
+	*out = *in
+	in.CoreDNS.DeepCopyInto(&out.CoreDNS)
+	in.KubeProxy.DeepCopyInto(&out.KubeProxy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultAddons.
+func (in *DefaultAddons) DeepCopy() *DefaultAddons {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultAddons)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
 	*out = *in
@@ -453,6 +509,21 @@ func (in *IdentityProviderStatus) DeepCopy() *IdentityProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeNetwork) DeepCopyInto(out *KubeNetwork) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeNetwork.
+func (in *KubeNetwork) DeepCopy() *KubeNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeProxy) DeepCopyInto(out *KubeProxy) {
 	*out = *in
@@ -552,6 +623,21 @@ func (in *OIDCProviderStatus) DeepCopy() *OIDCProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociation) DeepCopyInto(out *PodIdentityAssociation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociation.
+func (in *PodIdentityAssociation) DeepCopy() *PodIdentityAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleMapping) DeepCopyInto(out *RoleMapping) {
 	*out = *in
@@ -594,6 +680,11 @@ func (in *VpcCni) DeepCopyInto(out *VpcCni) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CustomNetworking != nil {
+		in, out := &in.CustomNetworking, &out.CustomNetworking
+		*out = new(VpcCniCustomNetworking)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcCni.
@@ -605,3 +696,45 @@ func (in *VpcCni) DeepCopy() *VpcCni {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcCniCustomNetworking) DeepCopyInto(out *VpcCniCustomNetworking) {
+	*out = *in
+	if in.ENIConfigs != nil {
+		in, out := &in.ENIConfigs, &out.ENIConfigs
+		*out = make([]VpcCniENIConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcCniCustomNetworking.
+func (in *VpcCniCustomNetworking) DeepCopy() *VpcCniCustomNetworking {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcCniCustomNetworking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcCniENIConfig) DeepCopyInto(out *VpcCniENIConfig) {
+	*out = *in
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcCniENIConfig.
+func (in *VpcCniENIConfig) DeepCopy() *VpcCniENIConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcCniENIConfig)
+	in.DeepCopyInto(out)
+	return out
+}
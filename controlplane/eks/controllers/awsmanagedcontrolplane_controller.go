@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -41,15 +42,19 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/awsnode"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/coredns"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/gc"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kubeproxy"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/securitygroup"
+	awswait "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/workload"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
 	capiannotations "sigs.k8s.io/cluster-api/util/annotations"
@@ -62,9 +67,26 @@ const (
 	// has dependencies during deletion.
 	deleteRequeueAfter = 20 * time.Second
 
+	// workloadClusterRequeueAfter is how long to wait before trying again to reconcile the resources in the
+	// workload cluster when its API server is unreachable.
+	workloadClusterRequeueAfter = 30 * time.Second
+
+	// pendingRequeueAfter is how long to wait before checking again on a long-running AWS operation which isn't
+	// waited for, see UseBlockingWaiters.
+	pendingRequeueAfter = 30 * time.Second
+
 	awsManagedControlPlaneKind = "AWSManagedControlPlane"
 )
 
+// workloadClusterBackoff is the backoff to retry the reconciliation of the resources in the workload cluster with
+// while its API server is unreachable, before requeuing.
+var workloadClusterBackoff = wait.Backoff{
+	Duration: time.Second,
+	Factor:   2,
+	Steps:    3,
+	Jitter:   0.2,
+}
+
 var defaultEKSSecurityGroupRoles = []infrav1.SecurityGroupRole{
 	infrav1.SecurityGroupEKSNodeAdditional,
 }
@@ -78,6 +100,9 @@ func securityGroupRolesForControlPlane(scope *scope.ManagedControlPlaneScope) []
 	if scope.Bastion().Enabled {
 		roles = append(roles, infrav1.SecurityGroupBastion)
 	}
+	if scope.InstanceConnectEndpoint() != nil {
+		roles = append(roles, infrav1.SecurityGroupInstanceConnectEndpoint)
+	}
 	return roles
 }
 
@@ -94,6 +119,10 @@ type AWSManagedControlPlaneReconciler struct {
 	AlternativeGCStrategy        bool
 	WaitInfraPeriod              time.Duration
 	TagUnmanagedNetworkResources bool
+
+	// UseBlockingWaiters makes the reconcile wait for the NAT gateways and the EKS cluster to be available and the
+	// bastion to be terminated, instead of requeuing until they are.
+	UseBlockingWaiters bool
 }
 
 // SetupWithManager is used to setup the controller.
@@ -186,6 +215,7 @@ func (r *AWSManagedControlPlaneReconciler) Reconcile(ctx context.Context, req ct
 		AllowAdditionalRoles:         r.AllowAdditionalRoles,
 		Endpoints:                    r.Endpoints,
 		TagUnmanagedNetworkResources: r.TagUnmanagedNetworkResources,
+		UseBlockingWaiters:           r.UseBlockingWaiters,
 		Logger:                       log,
 	})
 	if err != nil {
@@ -264,32 +294,97 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 	authService := iamauth.NewService(managedScope, iamauth.BackendTypeConfigMap, managedScope.Client)
 	awsnodeService := awsnode.NewService(managedScope)
 	kubeproxyService := kubeproxy.NewService(managedScope)
+	corednsService := coredns.NewService(managedScope)
+	kmsService := kms.NewService(managedScope)
+
+	if managedScope.ReconcileMode() == infrav1.ReconcileModeDetectOnly {
+		var reconcilers []scope.ResourceReconciler
+		if !awsManagedControlPlane.Spec.ExternalManaged {
+			reconcilers = append(reconcilers,
+				scope.ResourceReconciler{Name: "network", Reconcile: networkSvc.ReconcileNetwork},
+				scope.ResourceReconciler{Name: "security groups", Reconcile: sgService.ReconcileSecurityGroups},
+			)
+		}
+		reconcilers = append(reconcilers,
+			scope.ResourceReconciler{Name: "bastion", Reconcile: ec2Service.ReconcileBastion},
+			scope.ResourceReconciler{Name: "instance connect endpoint", Reconcile: ec2Service.ReconcileInstanceConnectEndpoint},
+			scope.ResourceReconciler{Name: "managed encryption key", Reconcile: kmsService.ReconcileManagedEncryptionKey},
+			scope.ResourceReconciler{Name: "control plane", Reconcile: func() error { return ekssvc.ReconcileControlPlane(ctx) }},
+		)
+		if !awsManagedControlPlane.Spec.ExternalManaged {
+			reconcilers = append(reconcilers, scope.ResourceReconciler{Name: "node security group egress rules", Reconcile: func() error {
+				return sgService.ReconcileEKSNodeEgressRules(awsManagedControlPlane.Spec.NodeEgressRules)
+			}})
+		}
+		// The resources of the workload cluster are only reconciled in the full reconcile mode.
+		return ctrl.Result{}, scope.DetectDrift(managedScope, reconcilers)
+	}
+	conditions.Delete(awsManagedControlPlane, infrav1.ResourcesInSyncCondition)
 
-	if err := networkSvc.ReconcileNetwork(); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed to reconcile network for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	// The network of an externally managed cluster is managed along with the cluster.
+	if !awsManagedControlPlane.Spec.ExternalManaged {
+		if err := networkSvc.ReconcileNetwork(); err != nil {
+			if awswait.IsPending(err) {
+				managedScope.Info("Waiting for network resources to be available", "reason", err.Error())
+				return reconcile.Result{RequeueAfter: pendingRequeueAfter}, nil
+			}
+			return reconcile.Result{}, fmt.Errorf("failed to reconcile network for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+		}
 	}
 
-	if err := sgService.ReconcileSecurityGroups(); err != nil {
-		conditions.MarkFalse(awsManagedControlPlane, infrav1.ClusterSecurityGroupsReadyCondition, infrav1.ClusterSecurityGroupReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
-		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile general security groups for AWSManagedControlPlane %s/%s", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name)
+	// The security groups of an externally managed cluster are managed along with the cluster.
+	if !awsManagedControlPlane.Spec.ExternalManaged {
+		if err := sgService.ReconcileSecurityGroups(); err != nil {
+			conditions.MarkFalse(awsManagedControlPlane, infrav1.ClusterSecurityGroupsReadyCondition, infrav1.ClusterSecurityGroupReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile general security groups for AWSManagedControlPlane %s/%s", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name)
+		}
+		scope.MarkResourceTagsSynced(managedScope)
 	}
 
 	if err := ec2Service.ReconcileBastion(); err != nil {
+		if awswait.IsPending(err) {
+			managedScope.Info("Waiting for bastion host to be terminated", "reason", err.Error())
+			return reconcile.Result{RequeueAfter: pendingRequeueAfter}, nil
+		}
 		conditions.MarkFalse(awsManagedControlPlane, infrav1.BastionHostReadyCondition, infrav1.BastionHostFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile bastion host for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
 
+	if err := ec2Service.ReconcileInstanceConnectEndpoint(); err != nil {
+		conditions.MarkFalse(awsManagedControlPlane, infrav1.InstanceConnectEndpointReadyCondition, infrav1.InstanceConnectEndpointFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return reconcile.Result{}, fmt.Errorf("failed to reconcile instance connect endpoint for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	}
+
+	// The managed key encrypts the secrets of the cluster, so it must exist before the cluster is created.
+	if err := kmsService.ReconcileManagedEncryptionKey(); err != nil {
+		conditions.MarkFalse(awsManagedControlPlane, infrav1.ManagedEncryptionKeyReadyCondition, infrav1.ManagedEncryptionKeyFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return reconcile.Result{}, fmt.Errorf("failed to reconcile managed encryption key for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	}
+	if awsManagedControlPlane.Spec.ManagedEncryptionKey {
+		conditions.MarkTrue(awsManagedControlPlane, infrav1.ManagedEncryptionKeyReadyCondition)
+	}
+
 	if err := ekssvc.ReconcileControlPlane(ctx); err != nil {
+		if awswait.IsPending(err) {
+			managedScope.Info("Waiting for EKS control plane to be active", "reason", err.Error())
+			return reconcile.Result{RequeueAfter: pendingRequeueAfter}, nil
+		}
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
 
-	if err := awsnodeService.ReconcileCNI(ctx); err != nil {
-		conditions.MarkFalse(managedScope.InfraCluster(), infrav1.SecondaryCidrsReadyCondition, infrav1.SecondaryCidrReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
-		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	// The VPC and subnets of an externally managed cluster are only known once the cluster is active.
+	if awsManagedControlPlane.Spec.ExternalManaged && managedScope.VPC().ID != "" {
+		if err := networkSvc.DiscoverNetwork(); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to discover network for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+		}
 	}
 
-	if err := kubeproxyService.ReconcileKubeProxy(ctx); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	// The node security group is created by EKS along with the cluster.
+	if !awsManagedControlPlane.Spec.ExternalManaged {
+		if err := sgService.ReconcileEKSNodeEgressRules(awsManagedControlPlane.Spec.NodeEgressRules); err != nil {
+			conditions.MarkFalse(awsManagedControlPlane, infrav1.ClusterSecurityGroupsReadyCondition, infrav1.ClusterSecurityGroupReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile node security group egress rules for AWSManagedControlPlane %s/%s", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name)
+		}
 	}
 
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
@@ -299,11 +394,6 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 			managedScope.Error(err, "non-fatal: failed to set up EventBridge")
 		}
 	}
-	if err := authService.ReconcileIAMAuthenticator(ctx); err != nil {
-		conditions.MarkFalse(awsManagedControlPlane, ekscontrolplanev1.IAMAuthenticatorConfiguredCondition, ekscontrolplanev1.IAMAuthenticatorConfigurationFailedReason, clusterv1.ConditionSeverityError, err.Error())
-		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile aws-iam-authenticator config for AWSManagedControlPlane %s/%s", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name)
-	}
-	conditions.MarkTrue(awsManagedControlPlane, ekscontrolplanev1.IAMAuthenticatorConfiguredCondition)
 
 	for _, subnet := range managedScope.Subnets().FilterPrivate() {
 		managedScope.SetFailureDomain(subnet.AvailabilityZone, clusterv1.FailureDomainSpec{
@@ -311,9 +401,62 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		})
 	}
 
+	return r.retryWorkloadCluster(managedScope, func() error {
+		return r.reconcileWorkloadCluster(ctx, managedScope, awsnodeService, kubeproxyService, corednsService, authService)
+	})
+}
+
+// retryWorkloadCluster runs the reconciliation of the resources in the workload cluster. The workload cluster API is
+// briefly unreachable during control plane upgrades: the reconciliation is retried for a while, then requeued
+// quietly rather than failed.
+func (r *AWSManagedControlPlaneReconciler) retryWorkloadCluster(managedScope *scope.ManagedControlPlaneScope, reconcileWorkloadCluster func() error) (ctrl.Result, error) {
+	err := workload.RetryWhileUnreachable(workloadClusterBackoff, reconcileWorkloadCluster)
+	if workload.IsUnreachable(err) {
+		managedScope.Info("Workload cluster API is unreachable, requeuing", "reason", err.Error())
+		conditions.MarkFalse(managedScope.ControlPlane, ekscontrolplanev1.WorkloadClusterReachableCondition, ekscontrolplanev1.WaitingForWorkloadClusterReason, clusterv1.ConditionSeverityInfo, err.Error())
+		return reconcile.Result{RequeueAfter: workloadClusterRequeueAfter}, nil
+	}
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	conditions.MarkTrue(managedScope.ControlPlane, ekscontrolplanev1.WorkloadClusterReachableCondition)
+
 	return reconcile.Result{}, nil
 }
 
+// reconcileWorkloadCluster reconciles the resources of the control plane in the workload cluster.
+func (r *AWSManagedControlPlaneReconciler) reconcileWorkloadCluster(ctx context.Context, managedScope *scope.ManagedControlPlaneScope, awsnodeService *awsnode.Service, kubeproxyService *kubeproxy.Service, corednsService *coredns.Service, authService *iamauth.Service) error {
+	awsManagedControlPlane := managedScope.ControlPlane
+
+	if err := awsnodeService.ReconcileCNI(ctx); err != nil {
+		if !workload.IsUnreachable(err) {
+			conditions.MarkFalse(managedScope.InfraCluster(), infrav1.SecondaryCidrsReadyCondition, infrav1.SecondaryCidrReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		}
+		return fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	}
+
+	if err := kubeproxyService.ReconcileKubeProxy(ctx); err != nil {
+		return fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	}
+
+	if err := corednsService.ReconcileCoreDNS(ctx); err != nil {
+		return fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	}
+
+	if err := authService.ReconcileIAMAuthenticator(ctx); err != nil {
+		if !workload.IsUnreachable(err) {
+			conditions.MarkFalse(awsManagedControlPlane, ekscontrolplanev1.IAMAuthenticatorConfiguredCondition, ekscontrolplanev1.IAMAuthenticatorConfigurationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		}
+		return errors.Wrapf(err, "failed to reconcile aws-iam-authenticator config for AWSManagedControlPlane %s/%s", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name)
+	}
+	if !conditions.IsTrue(awsManagedControlPlane, ekscontrolplanev1.IAMAuthenticatorConfiguredCondition) {
+		// Keep reporting the last drift correction, if any.
+		conditions.MarkTrue(awsManagedControlPlane, ekscontrolplanev1.IAMAuthenticatorConfiguredCondition)
+	}
+
+	return nil
+}
+
 func (r *AWSManagedControlPlaneReconciler) reconcileDelete(ctx context.Context, managedScope *scope.ManagedControlPlaneScope) (_ ctrl.Result, reterr error) {
 	log := logger.FromContext(ctx)
 
@@ -336,35 +479,56 @@ func (r *AWSManagedControlPlaneReconciler) reconcileDelete(ctx context.Context,
 	ec2svc := ec2.NewService(managedScope)
 	networkSvc := network.NewService(managedScope)
 	sgService := securitygroup.NewService(managedScope, securityGroupRolesForControlPlane(managedScope))
+	kmsService := kms.NewService(managedScope)
 
 	if err := ekssvc.DeleteControlPlane(); err != nil {
 		log.Error(err, "error deleting EKS cluster for EKS control plane", "namespace", controlPlane.Namespace, "name", controlPlane.Name)
 		return reconcile.Result{}, err
 	}
 
+	if err := kmsService.DeleteManagedEncryptionKey(); err != nil {
+		log.Error(err, "error deleting managed encryption key for AWSManagedControlPlane", "namespace", controlPlane.Namespace, "name", controlPlane.Name)
+		return reconcile.Result{}, err
+	}
+
 	if err := ec2svc.DeleteBastion(); err != nil {
+		if awswait.IsPending(err) {
+			log.Info("Waiting for bastion host to be terminated", "reason", err.Error())
+			return reconcile.Result{RequeueAfter: deleteRequeueAfter}, nil
+		}
 		log.Error(err, "error deleting bastion for AWSManagedControlPlane", "namespace", controlPlane.Namespace, "name", controlPlane.Name)
 		return reconcile.Result{}, err
 	}
 
-	if err := sgService.DeleteSecurityGroups(); err != nil {
-		log.Error(err, "error deleting general security groups for AWSManagedControlPlane", "namespace", controlPlane.Namespace, "name", controlPlane.Name)
+	if err := ec2svc.DeleteInstanceConnectEndpoint(); err != nil {
+		log.Error(err, "error deleting instance connect endpoint for AWSManagedControlPlane", "namespace", controlPlane.Namespace, "name", controlPlane.Name)
 		return reconcile.Result{}, err
 	}
 
-	if r.ExternalResourceGC {
+	if !controlPlane.Spec.ExternalManaged {
+		if err := sgService.DeleteSecurityGroups(); err != nil {
+			log.Error(err, "error deleting general security groups for AWSManagedControlPlane", "namespace", controlPlane.Namespace, "name", controlPlane.Name)
+			return reconcile.Result{}, err
+		}
+	}
+
+	// The resources of an externally managed cluster are still in use once it is no longer managed.
+	if r.ExternalResourceGC && !controlPlane.Spec.ExternalManaged {
 		gcSvc := gc.NewService(managedScope, gc.WithGCStrategy(r.AlternativeGCStrategy))
 		if gcErr := gcSvc.ReconcileDelete(ctx); gcErr != nil {
 			return reconcile.Result{}, fmt.Errorf("failed delete reconcile for gc service: %w", gcErr)
 		}
 	}
 
-	if err := networkSvc.DeleteNetwork(); err != nil {
-		log.Error(err, "error deleting network for AWSManagedControlPlane", "namespace", controlPlane.Namespace, "name", controlPlane.Name)
-		return reconcile.Result{}, err
+	if !controlPlane.Spec.ExternalManaged {
+		if err := networkSvc.DeleteNetwork(); err != nil {
+			log.Error(err, "error deleting network for AWSManagedControlPlane", "namespace", controlPlane.Namespace, "name", controlPlane.Name)
+			return reconcile.Result{}, err
+		}
 	}
 
 	controllerutil.RemoveFinalizer(controlPlane, ekscontrolplanev1.ManagedControlPlaneFinalizer)
+	scope.DeleteConcurrencyGate(managedScope)
 
 	return reconcile.Result{}, nil
 }
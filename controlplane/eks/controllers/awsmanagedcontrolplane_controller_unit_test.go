@@ -17,11 +17,18 @@ limitations under the License.
 package controllers
 
 import (
+	"errors"
+	"syscall"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestSecurityGroupRolesForCluster(t *testing.T) {
@@ -61,3 +68,85 @@ func TestSecurityGroupRolesForCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryWorkloadCluster(t *testing.T) {
+	defer func(backoff wait.Backoff) { workloadClusterBackoff = backoff }(workloadClusterBackoff)
+	workloadClusterBackoff = wait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 3}
+
+	unreachable := syscall.ECONNREFUSED
+
+	tests := []struct {
+		name            string
+		errs            []error
+		expectCalls     int
+		expectErr       bool
+		expectRequeue   bool
+		expectCondition corev1.ConditionStatus
+	}{
+		{
+			name:            "workload cluster reachable",
+			errs:            []error{nil},
+			expectCalls:     1,
+			expectCondition: corev1.ConditionTrue,
+		},
+		{
+			name:            "workload cluster temporarily unreachable",
+			errs:            []error{unreachable, nil},
+			expectCalls:     2,
+			expectCondition: corev1.ConditionTrue,
+		},
+		{
+			name:            "workload cluster unreachable",
+			errs:            []error{unreachable, unreachable, unreachable},
+			expectCalls:     3,
+			expectRequeue:   true,
+			expectCondition: corev1.ConditionFalse,
+		},
+		{
+			name:        "other errors are returned",
+			errs:        []error{errors.New("forbidden")},
+			expectCalls: 1,
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s, err := getManagedControlPlaneScope(getAWSManagedControlPlane("test", "test"))
+			g.Expect(err).To(BeNil(), "failed to create cluster scope for test")
+
+			calls := 0
+			r := &AWSManagedControlPlaneReconciler{}
+			result, err := r.retryWorkloadCluster(s, func() error {
+				err := tt.errs[calls]
+				calls++
+				return err
+			})
+
+			g.Expect(calls).To(Equal(tt.expectCalls))
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			if tt.expectRequeue {
+				g.Expect(result.RequeueAfter).To(Equal(workloadClusterRequeueAfter))
+			} else {
+				g.Expect(result.RequeueAfter).To(BeZero())
+			}
+
+			condition := conditions.Get(s.ControlPlane, ekscontrolplanev1.WorkloadClusterReachableCondition)
+			if tt.expectCondition == "" {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.expectCondition))
+			if tt.expectCondition == corev1.ConditionFalse {
+				g.Expect(condition.Reason).To(Equal(ekscontrolplanev1.WaitingForWorkloadClusterReason))
+			}
+		})
+	}
+}
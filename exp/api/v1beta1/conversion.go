@@ -43,13 +43,29 @@ func (src *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	if dst.Spec.RefreshPreferences != nil && restored.Spec.RefreshPreferences != nil {
 		dst.Spec.RefreshPreferences.Disable = restored.Spec.RefreshPreferences.Disable
+		dst.Spec.RefreshPreferences.MaxSurge = restored.Spec.RefreshPreferences.MaxSurge
+		dst.Spec.RefreshPreferences.MaxUnavailable = restored.Spec.RefreshPreferences.MaxUnavailable
 	}
 	if restored.Spec.AWSLaunchTemplate.InstanceMetadataOptions != nil {
 		dst.Spec.AWSLaunchTemplate.InstanceMetadataOptions = restored.Spec.AWSLaunchTemplate.InstanceMetadataOptions
 	}
+	dst.Spec.AWSLaunchTemplate.AMI.Architecture = restored.Spec.AWSLaunchTemplate.AMI.Architecture
 	if restored.Spec.AvailabilityZoneSubnetType != nil {
 		dst.Spec.AvailabilityZoneSubnetType = restored.Spec.AvailabilityZoneSubnetType
 	}
+	dst.Spec.KubeletExtraArgs = restored.Spec.KubeletExtraArgs
+	dst.Spec.MaxPods = restored.Spec.MaxPods
+	dst.Spec.AutoscalerTags = restored.Spec.AutoscalerTags
+	dst.Spec.MaxLaunchTemplateVersions = restored.Spec.MaxLaunchTemplateVersions
+	dst.Spec.ASGTags = restored.Spec.ASGTags
+	dst.Spec.LaunchTemplateTags = restored.Spec.LaunchTemplateTags
+	dst.Spec.InstanceTags = restored.Spec.InstanceTags
+	dst.Spec.DistinctSubnetAvailabilityZones = restored.Spec.DistinctSubnetAvailabilityZones
+	dst.Spec.SpotInterruptionHandling = restored.Spec.SpotInterruptionHandling
+	dst.Spec.BaseLaunchTemplateID = restored.Spec.BaseLaunchTemplateID
+	dst.Spec.SpotPlacementScores = restored.Spec.SpotPlacementScores
+	dst.Status.SpotInterruptionQueueURL = restored.Status.SpotInterruptionQueueURL
+	dst.Status.SpotPlacementScores = restored.Status.SpotPlacementScores
 
 	return nil
 }
@@ -95,10 +111,12 @@ func (src *AWSManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 			dst.Spec.AWSLaunchTemplate = restored.Spec.AWSLaunchTemplate
 		}
 		dst.Spec.AWSLaunchTemplate.InstanceMetadataOptions = restored.Spec.AWSLaunchTemplate.InstanceMetadataOptions
+		dst.Spec.AWSLaunchTemplate.AMI.Architecture = restored.Spec.AWSLaunchTemplate.AMI.Architecture
 	}
 	if restored.Spec.AvailabilityZoneSubnetType != nil {
 		dst.Spec.AvailabilityZoneSubnetType = restored.Spec.AvailabilityZoneSubnetType
 	}
+	dst.Spec.CreationTimeout = restored.Spec.CreationTimeout
 
 	return nil
 }
@@ -191,6 +209,11 @@ func Convert_v1beta2_AWSMachinePoolSpec_To_v1beta1_AWSMachinePoolSpec(in *infrav
 	return autoConvert_v1beta2_AWSMachinePoolSpec_To_v1beta1_AWSMachinePoolSpec(in, out, s)
 }
 
+// Convert_v1beta2_AWSMachinePoolStatus_To_v1beta1_AWSMachinePoolStatus converts the v1beta2 AWSMachinePoolStatus receiver to a v1beta1 AWSMachinePoolStatus.
+func Convert_v1beta2_AWSMachinePoolStatus_To_v1beta1_AWSMachinePoolStatus(in *infrav1exp.AWSMachinePoolStatus, out *AWSMachinePoolStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_AWSMachinePoolStatus_To_v1beta1_AWSMachinePoolStatus(in, out, s)
+}
+
 func Convert_v1beta1_AutoScalingGroup_To_v1beta2_AutoScalingGroup(in *AutoScalingGroup, out *infrav1exp.AutoScalingGroup, s apiconversion.Scope) error {
 	return autoConvert_v1beta1_AutoScalingGroup_To_v1beta2_AutoScalingGroup(in, out, s)
 }
@@ -202,6 +225,6 @@ func Convert_v1beta2_AutoScalingGroup_To_v1beta1_AutoScalingGroup(in *infrav1exp
 
 // Convert_v1beta2_RefreshPreferences_To_v1beta1_RefreshPreferences converts the v1beta2 RefreshPreferences receiver to a v1beta1 RefreshPreferences.
 func Convert_v1beta2_RefreshPreferences_To_v1beta1_RefreshPreferences(in *infrav1exp.RefreshPreferences, out *RefreshPreferences, s apiconversion.Scope) error {
-	// spec.refreshPreferences.disable has been added to v1beta2.
+	// spec.refreshPreferences.disable, maxSurge and maxUnavailable have been added to v1beta2.
 	return autoConvert_v1beta2_RefreshPreferences_To_v1beta1_RefreshPreferences(in, out, s)
 }
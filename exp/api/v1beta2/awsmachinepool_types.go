@@ -21,6 +21,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -31,6 +32,14 @@ import (
 const (
 	// LaunchTemplateLatestVersion defines the launching of the latest version of the template.
 	LaunchTemplateLatestVersion = "$Latest"
+
+	// EstimatedHourlyCostAnnotation is the annotation set on an AWSMachinePool with the estimated hourly cost
+	// in USD of its instances, or "unknown" if the price of its instance types isn't known.
+	EstimatedHourlyCostAnnotation = "aws.cluster.x-k8s.io/estimated-hourly-cost-usd"
+
+	// SpotPlacementScoresAnnotation is the annotation set on an AWSMachinePool with the Spot placement scores
+	// of its instance types per availability zone ID, as a comma separated list of zone=score pairs.
+	SpotPlacementScoresAnnotation = "aws.cluster.x-k8s.io/spot-placement-scores"
 )
 
 // AWSMachinePoolSpec defines the desired state of AWSMachinePool.
@@ -61,6 +70,12 @@ type AWSMachinePoolSpec struct {
 	// +optional
 	Subnets []infrav1.AWSResourceReference `json:"subnets,omitempty"`
 
+	// DistinctSubnetAvailabilityZones requires the subnets of the ASG to be in distinct availability zones, so that
+	// the ASG spreads the instances of the pool evenly across them. Reconciling the pool fails when two of its
+	// subnets are in the same availability zone.
+	// +optional
+	DistinctSubnetAvailabilityZones bool `json:"distinctSubnetAvailabilityZones,omitempty"`
+
 	// AdditionalTags is an optional set of tags to add to an instance, in addition to the ones added by default by the
 	// AWS provider.
 	// +optional
@@ -94,6 +109,140 @@ type AWSMachinePoolSpec struct {
 	// SuspendProcesses defines a list of processes to suspend for the given ASG. This is constantly reconciled.
 	// If a process is removed from this list it will automatically be resumed.
 	SuspendProcesses *SuspendProcessesTypes `json:"suspendProcesses,omitempty"`
+
+	// KubeletExtraArgs are extra arguments passed to the kubelet of the instances of the pool, e.g. max-pods.
+	// The arguments are added to the invocation of the EKS bootstrap script (/etc/eks/bootstrap.sh) in the
+	// bootstrap data and take precedence over the kubelet extra arguments set by the bootstrap provider.
+	// +optional
+	KubeletExtraArgs map[string]string `json:"kubeletExtraArgs,omitempty"`
+
+	// MaxPods enables computing the kubelet --max-pods argument of the instances of the pool from the
+	// network interface limits of the launch template instance type, as required by the Amazon VPC CNI.
+	// A max-pods value set explicitly in KubeletExtraArgs takes precedence over the computed one.
+	// +optional
+	MaxPods *MaxPodsOptions `json:"maxPods,omitempty"`
+
+	// AutoscalerTags enables tagging the ASG for the cluster autoscaler with the AWS cloud provider: the tags used
+	// to auto-discover the ASG, and the node template tags describing the labels and taints of the nodes of the pool
+	// so the autoscaler can scale it from zero.
+	// +optional
+	AutoscalerTags *AutoscalerTags `json:"autoscalerTags,omitempty"`
+
+	// MaxLaunchTemplateVersions is the maximum number of versions of the launch template to keep. When set, the
+	// oldest versions are deleted after a new version is created, except for the default version of the launch
+	// template and the versions existing instances of the pool were launched from. When not set, a single old
+	// version is deleted before each new version is created.
+	// +kubebuilder:validation:Minimum=2
+	// +optional
+	MaxLaunchTemplateVersions *int32 `json:"maxLaunchTemplateVersions,omitempty"`
+
+	// ASGTags are tags to add to the ASG only. They take precedence over the AdditionalTags, but not over the tags
+	// added by default by the AWS provider.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	ASGTags []ASGTag `json:"asgTags,omitempty"`
+
+	// LaunchTemplateTags are tags to add to the launch template only. They take precedence over the AdditionalTags,
+	// but not over the tags added by default by the AWS provider.
+	// +optional
+	LaunchTemplateTags infrav1.Tags `json:"launchTemplateTags,omitempty"`
+
+	// InstanceTags are tags to add to the instances launched from the launch template and their volumes only. They
+	// take precedence over the AdditionalTags, but not over the tags added by default by the AWS provider. Changing
+	// them creates a new version of the launch template.
+	// +optional
+	InstanceTags infrav1.Tags `json:"instanceTags,omitempty"`
+
+	// SpotInterruptionHandling routes the spot interruption notices, the rebalance recommendations and the
+	// termination lifecycle actions of the instances of the pool to an SQS queue, to be consumed by a node
+	// termination handler. The queue, the EventBridge rules and the termination lifecycle hook of the ASG are
+	// deleted with the AWSMachinePool, or when the field is unset.
+	// +optional
+	SpotInterruptionHandling *SpotInterruptionHandling `json:"spotInterruptionHandling,omitempty"`
+
+	// BaseLaunchTemplateID is the ID of a launch template the launch template of the pool inherits from. The settings
+	// of the default version of the base launch template are read each time a new version of the launch template of
+	// the pool is created, and the settings managed by the AWS provider, like the AMI, the user data or the security
+	// groups, take precedence over them. The tags of the base launch template are kept, unless overridden.
+	// +kubebuilder:validation:Pattern=`^lt-[0-9a-f]+$`
+	// +optional
+	BaseLaunchTemplateID *string `json:"baseLaunchTemplateID,omitempty"`
+
+	// SpotPlacementScores, if set, enables recording the Spot placement scores of the instance types of the pool
+	// in the availability zones of the region. The scores, from 1 to 10, tell how likely a request for the target
+	// capacity is to succeed in each availability zone. They are recorded in the
+	// aws.cluster.x-k8s.io/spot-placement-scores annotation and the SpotPlacementScoresReady condition, and
+	// failing to get them does not fail the reconciliation of the pool.
+	// +optional
+	SpotPlacementScores *SpotPlacementScores `json:"spotPlacementScores,omitempty"`
+}
+
+// SpotInterruptionHandling configures the interruption handling of the instances of an AWSMachinePool.
+type SpotInterruptionHandling struct {
+	// HeartbeatTimeoutSeconds is the time the termination lifecycle hook of the ASG gives the node termination
+	// handler to drain an instance before it is terminated. Defaults to 300.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=7200
+	// +optional
+	HeartbeatTimeoutSeconds *int64 `json:"heartbeatTimeoutSeconds,omitempty"`
+}
+
+// SpotPlacementScores defines the request of the Spot placement scores of a pool.
+type SpotPlacementScores struct {
+	// TargetCapacity is the number of instances the scores are requested for. Defaults to the maximum size of the pool.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetCapacity *int32 `json:"targetCapacity,omitempty"`
+}
+
+// SpotPlacementScoresStatus records the request of the Spot placement scores last fetched for a pool.
+type SpotPlacementScoresStatus struct {
+	// LastUpdated is the time the scores were last requested, whether the request succeeded or not.
+	LastUpdated metav1.Time `json:"lastUpdated"`
+
+	// InstanceTypes are the instance types the scores were fetched for.
+	InstanceTypes []string `json:"instanceTypes"`
+
+	// TargetCapacity is the number of instances the scores were fetched for.
+	TargetCapacity int32 `json:"targetCapacity"`
+}
+
+// ASGTag is a tag of an ASG.
+type ASGTag struct {
+	// Key is the key of the tag.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	// +kubebuilder:validation:MaxLength=256
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// PropagateAtLaunch makes the ASG add the tag to the instances it launches. The tags are added after the
+	// instances are launched: prefer InstanceTags for the tags that must be present at launch.
+	// +optional
+	PropagateAtLaunch bool `json:"propagateAtLaunch,omitempty"`
+}
+
+// AutoscalerTags describes the nodes of an AWSMachinePool to the cluster autoscaler.
+type AutoscalerTags struct {
+	// Labels are the labels of the nodes of the pool, tagged as k8s.io/cluster-autoscaler/node-template/label/<key>.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints are the taints of the nodes of the pool, tagged as k8s.io/cluster-autoscaler/node-template/taint/<key>.
+	// +optional
+	Taints Taints `json:"taints,omitempty"`
+}
+
+// MaxPodsOptions configures how the kubelet --max-pods argument is computed.
+type MaxPodsOptions struct {
+	// PrefixDelegation must be set when the Amazon VPC CNI assigns /28 IPv4 prefixes rather than
+	// individual addresses to the network interfaces (ENABLE_PREFIX_DELEGATION=true).
+	// +optional
+	PrefixDelegation bool `json:"prefixDelegation,omitempty"`
 }
 
 // SuspendProcessesTypes contains user friendly auto-completable values for suspended process names.
@@ -165,6 +314,29 @@ type RefreshPreferences struct {
 	// during an instance refresh. The default is 90.
 	// +optional
 	MinHealthyPercentage *int64 `json:"minHealthyPercentage,omitempty"`
+
+	// MaxSurge is the maximum number of instances that can be launched above the
+	// desired capacity of the ASG during an instance refresh. Value can be an absolute
+	// number (ex: 5) or a percentage of the desired capacity (ex: 10%).
+	// It is translated to the MaxHealthyPercentage of the instance refresh, which caps
+	// the capacity of the ASG to 200% of the desired capacity. Absolute numbers are
+	// rounded down to a percentage of the current desired capacity of the ASG, but to
+	// no less than 1% when no instance may be unavailable.
+	// MaxSurge can not be 0 if MaxUnavailable is not set, unless MinHealthyPercentage
+	// is lower than 100.
+	// If neither MaxSurge nor MaxUnavailable is set, the AWS defaults apply.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the maximum number of instances that can be unavailable below
+	// the desired capacity of the ASG during an instance refresh. Value can be an absolute
+	// number (ex: 5) or a percentage of the desired capacity (ex: 10%).
+	// It is translated to the MinHealthyPercentage of the instance refresh and cannot be
+	// set together with MinHealthyPercentage. Absolute numbers are rounded down to a
+	// percentage of the current desired capacity of the ASG, so that no more instances
+	// are unavailable. MaxUnavailable can not be 0 if MaxSurge is 0.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
 // AWSMachinePoolStatus defines the observed state of AWSMachinePool.
@@ -192,6 +364,16 @@ type AWSMachinePoolStatus struct {
 	// +optional
 	LaunchTemplateVersion *string `json:"launchTemplateVersion,omitempty"`
 
+	// SpotInterruptionQueueURL is the URL of the SQS queue the interruption notices of the instances of the pool
+	// are sent to, when SpotInterruptionHandling is enabled.
+	// +optional
+	SpotInterruptionQueueURL string `json:"spotInterruptionQueueURL,omitempty"`
+
+	// SpotPlacementScores records the request of the Spot placement scores last fetched, when SpotPlacementScores
+	// is set. The scores are fetched again once they are older than an hour, or when the request changes.
+	// +optional
+	SpotPlacementScores *SpotPlacementScoresStatus `json:"spotPlacementScores,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
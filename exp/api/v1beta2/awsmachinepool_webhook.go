@@ -17,10 +17,14 @@ limitations under the License.
 package v1beta2
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -96,6 +100,17 @@ func (r *AWSMachinePool) validateSubnets() field.ErrorList {
 		}
 	}
 
+	subnetIDs := make(map[string]struct{}, len(r.Spec.Subnets))
+	for i, subnet := range r.Spec.Subnets {
+		if subnet.ID == nil {
+			continue
+		}
+		if _, ok := subnetIDs[*subnet.ID]; ok {
+			allErrs = append(allErrs, field.Duplicate(field.NewPath("spec", "subnets").Index(i).Child("id"), *subnet.ID))
+		}
+		subnetIDs[*subnet.ID] = struct{}{}
+	}
+
 	return allErrs
 }
 
@@ -116,6 +131,132 @@ func (r *AWSMachinePool) validateSpotInstances() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachinePool) validateMaxPods() field.ErrorList {
+	var allErrs field.ErrorList
+	// The max pods are computed from the launch template instance type, which may be overridden by the mixed instances policy.
+	if r.Spec.MaxPods != nil && r.Spec.MixedInstancesPolicy != nil && len(r.Spec.MixedInstancesPolicy.Overrides) > 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "maxPods"), "max pods cannot be computed when the instance type is overridden by spec.mixedInstancesPolicy"))
+	}
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateRefreshPreferences() field.ErrorList {
+	var allErrs field.ErrorList
+	prefs := r.Spec.RefreshPreferences
+	if prefs == nil {
+		return allErrs
+	}
+
+	prefsPath := field.NewPath("spec", "refreshPreferences")
+	surge, surgeErrs := validateRefreshBound(prefs.MaxSurge, prefsPath.Child("maxSurge"))
+	allErrs = append(allErrs, surgeErrs...)
+	unavailable, unavailableErrs := validateRefreshBound(prefs.MaxUnavailable, prefsPath.Child("maxUnavailable"))
+	allErrs = append(allErrs, unavailableErrs...)
+
+	if prefs.MaxUnavailable != nil && prefs.MinHealthyPercentage != nil {
+		allErrs = append(allErrs, field.Forbidden(prefsPath.Child("maxUnavailable"), "either spec.refreshPreferences.maxUnavailable or spec.refreshPreferences.minHealthyPercentage should be used"))
+	}
+	if prefs.MaxSurge != nil && prefs.MaxUnavailable != nil && len(surgeErrs) == 0 && len(unavailableErrs) == 0 && surge == 0 && unavailable == 0 {
+		allErrs = append(allErrs, field.Invalid(prefsPath.Child("maxUnavailable"), prefs.MaxUnavailable.String(), "must not be 0 when spec.refreshPreferences.maxSurge is 0"))
+	}
+	// Without MaxUnavailable, the instance refresh keeps all the instances healthy unless MinHealthyPercentage is lower.
+	if prefs.MaxSurge != nil && prefs.MaxUnavailable == nil && len(surgeErrs) == 0 && surge == 0 && (prefs.MinHealthyPercentage == nil || *prefs.MinHealthyPercentage >= 100) {
+		allErrs = append(allErrs, field.Invalid(prefsPath.Child("maxSurge"), prefs.MaxSurge.String(), "must not be 0 when spec.refreshPreferences.maxUnavailable is not set"))
+	}
+
+	return allErrs
+}
+
+// validateRefreshBound validates an absolute or percentage bound of an instance refresh and returns its value.
+func validateRefreshBound(bound *intstr.IntOrString, fldPath *field.Path) (int, field.ErrorList) {
+	var allErrs field.ErrorList
+	if bound == nil {
+		return 0, allErrs
+	}
+
+	value, err := intstr.GetScaledValueFromIntOrPercent(bound, 100, true)
+	switch {
+	case err != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, bound.String(), err.Error()))
+	case value < 0:
+		allErrs = append(allErrs, field.Invalid(fldPath, bound.String(), "must be greater than or equal to 0"))
+	case bound.Type == intstr.String && value > 100:
+		allErrs = append(allErrs, field.Invalid(fldPath, bound.String(), "must not be greater than 100%"))
+	}
+
+	return value, allErrs
+}
+
+// knownKubeletFlags are the kubelet flags that are commonly set per machine pool. Other flags are allowed,
+// but a warning is returned as they may be misspelled or not supported by the kubelet version of the pool.
+var knownKubeletFlags = sets.New[string](
+	"allowed-unsafe-sysctls",
+	"container-log-max-files",
+	"container-log-max-size",
+	"cpu-cfs-quota",
+	"cpu-manager-policy",
+	"enforce-node-allocatable",
+	"eviction-hard",
+	"eviction-max-pod-grace-period",
+	"eviction-minimum-reclaim",
+	"eviction-soft",
+	"eviction-soft-grace-period",
+	"feature-gates",
+	"image-gc-high-threshold",
+	"image-gc-low-threshold",
+	"kube-api-burst",
+	"kube-api-qps",
+	"kube-reserved",
+	"kube-reserved-cgroup",
+	"max-pods",
+	"node-labels",
+	"node-status-update-frequency",
+	"pod-max-pids",
+	"register-with-taints",
+	"registry-burst",
+	"registry-qps",
+	"reserved-cpus",
+	"serialize-image-pulls",
+	"system-reserved",
+	"system-reserved-cgroup",
+	"topology-manager-policy",
+	"v",
+)
+
+func (r *AWSMachinePool) validateKubeletExtraArgs() (field.ErrorList, admission.Warnings) {
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+
+	argsPath := field.NewPath("spec", "kubeletExtraArgs")
+	for flag, value := range r.Spec.KubeletExtraArgs {
+		switch {
+		case flag == "" || strings.HasPrefix(flag, "-"):
+			allErrs = append(allErrs, field.Invalid(argsPath.Key(flag), flag, "flag must be given without leading dashes"))
+		case strings.ContainsAny(flag+value, "'\" \t\n"):
+			allErrs = append(allErrs, field.Invalid(argsPath.Key(flag), value, "flag and value must not contain quotes or whitespace"))
+		case !knownKubeletFlags.Has(flag):
+			warnings = append(warnings, fmt.Sprintf("%s: unknown kubelet flag %q", argsPath.Key(flag), flag))
+		}
+	}
+
+	return allErrs, warnings
+}
+
+// validateTags validates the tags of the ASG, the launch template and the instances like the additional tags.
+func (r *AWSMachinePool) validateTags() field.ErrorList {
+	var allErrs field.ErrorList
+
+	asgTags := v1beta2.Tags{}
+	for _, tag := range r.Spec.ASGTags {
+		asgTags[tag.Key] = tag.Value
+	}
+	allErrs = append(allErrs, asgTags.ValidateWithPath(field.NewPath("spec", "asgTags"))...)
+	allErrs = append(allErrs, r.Spec.LaunchTemplateTags.ValidateWithPath(field.NewPath("spec", "launchTemplateTags"))...)
+	allErrs = append(allErrs, r.Spec.InstanceTags.ValidateWithPath(field.NewPath("spec", "instanceTags"))...)
+
+	return allErrs
+}
+
 // ValidateCreate will do any extra validation when creating a AWSMachinePool.
 func (r *AWSMachinePool) ValidateCreate() (admission.Warnings, error) {
 	log.Info("AWSMachinePool validate create", "machine-pool", klog.KObj(r))
@@ -125,15 +266,22 @@ func (r *AWSMachinePool) ValidateCreate() (admission.Warnings, error) {
 	allErrs = append(allErrs, r.validateDefaultCoolDown()...)
 	allErrs = append(allErrs, r.validateRootVolume()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, r.validateTags()...)
 	allErrs = append(allErrs, r.validateSubnets()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
 	allErrs = append(allErrs, r.validateSpotInstances()...)
+	allErrs = append(allErrs, r.validateMaxPods()...)
+	allErrs = append(allErrs, r.validateRefreshPreferences()...)
+	allErrs = append(allErrs, r.Spec.AWSLaunchTemplate.InstanceMetadataOptions.Validate(field.NewPath("spec", "awsLaunchTemplate", "instanceMetadataOptions"))...)
+
+	argsErrs, warnings := r.validateKubeletExtraArgs()
+	allErrs = append(allErrs, argsErrs...)
 
 	if len(allErrs) == 0 {
-		return nil, nil
+		return warnings, nil
 	}
 
-	return nil, apierrors.NewInvalid(
+	return warnings, apierrors.NewInvalid(
 		r.GroupVersionKind().GroupKind(),
 		r.Name,
 		allErrs,
@@ -146,15 +294,22 @@ func (r *AWSMachinePool) ValidateUpdate(old runtime.Object) (admission.Warnings,
 
 	allErrs = append(allErrs, r.validateDefaultCoolDown()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, r.validateTags()...)
 	allErrs = append(allErrs, r.validateSubnets()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
 	allErrs = append(allErrs, r.validateSpotInstances()...)
+	allErrs = append(allErrs, r.validateMaxPods()...)
+	allErrs = append(allErrs, r.validateRefreshPreferences()...)
+	allErrs = append(allErrs, r.Spec.AWSLaunchTemplate.InstanceMetadataOptions.Validate(field.NewPath("spec", "awsLaunchTemplate", "instanceMetadataOptions"))...)
+
+	argsErrs, warnings := r.validateKubeletExtraArgs()
+	allErrs = append(allErrs, argsErrs...)
 
 	if len(allErrs) == 0 {
-		return nil, nil
+		return warnings, nil
 	}
 
-	return nil, apierrors.NewInvalid(
+	return warnings, apierrors.NewInvalid(
 		r.GroupVersionKind().GroupKind(),
 		r.Name,
 		allErrs,
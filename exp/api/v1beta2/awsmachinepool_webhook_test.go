@@ -23,6 +23,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -41,9 +42,10 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 	g := NewWithT(t)
 
 	tests := []struct {
-		name    string
-		pool    *AWSMachinePool
-		wantErr bool
+		name     string
+		pool     *AWSMachinePool
+		wantErr  bool
+		wantWarn bool
 	}{
 		{
 			name: "pool with valid tags is accepted",
@@ -72,6 +74,44 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid ASG tags are rejected",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ASGTags: []ASGTag{{Key: "aws:reserved", Value: "value-1", PropagateAtLaunch: true}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid launch template tags are rejected",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					LaunchTemplateTags: infrav1.Tags{"key-1": strings.Repeat("CAPI", 65)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid instance tags are rejected",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					InstanceTags: infrav1.Tags{"key#1": "value-1"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid ASG, launch template and instance tags are accepted",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ASGTags:            []ASGTag{{Key: "k8s.io/cluster-autoscaler/enabled", Value: "true"}},
+					LaunchTemplateTags: infrav1.Tags{"team": "platform"},
+					InstanceTags:       infrav1.Tags{"cost-center": "1234"},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Should fail if additional security groups are provided with both ID and Filters",
 			pool: &AWSMachinePool{
@@ -124,6 +164,22 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Should fail if a subnet ID is given twice in AWSMachinePool spec",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					Subnets: []infrav1.AWSResourceReference{
+						{
+							ID: ptr.To[string]("subnet-id"),
+						},
+						{
+							ID: ptr.To[string]("subnet-id"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Ensure root volume with device name works (for clusterctl move)",
 			pool: &AWSMachinePool{
@@ -153,6 +209,172 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should fail if instance metadata tags are enabled with the metadata endpoint disabled",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						InstanceMetadataOptions: &infrav1.InstanceMetadataOptions{
+							HTTPEndpoint:         infrav1.InstanceMetadataEndpointStateDisabled,
+							InstanceMetadataTags: infrav1.InstanceMetadataEndpointStateEnabled,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should accept instance metadata tags with the metadata endpoint enabled",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						InstanceMetadataOptions: &infrav1.InstanceMetadataOptions{
+							HTTPEndpoint:         infrav1.InstanceMetadataEndpointStateEnabled,
+							InstanceMetadataTags: infrav1.InstanceMetadataEndpointStateEnabled,
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should fail if max pods are computed with mixed instance type overrides",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{{InstanceType: "t3.medium"}},
+					},
+					MaxPods: &MaxPodsOptions{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should accept max pods without mixed instance type overrides",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MaxPods: &MaxPodsOptions{PrefixDelegation: true},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should accept known kubelet extra args",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					KubeletExtraArgs: map[string]string{
+						"max-pods":        "58",
+						"system-reserved": "cpu=100m,memory=200Mi",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should warn on unknown kubelet extra args",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					KubeletExtraArgs: map[string]string{
+						"max-podz": "58",
+					},
+				},
+			},
+			wantErr:  false,
+			wantWarn: true,
+		},
+		{
+			name: "Should fail if kubelet extra args have leading dashes",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					KubeletExtraArgs: map[string]string{
+						"--max-pods": "58",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should pass if max surge and max unavailable are valid",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					RefreshPreferences: &RefreshPreferences{
+						MaxSurge:       ptr.To(intstr.FromInt32(1)),
+						MaxUnavailable: ptr.To(intstr.FromString("10%")),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should fail if max unavailable is set along with min healthy percentage",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					RefreshPreferences: &RefreshPreferences{
+						MaxUnavailable:       ptr.To(intstr.FromInt32(1)),
+						MinHealthyPercentage: ptr.To[int64](90),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail if both max surge and max unavailable are 0",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					RefreshPreferences: &RefreshPreferences{
+						MaxSurge:       ptr.To(intstr.FromString("0%")),
+						MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail if max surge is 0 without max unavailable",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					RefreshPreferences: &RefreshPreferences{
+						MaxSurge: ptr.To(intstr.FromInt32(0)),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should pass if max surge is 0 with a min healthy percentage below 100",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					RefreshPreferences: &RefreshPreferences{
+						MaxSurge:             ptr.To(intstr.FromInt32(0)),
+						MinHealthyPercentage: ptr.To[int64](90),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should fail if max surge is negative or above 100%",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					RefreshPreferences: &RefreshPreferences{
+						MaxSurge:       ptr.To(intstr.FromString("150%")),
+						MaxUnavailable: ptr.To(intstr.FromInt32(-1)),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail if kubelet extra args contain quotes or whitespace",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					KubeletExtraArgs: map[string]string{
+						"node-labels": "role=worker' --v=9",
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -162,8 +384,11 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			} else {
 				g.Expect(err).To(Succeed())
 			}
-			// Nothing emits warnings yet
-			g.Expect(warn).To(BeEmpty())
+			if tt.wantWarn {
+				g.Expect(warn).ToNot(BeEmpty())
+			} else {
+				g.Expect(warn).To(BeEmpty())
+			}
 		})
 	}
 }
@@ -18,6 +18,7 @@ package v1beta2
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -37,8 +38,68 @@ const (
 	Al2x86_64GPU ManagedMachineAMIType = "AL2_x86_64_GPU"
 	// Al2Arm64 is the Arm AMI type.
 	Al2Arm64 ManagedMachineAMIType = "AL2_ARM_64"
+	// Al2023x86_64Standard is the x86-64 Amazon Linux 2023 AMI type.
+	Al2023x86_64Standard ManagedMachineAMIType = "AL2023_x86_64_STANDARD"
+	// Al2023Arm64Standard is the Arm Amazon Linux 2023 AMI type.
+	Al2023Arm64Standard ManagedMachineAMIType = "AL2023_ARM_64_STANDARD"
+	// Al2023x86_64Nvidia is the x86-64 Amazon Linux 2023 AMI type for NVIDIA GPUs.
+	Al2023x86_64Nvidia ManagedMachineAMIType = "AL2023_x86_64_NVIDIA"
+	// Al2023x86_64Neuron is the x86-64 Amazon Linux 2023 AMI type for AWS Inferentia and Trainium accelerators.
+	Al2023x86_64Neuron ManagedMachineAMIType = "AL2023_x86_64_NEURON"
+	// BottlerocketX86_64 is the x86-64 Bottlerocket AMI type.
+	BottlerocketX86_64 ManagedMachineAMIType = "BOTTLEROCKET_x86_64"
+	// BottlerocketArm64 is the Arm Bottlerocket AMI type.
+	BottlerocketArm64 ManagedMachineAMIType = "BOTTLEROCKET_ARM_64"
+	// BottlerocketX86_64Nvidia is the x86-64 Bottlerocket AMI type for NVIDIA GPUs.
+	BottlerocketX86_64Nvidia ManagedMachineAMIType = "BOTTLEROCKET_x86_64_NVIDIA"
+	// BottlerocketArm64Nvidia is the Arm Bottlerocket AMI type for NVIDIA GPUs.
+	BottlerocketArm64Nvidia ManagedMachineAMIType = "BOTTLEROCKET_ARM_64_NVIDIA"
 )
 
+const (
+	// AMIAcceleratorNvidia is the accelerator of the AMI types built for NVIDIA GPUs.
+	AMIAcceleratorNvidia = "NVIDIA"
+	// AMIAcceleratorNeuron is the accelerator of the AMI types built for AWS Inferentia and Trainium accelerators.
+	AMIAcceleratorNeuron = "NEURON"
+)
+
+// IsGPU returns whether the AMI type is built for instance types with GPUs or machine learning accelerators.
+func (t ManagedMachineAMIType) IsGPU() bool {
+	return len(t.Accelerators()) > 0
+}
+
+// Accelerators returns the accelerators the AMI type is built for, none if it isn't a GPU AMI type.
+func (t ManagedMachineAMIType) Accelerators() []string {
+	switch t {
+	case Al2x86_64GPU:
+		return []string{AMIAcceleratorNvidia, AMIAcceleratorNeuron}
+	case Al2023x86_64Nvidia, BottlerocketX86_64Nvidia, BottlerocketArm64Nvidia:
+		return []string{AMIAcceleratorNvidia}
+	case Al2023x86_64Neuron:
+		return []string{AMIAcceleratorNeuron}
+	default:
+		return nil
+	}
+}
+
+// Architecture returns the architecture of the AMI type, x86_64 or arm64, or nothing for the CUSTOM AMI type.
+func (t ManagedMachineAMIType) Architecture() string {
+	switch {
+	case strings.Contains(string(t), "_ARM_64"):
+		return "arm64"
+	case strings.Contains(string(t), "_x86_64"):
+		return "x86_64"
+	default:
+		return ""
+	}
+}
+
+// IsBottlerocket returns whether the AMI type is a Bottlerocket one. The release versions of the Bottlerocket
+// AMIs are versions of Bottlerocket, not of Kubernetes.
+func (t ManagedMachineAMIType) IsBottlerocket() bool {
+	return strings.HasPrefix(string(t), "BOTTLEROCKET_")
+}
+
 // ManagedMachinePoolCapacityType specifies the capacity type to be used for the managed MachinePool.
 type ManagedMachinePoolCapacityType string
 
@@ -96,15 +157,19 @@ type AWSManagedMachinePoolSpec struct {
 	// +optional
 	RoleName string `json:"roleName,omitempty"`
 
-	// AMIVersion defines the desired AMI release version. If no version number
+	// AMIVersion defines the desired AMI release version, e.g. 1.28.5-20240227. If no version number
 	// is supplied then the latest version for the Kubernetes version
-	// will be used
+	// will be used. The release version must be built for the Kubernetes minor
+	// version of the node group, changing it updates the AMI of the node group.
 	// +kubebuilder:validation:MinLength:=2
 	// +optional
 	AMIVersion *string `json:"amiVersion,omitempty"`
 
-	// AMIType defines the AMI type
-	// +kubebuilder:validation:Enum:=AL2_x86_64;AL2_x86_64_GPU;AL2_ARM_64;CUSTOM
+	// AMIType defines the AMI type of the node group, e.g. AL2_x86_64, AL2023_x86_64_STANDARD or BOTTLEROCKET_x86_64.
+	// The AMI type must match the architecture of the instance type. The NVIDIA AMI types, AL2023_x86_64_NVIDIA,
+	// BOTTLEROCKET_x86_64_NVIDIA and BOTTLEROCKET_ARM_64_NVIDIA, can only be used with instance types with NVIDIA GPUs,
+	// AL2023_x86_64_NEURON with AWS Inferentia and Trainium instance types, and AL2_x86_64_GPU with either.
+	// +kubebuilder:validation:Enum:=AL2_x86_64;AL2_x86_64_GPU;AL2_ARM_64;AL2023_x86_64_STANDARD;AL2023_ARM_64_STANDARD;AL2023_x86_64_NVIDIA;AL2023_x86_64_NEURON;BOTTLEROCKET_x86_64;BOTTLEROCKET_ARM_64;BOTTLEROCKET_x86_64_NVIDIA;BOTTLEROCKET_ARM_64_NVIDIA;CUSTOM
 	// +kubebuilder:default:=AL2_x86_64
 	// +optional
 	AMIType *ManagedMachineAMIType `json:"amiType,omitempty"`
@@ -155,6 +220,12 @@ type AWSManagedMachinePoolSpec struct {
 	// are prohibited (https://docs.aws.amazon.com/eks/latest/userguide/launch-templates.html).
 	// +optional
 	AWSLaunchTemplate *AWSLaunchTemplate `json:"awsLaunchTemplate,omitempty"`
+
+	// CreationTimeout is how long the nodegroup can take to be created. Once exceeded, the EKSNodegroupActive
+	// condition reports that the creation timed out and the controller stops waiting for the nodegroup to be
+	// active in each reconciliation. Defaults to waiting for up to 40 minutes in each reconciliation.
+	// +optional
+	CreationTimeout *metav1.Duration `json:"creationTimeout,omitempty"`
 }
 
 // ManagedMachinePoolScaling specifies scaling options.
@@ -19,6 +19,9 @@ package v1beta2
 import (
 	"fmt"
 	"reflect"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -38,6 +41,22 @@ const (
 	maxNodegroupNameLength = 64
 )
 
+// acceleratedInstanceFamilies are the accelerators of the instance families the GPU AMI types are built for: the
+// families with NVIDIA GPUs and the AWS Inferentia and Trainium ones. The other accelerated families, e.g. g4ad with
+// AMD GPUs or dl1 with Gaudi accelerators, aren't supported by these AMIs.
+var acceleratedInstanceFamilies = map[string]string{
+	"g3": AMIAcceleratorNvidia, "g3s": AMIAcceleratorNvidia, "g4dn": AMIAcceleratorNvidia, "g5": AMIAcceleratorNvidia,
+	"g5g": AMIAcceleratorNvidia, "g6": AMIAcceleratorNvidia, "g6e": AMIAcceleratorNvidia, "gr6": AMIAcceleratorNvidia,
+	"p2": AMIAcceleratorNvidia, "p3": AMIAcceleratorNvidia, "p3dn": AMIAcceleratorNvidia, "p4d": AMIAcceleratorNvidia,
+	"p4de": AMIAcceleratorNvidia, "p5": AMIAcceleratorNvidia, "p5e": AMIAcceleratorNvidia, "p5en": AMIAcceleratorNvidia,
+	"inf1": AMIAcceleratorNeuron, "inf2": AMIAcceleratorNeuron, "trn1": AMIAcceleratorNeuron, "trn1n": AMIAcceleratorNeuron,
+	"trn2": AMIAcceleratorNeuron,
+}
+
+// gravitonInstanceFamilyRegex matches the instance families with AWS Graviton processors, e.g. m6g, c7gn or g5g,
+// whose name has a g right after the generation.
+var gravitonInstanceFamilyRegex = regexp.MustCompile(`^(a1|[a-z]+[0-9]+g[a-z]*)$`)
+
 // log is for logging in this package.
 var mmpLog = ctrl.Log.WithName("awsmanagedmachinepool-resource")
 
@@ -135,9 +154,94 @@ func (r *AWSManagedMachinePool) validateLaunchTemplate() field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "AWSLaunchTemplate", "IamInstanceProfile"), r.Spec.AWSLaunchTemplate.IamInstanceProfile, "IAM instance profile in launch template is prohibited in EKS managed node group"))
 	}
 
+	allErrs = append(allErrs, r.Spec.AWSLaunchTemplate.InstanceMetadataOptions.Validate(field.NewPath("spec", "awsLaunchTemplate", "instanceMetadataOptions"))...)
+
+	return allErrs
+}
+
+func (r *AWSManagedMachinePool) validateAMIVersion() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.AMIVersion == nil {
+		return allErrs
+	}
+
+	amiVersionPath := field.NewPath("spec", "amiVersion")
+	isBottlerocket := r.Spec.AMIType != nil && r.Spec.AMIType.IsBottlerocket()
+	if _, err := eks.ParseAMIReleaseVersion(*r.Spec.AMIVersion); err != nil && !isBottlerocket {
+		allErrs = append(allErrs, field.Invalid(amiVersionPath, *r.Spec.AMIVersion, err.Error()))
+	}
+	if r.Spec.AWSLaunchTemplate != nil && r.Spec.AWSLaunchTemplate.AMI.ID != nil {
+		allErrs = append(allErrs, field.Invalid(amiVersionPath, *r.Spec.AMIVersion, "amiVersion cannot be specified when the launch template uses a custom AMI"))
+	}
+
+	return allErrs
+}
+
+// validateAMIType checks that the GPU AMI types are only used with accelerated instance types.
+func (r *AWSManagedMachinePool) validateAMIType() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.AMIType == nil || r.Spec.AMIType.Architecture() == "" {
+		return allErrs
+	}
+
+	instanceType := ptr.Deref(r.Spec.InstanceType, "")
+	if instanceType == "" && r.Spec.AWSLaunchTemplate != nil {
+		instanceType = r.Spec.AWSLaunchTemplate.InstanceType
+	}
+	if instanceType == "" {
+		return allErrs
+	}
+
+	amiTypePath := field.NewPath("spec", "amiType")
+	family, _, _ := strings.Cut(instanceType, ".")
+	architecture := "x86_64"
+	if gravitonInstanceFamilyRegex.MatchString(family) {
+		architecture = "arm64"
+	}
+	if architecture != r.Spec.AMIType.Architecture() {
+		allErrs = append(allErrs, field.Invalid(amiTypePath, *r.Spec.AMIType,
+			fmt.Sprintf("AMI type is built for %s instance types, %s is an %s instance type", r.Spec.AMIType.Architecture(), instanceType, architecture)))
+	}
+
+	if accelerators := r.Spec.AMIType.Accelerators(); len(accelerators) > 0 && !slices.Contains(accelerators, acceleratedInstanceFamilies[family]) {
+		allErrs = append(allErrs, field.Invalid(amiTypePath, *r.Spec.AMIType,
+			fmt.Sprintf("AMI type is built for instance types with %s accelerators, %s has none", strings.Join(accelerators, " or "), instanceType)))
+	}
+
 	return allErrs
 }
 
+func (r *AWSManagedMachinePool) validateCapacityType() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.CapacityType == nil {
+		return allErrs
+	}
+
+	switch *r.Spec.CapacityType {
+	case ManagedMachinePoolCapacityTypeOnDemand, ManagedMachinePoolCapacityTypeSpot:
+	default:
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("spec", "capacityType"), *r.Spec.CapacityType, []string{
+			string(ManagedMachinePoolCapacityTypeOnDemand),
+			string(ManagedMachinePoolCapacityTypeSpot),
+		}))
+	}
+
+	return allErrs
+}
+
+// capacityTypeWarnings warns about spot node groups, which can only use a single instance type.
+// Spot capacity is more likely to be available, and less likely to be interrupted, when the node
+// group can use multiple instance types.
+func (r *AWSManagedMachinePool) capacityTypeWarnings() admission.Warnings {
+	if r.Spec.CapacityType == nil || *r.Spec.CapacityType != ManagedMachinePoolCapacityTypeSpot {
+		return nil
+	}
+
+	return admission.Warnings{
+		"spot capacity is used with a single instance type, using multiple instance types is recommended to reduce the impact of spot interruptions",
+	}
+}
+
 // ValidateCreate will do any extra validation when creating a AWSManagedMachinePool.
 func (r *AWSManagedMachinePool) ValidateCreate() (admission.Warnings, error) {
 	mmpLog.Info("AWSManagedMachinePool validate create", "managed-machine-pool", klog.KObj(r))
@@ -159,14 +263,24 @@ func (r *AWSManagedMachinePool) ValidateCreate() (admission.Warnings, error) {
 	if errs := r.validateLaunchTemplate(); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
+	if errs := r.validateCapacityType(); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := r.validateAMIVersion(); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := r.validateAMIType(); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
 
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 
+	warnings := r.capacityTypeWarnings()
 	if len(allErrs) == 0 {
-		return nil, nil
+		return warnings, nil
 	}
 
-	return nil, apierrors.NewInvalid(
+	return warnings, apierrors.NewInvalid(
 		r.GroupVersionKind().GroupKind(),
 		r.Name,
 		allErrs,
@@ -196,6 +310,15 @@ func (r *AWSManagedMachinePool) ValidateUpdate(old runtime.Object) (admission.Wa
 	if errs := r.validateLaunchTemplate(); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
+	if errs := r.validateCapacityType(); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := r.validateAMIVersion(); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := r.validateAMIType(); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
 
 	if len(allErrs) == 0 {
 		return nil, nil
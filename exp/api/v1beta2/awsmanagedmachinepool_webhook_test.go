@@ -50,9 +50,10 @@ func TestAWSManagedMachinePoolValidateCreate(t *testing.T) {
 	g := NewWithT(t)
 
 	tests := []struct {
-		name    string
-		pool    *AWSManagedMachinePool
-		wantErr bool
+		name     string
+		pool     *AWSManagedMachinePool
+		wantErr  bool
+		wantWarn bool
 	}{
 		{
 			name: "pool requires a EKS Node group name",
@@ -115,6 +116,18 @@ func TestAWSManagedMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid update config with percentage",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					UpdateConfig: &UpdateConfig{
+						MaxUnavailablePercentage: aws.Int(10),
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "update config with no values",
 			pool: &AWSManagedMachinePool{
@@ -150,6 +163,132 @@ func TestAWSManagedMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "onDemand capacity type is accepted",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     ptr.To(ManagedMachinePoolCapacityTypeOnDemand),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "spot capacity type is accepted with a warning",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					InstanceType:     ptr.To("m5.large"),
+					CapacityType:     ptr.To(ManagedMachinePoolCapacityTypeSpot),
+				},
+			},
+			wantErr:  false,
+			wantWarn: true,
+		},
+		{
+			name: "unknown capacity type is rejected",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     ptr.To(ManagedMachinePoolCapacityType("reserved")),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ami version is accepted",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIVersion:       ptr.To("1.28.5-20240227"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid ami version is rejected",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIVersion:       ptr.To("v1.28"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bottlerocket ami version is accepted",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(BottlerocketX86_64),
+					AMIVersion:       ptr.To("1.19.2-29cc92cc"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "nvidia ami type with a gpu instance type is accepted",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(Al2023x86_64Nvidia),
+					InstanceType:     ptr.To("g5.xlarge"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "neuron ami type with an inferentia instance type is accepted",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(Al2023x86_64Neuron),
+					InstanceType:     ptr.To("inf2.xlarge"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "gpu ami type with a non gpu instance type is rejected",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(Al2x86_64GPU),
+					InstanceType:     ptr.To("m5.large"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "gpu ami type with a non gpu launch template instance type is rejected",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(BottlerocketArm64Nvidia),
+					AWSLaunchTemplate: &AWSLaunchTemplate{
+						Name:         "test",
+						InstanceType: "m7g.large",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ami version with a custom ami is rejected",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIVersion:       ptr.To("1.28.5-20240227"),
+					AWSLaunchTemplate: &AWSLaunchTemplate{
+						Name: "test",
+						AMI: infrav1.AMIReference{
+							ID: ptr.To("ami-123456"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -159,8 +298,54 @@ func TestAWSManagedMachinePoolValidateCreate(t *testing.T) {
 			} else {
 				g.Expect(err).To(Succeed())
 			}
-			// Nothing emits warnings yet
-			g.Expect(warn).To(BeEmpty())
+			if tt.wantWarn {
+				g.Expect(warn).NotTo(BeEmpty())
+			} else {
+				g.Expect(warn).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestAWSManagedMachinePoolValidateAMIType(t *testing.T) {
+	tests := []struct {
+		amiType ManagedMachineAMIType
+		// instanceType is an instance type the AMI type can be used with.
+		instanceType string
+		// invalidInstanceTypes are instance types of another architecture or without the accelerators of the AMI type.
+		invalidInstanceTypes []string
+	}{
+		{amiType: Al2x86_64, instanceType: "m5.large", invalidInstanceTypes: []string{"m6g.large"}},
+		{amiType: Al2x86_64GPU, instanceType: "p3.2xlarge", invalidInstanceTypes: []string{"t3.medium", "g4ad.xlarge", "g5g.xlarge"}},
+		{amiType: Al2Arm64, instanceType: "m6g.large", invalidInstanceTypes: []string{"t3.medium"}},
+		{amiType: Al2023x86_64Standard, instanceType: "m5.large", invalidInstanceTypes: []string{"c7gn.large"}},
+		{amiType: Al2023Arm64Standard, instanceType: "m7g.large", invalidInstanceTypes: []string{"m5.large"}},
+		{amiType: Al2023x86_64Nvidia, instanceType: "g6.xlarge", invalidInstanceTypes: []string{"t3.medium", "g4ad.xlarge", "inf2.xlarge"}},
+		{amiType: Al2023x86_64Neuron, instanceType: "trn1.2xlarge", invalidInstanceTypes: []string{"t3.medium", "g6.xlarge"}},
+		{amiType: BottlerocketX86_64, instanceType: "m5.large", invalidInstanceTypes: []string{"t4g.medium"}},
+		{amiType: BottlerocketArm64, instanceType: "c7g.large", invalidInstanceTypes: []string{"c5.large"}},
+		{amiType: BottlerocketX86_64Nvidia, instanceType: "g4dn.xlarge", invalidInstanceTypes: []string{"t3.medium", "inf1.xlarge", "g5g.xlarge"}},
+		{amiType: BottlerocketArm64Nvidia, instanceType: "g5g.xlarge", invalidInstanceTypes: []string{"m6g.large", "g4dn.xlarge"}},
+		{amiType: ManagedMachineAMIType("CUSTOM"), instanceType: "m6g.large"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.amiType), func(t *testing.T) {
+			g := NewWithT(t)
+			pool := &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group",
+					AMIType:          ptr.To(tt.amiType),
+					InstanceType:     ptr.To(tt.instanceType),
+				},
+			}
+			_, err := pool.ValidateCreate()
+			g.Expect(err).NotTo(HaveOccurred())
+
+			for _, instanceType := range tt.invalidInstanceTypes {
+				pool.Spec.InstanceType = ptr.To(instanceType)
+				_, err = pool.ValidateCreate()
+				g.Expect(err).To(HaveOccurred(), instanceType)
+			}
 		})
 	}
 }
@@ -249,6 +434,27 @@ func TestAWSManagedMachinePoolValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "update config with both values is rejected",
+			old: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					UpdateConfig: &UpdateConfig{
+						MaxUnavailable: aws.Int(1),
+					},
+				},
+			},
+			new: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					UpdateConfig: &UpdateConfig{
+						MaxUnavailable:           aws.Int(1),
+						MaxUnavailablePercentage: aws.Int(10),
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "removing update config is accepted",
 			old: &AWSManagedMachinePool{
@@ -688,6 +894,38 @@ func TestAWSManagedMachinePoolValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "changing ami version is accepted",
+			old: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					AMIVersion:       ptr.To("1.28.5-20240227"),
+				},
+			},
+			new: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					AMIVersion:       ptr.To("1.28.5-20240313"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "changing to an invalid ami version is rejected",
+			old: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					AMIVersion:       ptr.To("1.28.5-20240227"),
+				},
+			},
+			new: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					AMIVersion:       ptr.To("latest"),
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
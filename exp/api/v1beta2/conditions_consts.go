@@ -54,6 +54,24 @@ const (
 	InstanceRefreshNotReadyReason = "InstanceRefreshNotReady"
 	// InstanceRefreshFailedReason used to report when there instance refresh is not initiated.
 	InstanceRefreshFailedReason = "InstanceRefreshFailed"
+
+	// SpotInterruptionHandlingReadyCondition reports on the reconciliation of the SQS queue, EventBridge rules and
+	// termination lifecycle hook routing the interruption notices of the instances of an AWSMachinePool.
+	SpotInterruptionHandlingReadyCondition clusterv1.ConditionType = "SpotInterruptionHandlingReady"
+	// SpotInterruptionHandlingFailedReason used to report failures while reconciling the interruption handling.
+	SpotInterruptionHandlingFailedReason = "SpotInterruptionHandlingFailed"
+
+	// SpotPlacementScoresReadyCondition reports on the recording of the Spot placement scores of the pool.
+	SpotPlacementScoresReadyCondition clusterv1.ConditionType = "SpotPlacementScoresReady"
+	// SpotPlacementScoresUnavailableReason used when the Spot placement scores of the pool could not be retrieved.
+	SpotPlacementScoresUnavailableReason = "SpotPlacementScoresUnavailable"
+
+	// WorkloadClusterReachableCondition reports on whether the API server of the workload cluster could be reached
+	// to look up the nodes of the instances of an AWSMachinePool.
+	WorkloadClusterReachableCondition clusterv1.ConditionType = "WorkloadClusterReachable"
+	// WaitingForWorkloadClusterReason used to report that the API server of the workload cluster is temporarily
+	// unreachable, for instance while the control plane is upgraded, and that reconciliation is requeued.
+	WaitingForWorkloadClusterReason = "WaitingForWorkloadCluster"
 )
 
 const (
@@ -64,6 +82,20 @@ const (
 	// WaitingForEKSControlPlaneReason used when the machine pool is waiting for
 	// EKS control plane infrastructure to be ready before proceeding.
 	WaitingForEKSControlPlaneReason = "WaitingForEKSControlPlane"
+	// EKSNodegroupActiveCondition reports on the status of the EKS nodegroup, and on its health issues.
+	EKSNodegroupActiveCondition clusterv1.ConditionType = "EKSNodegroupActive"
+	// EKSNodegroupCreatingReason used when the EKS nodegroup is being created.
+	EKSNodegroupCreatingReason = "EKSNodegroupCreating"
+	// EKSNodegroupUpdatingReason used when the EKS nodegroup is being updated.
+	EKSNodegroupUpdatingReason = "EKSNodegroupUpdating"
+	// EKSNodegroupDeletingReason used when the EKS nodegroup is being deleted.
+	EKSNodegroupDeletingReason = "EKSNodegroupDeleting"
+	// EKSNodegroupDegradedReason used when the EKS nodegroup is active but has health issues.
+	EKSNodegroupDegradedReason = "EKSNodegroupDegraded"
+	// EKSNodegroupFailedReason used when the creation or the deletion of the EKS nodegroup failed.
+	EKSNodegroupFailedReason = "EKSNodegroupFailed"
+	// NodegroupCreationTimedOutReason used when the EKS nodegroup is still being created after its creation timeout.
+	NodegroupCreationTimedOutReason = "NodegroupCreationTimedOut"
 )
 
 const (
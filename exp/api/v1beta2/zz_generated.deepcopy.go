@@ -21,12 +21,29 @@ limitations under the License.
 package v1beta2
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	apiv1beta2 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/errors"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ASGTag) DeepCopyInto(out *ASGTag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ASGTag.
+func (in *ASGTag) DeepCopy() *ASGTag {
+	if in == nil {
+		return nil
+	}
+	out := new(ASGTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSFargateProfile) DeepCopyInto(out *AWSFargateProfile) {
 	*out = *in
@@ -262,6 +279,62 @@ func (in *AWSMachinePoolSpec) DeepCopyInto(out *AWSMachinePoolSpec) {
 		*out = new(SuspendProcessesTypes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KubeletExtraArgs != nil {
+		in, out := &in.KubeletExtraArgs, &out.KubeletExtraArgs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		*out = new(MaxPodsOptions)
+		**out = **in
+	}
+	if in.AutoscalerTags != nil {
+		in, out := &in.AutoscalerTags, &out.AutoscalerTags
+		*out = new(AutoscalerTags)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxLaunchTemplateVersions != nil {
+		in, out := &in.MaxLaunchTemplateVersions, &out.MaxLaunchTemplateVersions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ASGTags != nil {
+		in, out := &in.ASGTags, &out.ASGTags
+		*out = make([]ASGTag, len(*in))
+		copy(*out, *in)
+	}
+	if in.LaunchTemplateTags != nil {
+		in, out := &in.LaunchTemplateTags, &out.LaunchTemplateTags
+		*out = make(apiv1beta2.Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.InstanceTags != nil {
+		in, out := &in.InstanceTags, &out.InstanceTags
+		*out = make(apiv1beta2.Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SpotInterruptionHandling != nil {
+		in, out := &in.SpotInterruptionHandling, &out.SpotInterruptionHandling
+		*out = new(SpotInterruptionHandling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BaseLaunchTemplateID != nil {
+		in, out := &in.BaseLaunchTemplateID, &out.BaseLaunchTemplateID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SpotPlacementScores != nil {
+		in, out := &in.SpotPlacementScores, &out.SpotPlacementScores
+		*out = new(SpotPlacementScores)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachinePoolSpec.
@@ -296,6 +369,11 @@ func (in *AWSMachinePoolStatus) DeepCopyInto(out *AWSMachinePoolStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SpotPlacementScores != nil {
+		in, out := &in.SpotPlacementScores, &out.SpotPlacementScores
+		*out = new(SpotPlacementScoresStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FailureReason != nil {
 		in, out := &in.FailureReason, &out.FailureReason
 		*out = new(errors.MachineStatusError)
@@ -474,6 +552,11 @@ func (in *AWSManagedMachinePoolSpec) DeepCopyInto(out *AWSManagedMachinePoolSpec
 		*out = new(AWSLaunchTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CreationTimeout != nil {
+		in, out := &in.CreationTimeout, &out.CreationTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSManagedMachinePoolSpec.
@@ -578,6 +661,33 @@ func (in *AutoScalingGroup) DeepCopy() *AutoScalingGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerTags) DeepCopyInto(out *AutoscalerTags) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make(Taints, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerTags.
+func (in *AutoscalerTags) DeepCopy() *AutoscalerTags {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerTags)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockDeviceMapping) DeepCopyInto(out *BlockDeviceMapping) {
 	*out = *in
@@ -772,6 +882,21 @@ func (in *ManagedRemoteAccess) DeepCopy() *ManagedRemoteAccess {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaxPodsOptions) DeepCopyInto(out *MaxPodsOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaxPodsOptions.
+func (in *MaxPodsOptions) DeepCopy() *MaxPodsOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MaxPodsOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MixedInstancesPolicy) DeepCopyInto(out *MixedInstancesPolicy) {
 	*out = *in
@@ -1046,6 +1171,16 @@ func (in *RefreshPreferences) DeepCopyInto(out *RefreshPreferences) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RefreshPreferences.
@@ -1127,6 +1262,67 @@ func (in *RosaMachinePoolStatus) DeepCopy() *RosaMachinePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotInterruptionHandling) DeepCopyInto(out *SpotInterruptionHandling) {
+	*out = *in
+	if in.HeartbeatTimeoutSeconds != nil {
+		in, out := &in.HeartbeatTimeoutSeconds, &out.HeartbeatTimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotInterruptionHandling.
+func (in *SpotInterruptionHandling) DeepCopy() *SpotInterruptionHandling {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotInterruptionHandling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotPlacementScores) DeepCopyInto(out *SpotPlacementScores) {
+	*out = *in
+	if in.TargetCapacity != nil {
+		in, out := &in.TargetCapacity, &out.TargetCapacity
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotPlacementScores.
+func (in *SpotPlacementScores) DeepCopy() *SpotPlacementScores {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotPlacementScores)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotPlacementScoresStatus) DeepCopyInto(out *SpotPlacementScoresStatus) {
+	*out = *in
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotPlacementScoresStatus.
+func (in *SpotPlacementScoresStatus) DeepCopy() *SpotPlacementScoresStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotPlacementScoresStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuspendProcessesTypes) DeepCopyInto(out *SuspendProcessesTypes) {
 	*out = *in
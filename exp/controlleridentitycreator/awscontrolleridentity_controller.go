@@ -66,6 +66,11 @@ func (r *AWSControllerIdentityReconciler) Reconcile(ctx context.Context, req ctr
 	} else {
 		log.Trace("Found identityRef on AWSCluster")
 		identityRef = awsCluster.Spec.IdentityRef
+		// Identities selected by label are either AWSClusterRoleIdentities or AWSClusterStaticIdentities.
+		if identityRef == nil && awsCluster.Spec.IdentitySelector != nil {
+			log.Trace("AWSCluster selects its identity by label, skipping new instance creation")
+			return ctrl.Result{}, nil
+		}
 	}
 
 	// If AWSCluster is not found, check if AWSManagedControlPlane is used.
@@ -18,7 +18,11 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -27,6 +31,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -40,12 +45,16 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/controllers"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	capaannotations "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/pricing"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
 	asg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/workload"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
@@ -54,6 +63,24 @@ import (
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
 
+const (
+	// spotInterruptionLifecycleHookName is the name of the termination lifecycle hook of the ASGs of the
+	// AWSMachinePools with spot interruption handling.
+	spotInterruptionLifecycleHookName = "capa-spot-interruption-handling"
+
+	// defaultSpotInterruptionHeartbeatTimeout is the default heartbeat timeout of the termination lifecycle hook.
+	defaultSpotInterruptionHeartbeatTimeout = int64(300)
+)
+
+// workloadClusterBackoff is the backoff to retry the lookup of the nodes of the pool with while the API server of
+// the workload cluster is unreachable, before requeuing.
+var workloadClusterBackoff = wait.Backoff{
+	Duration: time.Second,
+	Factor:   2,
+	Steps:    3,
+	Jitter:   0.2,
+}
+
 // AWSMachinePoolReconciler reconciles a AWSMachinePool object.
 type AWSMachinePoolReconciler struct {
 	client.Client
@@ -62,6 +89,17 @@ type AWSMachinePoolReconciler struct {
 	asgServiceFactory            func(cloud.ClusterScoper) services.ASGInterface
 	ec2ServiceFactory            func(scope.EC2Scope) services.EC2Interface
 	TagUnmanagedNetworkResources bool
+
+	// RequeueInterval is the interval after which an AWSMachinePool waiting for the instances of its ASG is
+	// reconciled again. controllers.DefaultReconcilerRequeue is used when zero.
+	RequeueInterval time.Duration
+}
+
+func (r *AWSMachinePoolReconciler) requeueInterval() time.Duration {
+	if r.RequeueInterval > 0 {
+		return r.RequeueInterval
+	}
+	return controllers.DefaultReconcilerRequeue
 }
 
 func (r *AWSMachinePoolReconciler) getASGService(scope cloud.ClusterScoper) services.ASGInterface {
@@ -168,13 +206,13 @@ func (r *AWSMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			return ctrl.Result{}, r.reconcileDelete(machinePoolScope, infraScope, infraScope)
 		}
 
-		return ctrl.Result{}, r.reconcileNormal(ctx, machinePoolScope, infraScope, infraScope)
+		return r.reconcileNormal(ctx, machinePoolScope, infraScope, infraScope)
 	case *scope.ClusterScope:
 		if !awsMachinePool.ObjectMeta.DeletionTimestamp.IsZero() {
 			return ctrl.Result{}, r.reconcileDelete(machinePoolScope, infraScope, infraScope)
 		}
 
-		return ctrl.Result{}, r.reconcileNormal(ctx, machinePoolScope, infraScope, infraScope)
+		return r.reconcileNormal(ctx, machinePoolScope, infraScope, infraScope)
 	default:
 		return ctrl.Result{}, errors.New("infraCluster has unknown type")
 	}
@@ -192,7 +230,7 @@ func (r *AWSMachinePoolReconciler) SetupWithManager(ctx context.Context, mgr ctr
 		Complete(r)
 }
 
-func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope) error {
+func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope) (ctrl.Result, error) {
 	clusterScope.Info("Reconciling AWSMachinePool")
 
 	// If the AWSMachine is in an error state, return early.
@@ -201,38 +239,47 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 
 		// TODO: If we are in a failed state, delete the secret regardless of instance state
 
-		return nil
+		return ctrl.Result{}, nil
+	}
+
+	if capaannotations.Has(clusterScope.InfraCluster(), infrav1.PauseMachinesAnnotation) {
+		machinePoolScope.Info("Reconciliation of the machines is paused", "annotation", infrav1.PauseMachinesAnnotation)
+		conditions.MarkTrue(machinePoolScope.AWSMachinePool, infrav1.MachinePausedCondition)
+		return ctrl.Result{}, nil
 	}
+	conditions.Delete(machinePoolScope.AWSMachinePool, infrav1.MachinePausedCondition)
 
 	// If the AWSMachinepool doesn't have our finalizer, add it
 	if controllerutil.AddFinalizer(machinePoolScope.AWSMachinePool, expinfrav1.MachinePoolFinalizer) {
 		// Register finalizer immediately to avoid orphaning AWS resources
 		if err := machinePoolScope.PatchObject(); err != nil {
-			return err
+			return ctrl.Result{}, err
 		}
 	}
 
 	if !machinePoolScope.Cluster.Status.InfrastructureReady {
 		machinePoolScope.Info("Cluster infrastructure is not ready yet")
 		conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.ASGReadyCondition, infrav1.WaitingForClusterInfrastructureReason, clusterv1.ConditionSeverityInfo, "")
-		return nil
+		return ctrl.Result{}, nil
 	}
 
 	// Make sure bootstrap data is available and populated
 	if machinePoolScope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
 		machinePoolScope.Info("Bootstrap data secret reference is not yet available")
 		conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.ASGReadyCondition, infrav1.WaitingForBootstrapDataReason, clusterv1.ConditionSeverityInfo, "")
-		return nil
+		return ctrl.Result{}, nil
 	}
 
 	ec2Svc := r.getEC2Service(ec2Scope)
 	asgsvc := r.getASGService(clusterScope)
 
+	r.reconcileSpotPlacementScores(machinePoolScope, ec2Svc)
+
 	// Find existing ASG
 	asg, err := r.findASG(machinePoolScope, asgsvc)
 	if err != nil {
 		conditions.MarkUnknown(machinePoolScope.AWSMachinePool, expinfrav1.ASGReadyCondition, expinfrav1.ASGNotFoundReason, err.Error())
-		return err
+		return ctrl.Result{}, err
 	}
 
 	canUpdateLaunchTemplate := func() (bool, error) {
@@ -272,7 +319,7 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 	if err := ec2Svc.ReconcileLaunchTemplate(machinePoolScope, canUpdateLaunchTemplate, runPostLaunchTemplateUpdateOperation); err != nil {
 		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedLaunchTemplateReconcile", "Failed to reconcile launch template: %v", err)
 		machinePoolScope.Error(err, "failed to reconcile launch template")
-		return err
+		return ctrl.Result{}, err
 	}
 
 	// set the LaunchTemplateReady condition
@@ -282,9 +329,10 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		// Create new ASG
 		if err := r.createPool(machinePoolScope, clusterScope); err != nil {
 			conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.ASGReadyCondition, expinfrav1.ASGProvisionFailedReason, clusterv1.ConditionSeverityError, err.Error())
-			return err
+			return ctrl.Result{}, err
 		}
-		return nil
+		// The instances of the new ASG are reflected in the AWSMachinePool once they are launched.
+		return ctrl.Result{RequeueAfter: r.requeueInterval()}, nil
 	}
 
 	if annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
@@ -295,31 +343,47 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 				"external", asg.DesiredCapacity)
 			machinePoolScope.MachinePool.Spec.Replicas = asg.DesiredCapacity
 			if err := machinePoolScope.PatchCAPIMachinePoolObject(ctx); err != nil {
-				return err
+				return ctrl.Result{}, err
 			}
 		}
 	}
 
 	if err := r.updatePool(machinePoolScope, clusterScope, asg); err != nil {
 		machinePoolScope.Error(err, "error updating AWSMachinePool")
-		return err
+		return ctrl.Result{}, err
 	}
 
 	launchTemplateID := machinePoolScope.GetLaunchTemplateIDStatus()
 	asgName := machinePoolScope.Name()
 	resourceServiceToUpdate := []scope.ResourceServiceToUpdate{
 		{
-			ResourceID:      &launchTemplateID,
-			ResourceService: ec2Svc,
+			ResourceID:             &launchTemplateID,
+			ResourceService:        ec2Svc,
+			ResourceTags:           machinePoolScope.LaunchTemplateTags(),
+			ResourceTagsAnnotation: ec2.LaunchTemplateTagsLastAppliedAnnotation,
 		},
 		{
+			// The ASG specific tags are reconciled separately, as they can be propagated at launch.
 			ResourceID:      &asgName,
 			ResourceService: asgsvc,
+			ResourceTags:    asgResourceTags(machinePoolScope),
 		},
 	}
 	err = ec2Svc.ReconcileTags(machinePoolScope, resourceServiceToUpdate)
 	if err != nil {
-		return errors.Wrap(err, "error updating tags")
+		return ctrl.Result{}, errors.Wrap(err, "error updating tags")
+	}
+
+	if err := r.reconcileASGTags(machinePoolScope, asgsvc, asgName); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error updating ASG tags")
+	}
+
+	if err := r.reconcileAutoscalerTags(machinePoolScope, clusterScope, asgsvc, asg); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error updating cluster autoscaler tags")
+	}
+
+	if err := r.reconcileSpotInterruptionHandling(machinePoolScope, ec2Scope, asgsvc); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error reconciling spot interruption handling")
 	}
 
 	// Make sure Spec.ProviderID is always set.
@@ -335,16 +399,250 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 	machinePoolScope.AWSMachinePool.Spec.ProviderIDList = providerIDList
 	machinePoolScope.AWSMachinePool.Status.Replicas = int32(len(providerIDList))
 	machinePoolScope.AWSMachinePool.Status.Ready = true
+	r.reconcileEstimatedHourlyCost(machinePoolScope, clusterScope.Region())
 	conditions.MarkTrue(machinePoolScope.AWSMachinePool, expinfrav1.ASGReadyCondition)
 
-	err = machinePoolScope.UpdateInstanceStatuses(ctx, asg.Instances)
+	unreachable, err := r.retryWorkloadCluster(machinePoolScope, func() error {
+		return machinePoolScope.UpdateInstanceStatuses(ctx, asg.Instances)
+	})
 	if err != nil {
 		machinePoolScope.Error(err, "failed updating instances", "instances", asg.Instances)
 	}
+	if unreachable {
+		return ctrl.Result{RequeueAfter: r.requeueInterval()}, nil
+	}
 
+	if asg.DesiredCapacity != nil && len(asg.Instances) != int(*asg.DesiredCapacity) {
+		machinePoolScope.Info("Waiting for the ASG to reach its desired capacity", "desired", *asg.DesiredCapacity, "current", len(asg.Instances))
+		return ctrl.Result{RequeueAfter: r.requeueInterval()}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileASGTags keeps the ASG specific tags of the ASG, and their propagate at launch flag, in sync with the
+// AWSMachinePool. The tags last applied are tracked in an annotation.
+// retryWorkloadCluster runs fn, which reads from the workload cluster, like the lookup of the nodes of the instances.
+// The workload cluster API is briefly unreachable during control plane upgrades: fn is retried for a while, and if
+// the workload cluster is still unreachable, the WorkloadClusterReachable condition is set to false and true is
+// returned so that reconciliation is requeued quietly rather than failed. Other errors of fn are returned.
+func (r *AWSMachinePoolReconciler) retryWorkloadCluster(machinePoolScope *scope.MachinePoolScope, fn func() error) (bool, error) {
+	err := workload.RetryWhileUnreachable(workloadClusterBackoff, fn)
+	if workload.IsUnreachable(err) {
+		machinePoolScope.Info("Workload cluster API is unreachable, requeuing", "reason", err.Error())
+		conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.WorkloadClusterReachableCondition, expinfrav1.WaitingForWorkloadClusterReason, clusterv1.ConditionSeverityInfo, err.Error())
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	conditions.MarkTrue(machinePoolScope.AWSMachinePool, expinfrav1.WorkloadClusterReachableCondition)
+
+	return false, nil
+}
+
+func (r *AWSMachinePoolReconciler) reconcileASGTags(machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface, asgName string) error {
+	previous, err := asg.ParseASGTagsAnnotation(machinePoolScope.AWSMachinePool.GetAnnotations()[asg.ASGTagsLastAppliedAnnotation])
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse annotation %q", asg.ASGTagsLastAppliedAnnotation)
+	}
+
+	desired := machinePoolScope.AWSMachinePool.Spec.ASGTags
+	create, remove := asg.ASGTagsDiff(previous, desired, machinePoolScope.AdditionalTags())
+	if len(create) == 0 && len(remove) == 0 {
+		return nil
+	}
+	if err := asgsvc.UpdateASGTags(asgName, create, remove); err != nil {
+		return err
+	}
+
+	annotation, err := json.Marshal(desired)
+	if err != nil {
+		return err
+	}
+	machinePoolScope.SetAnnotation(asg.ASGTagsLastAppliedAnnotation, string(annotation))
 	return nil
 }
 
+// asgResourceTags returns the keys and values of the ASG specific tags of the AWSMachinePool.
+func asgResourceTags(machinePoolScope *scope.MachinePoolScope) infrav1.Tags {
+	return asg.ASGTagsToMap(machinePoolScope.AWSMachinePool.Spec.ASGTags)
+}
+
+// reconcileAutoscalerTags keeps the cluster autoscaler tags of the ASG in sync with the labels and taints of the
+// AWSMachinePool. The tags last applied are tracked in an annotation, so that only those are removed when they change
+// or are no longer enabled.
+func (r *AWSMachinePoolReconciler) reconcileAutoscalerTags(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, asgsvc services.ASGInterface, existingASG *expinfrav1.AutoScalingGroup) error {
+	previous, err := asg.ParseAutoscalerTagsAnnotation(machinePoolScope.AWSMachinePool.GetAnnotations()[asg.AutoscalerTagsLastAppliedAnnotation])
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse annotation %q", asg.AutoscalerTagsLastAppliedAnnotation)
+	}
+	desired, err := asg.AutoscalerTags(clusterScope.KubernetesClusterName(), machinePoolScope.AWSMachinePool.Spec.AutoscalerTags)
+	if err != nil {
+		return err
+	}
+	if previous == nil && desired == nil {
+		return nil
+	}
+
+	explicit := machinePoolScope.AdditionalTags()
+	explicit.Merge(asg.ASGTagsToMap(machinePoolScope.AWSMachinePool.Spec.ASGTags))
+	create, remove := asg.AutoscalerTagsDiff(previous, existingASG.Tags, desired, explicit)
+	if len(create) > 0 || len(remove) > 0 {
+		if err := asgsvc.UpdateResourceTags(&existingASG.Name, create, remove); err != nil {
+			return err
+		}
+	}
+
+	if desired == nil {
+		annotations := machinePoolScope.AWSMachinePool.GetAnnotations()
+		delete(annotations, asg.AutoscalerTagsLastAppliedAnnotation)
+		machinePoolScope.AWSMachinePool.SetAnnotations(annotations)
+		return nil
+	}
+	annotation, err := json.Marshal(desired)
+	if err != nil {
+		return err
+	}
+	machinePoolScope.SetAnnotation(asg.AutoscalerTagsLastAppliedAnnotation, string(annotation))
+	return nil
+}
+
+// reconcileSpotInterruptionHandling reconciles the SQS queue, the EventBridge rules and the termination lifecycle hook
+// routing the interruption notices of the instances of the pool to a node termination handler. They are torn down
+// when the spot interruption handling is disabled.
+func (r *AWSMachinePoolReconciler) reconcileSpotInterruptionHandling(machinePoolScope *scope.MachinePoolScope, ec2Scope scope.EC2Scope, asgsvc services.ASGInterface) error {
+	awsMachinePool := machinePoolScope.AWSMachinePool
+	asgName := machinePoolScope.Name()
+
+	handling := awsMachinePool.Spec.SpotInterruptionHandling
+	if handling == nil {
+		if awsMachinePool.Status.SpotInterruptionQueueURL == "" {
+			return nil
+		}
+		if err := asgsvc.DeleteLifecycleHook(asgName, spotInterruptionLifecycleHookName); err != nil {
+			return err
+		}
+		if err := instancestate.NewService(ec2Scope).DeleteSpotInterruptionHandling(asgName); err != nil {
+			return err
+		}
+		awsMachinePool.Status.SpotInterruptionQueueURL = ""
+		conditions.Delete(awsMachinePool, expinfrav1.SpotInterruptionHandlingReadyCondition)
+		return nil
+	}
+
+	heartbeatTimeout := defaultSpotInterruptionHeartbeatTimeout
+	if handling.HeartbeatTimeoutSeconds != nil {
+		heartbeatTimeout = *handling.HeartbeatTimeoutSeconds
+	}
+
+	// The queue and the rules are reconciled first, so the lifecycle actions of the hook are never lost.
+	queueURL, err := instancestate.NewService(ec2Scope).ReconcileSpotInterruptionHandling(asgName, asgName)
+	if err == nil {
+		err = asgsvc.ReconcileTerminationLifecycleHook(asgName, spotInterruptionLifecycleHookName, heartbeatTimeout)
+	}
+	if err != nil {
+		r.Recorder.Eventf(awsMachinePool, corev1.EventTypeWarning, "FailedSpotInterruptionHandlingReconcile", "Failed to reconcile spot interruption handling: %v", err)
+		conditions.MarkFalse(awsMachinePool, expinfrav1.SpotInterruptionHandlingReadyCondition, expinfrav1.SpotInterruptionHandlingFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return err
+	}
+
+	awsMachinePool.Status.SpotInterruptionQueueURL = queueURL
+	conditions.MarkTrue(awsMachinePool, expinfrav1.SpotInterruptionHandlingReadyCondition)
+	return nil
+}
+
+// reconcileEstimatedHourlyCost annotates the AWSMachinePool with the estimated hourly cost of its instances.
+// This is best effort: the annotation is set to "unknown" if the price of the instance types isn't known.
+func (r *AWSMachinePoolReconciler) reconcileEstimatedHourlyCost(machinePoolScope *scope.MachinePoolScope, region string) {
+	awsMachinePool := machinePoolScope.AWSMachinePool
+
+	var spotMaxPrice *string
+	if spot := awsMachinePool.Spec.AWSLaunchTemplate.SpotMarketOptions; spot != nil {
+		spotMaxPrice = spot.MaxPrice
+	}
+
+	cost := pricing.EstimateHourlyCost(region, poolInstanceTypes(awsMachinePool), spotMaxPrice, awsMachinePool.Status.Replicas)
+	machinePoolScope.SetAnnotation(expinfrav1.EstimatedHourlyCostAnnotation, cost)
+}
+
+// spotPlacementScoresRefreshInterval is the interval after which the Spot placement scores of a pool are fetched again.
+const spotPlacementScoresRefreshInterval = time.Hour
+
+// reconcileSpotPlacementScores annotates the AWSMachinePool with the Spot placement scores of its instance types,
+// when they are requested. The scores are only fetched again after the spotPlacementScoresRefreshInterval, or when
+// the instance types or the target capacity change, whether the last attempt succeeded or not. This is best effort: the scores are only advisory, so failing
+// to get them is reported on the SpotPlacementScoresReady condition without failing the reconciliation.
+func (r *AWSMachinePoolReconciler) reconcileSpotPlacementScores(machinePoolScope *scope.MachinePoolScope, ec2Svc services.EC2Interface) {
+	awsMachinePool := machinePoolScope.AWSMachinePool
+	if awsMachinePool.Spec.SpotPlacementScores == nil {
+		awsMachinePool.Status.SpotPlacementScores = nil
+		return
+	}
+
+	instanceTypes := poolInstanceTypes(awsMachinePool)
+	if len(instanceTypes) == 0 {
+		conditions.MarkFalse(awsMachinePool, expinfrav1.SpotPlacementScoresReadyCondition, expinfrav1.SpotPlacementScoresUnavailableReason, clusterv1.ConditionSeverityWarning, "the instance types of the pool are not set")
+		return
+	}
+
+	targetCapacity := awsMachinePool.Spec.MaxSize
+	if awsMachinePool.Spec.SpotPlacementScores.TargetCapacity != nil {
+		targetCapacity = *awsMachinePool.Spec.SpotPlacementScores.TargetCapacity
+	}
+	if targetCapacity < 1 {
+		targetCapacity = 1
+	}
+
+	if last := awsMachinePool.Status.SpotPlacementScores; last != nil &&
+		time.Since(last.LastUpdated.Time) < spotPlacementScoresRefreshInterval &&
+		last.TargetCapacity == targetCapacity && cmp.Equal(last.InstanceTypes, instanceTypes) {
+		return
+	}
+
+	// The attempt is recorded even when it fails, so a missing permission doesn't make every reconcile call the
+	// heavily rate-limited API again.
+	awsMachinePool.Status.SpotPlacementScores = &expinfrav1.SpotPlacementScoresStatus{
+		LastUpdated:    metav1.Now(),
+		InstanceTypes:  instanceTypes,
+		TargetCapacity: targetCapacity,
+	}
+	scores, err := ec2Svc.GetSpotPlacementScores(instanceTypes, targetCapacity)
+	if err != nil {
+		machinePoolScope.Info("Failed to get Spot placement scores", "error", err.Error())
+		conditions.MarkFalse(awsMachinePool, expinfrav1.SpotPlacementScoresReadyCondition, expinfrav1.SpotPlacementScoresUnavailableReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return
+	}
+
+	zones := make([]string, 0, len(scores))
+	for zone := range scores {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+	pairs := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", zone, scores[zone]))
+	}
+
+	machinePoolScope.SetAnnotation(expinfrav1.SpotPlacementScoresAnnotation, strings.Join(pairs, ","))
+	conditions.MarkTrue(awsMachinePool, expinfrav1.SpotPlacementScoresReadyCondition)
+}
+
+// poolInstanceTypes returns the instance types of the pool, which are overridden by the mixed instances policy if any.
+func poolInstanceTypes(awsMachinePool *expinfrav1.AWSMachinePool) []string {
+	if policy := awsMachinePool.Spec.MixedInstancesPolicy; policy != nil && len(policy.Overrides) > 0 {
+		instanceTypes := make([]string, 0, len(policy.Overrides))
+		for _, override := range policy.Overrides {
+			instanceTypes = append(instanceTypes, override.InstanceType)
+		}
+		return instanceTypes
+	}
+	if awsMachinePool.Spec.AWSLaunchTemplate.InstanceType == "" {
+		return nil
+	}
+	return []string{awsMachinePool.Spec.AWSLaunchTemplate.InstanceType}
+}
+
 func (r *AWSMachinePoolReconciler) reconcileDelete(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope) error {
 	clusterScope.Info("Handling deleted AWSMachinePool")
 
@@ -377,6 +675,14 @@ func (r *AWSMachinePoolReconciler) reconcileDelete(machinePoolScope *scope.Machi
 		}
 	}
 
+	// The termination lifecycle hook is deleted with the ASG.
+	if machinePoolScope.AWSMachinePool.Spec.SpotInterruptionHandling != nil || machinePoolScope.AWSMachinePool.Status.SpotInterruptionQueueURL != "" {
+		if err := instancestate.NewService(ec2Scope).DeleteSpotInterruptionHandling(machinePoolScope.Name()); err != nil {
+			r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedDelete", "Failed to delete spot interruption handling: %v", err)
+			return errors.Wrap(err, "failed to delete spot interruption handling")
+		}
+	}
+
 	launchTemplateID := machinePoolScope.AWSMachinePool.Status.LaunchTemplateID
 	launchTemplate, _, err := ec2Svc.GetLaunchTemplate(machinePoolScope.LaunchTemplateName())
 	if err != nil {
@@ -21,7 +21,9 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
@@ -31,12 +33,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/controllers"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
@@ -199,7 +203,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				buf := new(bytes.Buffer)
 				klog.SetOutput(buf)
 
-				_ = reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs)
 				g.Expect(buf).To(ContainSubstring("Error state detected, skipping reconciliation"))
 			})
 			t.Run("should add our finalizer to the machinepool", func(t *testing.T) {
@@ -208,7 +212,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				defer teardown(t, g)
 				getASG(t, g)
 
-				_ = reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs)
 
 				g.Expect(ms.AWSMachinePool.Finalizers).To(ContainElement(expinfrav1.MachinePoolFinalizer))
 			})
@@ -223,7 +227,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				buf := new(bytes.Buffer)
 				klog.SetOutput(buf)
 
-				err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
 				g.Expect(err).To(BeNil())
 				g.Expect(buf.String()).To(ContainSubstring("Cluster infrastructure is not ready yet"))
 				expectConditions(g, ms.AWSMachinePool, []conditionAssertion{{expinfrav1.ASGReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrav1.WaitingForClusterInfrastructureReason}})
@@ -238,12 +242,107 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				buf := new(bytes.Buffer)
 				klog.SetOutput(buf)
 
-				err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
 
 				g.Expect(err).To(BeNil())
 				g.Expect(buf.String()).To(ContainSubstring("Bootstrap data secret reference is not yet available"))
 				expectConditions(g, ms.AWSMachinePool, []conditionAssertion{{expinfrav1.ASGReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrav1.WaitingForBootstrapDataReason}})
 			})
+			t.Run("should exit immediately while the machines of the cluster are paused", func(t *testing.T) {
+				g := NewWithT(t)
+				setup(t, g)
+				defer teardown(t, g)
+
+				cs.AWSCluster.Annotations = map[string]string{infrav1.PauseMachinesAnnotation: ""}
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				g.Expect(err).To(BeNil())
+				g.Expect(conditions.IsTrue(ms.AWSMachinePool, infrav1.MachinePausedCondition)).To(BeTrue())
+
+				// The Auto Scaling group is reconciled again once the annotation is removed.
+				delete(cs.AWSCluster.Annotations, infrav1.PauseMachinesAnnotation)
+				getASG(t, g)
+
+				_, err = reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(conditions.Has(ms.AWSMachinePool, infrav1.MachinePausedCondition)).To(BeFalse())
+			})
+			t.Run("should not requeue after the requeue interval on error", func(t *testing.T) {
+				g := NewWithT(t)
+				setup(t, g)
+				defer teardown(t, g)
+				getASG(t, g)
+
+				reconciler.RequeueInterval = 10 * time.Minute
+				res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+				g.Expect(res.RequeueAfter).To(BeZero())
+			})
+		})
+		t.Run("requeue interval", func(t *testing.T) {
+			getASG := func(t *testing.T, g *WithT, instances int) {
+				t.Helper()
+
+				asg := &expinfrav1.AutoScalingGroup{
+					Name:            "name",
+					DesiredCapacity: ptr.To[int32](2),
+				}
+				for i := 0; i < instances; i++ {
+					asg.Instances = append(asg.Instances, infrav1.Instance{ID: fmt.Sprintf("i-%d", i)})
+				}
+				ec2Svc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				ec2Svc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
+				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(asg, nil)
+				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{}, nil)
+				asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil).AnyTimes()
+			}
+			t.Run("should not requeue when the ASG has reached its desired capacity", func(t *testing.T) {
+				g := NewWithT(t)
+				setup(t, g)
+				defer teardown(t, g)
+				getASG(t, g, 2)
+
+				res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				g.Expect(err).To(BeNil())
+				g.Expect(res.RequeueAfter).To(BeZero())
+				g.Expect(ms.AWSMachinePool.Status.Ready).To(BeTrue())
+				g.Expect(conditions.IsTrue(ms.AWSMachinePool, expinfrav1.ASGReadyCondition)).To(BeTrue())
+			})
+			t.Run("should requeue after the default interval while waiting for the instances of the ASG", func(t *testing.T) {
+				g := NewWithT(t)
+				setup(t, g)
+				defer teardown(t, g)
+				getASG(t, g, 1)
+
+				res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				g.Expect(err).To(BeNil())
+				g.Expect(res.RequeueAfter).To(Equal(controllers.DefaultReconcilerRequeue))
+			})
+			t.Run("should requeue after the requeue interval while waiting for the instances of the ASG", func(t *testing.T) {
+				g := NewWithT(t)
+				setup(t, g)
+				defer teardown(t, g)
+				getASG(t, g, 1)
+
+				reconciler.RequeueInterval = 10 * time.Minute
+				res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				g.Expect(err).To(BeNil())
+				g.Expect(res.RequeueAfter).To(Equal(10 * time.Minute))
+			})
+			t.Run("should requeue after the requeue interval once the ASG is created", func(t *testing.T) {
+				g := NewWithT(t)
+				setup(t, g)
+				defer teardown(t, g)
+
+				ec2Svc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().CreateASG(gomock.Any()).Return(&expinfrav1.AutoScalingGroup{Name: "name"}, nil)
+
+				reconciler.RequeueInterval = 10 * time.Minute
+				res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				g.Expect(err).To(BeNil())
+				g.Expect(res.RequeueAfter).To(Equal(10 * time.Minute))
+			})
 		})
 		t.Run("there's a provider ID", func(t *testing.T) {
 			id := "<cloudProvider>://<optional>/<segments>/<providerid>"
@@ -266,7 +365,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 
 				expectedErr := errors.New("no connection available ")
 				ec2Svc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
-				err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
 				g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
 			})
 		})
@@ -293,7 +392,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				}, nil)
 				asgSvc.EXPECT().SuspendProcesses("name", []string{"Launch", "Terminate"}).Return(nil).AnyTimes().Times(0)
 
-				err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
 				g.Expect(err).To(Succeed())
 			})
 		})
@@ -329,7 +428,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 					"ReplaceUnhealthy",
 				})).Return(nil).AnyTimes().Times(1)
 
-				err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
 				g.Expect(err).To(Succeed())
 			})
 		})
@@ -361,7 +460,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				asgSvc.EXPECT().SuspendProcesses("name", []string{"Terminate"}).Return(nil).AnyTimes().Times(1)
 				asgSvc.EXPECT().ResumeProcesses("name", []string{"process3"}).Return(nil).AnyTimes().Times(1)
 
-				err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
 				g.Expect(err).To(Succeed())
 			})
 		})
@@ -391,7 +490,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 
 			g.Expect(testEnv.Create(ctx, ms.MachinePool)).To(Succeed())
 
-			_ = reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			_, _ = reconciler.reconcileNormal(context.Background(), ms, cs, cs)
 			g.Expect(*ms.MachinePool.Spec.Replicas).To(Equal(int32(1)))
 		})
 		t.Run("No need to update Asg because asgNeedsUpdates is false and no subnets change", func(t *testing.T) {
@@ -422,7 +521,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet2", "subnet1"}, nil).Times(1)
 			asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil).Times(0)
 
-			err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
 			g.Expect(err).To(Succeed())
 		})
 		t.Run("update Asg due to subnet changes", func(t *testing.T) {
@@ -440,7 +539,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet1"}, nil).Times(1)
 			asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil).Times(1)
 
-			err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
 			g.Expect(err).To(Succeed())
 		})
 		t.Run("update Asg due to asgNeedsUpdates returns true", func(t *testing.T) {
@@ -458,7 +557,176 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{}, nil).Times(1)
 			asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil).Times(1)
 
-			err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(Succeed())
+		})
+		t.Run("annotates the estimated hourly cost of the instances", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			cs.AWSCluster.Spec.Region = "us-east-1"
+			ms.AWSMachinePool.Spec.MixedInstancesPolicy = nil
+			ms.AWSMachinePool.Spec.AWSLaunchTemplate.InstanceType = "m5.large"
+
+			asg := expinfrav1.AutoScalingGroup{
+				MinSize: int32(0),
+				MaxSize: int32(100),
+				Subnets: []string{"subnet1"},
+				Instances: []infrav1.Instance{
+					{ID: "i-1", AvailabilityZone: "us-east-1a"},
+					{ID: "i-2", AvailabilityZone: "us-east-1b"},
+				},
+			}
+			ec2Svc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			ec2Svc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil).AnyTimes()
+			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet1"}, nil).Times(1)
+			asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil).AnyTimes()
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(Succeed())
+			g.Expect(ms.AWSMachinePool.Annotations).To(HaveKeyWithValue(expinfrav1.EstimatedHourlyCostAnnotation, "0.1920"))
+		})
+		t.Run("annotates an unknown estimated hourly cost for instance types without price data", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			cs.AWSCluster.Spec.Region = "us-east-1"
+
+			asg := expinfrav1.AutoScalingGroup{
+				MinSize: int32(0),
+				MaxSize: int32(100),
+				Subnets: []string{"subnet1"},
+			}
+			ec2Svc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			ec2Svc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil).AnyTimes()
+			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet1"}, nil).Times(1)
+			asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil).AnyTimes()
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(Succeed())
+			g.Expect(ms.AWSMachinePool.Annotations).To(HaveKeyWithValue(expinfrav1.EstimatedHourlyCostAnnotation, "unknown"))
+		})
+		t.Run("annotates the Spot placement scores of the instance types when requested", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			ms.AWSMachinePool.Spec.SpotPlacementScores = &expinfrav1.SpotPlacementScores{}
+
+			asg := expinfrav1.AutoScalingGroup{
+				MinSize: int32(0),
+				MaxSize: int32(100),
+				Subnets: []string{"subnet1"},
+			}
+			ec2Svc.EXPECT().GetSpotPlacementScores([]string{"m6a.32xlarge"}, int32(100)).Return(map[string]int64{
+				"use1-az4": 3,
+				"use1-az1": 9,
+			}, nil)
+			ec2Svc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			ec2Svc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil).AnyTimes()
+			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet1"}, nil).Times(1)
+			asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil).AnyTimes()
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(Succeed())
+			g.Expect(ms.AWSMachinePool.Annotations).To(HaveKeyWithValue(expinfrav1.SpotPlacementScoresAnnotation, "use1-az1=9,use1-az4=3"))
+			g.Expect(conditions.IsTrue(ms.AWSMachinePool, expinfrav1.SpotPlacementScoresReadyCondition)).To(BeTrue())
+			g.Expect(ms.AWSMachinePool.Status.SpotPlacementScores).ToNot(BeNil())
+			g.Expect(ms.AWSMachinePool.Status.SpotPlacementScores.InstanceTypes).To(Equal([]string{"m6a.32xlarge"}))
+			g.Expect(ms.AWSMachinePool.Status.SpotPlacementScores.TargetCapacity).To(Equal(int32(100)))
+		})
+		t.Run("does not fetch the Spot placement scores again before the refresh interval", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			ms.AWSMachinePool.Spec.SpotPlacementScores = &expinfrav1.SpotPlacementScores{}
+			ms.AWSMachinePool.Status.SpotPlacementScores = &expinfrav1.SpotPlacementScoresStatus{
+				LastUpdated:    metav1.NewTime(time.Now().Add(-time.Minute)),
+				InstanceTypes:  []string{"m6a.32xlarge"},
+				TargetCapacity: 100,
+			}
+
+			asg := expinfrav1.AutoScalingGroup{
+				MinSize: int32(0),
+				MaxSize: int32(100),
+				Subnets: []string{"subnet1"},
+			}
+			ec2Svc.EXPECT().GetSpotPlacementScores(gomock.Any(), gomock.Any()).Times(0)
+			ec2Svc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			ec2Svc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil).AnyTimes()
+			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet1"}, nil).Times(1)
+			asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil).AnyTimes()
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(Succeed())
+		})
+		t.Run("fetches the Spot placement scores again when the target capacity changes", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			ms.AWSMachinePool.Spec.SpotPlacementScores = &expinfrav1.SpotPlacementScores{TargetCapacity: ptr.To[int32](20)}
+			ms.AWSMachinePool.Status.SpotPlacementScores = &expinfrav1.SpotPlacementScoresStatus{
+				LastUpdated:    metav1.NewTime(time.Now().Add(-time.Minute)),
+				InstanceTypes:  []string{"m6a.32xlarge"},
+				TargetCapacity: 100,
+			}
+
+			asg := expinfrav1.AutoScalingGroup{
+				MinSize: int32(0),
+				MaxSize: int32(100),
+				Subnets: []string{"subnet1"},
+			}
+			ec2Svc.EXPECT().GetSpotPlacementScores([]string{"m6a.32xlarge"}, int32(20)).Return(map[string]int64{
+				"use1-az1": 7,
+			}, nil)
+			ec2Svc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			ec2Svc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil).AnyTimes()
+			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet1"}, nil).Times(1)
+			asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil).AnyTimes()
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(Succeed())
+			g.Expect(ms.AWSMachinePool.Annotations).To(HaveKeyWithValue(expinfrav1.SpotPlacementScoresAnnotation, "use1-az1=7"))
+			g.Expect(ms.AWSMachinePool.Status.SpotPlacementScores.TargetCapacity).To(Equal(int32(20)))
+		})
+		t.Run("does not fail when the Spot placement scores are unavailable", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			ms.AWSMachinePool.Spec.SpotPlacementScores = &expinfrav1.SpotPlacementScores{TargetCapacity: ptr.To[int32](20)}
+
+			asg := expinfrav1.AutoScalingGroup{
+				MinSize: int32(0),
+				MaxSize: int32(100),
+				Subnets: []string{"subnet1"},
+			}
+			ec2Svc.EXPECT().GetSpotPlacementScores([]string{"m6a.32xlarge"}, int32(20)).Return(nil, errors.New("UnauthorizedOperation"))
+			ec2Svc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			ec2Svc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil).AnyTimes()
+			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet1"}, nil).Times(2)
+			asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil).AnyTimes()
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs)
+			g.Expect(err).To(Succeed())
+			g.Expect(ms.AWSMachinePool.Annotations).ToNot(HaveKey(expinfrav1.SpotPlacementScoresAnnotation))
+			g.Expect(conditions.IsFalse(ms.AWSMachinePool, expinfrav1.SpotPlacementScoresReadyCondition)).To(BeTrue())
+			g.Expect(conditions.GetReason(ms.AWSMachinePool, expinfrav1.SpotPlacementScoresReadyCondition)).To(Equal(expinfrav1.SpotPlacementScoresUnavailableReason))
+			g.Expect(ms.AWSMachinePool.Status.SpotPlacementScores).ToNot(BeNil())
+			g.Expect(ms.AWSMachinePool.Status.SpotPlacementScores.TargetCapacity).To(Equal(int32(20)))
+
+			// The failed attempt is not retried before the refresh interval.
+			_, err = reconciler.reconcileNormal(context.Background(), ms, cs, cs)
 			g.Expect(err).To(Succeed())
 		})
 	})
@@ -957,3 +1225,92 @@ func TestDiffASG(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryWorkloadCluster(t *testing.T) {
+	defer func(backoff wait.Backoff) { workloadClusterBackoff = backoff }(workloadClusterBackoff)
+	workloadClusterBackoff = wait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 3}
+
+	unreachable := syscall.ECONNREFUSED
+
+	tests := []struct {
+		name              string
+		errs              []error
+		expectCalls       int
+		expectErr         bool
+		expectUnreachable bool
+		expectCondition   corev1.ConditionStatus
+	}{
+		{
+			name:            "workload cluster reachable",
+			errs:            []error{nil},
+			expectCalls:     1,
+			expectCondition: corev1.ConditionTrue,
+		},
+		{
+			name:            "workload cluster temporarily unreachable",
+			errs:            []error{errors.Wrap(unreachable, "failed to List nodes"), nil},
+			expectCalls:     2,
+			expectCondition: corev1.ConditionTrue,
+		},
+		{
+			name:              "workload cluster unreachable",
+			errs:              []error{unreachable, unreachable, unreachable},
+			expectCalls:       3,
+			expectUnreachable: true,
+			expectCondition:   corev1.ConditionFalse,
+		},
+		{
+			name:        "other errors are returned",
+			errs:        []error{errors.New("forbidden")},
+			expectCalls: 1,
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cs, err := setupCluster("test-cluster")
+			g.Expect(err).NotTo(HaveOccurred())
+			awsMachinePool := &expinfrav1.AWSMachinePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			}
+			ms, err := scope.NewMachinePoolScope(scope.MachinePoolScopeParams{
+				Client:         fake.NewClientBuilder().WithObjects(awsMachinePool).Build(),
+				Cluster:        &clusterv1.Cluster{},
+				MachinePool:    &expclusterv1.MachinePool{},
+				InfraCluster:   cs,
+				AWSMachinePool: awsMachinePool,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			calls := 0
+			r := &AWSMachinePoolReconciler{}
+			unreachable, err := r.retryWorkloadCluster(ms, func() error {
+				err := tt.errs[calls]
+				calls++
+				return err
+			})
+
+			g.Expect(calls).To(Equal(tt.expectCalls))
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(unreachable).To(Equal(tt.expectUnreachable))
+
+			condition := conditions.Get(awsMachinePool, expinfrav1.WorkloadClusterReachableCondition)
+			if tt.expectCondition == "" {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.expectCondition))
+			if tt.expectCondition == corev1.ConditionFalse {
+				g.Expect(condition.Reason).To(Equal(expinfrav1.WaitingForWorkloadClusterReason))
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -40,6 +41,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks"
+	awswait "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
@@ -49,6 +51,9 @@ import (
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
 
+// nodegroupPendingRequeueAfter is how long to wait before reconciling a nodegroup being created or updated again.
+const nodegroupPendingRequeueAfter = 30 * time.Second
+
 // AWSManagedMachinePoolReconciler reconciles a AWSManagedMachinePool object.
 type AWSManagedMachinePoolReconciler struct {
 	client.Client
@@ -189,7 +194,15 @@ func (r *AWSManagedMachinePoolReconciler) Reconcile(ctx context.Context, req ctr
 		return ctrl.Result{}, r.reconcileDelete(ctx, machinePoolScope, managedControlPlaneScope)
 	}
 
-	return ctrl.Result{}, r.reconcileNormal(ctx, machinePoolScope, managedControlPlaneScope)
+	if err := r.reconcileNormal(ctx, machinePoolScope, managedControlPlaneScope); err != nil {
+		if awswait.IsPending(err) {
+			machinePoolScope.Info("Waiting for EKS nodegroup to be active", "reason", err.Error())
+			return ctrl.Result{RequeueAfter: nodegroupPendingRequeueAfter}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
 }
 
 func (r *AWSManagedMachinePoolReconciler) reconcileNormal(
@@ -235,6 +248,15 @@ func (r *AWSManagedMachinePoolReconciler) reconcileNormal(
 		conditions.MarkTrue(machinePoolScope.ManagedMachinePool, expinfrav1.LaunchTemplateReadyCondition)
 	}
 
+	// Without a launch template, the instance type of the node group is only set in the AWSManagedMachinePool spec.
+	if instanceType := machinePoolScope.ManagedMachinePool.Spec.InstanceType; machinePoolScope.ManagedMachinePool.Spec.AWSLaunchTemplate == nil &&
+		machinePoolScope.RequiresGPU() && instanceType != nil {
+		if err := ec2svc.ValidateGPUInstanceType(*instanceType); err != nil {
+			r.Recorder.Eventf(machinePoolScope.ManagedMachinePool, corev1.EventTypeWarning, "FailedValidateGPUInstanceType", "Failed to validate the instance type: %v", err)
+			return err
+		}
+	}
+
 	if err := ekssvc.ReconcilePool(ctx); err != nil {
 		return errors.Wrapf(err, "failed to reconcile machine pool for AWSManagedMachinePool %s/%s", machinePoolScope.ManagedMachinePool.Namespace, machinePoolScope.ManagedMachinePool.Name)
 	}
@@ -91,20 +91,32 @@ func init() {
 }
 
 var (
-	enableLeaderElection     bool
-	leaderElectionNamespace  string
-	watchNamespace           string
-	watchFilterValue         string
-	profilerAddress          string
-	awsClusterConcurrency    int
-	instanceStateConcurrency int
-	awsMachineConcurrency    int
-	waitInfraPeriod          time.Duration
-	syncPeriod               time.Duration
-	webhookPort              int
-	webhookCertDir           string
-	healthAddr               string
-	serviceEndpoints         string
+	enableLeaderElection           bool
+	leaderElectionNamespace        string
+	watchNamespace                 string
+	watchFilterValue               string
+	profilerAddress                string
+	awsClusterConcurrency          int
+	instanceStateConcurrency       int
+	awsMachineConcurrency          int
+	awsMachineFullReconcile        time.Duration
+	awsMachineInstanceGracePeriod  time.Duration
+	awsMachineRequeue              time.Duration
+	awsMachineErrorBackoff         time.Duration
+	awsClusterRequeue              time.Duration
+	awsClusterErrorBackoff         time.Duration
+	awsMachinePoolRequeue          time.Duration
+	awsMachinePoolErrorBackoff     time.Duration
+	mutatingRequestLimit           int
+	staticIdentitySecretNamespaces []string
+	waitInfraPeriod                time.Duration
+	syncPeriod                     time.Duration
+	webhookPort                    int
+	webhookCertDir                 string
+	healthAddr                     string
+	serviceEndpoints               string
+	serviceClientConfigs           string
+	useBlockingWaiters             bool
 
 	// maxEKSSyncPeriod is the maximum allowed duration for the sync-period flag when using EKS. It is set to 10 minutes
 	// because during resync it will create a new AWS auth token which can a maximum life of 15 minutes and this ensures
@@ -209,6 +221,17 @@ func main() {
 		setupLog.Info("Enabling Ignition support for machine bootstrap data")
 	}
 
+	scope.SetMaxConcurrentMutatingRequestsPerCluster(mutatingRequestLimit)
+	scope.SetStaticIdentitySecretNamespaces(staticIdentitySecretNamespaces)
+
+	// Parse service client configurations.
+	awsServiceClientConfigs, err := endpoints.ParseClientConfigFlag(serviceClientConfigs)
+	if err != nil {
+		setupLog.Error(err, "unable to parse service client configurations")
+		os.Exit(1)
+	}
+	scope.SetServiceClientConfigs(awsServiceClientConfigs)
+
 	// Parse service endpoints.
 	awsServiceEndpoints, err := endpoints.ParseFlag(serviceEndpoints)
 	if err != nil {
@@ -282,7 +305,10 @@ func setupReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager, awsServi
 		Endpoints:                    awsServiceEndpoints,
 		WatchFilterValue:             watchFilterValue,
 		TagUnmanagedNetworkResources: feature.Gates.Enabled(feature.TagUnmanagedNetworkResources),
-	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsMachineConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
+		RequeueInterval:              awsMachineRequeue,
+		FullReconcilePeriod:          awsMachineFullReconcile,
+		InstanceNotFoundGracePeriod:  awsMachineInstanceGracePeriod,
+	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsMachineConcurrency, RecoverPanic: ptr.To[bool](true), RateLimiter: controllers.NewErrorBackoffRateLimiter(awsMachineErrorBackoff)}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AWSMachine")
 		os.Exit(1)
 	}
@@ -295,7 +321,9 @@ func setupReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager, awsServi
 		ExternalResourceGC:           externalResourceGC,
 		AlternativeGCStrategy:        alternativeGCStrategy,
 		TagUnmanagedNetworkResources: feature.Gates.Enabled(feature.TagUnmanagedNetworkResources),
-	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsClusterConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
+		UseBlockingWaiters:           useBlockingWaiters,
+		RequeueInterval:              awsClusterRequeue,
+	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsClusterConcurrency, RecoverPanic: ptr.To[bool](true), RateLimiter: controllers.NewErrorBackoffRateLimiter(awsClusterErrorBackoff)}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AWSCluster")
 		os.Exit(1)
 	}
@@ -307,7 +335,8 @@ func setupReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager, awsServi
 			Recorder:                     mgr.GetEventRecorderFor("awsmachinepool-controller"),
 			WatchFilterValue:             watchFilterValue,
 			TagUnmanagedNetworkResources: feature.Gates.Enabled(feature.TagUnmanagedNetworkResources),
-		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: instanceStateConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
+			RequeueInterval:              awsMachinePoolRequeue,
+		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: instanceStateConcurrency, RecoverPanic: ptr.To[bool](true), RateLimiter: controllers.NewErrorBackoffRateLimiter(awsMachinePoolErrorBackoff)}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "AWSMachinePool")
 			os.Exit(1)
 		}
@@ -404,6 +433,7 @@ func setupEKSReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager, awsSe
 		AlternativeGCStrategy:        alternativeGCStrategy,
 		WaitInfraPeriod:              waitInfraPeriod,
 		TagUnmanagedNetworkResources: feature.Gates.Enabled(feature.TagUnmanagedNetworkResources),
+		UseBlockingWaiters:           useBlockingWaiters,
 	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsClusterConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AWSManagedControlPlane")
 		os.Exit(1)
@@ -520,6 +550,66 @@ func initFlags(fs *pflag.FlagSet) {
 		"Number of AWSMachines to process simultaneously",
 	)
 
+	fs.DurationVar(&awsMachineFullReconcile,
+		"awsmachine-full-reconcile-period",
+		0,
+		"The interval at which AWSMachines in steady state are fully reconciled against the AWS APIs, reconciles in between skip the AWS API calls. If unspecified or 0, every reconcile is a full reconcile.",
+	)
+
+	fs.DurationVar(&awsMachineInstanceGracePeriod,
+		"awsmachine-instance-not-found-grace-period",
+		controllers.DefaultInstanceNotFoundGracePeriod,
+		"The period after the creation of an AWSMachine instance during which the instance not being returned by the EC2 APIs is considered eventual consistency rather than a deletion. If 0, a missing instance is never waited for.",
+	)
+
+	fs.DurationVar(&awsMachineRequeue,
+		"awsmachine-requeue-interval",
+		controllers.DefaultReconcilerRequeue,
+		"The interval at which AWSMachines waiting for their instance to be running are reconciled again.",
+	)
+
+	fs.DurationVar(&awsMachineErrorBackoff,
+		"awsmachine-error-backoff",
+		controllers.DefaultErrorBackoff,
+		"The initial delay before AWSMachines that failed to reconcile are reconciled again, doubled on every consecutive failure.",
+	)
+
+	fs.DurationVar(&awsClusterRequeue,
+		"awscluster-requeue-interval",
+		controllers.DefaultClusterReconcilerRequeue,
+		"The interval at which AWSClusters waiting for the DNS name of their load balancer are reconciled again.",
+	)
+
+	fs.DurationVar(&awsClusterErrorBackoff,
+		"awscluster-error-backoff",
+		controllers.DefaultErrorBackoff,
+		"The initial delay before AWSClusters that failed to reconcile are reconciled again, doubled on every consecutive failure.",
+	)
+
+	fs.DurationVar(&awsMachinePoolRequeue,
+		"awsmachinepool-requeue-interval",
+		controllers.DefaultReconcilerRequeue,
+		"The interval at which AWSMachinePools waiting for the instances of their ASG are reconciled again.",
+	)
+
+	fs.DurationVar(&awsMachinePoolErrorBackoff,
+		"awsmachinepool-error-backoff",
+		controllers.DefaultErrorBackoff,
+		"The initial delay before AWSMachinePools that failed to reconcile are reconciled again, doubled on every consecutive failure.",
+	)
+
+	fs.IntVar(&mutatingRequestLimit,
+		"max-concurrent-mutating-requests-per-cluster",
+		0,
+		"Maximum number of mutating AWS API requests in flight for a single cluster. If unspecified or 0, the number of requests is not limited.",
+	)
+
+	fs.StringSliceVar(&staticIdentitySecretNamespaces,
+		"static-identity-secret-namespaces",
+		nil,
+		"Namespaces, besides the namespace of the controller, from which AWSClusterStaticIdentity secrets can be read. If unspecified, secrets are only read from the namespace of the controller.",
+	)
+
 	fs.DurationVar(&waitInfraPeriod,
 		"wait-infra-period",
 		1*time.Minute,
@@ -553,6 +643,18 @@ func initFlags(fs *pflag.FlagSet) {
 		"Set custom AWS service endpoins in semi-colon separated format: ${SigningRegion1}:${ServiceID1}=${URL},${ServiceID2}=${URL};${SigningRegion2}...",
 	)
 
+	fs.StringVar(&serviceClientConfigs,
+		"service-client-configs",
+		"",
+		"Set the retries and request timeout of the clients of AWS services in semi-colon separated format: ${ServiceID1}:maxRetries=${MaxRetries},requestTimeout=${Duration};${ServiceID2}... If unspecified, the defaults of the AWS SDK are used.",
+	)
+
+	fs.BoolVar(&useBlockingWaiters,
+		"use-blocking-waiters",
+		true,
+		"Block the reconciles until the NAT gateways and EKS clusters are available and the bastion hosts are terminated. When disabled, the reconciles are requeued and check on these operations instead.",
+	)
+
 	fs.StringVar(
 		&watchFilterValue,
 		"watch-filter",
@@ -17,6 +17,7 @@ limitations under the License.
 package awserrors
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -28,12 +29,15 @@ const (
 	AssociationIDNotFound             = "InvalidAssociationID.NotFound"
 	AuthFailure                       = "AuthFailure"
 	BucketAlreadyOwnedByYou           = "BucketAlreadyOwnedByYou"
+	DetectOnly                        = "DetectOnly"
 	EIPNotFound                       = "InvalidElasticIpID.NotFound"
 	GatewayNotFound                   = "InvalidGatewayID.NotFound"
 	GroupNotFound                     = "InvalidGroup.NotFound"
 	InternetGatewayNotFound           = "InvalidInternetGatewayID.NotFound"
 	EgressOnlyInternetGatewayNotFound = "InvalidEgressOnlyInternetGatewayID.NotFound"
 	InUseIPAddress                    = "InvalidIPAddress.InUse"
+	InsufficientInstanceCapacity      = "InsufficientInstanceCapacity"
+	InstanceConnectEndpointNotFound   = "InvalidInstanceConnectEndpointId.NotFound"
 	InvalidAccessKeyID                = "InvalidAccessKeyId"
 	InvalidClientTokenID              = "InvalidClientTokenId"
 	InvalidInstanceID                 = "InvalidInstanceID.NotFound"
@@ -41,6 +45,7 @@ const (
 	LaunchTemplateNameNotFound        = "InvalidLaunchTemplateName.NotFoundException"
 	LoadBalancerNotFound              = "LoadBalancerNotFound"
 	NATGatewayNotFound                = "InvalidNatGatewayID.NotFound"
+	NetworkInterfaceNotFound          = "InvalidNetworkInterfaceID.NotFound"
 	//nolint:gosec
 	NoCredentialProviders                   = "NoCredentialProviders"
 	NoSuchKey                               = "NoSuchKey"
@@ -50,8 +55,12 @@ const (
 	RouteTableNotFound                      = "InvalidRouteTableID.NotFound"
 	SubnetNotFound                          = "InvalidSubnetID.NotFound"
 	UnrecognizedClientException             = "UnrecognizedClientException"
+	Unsupported                             = "Unsupported"
+	UnsupportedOperation                    = "UnsupportedOperation"
 	UnauthorizedOperation                   = "UnauthorizedOperation"
+	ValidationError                         = "ValidationError"
 	VPCNotFound                             = "InvalidVpcID.NotFound"
+	VPCPeeringConnectionNotFound            = "InvalidVpcPeeringConnectionID.NotFound"
 	VPCMissingParameter                     = "MissingParameter"
 	ErrCodeRepositoryAlreadyExistsException = "RepositoryAlreadyExistsException"
 )
@@ -109,6 +118,13 @@ func IsBucketAlreadyOwnedByYou(err error) bool {
 	return false
 }
 
+// IsDetectOnly checks if the error, or an error it wraps, was returned for an operation changing an AWS resource
+// that was not called in the detectOnly reconcile mode.
+func IsDetectOnly(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == DetectOnly
+}
+
 // IsResourceExists checks the state of the resource.
 func IsResourceExists(err error) bool {
 	if code, ok := Code(err); ok {
@@ -125,6 +141,22 @@ func IsRepositoryExists(err error) bool {
 	return false
 }
 
+// IsInsufficientInstanceCapacity checks if there is not enough capacity to launch an instance.
+func IsInsufficientInstanceCapacity(err error) bool {
+	if code, ok := Code(err); ok {
+		return code == InsufficientInstanceCapacity
+	}
+	return false
+}
+
+// IsUnsupported checks if the request is not supported, like an instance type that isn't offered in an availability zone.
+func IsUnsupported(err error) bool {
+	if code, ok := Code(err); ok {
+		return code == Unsupported
+	}
+	return false
+}
+
 // NewFailedDependency returns an error which indicates that a dependency failure status.
 func NewFailedDependency(msg string) error {
 	return &EC2Error{
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awserrors
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// RequestError is an AWS API error annotated with the operation and the ID of the failed request,
+// which are needed to investigate the failure with AWS support.
+// It implements awserr.RequestFailure, so the error code of the original error is preserved.
+type RequestError struct {
+	err        awserr.Error
+	operation  string
+	requestID  string
+	statusCode int
+}
+
+var _ awserr.RequestFailure = &RequestError{}
+
+// NewRequestError annotates an AWS API error with the operation and the ID of the failed request.
+func NewRequestError(err awserr.Error, operation, requestID string, statusCode int) *RequestError {
+	return &RequestError{
+		err:        err,
+		operation:  operation,
+		requestID:  requestID,
+		statusCode: statusCode,
+	}
+}
+
+// Error implements the Error interface.
+func (e *RequestError) Error() string {
+	msg := fmt.Sprintf("%s: %s: %s (request id: %s)", e.operation, e.err.Code(), e.err.Message(), e.requestID)
+	if origErr := e.err.OrigErr(); origErr != nil {
+		msg = fmt.Sprintf("%s, caused by: %v", msg, origErr)
+	}
+	return msg
+}
+
+// Code returns the error code of the original error.
+func (e *RequestError) Code() string {
+	return e.err.Code()
+}
+
+// Message returns the error message of the original error.
+func (e *RequestError) Message() string {
+	return e.err.Message()
+}
+
+// OrigErr returns the error wrapped by the original error, if any.
+func (e *RequestError) OrigErr() error {
+	return e.err.OrigErr()
+}
+
+// Unwrap returns the original error.
+func (e *RequestError) Unwrap() error {
+	return e.err
+}
+
+// Operation returns the name of the API operation that failed.
+func (e *RequestError) Operation() string {
+	return e.operation
+}
+
+// RequestID returns the ID of the failed request.
+func (e *RequestError) RequestID() string {
+	return e.requestID
+}
+
+// StatusCode returns the HTTP status code of the failed request.
+func (e *RequestError) StatusCode() int {
+	return e.statusCode
+}
+
+// RequestID returns the ID of the AWS request that caused the error, if known.
+func RequestID(err error) (string, bool) {
+	var requestFailure awserr.RequestFailure
+	if errors.As(err, &requestFailure) && requestFailure.RequestID() != "" {
+		return requestFailure.RequestID(), true
+	}
+	return "", false
+}
+
+// AnnotateRequestErrorHandler is an AWS SDK request handler that annotates the error of a failed request
+// with the operation and the ID of the request. It must run after the request has completed.
+var AnnotateRequestErrorHandler = request.NamedHandler{
+	Name: "capa/annotate-request-error",
+	Fn: func(r *request.Request) {
+		awsErr, ok := r.Error.(awserr.Error)
+		if !ok || r.RequestID == "" {
+			return
+		}
+		if _, ok := awsErr.(*RequestError); ok {
+			return
+		}
+
+		statusCode := 0
+		if r.HTTPResponse != nil {
+			statusCode = r.HTTPResponse.StatusCode
+		}
+		r.Error = NewRequestError(awsErr, r.Operation.Name, r.RequestID, statusCode)
+	},
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awserrors
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+func newFailedRequest(operation string, err error, requestID string) *request.Request {
+	r := request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{Name: operation}, nil, nil)
+	r.Error = err
+	r.RequestID = requestID
+	r.HTTPResponse = &http.Response{StatusCode: http.StatusBadRequest}
+	return r
+}
+
+func TestAnnotateRequestErrorHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	r := newFailedRequest("DescribeVpcs",
+		awserr.NewRequestFailure(awserr.New(VPCNotFound, "The vpc ID 'vpc-1' does not exist", nil), http.StatusBadRequest, "8f7724d5-3cd6-4f6e-a5b3-2d1b5e0c1a2b"),
+		"8f7724d5-3cd6-4f6e-a5b3-2d1b5e0c1a2b",
+	)
+	AnnotateRequestErrorHandler.Fn(r)
+
+	err := errors.Wrap(r.Error, "failed to describe vpc")
+	g.Expect(err.Error()).To(Equal("failed to describe vpc: DescribeVpcs: InvalidVpcID.NotFound: The vpc ID 'vpc-1' does not exist (request id: 8f7724d5-3cd6-4f6e-a5b3-2d1b5e0c1a2b)"))
+
+	requestID, ok := RequestID(err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(requestID).To(Equal("8f7724d5-3cd6-4f6e-a5b3-2d1b5e0c1a2b"))
+
+	// The error code of the original error is preserved.
+	g.Expect(IsNotFound(r.Error)).To(BeTrue())
+	code, ok := Code(r.Error)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(code).To(Equal(VPCNotFound))
+
+	requestErr, ok := r.Error.(*RequestError)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(requestErr.Operation()).To(Equal("DescribeVpcs"))
+	g.Expect(requestErr.StatusCode()).To(Equal(http.StatusBadRequest))
+
+	// Annotating twice does not nest the annotation.
+	AnnotateRequestErrorHandler.Fn(r)
+	g.Expect(r.Error).To(BeIdenticalTo(requestErr))
+}
+
+func TestAnnotateRequestErrorHandlerWithoutRequestID(t *testing.T) {
+	g := NewWithT(t)
+
+	origErr := awserr.New(request.CanceledErrorCode, "request context canceled", nil)
+	r := newFailedRequest("RunInstances", origErr, "")
+	AnnotateRequestErrorHandler.Fn(r)
+
+	g.Expect(r.Error).To(BeIdenticalTo(origErr))
+	_, ok := RequestID(r.Error)
+	g.Expect(ok).To(BeFalse())
+}
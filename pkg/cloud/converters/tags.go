@@ -169,6 +169,17 @@ func MapToIAMTags(src infrav1.Tags) []*iam.Tag {
 	return tags
 }
 
+// IAMTagsToMap converts a []*iam.Tag into a infrav1.Tags.
+func IAMTagsToMap(src []*iam.Tag) infrav1.Tags {
+	tags := make(infrav1.Tags, len(src))
+
+	for _, t := range src {
+		tags[*t.Key] = *t.Value
+	}
+
+	return tags
+}
+
 // ASGTagsToMap converts a []*autoscaling.TagDescription into a infrav1.Tags.
 func ASGTagsToMap(src []*autoscaling.TagDescription) infrav1.Tags {
 	tags := make(infrav1.Tags, len(src))
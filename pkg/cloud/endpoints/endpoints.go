@@ -19,7 +19,9 @@ package endpoints
 import (
 	"errors"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 
@@ -32,6 +34,13 @@ var (
 	errServiceEndpointURL                = errors.New("must use a valid URL as a service-endpoint")
 	errServiceEndpointServiceID          = errors.New("must use a valid serviceID from the AWS GO SDK")
 	errServiceEndpointDuplicateServiceID = errors.New("same serviceID defined twice for signing region")
+
+	errServiceClientConfigFormat             = errors.New("must be formatted as ${ServiceID1}:maxRetries=${MaxRetries},requestTimeout=${Duration};${ServiceID2}...")
+	errServiceClientConfigServiceID          = errors.New("must use a valid serviceID from the AWS GO SDK")
+	errServiceClientConfigDuplicateServiceID = errors.New("same serviceID defined twice")
+	errServiceClientConfigOption             = errors.New("must only set the maxRetries and requestTimeout options")
+	errServiceClientConfigMaxRetries         = errors.New("must use a non-negative integer for maxRetries")
+	errServiceClientConfigRequestTimeout     = errors.New("must use a positive duration for requestTimeout")
 )
 
 func serviceEnum() []string {
@@ -107,6 +116,59 @@ func ParseFlag(serviceEndpoints string) ([]scope.ServiceEndpoint, error) {
 	return endpoints, nil
 }
 
+// ParseClientConfigFlag parses the command line flag of service client configurations in the format
+// ${ServiceID1}:maxRetries=${MaxRetries},requestTimeout=${Duration};${ServiceID2}...
+// returning a set of ServiceClientConfigs.
+func ParseClientConfigFlag(clientConfigs string) ([]scope.ServiceClientConfig, error) {
+	if clientConfigs == "" {
+		return nil, nil
+	}
+	serviceIDs := serviceEnum()
+	seenServices := []string{}
+	configs := []scope.ServiceClientConfig{}
+	for _, serviceConfig := range strings.Split(clientConfigs, ";") {
+		components := strings.SplitN(serviceConfig, ":", 2)
+		if len(components) != 2 {
+			return nil, errServiceClientConfigFormat
+		}
+		serviceID := components[0]
+		if !containsString(serviceIDs, serviceID) {
+			return nil, errServiceClientConfigServiceID
+		}
+		if containsString(seenServices, serviceID) {
+			return nil, errServiceClientConfigDuplicateServiceID
+		}
+		seenServices = append(seenServices, serviceID)
+
+		config := scope.ServiceClientConfig{ServiceID: serviceID}
+		for _, option := range strings.Split(components[1], ",") {
+			kv := strings.Split(option, "=")
+			if len(kv) != 2 {
+				return nil, errServiceClientConfigFormat
+			}
+			switch kv[0] {
+			case "maxRetries":
+				maxRetries, err := strconv.Atoi(kv[1])
+				if err != nil || maxRetries < 0 {
+					return nil, errServiceClientConfigMaxRetries
+				}
+				config.MaxRetries = &maxRetries
+			case "requestTimeout":
+				timeout, err := time.ParseDuration(kv[1])
+				if err != nil || timeout <= 0 {
+					return nil, errServiceClientConfigRequestTimeout
+				}
+				config.RequestTimeout = timeout
+			default:
+				return nil, errServiceClientConfigOption
+			}
+		}
+		configs = append(configs, config)
+	}
+
+	return configs, nil
+}
+
 func containsString(slice []string, s string) bool {
 	for _, item := range slice {
 		if item == s {
@@ -19,6 +19,10 @@ package endpoints
 import (
 	"errors"
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 )
@@ -139,3 +143,96 @@ func endpointsEqual(a, b []scope.ServiceEndpoint) bool {
 	}
 	return true
 }
+
+func TestParseClientConfigFlag(t *testing.T) {
+	testCases := []struct {
+		name           string
+		flagToParse    string
+		expectedOutput []scope.ServiceClientConfig
+		expectedError  error
+	}{
+		{
+			name:           "no configuration",
+			flagToParse:    "",
+			expectedOutput: nil,
+			expectedError:  nil,
+		},
+		{
+			name:        "single service, all options",
+			flagToParse: "eks:maxRetries=10,requestTimeout=20m",
+			expectedOutput: []scope.ServiceClientConfig{
+				{
+					ServiceID:      "eks",
+					MaxRetries:     ptr.To(10),
+					RequestTimeout: 20 * time.Minute,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name:        "multiple services, single option",
+			flagToParse: "eks:requestTimeout=20m;ec2:maxRetries=0",
+			expectedOutput: []scope.ServiceClientConfig{
+				{
+					ServiceID:      "eks",
+					RequestTimeout: 20 * time.Minute,
+				},
+				{
+					ServiceID:  "ec2",
+					MaxRetries: ptr.To(0),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name:           "duplicate service",
+			flagToParse:    "ec2:maxRetries=1;ec2:maxRetries=2",
+			expectedOutput: nil,
+			expectedError:  errServiceClientConfigDuplicateServiceID,
+		},
+		{
+			name:           "unknown service",
+			flagToParse:    "notaservice:maxRetries=1",
+			expectedOutput: nil,
+			expectedError:  errServiceClientConfigServiceID,
+		},
+		{
+			name:           "unknown option",
+			flagToParse:    "ec2:retries=1",
+			expectedOutput: nil,
+			expectedError:  errServiceClientConfigOption,
+		},
+		{
+			name:           "negative max retries",
+			flagToParse:    "ec2:maxRetries=-1",
+			expectedOutput: nil,
+			expectedError:  errServiceClientConfigMaxRetries,
+		},
+		{
+			name:           "invalid request timeout",
+			flagToParse:    "ec2:requestTimeout=10",
+			expectedOutput: nil,
+			expectedError:  errServiceClientConfigRequestTimeout,
+		},
+		{
+			name:           "invalid config",
+			flagToParse:    "ec2maxRetries=1",
+			expectedOutput: nil,
+			expectedError:  errServiceClientConfigFormat,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := ParseClientConfigFlag(tc.flagToParse)
+
+			if !errors.Is(err, tc.expectedError) {
+				t.Fatalf("did not expect correct error: got %v, expected %v", err, tc.expectedError)
+			}
+
+			if !cmp.Equal(out, tc.expectedOutput) {
+				t.Fatalf("did not expect correct output: got %v, expected %v", out, tc.expectedOutput)
+			}
+		})
+	}
+}
@@ -55,6 +55,14 @@ func (ec2Filters) Name(name string) *ec2.Filter {
 	}
 }
 
+// MachineName returns a filter based on the namespaced name of the machine owning the resource.
+func (ec2Filters) MachineName(namespacedName string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String(fmt.Sprintf("tag:%s", infrav1.MachineNameTagKey)),
+		Values: aws.StringSlice([]string{namespacedName}),
+	}
+}
+
 // ClusterOwned returns a filter using the Cluster API per-cluster tag where
 // the resource is owned.
 func (ec2Filters) ClusterOwned(clusterName string) *ec2.Filter {
@@ -129,6 +137,22 @@ func (ec2Filters) NATGatewayStates(states ...string) *ec2.Filter {
 	}
 }
 
+// InstanceConnectEndpointStates returns a filter based on the list of states passed in.
+func (ec2Filters) InstanceConnectEndpointStates(states ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("state"),
+		Values: aws.StringSlice(states),
+	}
+}
+
+// LaunchTemplateID returns a filter based on the ID of the launch template the instances were launched from.
+func (ec2Filters) LaunchTemplateID(id string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("tag:aws:ec2launchtemplate:id"),
+		Values: aws.StringSlice([]string{id}),
+	}
+}
+
 // InstanceStates returns a filter based on the list of states passed in.
 func (ec2Filters) InstanceStates(states ...string) *ec2.Filter {
 	return &ec2.Filter{
@@ -153,6 +177,54 @@ func (ec2Filters) SubnetStates(states ...string) *ec2.Filter {
 	}
 }
 
+// NetworkInterfaceStatuses returns a filter based on the list of network interface statuses passed in.
+func (ec2Filters) NetworkInterfaceStatuses(statuses ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("status"),
+		Values: aws.StringSlice(statuses),
+	}
+}
+
+// TransitGateway returns a filter based on the transit gateway ID.
+func (ec2Filters) TransitGateway(transitGatewayID string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("transit-gateway-id"),
+		Values: aws.StringSlice([]string{transitGatewayID}),
+	}
+}
+
+// TransitGatewayAttachmentStates returns a filter based on the list of transit gateway attachment states passed in.
+func (ec2Filters) TransitGatewayAttachmentStates(states ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String(filterNameState),
+		Values: aws.StringSlice(states),
+	}
+}
+
+// VPCPeeringRequester returns a filter based on the id of the requester VPC of a VPC peering connection.
+func (ec2Filters) VPCPeeringRequester(vpcID string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("requester-vpc-info.vpc-id"),
+		Values: aws.StringSlice([]string{vpcID}),
+	}
+}
+
+// VPCPeeringAccepter returns a filter based on the id of the accepter VPC of a VPC peering connection.
+func (ec2Filters) VPCPeeringAccepter(vpcID string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("accepter-vpc-info.vpc-id"),
+		Values: aws.StringSlice([]string{vpcID}),
+	}
+}
+
+// VPCPeeringConnectionStates returns a filter based on the list of VPC peering connection states passed in.
+func (ec2Filters) VPCPeeringConnectionStates(states ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("status-code"),
+		Values: aws.StringSlice(states),
+	}
+}
+
 func (ec2Filters) AvailabilityZone(zone string) *ec2.Filter {
 	return &ec2.Filter{
 		Name:   aws.String(filterAvailabilityZone),
@@ -173,3 +245,27 @@ func (ec2Filters) SecurityGroupName(name string) *ec2.Filter {
 		Values: aws.StringSlice([]string{name}),
 	}
 }
+
+// SecurityGroupID returns a filter based on the id of the security group.
+func (ec2Filters) SecurityGroupID(id string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("group-id"),
+		Values: aws.StringSlice([]string{id}),
+	}
+}
+
+// VolumeIDs returns a filter based on the ids of the volumes.
+func (ec2Filters) VolumeIDs(ids ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("volume-id"),
+		Values: aws.StringSlice(ids),
+	}
+}
+
+// VolumeModificationStates returns a filter based on the list of volume modification states passed in.
+func (ec2Filters) VolumeModificationStates(states ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("modification-state"),
+		Values: aws.StringSlice(states),
+	}
+}
@@ -20,16 +20,21 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/gob"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 )
 
@@ -70,6 +75,10 @@ func GetAssumeRoleCredentials(roleIdentityProvider *AWSRolePrincipalTypeProvider
 			p.Policy = aws.String(roleIdentityProvider.Principal.Spec.InlinePolicy)
 		}
 		p.Duration = time.Duration(roleIdentityProvider.Principal.Spec.DurationSeconds) * time.Second
+		p.Tags = sessionTags(roleIdentityProvider.Principal.Spec.SessionTags)
+		if len(roleIdentityProvider.Principal.Spec.TransitiveTagKeys) > 0 {
+			p.TransitiveTagKeys = aws.StringSlice(roleIdentityProvider.Principal.Spec.TransitiveTagKeys)
+		}
 		// For testing
 		if roleIdentityProvider.stsClient != nil {
 			p.Client = roleIdentityProvider.stsClient
@@ -78,6 +87,22 @@ func GetAssumeRoleCredentials(roleIdentityProvider *AWSRolePrincipalTypeProvider
 	return creds
 }
 
+// sessionTags converts the session tags of a role identity to STS tags, sorted by key.
+func sessionTags(tags map[string]string) []*sts.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	stsTags := make([]*sts.Tag, 0, len(tags))
+	for key, value := range tags {
+		stsTags = append(stsTags, &sts.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	sort.Slice(stsTags, func(i, j int) bool {
+		return *stsTags[i].Key < *stsTags[j].Key
+	})
+	return stsTags
+}
+
 // NewAWSRolePrincipalTypeProvider will create a new AWSRolePrincipalTypeProvider from an AWSClusterRoleIdentity.
 func NewAWSRolePrincipalTypeProvider(identity *infrav1.AWSClusterRoleIdentity, sourceProvider *AWSPrincipalTypeProvider, log logger.Wrapper) *AWSRolePrincipalTypeProvider {
 	return &AWSRolePrincipalTypeProvider{
@@ -166,7 +191,20 @@ func (p *AWSRolePrincipalTypeProvider) Retrieve() (credentials.Value, error) {
 		// Update credentials
 		p.credentials = creds
 	}
-	return p.credentials.Get()
+	value, err := p.credentials.Get()
+	if err != nil && isSessionDurationTooLong(err) {
+		return value, errors.Wrapf(err, "session duration of %d seconds requested by AWSClusterRoleIdentity %q exceeds the maximum session duration of role %q; "+
+			"lower spec.durationSeconds or raise the MaxSessionDuration of the role",
+			p.Principal.Spec.DurationSeconds, p.Principal.Name, p.Principal.Spec.RoleArn)
+	}
+	return value, err
+}
+
+// isSessionDurationTooLong returns true if STS rejected an AssumeRole call because the requested duration exceeds
+// the maximum session duration of the role, or the one hour limit for sessions of roles assumed by role chaining.
+func isSessionDurationTooLong(err error) bool {
+	code, _ := awserrors.Code(err)
+	return code == "ValidationError" && strings.Contains(awserrors.Message(err), "DurationSeconds exceeds")
 }
 
 // IsExpired checks the expiration state of the AWSRolePrincipalTypeProvider.
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/golang/mock/gomock"
@@ -28,6 +29,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -199,3 +201,144 @@ func TestAWSStaticPrincipalTypeProvider(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSRolePrincipalTypeProviderSessionTags(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"AccessKeyID":     []byte("static-AccessKeyID"),
+			"SecretAccessKey": []byte("static-SecretAccessKey"),
+		},
+	}
+	var staticProvider AWSPrincipalTypeProvider = NewAWSStaticPrincipalTypeProvider(&infrav1.AWSClusterStaticIdentity{}, secret)
+
+	stsMock := mock_stsiface.NewMockSTSAPI(mockCtrl)
+	roleIdentity := &infrav1.AWSClusterRoleIdentity{
+		Spec: infrav1.AWSClusterRoleIdentitySpec{
+			AWSRoleSpec: infrav1.AWSRoleSpec{
+				RoleArn:         "arn:*:iam::*:role/aws-role/tagged-role-provider",
+				SessionName:     "tagged-role-provider-session",
+				DurationSeconds: 900,
+				SessionTags: map[string]string{
+					"team":        "platform",
+					"cost-center": "1234",
+				},
+				TransitiveTagKeys: []string{"team"},
+			},
+		},
+	}
+	roleProvider := &AWSRolePrincipalTypeProvider{
+		Principal:      roleIdentity,
+		sourceProvider: &staticProvider,
+		stsClient:      stsMock,
+	}
+
+	stsMock.EXPECT().AssumeRoleWithContext(gomock.Any(), &sts.AssumeRoleInput{
+		RoleArn:         aws.String(roleIdentity.Spec.RoleArn),
+		RoleSessionName: aws.String(roleIdentity.Spec.SessionName),
+		DurationSeconds: ptr.To[int64](int64(roleIdentity.Spec.DurationSeconds)),
+		Tags: []*sts.Tag{
+			{Key: aws.String("cost-center"), Value: aws.String("1234")},
+			{Key: aws.String("team"), Value: aws.String("platform")},
+		},
+		TransitiveTagKeys: aws.StringSlice([]string{"team"}),
+	}).Return(&sts.AssumeRoleOutput{
+		Credentials: &sts.Credentials{
+			AccessKeyId:     aws.String("assumedAccessKeyId"),
+			SecretAccessKey: aws.String("assumedSecretAccessKey"),
+			SessionToken:    aws.String("assumedSessionToken"),
+			Expiration:      aws.Time(time.Now().Add(time.Hour)),
+		},
+	}, nil)
+
+	value, err := roleProvider.Retrieve()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(value.SessionToken).To(Equal("assumedSessionToken"))
+}
+
+func TestAWSRolePrincipalTypeProviderDurationSeconds(t *testing.T) {
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"AccessKeyID":     []byte("static-AccessKeyID"),
+			"SecretAccessKey": []byte("static-SecretAccessKey"),
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		durationSeconds int32
+		assumeRoleErr   error
+		expectErr       string
+	}{
+		{
+			name:            "Role provider requests a custom session duration",
+			durationSeconds: 43200,
+		},
+		{
+			name:            "Role provider fails when the duration exceeds the maximum session duration of the role",
+			durationSeconds: 43200,
+			assumeRoleErr:   awserr.New("ValidationError", "The requested DurationSeconds exceeds the MaxSessionDuration set for this role.", nil),
+			expectErr:       "exceeds the maximum session duration of role",
+		},
+		{
+			name:            "Role provider returns other errors unchanged",
+			durationSeconds: 3600,
+			assumeRoleErr:   awserr.New("AccessDenied", "Not authorized to perform sts:AssumeRole", nil),
+			expectErr:       "Not authorized to perform sts:AssumeRole",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var staticProvider AWSPrincipalTypeProvider = NewAWSStaticPrincipalTypeProvider(&infrav1.AWSClusterStaticIdentity{}, secret)
+			stsMock := mock_stsiface.NewMockSTSAPI(mockCtrl)
+			roleIdentity := &infrav1.AWSClusterRoleIdentity{
+				ObjectMeta: metav1.ObjectMeta{Name: "long-running-role"},
+				Spec: infrav1.AWSClusterRoleIdentitySpec{
+					AWSRoleSpec: infrav1.AWSRoleSpec{
+						RoleArn:         "arn:*:iam::*:role/aws-role/long-running-role",
+						SessionName:     "long-running-role-session",
+						DurationSeconds: tc.durationSeconds,
+					},
+				},
+			}
+			roleProvider := &AWSRolePrincipalTypeProvider{
+				Principal:      roleIdentity,
+				sourceProvider: &staticProvider,
+				stsClient:      stsMock,
+			}
+
+			output := &sts.AssumeRoleOutput{
+				Credentials: &sts.Credentials{
+					AccessKeyId:     aws.String("assumedAccessKeyId"),
+					SecretAccessKey: aws.String("assumedSecretAccessKey"),
+					SessionToken:    aws.String("assumedSessionToken"),
+					Expiration:      aws.Time(time.Now().Add(time.Duration(tc.durationSeconds) * time.Second)),
+				},
+			}
+			if tc.assumeRoleErr != nil {
+				output = &sts.AssumeRoleOutput{}
+			}
+			stsMock.EXPECT().AssumeRoleWithContext(gomock.Any(), &sts.AssumeRoleInput{
+				RoleArn:         aws.String(roleIdentity.Spec.RoleArn),
+				RoleSessionName: aws.String(roleIdentity.Spec.SessionName),
+				DurationSeconds: ptr.To[int64](int64(tc.durationSeconds)),
+			}).Return(output, tc.assumeRoleErr)
+
+			value, err := roleProvider.Retrieve()
+			if tc.expectErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(value.SessionToken).To(Equal("assumedSessionToken"))
+		})
+	}
+}
@@ -77,6 +77,12 @@ type ClusterScoper interface {
 	APIServerPort() int32
 	// AdditionalTags returns any tags that you would like to attach to AWS resources. The returned value will never be nil.
 	AdditionalTags() infrav1.Tags
+	// ReconcileMode returns how the AWS resources of the cluster are reconciled.
+	ReconcileMode() infrav1.ReconcileMode
+	// RecordDrift records an operation changing an AWS resource that was skipped in the detectOnly reconcile mode.
+	RecordDrift(operation string)
+	// DriftedOperations returns the operations recorded by RecordDrift.
+	DriftedOperations() []string
 	// SetFailureDomain sets the infrastructure provider failure domain key to the spec given as input.
 	SetFailureDomain(id string, spec clusterv1.FailureDomainSpec)
 	// PatchObject persists the cluster configuration and status.
@@ -35,12 +35,14 @@ const (
 	metricRequestCountKey    = "api_requests_total"
 	metricRequestDurationKey = "api_request_duration_seconds"
 	metricAPICallRetries     = "api_call_retries"
+	metricRequestQueueDepth  = "api_mutating_requests_queue_depth"
 	metricServiceLabel       = "service"
 	metricRegionLabel        = "region"
 	metricOperationLabel     = "operation"
 	metricControllerLabel    = "controller"
 	metricStatusCodeLabel    = "status_code"
 	metricErrorCodeLabel     = "error_code"
+	metricClusterLabel       = "cluster"
 )
 
 var (
@@ -60,12 +62,28 @@ var (
 		Help:      "Number of retries made against an AWS API",
 		Buckets:   []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
 	}, []string{metricControllerLabel, metricServiceLabel, metricRegionLabel, metricOperationLabel})
+	awsRequestQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricAWSSubsystem,
+		Name:      metricRequestQueueDepth,
+		Help:      "Number of mutating AWS requests waiting for a per-cluster concurrency slot",
+	}, []string{metricClusterLabel})
 )
 
 func init() {
 	metrics.Registry.MustRegister(awsRequestCount)
 	metrics.Registry.MustRegister(awsRequestDurationSeconds)
 	metrics.Registry.MustRegister(awsCallRetries)
+	metrics.Registry.MustRegister(awsRequestQueueDepth)
+}
+
+// RecordRequestQueueDepth records the number of mutating requests waiting to be sent for a cluster.
+func RecordRequestQueueDepth(cluster string, depth int) {
+	awsRequestQueueDepth.WithLabelValues(cluster).Set(float64(depth))
+}
+
+// DeleteRequestQueueDepth deletes the number of mutating requests waiting to be sent for a deleted cluster.
+func DeleteRequestQueueDepth(cluster string) {
+	awsRequestQueueDepth.DeleteLabelValues(cluster)
 }
 
 // CaptureRequestMetrics will monitor and capture request metrics.
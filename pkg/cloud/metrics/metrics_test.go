@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+)
+
+func TestCaptureRequestMetricsDoesNotUseRequestID(t *testing.T) {
+	g := NewWithT(t)
+
+	r := request.New(aws.Config{Region: aws.String("us-east-1")}, metadata.ClientInfo{Endpoint: "https://ec2.us-east-1.amazonaws.com"}, request.Handlers{}, nil, &request.Operation{Name: "DescribeVpcs"}, nil, nil)
+	r.HTTPResponse = &http.Response{StatusCode: http.StatusBadRequest}
+	r.RequestID = "8f7724d5-3cd6-4f6e-a5b3-2d1b5e0c1a2b"
+	r.Error = awserr.NewRequestFailure(awserr.New(awserrors.VPCNotFound, "The vpc ID 'vpc-1' does not exist", nil), http.StatusBadRequest, r.RequestID)
+	awserrors.AnnotateRequestErrorHandler.Fn(r)
+
+	CaptureRequestMetrics("test")(r)
+
+	g.Expect(testutil.ToFloat64(awsRequestCount.WithLabelValues("test", "ec2", "us-east-1", "DescribeVpcs", "400", awserrors.VPCNotFound))).To(Equal(float64(1)))
+
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(awsRequestCount, awsRequestDurationSeconds, awsCallRetries)
+	families, err := registry.Gather()
+	g.Expect(err).NotTo(HaveOccurred())
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				g.Expect(label.GetValue()).NotTo(ContainSubstring(r.RequestID), "label %q of metric %q", label.GetName(), family.GetName())
+			}
+		}
+	}
+}
+
+func TestDeleteRequestQueueDepth(t *testing.T) {
+	g := NewWithT(t)
+
+	RecordRequestQueueDepth("default/deleted", 3)
+	RecordRequestQueueDepth("default/other", 1)
+	g.Expect(testutil.CollectAndCount(awsRequestQueueDepth)).To(Equal(2))
+
+	DeleteRequestQueueDepth("default/deleted")
+	g.Expect(testutil.CollectAndCount(awsRequestQueueDepth)).To(Equal(1))
+	g.Expect(testutil.ToFloat64(awsRequestQueueDepth.WithLabelValues("default/other"))).To(Equal(float64(1)))
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing
+
+// onDemandHourlyPrices are the hourly on-demand prices in USD of Linux instances, by region and instance type.
+// The table only covers common instance types and regions, and is not kept in sync with the AWS price list:
+// it is meant to give a rough cost signal, not an exact bill.
+var onDemandHourlyPrices = map[string]map[string]float64{
+	"us-east-1": {
+		"t3.medium":  0.0416,
+		"t3.large":   0.0832,
+		"t3.xlarge":  0.1664,
+		"t3.2xlarge": 0.3328,
+		"m5.large":   0.096,
+		"m5.xlarge":  0.192,
+		"m5.2xlarge": 0.384,
+		"m5.4xlarge": 0.768,
+		"m6i.large":  0.096,
+		"m6i.xlarge": 0.192,
+		"c5.large":   0.085,
+		"c5.xlarge":  0.17,
+		"c5.2xlarge": 0.34,
+		"r5.large":   0.126,
+		"r5.xlarge":  0.252,
+	},
+	"us-east-2": {
+		"t3.medium":  0.0416,
+		"t3.large":   0.0832,
+		"t3.xlarge":  0.1664,
+		"t3.2xlarge": 0.3328,
+		"m5.large":   0.096,
+		"m5.xlarge":  0.192,
+		"m5.2xlarge": 0.384,
+		"m5.4xlarge": 0.768,
+		"m6i.large":  0.096,
+		"m6i.xlarge": 0.192,
+		"c5.large":   0.085,
+		"c5.xlarge":  0.17,
+		"c5.2xlarge": 0.34,
+		"r5.large":   0.126,
+		"r5.xlarge":  0.252,
+	},
+	"us-west-2": {
+		"t3.medium":  0.0416,
+		"t3.large":   0.0832,
+		"t3.xlarge":  0.1664,
+		"t3.2xlarge": 0.3328,
+		"m5.large":   0.096,
+		"m5.xlarge":  0.192,
+		"m5.2xlarge": 0.384,
+		"m5.4xlarge": 0.768,
+		"m6i.large":  0.096,
+		"m6i.xlarge": 0.192,
+		"c5.large":   0.085,
+		"c5.xlarge":  0.17,
+		"c5.2xlarge": 0.34,
+		"r5.large":   0.126,
+		"r5.xlarge":  0.252,
+	},
+	"eu-west-1": {
+		"t3.medium":  0.0456,
+		"t3.large":   0.0912,
+		"t3.xlarge":  0.1824,
+		"t3.2xlarge": 0.3648,
+		"m5.large":   0.107,
+		"m5.xlarge":  0.214,
+		"m5.2xlarge": 0.428,
+		"m5.4xlarge": 0.856,
+		"m6i.large":  0.107,
+		"m6i.xlarge": 0.214,
+		"c5.large":   0.096,
+		"c5.xlarge":  0.192,
+		"c5.2xlarge": 0.384,
+		"r5.large":   0.141,
+		"r5.xlarge":  0.282,
+	},
+	"eu-central-1": {
+		"t3.medium":  0.048,
+		"t3.large":   0.096,
+		"t3.xlarge":  0.192,
+		"t3.2xlarge": 0.384,
+		"m5.large":   0.115,
+		"m5.xlarge":  0.23,
+		"m5.2xlarge": 0.46,
+		"m5.4xlarge": 0.92,
+		"m6i.large":  0.115,
+		"m6i.xlarge": 0.23,
+		"c5.large":   0.097,
+		"c5.xlarge":  0.194,
+		"c5.2xlarge": 0.388,
+		"r5.large":   0.152,
+		"r5.xlarge":  0.304,
+	},
+}
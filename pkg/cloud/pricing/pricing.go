@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pricing provides rough estimates of the cost of EC2 instances from a static price table.
+package pricing
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Unknown is the estimate returned when the price of an instance type isn't in the price table.
+const Unknown = "unknown"
+
+// OnDemandHourlyPrice returns the hourly on-demand price in USD of a Linux instance of the given type
+// in the given region, and whether the price is known.
+func OnDemandHourlyPrice(region, instanceType string) (float64, bool) {
+	price, ok := onDemandHourlyPrices[region][instanceType]
+	return price, ok
+}
+
+// EstimateHourlyCost returns the estimated hourly cost in USD of running the given number of instances,
+// formatted with four decimals, or Unknown if the price of any of the instance types isn't known.
+// When multiple instance types are given, the most expensive one is used, so the estimate is an upper bound.
+// Spot instances are estimated at their maximum price when it is lower than the on-demand price, as the
+// actual Spot price isn't known in advance.
+func EstimateHourlyCost(region string, instanceTypes []string, spotMaxPrice *string, count int32) string {
+	if len(instanceTypes) == 0 {
+		return Unknown
+	}
+
+	var price float64
+	for _, instanceType := range instanceTypes {
+		p, ok := OnDemandHourlyPrice(region, instanceType)
+		if !ok {
+			return Unknown
+		}
+		if p > price {
+			price = p
+		}
+	}
+
+	if spotMaxPrice != nil {
+		if maxPrice, err := strconv.ParseFloat(*spotMaxPrice, 64); err == nil && maxPrice > 0 && maxPrice < price {
+			price = maxPrice
+		}
+	}
+
+	return fmt.Sprintf("%.4f", price*float64(count))
+}
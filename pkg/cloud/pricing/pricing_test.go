@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestEstimateHourlyCost(t *testing.T) {
+	testCases := []struct {
+		name          string
+		region        string
+		instanceTypes []string
+		spotMaxPrice  *string
+		count         int32
+		want          string
+	}{
+		{
+			name:          "single instance type",
+			region:        "us-east-1",
+			instanceTypes: []string{"m5.large"},
+			count:         3,
+			want:          "0.2880",
+		},
+		{
+			name:          "price depends on the region",
+			region:        "eu-west-1",
+			instanceTypes: []string{"m5.large"},
+			count:         3,
+			want:          "0.3210",
+		},
+		{
+			name:          "multiple instance types use the most expensive one",
+			region:        "us-west-2",
+			instanceTypes: []string{"t3.large", "m5.xlarge", "c5.large"},
+			count:         2,
+			want:          "0.3840",
+		},
+		{
+			name:          "spot instances use the max price when lower than the on-demand price",
+			region:        "us-east-1",
+			instanceTypes: []string{"m5.xlarge"},
+			spotMaxPrice:  ptr.To("0.05"),
+			count:         4,
+			want:          "0.2000",
+		},
+		{
+			name:          "spot instances use the on-demand price without a max price",
+			region:        "us-east-1",
+			instanceTypes: []string{"m5.xlarge"},
+			spotMaxPrice:  ptr.To(""),
+			count:         1,
+			want:          "0.1920",
+		},
+		{
+			name:          "no instances",
+			region:        "us-east-1",
+			instanceTypes: []string{"t3.medium"},
+			count:         0,
+			want:          "0.0000",
+		},
+		{
+			name:          "unknown instance type",
+			region:        "us-east-1",
+			instanceTypes: []string{"m5.large", "x2iedn.32xlarge"},
+			count:         1,
+			want:          Unknown,
+		},
+		{
+			name:          "unknown region",
+			region:        "ap-south-2",
+			instanceTypes: []string{"m5.large"},
+			count:         1,
+			want:          Unknown,
+		},
+		{
+			name:   "no instance type",
+			region: "us-east-1",
+			count:  1,
+			want:   Unknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(EstimateHourlyCost(tc.region, tc.instanceTypes, tc.spotMaxPrice, tc.count)).To(Equal(tc.want))
+		})
+	}
+}
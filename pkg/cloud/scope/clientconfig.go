@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+)
+
+// ServiceClientConfig defines the retry and timeout behaviour of the clients of an AWS service.
+type ServiceClientConfig struct {
+	// ServiceID is the endpoints ID of the service in the AWS GO SDK, e.g. ec2 or eks.
+	ServiceID string
+	// MaxRetries is the maximum number of times a failed request is retried.
+	// If nil, the default of the AWS GO SDK for the service is used.
+	MaxRetries *int
+	// RequestTimeout is the timeout of a single attempt of a request.
+	// If zero, attempts do not time out.
+	RequestTimeout time.Duration
+}
+
+// serviceClientConfigs are the client configurations keyed by service ID.
+var serviceClientConfigs = map[string]ServiceClientConfig{}
+
+// SetServiceClientConfigs sets the retry and timeout configuration used when building the clients of
+// the given AWS services. The clients of the other services keep the defaults of the AWS GO SDK.
+func SetServiceClientConfigs(configs []ServiceClientConfig) {
+	serviceClientConfigs = make(map[string]ServiceClientConfig, len(configs))
+	for _, config := range configs {
+		serviceClientConfigs[config.ServiceID] = config
+	}
+}
+
+// withServiceClientConfig applies the retry and timeout configuration of an AWS service to the config of its client.
+func withServiceClientConfig(cfg *aws.Config, session cloud.Session, serviceID string) *aws.Config {
+	clientConfig, ok := serviceClientConfigs[serviceID]
+	if !ok {
+		return cfg
+	}
+
+	if clientConfig.MaxRetries != nil {
+		cfg = cfg.WithMaxRetries(*clientConfig.MaxRetries)
+	}
+	if clientConfig.RequestTimeout > 0 {
+		// Copy the HTTP client of the session, so only the clients of this service use the timeout.
+		httpClient := &http.Client{}
+		if sessionClient := session.Session().ClientConfig(serviceID).Config.HTTPClient; sessionClient != nil {
+			*httpClient = *sessionClient
+		}
+		httpClient.Timeout = clientConfig.RequestTimeout
+		cfg = cfg.WithHTTPClient(httpClient)
+	}
+	return cfg
+}
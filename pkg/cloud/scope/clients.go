@@ -17,6 +17,9 @@ limitations under the License.
 package scope
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -24,6 +27,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/aws/aws-sdk-go/service/elb"
@@ -32,10 +37,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator/globalacceleratoriface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
@@ -48,7 +59,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	awslogs "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/logs"
 	awsmetrics "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/metrics"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
@@ -56,10 +69,14 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/version"
 )
 
+// GlobalAcceleratorRegion is the region serving the Global Accelerator API.
+const GlobalAcceleratorRegion = "us-west-2"
+
 // NewASGClient creates a new ASG API client for a given session.
 func NewASGClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) autoscalingiface.AutoScalingAPI {
-	asgClient := autoscaling.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
+	asgClient := autoscaling.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, autoscaling.EndpointsID))
 	asgClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	asgClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	asgClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 	asgClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
 
@@ -68,8 +85,9 @@ func NewASGClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 
 // NewEC2Client creates a new EC2 API client for a given session.
 func NewEC2Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) ec2iface.EC2API {
-	ec2Client := ec2.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
+	ec2Client := ec2.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, ec2.EndpointsID))
 	ec2Client.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	ec2Client.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	if session.ServiceLimiter(ec2.ServiceID) != nil {
 		ec2Client.Handlers.Sign.PushFront(session.ServiceLimiter(ec2.ServiceID).LimitRequest)
 	}
@@ -84,8 +102,9 @@ func NewEC2Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 
 // NewELBClient creates a new ELB API client for a given session.
 func NewELBClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) elbiface.ELBAPI {
-	elbClient := elb.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
+	elbClient := elb.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, elb.EndpointsID))
 	elbClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	elbClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	elbClient.Handlers.Sign.PushFront(session.ServiceLimiter(elb.ServiceID).LimitRequest)
 	elbClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 	elbClient.Handlers.CompleteAttempt.PushFront(session.ServiceLimiter(elb.ServiceID).ReviewResponse)
@@ -96,8 +115,9 @@ func NewELBClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 
 // NewELBv2Client creates a new ELB v2 API client for a given session.
 func NewELBv2Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) elbv2iface.ELBV2API {
-	elbClient := elbv2.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
+	elbClient := elbv2.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, elbv2.EndpointsID))
 	elbClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	elbClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	elbClient.Handlers.Sign.PushFront(session.ServiceLimiter(elbv2.ServiceID).LimitRequest)
 	elbClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 	elbClient.Handlers.CompleteAttempt.PushFront(session.ServiceLimiter(elbv2.ServiceID).ReviewResponse)
@@ -106,10 +126,23 @@ func NewELBv2Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger lo
 	return elbClient
 }
 
+// NewGlobalAcceleratorClient creates a new Global Accelerator API client for a given session.
+// The Global Accelerator API is only available in the us-west-2 region, whatever the region of the session.
+func NewGlobalAcceleratorClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) globalacceleratoriface.GlobalAcceleratorAPI {
+	gaClient := globalaccelerator.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithRegion(GlobalAcceleratorRegion).WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, globalaccelerator.EndpointsID))
+	gaClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	gaClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
+	gaClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
+	gaClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
+
+	return gaClient
+}
+
 // NewEventBridgeClient creates a new EventBridge API client for a given session.
 func NewEventBridgeClient(scopeUser cloud.ScopeUsage, session cloud.Session, target runtime.Object) eventbridgeiface.EventBridgeAPI {
-	eventBridgeClient := eventbridge.New(session.Session())
+	eventBridgeClient := eventbridge.New(session.Session(), withServiceClientConfig(aws.NewConfig(), session, eventbridge.EndpointsID))
 	eventBridgeClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	eventBridgeClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	eventBridgeClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 	eventBridgeClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
 
@@ -118,8 +151,9 @@ func NewEventBridgeClient(scopeUser cloud.ScopeUsage, session cloud.Session, tar
 
 // NewSQSClient creates a new SQS API client for a given session.
 func NewSQSClient(scopeUser cloud.ScopeUsage, session cloud.Session, target runtime.Object) sqsiface.SQSAPI {
-	SQSClient := sqs.New(session.Session())
+	SQSClient := sqs.New(session.Session(), withServiceClientConfig(aws.NewConfig(), session, sqs.EndpointsID))
 	SQSClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	SQSClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	SQSClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 	SQSClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
 
@@ -128,7 +162,7 @@ func NewSQSClient(scopeUser cloud.ScopeUsage, session cloud.Session, target runt
 
 // NewGlobalSQSClient for creating a new SQS API client that isn't tied to a cluster.
 func NewGlobalSQSClient(scopeUser cloud.ScopeUsage, session cloud.Session) sqsiface.SQSAPI {
-	SQSClient := sqs.New(session.Session())
+	SQSClient := sqs.New(session.Session(), withServiceClientConfig(aws.NewConfig(), session, sqs.EndpointsID))
 	SQSClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
 	SQSClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 
@@ -137,8 +171,9 @@ func NewGlobalSQSClient(scopeUser cloud.ScopeUsage, session cloud.Session) sqsif
 
 // NewResourgeTaggingClient creates a new Resource Tagging API client for a given session.
 func NewResourgeTaggingClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI {
-	resourceTagging := resourcegroupstaggingapi.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
+	resourceTagging := resourcegroupstaggingapi.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, resourcegroupstaggingapi.EndpointsID))
 	resourceTagging.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	resourceTagging.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	resourceTagging.Handlers.Sign.PushFront(session.ServiceLimiter(resourceTagging.ServiceID).LimitRequest)
 	resourceTagging.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 	resourceTagging.Handlers.CompleteAttempt.PushFront(session.ServiceLimiter(resourceTagging.ServiceID).ReviewResponse)
@@ -149,8 +184,9 @@ func NewResourgeTaggingClient(scopeUser cloud.ScopeUsage, session cloud.Session,
 
 // NewSecretsManagerClient creates a new Secrets API client for a given session..
 func NewSecretsManagerClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) secretsmanageriface.SecretsManagerAPI {
-	secretsClient := secretsmanager.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
+	secretsClient := secretsmanager.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, secretsmanager.EndpointsID))
 	secretsClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	secretsClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	secretsClient.Handlers.Sign.PushFront(session.ServiceLimiter(secretsClient.ServiceID).LimitRequest)
 	secretsClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 	secretsClient.Handlers.CompleteAttempt.PushFront(session.ServiceLimiter(secretsClient.ServiceID).ReviewResponse)
@@ -161,8 +197,9 @@ func NewSecretsManagerClient(scopeUser cloud.ScopeUsage, session cloud.Session,
 
 // NewEKSClient creates a new EKS API client for a given session.
 func NewEKSClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) eksiface.EKSAPI {
-	eksClient := eks.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
+	eksClient := eks.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, eks.EndpointsID))
 	eksClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	eksClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	eksClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 	eksClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
 
@@ -171,8 +208,9 @@ func NewEKSClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 
 // NewIAMClient creates a new IAM API client for a given session.
 func NewIAMClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) iamiface.IAMAPI {
-	iamClient := iam.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
+	iamClient := iam.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, iam.EndpointsID))
 	iamClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	iamClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	iamClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 	iamClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
 
@@ -181,8 +219,9 @@ func NewIAMClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 
 // NewSTSClient creates a new STS API client for a given session.
 func NewSTSClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) stsiface.STSAPI {
-	stsClient := sts.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
+	stsClient := sts.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, sts.EndpointsID))
 	stsClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	stsClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	stsClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 	stsClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
 
@@ -191,8 +230,9 @@ func NewSTSClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 
 // NewSSMClient creates a new Secrets API client for a given session.
 func NewSSMClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) ssmiface.SSMAPI {
-	ssmClient := ssm.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
+	ssmClient := ssm.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, ssm.EndpointsID))
 	ssmClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	ssmClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	ssmClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 	ssmClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
 
@@ -200,15 +240,54 @@ func NewSSMClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 }
 
 // NewS3Client creates a new S3 API client for a given session.
-func NewS3Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) s3iface.S3API {
-	s3Client := s3.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
+// If region is not empty, the client is built for that region instead of the region of the session.
+func NewS3Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object, region string) s3iface.S3API {
+	cfg := aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger()))
+	if region != "" {
+		cfg = cfg.WithRegion(region)
+	}
+	s3Client := s3.New(session.Session(), withServiceClientConfig(cfg, session, s3.EndpointsID))
 	s3Client.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	s3Client.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
 	s3Client.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
 	s3Client.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
 
 	return s3Client
 }
 
+// NewEFSClient creates a new EFS API client for a given session.
+func NewEFSClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) efsiface.EFSAPI {
+	efsClient := efs.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, efs.EndpointsID))
+	efsClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	efsClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
+	efsClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
+	efsClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
+
+	return efsClient
+}
+
+// NewKMSClient creates a new KMS API client for a given session.
+func NewKMSClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) kmsiface.KMSAPI {
+	kmsClient := kms.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, kms.EndpointsID))
+	kmsClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	kmsClient.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
+	kmsClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
+	kmsClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
+
+	return kmsClient
+}
+
+// NewRoute53Client creates a new Route53 API client for a given session.
+func NewRoute53Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) route53iface.Route53API {
+	route53Client := route53.New(session.Session(), withServiceClientConfig(aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())), session, route53.EndpointsID))
+	route53Client.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	route53Client.Handlers.Validate.PushBackNamed(detectOnlyHandler(scopeUser, target))
+	route53Client.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
+	route53Client.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
+
+	return route53Client
+}
+
 func recordAWSPermissionsIssue(target runtime.Object) func(r *request.Request) {
 	return func(r *request.Request) {
 		if awsErr, ok := r.Error.(awserr.Error); ok {
@@ -220,6 +299,66 @@ func recordAWSPermissionsIssue(target runtime.Object) func(r *request.Request) {
 	}
 }
 
+// detectOnlyHandler skips the operations changing AWS resources when the scope using the client is a cluster scope
+// in the detectOnly reconcile mode, records them in the scope, and records an event for each of them. The operations
+// creating or deleting a resource fail, as the reconciliation of the resource goes on with their output or waits
+// for them to complete. The other operations, changing the tags, attributes or rules of a resource, complete
+// without being sent, so the drift of the next resources is still detected.
+func detectOnlyHandler(scopeUser cloud.ScopeUsage, target runtime.Object) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "capa/detect-only",
+		Fn: func(r *request.Request) {
+			if r.Error != nil || isReadOnlyOperation(r.Operation.Name) {
+				return
+			}
+			clusterScoper, ok := scopeUser.(cloud.ClusterScoper)
+			if !ok || clusterScoper.ReconcileMode() != infrav1.ReconcileModeDetectOnly {
+				return
+			}
+
+			operation := fmt.Sprintf("%s %s", r.ClientInfo.ServiceName, r.Operation.Name)
+			clusterScoper.RecordDrift(operation)
+			record.Eventf(target, "DriftDetected", "Skipped %s in the detectOnly reconcile mode", operation)
+
+			if createsOrDeletesResource(r.Operation.Name) {
+				r.Error = awserr.New(awserrors.DetectOnly, fmt.Sprintf("%s is not called in the detectOnly reconcile mode", operation), nil)
+				return
+			}
+			// The output of the operation is left empty.
+			r.Handlers.Sign.Clear()
+			r.Handlers.Send.Clear()
+			r.Handlers.UnmarshalMeta.Clear()
+			r.Handlers.ValidateResponse.Clear()
+			r.Handlers.Unmarshal.Clear()
+			r.Handlers.CompleteAttempt.Clear()
+		},
+	}
+}
+
+// isReadOnlyOperation returns whether an operation of an AWS API only reads resources, going by the prefix of its name.
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range []string{"Describe", "Get", "List", "Head", "Lookup", "Search"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// createsOrDeletesResource returns whether an operation of an AWS API creates or deletes a resource, rather than
+// changing the tags of a resource, going by its name.
+func createsOrDeletesResource(name string) bool {
+	if strings.Contains(name, "Tag") {
+		return false
+	}
+	for _, prefix := range []string{"Create", "Allocate", "Run", "Request", "Import", "Copy", "Associate", "Start", "Delete", "Terminate", "Release"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func getUserAgentHandler() request.NamedHandler {
 	return request.NamedHandler{
 		Name: "capa/user-agent",
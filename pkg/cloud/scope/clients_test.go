@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsclient "github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/iam"
+	. "github.com/onsi/gomega"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/throttle"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+)
+
+type testSession struct {
+	session *session.Session
+}
+
+func (s *testSession) Session() awsclient.ConfigProvider {
+	return s.session
+}
+
+func (s *testSession) ServiceLimiter(_ string) *throttle.ServiceLimiter {
+	return nil
+}
+
+type testScopeUsage struct{}
+
+func (testScopeUsage) ControllerName() string {
+	return "test"
+}
+
+func TestServiceClientConfigs(t *testing.T) {
+	g := NewWithT(t)
+
+	sessionHTTPClient := &http.Client{}
+	ns, err := session.NewSession(&aws.Config{
+		Region:     aws.String("us-east-1"),
+		HTTPClient: sessionHTTPClient,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	sess := &testSession{session: ns}
+	log := logger.NewLogger(klog.Background())
+
+	SetServiceClientConfigs([]ServiceClientConfig{
+		{
+			ServiceID:      eks.EndpointsID,
+			MaxRetries:     ptr.To(10),
+			RequestTimeout: 20 * time.Minute,
+		},
+		{
+			ServiceID:  ec2.EndpointsID,
+			MaxRetries: ptr.To(0),
+		},
+	})
+	defer SetServiceClientConfigs(nil)
+
+	eksClient := NewEKSClient(testScopeUsage{}, sess, log, &infrav1.AWSCluster{}).(*eks.EKS)
+	g.Expect(eksClient.Retryer.MaxRetries()).To(Equal(10))
+	g.Expect(eksClient.Config.HTTPClient.Timeout).To(Equal(20 * time.Minute))
+
+	ec2Client := NewEC2Client(testScopeUsage{}, sess, log, &infrav1.AWSCluster{}).(*ec2.EC2)
+	g.Expect(ec2Client.Retryer.MaxRetries()).To(Equal(0))
+	g.Expect(ec2Client.Config.HTTPClient).To(BeIdenticalTo(sessionHTTPClient))
+
+	// Clients of unconfigured services keep the defaults.
+	iamClient := NewIAMClient(testScopeUsage{}, sess, log, &infrav1.AWSCluster{}).(*iam.IAM)
+	g.Expect(iamClient.Retryer.MaxRetries()).To(Equal(awsclient.DefaultRetryerMaxNumRetries))
+	g.Expect(iamClient.Config.HTTPClient).To(BeIdenticalTo(sessionHTTPClient))
+
+	// The timeout of a service does not leak into the HTTP client of the session.
+	g.Expect(sessionHTTPClient.Timeout).To(BeZero())
+}
+
+func TestDetectOnlyReconcileModeSkipsMutatingRequests(t *testing.T) {
+	g := NewWithT(t)
+
+	ns, err := session.NewSession(&aws.Config{Region: aws.String("us-east-1")})
+	g.Expect(err).NotTo(HaveOccurred())
+	sess := &testSession{session: ns}
+	log := logger.NewLogger(klog.Background())
+
+	awsCluster := &infrav1.AWSCluster{Spec: infrav1.AWSClusterSpec{ReconcileMode: infrav1.ReconcileModeDetectOnly}}
+	clusterScope := &ClusterScope{AWSCluster: awsCluster, drift: newDriftRecorder()}
+	detectOnlyClient := NewEC2Client(clusterScope, sess, log, awsCluster).(*ec2.EC2)
+
+	// The operations creating resources fail.
+	r, _ := detectOnlyClient.CreateVpcRequest(&ec2.CreateVpcInput{CidrBlock: aws.String("10.0.0.0/16")})
+	g.Expect(r.Send()).To(HaveOccurred())
+	g.Expect(awserrors.IsDetectOnly(r.Error)).To(BeTrue())
+
+	// The other operations complete without being sent.
+	r, _ = detectOnlyClient.ModifyVpcAttributeRequest(&ec2.ModifyVpcAttributeInput{VpcId: aws.String("vpc-1"), EnableDnsSupport: &ec2.AttributeBooleanValue{Value: aws.Bool(true)}})
+	g.Expect(r.Send()).To(Succeed())
+	r, _ = detectOnlyClient.CreateTagsRequest(&ec2.CreateTagsInput{Resources: aws.StringSlice([]string{"vpc-1"}), Tags: []*ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}})
+	g.Expect(r.Send()).To(Succeed())
+
+	// Read-only operations are still sent in the detectOnly reconcile mode.
+	r, _ = detectOnlyClient.DescribeVpcsRequest(&ec2.DescribeVpcsInput{})
+	g.Expect(r.Build()).To(Succeed())
+
+	g.Expect(clusterScope.DriftedOperations()).To(Equal([]string{"ec2 CreateVpc", "ec2 ModifyVpcAttribute", "ec2 CreateTags"}))
+
+	// The mode of a managed control plane is resolved the same way.
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{ReconcileMode: infrav1.ReconcileModeDetectOnly}}
+	managedScope := &ManagedControlPlaneScope{ControlPlane: controlPlane, drift: newDriftRecorder()}
+	eksClient := NewEKSClient(managedScope, sess, log, controlPlane).(*eks.EKS)
+	r, _ = eksClient.CreateClusterRequest(&eks.CreateClusterInput{Name: aws.String("cluster"), RoleArn: aws.String("arn:aws:iam::123456789012:role/eks"), ResourcesVpcConfig: &eks.VpcConfigRequest{}})
+	g.Expect(r.Build()).To(HaveOccurred())
+	g.Expect(awserrors.IsDetectOnly(r.Error)).To(BeTrue())
+	g.Expect(managedScope.DriftedOperations()).To(Equal([]string{"eks CreateCluster"}))
+
+	fullScope := &ClusterScope{AWSCluster: &infrav1.AWSCluster{Spec: infrav1.AWSClusterSpec{ReconcileMode: infrav1.ReconcileModeFull}}, drift: newDriftRecorder()}
+	fullClient := NewEC2Client(fullScope, sess, log, fullScope.AWSCluster).(*ec2.EC2)
+	r, _ = fullClient.CreateVpcRequest(&ec2.CreateVpcInput{CidrBlock: aws.String("10.0.0.0/16")})
+	g.Expect(r.Build()).To(Succeed())
+	g.Expect(fullScope.DriftedOperations()).To(BeEmpty())
+}
@@ -19,6 +19,7 @@ package scope
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	awsclient "github.com/aws/aws-sdk-go/aws/client"
 	"github.com/pkg/errors"
@@ -45,6 +46,7 @@ type ClusterScopeParams struct {
 	Endpoints                    []ServiceEndpoint
 	Session                      awsclient.ConfigProvider
 	TagUnmanagedNetworkResources bool
+	UseBlockingWaiters           bool
 }
 
 // NewClusterScope creates a new Scope from the supplied parameters.
@@ -69,6 +71,18 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		AWSCluster:                   params.AWSCluster,
 		controllerName:               params.ControllerName,
 		tagUnmanagedNetworkResources: params.TagUnmanagedNetworkResources,
+		useBlockingWaiters:           params.UseBlockingWaiters,
+		drift:                        newDriftRecorder(),
+		tagDrift:                     newDriftRecorder(),
+	}
+
+	if params.AWSCluster.Spec.IdentityRef == nil && params.AWSCluster.Spec.IdentitySelector != nil {
+		identityRef, err := identityRefForSelector(context.Background(), params.Client, params.AWSCluster.Spec.IdentitySelector)
+		if err != nil {
+			conditions.MarkFalse(params.AWSCluster, infrav1.PrincipalCredentialRetrievedCondition, infrav1.IdentitySelectionFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			return nil, errors.Wrap(err, "failed to select identity")
+		}
+		clusterScope.selectedIdentityRef = identityRef
 	}
 
 	session, serviceLimiters, err := sessionForClusterWithRegion(params.Client, clusterScope, params.AWSCluster.Spec.Region, params.Endpoints, params.Logger)
@@ -102,6 +116,13 @@ type ClusterScope struct {
 	controllerName  string
 
 	tagUnmanagedNetworkResources bool
+	useBlockingWaiters           bool
+
+	// selectedIdentityRef is the identity matching the identity selector of the AWSCluster, if any.
+	selectedIdentityRef *infrav1.AWSIdentityReference
+
+	drift    *driftRecorder
+	tagDrift *driftRecorder
 }
 
 // Network returns the cluster network object.
@@ -119,9 +140,42 @@ func (s *ClusterScope) Subnets() infrav1.Subnets {
 	return s.AWSCluster.Spec.NetworkSpec.Subnets
 }
 
-// IdentityRef returns the cluster identityRef.
+// SubnetCidrSizes returns the requested sizes of the default subnets.
+func (s *ClusterScope) SubnetCidrSizes() *infrav1.SubnetCidrSizes {
+	return s.AWSCluster.Spec.NetworkSpec.SubnetCidrSizes
+}
+
+// AdditionalRoutes returns the additional routes to add to the managed private route tables.
+func (s *ClusterScope) AdditionalRoutes() []infrav1.RouteSpec {
+	return s.AWSCluster.Spec.NetworkSpec.AdditionalRoutes
+}
+
+// TransitGatewayAttachment returns the transit gateway attachment of the VPC, if any.
+func (s *ClusterScope) TransitGatewayAttachment() *infrav1.TransitGatewayAttachmentSpec {
+	return s.AWSCluster.Spec.NetworkSpec.TransitGatewayAttachment
+}
+
+// VPCPeerings returns the peering connections of the VPC.
+func (s *ClusterScope) VPCPeerings() []infrav1.VPCPeeringSpec {
+	return s.AWSCluster.Spec.NetworkSpec.VPCPeerings
+}
+
+// NetworkACL returns the network ACL of the managed subnets, if any.
+func (s *ClusterScope) NetworkACL() *infrav1.NetworkACLSpec {
+	return s.AWSCluster.Spec.NetworkSpec.NetworkACL
+}
+
+// SubnetAvailableIPAddressThreshold returns the number of available IP addresses below which a subnet is low on IP addresses, if any.
+func (s *ClusterScope) SubnetAvailableIPAddressThreshold() *int64 {
+	return s.AWSCluster.Spec.NetworkSpec.SubnetAvailableIPAddressThreshold
+}
+
+// IdentityRef returns the cluster identityRef, or the identity matching the cluster identitySelector if identityRef isn't set.
 func (s *ClusterScope) IdentityRef() *infrav1.AWSIdentityReference {
-	return s.AWSCluster.Spec.IdentityRef
+	if s.AWSCluster.Spec.IdentityRef != nil {
+		return s.AWSCluster.Spec.IdentityRef
+	}
+	return s.selectedIdentityRef
 }
 
 // SetSubnets updates the clusters subnets.
@@ -142,6 +196,31 @@ func (s *ClusterScope) SecurityGroupOverrides() map[infrav1.SecurityGroupRole]st
 	return s.AWSCluster.Spec.NetworkSpec.SecurityGroupOverrides
 }
 
+// SecurityGroupReconcileStrategies returns the cluster security group reconcile strategies.
+func (s *ClusterScope) SecurityGroupReconcileStrategies() map[infrav1.SecurityGroupRole]infrav1.SecurityGroupRoleReconcileStrategy {
+	return s.AWSCluster.Spec.NetworkSpec.SecurityGroupReconcileStrategies
+}
+
+// KarpenterDiscovery returns the configuration of the karpenter.sh/discovery tag, if any.
+func (s *ClusterScope) KarpenterDiscovery() *infrav1.KarpenterDiscoverySpec {
+	return s.AWSCluster.Spec.NetworkSpec.KarpenterDiscovery
+}
+
+// NatGatewayStrategy returns how many NAT gateways are created for the private subnets.
+func (s *ClusterScope) NatGatewayStrategy() infrav1.NatGatewayStrategy {
+	return s.AWSCluster.Spec.NetworkSpec.NatGatewayStrategy
+}
+
+// FlowLogs returns the configuration of the flow log of the VPC, if any.
+func (s *ClusterScope) FlowLogs() *infrav1.FlowLogsSpec {
+	return s.AWSCluster.Spec.NetworkSpec.FlowLogs
+}
+
+// SecurityGroupEgressRules returns the cluster security group egress rules.
+func (s *ClusterScope) SecurityGroupEgressRules() map[infrav1.SecurityGroupRole]infrav1.EgressRules {
+	return s.AWSCluster.Spec.NetworkSpec.SecurityGroupEgressRules
+}
+
 // SecurityGroups returns the cluster security groups as a map, it creates the map if empty.
 func (s *ClusterScope) SecurityGroups() map[infrav1.SecurityGroupRole]infrav1.SecurityGroup {
 	return s.AWSCluster.Status.Network.SecurityGroups
@@ -202,10 +281,87 @@ func (s *ClusterScope) ControlPlaneEndpoint() clusterv1.APIEndpoint {
 	return s.AWSCluster.Spec.ControlPlaneEndpoint
 }
 
+// GlobalAcceleratorEndpointGroupARN returns the ARN of the Global Accelerator endpoint group to register the control plane with.
+func (s *ClusterScope) GlobalAcceleratorEndpointGroupARN() string {
+	return s.AWSCluster.Spec.GlobalAcceleratorEndpointGroupARN
+}
+
 func (s *ClusterScope) Bucket() *infrav1.S3Bucket {
 	return s.AWSCluster.Spec.S3Bucket
 }
 
+// EFS returns the EFS file system configuration of the cluster.
+func (s *ClusterScope) EFS() *infrav1.EFSSpec {
+	return s.AWSCluster.Spec.EFS
+}
+
+// EFSFileSystemID returns the ID of the EFS file system recorded in the cluster status.
+func (s *ClusterScope) EFSFileSystemID() string {
+	if s.AWSCluster.Status.EFS == nil {
+		return ""
+	}
+	return s.AWSCluster.Status.EFS.FileSystemID
+}
+
+// EBSEncryptionKeyARN returns the ARN of the KMS key used to encrypt the encrypted EBS volumes which don't set their
+// own key, either the cross-account key of the spec or the managed key of the cluster.
+func (s *ClusterScope) EBSEncryptionKeyARN() string {
+	if s.AWSCluster.Spec.EBSEncryptionKeyARN != "" {
+		return s.AWSCluster.Spec.EBSEncryptionKeyARN
+	}
+	return s.AWSCluster.Status.ManagedEncryptionKeyARN
+}
+
+// ManagedEncryptionKey returns whether CAPA manages a KMS key dedicated to the cluster.
+func (s *ClusterScope) ManagedEncryptionKey() bool {
+	return s.AWSCluster.Spec.ManagedEncryptionKey
+}
+
+// ManagedEncryptionKeyARN returns the ARN of the managed KMS key recorded in the cluster status.
+func (s *ClusterScope) ManagedEncryptionKeyARN() string {
+	return s.AWSCluster.Status.ManagedEncryptionKeyARN
+}
+
+// SetManagedEncryptionKeyARN records the ARN of the managed KMS key in the cluster status, an empty ARN clears it.
+func (s *ClusterScope) SetManagedEncryptionKeyARN(arn string) {
+	s.AWSCluster.Status.ManagedEncryptionKeyARN = arn
+}
+
+// EBSEncryptionKeyGrantID returns the ID of the KMS grant recorded in the cluster status.
+func (s *ClusterScope) EBSEncryptionKeyGrantID() string {
+	return s.AWSCluster.Status.EBSEncryptionKeyGrantID
+}
+
+// SetEBSEncryptionKeyGrantID records the ID of the KMS grant in the cluster status, an empty ID clears it.
+func (s *ClusterScope) SetEBSEncryptionKeyGrantID(id string) {
+	s.AWSCluster.Status.EBSEncryptionKeyGrantID = id
+}
+
+// EBSEncryptionKeyEC2GrantID returns the ID of the KMS grant of the controller identity recorded in the cluster status.
+func (s *ClusterScope) EBSEncryptionKeyEC2GrantID() string {
+	return s.AWSCluster.Status.EBSEncryptionKeyEC2GrantID
+}
+
+// SetEBSEncryptionKeyEC2GrantID records the ID of the KMS grant of the controller identity in the cluster status, an
+// empty ID clears it.
+func (s *ClusterScope) SetEBSEncryptionKeyEC2GrantID(id string) {
+	s.AWSCluster.Status.EBSEncryptionKeyEC2GrantID = id
+}
+
+// PublicDNS returns the Route53 record of the control plane endpoint, if any.
+func (s *ClusterScope) PublicDNS() *infrav1.PublicDNS {
+	return s.AWSCluster.Spec.PublicDNS
+}
+
+// SetEFSFileSystemID records the ID of the EFS file system in the cluster status, an empty ID clears it.
+func (s *ClusterScope) SetEFSFileSystemID(id string) {
+	if id == "" {
+		s.AWSCluster.Status.EFS = nil
+		return
+	}
+	s.AWSCluster.Status.EFS = &infrav1.EFSStatus{FileSystemID: id}
+}
+
 // ControlPlaneConfigMapName returns the name of the ConfigMap used to
 // coordinate the bootstrapping of control plane nodes.
 func (s *ClusterScope) ControlPlaneConfigMapName() string {
@@ -244,6 +400,22 @@ func (s *ClusterScope) PatchObject() error {
 		if s.VPC().IsIPv6Enabled() {
 			applicableConditions = append(applicableConditions, infrav1.EgressOnlyInternetGatewayReadyCondition)
 		}
+		if s.TransitGatewayAttachment() != nil {
+			applicableConditions = append(applicableConditions, infrav1.TransitGatewayAttachmentReadyCondition)
+		}
+		if len(s.VPCPeerings()) > 0 {
+			applicableConditions = append(applicableConditions, infrav1.VpcPeeringsReadyCondition)
+		}
+		if s.NetworkACL() != nil {
+			applicableConditions = append(applicableConditions, infrav1.NetworkACLReadyCondition)
+		}
+		if s.FlowLogs() != nil {
+			applicableConditions = append(applicableConditions, infrav1.FlowLogsReadyCondition)
+		}
+	}
+
+	if s.InstanceConnectEndpoint() != nil {
+		applicableConditions = append(applicableConditions, infrav1.InstanceConnectEndpointReadyCondition)
 	}
 
 	conditions.SetSummary(s.AWSCluster,
@@ -264,9 +436,17 @@ func (s *ClusterScope) PatchObject() error {
 			infrav1.NatGatewaysReadyCondition,
 			infrav1.RouteTablesReadyCondition,
 			infrav1.VpcEndpointsReadyCondition,
+			infrav1.TransitGatewayAttachmentReadyCondition,
+			infrav1.VpcPeeringsReadyCondition,
+			infrav1.NetworkACLReadyCondition,
+			infrav1.FlowLogsReadyCondition,
 			infrav1.ClusterSecurityGroupsReadyCondition,
 			infrav1.BastionHostReadyCondition,
 			infrav1.LoadBalancerReadyCondition,
+			infrav1.NetworkPausedCondition,
+			infrav1.LoadBalancerPausedCondition,
+			infrav1.ResourcesInSyncCondition,
+			infrav1.ResourceTagsSyncedCondition,
 			infrav1.PrincipalUsageAllowedCondition,
 			infrav1.PrincipalCredentialRetrievedCondition,
 		}})
@@ -288,6 +468,9 @@ func (s *ClusterScope) AdditionalTags() infrav1.Tags {
 
 // APIServerPort returns the APIServerPort to use when creating the load balancer.
 func (s *ClusterScope) APIServerPort() int32 {
+	if s.ControlPlaneLoadBalancer() != nil && s.ControlPlaneLoadBalancer().Port != nil {
+		return int32(*s.ControlPlaneLoadBalancer().Port)
+	}
 	if s.Cluster.Spec.ClusterNetwork != nil && s.Cluster.Spec.ClusterNetwork.APIServerPort != nil {
 		return *s.Cluster.Spec.ClusterNetwork.APIServerPort
 	}
@@ -340,11 +523,39 @@ func (s *ClusterScope) Bastion() *infrav1.Bastion {
 	return &s.AWSCluster.Spec.Bastion
 }
 
+// InstanceConnectEndpoint returns the EC2 Instance Connect Endpoint details, if any.
+func (s *ClusterScope) InstanceConnectEndpoint() *infrav1.InstanceConnectEndpointSpec {
+	return s.AWSCluster.Spec.NetworkSpec.InstanceConnectEndpoint
+}
+
 // TagUnmanagedNetworkResources returns if the feature flag tag unmanaged network resources is set.
 func (s *ClusterScope) TagUnmanagedNetworkResources() bool {
 	return s.tagUnmanagedNetworkResources
 }
 
+// TagReconcilePolicy returns the policy applied to the tags of the network, security group and load balancer
+// resources of the cluster changed outside of Cluster API.
+func (s *ClusterScope) TagReconcilePolicy() infrav1.TagReconcilePolicy {
+	return infrav1.GetTagReconcilePolicy(s.AWSCluster.GetAnnotations())
+}
+
+// RecordTagDrift records the tags of a resource that were removed or changed outside of Cluster API and are not
+// re-applied.
+func (s *ClusterScope) RecordTagDrift(resourceID string, keys []string) {
+	s.tagDrift.record(fmt.Sprintf("%s (%s)", resourceID, strings.Join(keys, ", ")))
+}
+
+// TagDrift returns the resources and tags recorded by RecordTagDrift.
+func (s *ClusterScope) TagDrift() []string {
+	return s.tagDrift.recorded()
+}
+
+// UseBlockingWaiters returns if the long-running AWS operations block the reconcile until they are done, instead of
+// being polled on the next reconciles.
+func (s *ClusterScope) UseBlockingWaiters() bool {
+	return s.useBlockingWaiters
+}
+
 // SetBastionInstance sets the bastion instance in the status of the cluster.
 func (s *ClusterScope) SetBastionInstance(instance *infrav1.Instance) {
 	s.AWSCluster.Status.Bastion = instance
@@ -376,6 +587,42 @@ func (s *ClusterScope) ImageLookupBaseOS() string {
 	return s.AWSCluster.Spec.ImageLookupBaseOS
 }
 
+// MachineLabelToTag returns the mapping of Machine label keys to the instance tag keys they are propagated to.
+func (s *ClusterScope) MachineLabelToTag() map[string]string {
+	return s.AWSCluster.Spec.MachineLabelToTag
+}
+
+// InstanceNameTemplate returns the template rendering the Name tag of the instances, empty if not set.
+func (s *ClusterScope) InstanceNameTemplate() string {
+	return s.AWSCluster.Spec.InstanceNameTemplate
+}
+
+// NodeNameFromPrivateDNSName returns whether the kubeadm node name of the instances is set to their private DNS name.
+func (s *ClusterScope) NodeNameFromPrivateDNSName() bool {
+	return s.AWSCluster.Spec.NodeNameFromPrivateDNSName
+}
+
+// OnExternalInstanceDeletion returns what happens by default when the instance of a machine is deleted outside of
+// Cluster API, empty if not set.
+func (s *ClusterScope) OnExternalInstanceDeletion() infrav1.OnExternalInstanceDeletion {
+	return s.AWSCluster.Spec.OnExternalInstanceDeletion
+}
+
+// ReconcileMode returns how the AWS resources of the cluster are reconciled.
+func (s *ClusterScope) ReconcileMode() infrav1.ReconcileMode {
+	return s.AWSCluster.Spec.ReconcileMode
+}
+
+// RecordDrift records an operation changing an AWS resource that was skipped in the detectOnly reconcile mode.
+func (s *ClusterScope) RecordDrift(operation string) {
+	s.drift.record(operation)
+}
+
+// DriftedOperations returns the operations recorded by RecordDrift.
+func (s *ClusterScope) DriftedOperations() []string {
+	return s.drift.recorded()
+}
+
 // Partition returns the cluster partition.
 func (s *ClusterScope) Partition() string {
 	if s.AWSCluster.Spec.Partition == "" {
@@ -384,7 +631,17 @@ func (s *ClusterScope) Partition() string {
 	return s.AWSCluster.Spec.Partition
 }
 
+// DNSSuffix returns the DNS suffix of the endpoints of the AWS services in the partition of the cluster.
+func (s *ClusterScope) DNSSuffix() string {
+	return system.GetDNSSuffixFromPartition(s.Partition())
+}
+
 // AdditionalControlPlaneIngressRules returns the additional ingress rules for control plane security group.
 func (s *ClusterScope) AdditionalControlPlaneIngressRules() []infrav1.IngressRule {
 	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().AdditionalControlPlaneIngressRules
 }
+
+// PodCIDRBlocks returns the CIDR blocks used by the CNI for pod IPs.
+func (s *ClusterScope) PodCIDRBlocks() []string {
+	return s.AWSCluster.Spec.PodCIDRBlocks
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// driftRecorder records the operations changing AWS resources that were skipped in the detectOnly reconcile mode.
+// It is shared by the copies of a scope, which the services of a cluster are given.
+type driftRecorder struct {
+	lock       sync.Mutex
+	operations []string
+}
+
+func newDriftRecorder() *driftRecorder {
+	return &driftRecorder{}
+}
+
+func (d *driftRecorder) record(operation string) {
+	if d == nil {
+		return
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.operations = append(d.operations, operation)
+}
+
+func (d *driftRecorder) recorded() []string {
+	if d == nil {
+		return nil
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return append([]string{}, d.operations...)
+}
+
+// TagDriftReporter reports the tags of the resources of a cluster that were removed or changed outside of Cluster
+// API and are not re-applied.
+type TagDriftReporter interface {
+	InfraCluster() cloud.ClusterObject
+	TagDrift() []string
+}
+
+// MarkResourceTagsSynced sets the ResourceTagsSynced condition of the infrastructure cluster from the tag drift
+// recorded while its resources were reconciled.
+func MarkResourceTagsSynced(s TagDriftReporter) {
+	if drifted := s.TagDrift(); len(drifted) > 0 {
+		conditions.MarkFalse(s.InfraCluster(), infrav1.ResourceTagsSyncedCondition, infrav1.ResourceTagsDriftedReason, clusterv1.ConditionSeverityWarning,
+			"Tags were removed or changed outside of Cluster API: %s", strings.Join(sets.List(sets.New(drifted...)), "; "))
+		return
+	}
+	conditions.MarkTrue(s.InfraCluster(), infrav1.ResourceTagsSyncedCondition)
+}
+
+// ResourceReconciler reconciles a group of AWS resources of a cluster.
+type ResourceReconciler struct {
+	Name      string
+	Reconcile func() error
+}
+
+// DetectDrift reconciles the groups of AWS resources of a cluster in the detectOnly reconcile mode, and reports the
+// operations changing them that were skipped by the ResourcesInSync condition of the infrastructure cluster. The
+// reconciliation of a group goes on after the skipped operations it doesn't depend on, and stops at the first one
+// creating or deleting a resource. All the groups are reconciled whatever the drift of the previous ones.
+func DetectDrift(clusterScope cloud.ClusterScoper, reconcilers []ResourceReconciler) error {
+	clusterScope.Info("Detecting the drift of the AWS resources of the cluster")
+
+	var drifted []string
+	var errs []error
+	for _, reconciler := range reconcilers {
+		recorded := len(clusterScope.DriftedOperations())
+		err := reconciler.Reconcile()
+		switch {
+		case err == nil, awserrors.IsDetectOnly(err):
+		case wait.IsPending(err):
+			clusterScope.Info("Waiting for resources to be available", "resources", reconciler.Name, "reason", err.Error())
+		default:
+			errs = append(errs, errors.Wrapf(err, "failed to detect the drift of the %s", reconciler.Name))
+		}
+		if operations := clusterScope.DriftedOperations()[recorded:]; len(operations) > 0 {
+			drifted = append(drifted, fmt.Sprintf("%s: %s", reconciler.Name, strings.Join(sets.List(sets.New(operations...)), ", ")))
+		}
+	}
+
+	infraCluster := clusterScope.InfraCluster()
+	switch {
+	case len(drifted) > 0:
+		conditions.MarkFalse(infraCluster, infrav1.ResourcesInSyncCondition, infrav1.ResourcesDriftedReason, clusterv1.ConditionSeverityWarning, "%s", strings.Join(drifted, "; "))
+	case len(errs) > 0:
+		conditions.MarkFalse(infraCluster, infrav1.ResourcesInSyncCondition, infrav1.DriftDetectionFailedReason, clusterv1.ConditionSeverityWarning, "%s", kerrors.NewAggregate(errs).Error())
+	default:
+		conditions.MarkTrue(infraCluster, infrav1.ResourcesInSyncCondition)
+	}
+
+	return kerrors.NewAggregate(errs)
+}
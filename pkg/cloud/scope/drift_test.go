@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestDetectDrift(t *testing.T) {
+	tests := []struct {
+		name            string
+		reconcilers     func(s *ManagedControlPlaneScope) []ResourceReconciler
+		expectErr       bool
+		expectedStatus  corev1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name: "marks the resources in sync when no operation was skipped",
+			reconcilers: func(_ *ManagedControlPlaneScope) []ResourceReconciler {
+				return []ResourceReconciler{{Name: "network", Reconcile: func() error { return nil }}}
+			},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name: "reports the skipped operations of all the groups",
+			reconcilers: func(s *ManagedControlPlaneScope) []ResourceReconciler {
+				return []ResourceReconciler{
+					{Name: "network", Reconcile: func() error {
+						s.RecordDrift("ec2 CreateTags")
+						s.RecordDrift("ec2 CreateNatGateway")
+						return fmt.Errorf("failed to create nat gateway: %w", awserr.New(awserrors.DetectOnly, "ec2 CreateNatGateway is not called in the detectOnly reconcile mode", nil))
+					}},
+					{Name: "bastion", Reconcile: func() error { return nil }},
+					{Name: "control plane", Reconcile: func() error {
+						s.RecordDrift("eks UpdateClusterConfig")
+						return nil
+					}},
+				}
+			},
+			expectedStatus:  corev1.ConditionFalse,
+			expectedReason:  infrav1.ResourcesDriftedReason,
+			expectedMessage: "network: ec2 CreateNatGateway, ec2 CreateTags; control plane: eks UpdateClusterConfig",
+		},
+		{
+			name: "reports the groups which could not be read",
+			reconcilers: func(_ *ManagedControlPlaneScope) []ResourceReconciler {
+				return []ResourceReconciler{
+					{Name: "network", Reconcile: func() error { return errors.New("access denied") }},
+					{Name: "bastion", Reconcile: func() error { return nil }},
+				}
+			},
+			expectErr:       true,
+			expectedStatus:  corev1.ConditionFalse,
+			expectedReason:  infrav1.DriftDetectionFailedReason,
+			expectedMessage: "failed to detect the drift of the network: access denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s := &ManagedControlPlaneScope{
+				Logger: *logger.NewLogger(klog.Background()),
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{ReconcileMode: infrav1.ReconcileModeDetectOnly},
+				},
+				drift: newDriftRecorder(),
+			}
+
+			err := DetectDrift(s, tt.reconcilers(s))
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+
+			condition := conditions.Get(s.ControlPlane, infrav1.ResourcesInSyncCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.expectedStatus))
+			g.Expect(condition.Reason).To(Equal(tt.expectedReason))
+			g.Expect(condition.Message).To(Equal(tt.expectedMessage))
+		})
+	}
+}
+
+func TestMarkResourceTagsSynced(t *testing.T) {
+	g := NewWithT(t)
+
+	s := &ManagedControlPlaneScope{
+		ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{},
+		tagDrift:     newDriftRecorder(),
+	}
+
+	MarkResourceTagsSynced(s)
+	g.Expect(conditions.IsTrue(s.ControlPlane, infrav1.ResourceTagsSyncedCondition)).To(BeTrue())
+
+	s.RecordTagDrift("vpc-1", []string{"Name", "team"})
+	s.RecordTagDrift("sg-1", []string{"team"})
+	MarkResourceTagsSynced(s)
+	condition := conditions.Get(s.ControlPlane, infrav1.ResourceTagsSyncedCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(infrav1.ResourceTagsDriftedReason))
+	g.Expect(condition.Message).To(Equal("Tags were removed or changed outside of Cluster API: sg-1 (team); vpc-1 (Name, team)"))
+}
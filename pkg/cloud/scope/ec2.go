@@ -40,6 +40,9 @@ type EC2Scope interface {
 	// Bastion returns the bastion details for the cluster.
 	Bastion() *infrav1.Bastion
 
+	// InstanceConnectEndpoint returns the EC2 Instance Connect Endpoint details for the cluster, if any.
+	InstanceConnectEndpoint() *infrav1.InstanceConnectEndpointSpec
+
 	// SetBastionInstance sets the bastion instance in the status of the cluster.
 	SetBastionInstance(instance *infrav1.Instance)
 
@@ -54,4 +57,27 @@ type EC2Scope interface {
 
 	// ImageLookupBaseOS returns the base operating system name to use when looking up AMIs
 	ImageLookupBaseOS() string
+
+	// MachineLabelToTag returns the mapping of Machine label keys to the instance tag keys they are propagated to.
+	MachineLabelToTag() map[string]string
+
+	// InstanceNameTemplate returns the template rendering the Name tag of the instances, empty if not set.
+	InstanceNameTemplate() string
+
+	// NodeNameFromPrivateDNSName returns whether the kubeadm node name of the instances is set to their private DNS name.
+	NodeNameFromPrivateDNSName() bool
+
+	// OnExternalInstanceDeletion returns what happens by default when the instance of a machine is deleted outside
+	// of Cluster API, empty if not set.
+	OnExternalInstanceDeletion() infrav1.OnExternalInstanceDeletion
+
+	// UseBlockingWaiters returns if the reconcile blocks until the bastion instance is terminated.
+	UseBlockingWaiters() bool
+
+	// DNSSuffix returns the DNS suffix of the endpoints of the AWS services in the partition of the cluster.
+	DNSSuffix() string
+
+	// EBSEncryptionKeyARN returns the ARN of the KMS key encrypting the encrypted EBS volumes which don't set
+	// their own key, empty if not set.
+	EBSEncryptionKeyARN() string
 }
@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+)
+
+// EFSScope is the interface for the scope to be used with the EFS service.
+type EFSScope interface {
+	cloud.ClusterScoper
+
+	// EFS returns the EFS file system configuration of the cluster.
+	EFS() *infrav1.EFSSpec
+	// EFSFileSystemID returns the ID of the EFS file system recorded in the cluster status.
+	EFSFileSystemID() string
+	// SetEFSFileSystemID records the ID of the EFS file system in the cluster status.
+	SetEFSFileSystemID(id string)
+	// Subnets returns the cluster subnets.
+	Subnets() infrav1.Subnets
+	// SecurityGroups returns the cluster security groups as a map, it creates the map if empty.
+	SecurityGroups() map[infrav1.SecurityGroupRole]infrav1.SecurityGroup
+}
@@ -49,4 +49,13 @@ type ELBScope interface {
 
 	// ControlPlaneEndpoint returns AWSCluster control plane endpoint
 	ControlPlaneEndpoint() clusterv1.APIEndpoint
+
+	// GlobalAcceleratorEndpointGroupARN returns the ARN of the Global Accelerator endpoint group to register the control plane with
+	GlobalAcceleratorEndpointGroupARN() string
+
+	// TagReconcilePolicy returns the policy applied to the tags of the resources changed outside of Cluster API.
+	TagReconcilePolicy() infrav1.TagReconcilePolicy
+	// RecordTagDrift records the tags of a resource that were removed or changed outside of Cluster API and are
+	// not re-applied.
+	RecordTagDrift(resourceID string, keys []string)
 }
@@ -90,6 +90,7 @@ func NewFargateProfileScope(params FargateProfileScopeParams) (*FargateProfileSc
 		serviceLimiters: serviceLimiters,
 		controllerName:  params.ControllerName,
 		enableIAM:       params.EnableIAM,
+		drift:           newDriftRecorder(),
 	}, nil
 }
 
@@ -108,6 +109,8 @@ type FargateProfileScope struct {
 	controllerName  string
 
 	enableIAM bool
+
+	drift *driftRecorder
 }
 
 // ManagedPoolName returns the managed machine pool name.
@@ -229,3 +232,18 @@ func (s *FargateProfileScope) ControllerName() string {
 func (s *FargateProfileScope) KubernetesClusterName() string {
 	return s.ControlPlane.Spec.EKSClusterName
 }
+
+// ReconcileMode returns how the AWS resources of the cluster are reconciled, following the control plane.
+func (s *FargateProfileScope) ReconcileMode() infrav1.ReconcileMode {
+	return s.ControlPlane.Spec.ReconcileMode
+}
+
+// RecordDrift records an operation changing an AWS resource that was skipped in the detectOnly reconcile mode.
+func (s *FargateProfileScope) RecordDrift(operation string) {
+	s.drift.record(operation)
+}
+
+// DriftedOperations returns the operations recorded by RecordDrift.
+func (s *FargateProfileScope) DriftedOperations() []string {
+	return s.drift.recorded()
+}
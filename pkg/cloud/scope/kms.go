@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+)
+
+// KMSScope is the interface for the scope to be used with the KMS service.
+type KMSScope interface {
+	cloud.ClusterScoper
+
+	// EBSEncryptionKeyARN returns the ARN of the KMS key encrypting the encrypted EBS volumes which don't set their own key.
+	EBSEncryptionKeyARN() string
+	// ManagedEncryptionKey returns whether CAPA manages a KMS key dedicated to the cluster.
+	ManagedEncryptionKey() bool
+	// ManagedEncryptionKeyARN returns the ARN of the managed KMS key recorded in the cluster status.
+	ManagedEncryptionKeyARN() string
+	// SetManagedEncryptionKeyARN records the ARN of the managed KMS key in the cluster status.
+	SetManagedEncryptionKeyARN(arn string)
+	// EBSEncryptionKeyGrantID returns the ID of the KMS grant recorded in the cluster status.
+	EBSEncryptionKeyGrantID() string
+	// SetEBSEncryptionKeyGrantID records the ID of the KMS grant in the cluster status.
+	SetEBSEncryptionKeyGrantID(id string)
+	// EBSEncryptionKeyEC2GrantID returns the ID of the KMS grant of the controller identity recorded in the cluster status.
+	EBSEncryptionKeyEC2GrantID() string
+	// SetEBSEncryptionKeyEC2GrantID records the ID of the KMS grant of the controller identity in the cluster status.
+	SetEBSEncryptionKeyEC2GrantID(id string)
+}
@@ -37,6 +37,23 @@ type LaunchTemplateScope interface {
 	GetLaunchTemplateLatestVersionStatus() string
 	SetLaunchTemplateLatestVersionStatus(version string)
 	GetRawBootstrapData() ([]byte, error)
+	// KubeletExtraArgs returns the kubelet arguments to add to the bootstrap data.
+	KubeletExtraArgs() map[string]string
+	// KubeadmNodeName returns the node name to set in the kubeadm configuration of the bootstrap data, empty if it
+	// must be left alone.
+	KubeadmNodeName() string
+	// MaxPods returns the options to compute the kubelet --max-pods argument with, nil if it must not be computed.
+	MaxPods() *expinfrav1.MaxPodsOptions
+	// MaxLaunchTemplateVersions returns the maximum number of launch template versions to keep, nil if not limited.
+	MaxLaunchTemplateVersions() *int32
+	// LaunchTemplateTags returns the tags to add to the launch template only, over the additional tags.
+	LaunchTemplateTags() infrav1.Tags
+	// InstanceTags returns the tags to add to the instances launched from the launch template only, over the additional tags.
+	InstanceTags() infrav1.Tags
+	// RequiresGPU returns whether the instances launched from the launch template must have GPUs.
+	RequiresGPU() bool
+	// BaseLaunchTemplateID returns the ID of the launch template the launch template inherits from, nil if none.
+	BaseLaunchTemplateID() *string
 
 	IsEKSManaged() bool
 	AdditionalTags() infrav1.Tags
@@ -53,6 +70,12 @@ type LaunchTemplateScope interface {
 type ResourceServiceToUpdate struct {
 	ResourceID      *string
 	ResourceService ResourceService
+	// ResourceTags are the tags specific to the resource, which take precedence over the additional tags.
+	// They are applied to the resource when ResourceTagsAnnotation is set, which tracks the last applied ones;
+	// otherwise they are only left alone when updating the additional tags.
+	ResourceTags infrav1.Tags
+	// ResourceTagsAnnotation is the annotation tracking the ResourceTags last applied to the resource.
+	ResourceTagsAnnotation string
 }
 
 type ResourceService interface {
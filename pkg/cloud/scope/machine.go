@@ -20,9 +20,13 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strings"
+	"text/template"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
@@ -30,6 +34,8 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/cloudinit"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/mime"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	capierrors "sigs.k8s.io/cluster-api/errors"
@@ -116,6 +122,11 @@ func (m *MachineScope) IsControlPlane() bool {
 	return util.IsControlPlaneMachine(m.Machine)
 }
 
+// RequiresGPU returns whether the AWSMachine or the Machine is labeled as requiring GPUs.
+func (m *MachineScope) RequiresGPU() bool {
+	return infrav1.IsGPURequired(m.AWSMachine.Labels, m.Machine.Labels)
+}
+
 // Role returns the machine role from the labels.
 func (m *MachineScope) Role() string {
 	if util.IsControlPlaneMachine(m.Machine) {
@@ -124,6 +135,63 @@ func (m *MachineScope) Role() string {
 	return "node"
 }
 
+// maxInstanceNameLength is the maximum length of the Name tag of an instance rendered from the instance name template.
+const maxInstanceNameLength = 255
+
+// instanceNameTemplateData is the data the instance name template of the cluster is rendered with.
+type instanceNameTemplateData struct {
+	Machine    *clusterv1.Machine
+	AWSMachine *infrav1.AWSMachine
+	Cluster    *clusterv1.Cluster
+	Role       string
+}
+
+// OnExternalInstanceDeletion returns what happens when the instance of the machine is deleted outside of Cluster API,
+// the policy of the AWSMachine or else the default of the cluster.
+func (m *MachineScope) OnExternalInstanceDeletion() infrav1.OnExternalInstanceDeletion {
+	if m.AWSMachine.Spec.OnExternalInstanceDeletion != "" {
+		return m.AWSMachine.Spec.OnExternalInstanceDeletion
+	}
+	return m.InfraCluster.OnExternalInstanceDeletion()
+}
+
+// InstanceName returns the Name tag of the instance, rendered from the instance name template of the cluster.
+// It falls back to the name of the AWSMachine when no template is set, it fails to render, or the rendered name is
+// longer than 255 characters.
+func (m *MachineScope) InstanceName() string {
+	nameTemplate := m.InfraCluster.InstanceNameTemplate()
+	if nameTemplate == "" {
+		return m.Name()
+	}
+
+	tmpl, err := template.New("instanceName").Parse(nameTemplate)
+	if err != nil {
+		m.Error(err, "failed to parse instance name template, using the machine name", "template", nameTemplate)
+		return m.Name()
+	}
+
+	var name strings.Builder
+	if err := tmpl.Execute(&name, instanceNameTemplateData{
+		Machine:    m.Machine,
+		AWSMachine: m.AWSMachine,
+		Cluster:    m.Cluster,
+		Role:       m.Role(),
+	}); err != nil {
+		m.Error(err, "failed to render instance name template, using the machine name", "template", nameTemplate)
+		return m.Name()
+	}
+	if strings.TrimSpace(name.String()) == "" {
+		m.Info("Instance name template rendered an empty name, using the machine name", "template", nameTemplate)
+		return m.Name()
+	}
+	if name.Len() > maxInstanceNameLength {
+		m.Info("Instance name template rendered a name which is too long, using the machine name", "template", nameTemplate, "max-length", maxInstanceNameLength)
+		return m.Name()
+	}
+
+	return name.String()
+}
+
 // GetInstanceID returns the AWSMachine instance id by parsing Spec.ProviderID.
 func (m *MachineScope) GetInstanceID() *string {
 	parsed, err := NewProviderID(m.GetProviderID())
@@ -162,6 +230,16 @@ func (m *MachineScope) SetInstanceState(v infrav1.InstanceState) {
 	m.AWSMachine.Status.InstanceState = &v
 }
 
+// SetInstanceType sets the AWSMachine status instance type.
+func (m *MachineScope) SetInstanceType(v string) {
+	m.AWSMachine.Status.InstanceType = v
+}
+
+// SetInstanceCreationTime sets the AWSMachine status instance creation time.
+func (m *MachineScope) SetInstanceCreationTime(v metav1.Time) {
+	m.AWSMachine.Status.InstanceCreationTime = &v
+}
+
 // SetReady sets the AWSMachine Ready Status.
 func (m *MachineScope) SetReady() {
 	m.AWSMachine.Status.Ready = true
@@ -285,6 +363,58 @@ func (m *MachineScope) GetRawBootstrapDataWithFormat() ([]byte, string, error) {
 	return value, string(secret.Data["format"]), nil
 }
 
+// TemplateBootstrapParameters adds the AWSMachine's additional bootstrap parameters to the given
+// bootstrap data, and sets the node name of its kubeadm configuration to the private DNS name of the
+// instance when the cluster names nodes after it. Only the references to the parameters are templated,
+// never their values. Ignition bootstrap data is returned unchanged.
+func (m *MachineScope) TemplateBootstrapParameters(userData []byte, userDataFormat string) ([]byte, error) {
+	if m.UseIgnition(userDataFormat) {
+		return userData, nil
+	}
+
+	if m.InfraCluster.NodeNameFromPrivateDNSName() {
+		var updated bool
+		if userData, updated = cloudinit.SetKubeadmNodeName(userData, privateDNSNameTemplate(m.InfraCluster.Region(), m.hostnameType())); !updated {
+			m.Info("Not setting the node name to the private DNS name, bootstrap data isn't a kubeadm cloud-config rendered as a Jinja template")
+		}
+	}
+
+	if len(m.AWSMachine.Spec.AdditionalBootstrapParameters) == 0 {
+		return userData, nil
+	}
+
+	data, err := mime.GenerateBootstrapParametersDocument(userData, m.AWSMachine.Spec.AdditionalBootstrapParameters)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to template additional bootstrap parameters into bootstrap data")
+	}
+
+	return data, nil
+}
+
+// privateDNSNameTemplate returns the Jinja expression cloud-init resolves to the EC2 private DNS name of an
+// instance in the given region. The private DNS name is in the ec2.internal domain in us-east-1, and in the
+// <region>.compute.internal domain in the other regions, whatever the domain of the DHCP options of the VPC. It is
+// named after the instance ID with resource-name hostnames, and after the private IPv4 address with ip-name ones.
+func privateDNSNameTemplate(region string, hostnameType infrav1.HostnameType) string {
+	domain := region + ".compute.internal"
+	if region == "us-east-1" {
+		domain = "ec2.internal"
+	}
+
+	if hostnameType == infrav1.HostnameTypeResourceName {
+		return fmt.Sprintf("{{ ds.meta_data.instance_id }}.%s", domain)
+	}
+	return fmt.Sprintf("ip-{{ ds.meta_data.local_ipv4 | replace('.', '-') }}.%s", domain)
+}
+
+// hostnameType returns the hostname type of the instance, instances use ip-name hostnames by default.
+func (m *MachineScope) hostnameType() infrav1.HostnameType {
+	if m.AWSMachine.Spec.PrivateDNSName == nil || m.AWSMachine.Spec.PrivateDNSName.HostnameType == nil {
+		return infrav1.HostnameTypeIPName
+	}
+	return *m.AWSMachine.Spec.PrivateDNSName.HostnameType
+}
+
 // PatchObject persists the machine spec and status.
 func (m *MachineScope) PatchObject() error {
 	// Always update the readyCondition by summarizing the state of other conditions.
@@ -312,6 +442,10 @@ func (m *MachineScope) PatchObject() error {
 			infrav1.InstanceReadyCondition,
 			infrav1.SecurityGroupsReadyCondition,
 			infrav1.ELBAttachedCondition,
+			infrav1.InstancePlacementCondition,
+			infrav1.BootDiagnosticsCapturedCondition,
+			infrav1.InstanceTagsSyncedCondition,
+			infrav1.MachinePausedCondition,
 		}})
 }
 
@@ -325,7 +459,11 @@ func (m *MachineScope) Close() error {
 func (m *MachineScope) AdditionalTags() infrav1.Tags {
 	tags := make(infrav1.Tags)
 
-	// Start with the cluster-wide tags...
+	// Start with the MachineDeployment and MachineSet owning the Machine...
+	tags.Merge(m.machineLineageTags())
+	// ... then the Machine labels that are configured to be propagated...
+	tags.Merge(m.machineLabelTags())
+	// ... then the cluster-wide tags...
 	tags.Merge(m.InfraCluster.AdditionalTags())
 	// ... and merge in the Machine's
 	tags.Merge(m.AWSMachine.Spec.AdditionalTags)
@@ -333,6 +471,50 @@ func (m *MachineScope) AdditionalTags() infrav1.Tags {
 	return tags
 }
 
+// machineLineageTags returns the tags for the namespaced names of the MachineSet owning the Machine and of the
+// MachineDeployment owning that MachineSet, if any. The MachineDeployment name is read from the label Cluster API sets
+// on the Machines of a MachineDeployment, which saves getting the MachineSet to walk its owner references.
+func (m *MachineScope) machineLineageTags() infrav1.Tags {
+	tags := make(infrav1.Tags)
+	for _, ref := range m.Machine.OwnerReferences {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil || gv.Group != clusterv1.GroupVersion.Group || ref.Kind != "MachineSet" {
+			continue
+		}
+		tags[infrav1.MachineSetNameTagKey] = types.NamespacedName{Namespace: m.Machine.Namespace, Name: ref.Name}.String()
+		if name, ok := m.Machine.Labels[clusterv1.MachineDeploymentNameLabel]; ok {
+			tags[infrav1.MachineDeploymentNameTagKey] = types.NamespacedName{Namespace: m.Machine.Namespace, Name: name}.String()
+		}
+		break
+	}
+
+	return tags
+}
+
+// machineLabelTags returns the tags for the Machine labels listed in the cluster's MachineLabelToTag mapping.
+// Labels that would be mapped onto tags managed by the AWS provider are skipped.
+func (m *MachineScope) machineLabelTags() infrav1.Tags {
+	tags := make(infrav1.Tags)
+	for label, tagKey := range m.InfraCluster.MachineLabelToTag() {
+		value, ok := m.Machine.Labels[label]
+		if !ok || isProviderTagKey(tagKey) {
+			continue
+		}
+		tags[tagKey] = value
+	}
+
+	return tags
+}
+
+func isProviderTagKey(key string) bool {
+	return key == "Name" ||
+		key == infrav1.MachineNameTagKey ||
+		key == infrav1.MachineSetNameTagKey ||
+		key == infrav1.MachineDeploymentNameTagKey ||
+		strings.HasPrefix(key, infrav1.NameAWSProviderPrefix) ||
+		strings.HasPrefix(key, infrav1.NameKubernetesAWSCloudProviderPrefix)
+}
+
 // HasFailed returns the failure state of the machine scope.
 func (m *MachineScope) HasFailed() bool {
 	return m.AWSMachine.Status.FailureReason != nil || m.AWSMachine.Status.FailureMessage != nil
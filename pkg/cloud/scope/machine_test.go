@@ -18,6 +18,8 @@ package scope
 
 import (
 	"encoding/base64"
+	"reflect"
+	"strings"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -258,6 +260,123 @@ func TestUseSecretsManagerTrue(t *testing.T) {
 	}
 }
 
+func TestTemplateBootstrapParameters(t *testing.T) {
+	t.Run("returns_bootstrap_data_unchanged_when_no_parameters_are_set", func(t *testing.T) {
+		scope, err := setupMachineScope()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userData, err := scope.TemplateBootstrapParameters([]byte("user data"), "")
+		if err != nil {
+			t.Fatalf("Templating bootstrap parameters: %v", err)
+		}
+
+		if string(userData) != "user data" {
+			t.Fatalf("Bootstrap data should be unchanged, got: %q", string(userData))
+		}
+	})
+
+	t.Run("templates_parameter_references_into_bootstrap_data", func(t *testing.T) {
+		scope, err := setupMachineScope()
+		if err != nil {
+			t.Fatal(err)
+		}
+		scope.AWSMachine.Spec.AdditionalBootstrapParameters = map[string]string{
+			"JOIN_TOKEN": "arn:aws:ssm:us-east-1:123456789012:parameter/cluster/join-token",
+		}
+
+		userData, err := scope.TemplateBootstrapParameters([]byte("user data"), "cloud-config")
+		if err != nil {
+			t.Fatalf("Templating bootstrap parameters: %v", err)
+		}
+
+		for _, expected := range []string{
+			"text/cloud-boothook",
+			"JOIN_TOKEN='arn:aws:ssm:us-east-1:123456789012:parameter/cluster/join-token'",
+			"user data",
+		} {
+			if !strings.Contains(string(userData), expected) {
+				t.Fatalf("Bootstrap data should contain %q, got: %q", expected, string(userData))
+			}
+		}
+	})
+
+	t.Run("templates_private_dns_name_into_kubeadm_node_name", func(t *testing.T) {
+		joinConfig := "## template: jinja\n#cloud-config\nwrite_files:\n- path: /run/kubeadm/kubeadm-join-config.yaml\n  content: |\n    apiVersion: kubeadm.k8s.io/v1beta3\n    kind: JoinConfiguration\n    nodeRegistration:\n      name: '{{ ds.meta_data.local_hostname }}'\n"
+		for _, tc := range []struct {
+			region       string
+			hostnameType *infrav1.HostnameType
+			expected     string
+		}{
+			{
+				region:   "eu-west-1",
+				expected: `name: "ip-{{ ds.meta_data.local_ipv4 | replace('.', '-') }}.eu-west-1.compute.internal"`,
+			},
+			{
+				region:       "eu-west-1",
+				hostnameType: &infrav1.HostnameTypeIPName,
+				expected:     `name: "ip-{{ ds.meta_data.local_ipv4 | replace('.', '-') }}.eu-west-1.compute.internal"`,
+			},
+			{
+				region:       "eu-west-1",
+				hostnameType: &infrav1.HostnameTypeResourceName,
+				expected:     `name: "{{ ds.meta_data.instance_id }}.eu-west-1.compute.internal"`,
+			},
+			{
+				region:       "us-east-1",
+				hostnameType: &infrav1.HostnameTypeResourceName,
+				expected:     `name: "{{ ds.meta_data.instance_id }}.ec2.internal"`,
+			},
+		} {
+			scope, err := setupMachineScope()
+			if err != nil {
+				t.Fatal(err)
+			}
+			awsCluster := scope.InfraCluster.(*ClusterScope).AWSCluster
+			awsCluster.Spec.Region = tc.region
+			awsCluster.Spec.NodeNameFromPrivateDNSName = true
+			scope.AWSMachine.Spec.PrivateDNSName = &infrav1.PrivateDNSName{HostnameType: tc.hostnameType}
+
+			userData, err := scope.TemplateBootstrapParameters([]byte(joinConfig), "cloud-config")
+			if err != nil {
+				t.Fatalf("Templating bootstrap parameters: %v", err)
+			}
+
+			for _, expected := range []string{
+				"## template: jinja\n#cloud-config\n",
+				tc.expected,
+			} {
+				if !strings.Contains(string(userData), expected) {
+					t.Fatalf("Bootstrap data should contain %q, got: %q", expected, string(userData))
+				}
+			}
+			if strings.Contains(string(userData), "local_hostname") {
+				t.Fatalf("Bootstrap data should not contain the previous node name, got: %q", string(userData))
+			}
+		}
+	})
+
+	t.Run("returns_bootstrap_data_unchanged_when_bootstrap_data_format_is_ignition", func(t *testing.T) {
+		scope, err := setupMachineScope()
+		if err != nil {
+			t.Fatal(err)
+		}
+		scope.AWSMachine.Spec.AdditionalBootstrapParameters = map[string]string{
+			"JOIN_TOKEN": "/cluster/join-token",
+		}
+
+		userData, err := scope.TemplateBootstrapParameters([]byte("user data"), "ignition")
+		if err != nil {
+			t.Fatalf("Templating bootstrap parameters: %v", err)
+		}
+
+		if string(userData) != "user data" {
+			t.Fatalf("Ignition bootstrap data should be unchanged, got: %q", string(userData))
+		}
+	})
+}
+
 func TestUseIgnition(t *testing.T) {
 	t.Run("returns_true_when_given_bootstrap_data_format_is_ignition", func(t *testing.T) {
 		scope, err := setupMachineScope()
@@ -341,3 +460,174 @@ func TestSetProviderID(t *testing.T) {
 		t.Fatalf("Expected providerID %s, got %s", expectedProviderID, providerID)
 	}
 }
+
+func TestAdditionalTagsPropagatesMappedMachineLabels(t *testing.T) {
+	scope, err := setupMachineScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scope.Machine.Labels = map[string]string{
+		"team":        "platform",
+		"cost-center": "1234",
+		"unlisted":    "value",
+		"conflicting": "from-label",
+		"name":        "from-label",
+	}
+	scope.InfraCluster.(*ClusterScope).AWSCluster.Spec.MachineLabelToTag = map[string]string{
+		"team":        "Team",
+		"cost-center": "CostCenter",
+		"conflicting": "Environment",
+		"name":        "Name",
+		"missing":     "Missing",
+	}
+	scope.InfraCluster.(*ClusterScope).AWSCluster.Spec.AdditionalTags = infrav1.Tags{
+		"Environment": "from-cluster",
+	}
+
+	tags := scope.AdditionalTags()
+	expectedTags := infrav1.Tags{
+		"Team":        "platform",
+		"CostCenter":  "1234",
+		"Environment": "from-cluster",
+	}
+	if !reflect.DeepEqual(tags, expectedTags) {
+		t.Fatalf("Expected tags %v, got %v", expectedTags, tags)
+	}
+}
+
+func TestAdditionalTagsDoesNotPropagateMachineLabelsByDefault(t *testing.T) {
+	scope, err := setupMachineScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scope.Machine.Labels = map[string]string{
+		"team": "platform",
+	}
+
+	tags := scope.AdditionalTags()
+	if len(tags) != 0 {
+		t.Fatalf("Expected no tags, got %v", tags)
+	}
+}
+
+func TestAdditionalTagsPropagatesMachineLineage(t *testing.T) {
+	scope, err := setupMachineScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scope.Machine.Labels = map[string]string{
+		clusterv1.MachineDeploymentNameLabel: "my-deployment",
+	}
+	scope.Machine.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion: clusterv1.GroupVersion.String(),
+			Kind:       "MachineSet",
+			Name:       "my-deployment-5b8f7d9c4",
+		},
+	}
+
+	tags := scope.AdditionalTags()
+	expectedTags := infrav1.Tags{
+		infrav1.MachineSetNameTagKey:        scope.Machine.Namespace + "/my-deployment-5b8f7d9c4",
+		infrav1.MachineDeploymentNameTagKey: scope.Machine.Namespace + "/my-deployment",
+	}
+	if !reflect.DeepEqual(tags, expectedTags) {
+		t.Fatalf("Expected tags %v, got %v", expectedTags, tags)
+	}
+}
+
+func TestAdditionalTagsOmitsLineageOfStandaloneMachines(t *testing.T) {
+	scope, err := setupMachineScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scope.Machine.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion: "controlplane.cluster.x-k8s.io/v1beta1",
+			Kind:       "KubeadmControlPlane",
+			Name:       "my-control-plane",
+		},
+	}
+
+	tags := scope.AdditionalTags()
+	if len(tags) != 0 {
+		t.Fatalf("Expected no tags, got %v", tags)
+	}
+}
+
+func TestInstanceName(t *testing.T) {
+	tests := []struct {
+		name         string
+		template     string
+		controlPlane bool
+		expected     string
+	}{
+		{
+			name:     "machine name is used without a template",
+			expected: "my-machine-0",
+		},
+		{
+			name:     "template is rendered with the cluster and machine",
+			template: "{{ .Cluster.Name }}-{{ .Role }}-{{ .Machine.Name }}",
+			expected: "my-cluster-node-my-machine-0",
+		},
+		{
+			name:         "template is rendered with the role of control plane machines",
+			template:     "{{ .Cluster.Name }}-{{ .Role }}-{{ .AWSMachine.Name }}",
+			controlPlane: true,
+			expected:     "my-cluster-control-plane-my-machine-0",
+		},
+		{
+			name:     "template can use the labels of the machine",
+			template: `{{ index .Machine.Labels "cmdb.example.com/site" }}-{{ .Machine.Namespace }}-{{ .Machine.Name }}`,
+			expected: "fra1-default-my-machine-0",
+		},
+		{
+			name:     "machine name is used when the template doesn't parse",
+			template: "{{ .Cluster.Name }-{{ .Role }}",
+			expected: "my-machine-0",
+		},
+		{
+			name:     "machine name is used when the template fails to render",
+			template: "{{ .Machine.Unknown }}",
+			expected: "my-machine-0",
+		},
+		{
+			name:     "machine name is used when the template renders an empty name",
+			template: `{{ index .Machine.Labels "missing" }}`,
+			expected: "my-machine-0",
+		},
+		{
+			name:     "machine name is used when the template renders a name longer than 255 characters",
+			template: `{{ .Cluster.Name }}-` + strings.Repeat("x", 250),
+			expected: "my-machine-0",
+		},
+		{
+			name:     "template can render a name of 255 characters",
+			template: `{{ .Cluster.Name }}-` + strings.Repeat("x", 244),
+			expected: "my-cluster-" + strings.Repeat("x", 244),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := setupMachineScope()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			scope.Machine.Labels["cmdb.example.com/site"] = "fra1"
+			if tt.controlPlane {
+				scope.Machine.Labels[clusterv1.MachineControlPlaneLabel] = ""
+			}
+			scope.InfraCluster.(*ClusterScope).AWSCluster.Spec.InstanceNameTemplate = tt.template
+
+			if name := scope.InstanceName(); name != tt.expected {
+				t.Fatalf("Expected instance name %q, got %q", tt.expected, name)
+			}
+		})
+	}
+}
@@ -184,6 +184,8 @@ func (m *MachinePoolScope) PatchObject() error {
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			expinfrav1.ASGReadyCondition,
 			expinfrav1.LaunchTemplateReadyCondition,
+			expinfrav1.WorkloadClusterReachableCondition,
+			infrav1.MachinePausedCondition,
 		}})
 }
 
@@ -378,6 +380,51 @@ func (m *MachinePoolScope) GetLaunchTemplate() *expinfrav1.AWSLaunchTemplate {
 	return &m.AWSMachinePool.Spec.AWSLaunchTemplate
 }
 
+// KubeletExtraArgs returns the kubelet arguments to add to the bootstrap data of the instances of the pool.
+func (m *MachinePoolScope) KubeletExtraArgs() map[string]string {
+	return m.AWSMachinePool.Spec.KubeletExtraArgs
+}
+
+// KubeadmNodeName returns the Jinja expression resolving to the private DNS name of the instances of the pool when
+// the cluster names nodes after it, empty otherwise. The launch template doesn't set the hostname type of the
+// instances, so they use ip-name hostnames.
+func (m *MachinePoolScope) KubeadmNodeName() string {
+	if !m.InfraCluster.NodeNameFromPrivateDNSName() {
+		return ""
+	}
+	return privateDNSNameTemplate(m.InfraCluster.Region(), infrav1.HostnameTypeIPName)
+}
+
+// MaxPods returns the options to compute the kubelet --max-pods argument of the instances of the pool with.
+func (m *MachinePoolScope) MaxPods() *expinfrav1.MaxPodsOptions {
+	return m.AWSMachinePool.Spec.MaxPods
+}
+
+// RequiresGPU returns whether the AWSMachinePool or the MachinePool is labeled as requiring GPUs.
+func (m *MachinePoolScope) RequiresGPU() bool {
+	return infrav1.IsGPURequired(m.AWSMachinePool.Labels, m.MachinePool.Labels)
+}
+
+// MaxLaunchTemplateVersions returns the maximum number of launch template versions to keep, nil if not limited.
+func (m *MachinePoolScope) MaxLaunchTemplateVersions() *int32 {
+	return m.AWSMachinePool.Spec.MaxLaunchTemplateVersions
+}
+
+// BaseLaunchTemplateID returns the ID of the launch template the launch template of the pool inherits from.
+func (m *MachinePoolScope) BaseLaunchTemplateID() *string {
+	return m.AWSMachinePool.Spec.BaseLaunchTemplateID
+}
+
+// LaunchTemplateTags returns the tags to add to the launch template of the pool only.
+func (m *MachinePoolScope) LaunchTemplateTags() infrav1.Tags {
+	return m.AWSMachinePool.Spec.LaunchTemplateTags
+}
+
+// InstanceTags returns the tags to add to the instances of the pool only.
+func (m *MachinePoolScope) InstanceTags() infrav1.Tags {
+	return m.AWSMachinePool.Spec.InstanceTags
+}
+
 func (m *MachinePoolScope) GetMachinePool() *expclusterv1.MachinePool {
 	return m.MachinePool
 }
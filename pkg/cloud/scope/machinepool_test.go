@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+func TestMachinePoolScopeKubeadmNodeName(t *testing.T) {
+	tests := []struct {
+		name                       string
+		region                     string
+		nodeNameFromPrivateDNSName bool
+		expected                   string
+	}{
+		{
+			name:     "is empty when the cluster doesn't name nodes after their private DNS name",
+			region:   "eu-west-1",
+			expected: "",
+		},
+		{
+			name:                       "is the ip-name private DNS name of the instances",
+			region:                     "eu-west-1",
+			nodeNameFromPrivateDNSName: true,
+			expected:                   "ip-{{ ds.meta_data.local_ipv4 | replace('.', '-') }}.eu-west-1.compute.internal",
+		},
+		{
+			name:                       "is in the ec2.internal domain in us-east-1",
+			region:                     "us-east-1",
+			nodeNameFromPrivateDNSName: true,
+			expected:                   "ip-{{ ds.meta_data.local_ipv4 | replace('.', '-') }}.ec2.internal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scope := &MachinePoolScope{
+				InfraCluster: &ClusterScope{
+					AWSCluster: &infrav1.AWSCluster{
+						Spec: infrav1.AWSClusterSpec{
+							Region:                     tt.region,
+							NodeNameFromPrivateDNSName: tt.nodeNameFromPrivateDNSName,
+						},
+					},
+				},
+			}
+			g.Expect(scope.KubeadmNodeName()).To(Equal(tt.expected))
+		})
+	}
+}
@@ -19,6 +19,7 @@ package scope
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	amazoncni "github.com/aws/amazon-vpc-cni-k8s/pkg/apis/crd/v1alpha1"
@@ -66,6 +67,7 @@ type ManagedControlPlaneScopeParams struct {
 	EnableIAM                    bool
 	AllowAdditionalRoles         bool
 	TagUnmanagedNetworkResources bool
+	UseBlockingWaiters           bool
 }
 
 // NewManagedControlPlaneScope creates a new Scope from the supplied parameters.
@@ -94,6 +96,9 @@ func NewManagedControlPlaneScope(params ManagedControlPlaneScopeParams) (*Manage
 		allowAdditionalRoles:         params.AllowAdditionalRoles,
 		enableIAM:                    params.EnableIAM,
 		tagUnmanagedNetworkResources: params.TagUnmanagedNetworkResources,
+		useBlockingWaiters:           params.UseBlockingWaiters,
+		drift:                        newDriftRecorder(),
+		tagDrift:                     newDriftRecorder(),
 	}
 	session, serviceLimiters, err := sessionForClusterWithRegion(params.Client, managedScope, params.ControlPlane.Spec.Region, params.Endpoints, params.Logger)
 	if err != nil {
@@ -128,6 +133,10 @@ type ManagedControlPlaneScope struct {
 	enableIAM                    bool
 	allowAdditionalRoles         bool
 	tagUnmanagedNetworkResources bool
+	useBlockingWaiters           bool
+
+	drift    *driftRecorder
+	tagDrift *driftRecorder
 }
 
 // RemoteClient returns the Kubernetes client for connecting to the workload cluster.
@@ -169,6 +178,36 @@ func (s *ManagedControlPlaneScope) Subnets() infrav1.Subnets {
 	return s.ControlPlane.Spec.NetworkSpec.Subnets
 }
 
+// SubnetCidrSizes returns the requested sizes of the default subnets.
+func (s *ManagedControlPlaneScope) SubnetCidrSizes() *infrav1.SubnetCidrSizes {
+	return s.ControlPlane.Spec.NetworkSpec.SubnetCidrSizes
+}
+
+// AdditionalRoutes returns the additional routes to add to the managed private route tables.
+func (s *ManagedControlPlaneScope) AdditionalRoutes() []infrav1.RouteSpec {
+	return s.ControlPlane.Spec.NetworkSpec.AdditionalRoutes
+}
+
+// TransitGatewayAttachment returns the transit gateway attachment of the VPC, if any.
+func (s *ManagedControlPlaneScope) TransitGatewayAttachment() *infrav1.TransitGatewayAttachmentSpec {
+	return s.ControlPlane.Spec.NetworkSpec.TransitGatewayAttachment
+}
+
+// VPCPeerings returns the peering connections of the VPC.
+func (s *ManagedControlPlaneScope) VPCPeerings() []infrav1.VPCPeeringSpec {
+	return s.ControlPlane.Spec.NetworkSpec.VPCPeerings
+}
+
+// NetworkACL returns the network ACL of the managed subnets, if any.
+func (s *ManagedControlPlaneScope) NetworkACL() *infrav1.NetworkACLSpec {
+	return s.ControlPlane.Spec.NetworkSpec.NetworkACL
+}
+
+// SubnetAvailableIPAddressThreshold returns the number of available IP addresses below which a subnet is low on IP addresses, if any.
+func (s *ManagedControlPlaneScope) SubnetAvailableIPAddressThreshold() *int64 {
+	return s.ControlPlane.Spec.NetworkSpec.SubnetAvailableIPAddressThreshold
+}
+
 // SetNatGatewaysIPs sets the Nat Gateways Public IPs.
 func (s *ManagedControlPlaneScope) SetNatGatewaysIPs(ips []string) {
 	s.ControlPlane.Status.Network.NatGatewaysIPs = ips
@@ -212,6 +251,31 @@ func (s *ManagedControlPlaneScope) SecurityGroupOverrides() map[infrav1.Security
 	return s.ControlPlane.Spec.NetworkSpec.SecurityGroupOverrides
 }
 
+// SecurityGroupReconcileStrategies returns the security group reconcile strategies in the ControlPlane spec.
+func (s *ManagedControlPlaneScope) SecurityGroupReconcileStrategies() map[infrav1.SecurityGroupRole]infrav1.SecurityGroupRoleReconcileStrategy {
+	return s.ControlPlane.Spec.NetworkSpec.SecurityGroupReconcileStrategies
+}
+
+// KarpenterDiscovery returns the configuration of the karpenter.sh/discovery tag, if any.
+func (s *ManagedControlPlaneScope) KarpenterDiscovery() *infrav1.KarpenterDiscoverySpec {
+	return s.ControlPlane.Spec.NetworkSpec.KarpenterDiscovery
+}
+
+// NatGatewayStrategy returns how many NAT gateways are created for the private subnets.
+func (s *ManagedControlPlaneScope) NatGatewayStrategy() infrav1.NatGatewayStrategy {
+	return s.ControlPlane.Spec.NetworkSpec.NatGatewayStrategy
+}
+
+// FlowLogs returns the configuration of the flow log of the VPC, if any.
+func (s *ManagedControlPlaneScope) FlowLogs() *infrav1.FlowLogsSpec {
+	return s.ControlPlane.Spec.NetworkSpec.FlowLogs
+}
+
+// SecurityGroupEgressRules returns the security group egress rules in the ControlPlane spec.
+func (s *ManagedControlPlaneScope) SecurityGroupEgressRules() map[infrav1.SecurityGroupRole]infrav1.EgressRules {
+	return s.ControlPlane.Spec.NetworkSpec.SecurityGroupEgressRules
+}
+
 // Name returns the CAPI cluster name.
 func (s *ManagedControlPlaneScope) Name() string {
 	return s.Cluster.Name
@@ -252,12 +316,21 @@ func (s *ManagedControlPlaneScope) PatchObject() error {
 			infrav1.NatGatewaysReadyCondition,
 			infrav1.RouteTablesReadyCondition,
 			infrav1.VpcEndpointsReadyCondition,
+			infrav1.TransitGatewayAttachmentReadyCondition,
+			infrav1.VpcPeeringsReadyCondition,
+			infrav1.NetworkACLReadyCondition,
+			infrav1.FlowLogsReadyCondition,
 			infrav1.BastionHostReadyCondition,
 			infrav1.EgressOnlyInternetGatewayReadyCondition,
+			infrav1.NetworkPausedCondition,
+			infrav1.ResourcesInSyncCondition,
+			infrav1.ResourceTagsSyncedCondition,
 			ekscontrolplanev1.EKSControlPlaneCreatingCondition,
 			ekscontrolplanev1.EKSControlPlaneReadyCondition,
 			ekscontrolplanev1.EKSControlPlaneUpdatingCondition,
+			ekscontrolplanev1.ControlPlaneUpgradingCondition,
 			ekscontrolplanev1.IAMControlPlaneRolesReadyCondition,
+			ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredCondition,
 		}})
 }
 
@@ -280,6 +353,21 @@ func (s *ManagedControlPlaneScope) APIServerPort() int32 {
 	return 443
 }
 
+// ReconcileMode returns how the AWS resources of the cluster are reconciled.
+func (s *ManagedControlPlaneScope) ReconcileMode() infrav1.ReconcileMode {
+	return s.ControlPlane.Spec.ReconcileMode
+}
+
+// RecordDrift records an operation changing an AWS resource that was skipped in the detectOnly reconcile mode.
+func (s *ManagedControlPlaneScope) RecordDrift(operation string) {
+	s.drift.record(operation)
+}
+
+// DriftedOperations returns the operations recorded by RecordDrift.
+func (s *ManagedControlPlaneScope) DriftedOperations() []string {
+	return s.drift.recorded()
+}
+
 // SetFailureDomain sets the infrastructure provider failure domain key to the spec given as input.
 func (s *ManagedControlPlaneScope) SetFailureDomain(id string, spec clusterv1.FailureDomainSpec) {
 	if s.ControlPlane.Status.FailureDomains == nil {
@@ -308,6 +396,11 @@ func (s *ManagedControlPlaneScope) Bastion() *infrav1.Bastion {
 	return &s.ControlPlane.Spec.Bastion
 }
 
+// InstanceConnectEndpoint returns the EC2 Instance Connect Endpoint details, if any.
+func (s *ManagedControlPlaneScope) InstanceConnectEndpoint() *infrav1.InstanceConnectEndpointSpec {
+	return s.ControlPlane.Spec.NetworkSpec.InstanceConnectEndpoint
+}
+
 // Bucket returns the bucket details.
 // For ManagedControlPlane this is always nil, as we don't support S3 buckets for managed clusters.
 func (s *ManagedControlPlaneScope) Bucket() *infrav1.S3Bucket {
@@ -319,6 +412,29 @@ func (s *ManagedControlPlaneScope) TagUnmanagedNetworkResources() bool {
 	return s.tagUnmanagedNetworkResources
 }
 
+// TagReconcilePolicy returns the policy applied to the tags of the network, security group and load balancer
+// resources of the cluster changed outside of Cluster API.
+func (s *ManagedControlPlaneScope) TagReconcilePolicy() infrav1.TagReconcilePolicy {
+	return infrav1.GetTagReconcilePolicy(s.ControlPlane.GetAnnotations())
+}
+
+// RecordTagDrift records the tags of a resource that were removed or changed outside of Cluster API and are not
+// re-applied.
+func (s *ManagedControlPlaneScope) RecordTagDrift(resourceID string, keys []string) {
+	s.tagDrift.record(fmt.Sprintf("%s (%s)", resourceID, strings.Join(keys, ", ")))
+}
+
+// TagDrift returns the resources and tags recorded by RecordTagDrift.
+func (s *ManagedControlPlaneScope) TagDrift() []string {
+	return s.tagDrift.recorded()
+}
+
+// UseBlockingWaiters returns if the long-running AWS operations block the reconcile until they are done, instead of
+// being polled on the next reconciles.
+func (s *ManagedControlPlaneScope) UseBlockingWaiters() bool {
+	return s.useBlockingWaiters
+}
+
 // SetBastionInstance sets the bastion instance in the status of the cluster.
 func (s *ManagedControlPlaneScope) SetBastionInstance(instance *infrav1.Instance) {
 	s.ControlPlane.Status.Bastion = instance
@@ -375,6 +491,68 @@ func (s *ManagedControlPlaneScope) ImageLookupBaseOS() string {
 	return s.ControlPlane.Spec.ImageLookupBaseOS
 }
 
+// MachineLabelToTag returns the mapping of Machine label keys to the instance tag keys they are propagated to.
+// Label propagation is not supported for EKS clusters, so this is always empty.
+func (s *ManagedControlPlaneScope) MachineLabelToTag() map[string]string {
+	return nil
+}
+
+// InstanceNameTemplate returns the template rendering the Name tag of the instances.
+// Instance name templates are not supported for EKS clusters, so this is always empty.
+func (s *ManagedControlPlaneScope) InstanceNameTemplate() string {
+	return ""
+}
+
+// EBSEncryptionKeyARN returns the ARN of the KMS key used to encrypt the encrypted EBS volumes which don't set their
+// own key, which is the managed key of the cluster, if any.
+func (s *ManagedControlPlaneScope) EBSEncryptionKeyARN() string {
+	return s.ControlPlane.Status.ManagedEncryptionKeyARN
+}
+
+// ManagedEncryptionKey returns whether CAPA manages a KMS key dedicated to the cluster.
+func (s *ManagedControlPlaneScope) ManagedEncryptionKey() bool {
+	return s.ControlPlane.Spec.ManagedEncryptionKey
+}
+
+// ManagedEncryptionKeyARN returns the ARN of the managed KMS key recorded in the control plane status.
+func (s *ManagedControlPlaneScope) ManagedEncryptionKeyARN() string {
+	return s.ControlPlane.Status.ManagedEncryptionKeyARN
+}
+
+// SetManagedEncryptionKeyARN records the ARN of the managed KMS key in the control plane status, an empty ARN clears it.
+func (s *ManagedControlPlaneScope) SetManagedEncryptionKeyARN(arn string) {
+	s.ControlPlane.Status.ManagedEncryptionKeyARN = arn
+}
+
+// EBSEncryptionKeyGrantID returns the ID of the KMS grant for a cross-account EBS encryption key, which isn't
+// supported by managed control planes.
+func (s *ManagedControlPlaneScope) EBSEncryptionKeyGrantID() string {
+	return ""
+}
+
+// SetEBSEncryptionKeyGrantID is a no-op, as cross-account EBS encryption keys aren't supported by managed control planes.
+func (s *ManagedControlPlaneScope) SetEBSEncryptionKeyGrantID(_ string) {}
+
+// EBSEncryptionKeyEC2GrantID returns the ID of the KMS grant of the controller identity for a cross-account EBS
+// encryption key, which isn't supported by managed control planes.
+func (s *ManagedControlPlaneScope) EBSEncryptionKeyEC2GrantID() string {
+	return ""
+}
+
+// SetEBSEncryptionKeyEC2GrantID is a no-op, as cross-account EBS encryption keys aren't supported by managed control planes.
+func (s *ManagedControlPlaneScope) SetEBSEncryptionKeyEC2GrantID(_ string) {}
+
+// OnExternalInstanceDeletion returns what happens by default when the instance of a machine is deleted outside of
+// Cluster API, which isn't configurable on managed control planes, so the AWSMachines decide.
+func (s *ManagedControlPlaneScope) OnExternalInstanceDeletion() infrav1.OnExternalInstanceDeletion {
+	return ""
+}
+
+// NodeNameFromPrivateDNSName returns whether the kubeadm node name of the instances is set to their private DNS name.
+func (s *ManagedControlPlaneScope) NodeNameFromPrivateDNSName() bool {
+	return false
+}
+
 // IAMAuthConfig returns the IAM authenticator config. The returned value will never be nil.
 func (s *ManagedControlPlaneScope) IAMAuthConfig() *ekscontrolplanev1.IAMAuthenticatorConfig {
 	if s.ControlPlane.Spec.IAMAuthenticatorConfig == nil {
@@ -391,9 +569,33 @@ func (s *ManagedControlPlaneScope) Addons() []ekscontrolplanev1.Addon {
 	return *s.ControlPlane.Spec.Addons
 }
 
+// IPFamily returns the IP family of the EKS cluster. If it isn't set, the cluster is IPv6
+// when IPv6 is enabled for the VPC.
+func (s *ManagedControlPlaneScope) IPFamily() ekscontrolplanev1.IPFamily {
+	if s.ControlPlane.Spec.IsIPv6() {
+		return ekscontrolplanev1.IPFamilyIPv6
+	}
+	return ekscontrolplanev1.IPFamilyIPv4
+}
+
+// PodIdentityAssociations returns the list of EKS Pod Identity associations for a EKS cluster.
+func (s *ManagedControlPlaneScope) PodIdentityAssociations() []ekscontrolplanev1.PodIdentityAssociation {
+	return s.ControlPlane.Spec.PodIdentityAssociations
+}
+
+// DefaultAddons returns how the addons EKS installs into the cluster by default are managed.
+func (s *ManagedControlPlaneScope) DefaultAddons() ekscontrolplanev1.DefaultAddons {
+	return s.ControlPlane.Spec.DefaultAddons
+}
+
 // DisableKubeProxy returns whether kube-proxy should be disabled.
 func (s *ManagedControlPlaneScope) DisableKubeProxy() bool {
-	return s.ControlPlane.Spec.KubeProxy.Disable
+	return s.ControlPlane.Spec.KubeProxy.Disable || s.ControlPlane.Spec.DefaultAddons.KubeProxy.Remove
+}
+
+// RemoveCoreDNS returns whether coredns should be removed.
+func (s *ManagedControlPlaneScope) RemoveCoreDNS() bool {
+	return s.ControlPlane.Spec.DefaultAddons.CoreDNS.Remove
 }
 
 // DisableVPCCNI returns whether the AWS VPC CNI should be disabled.
@@ -436,7 +638,17 @@ func (s *ManagedControlPlaneScope) Partition() string {
 	return s.ControlPlane.Spec.Partition
 }
 
+// DNSSuffix returns the DNS suffix of the endpoints of the AWS services in the partition of the cluster.
+func (s *ManagedControlPlaneScope) DNSSuffix() string {
+	return system.GetDNSSuffixFromPartition(s.Partition())
+}
+
 // AdditionalControlPlaneIngressRules returns the additional ingress rules for the control plane security group.
 func (s *ManagedControlPlaneScope) AdditionalControlPlaneIngressRules() []infrav1.IngressRule {
 	return nil
 }
+
+// PodCIDRBlocks returns the CIDR blocks used by the CNI for pod IPs, which are not configurable for EKS.
+func (s *ManagedControlPlaneScope) PodCIDRBlocks() []string {
+	return nil
+}
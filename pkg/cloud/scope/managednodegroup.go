@@ -114,6 +114,7 @@ func NewManagedMachinePoolScope(params ManagedMachinePoolScopeParams) (*ManagedM
 		controllerName:       params.ControllerName,
 		enableIAM:            params.EnableIAM,
 		allowAdditionalRoles: params.AllowAdditionalRoles,
+		drift:                newDriftRecorder(),
 	}, nil
 }
 
@@ -136,6 +137,8 @@ type ManagedMachinePoolScope struct {
 
 	enableIAM            bool
 	allowAdditionalRoles bool
+
+	drift *driftRecorder
 }
 
 // ManagedPoolName returns the managed machine pool name.
@@ -267,6 +270,7 @@ func (s *ManagedMachinePoolScope) PatchObject() error {
 		s.ManagedMachinePool,
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			expinfrav1.EKSNodegroupReadyCondition,
+			expinfrav1.EKSNodegroupActiveCondition,
 			expinfrav1.IAMNodegroupRolesReadyCondition,
 		}})
 }
@@ -310,6 +314,21 @@ func (s *ManagedMachinePoolScope) KubernetesClusterName() string {
 	return s.ControlPlane.Spec.EKSClusterName
 }
 
+// ReconcileMode returns how the AWS resources of the cluster are reconciled, following the control plane.
+func (s *ManagedMachinePoolScope) ReconcileMode() infrav1.ReconcileMode {
+	return s.ControlPlane.Spec.ReconcileMode
+}
+
+// RecordDrift records an operation changing an AWS resource that was skipped in the detectOnly reconcile mode.
+func (s *ManagedMachinePoolScope) RecordDrift(operation string) {
+	s.drift.record(operation)
+}
+
+// DriftedOperations returns the operations recorded by RecordDrift.
+func (s *ManagedMachinePoolScope) DriftedOperations() []string {
+	return s.drift.recorded()
+}
+
 // NodegroupName is the name of the EKS nodegroup.
 func (s *ManagedMachinePoolScope) NodegroupName() string {
 	return s.ManagedMachinePool.Spec.EKSNodegroupName
@@ -387,6 +406,46 @@ func (s *ManagedMachinePoolScope) GetLaunchTemplate() *expinfrav1.AWSLaunchTempl
 	return s.ManagedMachinePool.Spec.AWSLaunchTemplate
 }
 
+// KubeletExtraArgs returns nil, the kubelet arguments of managed node groups are set in the bootstrap config.
+func (s *ManagedMachinePoolScope) KubeletExtraArgs() map[string]string {
+	return nil
+}
+
+// KubeadmNodeName returns an empty name, managed node groups aren't bootstrapped with kubeadm.
+func (s *ManagedMachinePoolScope) KubeadmNodeName() string {
+	return ""
+}
+
+// MaxPods returns nil, the max pods of managed node groups are set by the EKS bootstrap script.
+func (s *ManagedMachinePoolScope) MaxPods() *expinfrav1.MaxPodsOptions {
+	return nil
+}
+
+// RequiresGPU returns whether the AWSManagedMachinePool or the MachinePool is labeled as requiring GPUs.
+func (s *ManagedMachinePoolScope) RequiresGPU() bool {
+	return infrav1.IsGPURequired(s.ManagedMachinePool.Labels, s.MachinePool.Labels)
+}
+
+// MaxLaunchTemplateVersions returns nil, the launch template versions of managed node groups are pruned one at a time.
+func (s *ManagedMachinePoolScope) MaxLaunchTemplateVersions() *int32 {
+	return nil
+}
+
+// BaseLaunchTemplateID returns nil, the launch templates of managed node groups don't inherit from another one.
+func (s *ManagedMachinePoolScope) BaseLaunchTemplateID() *string {
+	return nil
+}
+
+// LaunchTemplateTags returns nil, the launch templates of managed node groups are only tagged with the additional tags.
+func (s *ManagedMachinePoolScope) LaunchTemplateTags() infrav1.Tags {
+	return nil
+}
+
+// InstanceTags returns nil, the instances of managed node groups are only tagged with the additional tags.
+func (s *ManagedMachinePoolScope) InstanceTags() infrav1.Tags {
+	return nil
+}
+
 func (s *ManagedMachinePoolScope) GetMachinePool() *expclusterv1.MachinePool {
 	return s.MachinePool
 }
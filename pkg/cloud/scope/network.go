@@ -33,6 +33,24 @@ type NetworkScope interface {
 	Subnets() infrav1.Subnets
 	// SetSubnets updates the clusters subnets.
 	SetSubnets(subnets infrav1.Subnets)
+	// SubnetCidrSizes returns the requested sizes of the default subnets.
+	SubnetCidrSizes() *infrav1.SubnetCidrSizes
+	// AdditionalRoutes returns the additional routes to add to the managed private route tables.
+	AdditionalRoutes() []infrav1.RouteSpec
+	// TransitGatewayAttachment returns the transit gateway attachment of the VPC, if any.
+	TransitGatewayAttachment() *infrav1.TransitGatewayAttachmentSpec
+	// VPCPeerings returns the peering connections of the VPC.
+	VPCPeerings() []infrav1.VPCPeeringSpec
+	// NetworkACL returns the network ACL of the managed subnets, if any.
+	NetworkACL() *infrav1.NetworkACLSpec
+	// KarpenterDiscovery returns the configuration of the karpenter.sh/discovery tag, if any.
+	KarpenterDiscovery() *infrav1.KarpenterDiscoverySpec
+	// NatGatewayStrategy returns how many NAT gateways are created for the private subnets.
+	NatGatewayStrategy() infrav1.NatGatewayStrategy
+	// FlowLogs returns the configuration of the flow log of the VPC, if any.
+	FlowLogs() *infrav1.FlowLogsSpec
+	// SubnetAvailableIPAddressThreshold returns the number of available IP addresses below which a subnet is low on IP addresses, if any.
+	SubnetAvailableIPAddressThreshold() *int64
 	// CNIIngressRules returns the CNI spec ingress rules.
 	CNIIngressRules() infrav1.CNIIngressRules
 	// SecurityGroups returns the cluster security groups as a map, it creates the map if empty.
@@ -49,6 +67,15 @@ type NetworkScope interface {
 	// TagUnmanagedNetworkResources returns is tagging unmanaged network resources is set.
 	TagUnmanagedNetworkResources() bool
 
+	// TagReconcilePolicy returns the policy applied to the tags of the resources changed outside of Cluster API.
+	TagReconcilePolicy() infrav1.TagReconcilePolicy
+	// RecordTagDrift records the tags of a resource that were removed or changed outside of Cluster API and are
+	// not re-applied.
+	RecordTagDrift(resourceID string, keys []string)
+
+	// UseBlockingWaiters returns if the reconcile blocks until the NAT gateways are available.
+	UseBlockingWaiters() bool
+
 	// SetNatGatewaysIPs sets the Nat Gateways Public IPs.
 	SetNatGatewaysIPs(ips []string)
 	// GetNatGatewaysIPs gets the Nat Gateways Public IPs.
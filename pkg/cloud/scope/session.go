@@ -19,6 +19,8 @@ package scope
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -38,6 +40,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/identity"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/throttle"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
@@ -52,6 +55,10 @@ const (
 	notPermittedError = "Namespace is not permitted to use %s: %s"
 )
 
+// errSecretNamespaceNotAllowed is returned when the secret of an AWSClusterStaticIdentity is in a namespace
+// the controller isn't allowed to read static identity secrets from.
+var errSecretNamespaceNotAllowed = errors.New("secret namespace is not allowed for static identities")
+
 // ServiceEndpoint defines a tuple containing AWS Service resolution information.
 type ServiceEndpoint struct {
 	ServiceID     string
@@ -61,6 +68,28 @@ type ServiceEndpoint struct {
 
 var sessionCache sync.Map
 var providerCache sync.Map
+var concurrencyGateCache sync.Map
+
+// maxConcurrentMutatingRequestsPerCluster is the maximum number of mutating AWS requests
+// that can be in flight for a single cluster. A value of 0 or less disables the limit.
+var maxConcurrentMutatingRequestsPerCluster int
+
+// staticIdentitySecretNamespaces are the namespaces, besides the namespace of the controller, from which
+// the secrets of AWSClusterStaticIdentities can be read.
+var staticIdentitySecretNamespaces []string
+
+// SetStaticIdentitySecretNamespaces sets the namespaces, besides the namespace of the controller, from which
+// the secrets of AWSClusterStaticIdentities can be read.
+func SetStaticIdentitySecretNamespaces(namespaces []string) {
+	staticIdentitySecretNamespaces = namespaces
+}
+
+// SetMaxConcurrentMutatingRequestsPerCluster sets the maximum number of mutating AWS requests
+// that can be in flight for a single cluster, so one cluster can not starve the others.
+// A value of 0 or less disables the limit.
+func SetMaxConcurrentMutatingRequestsPerCluster(limit int) {
+	maxConcurrentMutatingRequestsPerCluster = limit
+}
 
 type sessionCacheEntry struct {
 	session         *session.Session
@@ -95,6 +124,7 @@ func sessionForRegion(region string, endpoint []ServiceEndpoint) (*session.Sessi
 	if err != nil {
 		return nil, nil, err
 	}
+	ns.Handlers.Complete.PushBackNamed(awserrors.AnnotateRequestErrorHandler)
 
 	sl := newServiceLimiters()
 	sessionCache.Store(region, &sessionCacheEntry{
@@ -123,7 +153,11 @@ func sessionForClusterWithRegion(k8sClient client.Client, clusterScoper cloud.Cl
 	providers, err := getProvidersForCluster(context.Background(), k8sClient, clusterScoper, log)
 	if err != nil {
 		// could not get providers and retrieve the credentials
-		conditions.MarkFalse(clusterScoper.InfraCluster(), infrav1.PrincipalCredentialRetrievedCondition, infrav1.PrincipalCredentialRetrievalFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		reason := infrav1.PrincipalCredentialRetrievalFailedReason
+		if errors.Is(err, errSecretNamespaceNotAllowed) {
+			reason = infrav1.SecretNamespaceNotAllowedReason
+		}
+		conditions.MarkFalse(clusterScoper.InfraCluster(), infrav1.PrincipalCredentialRetrievedCondition, reason, clusterv1.ConditionSeverityError, err.Error())
 		return nil, nil, errors.Wrap(err, "Failed to get providers for cluster")
 	}
 
@@ -177,6 +211,13 @@ func sessionForClusterWithRegion(k8sClient client.Client, clusterScoper cloud.Cl
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "Failed to create a new AWS session")
 	}
+	ns.Handlers.Complete.PushBackNamed(awserrors.AnnotateRequestErrorHandler)
+	if gate := concurrencyGateForCluster(clusterScoper); gate != nil {
+		// The slot is held for each attempt of a request, and not while it waits to be retried.
+		ns.Handlers.Sign.PushFront(gate.LimitRequest)
+		ns.Handlers.CompleteAttempt.PushBack(gate.ReleaseRequest)
+		ns.Handlers.Complete.PushBack(gate.ReleaseRequest)
+	}
 	sl := newServiceLimiters()
 	sessionCache.Store(getSessionName(region, clusterScoper), &sessionCacheEntry{
 		session:         ns,
@@ -190,6 +231,28 @@ func getSessionName(region string, clusterScoper cloud.ClusterScoper) string {
 	return fmt.Sprintf("%s-%s-%s", region, clusterScoper.InfraClusterName(), clusterScoper.Namespace())
 }
 
+// concurrencyGateForCluster returns the gate shared by all the sessions of a cluster,
+// or nil if the number of concurrent mutating requests is not limited.
+func concurrencyGateForCluster(clusterScoper cloud.ClusterScoper) *throttle.ConcurrencyGate {
+	if maxConcurrentMutatingRequestsPerCluster <= 0 {
+		return nil
+	}
+	name := concurrencyGateName(clusterScoper)
+	gate, _ := concurrencyGateCache.LoadOrStore(name, throttle.NewConcurrencyGate(name, maxConcurrentMutatingRequestsPerCluster))
+	return gate.(*throttle.ConcurrencyGate)
+}
+
+// DeleteConcurrencyGate deletes the gate of a deleted cluster, along with its queue depth metric.
+func DeleteConcurrencyGate(clusterScoper cloud.ClusterScoper) {
+	if gate, ok := concurrencyGateCache.LoadAndDelete(concurrencyGateName(clusterScoper)); ok {
+		gate.(*throttle.ConcurrencyGate).Close()
+	}
+}
+
+func concurrencyGateName(clusterScoper cloud.ClusterScoper) string {
+	return fmt.Sprintf("%s/%s", clusterScoper.Namespace(), clusterScoper.InfraClusterName())
+}
+
 func newServiceLimiters() throttle.ServiceLimiters {
 	return throttle.ServiceLimiters{
 		ec2.ServiceID:                      newEC2ServiceLimiter(),
@@ -346,8 +409,16 @@ func buildAWSClusterStaticIdentity(ctx context.Context, identityObjectKey client
 	if err != nil {
 		return nil, err
 	}
+	secretNamespace := system.GetManagerNamespace()
+	if staticPrincipal.Spec.SecretNamespace != "" && staticPrincipal.Spec.SecretNamespace != secretNamespace {
+		if !slices.Contains(staticIdentitySecretNamespaces, staticPrincipal.Spec.SecretNamespace) {
+			return nil, errors.Wrapf(errSecretNamespaceNotAllowed, "%s %s references secret %s in namespace %s",
+				infrav1.ClusterStaticIdentityKind, staticPrincipal.Name, staticPrincipal.Spec.SecretRef, staticPrincipal.Spec.SecretNamespace)
+		}
+		secretNamespace = staticPrincipal.Spec.SecretNamespace
+	}
 	secret := &corev1.Secret{}
-	err = k8sClient.Get(ctx, client.ObjectKey{Name: staticPrincipal.Spec.SecretRef, Namespace: system.GetManagerNamespace()}, secret)
+	err = k8sClient.Get(ctx, client.ObjectKey{Name: staticPrincipal.Spec.SecretRef, Namespace: secretNamespace}, secret)
 	if err != nil {
 		return nil, err
 	}
@@ -408,6 +479,44 @@ func buildAWSClusterControllerIdentity(ctx context.Context, identityObjectKey cl
 	return nil
 }
 
+// identityRefForSelector returns a reference to the AWSClusterRoleIdentity or AWSClusterStaticIdentity matching
+// the given label selector. It returns an error unless exactly one identity matches.
+func identityRefForSelector(ctx context.Context, k8sClient client.Client, selector *metav1.LabelSelector) (*infrav1.AWSIdentityReference, error) {
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse identity selector")
+	}
+
+	refs := []infrav1.AWSIdentityReference{}
+	roleIdentities := &infrav1.AWSClusterRoleIdentityList{}
+	if err := k8sClient.List(ctx, roleIdentities, client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
+		return nil, errors.Wrap(err, "failed to list AWSClusterRoleIdentities")
+	}
+	for _, roleIdentity := range roleIdentities.Items {
+		refs = append(refs, infrav1.AWSIdentityReference{Name: roleIdentity.Name, Kind: infrav1.ClusterRoleIdentityKind})
+	}
+	staticIdentities := &infrav1.AWSClusterStaticIdentityList{}
+	if err := k8sClient.List(ctx, staticIdentities, client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
+		return nil, errors.Wrap(err, "failed to list AWSClusterStaticIdentities")
+	}
+	for _, staticIdentity := range staticIdentities.Items {
+		refs = append(refs, infrav1.AWSIdentityReference{Name: staticIdentity.Name, Kind: infrav1.ClusterStaticIdentityKind})
+	}
+
+	switch len(refs) {
+	case 0:
+		return nil, errors.Errorf("no identity matches identity selector %q", labelSelector.String())
+	case 1:
+		return &refs[0], nil
+	default:
+		names := make([]string, 0, len(refs))
+		for _, ref := range refs {
+			names = append(names, fmt.Sprintf("%s/%s", ref.Kind, ref.Name))
+		}
+		return nil, errors.Errorf("identity selector %q matches more than one identity: %s", labelSelector.String(), strings.Join(names, ", "))
+	}
+}
+
 func getProvidersForCluster(ctx context.Context, k8sClient client.Client, clusterScoper cloud.ClusterScoper, log logger.Wrapper) ([]identity.AWSPrincipalTypeProvider, error) {
 	providers := make([]identity.AWSPrincipalTypeProvider, 0)
 	providers, err := buildProvidersForRef(ctx, providers, k8sClient, clusterScoper, clusterScoper.IdentityRef(), log)
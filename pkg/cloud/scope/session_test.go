@@ -18,8 +18,15 @@ package scope
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -35,6 +42,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/util/system"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestIsClusterPermittedToUsePrincipal(t *testing.T) {
@@ -503,3 +511,380 @@ func TestPrincipalParsing(t *testing.T) {
 		})
 	}
 }
+
+func TestStaticIdentitySecretNamespace(t *testing.T) {
+	testCases := []struct {
+		name              string
+		secretNamespace   string
+		allowedNamespaces []string
+		expectErr         error
+	}{
+		{
+			name:            "Reads the secret from the namespace of the controller by default",
+			secretNamespace: system.GetManagerNamespace(),
+		},
+		{
+			name:              "Reads the secret from an allowed namespace",
+			secretNamespace:   "credentials",
+			allowedNamespaces: []string{"credentials"},
+		},
+		{
+			name:              "Does not read the secret from a namespace which isn't allowed",
+			secretNamespace:   "credentials",
+			allowedNamespaces: []string{"other-credentials"},
+			expectErr:         errSecretNamespaceNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			SetStaticIdentitySecretNamespaces(tc.allowedNamespaces)
+			defer SetStaticIdentitySecretNamespaces(nil)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			staticIdentity := &infrav1.AWSClusterStaticIdentity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "static-identity",
+				},
+				Spec: infrav1.AWSClusterStaticIdentitySpec{
+					SecretRef:       "static-credentials-secret",
+					SecretNamespace: tc.secretNamespace,
+					AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+						AllowedNamespaces: &infrav1.AllowedNamespaces{},
+					},
+				},
+			}
+			g.Expect(k8sClient.Create(context.Background(), staticIdentity)).To(Succeed())
+			g.Expect(k8sClient.Create(context.Background(), &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "static-credentials-secret",
+					Namespace: tc.secretNamespace,
+				},
+				Data: map[string][]byte{
+					"AccessKeyID":     []byte("1234567890"),
+					"SecretAccessKey": []byte("abcdefghijklmnop"),
+				},
+			})).To(Succeed())
+
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: infrav1.AWSClusterSpec{
+					IdentityRef: &infrav1.AWSIdentityReference{
+						Name: "static-identity",
+						Kind: infrav1.ClusterStaticIdentityKind,
+					},
+				},
+			}
+			clusterScope, err := NewClusterScope(ClusterScopeParams{
+				Client: k8sClient,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "default",
+					},
+				},
+				AWSCluster: awsCluster,
+			})
+			if tc.expectErr != nil {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectErr.Error())))
+				condition := conditions.Get(awsCluster, infrav1.PrincipalCredentialRetrievedCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+				g.Expect(condition.Reason).To(Equal(infrav1.SecretNamespaceNotAllowedReason))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+
+			providers, err := getProvidersForCluster(context.Background(), k8sClient, clusterScope, logger.NewLogger(klog.Background()))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(providers).To(HaveLen(1))
+			p, ok := providers[0].(*identity.AWSStaticPrincipalTypeProvider)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(p.AccessKeyID).To(Equal("1234567890"))
+		})
+	}
+}
+
+func TestIdentityRefForSelector(t *testing.T) {
+	testCases := []struct {
+		name        string
+		selector    *metav1.LabelSelector
+		expectRef   *infrav1.AWSIdentityReference
+		expectError string
+	}{
+		{
+			name:      "Selects the only matching identity",
+			selector:  &metav1.LabelSelector{MatchLabels: map[string]string{"account": "production"}},
+			expectRef: &infrav1.AWSIdentityReference{Name: "production", Kind: infrav1.ClusterRoleIdentityKind},
+		},
+		{
+			name:      "Selects static identities",
+			selector:  &metav1.LabelSelector{MatchLabels: map[string]string{"account": "development"}},
+			expectRef: &infrav1.AWSIdentityReference{Name: "development", Kind: infrav1.ClusterStaticIdentityKind},
+		},
+		{
+			name:        "Fails when no identity matches",
+			selector:    &metav1.LabelSelector{MatchLabels: map[string]string{"account": "staging"}},
+			expectError: "no identity matches identity selector",
+		},
+		{
+			name:        "Fails when more than one identity matches",
+			selector:    &metav1.LabelSelector{MatchLabels: map[string]string{"team": "platform"}},
+			expectError: "matches more than one identity",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&infrav1.AWSClusterRoleIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "production",
+						Labels: map[string]string{"account": "production", "team": "platform"},
+					},
+				},
+				&infrav1.AWSClusterStaticIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "development",
+						Labels: map[string]string{"account": "development", "team": "platform"},
+					},
+				},
+			).Build()
+
+			ref, err := identityRefForSelector(context.Background(), k8sClient, tc.selector)
+			if tc.expectError != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectError)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(ref).To(Equal(tc.expectRef))
+		})
+	}
+}
+
+func TestClusterScopeIdentitySelector(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme, err := setupScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&infrav1.AWSClusterStaticIdentity{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "development",
+				Labels: map[string]string{"account": "development"},
+			},
+			Spec: infrav1.AWSClusterStaticIdentitySpec{
+				SecretRef: "development-credentials",
+				AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+					AllowedNamespaces: &infrav1.AllowedNamespaces{},
+				},
+			},
+		},
+		&infrav1.AWSClusterControllerIdentity{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: infrav1.AWSClusterControllerIdentityName,
+			},
+			Spec: infrav1.AWSClusterControllerIdentitySpec{
+				AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+					AllowedNamespaces: &infrav1.AllowedNamespaces{},
+				},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "development-credentials",
+				Namespace: system.GetManagerNamespace(),
+			},
+			Data: map[string][]byte{
+				"AccessKeyID":     []byte("1234567890"),
+				"SecretAccessKey": []byte("abcdefghijklmnop"),
+			},
+		},
+	).Build()
+
+	newAWSCluster := func(identityRef *infrav1.AWSIdentityReference, account string) *infrav1.AWSCluster {
+		return &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "default",
+			},
+			Spec: infrav1.AWSClusterSpec{
+				IdentityRef:      identityRef,
+				IdentitySelector: &metav1.LabelSelector{MatchLabels: map[string]string{"account": account}},
+			},
+		}
+	}
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+	}
+
+	clusterScope, err := NewClusterScope(ClusterScopeParams{
+		Client:     k8sClient,
+		Cluster:    cluster,
+		AWSCluster: newAWSCluster(nil, "development"),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(clusterScope.IdentityRef()).To(Equal(&infrav1.AWSIdentityReference{Name: "development", Kind: infrav1.ClusterStaticIdentityKind}))
+
+	// The identity reference takes precedence over the identity selector.
+	controllerIdentityRef := &infrav1.AWSIdentityReference{Name: infrav1.AWSClusterControllerIdentityName, Kind: infrav1.ControllerIdentityKind}
+	clusterScope, err = NewClusterScope(ClusterScopeParams{
+		Client:     k8sClient,
+		Cluster:    cluster,
+		AWSCluster: newAWSCluster(controllerIdentityRef, "staging"),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(clusterScope.IdentityRef()).To(Equal(controllerIdentityRef))
+
+	awsCluster := newAWSCluster(nil, "staging")
+	_, err = NewClusterScope(ClusterScopeParams{
+		Client:     k8sClient,
+		Cluster:    cluster,
+		AWSCluster: awsCluster,
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("no identity matches identity selector")))
+	g.Expect(conditions.GetReason(awsCluster, infrav1.PrincipalCredentialRetrievedCondition)).To(Equal(infrav1.IdentitySelectionFailedReason))
+}
+
+func TestMutatingRequestsAreLimitedPerCluster(t *testing.T) {
+	g := NewWithT(t)
+
+	SetMaxConcurrentMutatingRequestsPerCluster(2)
+	defer SetMaxConcurrentMutatingRequestsPerCluster(0)
+
+	newScope := func(name string) *ClusterScope {
+		scheme := runtime.NewScheme()
+		_ = infrav1.AddToScheme(scheme)
+		cl := fake.NewClientBuilder().WithScheme(scheme).Build()
+		clusterScope, err := NewClusterScope(ClusterScopeParams{
+			Client: cl,
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "default",
+				},
+			},
+			AWSCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "default",
+				},
+			},
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		return clusterScope
+	}
+	newRequest := func(s *ClusterScope, operation string) *request.Request {
+		return request.New(
+			aws.Config{Region: aws.String("us-east-1")},
+			metadata.ClientInfo{Endpoint: "https://ec2.us-east-1.amazonaws.com"},
+			s.Session().(*session.Session).Handlers.Copy(),
+			nil,
+			&request.Operation{Name: operation},
+			nil,
+			nil,
+		)
+	}
+
+	clusterA := newScope("concurrency-cluster-a")
+	clusterB := newScope("concurrency-cluster-b")
+
+	// Simulate concurrent reconciles of both clusters and track the in-flight requests of each.
+	inFlight := map[string]*int32{clusterA.Name(): new(int32), clusterB.Name(): new(int32)}
+	maxInFlight := map[string]*int32{clusterA.Name(): new(int32), clusterB.Name(): new(int32)}
+	var wg sync.WaitGroup
+	for _, s := range []*ClusterScope{clusterA, clusterB} {
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(s *ClusterScope) {
+				defer wg.Done()
+				r := newRequest(s, "RunInstances")
+				r.Sign()
+				if r.Error != nil {
+					t.Errorf("unexpected error building request: %v", r.Error)
+					return
+				}
+				current := atomic.AddInt32(inFlight[s.Name()], 1)
+				for {
+					observed := atomic.LoadInt32(maxInFlight[s.Name()])
+					if current <= observed || atomic.CompareAndSwapInt32(maxInFlight[s.Name()], observed, current) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				atomic.AddInt32(inFlight[s.Name()], -1)
+				r.Handlers.Complete.Run(r)
+			}(s)
+		}
+	}
+	wg.Wait()
+
+	g.Expect(atomic.LoadInt32(maxInFlight[clusterA.Name()])).To(BeNumerically("<=", 2))
+	g.Expect(atomic.LoadInt32(maxInFlight[clusterB.Name()])).To(BeNumerically("<=", 2))
+
+	// A cluster using all of its slots must not block the requests of another cluster.
+	held := []*request.Request{newRequest(clusterA, "RunInstances"), newRequest(clusterA, "TerminateInstances")}
+	for _, r := range held {
+		r.Sign()
+		g.Expect(r.Error).NotTo(HaveOccurred())
+	}
+
+	queued := make(chan struct{})
+	go func() {
+		r := newRequest(clusterA, "CreateTags")
+		r.Sign()
+		close(queued)
+		r.Handlers.Complete.Run(r)
+	}()
+
+	otherCluster := make(chan struct{})
+	go func() {
+		r := newRequest(clusterB, "CreateTags")
+		r.Sign()
+		r.Handlers.Complete.Run(r)
+		close(otherCluster)
+	}()
+
+	g.Eventually(otherCluster).Should(BeClosed())
+	g.Consistently(queued, 100*time.Millisecond).ShouldNot(BeClosed())
+
+	// Read-only requests are not limited.
+	describe := newRequest(clusterA, "DescribeInstances")
+	describe.Sign()
+	g.Expect(describe.Error).NotTo(HaveOccurred())
+
+	// The slot of a request waiting to be retried is handed over to the queued request.
+	held[0].Handlers.CompleteAttempt.Run(held[0])
+	g.Eventually(queued).Should(BeClosed())
+
+	// The slot is acquired again for the next attempt, and released once the request completes.
+	retried := make(chan struct{})
+	go func() {
+		held[0].Sign()
+		close(retried)
+	}()
+	g.Eventually(retried).Should(BeClosed())
+	g.Expect(held[0].Error).NotTo(HaveOccurred())
+	for _, r := range held {
+		r.Handlers.Complete.Run(r)
+	}
+
+	DeleteConcurrencyGate(clusterA)
+	_, ok := concurrencyGateCache.Load(concurrencyGateName(clusterA))
+	g.Expect(ok).To(BeFalse())
+}
@@ -34,6 +34,21 @@ type SGScope interface {
 	// SecurityGroupOverrides returns the security groups that are used as overrides in the cluster spec
 	SecurityGroupOverrides() map[infrav1.SecurityGroupRole]string
 
+	// SecurityGroupReconcileStrategies returns how the ingress rules of the security groups are reconciled, per role.
+	SecurityGroupReconcileStrategies() map[infrav1.SecurityGroupRole]infrav1.SecurityGroupRoleReconcileStrategy
+
+	// SecurityGroupEgressRules returns the egress rules replacing the default egress rule of the security groups, per role.
+	SecurityGroupEgressRules() map[infrav1.SecurityGroupRole]infrav1.EgressRules
+
+	// KarpenterDiscovery returns the configuration of the karpenter.sh/discovery tag, if any.
+	KarpenterDiscovery() *infrav1.KarpenterDiscoverySpec
+
+	// TagReconcilePolicy returns the policy applied to the tags of the resources changed outside of Cluster API.
+	TagReconcilePolicy() infrav1.TagReconcilePolicy
+	// RecordTagDrift records the tags of a resource that were removed or changed outside of Cluster API and are
+	// not re-applied.
+	RecordTagDrift(resourceID string, keys []string)
+
 	// VPC returns the cluster VPC.
 	VPC() *infrav1.VPCSpec
 
@@ -43,6 +58,9 @@ type SGScope interface {
 	// Bastion returns the bastion details for the cluster.
 	Bastion() *infrav1.Bastion
 
+	// InstanceConnectEndpoint returns the EC2 Instance Connect Endpoint details for the cluster, if any.
+	InstanceConnectEndpoint() *infrav1.InstanceConnectEndpointSpec
+
 	// ControlPlaneLoadBalancer returns the load balancer settings that are requested.
 	ControlPlaneLoadBalancer() *infrav1.AWSLoadBalancerSpec
 
@@ -54,4 +72,7 @@ type SGScope interface {
 
 	// AdditionalControlPlaneIngressRules returns the additional ingress rules for the control plane security group.
 	AdditionalControlPlaneIngressRules() []infrav1.IngressRule
+
+	// PodCIDRBlocks returns the CIDR blocks used by the CNI for pod IPs.
+	PodCIDRBlocks() []string
 }
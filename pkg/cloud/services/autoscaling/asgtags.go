@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+)
+
+// ASGTagsLastAppliedAnnotation is the key for the AWSMachinePool object annotation which tracks the ASGTags applied
+// to the ASG, with their propagate at launch flag.
+const ASGTagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-aws-last-applied-asg-tags"
+
+// ASGTagsToMap returns the keys and values of ASG tags.
+func ASGTagsToMap(asgTags []expinfrav1.ASGTag) infrav1.Tags {
+	if len(asgTags) == 0 {
+		return nil
+	}
+	tags := make(infrav1.Tags, len(asgTags))
+	for _, tag := range asgTags {
+		tags[tag.Key] = tag.Value
+	}
+	return tags
+}
+
+// ParseASGTagsAnnotation returns the ASG tags recorded in the ASGTagsLastAppliedAnnotation annotation.
+func ParseASGTagsAnnotation(annotation string) ([]expinfrav1.ASGTag, error) {
+	if annotation == "" {
+		return nil, nil
+	}
+	var tags []expinfrav1.ASGTag
+	if err := json.Unmarshal([]byte(annotation), &tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// ASGTagsDiff returns the ASG tags to create or update and the tags to remove to go from the previously applied ASG
+// tags to the desired ones. The tags no longer set in the ASG tags are reset to their value in the additional tags,
+// not propagated at launch, rather than removed.
+func ASGTagsDiff(previous, desired []expinfrav1.ASGTag, additional infrav1.Tags) (create []expinfrav1.ASGTag, remove map[string]string) {
+	remove = map[string]string{}
+
+	previousByKey := make(map[string]expinfrav1.ASGTag, len(previous))
+	for _, tag := range previous {
+		previousByKey[tag.Key] = tag
+	}
+	desiredByKey := make(map[string]expinfrav1.ASGTag, len(desired))
+	for _, tag := range desired {
+		desiredByKey[tag.Key] = tag
+		if previousTag, ok := previousByKey[tag.Key]; !ok || previousTag != tag {
+			create = append(create, tag)
+		}
+	}
+	for _, tag := range previous {
+		if _, ok := desiredByKey[tag.Key]; ok {
+			continue
+		}
+		if value, ok := additional[tag.Key]; ok {
+			create = append(create, expinfrav1.ASGTag{Key: tag.Key, Value: value})
+		} else {
+			remove[tag.Key] = tag.Value
+		}
+	}
+	return create, remove
+}
+
+// setPropagateAtLaunch sets the propagate at launch flag of the tags of an ASG that are ASG tags.
+func setPropagateAtLaunch(tags []*autoscaling.Tag, asgTags []expinfrav1.ASGTag) {
+	propagateAtLaunch := make(map[string]bool, len(asgTags))
+	for _, tag := range asgTags {
+		propagateAtLaunch[tag.Key] = tag.PropagateAtLaunch
+	}
+	for _, tag := range tags {
+		if propagate, ok := propagateAtLaunch[aws.StringValue(tag.Key)]; ok {
+			tag.PropagateAtLaunch = aws.Bool(propagate)
+		}
+	}
+}
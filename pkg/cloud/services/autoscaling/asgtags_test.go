@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+)
+
+func TestASGTagsDiff(t *testing.T) {
+	tests := []struct {
+		name       string
+		previous   []expinfrav1.ASGTag
+		desired    []expinfrav1.ASGTag
+		additional infrav1.Tags
+		wantCreate []expinfrav1.ASGTag
+		wantRemove map[string]string
+	}{
+		{
+			name:       "nothing to do without ASG tags",
+			wantRemove: map[string]string{},
+		},
+		{
+			name: "new tags are created with their propagation flag",
+			desired: []expinfrav1.ASGTag{
+				{Key: "team", Value: "platform", PropagateAtLaunch: true},
+				{Key: "env", Value: "prod"},
+			},
+			wantCreate: []expinfrav1.ASGTag{
+				{Key: "team", Value: "platform", PropagateAtLaunch: true},
+				{Key: "env", Value: "prod"},
+			},
+			wantRemove: map[string]string{},
+		},
+		{
+			name: "tags whose value or propagation flag changed are updated",
+			previous: []expinfrav1.ASGTag{
+				{Key: "team", Value: "platform"},
+				{Key: "env", Value: "dev"},
+				{Key: "owner", Value: "me"},
+			},
+			desired: []expinfrav1.ASGTag{
+				{Key: "team", Value: "platform", PropagateAtLaunch: true},
+				{Key: "env", Value: "prod"},
+				{Key: "owner", Value: "me"},
+			},
+			wantCreate: []expinfrav1.ASGTag{
+				{Key: "team", Value: "platform", PropagateAtLaunch: true},
+				{Key: "env", Value: "prod"},
+			},
+			wantRemove: map[string]string{},
+		},
+		{
+			name: "removed tags are reset to the additional tags or removed",
+			previous: []expinfrav1.ASGTag{
+				{Key: "team", Value: "platform", PropagateAtLaunch: true},
+				{Key: "env", Value: "prod"},
+			},
+			additional: infrav1.Tags{
+				"env": "dev",
+			},
+			wantCreate: []expinfrav1.ASGTag{
+				{Key: "env", Value: "dev"},
+			},
+			wantRemove: map[string]string{
+				"team": "platform",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			create, remove := ASGTagsDiff(tt.previous, tt.desired, tt.additional)
+			g.Expect(create).To(Equal(tt.wantCreate))
+			g.Expect(remove).To(Equal(tt.wantRemove))
+		})
+	}
+}
+
+func TestParseASGTagsAnnotation(t *testing.T) {
+	g := NewWithT(t)
+
+	tags, err := ParseASGTagsAnnotation("")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(tags).To(BeEmpty())
+
+	tags, err = ParseASGTagsAnnotation(`[{"key":"team","value":"platform","propagateAtLaunch":true}]`)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(tags).To(Equal([]expinfrav1.ASGTag{{Key: "team", Value: "platform", PropagateAtLaunch: true}}))
+
+	_, err = ParseASGTagsAnnotation("not json")
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"encoding/json"
+	"fmt"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+)
+
+const (
+	// AutoscalerTagsLastAppliedAnnotation is the key for the AWSMachinePool object annotation which tracks the
+	// cluster autoscaler tags applied to the ASG.
+	AutoscalerTagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-aws-last-applied-autoscaler-tags"
+
+	// autoscalerTagPrefix is the prefix of all the tags read by the cluster autoscaler.
+	autoscalerTagPrefix = "k8s.io/cluster-autoscaler/"
+
+	// autoscalerEnabledTagKey is the tag the cluster autoscaler auto-discovers ASGs with.
+	autoscalerEnabledTagKey = autoscalerTagPrefix + "enabled"
+
+	// autoscalerLabelTagPrefix and autoscalerTaintTagPrefix are the prefixes of the tags describing the labels and
+	// taints of the nodes of an ASG, used by the cluster autoscaler to build a node template when scaling from zero.
+	autoscalerLabelTagPrefix = autoscalerTagPrefix + "node-template/label/"
+	autoscalerTaintTagPrefix = autoscalerTagPrefix + "node-template/taint/"
+)
+
+// AutoscalerTags returns the cluster autoscaler tags of the ASG of an AWSMachinePool, nil if they are not enabled.
+func AutoscalerTags(clusterName string, options *expinfrav1.AutoscalerTags) (infrav1.Tags, error) {
+	if options == nil {
+		return nil, nil
+	}
+
+	tags := infrav1.Tags{
+		autoscalerEnabledTagKey:           "true",
+		autoscalerTagPrefix + clusterName: string(infrav1.ResourceLifecycleOwned),
+	}
+	for key, value := range options.Labels {
+		tags[autoscalerLabelTagPrefix+key] = value
+	}
+	for _, taint := range options.Taints {
+		effect, err := converters.TaintEffectToKubernetes(taint.Effect)
+		if err != nil {
+			return nil, fmt.Errorf("converting taint effect %s: %w", taint.Effect, err)
+		}
+		tags[autoscalerTaintTagPrefix+taint.Key] = fmt.Sprintf("%s:%s", taint.Value, effect)
+	}
+	return tags, nil
+}
+
+// ParseAutoscalerTagsAnnotation returns the cluster autoscaler tags recorded in the
+// AutoscalerTagsLastAppliedAnnotation annotation.
+func ParseAutoscalerTagsAnnotation(annotation string) (infrav1.Tags, error) {
+	if annotation == "" {
+		return nil, nil
+	}
+	var tags infrav1.Tags
+	if err := json.Unmarshal([]byte(annotation), &tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// AutoscalerTagsDiff returns the cluster autoscaler tags to create or update to bring the current tags of an ASG to
+// the desired ones, and the previously applied ones to remove. Only the tags applied by CAPA are removed, and the tags
+// set explicitly in the additional tags are left alone.
+func AutoscalerTagsDiff(previous, current, desired, additional infrav1.Tags) (create, remove map[string]string) {
+	create = map[string]string{}
+	remove = map[string]string{}
+
+	for key, value := range desired {
+		if _, ok := additional[key]; ok {
+			continue
+		}
+		if currentValue, ok := current[key]; !ok || currentValue != value {
+			create[key] = value
+		}
+	}
+	for key, value := range previous {
+		if _, ok := additional[key]; ok {
+			continue
+		}
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if _, ok := current[key]; ok {
+			remove[key] = value
+		}
+	}
+	return create, remove
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+)
+
+func TestAutoscalerTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *expinfrav1.AutoscalerTags
+		want    infrav1.Tags
+		wantErr bool
+	}{
+		{
+			name: "no tags when not enabled",
+			want: nil,
+		},
+		{
+			name:    "discovery tags only",
+			options: &expinfrav1.AutoscalerTags{},
+			want: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/enabled": "true",
+				"k8s.io/cluster-autoscaler/test":    "owned",
+			},
+		},
+		{
+			name: "node template tags for labels and taints",
+			options: &expinfrav1.AutoscalerTags{
+				Labels: map[string]string{
+					"node.kubernetes.io/pool": "gpu",
+				},
+				Taints: expinfrav1.Taints{
+					{Key: "nvidia.com/gpu", Value: "present", Effect: expinfrav1.TaintEffectNoSchedule},
+					{Key: "dedicated", Value: "batch", Effect: expinfrav1.TaintEffectNoExecute},
+					{Key: "spot", Value: "true", Effect: expinfrav1.TaintEffectPreferNoSchedule},
+				},
+			},
+			want: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/enabled":                                     "true",
+				"k8s.io/cluster-autoscaler/test":                                        "owned",
+				"k8s.io/cluster-autoscaler/node-template/label/node.kubernetes.io/pool": "gpu",
+				"k8s.io/cluster-autoscaler/node-template/taint/nvidia.com/gpu":          "present:NoSchedule",
+				"k8s.io/cluster-autoscaler/node-template/taint/dedicated":               "batch:NoExecute",
+				"k8s.io/cluster-autoscaler/node-template/taint/spot":                    "true:PreferNoSchedule",
+			},
+		},
+		{
+			name: "unknown taint effect",
+			options: &expinfrav1.AutoscalerTags{
+				Taints: expinfrav1.Taints{
+					{Key: "dedicated", Value: "batch", Effect: expinfrav1.TaintEffect("NoSchedule")},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			tags, err := AutoscalerTags("test", tt.options)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tags).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestAutoscalerTagsDiff(t *testing.T) {
+	tests := []struct {
+		name       string
+		previous   infrav1.Tags
+		current    infrav1.Tags
+		desired    infrav1.Tags
+		additional infrav1.Tags
+		wantCreate map[string]string
+		wantRemove map[string]string
+	}{
+		{
+			name: "missing tags are created",
+			current: infrav1.Tags{
+				"Name": "pool",
+			},
+			desired: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/enabled":                "true",
+				"k8s.io/cluster-autoscaler/node-template/label/os": "linux",
+			},
+			wantCreate: map[string]string{
+				"k8s.io/cluster-autoscaler/enabled":                "true",
+				"k8s.io/cluster-autoscaler/node-template/label/os": "linux",
+			},
+			wantRemove: map[string]string{},
+		},
+		{
+			name: "changed tags are updated and stale ones removed",
+			previous: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/enabled":                  "true",
+				"k8s.io/cluster-autoscaler/node-template/label/os":   "windows",
+				"k8s.io/cluster-autoscaler/node-template/taint/spot": "true:NoSchedule",
+			},
+			current: infrav1.Tags{
+				"Name":                              "pool",
+				"k8s.io/cluster-autoscaler/enabled": "true",
+				"k8s.io/cluster-autoscaler/node-template/label/os":   "windows",
+				"k8s.io/cluster-autoscaler/node-template/taint/spot": "true:NoSchedule",
+			},
+			desired: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/enabled":                "true",
+				"k8s.io/cluster-autoscaler/node-template/label/os": "linux",
+			},
+			wantCreate: map[string]string{
+				"k8s.io/cluster-autoscaler/node-template/label/os": "linux",
+			},
+			wantRemove: map[string]string{
+				"k8s.io/cluster-autoscaler/node-template/taint/spot": "true:NoSchedule",
+			},
+		},
+		{
+			name: "tags which were not applied by CAPA are left alone",
+			previous: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/enabled": "true",
+			},
+			current: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/enabled":                           "true",
+				"k8s.io/cluster-autoscaler/node-template/resources/ephemeral": "100Gi",
+			},
+			desired: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/enabled": "true",
+			},
+			wantCreate: map[string]string{},
+			wantRemove: map[string]string{},
+		},
+		{
+			name: "all the applied tags are removed when they are no longer enabled",
+			previous: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/enabled":                "true",
+				"k8s.io/cluster-autoscaler/test":                   "owned",
+				"k8s.io/cluster-autoscaler/node-template/label/os": "linux",
+			},
+			current: infrav1.Tags{
+				"Name":                              "pool",
+				"k8s.io/cluster-autoscaler/enabled": "true",
+				"k8s.io/cluster-autoscaler/test":    "owned",
+				"k8s.io/cluster-autoscaler/node-template/label/os": "linux",
+			},
+			wantCreate: map[string]string{},
+			wantRemove: map[string]string{
+				"k8s.io/cluster-autoscaler/enabled":                "true",
+				"k8s.io/cluster-autoscaler/test":                   "owned",
+				"k8s.io/cluster-autoscaler/node-template/label/os": "linux",
+			},
+		},
+		{
+			name: "tags set in the additional tags are left alone",
+			current: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/node-template/label/os":            "windows",
+				"k8s.io/cluster-autoscaler/node-template/label/deprecated":    "true",
+				"k8s.io/cluster-autoscaler/node-template/resources/ephemeral": "100Gi",
+			},
+			desired: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/node-template/label/os": "linux",
+			},
+			previous: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/node-template/label/os":         "linux",
+				"k8s.io/cluster-autoscaler/node-template/label/deprecated": "true",
+			},
+			additional: infrav1.Tags{
+				"k8s.io/cluster-autoscaler/node-template/label/os":            "windows",
+				"k8s.io/cluster-autoscaler/node-template/label/deprecated":    "true",
+				"k8s.io/cluster-autoscaler/node-template/resources/ephemeral": "100Gi",
+			},
+			wantCreate: map[string]string{},
+			wantRemove: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			create, remove := AutoscalerTagsDiff(tt.previous, tt.current, tt.desired, tt.additional)
+			g.Expect(create).To(Equal(tt.wantCreate))
+			g.Expect(remove).To(Equal(tt.wantRemove))
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -188,6 +189,18 @@ func (s *Service) CreateASG(machinePoolScope *scope.MachinePoolScope) (*expinfra
 
 	// Make sure to use the MachinePoolScope here to get the merger of AWSCluster and AWSMachinePool tags
 	additionalTags := machinePoolScope.AdditionalTags()
+	// Add the cluster autoscaler tags, unless set explicitly in the additional tags
+	autoscalerTags, err := AutoscalerTags(s.scope.KubernetesClusterName(), machinePoolScope.AWSMachinePool.Spec.AutoscalerTags)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build the cluster autoscaler tags")
+	}
+	for key, value := range autoscalerTags {
+		if _, ok := additionalTags[key]; !ok {
+			additionalTags[key] = value
+		}
+	}
+	// The ASG specific tags take precedence over the additional tags
+	additionalTags.Merge(ASGTagsToMap(machinePoolScope.AWSMachinePool.Spec.ASGTags))
 	// Set the cloud provider tag
 	additionalTags[infrav1.ClusterAWSCloudProviderTagKey(s.scope.KubernetesClusterName())] = string(infrav1.ResourceLifecycleOwned)
 
@@ -200,7 +213,7 @@ func (s *Service) CreateASG(machinePoolScope *scope.MachinePoolScope) (*expinfra
 	})
 
 	s.scope.Info("Running instance")
-	if err := s.runPool(input, machinePoolScope.AWSMachinePool.Status.LaunchTemplateID); err != nil {
+	if err := s.runPool(input, machinePoolScope.AWSMachinePool.Status.LaunchTemplateID, machinePoolScope.AWSMachinePool.Spec.ASGTags); err != nil {
 		// Only record the failure event if the error is not related to failed dependencies.
 		// This is to avoid spamming failure events since the machine will be requeued by the actuator.
 		// if !awserrors.IsFailedDependency(errors.Cause(err)) {
@@ -214,7 +227,7 @@ func (s *Service) CreateASG(machinePoolScope *scope.MachinePoolScope) (*expinfra
 	return nil, nil
 }
 
-func (s *Service) runPool(i *expinfrav1.AutoScalingGroup, launchTemplateID string) error {
+func (s *Service) runPool(i *expinfrav1.AutoScalingGroup, launchTemplateID string, asgTags []expinfrav1.ASGTag) error {
 	input := &autoscaling.CreateAutoScalingGroupInput{
 		AutoScalingGroupName: aws.String(i.Name),
 		MaxSize:              aws.Int64(int64(i.MaxSize)),
@@ -239,6 +252,7 @@ func (s *Service) runPool(i *expinfrav1.AutoScalingGroup, launchTemplateID strin
 
 	if i.Tags != nil {
 		input.Tags = BuildTagsFromMap(i.Name, i.Tags)
+		setPropagateAtLaunch(input.Tags, asgTags)
 	}
 
 	if _, err := s.ASGClient.CreateAutoScalingGroupWithContext(context.TODO(), input); err != nil {
@@ -345,7 +359,7 @@ func (s *Service) CanStartASGInstanceRefresh(scope *scope.MachinePoolScope) (boo
 // StartASGInstanceRefresh will start an ASG instance with refresh.
 func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) error {
 	strategy := ptr.To[string](autoscaling.RefreshStrategyRolling)
-	var minHealthyPercentage, instanceWarmup *int64
+	var minHealthyPercentage, maxHealthyPercentage, instanceWarmup *int64
 	if scope.AWSMachinePool.Spec.RefreshPreferences != nil {
 		if scope.AWSMachinePool.Spec.RefreshPreferences.Strategy != nil {
 			strategy = scope.AWSMachinePool.Spec.RefreshPreferences.Strategy
@@ -356,6 +370,23 @@ func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) error {
 		if scope.AWSMachinePool.Spec.RefreshPreferences.MinHealthyPercentage != nil {
 			minHealthyPercentage = scope.AWSMachinePool.Spec.RefreshPreferences.MinHealthyPercentage
 		}
+
+		desiredCapacity, err := s.refreshDesiredCapacity(scope)
+		if err != nil {
+			return err
+		}
+		minHealthy, maxHealthy, surgeCapped, err := refreshHealthyPercentages(scope.AWSMachinePool.Spec.RefreshPreferences, desiredCapacity)
+		if err != nil {
+			return errors.Wrapf(err, "failed to compute the healthy percentages of ASG instance refresh %q", scope.Name())
+		}
+		if surgeCapped {
+			record.Warnf(scope.AWSMachinePool, "MaxSurgeCapped", "Max surge %s of ASG %q is capped at 200%% of its desired capacity of %d instances",
+				scope.AWSMachinePool.Spec.RefreshPreferences.MaxSurge.String(), scope.Name(), desiredCapacity)
+		}
+		if minHealthy != nil {
+			minHealthyPercentage = minHealthy
+		}
+		maxHealthyPercentage = maxHealthy
 	}
 
 	input := &autoscaling.StartInstanceRefreshInput{
@@ -364,6 +395,7 @@ func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) error {
 		Preferences: &autoscaling.RefreshPreferences{
 			InstanceWarmup:       instanceWarmup,
 			MinHealthyPercentage: minHealthyPercentage,
+			MaxHealthyPercentage: maxHealthyPercentage,
 		},
 	}
 
@@ -374,6 +406,91 @@ func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) error {
 	return nil
 }
 
+// refreshDesiredCapacity returns the desired capacity the absolute MaxSurge and MaxUnavailable bounds of an instance
+// refresh are adjusted to. The healthy percentages of the refresh apply to the current desired capacity of the ASG,
+// which differs from the replicas of the MachinePool while they are managed by an external autoscaler, so it is only
+// described when one of the bounds is an absolute number.
+func (s *Service) refreshDesiredCapacity(scope *scope.MachinePoolScope) (int32, error) {
+	prefs := scope.AWSMachinePool.Spec.RefreshPreferences
+	if !isAbsoluteBound(prefs.MaxSurge) && !isAbsoluteBound(prefs.MaxUnavailable) {
+		return 0, nil
+	}
+
+	asg, err := s.GetASGByName(scope)
+	if err != nil {
+		return 0, err
+	}
+	if asg != nil && asg.DesiredCapacity != nil {
+		return *asg.DesiredCapacity, nil
+	}
+	return ptr.Deref(scope.MachinePool.Spec.Replicas, 0), nil
+}
+
+func isAbsoluteBound(bound *intstr.IntOrString) bool {
+	return bound != nil && bound.Type == intstr.Int
+}
+
+// refreshHealthyPercentages translates the MaxSurge and MaxUnavailable bounds of an instance refresh
+// into the minimum and maximum healthy percentages of the desired capacity of the ASG, and returns whether
+// MaxSurge was capped to the 200% AWS allows.
+// A nil percentage is left to the MinHealthyPercentage preference or to the AWS defaults.
+func refreshHealthyPercentages(prefs *expinfrav1.RefreshPreferences, desiredCapacity int32) (minHealthy, maxHealthy *int64, surgeCapped bool, err error) {
+	if prefs.MaxSurge == nil && prefs.MaxUnavailable == nil {
+		return nil, nil, false, nil
+	}
+
+	if prefs.MaxUnavailable != nil {
+		unavailable, err := percentageOfCapacity(prefs.MaxUnavailable, desiredCapacity)
+		if err != nil {
+			return nil, nil, false, errors.Wrap(err, "invalid maxUnavailable")
+		}
+		minHealthy = aws.Int64(max(100-unavailable, 0))
+	}
+
+	if prefs.MaxSurge != nil {
+		surge, err := percentageOfCapacity(prefs.MaxSurge, desiredCapacity)
+		if err != nil {
+			return nil, nil, false, errors.Wrap(err, "invalid maxSurge")
+		}
+
+		// AWS requires the minimum healthy percentage along with the maximum one, at most 100 below it.
+		if minHealthy == nil {
+			minHealthy = aws.Int64(100)
+			if prefs.MinHealthyPercentage != nil {
+				minHealthy = aws.Int64(*prefs.MinHealthyPercentage)
+			}
+		}
+
+		// With a minimum healthy percentage of 100, AWS terminates an instance before launching its replacement
+		// unless it can launch instances above the desired capacity. A surge of less than 1% of the desired
+		// capacity is rounded up to 1% rather than making an instance unavailable.
+		if surge == 0 && *minHealthy == 100 && prefs.MaxSurge.IntValue() > 0 {
+			surge = 1
+		}
+
+		surgeCapped = surge > 100
+		maxHealthy = aws.Int64(min(100+surge, 200))
+		if *maxHealthy-*minHealthy > 100 {
+			minHealthy = aws.Int64(*maxHealthy - 100)
+		}
+	}
+
+	return minHealthy, maxHealthy, surgeCapped, nil
+}
+
+// percentageOfCapacity returns an absolute number or percentage of instances as a percentage of the desired
+// capacity. Absolute numbers are rounded down so that the bound is never exceeded, e.g. one instance of 200 is 0%,
+// with which AWS still replaces the instances one at a time.
+func percentageOfCapacity(bound *intstr.IntOrString, desiredCapacity int32) (int64, error) {
+	if bound.Type == intstr.String {
+		value, err := intstr.GetScaledValueFromIntOrPercent(bound, 100, true)
+		return int64(value), err
+	}
+
+	desired := int64(max(desiredCapacity, 1))
+	return int64(bound.IntValue()) * 100 / desired, nil
+}
+
 func createSDKMixedInstancesPolicy(name string, i *expinfrav1.MixedInstancesPolicy) *autoscaling.MixedInstancesPolicy {
 	mixedInstancesPolicy := &autoscaling.MixedInstancesPolicy{
 		LaunchTemplate: &autoscaling.LaunchTemplate{
@@ -465,6 +582,39 @@ func (s *Service) UpdateResourceTags(resourceID *string, create, remove map[stri
 	return nil
 }
 
+// UpdateASGTags creates or updates and removes tags of an autoscaling group, setting the propagate at launch flag
+// of the created tags.
+func (s *Service) UpdateASGTags(name string, create []expinfrav1.ASGTag, remove map[string]string) error {
+	s.scope.Info("updating ASG tags on resource", "resource-id", name, "create", create, "remove", remove)
+
+	if len(create) > 0 {
+		tags := make([]*autoscaling.Tag, 0, len(create))
+		for _, tag := range create {
+			tags = append(tags, &autoscaling.Tag{
+				Key:               aws.String(tag.Key),
+				PropagateAtLaunch: aws.Bool(tag.PropagateAtLaunch),
+				ResourceId:        aws.String(name),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Value:             aws.String(tag.Value),
+			})
+		}
+		if _, err := s.ASGClient.CreateOrUpdateTagsWithContext(context.TODO(), &autoscaling.CreateOrUpdateTagsInput{Tags: tags}); err != nil {
+			return errors.Wrapf(err, "failed to update tags on AutoScalingGroup %q", name)
+		}
+	}
+
+	if len(remove) > 0 {
+		input := &autoscaling.DeleteTagsInput{
+			Tags: mapToTags(remove, aws.String(name)),
+		}
+		if _, err := s.ASGClient.DeleteTagsWithContext(context.TODO(), input); err != nil {
+			return errors.Wrapf(err, "failed to delete tags on AutoScalingGroup %q: %v", name, remove)
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) SuspendProcesses(name string, processes []string) error {
 	input := autoscaling.ScalingProcessQuery{
 		AutoScalingGroupName: aws.String(name),
@@ -487,6 +637,102 @@ func (s *Service) ResumeProcesses(name string, processes []string) error {
 	return nil
 }
 
+// terminationLifecycleTransition is the lifecycle transition of the hooks run when an instance is terminated.
+const terminationLifecycleTransition = "autoscaling:EC2_INSTANCE_TERMINATING"
+
+// ReconcileTerminationLifecycleHook makes sure the ASG has a termination lifecycle hook with the given name and
+// heartbeat timeout, giving a node termination handler the time to drain its instances before they are terminated.
+func (s *Service) ReconcileTerminationLifecycleHook(asgName, hookName string, heartbeatTimeout int64) error {
+	out, err := s.ASGClient.DescribeLifecycleHooksWithContext(context.TODO(), &autoscaling.DescribeLifecycleHooksInput{
+		AutoScalingGroupName: aws.String(asgName),
+		LifecycleHookNames:   aws.StringSlice([]string{hookName}),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe lifecycle hook %q of AutoScalingGroup: %q", hookName, asgName)
+	}
+	for _, hook := range out.LifecycleHooks {
+		if aws.StringValue(hook.LifecycleTransition) == terminationLifecycleTransition && aws.Int64Value(hook.HeartbeatTimeout) == heartbeatTimeout {
+			return nil
+		}
+	}
+
+	if _, err := s.ASGClient.PutLifecycleHookWithContext(context.TODO(), &autoscaling.PutLifecycleHookInput{
+		AutoScalingGroupName: aws.String(asgName),
+		LifecycleHookName:    aws.String(hookName),
+		LifecycleTransition:  aws.String(terminationLifecycleTransition),
+		HeartbeatTimeout:     aws.Int64(heartbeatTimeout),
+		DefaultResult:        aws.String("CONTINUE"),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to put lifecycle hook %q of AutoScalingGroup: %q", hookName, asgName)
+	}
+	s.scope.Info("Put termination lifecycle hook", "name", hookName, "asg", asgName)
+	return nil
+}
+
+// GroupNameTagKey is the tag AWS adds to the instances launched by an ASG, with the name of the ASG as value.
+const GroupNameTagKey = "aws:autoscaling:groupName"
+
+// TerminateInstanceAndDecrementCapacity terminates an instance of an ASG and decrements the desired capacity of
+// the ASG, so that the instance isn't replaced. When the desired capacity can't be decremented, because it would
+// go below the minimum size of the ASG, the instance is terminated without decrementing it. A NotFound error is
+// returned when the instance isn't part of any ASG, for example because it was detached.
+func (s *Service) TerminateInstanceAndDecrementCapacity(instanceID string) error {
+	s.scope.Debug("Attempting to terminate instance of AutoScalingGroup", "instance-id", instanceID)
+
+	_, err := s.ASGClient.TerminateInstanceInAutoScalingGroupWithContext(context.TODO(), &autoscaling.TerminateInstanceInAutoScalingGroupInput{
+		InstanceId:                     aws.String(instanceID),
+		ShouldDecrementDesiredCapacity: aws.Bool(true),
+	})
+	if code, _ := awserrors.Code(err); code == awserrors.ValidationError {
+		// The request is also rejected as invalid when the instance isn't part of an ASG.
+		out, describeErr := s.ASGClient.DescribeAutoScalingInstancesWithContext(context.TODO(), &autoscaling.DescribeAutoScalingInstancesInput{
+			InstanceIds: []*string{aws.String(instanceID)},
+		})
+		if describeErr != nil {
+			return errors.Wrapf(describeErr, "failed to describe AutoScalingGroup of instance %q", instanceID)
+		}
+		if len(out.AutoScalingInstances) == 0 {
+			return awserrors.NewNotFound(fmt.Sprintf("instance %q is not part of an AutoScalingGroup", instanceID))
+		}
+
+		s.scope.Debug("Unable to decrement the desired capacity of AutoScalingGroup, terminating instance without decrementing it",
+			"instance-id", instanceID, "reason", awserrors.Message(err))
+		_, err = s.ASGClient.TerminateInstanceInAutoScalingGroupWithContext(context.TODO(), &autoscaling.TerminateInstanceInAutoScalingGroupInput{
+			InstanceId:                     aws.String(instanceID),
+			ShouldDecrementDesiredCapacity: aws.Bool(false),
+		})
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to terminate instance %q of AutoScalingGroup", instanceID)
+	}
+
+	s.scope.Debug("Terminated instance of AutoScalingGroup", "instance-id", instanceID)
+	return nil
+}
+
+// DeleteLifecycleHook deletes the lifecycle hook with the given name of the ASG, if it exists.
+func (s *Service) DeleteLifecycleHook(asgName, hookName string) error {
+	out, err := s.ASGClient.DescribeLifecycleHooksWithContext(context.TODO(), &autoscaling.DescribeLifecycleHooksInput{
+		AutoScalingGroupName: aws.String(asgName),
+		LifecycleHookNames:   aws.StringSlice([]string{hookName}),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe lifecycle hook %q of AutoScalingGroup: %q", hookName, asgName)
+	}
+	if len(out.LifecycleHooks) == 0 {
+		return nil
+	}
+
+	if _, err := s.ASGClient.DeleteLifecycleHookWithContext(context.TODO(), &autoscaling.DeleteLifecycleHookInput{
+		AutoScalingGroupName: aws.String(asgName),
+		LifecycleHookName:    aws.String(hookName),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to delete lifecycle hook %q of AutoScalingGroup: %q", hookName, asgName)
+	}
+	s.scope.Info("Deleted lifecycle hook", "name", hookName, "asg", asgName)
+	return nil
+}
+
 func mapToTags(input map[string]string, resourceID *string) []*autoscaling.Tag {
 	tags := make([]*autoscaling.Tag, 0)
 	for k, v := range input {
@@ -539,5 +785,42 @@ func (s *Service) SubnetIDs(scope *scope.MachinePoolScope) ([]string, error) {
 		}
 	}
 
-	return scope.SubnetIDs(subnetIDs)
+	subnetIDs, err := scope.SubnetIDs(subnetIDs)
+	if err != nil {
+		return subnetIDs, err
+	}
+
+	if scope.AWSMachinePool.Spec.DistinctSubnetAvailabilityZones {
+		if err := s.validateDistinctSubnetAvailabilityZones(scope, subnetIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	return subnetIDs, nil
+}
+
+// validateDistinctSubnetAvailabilityZones checks that the subnets of the ASG of a pool are in distinct availability zones.
+func (s *Service) validateDistinctSubnetAvailabilityZones(scope *scope.MachinePoolScope, subnetIDs []string) error {
+	if len(subnetIDs) < 2 {
+		return nil
+	}
+
+	out, err := s.EC2Client.DescribeSubnetsWithContext(context.TODO(), &ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice(subnetIDs),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe subnets %v", subnetIDs)
+	}
+
+	subnetsByZone := make(map[string]string, len(out.Subnets))
+	for _, subnet := range out.Subnets {
+		zone := aws.StringValue(subnet.AvailabilityZone)
+		if other, ok := subnetsByZone[zone]; ok {
+			record.Warnf(scope.AWSMachinePool, "InvalidSubnets", "Subnets %q and %q of ASG %q are both in availability zone %q", other, aws.StringValue(subnet.SubnetId), scope.Name(), zone)
+			return errors.Errorf("subnets %q and %q of ASG %q are both in availability zone %q", other, aws.StringValue(subnet.SubnetId), scope.Name(), zone)
+		}
+		subnetsByZone[zone] = aws.StringValue(subnet.SubnetId)
+	}
+
+	return nil
 }
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -30,6 +31,7 @@ import (
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -607,6 +609,89 @@ func TestServiceCreateASG(t *testing.T) {
 					})
 			},
 		},
+		{
+			name:            "should tag the ASG for the cluster autoscaler if enabled",
+			machinePoolName: "create-asg-success",
+			setupMachinePoolScope: func(mps *scope.MachinePoolScope) {
+				mps.AWSMachinePool.Spec.AutoscalerTags = &expinfrav1.AutoscalerTags{
+					Labels: map[string]string{"node.kubernetes.io/pool": "gpu"},
+					Taints: expinfrav1.Taints{
+						{Key: "nvidia.com/gpu", Value: "present", Effect: expinfrav1.TaintEffectNoSchedule},
+					},
+				}
+			},
+			wantErr: false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.CreateAutoScalingGroupWithContext(context.TODO(), gomock.AssignableToTypeOf(&autoscaling.CreateAutoScalingGroupInput{})).Do(
+					func(ctx context.Context, actual *autoscaling.CreateAutoScalingGroupInput, requestOptions ...request.Option) (*autoscaling.CreateAutoScalingGroupOutput, error) {
+						tags := map[string]string{}
+						for _, tag := range actual.Tags {
+							tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+						}
+						expected := map[string]string{
+							"k8s.io/cluster-autoscaler/enabled":                                     "true",
+							"k8s.io/cluster-autoscaler/test":                                        "owned",
+							"k8s.io/cluster-autoscaler/node-template/label/node.kubernetes.io/pool": "gpu",
+							"k8s.io/cluster-autoscaler/node-template/taint/nvidia.com/gpu":          "present:NoSchedule",
+						}
+						for key, value := range expected {
+							if tags[key] != value {
+								t.Fatalf("Actual tag %q did not match expected, Actual: %q, Expected: %q", key, tags[key], value)
+							}
+						}
+						return &autoscaling.CreateAutoScalingGroupOutput{}, nil
+					})
+			},
+		},
+		{
+			name:            "should tag the ASG with the ASG tags over the additional tags, with their propagation flag",
+			machinePoolName: "create-asg-success",
+			setupMachinePoolScope: func(mps *scope.MachinePoolScope) {
+				mps.AWSMachinePool.Spec.AdditionalTags = infrav1.Tags{
+					"team": "platform",
+					"env":  "dev",
+				}
+				mps.AWSMachinePool.Spec.ASGTags = []expinfrav1.ASGTag{
+					{Key: "env", Value: "prod"},
+					{Key: "cost-center", Value: "1234", PropagateAtLaunch: true},
+				}
+				mps.AWSMachinePool.Spec.LaunchTemplateTags = infrav1.Tags{"lt-only": "true"}
+				mps.AWSMachinePool.Spec.InstanceTags = infrav1.Tags{"instance-only": "true"}
+			},
+			wantErr: false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.CreateAutoScalingGroupWithContext(context.TODO(), gomock.AssignableToTypeOf(&autoscaling.CreateAutoScalingGroupInput{})).Do(
+					func(ctx context.Context, actual *autoscaling.CreateAutoScalingGroupInput, requestOptions ...request.Option) (*autoscaling.CreateAutoScalingGroupOutput, error) {
+						tags := map[string]string{}
+						propagated := map[string]bool{}
+						for _, tag := range actual.Tags {
+							tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+							propagated[aws.StringValue(tag.Key)] = aws.BoolValue(tag.PropagateAtLaunch)
+						}
+						expected := map[string]string{
+							"team":        "platform",
+							"env":         "prod",
+							"cost-center": "1234",
+						}
+						for key, value := range expected {
+							if tags[key] != value {
+								t.Fatalf("Actual tag %q did not match expected, Actual: %q, Expected: %q", key, tags[key], value)
+							}
+						}
+						for _, key := range []string{"lt-only", "instance-only"} {
+							if _, ok := tags[key]; ok {
+								t.Fatalf("Tag %q of another resource should not be set on the ASG", key)
+							}
+						}
+						for key, propagate := range propagated {
+							if propagate != (key == "cost-center") {
+								t.Fatalf("Actual propagation of tag %q did not match expected, Actual: %t", key, propagate)
+							}
+						}
+						return &autoscaling.CreateAutoScalingGroupOutput{}, nil
+					})
+			},
+		},
 		{
 			name:            "should return error if MachinePool replicas number is less than AWSMachinePool MinSize",
 			machinePoolName: "create-asg-fail",
@@ -868,6 +953,114 @@ func TestServiceUpdateASGWithSubnetFilters(t *testing.T) {
 	}
 }
 
+func TestServiceSubnetIDs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name                 string
+		awsResourceReference []infrav1.AWSResourceReference
+		distinctZones        bool
+		want                 []string
+		wantErr              bool
+		expect               func(e *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name: "should return the explicit subnet IDs",
+			awsResourceReference: []infrav1.AWSResourceReference{
+				{ID: aws.String("subnet-01")},
+				{ID: aws.String("subnet-02")},
+			},
+			want: []string{"subnet-01", "subnet-02"},
+		},
+		{
+			name: "should return the subnets matching the filters",
+			awsResourceReference: []infrav1.AWSResourceReference{
+				{Filters: []infrav1.Filter{{Name: "tag:spot", Values: []string{"true"}}}},
+			},
+			want: []string{"subnet-01", "subnet-02"},
+			expect: func(e *mocks.MockEC2APIMockRecorder) {
+				e.DescribeSubnetsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeSubnetsInput{
+					Filters: []*ec2.Filter{{Name: aws.String("tag:spot"), Values: aws.StringSlice([]string{"true"})}},
+				})).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{{SubnetId: aws.String("subnet-01")}, {SubnetId: aws.String("subnet-02")}},
+				}, nil)
+			},
+		},
+		{
+			name: "should accept subnets in distinct availability zones when required",
+			awsResourceReference: []infrav1.AWSResourceReference{
+				{ID: aws.String("subnet-01")},
+				{ID: aws.String("subnet-02")},
+			},
+			distinctZones: true,
+			want:          []string{"subnet-01", "subnet-02"},
+			expect: func(e *mocks.MockEC2APIMockRecorder) {
+				e.DescribeSubnetsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeSubnetsInput{
+					SubnetIds: aws.StringSlice([]string{"subnet-01", "subnet-02"}),
+				})).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{SubnetId: aws.String("subnet-01"), AvailabilityZone: aws.String("us-east-1a")},
+						{SubnetId: aws.String("subnet-02"), AvailabilityZone: aws.String("us-east-1b")},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "should reject subnets in the same availability zone when distinct zones are required",
+			awsResourceReference: []infrav1.AWSResourceReference{
+				{ID: aws.String("subnet-01")},
+				{ID: aws.String("subnet-02")},
+			},
+			distinctZones: true,
+			wantErr:       true,
+			expect: func(e *mocks.MockEC2APIMockRecorder) {
+				e.DescribeSubnetsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSubnetsInput{})).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{SubnetId: aws.String("subnet-01"), AvailabilityZone: aws.String("us-east-1a")},
+						{SubnetId: aws.String("subnet-02"), AvailabilityZone: aws.String("us-east-1a")},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "should accept subnets in the same availability zone when distinct zones are not required",
+			awsResourceReference: []infrav1.AWSResourceReference{
+				{ID: aws.String("subnet-01")},
+				{ID: aws.String("subnet-02")},
+			},
+			want: []string{"subnet-01", "subnet-02"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := getFakeClient()
+
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(ec2Mock.EXPECT())
+			}
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			mps, err := getMachinePoolScope(fakeClient, clusterScope)
+			g.Expect(err).ToNot(HaveOccurred())
+			mps.AWSMachinePool.Spec.Subnets = tt.awsResourceReference
+			mps.AWSMachinePool.Spec.DistinctSubnetAvailabilityZones = tt.distinctZones
+
+			subnetIDs, err := s.SubnetIDs(mps)
+			checkErr(tt.wantErr, err, g)
+			if !tt.wantErr {
+				g.Expect(subnetIDs).To(Equal(tt.want))
+			}
+		})
+	}
+}
+
 func TestServiceUpdateResourceTags(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -984,6 +1177,97 @@ func TestServiceUpdateResourceTags(t *testing.T) {
 	}
 }
 
+func TestServiceUpdateASGTags(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name    string
+		create  []expinfrav1.ASGTag
+		remove  map[string]string
+		wantErr bool
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name:    "should return nil if nothing to update",
+			wantErr: false,
+			expect:  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {},
+		},
+		{
+			name: "should create tags with their propagation flag",
+			create: []expinfrav1.ASGTag{
+				{Key: "key1", Value: "value1", PropagateAtLaunch: true},
+				{Key: "key2", Value: "value2"},
+			},
+			wantErr: false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.CreateOrUpdateTagsWithContext(context.TODO(), gomock.Eq(&autoscaling.CreateOrUpdateTagsInput{
+					Tags: []*autoscaling.Tag{
+						{
+							Key:               aws.String("key1"),
+							PropagateAtLaunch: aws.Bool(true),
+							ResourceId:        aws.String("mock-asg"),
+							ResourceType:      aws.String("auto-scaling-group"),
+							Value:             aws.String("value1"),
+						},
+						{
+							Key:               aws.String("key2"),
+							PropagateAtLaunch: aws.Bool(false),
+							ResourceId:        aws.String("mock-asg"),
+							ResourceType:      aws.String("auto-scaling-group"),
+							Value:             aws.String("value2"),
+						},
+					},
+				})).
+					Return(nil, nil)
+			},
+		},
+		{
+			name: "should remove tags",
+			remove: map[string]string{
+				"key1": "value1",
+			},
+			wantErr: false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DeleteTagsWithContext(context.TODO(), gomock.Eq(&autoscaling.DeleteTagsInput{
+					Tags: mapToTags(map[string]string{
+						"key1": "value1",
+					}, aws.String("mock-asg")),
+				})).
+					Return(nil, nil)
+			},
+		},
+		{
+			name: "should return error if creating tags failed",
+			create: []expinfrav1.ASGTag{
+				{Key: "key1", Value: "value1"},
+			},
+			wantErr: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.CreateOrUpdateTagsWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserrors.NewNotFound("not found"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := getFakeClient()
+
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			err = s.UpdateASGTags("mock-asg", tt.create, tt.remove)
+			checkErr(tt.wantErr, err, g)
+		})
+	}
+}
+
 func TestServiceDeleteASG(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -1035,6 +1319,171 @@ func TestServiceDeleteASG(t *testing.T) {
 	}
 }
 
+func TestServiceTerminateInstanceAndDecrementCapacity(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	input := &autoscaling.TerminateInstanceInAutoScalingGroupInput{
+		InstanceId:                     aws.String("instanceID"),
+		ShouldDecrementDesiredCapacity: aws.Bool(true),
+	}
+
+	describeInput := &autoscaling.DescribeAutoScalingInstancesInput{
+		InstanceIds: []*string{aws.String("instanceID")},
+	}
+
+	tests := []struct {
+		name         string
+		wantErr      bool
+		wantNotFound bool
+		expect       func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name:    "should terminate the instance and decrement the desired capacity",
+			wantErr: false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.TerminateInstanceInAutoScalingGroupWithContext(context.TODO(), gomock.Eq(input)).
+					Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+			},
+		},
+		{
+			name:    "should return error if the instance can't be terminated",
+			wantErr: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.TerminateInstanceInAutoScalingGroupWithContext(context.TODO(), gomock.Eq(input)).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+		},
+		{
+			name:    "should terminate the instance without decrementing the desired capacity if it can't be decremented",
+			wantErr: false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.TerminateInstanceInAutoScalingGroupWithContext(context.TODO(), gomock.Eq(input)).
+					Return(nil, awserr.New(awserrors.ValidationError, "Currently, desired capacity is equal to min size", nil))
+				m.DescribeAutoScalingInstancesWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeAutoScalingInstancesOutput{
+						AutoScalingInstances: []*autoscaling.InstanceDetails{{InstanceId: aws.String("instanceID")}},
+					}, nil)
+				m.TerminateInstanceInAutoScalingGroupWithContext(context.TODO(), gomock.Eq(&autoscaling.TerminateInstanceInAutoScalingGroupInput{
+					InstanceId:                     aws.String("instanceID"),
+					ShouldDecrementDesiredCapacity: aws.Bool(false),
+				})).Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+			},
+		},
+		{
+			name:         "should return a not found error if the instance isn't part of an AutoScalingGroup",
+			wantErr:      true,
+			wantNotFound: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.TerminateInstanceInAutoScalingGroupWithContext(context.TODO(), gomock.Eq(input)).
+					Return(nil, awserr.New(awserrors.ValidationError, "Instance Id not found", nil))
+				m.DescribeAutoScalingInstancesWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeAutoScalingInstancesOutput{}, nil)
+			},
+		},
+		{
+			name:    "should return error if the AutoScalingGroup of the instance can't be described",
+			wantErr: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.TerminateInstanceInAutoScalingGroupWithContext(context.TODO(), gomock.Eq(input)).
+					Return(nil, awserr.New(awserrors.ValidationError, "Instance Id not found", nil))
+				m.DescribeAutoScalingInstancesWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := getFakeClient()
+
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			err = s.TerminateInstanceAndDecrementCapacity("instanceID")
+			checkErr(tt.wantErr, err, g)
+			g.Expect(awserrors.IsNotFound(err)).To(Equal(tt.wantNotFound))
+		})
+	}
+}
+
+func TestServiceReconcileTerminationLifecycleHook(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	describeInput := &autoscaling.DescribeLifecycleHooksInput{
+		AutoScalingGroupName: aws.String("asgName"),
+		LifecycleHookNames:   aws.StringSlice([]string{"hookName"}),
+	}
+
+	tests := []struct {
+		name    string
+		wantErr bool
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name:    "Puts the hook when it doesn't exist",
+			wantErr: false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeLifecycleHooksWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeLifecycleHooksOutput{}, nil)
+				m.PutLifecycleHookWithContext(context.TODO(), gomock.Eq(&autoscaling.PutLifecycleHookInput{
+					AutoScalingGroupName: aws.String("asgName"),
+					LifecycleHookName:    aws.String("hookName"),
+					LifecycleTransition:  aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+					HeartbeatTimeout:     aws.Int64(300),
+					DefaultResult:        aws.String("CONTINUE"),
+				})).
+					Return(&autoscaling.PutLifecycleHookOutput{}, nil)
+			},
+		},
+		{
+			name:    "Leaves an up to date hook alone",
+			wantErr: false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeLifecycleHooksWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeLifecycleHooksOutput{
+						LifecycleHooks: []*autoscaling.LifecycleHook{{
+							LifecycleHookName:   aws.String("hookName"),
+							LifecycleTransition: aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+							HeartbeatTimeout:    aws.Int64(300),
+						}},
+					}, nil)
+			},
+		},
+		{
+			name:    "Fails when the hooks can't be described",
+			wantErr: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeLifecycleHooksWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := getFakeClient()
+
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			err = s.ReconcileTerminationLifecycleHook("asgName", "hookName", 300)
+			checkErr(tt.wantErr, err, g)
+		})
+	}
+}
+
 func TestServiceDeleteASGAndWait(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -1188,9 +1637,14 @@ func TestServiceStartASGInstanceRefresh(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	tests := []struct {
-		name    string
-		wantErr bool
-		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		name           string
+		replicas       *int32
+		maxSurge       *intstr.IntOrString
+		maxUnavailable *intstr.IntOrString
+		// desiredCapacity is the desired capacity of the described ASG, which is not found when it is nil.
+		desiredCapacity *int64
+		wantErr         bool
+		expect          func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
 	}{
 		{
 			name:    "should return error if start instance refresh failed",
@@ -1222,6 +1676,156 @@ func TestServiceStartASGInstanceRefresh(t *testing.T) {
 					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
 			},
 		},
+		{
+			name:           "should cap the instance refresh to the percentages of max surge and max unavailable",
+			replicas:       ptr.To[int32](10),
+			maxSurge:       ptr.To(intstr.FromString("20%")),
+			maxUnavailable: ptr.To(intstr.FromString("0%")),
+			wantErr:        false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.StartInstanceRefreshWithContext(context.TODO(), gomock.Eq(&autoscaling.StartInstanceRefreshInput{
+					AutoScalingGroupName: aws.String("mpn"),
+					Strategy:             aws.String("Rolling"),
+					Preferences: &autoscaling.RefreshPreferences{
+						InstanceWarmup:       aws.Int64(100),
+						MinHealthyPercentage: aws.Int64(100),
+						MaxHealthyPercentage: aws.Int64(120),
+					},
+				})).
+					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
+			},
+		},
+		{
+			name:            "should round down absolute max surge and max unavailable to percentages of the desired capacity",
+			replicas:        ptr.To[int32](3),
+			desiredCapacity: aws.Int64(3),
+			maxSurge:        ptr.To(intstr.FromInt32(1)),
+			maxUnavailable:  ptr.To(intstr.FromInt32(1)),
+			wantErr:         false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.StartInstanceRefreshWithContext(context.TODO(), gomock.Eq(&autoscaling.StartInstanceRefreshInput{
+					AutoScalingGroupName: aws.String("mpn"),
+					Strategy:             aws.String("Rolling"),
+					Preferences: &autoscaling.RefreshPreferences{
+						InstanceWarmup:       aws.Int64(100),
+						MinHealthyPercentage: aws.Int64(67),
+						MaxHealthyPercentage: aws.Int64(133),
+					},
+				})).
+					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
+			},
+		},
+		{
+			name:            "should not make more instances unavailable than max unavailable when it rounds down to 0%",
+			replicas:        ptr.To[int32](200),
+			desiredCapacity: aws.Int64(200),
+			maxUnavailable:  ptr.To(intstr.FromInt32(1)),
+			wantErr:         false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.StartInstanceRefreshWithContext(context.TODO(), gomock.Eq(&autoscaling.StartInstanceRefreshInput{
+					AutoScalingGroupName: aws.String("mpn"),
+					Strategy:             aws.String("Rolling"),
+					Preferences: &autoscaling.RefreshPreferences{
+						InstanceWarmup:       aws.Int64(100),
+						MinHealthyPercentage: aws.Int64(100),
+					},
+				})).
+					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
+			},
+		},
+		{
+			name:            "should surge by 1% when max surge rounds down to 0% without unavailable instances",
+			replicas:        ptr.To[int32](200),
+			desiredCapacity: aws.Int64(200),
+			maxSurge:        ptr.To(intstr.FromInt32(1)),
+			maxUnavailable:  ptr.To(intstr.FromInt32(0)),
+			wantErr:         false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.StartInstanceRefreshWithContext(context.TODO(), gomock.Eq(&autoscaling.StartInstanceRefreshInput{
+					AutoScalingGroupName: aws.String("mpn"),
+					Strategy:             aws.String("Rolling"),
+					Preferences: &autoscaling.RefreshPreferences{
+						InstanceWarmup:       aws.Int64(100),
+						MinHealthyPercentage: aws.Int64(100),
+						MaxHealthyPercentage: aws.Int64(101),
+					},
+				})).
+					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
+			},
+		},
+		{
+			name:            "should compute the percentages from the desired capacity of the ASG",
+			replicas:        ptr.To[int32](10),
+			desiredCapacity: aws.Int64(20),
+			maxUnavailable:  ptr.To(intstr.FromInt32(2)),
+			wantErr:         false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.StartInstanceRefreshWithContext(context.TODO(), gomock.Eq(&autoscaling.StartInstanceRefreshInput{
+					AutoScalingGroupName: aws.String("mpn"),
+					Strategy:             aws.String("Rolling"),
+					Preferences: &autoscaling.RefreshPreferences{
+						InstanceWarmup:       aws.Int64(100),
+						MinHealthyPercentage: aws.Int64(90),
+					},
+				})).
+					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
+			},
+		},
+		{
+			name:           "should compute the percentages from the replicas when the ASG is not found",
+			replicas:       ptr.To[int32](10),
+			maxUnavailable: ptr.To(intstr.FromInt32(2)),
+			wantErr:        false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.StartInstanceRefreshWithContext(context.TODO(), gomock.Eq(&autoscaling.StartInstanceRefreshInput{
+					AutoScalingGroupName: aws.String("mpn"),
+					Strategy:             aws.String("Rolling"),
+					Preferences: &autoscaling.RefreshPreferences{
+						InstanceWarmup:       aws.Int64(100),
+						MinHealthyPercentage: aws.Int64(80),
+					},
+				})).
+					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
+			},
+		},
+		{
+			name:            "should keep the min healthy percentage when only max surge is set",
+			replicas:        ptr.To[int32](10),
+			desiredCapacity: aws.Int64(10),
+			maxSurge:        ptr.To(intstr.FromInt32(1)),
+			wantErr:         false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.StartInstanceRefreshWithContext(context.TODO(), gomock.Eq(&autoscaling.StartInstanceRefreshInput{
+					AutoScalingGroupName: aws.String("mpn"),
+					Strategy:             aws.String("Rolling"),
+					Preferences: &autoscaling.RefreshPreferences{
+						InstanceWarmup:       aws.Int64(100),
+						MinHealthyPercentage: aws.Int64(80),
+						MaxHealthyPercentage: aws.Int64(110),
+					},
+				})).
+					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
+			},
+		},
+		{
+			name:            "should keep the healthy percentages within the AWS limits",
+			replicas:        ptr.To[int32](2),
+			desiredCapacity: aws.Int64(2),
+			maxSurge:        ptr.To(intstr.FromInt32(4)),
+			wantErr:         false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.StartInstanceRefreshWithContext(context.TODO(), gomock.Eq(&autoscaling.StartInstanceRefreshInput{
+					AutoScalingGroupName: aws.String("mpn"),
+					Strategy:             aws.String("Rolling"),
+					Preferences: &autoscaling.RefreshPreferences{
+						InstanceWarmup:       aws.Int64(100),
+						MinHealthyPercentage: aws.Int64(100),
+						MaxHealthyPercentage: aws.Int64(200),
+					},
+				})).
+					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1232,6 +1836,18 @@ func TestServiceStartASGInstanceRefresh(t *testing.T) {
 			clusterScope, err := getClusterScope(fakeClient)
 			g.Expect(err).ToNot(HaveOccurred())
 			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			if isAbsoluteBound(tt.maxSurge) || isAbsoluteBound(tt.maxUnavailable) {
+				describe := asgMock.EXPECT().DescribeAutoScalingGroupsWithContext(context.TODO(), gomock.Eq(&autoscaling.DescribeAutoScalingGroupsInput{
+					AutoScalingGroupNames: []*string{aws.String("mpn")},
+				}))
+				if tt.desiredCapacity != nil {
+					describe.Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+						AutoScalingGroups: []*autoscaling.Group{{AutoScalingGroupName: aws.String("mpn"), DesiredCapacity: tt.desiredCapacity}},
+					}, nil)
+				} else {
+					describe.Return(nil, awserrors.NewNotFound("not found"))
+				}
+			}
 			tt.expect(asgMock.EXPECT())
 			s := NewService(clusterScope)
 			s.ASGClient = asgMock
@@ -1239,6 +1855,12 @@ func TestServiceStartASGInstanceRefresh(t *testing.T) {
 			mps, err := getMachinePoolScope(fakeClient, clusterScope)
 			g.Expect(err).ToNot(HaveOccurred())
 			mps.AWSMachinePool.Name = "mpn"
+			mps.MachinePool.Spec.Replicas = tt.replicas
+			mps.AWSMachinePool.Spec.RefreshPreferences.MaxSurge = tt.maxSurge
+			if tt.maxUnavailable != nil {
+				mps.AWSMachinePool.Spec.RefreshPreferences.MinHealthyPercentage = nil
+				mps.AWSMachinePool.Spec.RefreshPreferences.MaxUnavailable = tt.maxUnavailable
+			}
 
 			err = s.StartASGInstanceRefresh(mps)
 			checkErr(tt.wantErr, err, g)
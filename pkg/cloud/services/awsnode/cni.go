@@ -19,6 +19,7 @@ package awsnode
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	amazoncni "github.com/aws/amazon-vpc-cni-k8s/pkg/apis/crd/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -41,8 +42,54 @@ import (
 const (
 	awsNodeName      = "aws-node"
 	awsNodeNamespace = "kube-system"
+
+	// customNetworkEnvVar is the environment variable of aws-node that enables custom networking.
+	customNetworkEnvVar = "AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG"
+	// eniConfigLabelEnvVar is the environment variable of aws-node that defines the node label
+	// holding the name of the ENIConfig to use.
+	eniConfigLabelEnvVar = "ENI_CONFIG_LABEL_DEF"
+	// enableIPv6EnvVar is the environment variable of aws-node that enables IPv6 pod addresses.
+	enableIPv6EnvVar = "ENABLE_IPv6"
+	// enableIPv4EnvVar is the environment variable of aws-node that enables IPv4 pod addresses.
+	enableIPv4EnvVar = "ENABLE_IPv4"
+	// enablePrefixDelegationEnvVar is the environment variable of aws-node that enables prefix delegation,
+	// which IPv6 requires.
+	enablePrefixDelegationEnvVar = "ENABLE_PREFIX_DELEGATION"
 )
 
+// CustomNetworkingEnv returns the environment variables of aws-node that enable custom networking,
+// selecting the ENIConfig named after the availability zone of the node.
+func CustomNetworkingEnv() []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{
+			Name:  customNetworkEnvVar,
+			Value: "true",
+		},
+		{
+			Name:  eniConfigLabelEnvVar,
+			Value: corev1.LabelTopologyZone,
+		},
+	}
+}
+
+// IPv6Env returns the environment variables of aws-node that assign IPv6 addresses to pods.
+func IPv6Env() []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{
+			Name:  enableIPv6EnvVar,
+			Value: "true",
+		},
+		{
+			Name:  enableIPv4EnvVar,
+			Value: "false",
+		},
+		{
+			Name:  enablePrefixDelegationEnvVar,
+			Value: "true",
+		},
+	}
+}
+
 // ReconcileCNI will reconcile the CNI of a service.
 func (s *Service) ReconcileCNI(ctx context.Context) error {
 	s.scope.Info("Reconciling aws-node DaemonSet in cluster", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
@@ -69,19 +116,34 @@ func (s *Service) ReconcileCNI(ctx context.Context) error {
 	}
 
 	var needsUpdate bool
-	if len(s.scope.VpcCni().Env) > 0 {
+	if env := s.desiredEnvironment(); len(env) > 0 {
 		s.scope.Info("updating aws-node daemonset environment variables", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
 
 		for i := range ds.Spec.Template.Spec.Containers {
 			container := &ds.Spec.Template.Spec.Containers[i]
 			if container.Name == "aws-node" {
-				container.Env, needsUpdate = s.applyUserProvidedEnvironmentProperties(container.Env)
+				container.Env, needsUpdate = applyEnvironmentProperties(container.Env, env)
+			}
+		}
+	}
+	if env := s.undesiredEnvironment(); len(env) > 0 {
+		for i := range ds.Spec.Template.Spec.Containers {
+			container := &ds.Spec.Template.Spec.Containers[i]
+			if container.Name == "aws-node" {
+				var removed bool
+				if container.Env, removed = removeEnvironmentProperties(container.Env, env); removed {
+					s.scope.Info("removing custom networking environment variables from aws-node daemonset", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
+					needsUpdate = true
+				}
 			}
 		}
 	}
 
-	secondarySubnets := s.secondarySubnets()
-	if len(secondarySubnets) == 0 {
+	eniConfigs, err := s.eniConfigs()
+	if err != nil {
+		return err
+	}
+	if len(eniConfigs) == 0 {
 		if needsUpdate {
 			s.scope.Info("adding environment properties to vpc-cni", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
 			if err = remoteClient.Update(ctx, &ds, &client.UpdateOptions{}); err != nil {
@@ -89,50 +151,29 @@ func (s *Service) ReconcileCNI(ctx context.Context) error {
 			}
 		}
 
-		// with no secondary subnets there is no need for eni configs
+		// with no secondary subnets or custom networking there is no need for eni configs
 		return nil
 	}
 
-	sgs, err := s.getSecurityGroups()
-	if err != nil {
-		return err
-	}
-
-	metaLabels := map[string]string{
-		"app.kubernetes.io/managed-by": "cluster-api-provider-aws",
-		"app.kubernetes.io/part-of":    s.scope.Name(),
-	}
+	s.scope.Info("for each eni config", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
+	for i := range eniConfigs {
+		desired := eniConfigs[i]
 
-	s.scope.Info("for each subnet", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
-	for _, subnet := range secondarySubnets {
 		var eniConfig amazoncni.ENIConfig
-		if err := remoteClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: subnet.AvailabilityZone}, &eniConfig); err != nil {
+		if err := remoteClient.Get(ctx, types.NamespacedName{Namespace: desired.Namespace, Name: desired.Name}, &eniConfig); err != nil {
 			if !apierrors.IsNotFound(err) {
 				return err
 			}
-			s.scope.Info("Creating ENIConfig", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()), "subnet", subnet.ID, "availability-zone", subnet.AvailabilityZone)
-			eniConfig = amazoncni.ENIConfig{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: metav1.NamespaceSystem,
-					Name:      subnet.AvailabilityZone,
-					Labels:    metaLabels,
-				},
-				Spec: amazoncni.ENIConfigSpec{
-					Subnet:         subnet.GetResourceID(),
-					SecurityGroups: sgs,
-				},
-			}
+			s.scope.Info("Creating ENIConfig", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()), "subnet", desired.Spec.Subnet, "availability-zone", desired.Name)
+			eniConfig = *desired.DeepCopy()
 
 			if err := remoteClient.Create(ctx, &eniConfig, &client.CreateOptions{}); err != nil {
 				return err
 			}
 		}
 
-		s.scope.Info("Updating ENIConfig", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()), "subnet", subnet.ID, "availability-zone", subnet.AvailabilityZone)
-		eniConfig.Spec = amazoncni.ENIConfigSpec{
-			Subnet:         subnet.GetResourceID(),
-			SecurityGroups: sgs,
-		}
+		s.scope.Info("Updating ENIConfig", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()), "subnet", desired.Spec.Subnet, "availability-zone", desired.Name)
+		eniConfig.Spec = desired.Spec
 
 		if err := remoteClient.Update(ctx, &eniConfig, &client.UpdateOptions{}); err != nil {
 			return err
@@ -140,18 +181,18 @@ func (s *Service) ReconcileCNI(ctx context.Context) error {
 	}
 
 	// Removing any ENIConfig no longer needed
-	var eniConfigs amazoncni.ENIConfigList
-	err = remoteClient.List(ctx, &eniConfigs, &client.ListOptions{
+	var existingENIConfigs amazoncni.ENIConfigList
+	err = remoteClient.List(ctx, &existingENIConfigs, &client.ListOptions{
 		Namespace:     metav1.NamespaceSystem,
-		LabelSelector: labels.SelectorFromSet(metaLabels),
+		LabelSelector: labels.SelectorFromSet(s.eniConfigLabels()),
 	})
 	if err != nil {
 		return err
 	}
-	for _, eniConfig := range eniConfigs.Items {
+	for _, eniConfig := range existingENIConfigs.Items {
 		matchFound := false
-		for _, subnet := range s.secondarySubnets() {
-			if eniConfig.Name == subnet.AvailabilityZone {
+		for _, desired := range eniConfigs {
+			if eniConfig.Name == desired.Name {
 				matchFound = true
 				break
 			}
@@ -170,6 +211,64 @@ func (s *Service) ReconcileCNI(ctx context.Context) error {
 	return remoteClient.Update(ctx, &ds, &client.UpdateOptions{})
 }
 
+// eniConfigs returns the ENIConfigs to create in the workload cluster, one per availability zone.
+// With custom networking configured explicitly these are built from its configuration, otherwise
+// they are built from the secondary subnets of the cluster.
+func (s *Service) eniConfigs() ([]amazoncni.ENIConfig, error) {
+	vpcCni := s.scope.VpcCni()
+	if vpcCni.IsCustomNetworkingEnabled() && len(vpcCni.CustomNetworking.ENIConfigs) > 0 {
+		eniConfigs := make([]amazoncni.ENIConfig, 0, len(vpcCni.CustomNetworking.ENIConfigs))
+		for _, config := range vpcCni.CustomNetworking.ENIConfigs {
+			sgs := config.SecurityGroupIDs
+			if len(sgs) == 0 {
+				var err error
+				if sgs, err = s.getSecurityGroups(); err != nil {
+					return nil, err
+				}
+			}
+			eniConfigs = append(eniConfigs, s.eniConfig(config.AvailabilityZone, config.SubnetID, sgs))
+		}
+		return eniConfigs, nil
+	}
+
+	secondarySubnets := s.secondarySubnets()
+	if len(secondarySubnets) == 0 {
+		return nil, nil
+	}
+
+	sgs, err := s.getSecurityGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	eniConfigs := make([]amazoncni.ENIConfig, 0, len(secondarySubnets))
+	for _, subnet := range secondarySubnets {
+		eniConfigs = append(eniConfigs, s.eniConfig(subnet.AvailabilityZone, subnet.GetResourceID(), sgs))
+	}
+	return eniConfigs, nil
+}
+
+func (s *Service) eniConfig(availabilityZone, subnetID string, sgs []string) amazoncni.ENIConfig {
+	return amazoncni.ENIConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceSystem,
+			Name:      availabilityZone,
+			Labels:    s.eniConfigLabels(),
+		},
+		Spec: amazoncni.ENIConfigSpec{
+			Subnet:         subnetID,
+			SecurityGroups: sgs,
+		},
+	}
+}
+
+func (s *Service) eniConfigLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/managed-by": "cluster-api-provider-aws",
+		"app.kubernetes.io/part-of":    s.scope.Name(),
+	}
+}
+
 func (s *Service) getSecurityGroups() ([]string, error) {
 	sgRoles := []infrav1.SecurityGroupRole{
 		infrav1.SecurityGroupNode,
@@ -186,13 +285,54 @@ func (s *Service) getSecurityGroups() ([]string, error) {
 	return sgs, nil
 }
 
-// applyUserProvidedEnvironmentProperties takes a container environment and applies user provided values to it.
-func (s *Service) applyUserProvidedEnvironmentProperties(containerEnv []corev1.EnvVar) ([]corev1.EnvVar, bool) {
+// desiredEnvironment returns the environment variables to apply to aws-node. The variables provided
+// by the user take precedence over the ones required by custom networking.
+func (s *Service) desiredEnvironment() []corev1.EnvVar {
+	vpcCni := s.scope.VpcCni()
+	if !vpcCni.IsCustomNetworkingEnabled() {
+		return vpcCni.Env
+	}
+	return append(CustomNetworkingEnv(), vpcCni.Env...)
+}
+
+// undesiredEnvironment returns the environment variables applied to aws-node by custom networking when it is
+// disabled, so that they are removed once custom networking is turned off. The variables provided by the user are kept.
+func (s *Service) undesiredEnvironment() []corev1.EnvVar {
+	vpcCni := s.scope.VpcCni()
+	if vpcCni.IsCustomNetworkingEnabled() {
+		return nil
+	}
+
+	var undesired []corev1.EnvVar
+	for _, e := range CustomNetworkingEnv() {
+		if !slices.ContainsFunc(vpcCni.Env, func(v corev1.EnvVar) bool { return v.Name == e.Name }) {
+			undesired = append(undesired, e)
+		}
+	}
+	return undesired
+}
+
+// removeEnvironmentProperties takes a container environment and removes the variables still set to the given values.
+func removeEnvironmentProperties(containerEnv []corev1.EnvVar, env []corev1.EnvVar) ([]corev1.EnvVar, bool) {
+	removed := false
+	res := make([]corev1.EnvVar, 0, len(containerEnv))
+	for _, e := range containerEnv {
+		if slices.ContainsFunc(env, func(v corev1.EnvVar) bool { return v.String() == e.String() }) {
+			removed = true
+			continue
+		}
+		res = append(res, e)
+	}
+	return res, removed
+}
+
+// applyEnvironmentProperties takes a container environment and applies the desired values to it.
+func applyEnvironmentProperties(containerEnv []corev1.EnvVar, desiredEnv []corev1.EnvVar) ([]corev1.EnvVar, bool) {
 	var (
 		envVars     = make(map[string]corev1.EnvVar)
 		needsUpdate = false
 	)
-	for _, e := range s.scope.VpcCni().Env {
+	for _, e := range desiredEnv {
 		envVars[e.Name] = e
 	}
 	// Handle the case where we overwrite an existing value if it's not already the desired value.
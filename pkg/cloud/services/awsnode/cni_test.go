@@ -257,6 +257,311 @@ func TestReconcileCniVpcCniValues(t *testing.T) {
 	}
 }
 
+func TestReconcileCniCustomNetworking(t *testing.T) {
+	g := NewWithT(t)
+	mockClient := &cachingClient{
+		getValue: &v1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      awsNodeName,
+				Namespace: awsNodeNamespace,
+			},
+			Spec: v1.DaemonSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: awsNodeName,
+								Env: []corev1.EnvVar{
+									{
+										Name:  customNetworkEnvVar,
+										Value: "false",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	m := &mockScope{
+		client: mockClient,
+		cni: ekscontrolplanev1.VpcCni{
+			Env: []corev1.EnvVar{
+				{
+					Name:  "NAME1",
+					Value: "VALUE1",
+				},
+			},
+			CustomNetworking: &ekscontrolplanev1.VpcCniCustomNetworking{
+				Enabled: true,
+				ENIConfigs: []ekscontrolplanev1.VpcCniENIConfig{
+					{
+						AvailabilityZone: "us-east-1a",
+						SubnetID:         "subnet-pods-1a",
+					},
+				},
+			},
+		},
+		securityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+			"node": {
+				ID:   "sg-node",
+				Name: "node",
+			},
+		},
+	}
+	s := NewService(m)
+
+	err := s.ReconcileCNI(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(mockClient.updateChain).To(HaveLen(2)) // 0: eniconfig 1: daemonset
+	eniconf, ok := mockClient.updateChain[0].(*v1alpha1.ENIConfig)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(eniconf.Spec.Subnet).To(Equal("subnet-pods-1a"))
+	g.Expect(eniconf.Spec.SecurityGroups).To(ConsistOf("sg-node"))
+
+	ds, ok := mockClient.updateChain[1].(*v1.DaemonSet)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ConsistOf(
+		corev1.EnvVar{Name: customNetworkEnvVar, Value: "true"},
+		corev1.EnvVar{Name: eniConfigLabelEnvVar, Value: corev1.LabelTopologyZone},
+		corev1.EnvVar{Name: "NAME1", Value: "VALUE1"},
+	))
+}
+
+func TestReconcileCniCustomNetworkingDisabled(t *testing.T) {
+	tests := []struct {
+		name       string
+		cniValues  ekscontrolplanev1.VpcCni
+		consistsOf []corev1.EnvVar
+	}{
+		{
+			name: "removes the environment variables of custom networking",
+			consistsOf: []corev1.EnvVar{
+				{Name: "NAME1", Value: "VALUE1"},
+			},
+		},
+		{
+			name: "keeps the environment variables of custom networking provided by the user",
+			cniValues: ekscontrolplanev1.VpcCni{
+				Env: []corev1.EnvVar{
+					{Name: customNetworkEnvVar, Value: "true"},
+				},
+			},
+			consistsOf: []corev1.EnvVar{
+				{Name: customNetworkEnvVar, Value: "true"},
+				{Name: "NAME1", Value: "VALUE1"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockClient := &cachingClient{
+				getValue: &v1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      awsNodeName,
+						Namespace: awsNodeNamespace,
+					},
+					Spec: v1.DaemonSetSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{
+										Name: awsNodeName,
+										Env: []corev1.EnvVar{
+											{Name: customNetworkEnvVar, Value: "true"},
+											{Name: eniConfigLabelEnvVar, Value: corev1.LabelTopologyZone},
+											{Name: "NAME1", Value: "VALUE1"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			m := &mockScope{
+				client: mockClient,
+				cni:    tc.cniValues,
+			}
+			s := NewService(m)
+
+			err := s.ReconcileCNI(context.Background())
+			g.Expect(err).NotTo(HaveOccurred())
+
+			g.Expect(mockClient.updateChain).To(HaveLen(1))
+			ds, ok := mockClient.updateChain[0].(*v1.DaemonSet)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ConsistOf(tc.consistsOf))
+		})
+	}
+}
+
+func TestENIConfigs(t *testing.T) {
+	nodeSecurityGroups := map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+		"node": {
+			ID:   "sg-node",
+			Name: "node",
+		},
+	}
+	secondarySubnets := infrav1.Subnets{
+		{
+			ID:               "subnet-secondary-1a",
+			AvailabilityZone: "us-east-1a",
+			Tags: infrav1.Tags{
+				infrav1.NameAWSSubnetAssociation: infrav1.SecondarySubnetTagValue,
+			},
+		},
+		{
+			ID:               "subnet-private-1a",
+			AvailabilityZone: "us-east-1a",
+		},
+	}
+	metaLabels := map[string]string{
+		"app.kubernetes.io/managed-by": "cluster-api-provider-aws",
+		"app.kubernetes.io/part-of":    "mock-name",
+	}
+
+	tests := []struct {
+		name           string
+		cni            ekscontrolplanev1.VpcCni
+		securityGroups map[infrav1.SecurityGroupRole]infrav1.SecurityGroup
+		subnets        infrav1.Subnets
+		want           []v1alpha1.ENIConfig
+		wantErr        bool
+	}{
+		{
+			name: "no eni configs without secondary subnets or custom networking",
+		},
+		{
+			name:           "eni configs are built from the secondary subnets",
+			securityGroups: nodeSecurityGroups,
+			subnets:        secondarySubnets,
+			want: []v1alpha1.ENIConfig{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "us-east-1a", Labels: metaLabels},
+					Spec:       v1alpha1.ENIConfigSpec{Subnet: "subnet-secondary-1a", SecurityGroups: []string{"sg-node"}},
+				},
+			},
+		},
+		{
+			name: "custom networking without eni configs uses the secondary subnets",
+			cni: ekscontrolplanev1.VpcCni{
+				CustomNetworking: &ekscontrolplanev1.VpcCniCustomNetworking{Enabled: true},
+			},
+			securityGroups: nodeSecurityGroups,
+			subnets:        secondarySubnets,
+			want: []v1alpha1.ENIConfig{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "us-east-1a", Labels: metaLabels},
+					Spec:       v1alpha1.ENIConfigSpec{Subnet: "subnet-secondary-1a", SecurityGroups: []string{"sg-node"}},
+				},
+			},
+		},
+		{
+			name: "custom networking eni configs take precedence over the secondary subnets",
+			cni: ekscontrolplanev1.VpcCni{
+				CustomNetworking: &ekscontrolplanev1.VpcCniCustomNetworking{
+					Enabled: true,
+					ENIConfigs: []ekscontrolplanev1.VpcCniENIConfig{
+						{
+							AvailabilityZone: "us-east-1a",
+							SubnetID:         "subnet-pods-1a",
+						},
+						{
+							AvailabilityZone: "us-east-1b",
+							SubnetID:         "subnet-pods-1b",
+							SecurityGroupIDs: []string{"sg-pods-1", "sg-pods-2"},
+						},
+					},
+				},
+			},
+			securityGroups: nodeSecurityGroups,
+			subnets:        secondarySubnets,
+			want: []v1alpha1.ENIConfig{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "us-east-1a", Labels: metaLabels},
+					Spec:       v1alpha1.ENIConfigSpec{Subnet: "subnet-pods-1a", SecurityGroups: []string{"sg-node"}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "us-east-1b", Labels: metaLabels},
+					Spec:       v1alpha1.ENIConfigSpec{Subnet: "subnet-pods-1b", SecurityGroups: []string{"sg-pods-1", "sg-pods-2"}},
+				},
+			},
+		},
+		{
+			name: "custom networking eni configs do not need the node security group when security groups are set",
+			cni: ekscontrolplanev1.VpcCni{
+				CustomNetworking: &ekscontrolplanev1.VpcCniCustomNetworking{
+					Enabled: true,
+					ENIConfigs: []ekscontrolplanev1.VpcCniENIConfig{
+						{
+							AvailabilityZone: "us-east-1b",
+							SubnetID:         "subnet-pods-1b",
+							SecurityGroupIDs: []string{"sg-pods-1"},
+						},
+					},
+				},
+			},
+			want: []v1alpha1.ENIConfig{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "us-east-1b", Labels: metaLabels},
+					Spec:       v1alpha1.ENIConfigSpec{Subnet: "subnet-pods-1b", SecurityGroups: []string{"sg-pods-1"}},
+				},
+			},
+		},
+		{
+			name: "disabled custom networking ignores the eni configs",
+			cni: ekscontrolplanev1.VpcCni{
+				CustomNetworking: &ekscontrolplanev1.VpcCniCustomNetworking{
+					Enabled: false,
+					ENIConfigs: []ekscontrolplanev1.VpcCniENIConfig{
+						{
+							AvailabilityZone: "us-east-1b",
+							SubnetID:         "subnet-pods-1b",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "fails without the node security group",
+			cni: ekscontrolplanev1.VpcCni{
+				CustomNetworking: &ekscontrolplanev1.VpcCniCustomNetworking{
+					Enabled: true,
+					ENIConfigs: []ekscontrolplanev1.VpcCniENIConfig{
+						{
+							AvailabilityZone: "us-east-1a",
+							SubnetID:         "subnet-pods-1a",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := NewService(&mockScope{
+				cni:            tc.cni,
+				securityGroups: tc.securityGroups,
+				subnets:        tc.subnets,
+			})
+
+			eniConfigs, err := s.eniConfigs()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(eniConfigs).To(Equal(tc.want))
+		})
+	}
+}
+
 type cachingClient struct {
 	client.Client
 	getValue    client.Object
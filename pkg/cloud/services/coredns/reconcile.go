@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coredns
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/workload"
+)
+
+const (
+	corednsName      = "coredns"
+	corednsNamespace = "kube-system"
+)
+
+// ReconcileCoreDNS will reconcile coredns.
+func (s *Service) ReconcileCoreDNS(ctx context.Context) error {
+	if !s.scope.RemoveCoreDNS() {
+		return nil
+	}
+
+	s.scope.Info("Reconciling coredns Deployment in cluster", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
+
+	remoteClient, err := s.scope.RemoteClient()
+	if err != nil {
+		s.scope.Error(err, "getting client for remote cluster")
+		return fmt.Errorf("getting client for remote cluster: %w", err)
+	}
+
+	if err := s.deleteCoreDNS(ctx, remoteClient); err != nil {
+		return fmt.Errorf("removing coredns: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) deleteCoreDNS(ctx context.Context, remoteClient client.Client) error {
+	s.scope.Info("Ensuring the coredns Deployment in cluster is deleted", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
+
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: corednsNamespace, Name: corednsName}}
+	deleted, err := workload.DeleteIfExists(ctx, remoteClient, deployment)
+	if err != nil {
+		return fmt.Errorf("deleting coredns Deployment: %w", err)
+	}
+	if !deleted {
+		s.scope.Debug("The coredns Deployment is not found, no action")
+		return nil
+	}
+	record.Eventf(s.scope.InfraCluster(), "DeletedCoreDNS", "CoreDNS has been removed from the cluster. Ensure you enable cluster DNS via another mechanism")
+
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coredns
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+func TestReconcileCoreDNS(t *testing.T) {
+	testCases := []struct {
+		name        string
+		remove      bool
+		deployment  bool
+		wantDeleted bool
+	}{
+		{
+			name:        "coredns is kept if it isn't removed",
+			remove:      false,
+			deployment:  true,
+			wantDeleted: false,
+		},
+		{
+			name:        "coredns is deleted if it is removed",
+			remove:      true,
+			deployment:  true,
+			wantDeleted: true,
+		},
+		{
+			name:        "coredns that was already deleted is not recreated",
+			remove:      true,
+			deployment:  false,
+			wantDeleted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = appsv1.AddToScheme(scheme)
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			if tc.deployment {
+				builder = builder.WithObjects(&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: corednsNamespace,
+						Name:      corednsName,
+					},
+				})
+			}
+			remoteClient := builder.Build()
+
+			s := NewService(&mockScope{client: remoteClient, remove: tc.remove})
+			// Reconciling twice checks that the deletion is idempotent.
+			g.Expect(s.ReconcileCoreDNS(context.TODO())).To(Succeed())
+			g.Expect(s.ReconcileCoreDNS(context.TODO())).To(Succeed())
+
+			err := remoteClient.Get(context.TODO(), types.NamespacedName{Namespace: corednsNamespace, Name: corednsName}, &appsv1.Deployment{})
+			if tc.wantDeleted {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+type mockScope struct {
+	scope.CoreDNSScope
+	client client.Client
+	remove bool
+}
+
+func (s *mockScope) RemoteClient() (client.Client, error) {
+	return s.client, nil
+}
+
+func (s *mockScope) RemoveCoreDNS() bool {
+	return s.remove
+}
+
+func (s *mockScope) Info(msg string, keysAndValues ...interface{}) {
+}
+
+func (s *mockScope) Debug(msg string, keysAndValues ...interface{}) {
+}
+
+func (s *mockScope) Name() string {
+	return "mock-name"
+}
+
+func (s *mockScope) Namespace() string {
+	return "mock-namespace"
+}
+
+func (s *mockScope) InfraCluster() cloud.ClusterObject {
+	return &ekscontrolplanev1.AWSManagedControlPlane{}
+}
@@ -112,12 +112,42 @@ func GenerateAmiName(amiNameFormat, baseOS, kubernetesVersion string) (string, e
 	return templateBytes.String(), nil
 }
 
+// pickArchitecture returns the architecture of the AMI reference if set, once checked against the architectures
+// supported by the instance type, or the architecture supported by the instance type otherwise.
+func (s *Service) pickArchitecture(ami infrav1.AMIReference, instanceType string) (string, error) {
+	if ami.Architecture == "" {
+		return s.pickArchitectureForInstanceType(instanceType)
+	}
+	if instanceType == "" {
+		return ami.Architecture, nil
+	}
+
+	info, err := s.describeInstanceType(instanceType)
+	if err != nil {
+		// if call to DescribeInstanceTypes fails due to permissions error, log a warning and trust the architecture of the AMI reference.
+		if awserrors.IsPermissionsError(err) {
+			record.Warnf(s.scope.InfraCluster(), "FailedDescribeInstanceTypes", "insufficient permissions to describe instance types for instance type %q, using the architecture %q of the AMI reference: %v", instanceType, ami.Architecture, err)
+
+			return ami.Architecture, nil
+		}
+		return "", errors.Wrapf(err, "failed to describe instance types for instance type %q", instanceType)
+	}
+
+	if info == nil {
+		return "", fmt.Errorf("instance type result empty for type %q", instanceType)
+	}
+
+	for _, a := range info.ProcessorInfo.SupportedArchitectures {
+		if aws.StringValue(a) == ami.Architecture {
+			return ami.Architecture, nil
+		}
+	}
+	return "", fmt.Errorf("architecture %q of the AMI reference is not supported by instance type %q, which supports %v", ami.Architecture, instanceType, aws.StringValueSlice(info.ProcessorInfo.SupportedArchitectures))
+}
+
 // Determine architecture based on instance type.
 func (s *Service) pickArchitectureForInstanceType(instanceType string) (string, error) {
-	descInstanceTypeInput := &ec2.DescribeInstanceTypesInput{
-		InstanceTypes: []*string{&instanceType},
-	}
-	describeInstanceTypeResult, err := s.EC2Client.DescribeInstanceTypesWithContext(context.TODO(), descInstanceTypeInput)
+	info, err := s.describeInstanceType(instanceType)
 	if err != nil {
 		// if call to DescribeInstanceTypes fails due to permissions error, log a warning and return the default architecture.
 		if awserrors.IsPermissionsError(err) {
@@ -128,11 +158,11 @@ func (s *Service) pickArchitectureForInstanceType(instanceType string) (string,
 		return "", errors.Wrapf(err, "failed to describe instance types for instance type %q", instanceType)
 	}
 
-	if len(describeInstanceTypeResult.InstanceTypes) == 0 {
+	if info == nil {
 		return "", fmt.Errorf("instance type result empty for type %q", instanceType)
 	}
 
-	supportedArchs := describeInstanceTypeResult.InstanceTypes[0].ProcessorInfo.SupportedArchitectures
+	supportedArchs := info.ProcessorInfo.SupportedArchitectures
 
 	logger := s.scope.GetLogger().WithValues("instance type", instanceType, "supported architectures", supportedArchs)
 	logger.Info("Obtained a list of supported architectures for instance type")
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/golang/mock/gomock"
@@ -579,3 +580,113 @@ func TestEKSAMILookUp(t *testing.T) {
 		})
 	}
 }
+
+func TestPickArchitecture(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name    string
+		ami     infrav1.AMIReference
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Should use the architecture hint supported by the instance type",
+			ami:  infrav1.AMIReference{Architecture: Arm64ArchitectureTag},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("m6g.large")},
+				})).Return(&ec2.DescribeInstanceTypesOutput{
+					InstanceTypes: []*ec2.InstanceTypeInfo{
+						{
+							ProcessorInfo: &ec2.ProcessorInfo{
+								SupportedArchitectures: []*string{aws.String(Arm64ArchitectureTag)},
+							},
+						},
+					},
+				}, nil)
+			},
+			want: Arm64ArchitectureTag,
+		},
+		{
+			name: "Should return an error if the architecture hint is not supported by the instance type",
+			ami:  infrav1.AMIReference{Architecture: Amd64ArchitectureTag},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeInstanceTypesOutput{
+					InstanceTypes: []*ec2.InstanceTypeInfo{
+						{
+							ProcessorInfo: &ec2.ProcessorInfo{
+								SupportedArchitectures: []*string{aws.String(Arm64ArchitectureTag)},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should use the architecture hint if the instance type can't be described due to permissions",
+			ami:  infrav1.AMIReference{Architecture: Arm64ArchitectureTag},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New(awserrors.UnauthorizedOperation, "not authorized", nil))
+			},
+			want: Arm64ArchitectureTag,
+		},
+		{
+			name: "Should use the architecture supported by the instance type without a hint",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("m6g.large")},
+				})).Return(&ec2.DescribeInstanceTypesOutput{
+					InstanceTypes: []*ec2.InstanceTypeInfo{
+						{
+							ProcessorInfo: &ec2.ProcessorInfo{
+								SupportedArchitectures: []*string{aws.String(Arm64ArchitectureTag)},
+							},
+						},
+					},
+				}, nil)
+			},
+			want: Arm64ArchitectureTag,
+		},
+		{
+			name: "Should return an error if the instance type can't be described without a hint",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(ec2Mock.EXPECT())
+			}
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			got, err := s.pickArchitecture(tt.ami, "m6g.large")
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).Should(Equal(tt.want))
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/userdata"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -51,7 +52,7 @@ func (s *Service) ReconcileBastion() error {
 		_, err := s.describeBastionInstance()
 		if err != nil {
 			if awserrors.IsNotFound(err) {
-				return nil
+				return s.reconcileBastionTermination()
 			}
 			return err
 		}
@@ -109,7 +110,7 @@ func (s *Service) DeleteBastion() error {
 	if err != nil {
 		if awserrors.IsNotFound(err) {
 			s.scope.Trace("bastion instance does not exist")
-			return nil
+			return s.reconcileBastionTermination()
 		}
 		return errors.Wrap(err, "unable to describe bastion instance")
 	}
@@ -119,12 +120,22 @@ func (s *Service) DeleteBastion() error {
 		return err
 	}
 
-	if err := s.TerminateInstanceAndWait(instance.ID); err != nil {
+	terminate := s.TerminateInstanceAndWait
+	if !s.scope.UseBlockingWaiters() {
+		terminate = s.TerminateInstance
+	}
+	if err := terminate(instance.ID); err != nil {
 		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
 		record.Warnf(s.scope.InfraCluster(), "FailedTerminateBastion", "Failed to terminate bastion instance %q: %v", instance.ID, err)
 		return errors.Wrap(err, "unable to delete bastion instance")
 	}
 
+	if !s.scope.UseBlockingWaiters() {
+		// The Deleting reason of the condition tells the next reconciles to check on the termination.
+		s.scope.Info("Waiting for bastion host to terminate", "id", instance.ID)
+		return wait.NewPending(fmt.Sprintf("bastion instance %q is terminating", instance.ID))
+	}
+
 	s.scope.SetBastionInstance(nil)
 
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
@@ -134,6 +145,42 @@ func (s *Service) DeleteBastion() error {
 	return nil
 }
 
+// reconcileBastionTermination checks on a bastion instance terminated without waiting for it. It returns a pending
+// error until the instance is terminated.
+func (s *Service) reconcileBastionTermination() error {
+	if s.scope.UseBlockingWaiters() || conditions.GetReason(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition) != clusterv1.DeletingReason {
+		return nil
+	}
+
+	input := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ProviderRole(infrav1.BastionRoleTagValue),
+			filter.EC2.Cluster(s.scope.Name()),
+			filter.EC2.InstanceStates(ec2.InstanceStateNameShuttingDown),
+		},
+	}
+
+	out, err := s.EC2Client.DescribeInstancesWithContext(context.TODO(), input)
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeBastionHost", "Failed to describe bastion host: %v", err)
+		return errors.Wrap(err, "failed to describe bastion host")
+	}
+
+	for _, res := range out.Reservations {
+		if len(res.Instances) > 0 {
+			return wait.NewPending(fmt.Sprintf("bastion instance %q is terminating", aws.StringValue(res.Instances[0].InstanceId)))
+		}
+	}
+
+	s.scope.SetBastionInstance(nil)
+
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulTerminateBastion", "Terminated bastion instance")
+	s.scope.Info("Deleted bastion host")
+
+	return nil
+}
+
 func (s *Service) describeBastionInstance() (*infrav1.Instance, error) {
 	input := &ec2.DescribeInstancesInput{
 		Filters: []*ec2.Filter{
@@ -169,7 +216,7 @@ func (s *Service) describeBastionInstance() (*infrav1.Instance, error) {
 
 func (s *Service) getDefaultBastion(instanceType, ami string) (*infrav1.Instance, error) {
 	name := fmt.Sprintf("%s-bastion", s.scope.Name())
-	userData, _ := userdata.NewBastion(&userdata.BastionInput{})
+	userData, _ := userdata.NewBastion(&userdata.BastionInput{DNSSuffix: s.scope.DNSSuffix()})
 
 	// If SSHKeyName WAS NOT provided, use the defaultSSHKeyName
 	keyName := s.scope.SSHKeyName()
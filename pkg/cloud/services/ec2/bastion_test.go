@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
@@ -32,8 +33,10 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestServiceDeleteBastion(t *testing.T) {
@@ -195,8 +198,9 @@ func TestServiceDeleteBastion(t *testing.T) {
 							Name:      clusterName,
 						},
 					},
-					AWSCluster: awsCluster,
-					Client:     client,
+					AWSCluster:         awsCluster,
+					Client:             client,
+					UseBlockingWaiters: true,
 				})
 				g.Expect(err).To(BeNil())
 
@@ -224,6 +228,116 @@ func TestServiceDeleteBastion(t *testing.T) {
 	}
 }
 
+func TestServiceDeleteBastionPolling(t *testing.T) {
+	g := NewWithT(t)
+	clusterName := "cluster"
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+	ec2Mock := mocks.NewMockEC2API(mockControl)
+
+	scheme, err := setupScheme()
+	g.Expect(err).To(BeNil())
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpcID",
+				},
+			},
+		},
+		Status: infrav1.AWSClusterStatus{
+			Bastion: &infrav1.Instance{ID: "id123"},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).WithStatusSubresource(awsCluster).Build()
+
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      clusterName,
+			},
+		},
+		AWSCluster: awsCluster,
+		Client:     client,
+	})
+	g.Expect(err).To(BeNil())
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	describeInput := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ProviderRole(infrav1.BastionRoleTagValue),
+			filter.EC2.Cluster(clusterName),
+			filter.EC2.InstanceStates(
+				ec2.InstanceStateNamePending,
+				ec2.InstanceStateNameRunning,
+				ec2.InstanceStateNameStopping,
+				ec2.InstanceStateNameStopped,
+			),
+		},
+	}
+	describeShuttingDownInput := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ProviderRole(infrav1.BastionRoleTagValue),
+			filter.EC2.Cluster(clusterName),
+			filter.EC2.InstanceStates(ec2.InstanceStateNameShuttingDown),
+		},
+	}
+	instanceOutput := func(state string) *ec2.DescribeInstancesOutput {
+		return &ec2.DescribeInstancesOutput{
+			Reservations: []*ec2.Reservation{{
+				Instances: []*ec2.Instance{{
+					InstanceId: aws.String("id123"),
+					State:      &ec2.InstanceState{Name: aws.String(state)},
+					Placement:  &ec2.Placement{AvailabilityZone: aws.String("us-east-1")},
+				}},
+			}},
+		}
+	}
+
+	// The first reconcile terminates the instance without waiting for it.
+	ec2Mock.EXPECT().DescribeInstancesWithContext(context.TODO(), gomock.Eq(describeInput)).
+		Return(instanceOutput(ec2.InstanceStateNameRunning), nil)
+	ec2Mock.EXPECT().TerminateInstancesWithContext(context.TODO(), gomock.Eq(&ec2.TerminateInstancesInput{InstanceIds: aws.StringSlice([]string{"id123"})})).
+		Return(nil, nil)
+
+	err = s.DeleteBastion()
+	g.Expect(wait.IsPending(err)).To(BeTrue(), "expected a pending error, got %v", err)
+	g.Expect(conditions.GetReason(scope.AWSCluster, infrav1.BastionHostReadyCondition)).To(Equal(clusterv1.DeletingReason))
+	g.Expect(scope.AWSCluster.Status.Bastion).NotTo(BeNil())
+
+	// The next reconcile finds the instance shutting down.
+	ec2Mock.EXPECT().DescribeInstancesWithContext(context.TODO(), gomock.Eq(describeInput)).
+		Return(&ec2.DescribeInstancesOutput{}, nil)
+	ec2Mock.EXPECT().DescribeInstancesWithContext(context.TODO(), gomock.Eq(describeShuttingDownInput)).
+		Return(instanceOutput(ec2.InstanceStateNameShuttingDown), nil)
+
+	err = s.DeleteBastion()
+	g.Expect(wait.IsPending(err)).To(BeTrue(), "expected a pending error, got %v", err)
+	g.Expect(scope.AWSCluster.Status.Bastion).NotTo(BeNil())
+
+	// Once the instance is terminated the bastion is deleted.
+	ec2Mock.EXPECT().DescribeInstancesWithContext(context.TODO(), gomock.Eq(describeInput)).
+		Return(&ec2.DescribeInstancesOutput{}, nil)
+	ec2Mock.EXPECT().DescribeInstancesWithContext(context.TODO(), gomock.Eq(describeShuttingDownInput)).
+		Return(&ec2.DescribeInstancesOutput{}, nil)
+
+	g.Expect(s.DeleteBastion()).To(Succeed())
+	g.Expect(conditions.GetReason(scope.AWSCluster, infrav1.BastionHostReadyCondition)).To(Equal(clusterv1.DeletedReason))
+	g.Expect(scope.AWSCluster.Status.Bastion).To(BeNil())
+
+	// Later reconciles don't look for the terminated instance anymore.
+	ec2Mock.EXPECT().DescribeInstancesWithContext(context.TODO(), gomock.Eq(describeInput)).
+		Return(&ec2.DescribeInstancesOutput{}, nil)
+
+	g.Expect(s.DeleteBastion()).To(Succeed())
+}
+
 func TestServiceReconcileBastion(t *testing.T) {
 	clusterName := "cluster"
 
@@ -259,11 +373,13 @@ func TestServiceReconcileBastion(t *testing.T) {
 	}
 
 	tests := []struct {
-		name           string
-		bastionEnabled bool
-		expect         func(m *mocks.MockEC2APIMockRecorder)
-		expectError    bool
-		bastionStatus  *infrav1.Instance
+		name                string
+		bastionEnabled      bool
+		bastionInstanceType string
+		bastionAMI          string
+		expect              func(m *mocks.MockEC2APIMockRecorder)
+		expectError         bool
+		bastionStatus       *infrav1.Instance
 	}{
 		{
 			name: "Should ignore reconciliation if instance not found",
@@ -379,6 +495,65 @@ func TestServiceReconcileBastion(t *testing.T) {
 				VolumeIDs:        []string{"volume-1"},
 			},
 		},
+		{
+			name: "Should create bastion with the specified instance type and AMI",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstancesWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeInstancesOutput{}, nil).MinTimes(1)
+				m.RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, input *ec2.RunInstancesInput, requestOptions ...request.Option) (*ec2.Reservation, error) {
+						if aws.StringValue(input.InstanceType) != "m5.large" {
+							return nil, errors.Errorf("expected instance type to be 'm5.large', not '%s'", aws.StringValue(input.InstanceType))
+						}
+						if aws.StringValue(input.ImageId) != "custom-ami-id" {
+							return nil, errors.Errorf("expected AMI to be 'custom-ami-id', not '%s'", aws.StringValue(input.ImageId))
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNameRunning),
+									},
+									IamInstanceProfile: &ec2.IamInstanceProfile{
+										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+									},
+									InstanceId:     aws.String("id123"),
+									InstanceType:   aws.String("m5.large"),
+									SubnetId:       aws.String("subnet-1"),
+									ImageId:        aws.String("custom-ami-id"),
+									RootDeviceName: aws.String("device-1"),
+									BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+										{
+											DeviceName: aws.String("device-1"),
+											Ebs: &ec2.EbsInstanceBlockDevice{
+												VolumeId: aws.String("volume-1"),
+											},
+										},
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: aws.String("us-east-1"),
+									},
+								},
+							},
+						}, nil
+					})
+			},
+			bastionEnabled:      true,
+			bastionInstanceType: "m5.large",
+			bastionAMI:          "custom-ami-id",
+			expectError:         false,
+			bastionStatus: &infrav1.Instance{
+				ID:               "id123",
+				State:            "running",
+				Type:             "m5.large",
+				SubnetID:         "subnet-1",
+				ImageID:          "custom-ami-id",
+				IAMProfile:       "foo",
+				Addresses:        []clusterv1.MachineAddress{},
+				AvailabilityZone: "us-east-1",
+				VolumeIDs:        []string{"volume-1"},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -414,7 +589,11 @@ func TestServiceReconcileBastion(t *testing.T) {
 								},
 							},
 						},
-						Bastion: infrav1.Bastion{Enabled: tc.bastionEnabled},
+						Bastion: infrav1.Bastion{
+							Enabled:      tc.bastionEnabled,
+							InstanceType: tc.bastionInstanceType,
+							AMI:          tc.bastionAMI,
+						},
 					},
 				}
 
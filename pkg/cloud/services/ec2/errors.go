@@ -24,4 +24,13 @@ var (
 
 	// ErrDescribeInstance defines an error for when AWS SDK returns error when describing instances.
 	ErrDescribeInstance = errors.New("failed to describe instance by id")
+
+	// errUnsupportedCPUOptions defines an error for when the requested CPU options are not supported by the instance type.
+	errUnsupportedCPUOptions = errors.New("unsupported CPU options")
+
+	// errUnsupportedEnclaveOptions defines an error for when Nitro Enclaves are requested on an instance type which does not support them.
+	errUnsupportedEnclaveOptions = errors.New("unsupported enclave options")
+
+	// errMissingGPUs defines an error for when GPUs are required on an instance type which has none.
+	errMissingGPUs = errors.New("missing GPUs")
 )
@@ -0,0 +1,299 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// activeInstanceConnectEndpointStates are the states of EC2 Instance Connect Endpoints that are not being deleted.
+var activeInstanceConnectEndpointStates = []string{
+	ec2.Ec2InstanceConnectEndpointStateCreateInProgress,
+	ec2.Ec2InstanceConnectEndpointStateCreateComplete,
+	ec2.Ec2InstanceConnectEndpointStateCreateFailed,
+}
+
+// ReconcileInstanceConnectEndpoint ensures the EC2 Instance Connect Endpoint of the cluster exists when it is
+// configured, and deletes it when it is not.
+func (s *Service) ReconcileInstanceConnectEndpoint() error {
+	spec := s.scope.InstanceConnectEndpoint()
+	if spec == nil {
+		// Instance Connect Endpoint APIs are only called when an endpoint was created,
+		// so that clusters not using it don't need the permissions.
+		_, hasSecurityGroup := s.scope.SecurityGroups()[infrav1.SecurityGroupInstanceConnectEndpoint]
+		if s.scope.Network().InstanceConnectEndpointID == "" && !hasSecurityGroup {
+			return nil
+		}
+		if err := s.DeleteInstanceConnectEndpoint(); err != nil {
+			return err
+		}
+		// The security group is no longer reconciled once the endpoint is disabled, so it is deleted along with it.
+		// On cluster deletion, it is deleted with the other security groups of the cluster instead.
+		return s.deleteInstanceConnectEndpointSecurityGroup()
+	}
+
+	s.scope.Debug("Reconciling instance connect endpoint")
+
+	subnetID := aws.StringValue(spec.SubnetID)
+	if subnetID == "" {
+		private := s.scope.Subnets().FilterPrivate()
+		if len(private) == 0 {
+			return errors.New("failed to reconcile instance connect endpoint, no private subnets are available")
+		}
+		subnetID = private[0].GetResourceID()
+	} else {
+		subnet := s.scope.Subnets().FindByID(subnetID)
+		if subnet == nil {
+			return errors.Errorf("failed to reconcile instance connect endpoint, subnet %q is not a subnet of the cluster", subnetID)
+		}
+		if subnet.IsPublic {
+			return errors.Errorf("failed to reconcile instance connect endpoint, subnet %q is not a private subnet", subnetID)
+		}
+	}
+
+	endpoint, err := s.describeInstanceConnectEndpoint()
+	if err != nil {
+		return err
+	}
+
+	// Endpoints can't be moved to another subnet, and failed ones can't be recovered, so they are recreated.
+	if endpoint != nil && (aws.StringValue(endpoint.SubnetId) != subnetID || aws.StringValue(endpoint.State) == ec2.Ec2InstanceConnectEndpointStateCreateFailed) {
+		if err := s.deleteInstanceConnectEndpoint(aws.StringValue(endpoint.InstanceConnectEndpointId)); err != nil {
+			return err
+		}
+		endpoint = nil
+	}
+
+	if endpoint == nil {
+		endpoint, err = s.createInstanceConnectEndpoint(subnetID)
+		if err != nil {
+			return err
+		}
+	}
+
+	id := aws.StringValue(endpoint.InstanceConnectEndpointId)
+	s.scope.Network().InstanceConnectEndpointID = id
+
+	if aws.StringValue(endpoint.State) != ec2.Ec2InstanceConnectEndpointStateCreateComplete {
+		if err := s.waitForInstanceConnectEndpointCreated(id); err != nil {
+			return err
+		}
+	}
+
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition)
+	return nil
+}
+
+// DeleteInstanceConnectEndpoint deletes the EC2 Instance Connect Endpoint of the cluster, if any.
+func (s *Service) DeleteInstanceConnectEndpoint() error {
+	if s.scope.InstanceConnectEndpoint() == nil && s.scope.Network().InstanceConnectEndpointID == "" {
+		return nil
+	}
+
+	endpoint, err := s.describeInstanceConnectEndpoint()
+	if err != nil {
+		return err
+	}
+
+	if endpoint != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+		if err := s.scope.PatchObject(); err != nil {
+			return err
+		}
+
+		if err := s.deleteInstanceConnectEndpoint(aws.StringValue(endpoint.InstanceConnectEndpointId)); err != nil {
+			conditions.MarkFalse(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+			return err
+		}
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+	}
+
+	s.scope.Network().InstanceConnectEndpointID = ""
+	return nil
+}
+
+func (s *Service) createInstanceConnectEndpoint(subnetID string) (*ec2.Ec2InstanceConnectEndpoint, error) {
+	sg, ok := s.scope.SecurityGroups()[infrav1.SecurityGroupInstanceConnectEndpoint]
+	if !ok || sg.ID == "" {
+		return nil, errors.Errorf("failed to create instance connect endpoint: security group %q not found", infrav1.SecurityGroupInstanceConnectEndpoint)
+	}
+
+	out, err := s.EC2Client.CreateInstanceConnectEndpointWithContext(context.TODO(), &ec2.CreateInstanceConnectEndpointInput{
+		SubnetId:         aws.String(subnetID),
+		SecurityGroupIds: aws.StringSlice([]string{sg.ID}),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeInstanceConnectEndpoint, s.getInstanceConnectEndpointTagParams(services.TemporaryResourceID)),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateInstanceConnectEndpoint", "Failed to create Instance Connect Endpoint in subnet %q: %v", subnetID, err)
+		return nil, errors.Wrapf(err, "failed to create instance connect endpoint in subnet %q", subnetID)
+	}
+
+	id := aws.StringValue(out.InstanceConnectEndpoint.InstanceConnectEndpointId)
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateInstanceConnectEndpoint", "Created Instance Connect Endpoint %q in subnet %q", id, subnetID)
+	s.scope.Info("Created instance connect endpoint", "instance-connect-endpoint-id", id, "subnet-id", subnetID)
+
+	return out.InstanceConnectEndpoint, nil
+}
+
+func (s *Service) deleteInstanceConnectEndpointSecurityGroup() error {
+	sg, ok := s.scope.SecurityGroups()[infrav1.SecurityGroupInstanceConnectEndpoint]
+	if !ok {
+		return nil
+	}
+
+	if sg.ID != "" {
+		if _, err := s.EC2Client.DeleteSecurityGroupWithContext(context.TODO(), &ec2.DeleteSecurityGroupInput{
+			GroupId: aws.String(sg.ID),
+		}); awserrors.IsIgnorableSecurityGroupError(err) != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteSecurityGroup", "Failed to delete instance connect endpoint SecurityGroup %q: %v", sg.ID, err)
+			return errors.Wrapf(err, "failed to delete instance connect endpoint security group %q", sg.ID)
+		}
+
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteSecurityGroup", "Deleted instance connect endpoint SecurityGroup %q", sg.ID)
+		s.scope.Info("Deleted security group", "security-group-id", sg.ID, "kind", "instance connect endpoint")
+	}
+
+	delete(s.scope.SecurityGroups(), infrav1.SecurityGroupInstanceConnectEndpoint)
+	return nil
+}
+
+func (s *Service) waitForInstanceConnectEndpointCreated(id string) error {
+	s.scope.Debug("Waiting for instance connect endpoint to be created", "instance-connect-endpoint-id", id)
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		endpoint, err := s.getInstanceConnectEndpoint(id)
+		if err != nil {
+			return false, err
+		}
+		if endpoint == nil {
+			return false, errors.Errorf("instance connect endpoint %q not found", id)
+		}
+		switch aws.StringValue(endpoint.State) {
+		case ec2.Ec2InstanceConnectEndpointStateCreateComplete:
+			return true, nil
+		case ec2.Ec2InstanceConnectEndpointStateCreateFailed:
+			return false, errors.Errorf("instance connect endpoint %q failed to be created: %s", id, aws.StringValue(endpoint.StateMessage))
+		}
+		return false, nil
+	}); err != nil {
+		return errors.Wrapf(err, "failed to wait for instance connect endpoint %q to be created", id)
+	}
+
+	return nil
+}
+
+func (s *Service) deleteInstanceConnectEndpoint(id string) error {
+	if _, err := s.EC2Client.DeleteInstanceConnectEndpointWithContext(context.TODO(), &ec2.DeleteInstanceConnectEndpointInput{
+		InstanceConnectEndpointId: aws.String(id),
+	}); err != nil {
+		if code, _ := awserrors.Code(err); code == awserrors.InstanceConnectEndpointNotFound {
+			return nil
+		}
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteInstanceConnectEndpoint", "Failed to delete Instance Connect Endpoint %q: %v", id, err)
+		return errors.Wrapf(err, "failed to delete instance connect endpoint %q", id)
+	}
+
+	// The endpoint holds a network interface using its security group, so it needs to be gone before the security group is deleted.
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		endpoint, err := s.getInstanceConnectEndpoint(id)
+		if err != nil {
+			return false, err
+		}
+		if endpoint == nil {
+			return true, nil
+		}
+		switch aws.StringValue(endpoint.State) {
+		case ec2.Ec2InstanceConnectEndpointStateDeleteComplete:
+			return true, nil
+		case ec2.Ec2InstanceConnectEndpointStateDeleteFailed:
+			return false, errors.Errorf("instance connect endpoint %q failed to be deleted: %s", id, aws.StringValue(endpoint.StateMessage))
+		}
+		return false, nil
+	}); err != nil {
+		return errors.Wrapf(err, "failed to wait for instance connect endpoint %q to be deleted", id)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteInstanceConnectEndpoint", "Deleted Instance Connect Endpoint %q", id)
+	s.scope.Info("Deleted instance connect endpoint", "instance-connect-endpoint-id", id)
+
+	return nil
+}
+
+// describeInstanceConnectEndpoint returns the active EC2 Instance Connect Endpoint owned by the cluster, if any.
+func (s *Service) describeInstanceConnectEndpoint() (*ec2.Ec2InstanceConnectEndpoint, error) {
+	out, err := s.EC2Client.DescribeInstanceConnectEndpointsWithContext(context.TODO(), &ec2.DescribeInstanceConnectEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.InstanceConnectEndpointStates(activeInstanceConnectEndpointStates...),
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeInstanceConnectEndpoints", "Failed to describe instance connect endpoints: %v", err)
+		return nil, errors.Wrap(err, "failed to describe instance connect endpoints")
+	}
+
+	if len(out.InstanceConnectEndpoints) == 0 {
+		return nil, nil
+	}
+	return out.InstanceConnectEndpoints[0], nil
+}
+
+func (s *Service) getInstanceConnectEndpoint(id string) (*ec2.Ec2InstanceConnectEndpoint, error) {
+	out, err := s.EC2Client.DescribeInstanceConnectEndpointsWithContext(context.TODO(), &ec2.DescribeInstanceConnectEndpointsInput{
+		InstanceConnectEndpointIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		if code, _ := awserrors.Code(err); code == awserrors.InstanceConnectEndpointNotFound {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe instance connect endpoint %q", id)
+	}
+	if len(out.InstanceConnectEndpoints) == 0 {
+		return nil, nil
+	}
+
+	return out.InstanceConnectEndpoints[0], nil
+}
+
+func (s *Service) getInstanceConnectEndpointTagParams(id string) infrav1.BuildParams {
+	name := s.scope.Name() + "-instance-connect-endpoint"
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  id,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
@@ -0,0 +1,399 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestServiceReconcileInstanceConnectEndpoint(t *testing.T) {
+	clusterName := "cluster"
+
+	describeOwnedInput := &ec2.DescribeInstanceConnectEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ClusterOwned(clusterName),
+			filter.EC2.InstanceConnectEndpointStates(activeInstanceConnectEndpointStates...),
+		},
+	}
+
+	tests := []struct {
+		name       string
+		endpoint   *infrav1.InstanceConnectEndpointSpec
+		endpointID string
+		expect     func(m *mocks.MockEC2APIMockRecorder)
+		expectErr  bool
+		expectID   string
+	}{
+		{
+			name: "does nothing when the endpoint is not configured",
+		},
+		{
+			name:     "creates the endpoint in the first private subnet with its security group",
+			endpoint: &infrav1.InstanceConnectEndpointSpec{},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeOwnedInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{}, nil)
+				m.CreateInstanceConnectEndpointWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateInstanceConnectEndpointInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateInstanceConnectEndpointInput, _ ...interface{}) (*ec2.CreateInstanceConnectEndpointOutput, error) {
+						if aws.StringValue(input.SubnetId) != "subnet-private" {
+							t.Fatalf("expected endpoint in subnet %q, got %q", "subnet-private", aws.StringValue(input.SubnetId))
+						}
+						if len(input.SecurityGroupIds) != 1 || aws.StringValue(input.SecurityGroupIds[0]) != "sg-eice" {
+							t.Fatalf("expected endpoint with security group %q, got %v", "sg-eice", aws.StringValueSlice(input.SecurityGroupIds))
+						}
+						return &ec2.CreateInstanceConnectEndpointOutput{
+							InstanceConnectEndpoint: &ec2.Ec2InstanceConnectEndpoint{
+								InstanceConnectEndpointId: aws.String("eice-1"),
+								SubnetId:                  aws.String("subnet-private"),
+								State:                     aws.String(ec2.Ec2InstanceConnectEndpointStateCreateInProgress),
+							},
+						}, nil
+					})
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceConnectEndpointsInput{
+					InstanceConnectEndpointIds: aws.StringSlice([]string{"eice-1"}),
+				})).Return(&ec2.DescribeInstanceConnectEndpointsOutput{
+					InstanceConnectEndpoints: []*ec2.Ec2InstanceConnectEndpoint{
+						{
+							InstanceConnectEndpointId: aws.String("eice-1"),
+							State:                     aws.String(ec2.Ec2InstanceConnectEndpointStateCreateComplete),
+						},
+					},
+				}, nil)
+			},
+			expectID: "eice-1",
+		},
+		{
+			name:     "keeps an existing endpoint in the configured subnet",
+			endpoint: &infrav1.InstanceConnectEndpointSpec{SubnetID: aws.String("subnet-other")},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeOwnedInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{
+						InstanceConnectEndpoints: []*ec2.Ec2InstanceConnectEndpoint{
+							{
+								InstanceConnectEndpointId: aws.String("eice-1"),
+								SubnetId:                  aws.String("subnet-other"),
+								State:                     aws.String(ec2.Ec2InstanceConnectEndpointStateCreateComplete),
+							},
+						},
+					}, nil)
+			},
+			expectID: "eice-1",
+		},
+		{
+			name:       "recreates the endpoint when its subnet changed",
+			endpoint:   &infrav1.InstanceConnectEndpointSpec{},
+			endpointID: "eice-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeOwnedInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{
+						InstanceConnectEndpoints: []*ec2.Ec2InstanceConnectEndpoint{
+							{
+								InstanceConnectEndpointId: aws.String("eice-1"),
+								SubnetId:                  aws.String("subnet-other"),
+								State:                     aws.String(ec2.Ec2InstanceConnectEndpointStateCreateComplete),
+							},
+						},
+					}, nil)
+				m.DeleteInstanceConnectEndpointWithContext(context.TODO(), gomock.Eq(&ec2.DeleteInstanceConnectEndpointInput{
+					InstanceConnectEndpointId: aws.String("eice-1"),
+				})).Return(&ec2.DeleteInstanceConnectEndpointOutput{}, nil)
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceConnectEndpointsInput{
+					InstanceConnectEndpointIds: aws.StringSlice([]string{"eice-1"}),
+				})).Return(nil, awserr.New(awserrors.InstanceConnectEndpointNotFound, "not found", nil))
+				m.CreateInstanceConnectEndpointWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateInstanceConnectEndpointInput{})).
+					Return(&ec2.CreateInstanceConnectEndpointOutput{
+						InstanceConnectEndpoint: &ec2.Ec2InstanceConnectEndpoint{
+							InstanceConnectEndpointId: aws.String("eice-2"),
+							SubnetId:                  aws.String("subnet-private"),
+							State:                     aws.String(ec2.Ec2InstanceConnectEndpointStateCreateComplete),
+						},
+					}, nil)
+			},
+			expectID: "eice-2",
+		},
+		{
+			name:       "deletes the endpoint and its security group after it was removed from the spec",
+			endpointID: "eice-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeOwnedInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{
+						InstanceConnectEndpoints: []*ec2.Ec2InstanceConnectEndpoint{
+							{
+								InstanceConnectEndpointId: aws.String("eice-1"),
+								SubnetId:                  aws.String("subnet-private"),
+								State:                     aws.String(ec2.Ec2InstanceConnectEndpointStateCreateComplete),
+							},
+						},
+					}, nil)
+				m.DeleteInstanceConnectEndpointWithContext(context.TODO(), gomock.Eq(&ec2.DeleteInstanceConnectEndpointInput{
+					InstanceConnectEndpointId: aws.String("eice-1"),
+				})).Return(&ec2.DeleteInstanceConnectEndpointOutput{}, nil)
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceConnectEndpointsInput{
+					InstanceConnectEndpointIds: aws.StringSlice([]string{"eice-1"}),
+				})).Return(&ec2.DescribeInstanceConnectEndpointsOutput{}, nil)
+				m.DeleteSecurityGroupWithContext(context.TODO(), gomock.Eq(&ec2.DeleteSecurityGroupInput{
+					GroupId: aws.String("sg-eice"),
+				})).Return(&ec2.DeleteSecurityGroupOutput{}, nil)
+			},
+		},
+		{
+			name:       "fails when the security group of the removed endpoint can't be deleted",
+			endpointID: "eice-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeOwnedInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{}, nil)
+				m.DeleteSecurityGroupWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New("DependencyViolation", "in use", nil))
+			},
+			expectErr: true,
+		},
+		{
+			name:      "fails when the configured subnet is public",
+			endpoint:  &infrav1.InstanceConnectEndpointSpec{SubnetID: aws.String("subnet-public")},
+			expectErr: true,
+		},
+		{
+			name:      "fails when the configured subnet is not a subnet of the cluster",
+			endpoint:  &infrav1.InstanceConnectEndpointSpec{SubnetID: aws.String("subnet-unknown")},
+			expectErr: true,
+		},
+		{
+			name:     "fails when the endpoint can't be created",
+			endpoint: &infrav1.InstanceConnectEndpointSpec{},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeOwnedInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{}, nil)
+				m.CreateInstanceConnectEndpointWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := newInstanceConnectEndpointService(t, clusterName, tc.endpoint, tc.endpointID)
+			s.EC2Client = ec2Mock
+
+			err := s.ReconcileInstanceConnectEndpoint()
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s.scope.Network().InstanceConnectEndpointID).To(Equal(tc.expectID))
+			if tc.endpoint != nil {
+				g.Expect(conditions.IsTrue(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition)).To(BeTrue())
+			} else {
+				g.Expect(s.scope.SecurityGroups()).NotTo(HaveKey(infrav1.SecurityGroupInstanceConnectEndpoint))
+			}
+		})
+	}
+}
+
+func TestServiceDeleteInstanceConnectEndpoint(t *testing.T) {
+	clusterName := "cluster"
+
+	describeOwnedInput := &ec2.DescribeInstanceConnectEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ClusterOwned(clusterName),
+			filter.EC2.InstanceConnectEndpointStates(activeInstanceConnectEndpointStates...),
+		},
+	}
+	describeByIDInput := &ec2.DescribeInstanceConnectEndpointsInput{
+		InstanceConnectEndpointIds: aws.StringSlice([]string{"eice-1"}),
+	}
+	ownedEndpoint := &ec2.DescribeInstanceConnectEndpointsOutput{
+		InstanceConnectEndpoints: []*ec2.Ec2InstanceConnectEndpoint{
+			{
+				InstanceConnectEndpointId: aws.String("eice-1"),
+				SubnetId:                  aws.String("subnet-private"),
+				State:                     aws.String(ec2.Ec2InstanceConnectEndpointStateCreateComplete),
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		endpoint   *infrav1.InstanceConnectEndpointSpec
+		endpointID string
+		expect     func(m *mocks.MockEC2APIMockRecorder)
+		expectErr  bool
+	}{
+		{
+			name: "does nothing when no endpoint was created",
+		},
+		{
+			name:       "deletes the endpoint and waits for it to be gone",
+			endpoint:   &infrav1.InstanceConnectEndpointSpec{},
+			endpointID: "eice-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeOwnedInput)).
+					Return(ownedEndpoint, nil)
+				m.DeleteInstanceConnectEndpointWithContext(context.TODO(), gomock.Eq(&ec2.DeleteInstanceConnectEndpointInput{
+					InstanceConnectEndpointId: aws.String("eice-1"),
+				})).Return(&ec2.DeleteInstanceConnectEndpointOutput{}, nil)
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeByIDInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{
+						InstanceConnectEndpoints: []*ec2.Ec2InstanceConnectEndpoint{
+							{
+								InstanceConnectEndpointId: aws.String("eice-1"),
+								State:                     aws.String(ec2.Ec2InstanceConnectEndpointStateDeleteComplete),
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name:       "deletes the endpoint recorded in status after it was removed from the spec",
+			endpointID: "eice-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeOwnedInput)).
+					Return(ownedEndpoint, nil)
+				m.DeleteInstanceConnectEndpointWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DeleteInstanceConnectEndpointOutput{}, nil)
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeByIDInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{}, nil)
+			},
+		},
+		{
+			name:       "ignores an endpoint that is already gone",
+			endpoint:   &infrav1.InstanceConnectEndpointSpec{},
+			endpointID: "eice-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeOwnedInput)).
+					Return(ownedEndpoint, nil)
+				m.DeleteInstanceConnectEndpointWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New(awserrors.InstanceConnectEndpointNotFound, "not found", nil))
+			},
+		},
+		{
+			name:       "fails when the endpoint can't be deleted",
+			endpoint:   &infrav1.InstanceConnectEndpointSpec{},
+			endpointID: "eice-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeOwnedInput)).
+					Return(ownedEndpoint, nil)
+				m.DeleteInstanceConnectEndpointWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := newInstanceConnectEndpointService(t, clusterName, tc.endpoint, tc.endpointID)
+			s.EC2Client = ec2Mock
+
+			err := s.DeleteInstanceConnectEndpoint()
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(s.scope.Network().InstanceConnectEndpointID).To(Equal(tc.endpointID))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s.scope.Network().InstanceConnectEndpointID).To(BeEmpty())
+		})
+	}
+}
+
+func newInstanceConnectEndpointService(t *testing.T, clusterName string, endpoint *infrav1.InstanceConnectEndpointSpec, endpointID string) *Service {
+	t.Helper()
+	g := NewWithT(t)
+
+	scheme, err := setupScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	securityGroups := map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{}
+	if endpoint != nil || endpointID != "" {
+		securityGroups[infrav1.SecurityGroupInstanceConnectEndpoint] = infrav1.SecurityGroup{ID: "sg-eice"}
+	}
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{ID: "vpc-1"},
+				Subnets: infrav1.Subnets{
+					{ID: "subnet-public", IsPublic: true},
+					{ID: "subnet-private", IsPublic: false},
+					{ID: "subnet-other", IsPublic: false},
+				},
+				InstanceConnectEndpoint: endpoint,
+			},
+		},
+		Status: infrav1.AWSClusterStatus{
+			Network: infrav1.NetworkStatus{
+				SecurityGroups:            securityGroups,
+				InstanceConnectEndpointID: endpointID,
+			},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).WithStatusSubresource(awsCluster).Build()
+
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      clusterName,
+			},
+		},
+		AWSCluster: awsCluster,
+		Client:     client,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return NewService(cs)
+}
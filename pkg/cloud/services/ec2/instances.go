@@ -24,8 +24,10 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -37,8 +39,12 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	capierrors "sigs.k8s.io/cluster-api/errors"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
+// MaxUserDataSize is the maximum size in bytes of the user data of an EC2 instance, before it is base64 encoded.
+const MaxUserDataSize = 16 * 1024
+
 // GetRunningInstanceByTags returns the existing instance or nothing if it doesn't exist.
 func (s *Service) GetRunningInstanceByTags(scope *scope.MachineScope) (*infrav1.Instance, error) {
 	s.scope.Debug("Looking for existing machine instance by tags")
@@ -47,7 +53,7 @@ func (s *Service) GetRunningInstanceByTags(scope *scope.MachineScope) (*infrav1.
 		Filters: []*ec2.Filter{
 			filter.EC2.VPC(s.scope.VPC().ID),
 			filter.EC2.ClusterOwned(s.scope.Name()),
-			filter.EC2.Name(scope.Name()),
+			filter.EC2.MachineName(types.NamespacedName{Namespace: scope.Machine.Namespace, Name: scope.Machine.Name}.String()),
 			filter.EC2.InstanceStates(ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning),
 		},
 	}
@@ -125,14 +131,14 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 	input.Tags = infrav1.Build(infrav1.BuildParams{
 		ClusterName: s.scope.KubernetesClusterName(),
 		Lifecycle:   infrav1.ResourceLifecycleOwned,
-		Name:        aws.String(scope.Name()),
+		Name:        aws.String(scope.InstanceName()),
 		Role:        aws.String(scope.Role()),
 		Additional:  additionalTags,
 	}.WithCloudProvider(s.scope.KubernetesClusterName()).WithMachineName(scope.Machine))
 
 	var err error
 
-	imageArchitecture, err := s.pickArchitectureForInstanceType(input.Type)
+	imageArchitecture, err := s.pickArchitecture(scope.AWSMachine.Spec.AMI, input.Type)
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +201,10 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 		}
 	}
 
+	if err := checkUserDataSize(scope, userData, userDataFormat); err != nil {
+		return nil, err
+	}
+
 	input.UserData = ptr.To[string](base64.StdEncoding.EncodeToString(userData))
 
 	// Set security groups.
@@ -238,9 +248,69 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 
 	input.PlacementGroupName = scope.AWSMachine.Spec.PlacementGroupName
 
+	input.PrivateDNSName = scope.AWSMachine.Spec.PrivateDNSName
+
+	input.MaintenanceOptions = scope.AWSMachine.Spec.MaintenanceOptions
+
+	// Every instance type the instance could be launched with must support the CPU options, enclaves and GPUs
+	// of the machine.
+	instanceTypes := append([]string{input.Type}, scope.AWSMachine.Spec.FallbackInstanceTypes...)
+
+	// The omitted CPU options are resolved to the defaults of each instance type.
+	cpuOptions := map[string]*infrav1.CPUOptions{}
+	if scope.AWSMachine.Spec.CPUOptions != nil {
+		for _, instanceType := range instanceTypes {
+			resolved, err := s.resolveCPUOptions(instanceType, scope.AWSMachine.Spec.CPUOptions)
+			if err != nil {
+				if errors.Is(err, errUnsupportedCPUOptions) {
+					scope.SetFailureReason(capierrors.CreateMachineError)
+					scope.SetFailureMessage(err)
+				}
+				return nil, err
+			}
+			cpuOptions[instanceType] = resolved
+		}
+		input.CPUOptions = cpuOptions[input.Type]
+	}
+
+	if aws.BoolValue(scope.AWSMachine.Spec.EnclaveOptions) {
+		for _, instanceType := range instanceTypes {
+			if err := s.validateEnclaveSupport(instanceType); err != nil {
+				if errors.Is(err, errUnsupportedEnclaveOptions) {
+					scope.SetFailureReason(capierrors.CreateMachineError)
+					scope.SetFailureMessage(err)
+				}
+				return nil, err
+			}
+		}
+		input.EnclaveOptions = aws.Bool(true)
+	}
+
+	if scope.RequiresGPU() {
+		for _, instanceType := range instanceTypes {
+			if err := s.ValidateGPUInstanceType(instanceType); err != nil {
+				if errors.Is(err, errMissingGPUs) {
+					scope.SetFailureReason(capierrors.CreateMachineError)
+					scope.SetFailureMessage(err)
+				}
+				return nil, err
+			}
+		}
+	}
+
+	if scope.IsControlPlane() && aws.BoolValue(scope.AWSMachine.Spec.EnableDeletionProtection) {
+		input.DisableAPITermination = aws.Bool(true)
+	}
+
 	s.scope.Debug("Running instance", "machine-role", scope.Role())
 	s.scope.Debug("Running instance with instance metadata options", "metadata options", input.InstanceMetadataOptions)
 	out, err := s.runInstance(scope.Role(), input)
+	switch {
+	case err == nil:
+		conditions.MarkTrue(scope.AWSMachine, infrav1.InstancePlacementCondition)
+	case isInstanceTypeUnavailable(err):
+		out, err = s.runInstanceWithCapacityFallback(scope, input, cpuOptions, err)
+	}
 	if err != nil {
 		// Only record the failure event if the error is not related to failed dependencies.
 		// This is to avoid spamming failure events since the machine will be requeued by the actuator.
@@ -288,6 +358,97 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 	return out, nil
 }
 
+// isInstanceTypeUnavailable returns whether an instance failed to launch because there is not enough capacity for
+// its instance type or its instance type isn't supported in its availability zone.
+func isInstanceTypeUnavailable(err error) bool {
+	return awserrors.IsInsufficientInstanceCapacity(errors.Cause(err)) || awserrors.IsUnsupported(errors.Cause(err))
+}
+
+// runInstanceWithCapacityFallback retries launching an instance which failed because there was not enough capacity
+// for its instance type or it isn't supported in its availability zone, first in the subnets of the other
+// availability zones, then with each of the fallback instance types.
+// A subnet or failure domain set on the machine is a hard constraint, only the instance type is changed then.
+func (s *Service) runInstanceWithCapacityFallback(scope *scope.MachineScope, input *infrav1.Instance, cpuOptions map[string]*infrav1.CPUOptions, launchErr error) (*infrav1.Instance, error) {
+	subnetIDs := []string{input.SubnetID}
+	pinned := scope.Machine.Spec.FailureDomain != nil ||
+		(scope.AWSMachine.Spec.Subnet != nil && (scope.AWSMachine.Spec.Subnet.ID != nil || scope.AWSMachine.Spec.Subnet.Filters != nil))
+	if !pinned && len(input.NetworkInterfaces) == 0 {
+		subnetIDs = append(subnetIDs, s.capacityFallbackSubnets(scope, input.SubnetID)...)
+	}
+	instanceTypes := append([]string{input.Type}, scope.AWSMachine.Spec.FallbackInstanceTypes...)
+
+	preferredSubnetID, preferredType := input.SubnetID, input.Type
+	reason, cause := infrav1.InsufficientInstanceCapacityReason, fmt.Sprintf("of insufficient capacity in subnet %q for instance type %q", preferredSubnetID, preferredType)
+	if awserrors.IsUnsupported(errors.Cause(launchErr)) {
+		reason, cause = infrav1.InstanceTypeUnsupportedReason, fmt.Sprintf("instance type %q is not supported in the availability zone of subnet %q", preferredType, preferredSubnetID)
+	}
+
+	for _, instanceType := range instanceTypes {
+		for _, subnetID := range subnetIDs {
+			if instanceType == preferredType && subnetID == preferredSubnetID {
+				continue
+			}
+
+			s.scope.Debug("Retrying to run instance with another subnet or instance type", "subnet-id", subnetID, "instance-type", instanceType, "reason", launchErr.Error())
+			input.SubnetID, input.Type, input.CPUOptions = subnetID, instanceType, cpuOptions[instanceType]
+			out, err := s.runInstance(scope.Role(), input)
+			if err == nil {
+				msg := fmt.Sprintf("Instance launched in subnet %q with instance type %q because %s", subnetID, instanceType, cause)
+				record.Warnf(scope.AWSMachine, reason, "%s", msg)
+				conditions.MarkFalse(scope.AWSMachine, infrav1.InstancePlacementCondition, reason, clusterv1.ConditionSeverityInfo, "%s", msg)
+				return out, nil
+			}
+			if !isInstanceTypeUnavailable(err) {
+				return nil, err
+			}
+		}
+	}
+
+	return nil, launchErr
+}
+
+// checkUserDataSize returns an error and marks the InstanceReady condition of the AWSMachine false when the user
+// data is larger than EC2 accepts and isn't offloaded to AWS Secrets Manager or S3, rather than letting
+// RunInstances fail with an error that doesn't tell the user how to fix it.
+func checkUserDataSize(scope *scope.MachineScope, userData []byte, userDataFormat string) error {
+	if len(userData) <= MaxUserDataSize || scope.UseSecretsManager(userDataFormat) || scope.UseIgnition(userDataFormat) {
+		return nil
+	}
+
+	msg := fmt.Sprintf("user data is %d bytes, which exceeds the EC2 limit of %d bytes: enable offloading of the user data "+
+		"to AWS Secrets Manager by setting spec.cloudInit.insecureSkipSecretsManager to false",
+		len(userData), MaxUserDataSize)
+	record.Warnf(scope.AWSMachine, infrav1.UserDataTooLargeReason, "%s", msg)
+	conditions.MarkFalse(scope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.UserDataTooLargeReason, clusterv1.ConditionSeverityError, msg)
+
+	return errors.New(msg)
+}
+
+// capacityFallbackSubnets returns a subnet of the cluster for each availability zone other than the one
+// of the given subnet, which matches the public IP setting of the machine.
+func (s *Service) capacityFallbackSubnets(scope *scope.MachineScope, subnetID string) []string {
+	subnets := s.scope.Subnets().FilterPrivate()
+	if scope.AWSMachine.Spec.PublicIP != nil && *scope.AWSMachine.Spec.PublicIP {
+		subnets = s.scope.Subnets().FilterPublic()
+	}
+
+	var zone string
+	if subnet := s.scope.Subnets().FindByID(subnetID); subnet != nil {
+		zone = subnet.AvailabilityZone
+	}
+
+	var subnetIDs []string
+	zones := map[string]bool{zone: true}
+	for _, subnet := range subnets {
+		if subnet.GetResourceID() == subnetID || zones[subnet.AvailabilityZone] {
+			continue
+		}
+		zones[subnet.AvailabilityZone] = true
+		subnetIDs = append(subnetIDs, subnet.GetResourceID())
+	}
+	return subnetIDs
+}
+
 // findSubnet attempts to retrieve a subnet ID in the following order:
 // - subnetID specified in machine configuration,
 // - subnet based on filters in machine configuration
@@ -404,6 +565,7 @@ func (s *Service) getFilteredSubnets(criteria ...*ec2.Filter) ([]*ec2.Subnet, er
 
 // GetCoreSecurityGroups looks up the security group IDs managed by this actuator
 // They are considered "core" to its proper functioning.
+// The order is deterministic, starting with the security group of the role of the machine.
 func (s *Service) GetCoreSecurityGroups(scope *scope.MachineScope) ([]string, error) {
 	if scope.IsExternallyManaged() {
 		ids := make([]string, 0)
@@ -416,26 +578,24 @@ func (s *Service) GetCoreSecurityGroups(scope *scope.MachineScope) ([]string, er
 		return ids, nil
 	}
 
-	// These are common across both controlplane and node machines
-	sgRoles := []infrav1.SecurityGroupRole{
-		infrav1.SecurityGroupNode,
-	}
-
-	if !scope.IsEKSManaged() {
-		sgRoles = append(sgRoles, infrav1.SecurityGroupLB)
-	}
-
+	// The security group of the role of the machine comes first, as it is the primary one
+	var sgRoles []infrav1.SecurityGroupRole
 	switch scope.Role() {
 	case "node":
-		// Just the common security groups above
-		if scope.IsEKSManaged() {
-			sgRoles = append(sgRoles, infrav1.SecurityGroupEKSNodeAdditional)
-		}
+		sgRoles = append(sgRoles, infrav1.SecurityGroupNode)
 	case "control-plane":
-		sgRoles = append(sgRoles, infrav1.SecurityGroupControlPlane)
+		sgRoles = append(sgRoles, infrav1.SecurityGroupControlPlane, infrav1.SecurityGroupNode)
 	default:
 		return nil, errors.Errorf("Unknown node role %q", scope.Role())
 	}
+
+	// These are common across both controlplane and node machines
+	if !scope.IsEKSManaged() {
+		sgRoles = append(sgRoles, infrav1.SecurityGroupLB)
+	} else if scope.Role() == "node" {
+		sgRoles = append(sgRoles, infrav1.SecurityGroupEKSNodeAdditional)
+	}
+
 	ids := make([]string, 0, len(sgRoles))
 	for _, sg := range sgRoles {
 		if _, ok := s.scope.SecurityGroups()[sg]; !ok {
@@ -542,8 +702,15 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	}
 
 	if i.IAMProfile != "" {
-		input.IamInstanceProfile = &ec2.IamInstanceProfileSpecification{
-			Name: aws.String(i.IAMProfile),
+		// Instance profiles of other accounts can only be referenced by their ARN.
+		if arn.IsARN(i.IAMProfile) {
+			input.IamInstanceProfile = &ec2.IamInstanceProfileSpecification{
+				Arn: aws.String(i.IAMProfile),
+			}
+		} else {
+			input.IamInstanceProfile = &ec2.IamInstanceProfileSpecification{
+				Name: aws.String(i.IAMProfile),
+			}
 		}
 	}
 
@@ -556,7 +723,7 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		}
 
 		i.RootVolume.DeviceName = aws.StringValue(rootDeviceName)
-		blockDeviceMapping := volumeToBlockDeviceMapping(i.RootVolume)
+		blockDeviceMapping := volumeToBlockDeviceMapping(i.RootVolume, s.scope.EBSEncryptionKeyARN())
 		blockdeviceMappings = append(blockdeviceMappings, blockDeviceMapping)
 	}
 
@@ -567,7 +734,7 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 			return nil, errors.Errorf("non root volume should have device name specified")
 		}
 
-		blockDeviceMapping := volumeToBlockDeviceMapping(&nonRootVolume)
+		blockDeviceMapping := volumeToBlockDeviceMapping(&nonRootVolume, s.scope.EBSEncryptionKeyARN())
 		blockdeviceMappings = append(blockdeviceMappings, blockDeviceMapping)
 	}
 
@@ -576,21 +743,32 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	}
 
 	if len(i.Tags) > 0 {
-		spec := &ec2.TagSpecification{ResourceType: aws.String(ec2.ResourceTypeInstance)}
 		// We need to sort keys for tests to work
 		keys := make([]string, 0, len(i.Tags))
 		for k := range i.Tags {
 			keys = append(keys, k)
 		}
 		sort.Strings(keys)
-		for _, key := range keys {
-			spec.Tags = append(spec.Tags, &ec2.Tag{
-				Key:   aws.String(key),
-				Value: aws.String(i.Tags[key]),
-			})
+
+		// Tag the volumes along with the instance, so they are never left untagged.
+		resourceTypes := []string{ec2.ResourceTypeInstance, ec2.ResourceTypeVolume}
+		// The network interfaces are only created by the launch when no existing ones are attached,
+		// existing network interfaces are tagged once the instance is created.
+		if len(i.NetworkInterfaces) == 0 {
+			resourceTypes = append(resourceTypes, ec2.ResourceTypeNetworkInterface)
 		}
 
-		input.TagSpecifications = append(input.TagSpecifications, spec)
+		for _, resourceType := range resourceTypes {
+			spec := &ec2.TagSpecification{ResourceType: aws.String(resourceType)}
+			for _, key := range keys {
+				spec.Tags = append(spec.Tags, &ec2.Tag{
+					Key:   aws.String(key),
+					Value: aws.String(i.Tags[key]),
+				})
+			}
+
+			input.TagSpecifications = append(input.TagSpecifications, spec)
+		}
 	}
 
 	input.InstanceMarketOptions = getInstanceMarketOptionsRequest(i.SpotMarketOptions)
@@ -609,6 +787,31 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		input.Placement.GroupName = &i.PlacementGroupName
 	}
 
+	if i.CPUOptions != nil {
+		input.CpuOptions = &ec2.CpuOptionsRequest{
+			CoreCount:      i.CPUOptions.CoreCount,
+			ThreadsPerCore: i.CPUOptions.ThreadsPerCore,
+		}
+	}
+
+	if aws.BoolValue(i.EnclaveOptions) {
+		input.EnclaveOptions = &ec2.EnclaveOptionsRequest{
+			Enabled: aws.Bool(true),
+		}
+	}
+
+	if aws.BoolValue(i.DisableAPITermination) {
+		input.DisableApiTermination = aws.Bool(true)
+	}
+
+	input.PrivateDnsNameOptions = getPrivateDNSNameOptionsRequest(i.PrivateDNSName)
+
+	if i.MaintenanceOptions != nil && i.MaintenanceOptions.AutoRecovery != "" {
+		input.MaintenanceOptions = &ec2.InstanceMaintenanceOptionsRequest{
+			AutoRecovery: aws.String(i.MaintenanceOptions.AutoRecovery),
+		}
+	}
+
 	out, err := s.EC2Client.RunInstancesWithContext(context.TODO(), input)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to run instance")
@@ -621,7 +824,9 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	return s.SDKToInstance(out.Instances[0])
 }
 
-func volumeToBlockDeviceMapping(v *infrav1.Volume) *ec2.BlockDeviceMapping {
+// volumeToBlockDeviceMapping returns the block device mapping of a volume. Encrypted volumes which don't set their
+// own encryption key are encrypted with the default encryption key, if any.
+func volumeToBlockDeviceMapping(v *infrav1.Volume, defaultEncryptionKey string) *ec2.BlockDeviceMapping {
 	ebsDevice := &ec2.EbsBlockDevice{
 		DeleteOnTermination: aws.Bool(true),
 		VolumeSize:          aws.Int64(v.Size),
@@ -636,9 +841,9 @@ func volumeToBlockDeviceMapping(v *infrav1.Volume) *ec2.BlockDeviceMapping {
 		ebsDevice.Iops = aws.Int64(v.IOPS)
 	}
 
-	if v.EncryptionKey != "" {
+	if encryptionKey := volumeEncryptionKey(v, defaultEncryptionKey); encryptionKey != "" {
 		ebsDevice.Encrypted = aws.Bool(true)
-		ebsDevice.KmsKeyId = aws.String(v.EncryptionKey)
+		ebsDevice.KmsKeyId = aws.String(encryptionKey)
 	}
 
 	if v.Type != "" {
@@ -651,6 +856,18 @@ func volumeToBlockDeviceMapping(v *infrav1.Volume) *ec2.BlockDeviceMapping {
 	}
 }
 
+// volumeEncryptionKey returns the encryption key of a volume, or the default encryption key if the volume is
+// encrypted without setting its own key.
+func volumeEncryptionKey(v *infrav1.Volume, defaultEncryptionKey string) string {
+	if v.EncryptionKey != "" {
+		return v.EncryptionKey
+	}
+	if aws.BoolValue(v.Encrypted) {
+		return defaultEncryptionKey
+	}
+	return ""
+}
+
 // GetInstanceSecurityGroups returns a map from ENI id to the security groups applied to that ENI
 // While some security group operations take place at the "instance" level, these are in fact an API convenience for manipulating the first ("primary") ENI's properties.
 func (s *Service) GetInstanceSecurityGroups(instanceID string) (map[string][]string, error) {
@@ -865,9 +1082,166 @@ func (s *Service) SDKToInstance(v *ec2.Instance) (*infrav1.Instance, error) {
 		i.InstanceMetadataOptions = metadataOptions
 	}
 
+	if v.CpuOptions != nil {
+		i.CPUOptions = &infrav1.CPUOptions{
+			CoreCount:      v.CpuOptions.CoreCount,
+			ThreadsPerCore: v.CpuOptions.ThreadsPerCore,
+		}
+	}
+
+	if v.EnclaveOptions != nil && aws.BoolValue(v.EnclaveOptions.Enabled) {
+		i.EnclaveOptions = aws.Bool(true)
+	}
+
+	if v.PrivateDnsNameOptions != nil {
+		i.PrivateDNSName = &infrav1.PrivateDNSName{
+			EnableResourceNameDNSARecord:    v.PrivateDnsNameOptions.EnableResourceNameDnsARecord,
+			EnableResourceNameDNSAAAARecord: v.PrivateDnsNameOptions.EnableResourceNameDnsAAAARecord,
+		}
+		if v.PrivateDnsNameOptions.HostnameType != nil {
+			hostnameType := infrav1.HostnameType(*v.PrivateDnsNameOptions.HostnameType)
+			i.PrivateDNSName.HostnameType = &hostnameType
+		}
+	}
+
+	if v.MaintenanceOptions != nil && v.MaintenanceOptions.AutoRecovery != nil {
+		i.MaintenanceOptions = &infrav1.MaintenanceOptions{
+			AutoRecovery: *v.MaintenanceOptions.AutoRecovery,
+		}
+	}
+
+	i.SourceDestCheck = v.SourceDestCheck
+
 	return i, nil
 }
 
+// resolveCPUOptions validates the requested CPU options against the ones supported by the instance type
+// and fills in the values which were omitted with the defaults of the instance type.
+func (s *Service) resolveCPUOptions(instanceType string, options *infrav1.CPUOptions) (*infrav1.CPUOptions, error) {
+	info, err := s.describeInstanceType(instanceType)
+	if err != nil {
+		// If the instance type can't be described due to a permissions error, leave the validation to RunInstances.
+		if awserrors.IsPermissionsError(err) {
+			record.Warnf(s.scope.InfraCluster(), "FailedDescribeInstanceTypes", "insufficient permissions to describe instance type %q, skipping validation of CPU options: %v", instanceType, err)
+			return options.DeepCopy(), nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+
+	if info == nil || info.VCpuInfo == nil {
+		return nil, fmt.Errorf("instance type result empty for type %q", instanceType)
+	}
+	vcpuInfo := info.VCpuInfo
+
+	if len(vcpuInfo.ValidCores) == 0 || len(vcpuInfo.ValidThreadsPerCore) == 0 {
+		return nil, errors.Wrapf(errUnsupportedCPUOptions, "instance type %q does not support CPU options", instanceType)
+	}
+
+	resolved := &infrav1.CPUOptions{
+		CoreCount:      vcpuInfo.DefaultCores,
+		ThreadsPerCore: vcpuInfo.DefaultThreadsPerCore,
+	}
+	if options.CoreCount != nil {
+		if !containsInt64(vcpuInfo.ValidCores, *options.CoreCount) {
+			return nil, errors.Wrapf(errUnsupportedCPUOptions, "core count %d is not supported by instance type %q, valid values are %v",
+				*options.CoreCount, instanceType, aws.Int64ValueSlice(vcpuInfo.ValidCores))
+		}
+		resolved.CoreCount = options.CoreCount
+	}
+	if options.ThreadsPerCore != nil {
+		if !containsInt64(vcpuInfo.ValidThreadsPerCore, *options.ThreadsPerCore) {
+			return nil, errors.Wrapf(errUnsupportedCPUOptions, "threads per core %d is not supported by instance type %q, valid values are %v",
+				*options.ThreadsPerCore, instanceType, aws.Int64ValueSlice(vcpuInfo.ValidThreadsPerCore))
+		}
+		resolved.ThreadsPerCore = options.ThreadsPerCore
+	}
+
+	return resolved, nil
+}
+
+// validateEnclaveSupport checks that the instance type supports AWS Nitro Enclaves.
+func (s *Service) validateEnclaveSupport(instanceType string) error {
+	info, err := s.describeInstanceType(instanceType)
+	if err != nil {
+		// If the instance type can't be described due to a permissions error, leave the validation to RunInstances.
+		if awserrors.IsPermissionsError(err) {
+			record.Warnf(s.scope.InfraCluster(), "FailedDescribeInstanceTypes", "insufficient permissions to describe instance type %q, skipping validation of enclave options: %v", instanceType, err)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+
+	if info == nil {
+		return fmt.Errorf("instance type result empty for type %q", instanceType)
+	}
+
+	if aws.StringValue(info.NitroEnclavesSupport) != ec2.NitroEnclavesSupportSupported {
+		return errors.Wrapf(errUnsupportedEnclaveOptions, "instance type %q does not support Nitro Enclaves", instanceType)
+	}
+
+	return nil
+}
+
+// ValidateGPUInstanceType checks that the instance type has GPUs, for the machines and machine pools labeled as
+// requiring them.
+func (s *Service) ValidateGPUInstanceType(instanceType string) error {
+	info, err := s.describeInstanceType(instanceType)
+	if err != nil {
+		// If the instance type can't be described due to a permissions error, the validation can't be done.
+		if awserrors.IsPermissionsError(err) {
+			record.Warnf(s.scope.InfraCluster(), "FailedDescribeInstanceTypes", "insufficient permissions to describe instance type %q, skipping validation of GPUs: %v", instanceType, err)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+
+	if info == nil {
+		return fmt.Errorf("instance type result empty for type %q", instanceType)
+	}
+
+	if info.GpuInfo == nil || len(info.GpuInfo.Gpus) == 0 {
+		return errors.Wrapf(errMissingGPUs, "instance type %q has no GPU but the %q label requires GPUs, select an instance type with GPUs or remove the label",
+			instanceType, infrav1.GPURequiredLabel)
+	}
+
+	return nil
+}
+
+// describeInstanceType returns the information of the instance type, or nil if it wasn't found. The instance types
+// are only described once per service, so the validations of a reconciliation don't each call DescribeInstanceTypes.
+func (s *Service) describeInstanceType(instanceType string) (*ec2.InstanceTypeInfo, error) {
+	if info, ok := s.instanceTypes[instanceType]; ok {
+		return info, nil
+	}
+
+	out, err := s.EC2Client.DescribeInstanceTypesWithContext(context.TODO(), &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: []*string{aws.String(instanceType)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var info *ec2.InstanceTypeInfo
+	if len(out.InstanceTypes) > 0 {
+		info = out.InstanceTypes[0]
+	}
+	if s.instanceTypes == nil {
+		s.instanceTypes = map[string]*ec2.InstanceTypeInfo{}
+	}
+	s.instanceTypes[instanceType] = info
+
+	return info, nil
+}
+
+func containsInt64(values []*int64, value int64) bool {
+	for _, v := range values {
+		if aws.Int64Value(v) == value {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Service) getInstanceAddresses(instance *ec2.Instance) []clusterv1.MachineAddress {
 	addresses := []clusterv1.MachineAddress{}
 	for _, eni := range instance.NetworkInterfaces {
@@ -992,6 +1366,70 @@ func (s *Service) ModifyInstanceMetadataOptions(instanceID string, options *infr
 	return nil
 }
 
+// DisableInstanceDeletionProtection removes the termination protection of an instance, so that it can be terminated.
+func (s *Service) DisableInstanceDeletionProtection(instanceID string) error {
+	input := &ec2.ModifyInstanceAttributeInput{
+		InstanceId:            aws.String(instanceID),
+		DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+	}
+
+	s.scope.Info("Disabling instance deletion protection", "instance id", instanceID)
+	if _, err := s.EC2Client.ModifyInstanceAttributeWithContext(context.TODO(), input); err != nil {
+		return errors.Wrapf(err, "failed to disable deletion protection of instance %q", instanceID)
+	}
+
+	return nil
+}
+
+// ModifyInstanceSourceDestCheck enables or disables the source/destination check of an instance.
+func (s *Service) ModifyInstanceSourceDestCheck(instanceID string, enabled bool) error {
+	input := &ec2.ModifyInstanceAttributeInput{
+		InstanceId:      aws.String(instanceID),
+		SourceDestCheck: &ec2.AttributeBooleanValue{Value: aws.Bool(enabled)},
+	}
+
+	s.scope.Info("Updating instance source/destination check", "instance id", instanceID, "enabled", enabled)
+	if _, err := s.EC2Client.ModifyInstanceAttributeWithContext(context.TODO(), input); err != nil {
+		return errors.Wrapf(err, "failed to modify source/destination check of instance %q", instanceID)
+	}
+
+	return nil
+}
+
+// GetConsoleDiagnostics returns the serial console output and a screenshot of the console of an instance. The screenshot
+// is nil for instance types that don't support it.
+func (s *Service) GetConsoleDiagnostics(instanceID string) (output []byte, screenshot []byte, err error) {
+	s.scope.Debug("Getting instance console diagnostics", "instance-id", instanceID)
+
+	outputOut, err := s.EC2Client.GetConsoleOutputWithContext(context.TODO(), &ec2.GetConsoleOutputInput{
+		InstanceId: aws.String(instanceID),
+		Latest:     aws.Bool(true),
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get console output of instance %q", instanceID)
+	}
+	if output, err = base64.StdEncoding.DecodeString(aws.StringValue(outputOut.Output)); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to decode console output of instance %q", instanceID)
+	}
+
+	screenshotOut, err := s.EC2Client.GetConsoleScreenshotWithContext(context.TODO(), &ec2.GetConsoleScreenshotInput{
+		InstanceId: aws.String(instanceID),
+		WakeUp:     aws.Bool(true),
+	})
+	if err != nil {
+		if code, _ := awserrors.Code(err); code == awserrors.UnsupportedOperation {
+			s.scope.Debug("Instance doesn't support console screenshots", "instance-id", instanceID)
+			return output, nil, nil
+		}
+		return nil, nil, errors.Wrapf(err, "failed to get console screenshot of instance %q", instanceID)
+	}
+	if screenshot, err = base64.StdEncoding.DecodeString(aws.StringValue(screenshotOut.ImageData)); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to decode console screenshot of instance %q", instanceID)
+	}
+
+	return output, screenshot, nil
+}
+
 // filterGroups filters a list for a string.
 func filterGroups(list []string, strToFilter string) (newList []string) {
 	for _, item := range list {
@@ -1032,6 +1470,22 @@ func getInstanceMarketOptionsRequest(spotMarketOptions *infrav1.SpotMarketOption
 	return instanceMarketOptionsRequest
 }
 
+func getPrivateDNSNameOptionsRequest(privateDNSName *infrav1.PrivateDNSName) *ec2.PrivateDnsNameOptionsRequest {
+	if privateDNSName == nil {
+		return nil
+	}
+
+	request := &ec2.PrivateDnsNameOptionsRequest{
+		EnableResourceNameDnsARecord:    privateDNSName.EnableResourceNameDNSARecord,
+		EnableResourceNameDnsAAAARecord: privateDNSName.EnableResourceNameDNSAAAARecord,
+	}
+	if privateDNSName.HostnameType != nil {
+		request.HostnameType = aws.String(string(*privateDNSName.HostnameType))
+	}
+
+	return request
+}
+
 func getInstanceMetadataOptionsRequest(metadataOptions *infrav1.InstanceMetadataOptions) *ec2.InstanceMetadataOptionsRequest {
 	if metadataOptions == nil {
 		return nil
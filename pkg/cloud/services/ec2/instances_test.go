@@ -19,6 +19,8 @@ package ec2
 import (
 	"context"
 	"encoding/base64"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -42,6 +45,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/userdata"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestInstanceIfExists(t *testing.T) {
@@ -273,6 +277,137 @@ func TestTerminateInstance(t *testing.T) {
 	}
 }
 
+func TestDisableInstanceDeletionProtection(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name    string
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "clears the termination protection of the instance",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.ModifyInstanceAttributeWithContext(context.TODO(), gomock.Eq(&ec2.ModifyInstanceAttributeInput{
+					InstanceId:            aws.String("i-exist"),
+					DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+				})).
+					Return(&ec2.ModifyInstanceAttributeOutput{}, nil)
+			},
+		},
+		{
+			name: "returns the error of the modification",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.ModifyInstanceAttributeWithContext(context.TODO(), gomock.Any()).
+					Return(nil, errors.New("unauthorized"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.DisableInstanceDeletionProtection("i-exist")
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestModifyInstanceSourceDestCheck(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name    string
+		enabled bool
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name:    "disables the source/destination check of the instance",
+			enabled: false,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.ModifyInstanceAttributeWithContext(context.TODO(), gomock.Eq(&ec2.ModifyInstanceAttributeInput{
+					InstanceId:      aws.String("i-exist"),
+					SourceDestCheck: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+				})).
+					Return(&ec2.ModifyInstanceAttributeOutput{}, nil)
+			},
+		},
+		{
+			name:    "enables the source/destination check of the instance",
+			enabled: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.ModifyInstanceAttributeWithContext(context.TODO(), gomock.Eq(&ec2.ModifyInstanceAttributeInput{
+					InstanceId:      aws.String("i-exist"),
+					SourceDestCheck: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+				})).
+					Return(&ec2.ModifyInstanceAttributeOutput{}, nil)
+			},
+		},
+		{
+			name: "returns the error of the modification",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.ModifyInstanceAttributeWithContext(context.TODO(), gomock.Any()).
+					Return(nil, errors.New("unauthorized"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.ModifyInstanceSourceDestCheck("i-exist", tc.enabled)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
 func TestCreateInstance(t *testing.T) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -698,6 +833,155 @@ func TestCreateInstance(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "with an AMI architecture hint",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+					Version: ptr.To[string]("v1.16.1"),
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				ImageLookupOrg: "test-org-123",
+				InstanceType:   "m6g.large",
+				AMI: infrav1.AMIReference{
+					Architecture: "arm64",
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				amiName, err := GenerateAmiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "1.16.1")
+				if err != nil {
+					t.Fatalf("Failed to process ami format: %v", err)
+				}
+				// verify that the architecture hint is checked against the instance type and used to look up the image
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{aws.String("m6g.large")},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{aws.String("arm64")},
+								},
+							},
+						},
+					}, nil)
+				m.
+					DescribeImagesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeImagesInput{
+						Filters: []*ec2.Filter{
+							{
+								Name:   aws.String("owner-id"),
+								Values: []*string{aws.String("test-org-123")},
+							},
+							{
+								Name:   aws.String("name"),
+								Values: []*string{aws.String(amiName)},
+							},
+							{
+								Name:   aws.String("architecture"),
+								Values: []*string{aws.String("arm64")},
+							},
+							{
+								Name:   aws.String("state"),
+								Values: []*string{aws.String("available")},
+							},
+							{
+								Name:   aws.String("virtualization-type"),
+								Values: []*string{aws.String("hvm")},
+							},
+						},
+					})).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name:         aws.String("ami-1"),
+								CreationDate: aws.String("2006-01-02T15:04:05.000Z"),
+							},
+						},
+					}, nil)
+				m. // TODO: Restore these parameters, but with the tags as well
+					RunInstancesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.
+					DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
 		{
 			name: "with ImageLookupOrg specified at the cluster-level",
 			machine: &clusterv1.Machine{
@@ -2439,35 +2723,85 @@ func TestCreateInstance(t *testing.T) {
 									},
 								},
 							},
-						},
-						UserData: aws.String(base64.StdEncoding.EncodeToString(userDataCompressed)),
-					})).
-					Return(&ec2.Reservation{
-						Instances: []*ec2.Instance{
 							{
-								State: &ec2.InstanceState{
-									Name: aws.String(ec2.InstanceStateNamePending),
-								},
-								IamInstanceProfile: &ec2.IamInstanceProfile{
-									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
-								},
-								InstanceId:     aws.String("two"),
-								InstanceType:   aws.String("m5.large"),
-								SubnetId:       aws.String("subnet-1"),
-								ImageId:        aws.String("ami-1"),
-								RootDeviceName: aws.String("device-1"),
-								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+								ResourceType: aws.String("volume"),
+								Tags: []*ec2.Tag{
 									{
-										DeviceName: aws.String("device-1"),
-										Ebs: &ec2.EbsInstanceBlockDevice{
-											VolumeId: aws.String("volume-1"),
-										},
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
 									},
-								},
-								Placement: &ec2.Placement{
-									AvailabilityZone: &az,
-									Tenancy:          &tenancy,
-								},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
+							{
+								ResourceType: aws.String("network-interface"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
+						},
+						UserData: aws.String(base64.StdEncoding.EncodeToString(userDataCompressed)),
+					})).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+									Tenancy:          &tenancy,
+								},
 							},
 						},
 					}, nil)
@@ -2595,6 +2929,56 @@ func TestCreateInstance(t *testing.T) {
 									},
 								},
 							},
+							{
+								ResourceType: aws.String("volume"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
+							{
+								ResourceType: aws.String("network-interface"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
 						},
 						UserData: aws.String(base64.StdEncoding.EncodeToString(userDataCompressed)),
 					})).
@@ -2772,6 +3156,56 @@ func TestCreateInstance(t *testing.T) {
 									},
 								},
 							},
+							{
+								ResourceType: aws.String("volume"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
+							{
+								ResourceType: aws.String("network-interface"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
 						},
 						UserData: aws.String(base64.StdEncoding.EncodeToString(data)),
 					})).
@@ -3591,78 +4025,1739 @@ func TestCreateInstance(t *testing.T) {
 				}
 			},
 		},
-	}
-
-	for _, tc := range testcases {
-		t.Run(tc.name, func(t *testing.T) {
-			mockCtrl := gomock.NewController(t)
-			ec2Mock := mocks.NewMockEC2API(mockCtrl)
-
-			scheme, err := setupScheme()
-			if err != nil {
-				t.Fatalf("failed to create scheme: %v", err)
-			}
-
-			cluster := &clusterv1.Cluster{
+		{
+			name: "with hyperthreading disabled",
+			machine: &clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "test1",
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
 				},
-				Spec: clusterv1.ClusterSpec{
-					ClusterNetwork: &clusterv1.ClusterNetwork{
-						ServiceDomain: "cluster.local",
-						Services: &clusterv1.NetworkRanges{
-							CIDRBlocks: []string{"192.168.0.0/16"},
-						},
-						Pods: &clusterv1.NetworkRanges{
-							CIDRBlocks: []string{"192.168.0.0/16"},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.large",
+				CPUOptions: &infrav1.CPUOptions{
+					ThreadsPerCore: aws.Int64(1),
+				},
+				UncompressedUserData: &isUncompressedFalse,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
 						},
 					},
 				},
-			}
-
-			machine := tc.machine
-
-			awsMachine := &infrav1.AWSMachine{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "aws-test1",
-					OwnerReferences: []metav1.OwnerReference{
-						{
-							APIVersion: clusterv1.GroupVersion.String(),
-							Kind:       "Machine",
-							Name:       "test1",
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
 						},
 					},
 				},
-			}
-
-			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret, cluster, machine).Build()
-			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
-				Client:     client,
-				Cluster:    cluster,
-				AWSCluster: tc.awsCluster,
-			})
-			if err != nil {
-				t.Fatalf("Failed to create test context: %v", err)
-			}
-
-			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+								VCpuInfo: &ec2.VCpuInfo{
+									DefaultCores:          aws.Int64(1),
+									DefaultThreadsPerCore: aws.Int64(2),
+									ValidCores:            []*int64{aws.Int64(1)},
+									ValidThreadsPerCore:   []*int64{aws.Int64(1), aws.Int64(2)},
+								},
+							},
+						},
+					}, nil)
+				m.
+					RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+						expected := &ec2.CpuOptionsRequest{
+							CoreCount:      aws.Int64(1),
+							ThreadsPerCore: aws.Int64(1),
+						}
+						if !cmp.Equal(input.CpuOptions, expected) {
+							t.Fatalf("expected CPU options %v, got %v", expected, input.CpuOptions)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									InstanceId:   aws.String("two"),
+									InstanceType: aws.String("m5.large"),
+									SubnetId:     aws.String("subnet-1"),
+									ImageId:      aws.String("abc"),
+									CpuOptions: &ec2.CpuOptions{
+										CoreCount:      aws.Int64(1),
+										ThreadsPerCore: aws.Int64(1),
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.
+					DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if instance.CPUOptions == nil || aws.Int64Value(instance.CPUOptions.ThreadsPerCore) != 1 {
+					t.Fatalf("expected instance to have 1 thread per core, got %v", instance.CPUOptions)
+				}
+			},
+		},
+		{
+			name: "with CPU options unsupported by the instance type",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.large",
+				CPUOptions: &infrav1.CPUOptions{
+					CoreCount:      aws.Int64(4),
+					ThreadsPerCore: aws.Int64(1),
+				},
+				UncompressedUserData: &isUncompressedFalse,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+								VCpuInfo: &ec2.VCpuInfo{
+									DefaultCores:          aws.Int64(1),
+									DefaultThreadsPerCore: aws.Int64(2),
+									ValidCores:            []*int64{aws.Int64(1)},
+									ValidThreadsPerCore:   []*int64{aws.Int64(1), aws.Int64(2)},
+								},
+							},
+						},
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err == nil {
+					t.Fatalf("expected an error for unsupported CPU options")
+				}
+				if !errors.Is(err, errUnsupportedCPUOptions) {
+					t.Fatalf("expected an unsupported CPU options error, got: %v", err)
+				}
+			},
+		},
+		{
+			name: "with private DNS name options",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.large",
+				PrivateDNSName: &infrav1.PrivateDNSName{
+					HostnameType:                    ptr.To[infrav1.HostnameType](infrav1.HostnameTypeResourceName),
+					EnableResourceNameDNSARecord:    aws.Bool(true),
+					EnableResourceNameDNSAAAARecord: aws.Bool(false),
+				},
+				UncompressedUserData: &isUncompressedFalse,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.
+					RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+						expected := &ec2.PrivateDnsNameOptionsRequest{
+							HostnameType:                    aws.String("resource-name"),
+							EnableResourceNameDnsARecord:    aws.Bool(true),
+							EnableResourceNameDnsAAAARecord: aws.Bool(false),
+						}
+						if !cmp.Equal(input.PrivateDnsNameOptions, expected) {
+							t.Fatalf("expected private DNS name options %v, got %v", expected, input.PrivateDnsNameOptions)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									InstanceId:   aws.String("two"),
+									InstanceType: aws.String("m5.large"),
+									SubnetId:     aws.String("subnet-1"),
+									ImageId:      aws.String("abc"),
+									PrivateDnsNameOptions: &ec2.PrivateDnsNameOptionsResponse{
+										HostnameType:                    aws.String("resource-name"),
+										EnableResourceNameDnsARecord:    aws.Bool(true),
+										EnableResourceNameDnsAAAARecord: aws.Bool(false),
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.
+					DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if instance.PrivateDNSName == nil || aws.StringValue((*string)(instance.PrivateDNSName.HostnameType)) != "resource-name" {
+					t.Fatalf("expected instance to have resource-name hostname type, got %v", instance.PrivateDNSName)
+				}
+			},
+		},
+		{
+			name: "with Nitro Enclaves enabled",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:         "m5.large",
+				EnclaveOptions:       aws.Bool(true),
+				UncompressedUserData: &isUncompressedFalse,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+								NitroEnclavesSupport: aws.String(ec2.NitroEnclavesSupportSupported),
+							},
+						},
+					}, nil)
+				m.
+					RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+						expected := &ec2.EnclaveOptionsRequest{
+							Enabled: aws.Bool(true),
+						}
+						if !cmp.Equal(input.EnclaveOptions, expected) {
+							t.Fatalf("expected enclave options %v, got %v", expected, input.EnclaveOptions)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									InstanceId:   aws.String("two"),
+									InstanceType: aws.String("m5.large"),
+									SubnetId:     aws.String("subnet-1"),
+									ImageId:      aws.String("abc"),
+									EnclaveOptions: &ec2.EnclaveOptions{
+										Enabled: aws.Bool(true),
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.
+					DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if !aws.BoolValue(instance.EnclaveOptions) {
+					t.Fatalf("expected instance to have Nitro Enclaves enabled")
+				}
+			},
+		},
+		{
+			name: "with deletion protection on a control plane machine",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:             "m5.large",
+				EnableDeletionProtection: aws.Bool(true),
+				UncompressedUserData:     &isUncompressedFalse,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.
+					RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+						if !cmp.Equal(input.DisableApiTermination, aws.Bool(true)) {
+							t.Fatalf("expected disable API termination %v, got %v", aws.Bool(true), input.DisableApiTermination)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									InstanceId:   aws.String("two"),
+									InstanceType: aws.String("m5.large"),
+									SubnetId:     aws.String("subnet-1"),
+									ImageId:      aws.String("abc"),
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.
+					DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with deletion protection on a worker machine, ignores it",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:             "m5.large",
+				EnableDeletionProtection: aws.Bool(true),
+				UncompressedUserData:     &isUncompressedFalse,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.
+					RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+						if !cmp.Equal(input.DisableApiTermination, (*bool)(nil)) {
+							t.Fatalf("expected disable API termination %v, got %v", (*bool)(nil), input.DisableApiTermination)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									InstanceId:   aws.String("two"),
+									InstanceType: aws.String("m5.large"),
+									SubnetId:     aws.String("subnet-1"),
+									ImageId:      aws.String("abc"),
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.
+					DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with auto recovery set to default",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.large",
+				MaintenanceOptions: &infrav1.MaintenanceOptions{
+					AutoRecovery: infrav1.AutoRecoveryDefault,
+				},
+				UncompressedUserData: &isUncompressedFalse,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.
+					RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+						expected := &ec2.InstanceMaintenanceOptionsRequest{
+							AutoRecovery: aws.String(infrav1.AutoRecoveryDefault),
+						}
+						if !cmp.Equal(input.MaintenanceOptions, expected) {
+							t.Fatalf("expected maintenance options %v, got %v", expected, input.MaintenanceOptions)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									InstanceId:   aws.String("two"),
+									InstanceType: aws.String("m5.large"),
+									SubnetId:     aws.String("subnet-1"),
+									ImageId:      aws.String("abc"),
+									MaintenanceOptions: &ec2.InstanceMaintenanceOptions{
+										AutoRecovery: aws.String(infrav1.AutoRecoveryDefault),
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.
+					DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if instance.MaintenanceOptions == nil || instance.MaintenanceOptions.AutoRecovery != infrav1.AutoRecoveryDefault {
+					t.Fatalf("expected instance to have auto recovery %q, got %v", infrav1.AutoRecoveryDefault, instance.MaintenanceOptions)
+				}
+			},
+		},
+		{
+			name: "with auto recovery disabled",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.large",
+				MaintenanceOptions: &infrav1.MaintenanceOptions{
+					AutoRecovery: infrav1.AutoRecoveryDisabled,
+				},
+				UncompressedUserData: &isUncompressedFalse,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.
+					RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+						expected := &ec2.InstanceMaintenanceOptionsRequest{
+							AutoRecovery: aws.String(infrav1.AutoRecoveryDisabled),
+						}
+						if !cmp.Equal(input.MaintenanceOptions, expected) {
+							t.Fatalf("expected maintenance options %v, got %v", expected, input.MaintenanceOptions)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									InstanceId:   aws.String("two"),
+									InstanceType: aws.String("m5.large"),
+									SubnetId:     aws.String("subnet-1"),
+									ImageId:      aws.String("abc"),
+									MaintenanceOptions: &ec2.InstanceMaintenanceOptions{
+										AutoRecovery: aws.String(infrav1.AutoRecoveryDisabled),
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.
+					DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if instance.MaintenanceOptions == nil || instance.MaintenanceOptions.AutoRecovery != infrav1.AutoRecoveryDisabled {
+					t.Fatalf("expected instance to have auto recovery %q, got %v", infrav1.AutoRecoveryDisabled, instance.MaintenanceOptions)
+				}
+			},
+		},
+		{
+			name: "with Nitro Enclaves unsupported by the instance type",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:         "m5.large",
+				EnclaveOptions:       aws.Bool(true),
+				UncompressedUserData: &isUncompressedFalse,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+								NitroEnclavesSupport: aws.String(ec2.NitroEnclavesSupportUnsupported),
+							},
+						},
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err == nil {
+					t.Fatalf("expected an error for unsupported enclave options")
+				}
+				if !errors.Is(err, errUnsupportedEnclaveOptions) {
+					t.Fatalf("expected an unsupported enclave options error, got: %v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			if err != nil {
+				t.Fatalf("failed to create scheme: %v", err)
+			}
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test1",
+				},
+				Spec: clusterv1.ClusterSpec{
+					ClusterNetwork: &clusterv1.ClusterNetwork{
+						ServiceDomain: "cluster.local",
+						Services: &clusterv1.NetworkRanges{
+							CIDRBlocks: []string{"192.168.0.0/16"},
+						},
+						Pods: &clusterv1.NetworkRanges{
+							CIDRBlocks: []string{"192.168.0.0/16"},
+						},
+					},
+				},
+			}
+
+			machine := tc.machine
+
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "aws-test1",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "Machine",
+							Name:       "test1",
+						},
+					},
+				},
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret, cluster, machine).Build()
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    cluster,
+				AWSCluster: tc.awsCluster,
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       client,
+				Cluster:      cluster,
+				Machine:      machine,
+				AWSMachine:   awsMachine,
+				InfraCluster: clusterScope,
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+			machineScope.AWSMachine.Spec = *tc.machineConfig
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			instance, err := s.CreateInstance(machineScope, data, "")
+			tc.check(instance, err)
+		})
+	}
+}
+
+func TestCreateInstanceCapacityFallback(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "bootstrap-data",
+		},
+		Data: map[string][]byte{
+			"value": []byte("data"),
+		},
+	}
+
+	capacityErr := awserr.New(awserrors.InsufficientInstanceCapacity, "insufficient capacity", nil)
+
+	testCases := []struct {
+		name          string
+		machineConfig *infrav1.AWSMachineSpec
+		expect        func(m *mocks.MockEC2APIMockRecorder, launch func(subnetID, instanceType string) (*ec2.Reservation, error))
+		// capacity maps the subnet and instance type pairs with capacity to whether they were launched.
+		capacity       map[string]bool
+		expectedLaunch string
+		expectErr      bool
+	}{
+		{
+			name: "falls back to a subnet in another availability zone",
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI:          infrav1.AMIReference{ID: aws.String("abc")},
+				InstanceType: "m5.large",
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder, launch func(subnetID, instanceType string) (*ec2.Reservation, error)) {
+				m.RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+						return launch(aws.StringValue(input.SubnetId), aws.StringValue(input.InstanceType))
+					}).Times(2)
+			},
+			capacity:       map[string]bool{"subnet-2/m5.large": true},
+			expectedLaunch: "subnet-2/m5.large",
+		},
+		{
+			name: "falls back to another instance type when there is no capacity in any availability zone",
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI:                   infrav1.AMIReference{ID: aws.String("abc")},
+				InstanceType:          "m5.large",
+				FallbackInstanceTypes: []string{"m5a.large"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder, launch func(subnetID, instanceType string) (*ec2.Reservation, error)) {
+				m.RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+						return launch(aws.StringValue(input.SubnetId), aws.StringValue(input.InstanceType))
+					}).Times(3)
+			},
+			capacity:       map[string]bool{"subnet-1/m5a.large": true},
+			expectedLaunch: "subnet-1/m5a.large",
+		},
+		{
+			name: "does not fall back to another subnet when the subnet is set on the machine",
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI:          infrav1.AMIReference{ID: aws.String("abc")},
+				InstanceType: "m5.large",
+				Subnet:       &infrav1.AWSResourceReference{ID: aws.String("subnet-1")},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder, launch func(subnetID, instanceType string) (*ec2.Reservation, error)) {
+				m.DescribeSubnetsWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeSubnetsOutput{
+						Subnets: []*ec2.Subnet{
+							{
+								SubnetId:            aws.String("subnet-1"),
+								AvailabilityZone:    aws.String("us-east-1a"),
+								MapPublicIpOnLaunch: aws.Bool(false),
+							},
+						},
+					}, nil)
+				m.RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+						return launch(aws.StringValue(input.SubnetId), aws.StringValue(input.InstanceType))
+					}).Times(1)
+			},
+			capacity:  map[string]bool{"subnet-2/m5.large": true},
+			expectErr: true,
+		},
+		{
+			name: "keeps the subnet set on the machine when falling back to another instance type",
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI:                   infrav1.AMIReference{ID: aws.String("abc")},
+				InstanceType:          "m5.large",
+				FallbackInstanceTypes: []string{"m5a.large"},
+				Subnet:                &infrav1.AWSResourceReference{ID: aws.String("subnet-1")},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder, launch func(subnetID, instanceType string) (*ec2.Reservation, error)) {
+				m.DescribeSubnetsWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeSubnetsOutput{
+						Subnets: []*ec2.Subnet{
+							{
+								SubnetId:            aws.String("subnet-1"),
+								AvailabilityZone:    aws.String("us-east-1a"),
+								MapPublicIpOnLaunch: aws.Bool(false),
+							},
+						},
+					}, nil)
+				m.RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+						return launch(aws.StringValue(input.SubnetId), aws.StringValue(input.InstanceType))
+					}).Times(2)
+			},
+			capacity:       map[string]bool{"subnet-1/m5a.large": true, "subnet-2/m5.large": true},
+			expectedLaunch: "subnet-1/m5a.large",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).ToNot(HaveOccurred())
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test1",
+				},
+			}
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			}
+			awsCluster := &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+							{ID: "subnet-3", AvailabilityZone: "us-east-1a"},
+							{ID: "subnet-2", AvailabilityZone: "us-east-1b"},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupNode: {ID: "2"},
+							infrav1.SecurityGroupLB:   {ID: "3"},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret, cluster, machine).Build()
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    cluster,
+				AWSCluster: awsCluster,
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
 				Client:       client,
 				Cluster:      cluster,
 				Machine:      machine,
-				AWSMachine:   awsMachine,
+				AWSMachine:   &infrav1.AWSMachine{ObjectMeta: metav1.ObjectMeta{Name: "aws-test1"}},
+				InfraCluster: clusterScope,
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+			machineScope.AWSMachine.Spec = *tc.machineConfig
+
+			launch := func(subnetID, instanceType string) (*ec2.Reservation, error) {
+				if !tc.capacity[subnetID+"/"+instanceType] {
+					return nil, capacityErr
+				}
+				return &ec2.Reservation{
+					Instances: []*ec2.Instance{
+						{
+							InstanceId:   aws.String("i-1"),
+							InstanceType: aws.String(instanceType),
+							SubnetId:     aws.String(subnetID),
+							State: &ec2.InstanceState{
+								Name: aws.String(ec2.InstanceStateNamePending),
+							},
+							Placement: &ec2.Placement{
+								AvailabilityZone: aws.String("us-east-1a"),
+							},
+						},
+					},
+				}, nil
+			}
+
+			ec2Mock.EXPECT().DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+				Return(&ec2.DescribeInstanceTypesOutput{
+					InstanceTypes: []*ec2.InstanceTypeInfo{
+						{
+							ProcessorInfo: &ec2.ProcessorInfo{
+								SupportedArchitectures: []*string{aws.String("x86_64")},
+							},
+						},
+					},
+				}, nil)
+			ec2Mock.EXPECT().DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+				Return(&ec2.DescribeNetworkInterfacesOutput{}, nil).AnyTimes()
+			tc.expect(ec2Mock.EXPECT(), launch)
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			instance, err := s.CreateInstance(machineScope, []byte("userData"), "")
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(awserrors.IsInsufficientInstanceCapacity(errors.Cause(err))).To(BeTrue())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(instance.SubnetID + "/" + instance.Type).To(Equal(tc.expectedLaunch))
+
+			condition := conditions.Get(machineScope.AWSMachine, infrav1.InstancePlacementCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			g.Expect(condition.Reason).To(Equal(infrav1.InsufficientInstanceCapacityReason))
+		})
+	}
+}
+
+func TestCreateInstanceFallbackInstanceTypes(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "bootstrap-data",
+		},
+		Data: map[string][]byte{
+			"value": []byte("data"),
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		machineConfig *infrav1.AWSMachineSpec
+		// launchErrs maps the instance types which fail to launch to their error.
+		launchErrs map[string]error
+		// noEnclaveTypes are the instance types which don't support Nitro Enclaves.
+		noEnclaveTypes   []string
+		expectedLaunches []string
+		// expectedCoreCounts are the core counts of the CPU options of each launch.
+		expectedCoreCounts []int64
+		expectedType       string
+		expectedReason     string
+		expectErr          bool
+	}{
+		{
+			name: "falls through unavailable instance types in order",
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI:                   infrav1.AMIReference{ID: aws.String("abc")},
+				InstanceType:          "m5.large",
+				FallbackInstanceTypes: []string{"m5a.large", "m6i.large"},
+			},
+			launchErrs: map[string]error{
+				"m5.large":  awserr.New(awserrors.InsufficientInstanceCapacity, "insufficient capacity", nil),
+				"m5a.large": awserr.New(awserrors.Unsupported, "unsupported in the availability zone", nil),
+			},
+			expectedLaunches: []string{"m5.large", "m5a.large", "m6i.large"},
+			expectedType:     "m6i.large",
+			expectedReason:   infrav1.InsufficientInstanceCapacityReason,
+		},
+		{
+			name: "falls back when the instance type is not supported in the availability zone",
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI:                   infrav1.AMIReference{ID: aws.String("abc")},
+				InstanceType:          "m5.large",
+				FallbackInstanceTypes: []string{"m5a.large"},
+			},
+			launchErrs: map[string]error{
+				"m5.large": awserr.New(awserrors.Unsupported, "unsupported in the availability zone", nil),
+			},
+			expectedLaunches: []string{"m5.large", "m5a.large"},
+			expectedType:     "m5a.large",
+			expectedReason:   infrav1.InstanceTypeUnsupportedReason,
+		},
+		{
+			name: "uses the instance type when it is available",
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI:                   infrav1.AMIReference{ID: aws.String("abc")},
+				InstanceType:          "m5.large",
+				FallbackInstanceTypes: []string{"m5a.large"},
+			},
+			expectedLaunches: []string{"m5.large"},
+			expectedType:     "m5.large",
+		},
+		{
+			name: "resolves the CPU options for the fallback instance type",
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI:                   infrav1.AMIReference{ID: aws.String("abc")},
+				InstanceType:          "m5.large",
+				FallbackInstanceTypes: []string{"m5.xlarge"},
+				CPUOptions:            &infrav1.CPUOptions{ThreadsPerCore: aws.Int64(1)},
+			},
+			launchErrs: map[string]error{
+				"m5.large": awserr.New(awserrors.InsufficientInstanceCapacity, "insufficient capacity", nil),
+			},
+			expectedLaunches:   []string{"m5.large", "m5.xlarge"},
+			expectedCoreCounts: []int64{1, 2},
+			expectedType:       "m5.xlarge",
+			expectedReason:     infrav1.InsufficientInstanceCapacityReason,
+		},
+		{
+			name: "does not fall through on other errors",
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI:                   infrav1.AMIReference{ID: aws.String("abc")},
+				InstanceType:          "m5.large",
+				FallbackInstanceTypes: []string{"m5a.large", "m6i.large"},
+			},
+			launchErrs: map[string]error{
+				"m5.large":  awserr.New(awserrors.InsufficientInstanceCapacity, "insufficient capacity", nil),
+				"m5a.large": awserr.New(awserrors.UnauthorizedOperation, "not authorized", nil),
+			},
+			expectedLaunches: []string{"m5.large", "m5a.large"},
+			expectErr:        true,
+		},
+		{
+			name: "fails before launching when a fallback instance type does not support enclaves",
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI:                   infrav1.AMIReference{ID: aws.String("abc")},
+				InstanceType:          "m5.large",
+				FallbackInstanceTypes: []string{"t3.large"},
+				EnclaveOptions:        aws.Bool(true),
+			},
+			noEnclaveTypes: []string{"t3.large"},
+			expectErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).ToNot(HaveOccurred())
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test1",
+				},
+			}
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			}
+			awsCluster := &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupNode: {ID: "2"},
+							infrav1.SecurityGroupLB:   {ID: "3"},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret, cluster, machine).Build()
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    cluster,
+				AWSCluster: awsCluster,
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       client,
+				Cluster:      cluster,
+				Machine:      machine,
+				AWSMachine:   &infrav1.AWSMachine{ObjectMeta: metav1.ObjectMeta{Name: "aws-test1"}},
 				InfraCluster: clusterScope,
 			})
+			g.Expect(err).ToNot(HaveOccurred())
+			machineScope.AWSMachine.Spec = *tc.machineConfig
+
+			ec2Mock.EXPECT().DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, input *ec2.DescribeInstanceTypesInput, _ ...request.Option) (*ec2.DescribeInstanceTypesOutput, error) {
+					enclaves := ec2.NitroEnclavesSupportSupported
+					if slices.Contains(tc.noEnclaveTypes, aws.StringValue(input.InstanceTypes[0])) {
+						enclaves = ec2.NitroEnclavesSupportUnsupported
+					}
+					defaultCores := int64(1)
+					if aws.StringValue(input.InstanceTypes[0]) == "m5.xlarge" {
+						defaultCores = 2
+					}
+					return &ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType:         input.InstanceTypes[0],
+								NitroEnclavesSupport: aws.String(enclaves),
+								VCpuInfo: &ec2.VCpuInfo{
+									DefaultCores:          aws.Int64(defaultCores),
+									DefaultThreadsPerCore: aws.Int64(2),
+									ValidCores:            aws.Int64Slice([]int64{1, 2}),
+									ValidThreadsPerCore:   aws.Int64Slice([]int64{1, 2}),
+								},
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{aws.String("x86_64")},
+								},
+							},
+						},
+					}, nil
+				}).AnyTimes()
+			ec2Mock.EXPECT().DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+				Return(&ec2.DescribeNetworkInterfacesOutput{}, nil).AnyTimes()
+
+			var launches []string
+			var coreCounts []int64
+			ec2Mock.EXPECT().RunInstancesWithContext(context.TODO(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+					instanceType := aws.StringValue(input.InstanceType)
+					launches = append(launches, instanceType)
+					if input.CpuOptions != nil {
+						coreCounts = append(coreCounts, aws.Int64Value(input.CpuOptions.CoreCount))
+					}
+					if err, ok := tc.launchErrs[instanceType]; ok {
+						return nil, err
+					}
+					return &ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								InstanceId:   aws.String("i-1"),
+								InstanceType: aws.String(instanceType),
+								SubnetId:     input.SubnetId,
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: aws.String("us-east-1a"),
+								},
+							},
+						},
+					}, nil
+				}).Times(len(tc.expectedLaunches))
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			instance, err := s.CreateInstance(machineScope, []byte("userData"), "")
+			g.Expect(launches).To(Equal(tc.expectedLaunches))
+			g.Expect(coreCounts).To(Equal(tc.expectedCoreCounts))
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(instance.Type).To(Equal(tc.expectedType))
+
+			condition := conditions.Get(machineScope.AWSMachine, infrav1.InstancePlacementCondition)
+			g.Expect(condition).ToNot(BeNil())
+			if tc.expectedReason == "" {
+				g.Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+				return
+			}
+			g.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			g.Expect(condition.Reason).To(Equal(tc.expectedReason))
+		})
+	}
+}
+
+func TestRunInstanceTagSpecifications(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name                  string
+		networkInterfaces     []string
+		expectedResourceTypes []string
+	}{
+		{
+			name:                  "tags the instance, its volumes and the network interfaces it creates",
+			expectedResourceTypes: []string{"instance", "volume", "network-interface"},
+		},
+		{
+			name:                  "does not tag network interfaces at launch when existing ones are attached",
+			networkInterfaces:     []string{"eni-1"},
+			expectedResourceTypes: []string{"instance", "volume"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			ec2Mock.EXPECT().
+				RunInstancesWithContext(context.TODO(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+					resourceTypes := make([]string, 0, len(input.TagSpecifications))
+					for _, spec := range input.TagSpecifications {
+						resourceTypes = append(resourceTypes, aws.StringValue(spec.ResourceType))
+
+						expectedTags := []*ec2.Tag{
+							{
+								Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+								Value: aws.String("owned"),
+							},
+						}
+						if !cmp.Equal(spec.Tags, expectedTags) {
+							t.Fatalf("expected %s to be tagged with %v, got %v", aws.StringValue(spec.ResourceType), expectedTags, spec.Tags)
+						}
+					}
+					if !cmp.Equal(resourceTypes, tc.expectedResourceTypes) {
+						t.Fatalf("expected tag specifications for %v, got %v", tc.expectedResourceTypes, resourceTypes)
+					}
+
+					return &ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								InstanceId: aws.String("i-1"),
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: aws.String("us-east-1a"),
+								},
+							},
+						},
+					}, nil
+				})
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			_, err = s.runInstance("node", &infrav1.Instance{
+				Type:              "m5.large",
+				ImageID:           "ami-1",
+				SubnetID:          "subnet-1",
+				UserData:          aws.String(""),
+				NetworkInterfaces: tc.networkInterfaces,
+				Tags: infrav1.Tags{
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/test1": "owned",
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect error: %v", err)
+			}
+		})
+	}
+}
+
+func TestRunInstanceIAMInstanceProfile(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name            string
+		iamProfile      string
+		expectedProfile *ec2.IamInstanceProfileSpecification
+	}{
+		{
+			name:            "references the instance profile by its name",
+			iamProfile:      "nodes.cluster-api-provider-aws.sigs.k8s.io",
+			expectedProfile: &ec2.IamInstanceProfileSpecification{Name: aws.String("nodes.cluster-api-provider-aws.sigs.k8s.io")},
+		},
+		{
+			name:            "references the instance profile by its ARN",
+			iamProfile:      "arn:aws:iam::123456789012:instance-profile/nodes",
+			expectedProfile: &ec2.IamInstanceProfileSpecification{Arn: aws.String("arn:aws:iam::123456789012:instance-profile/nodes")},
+		},
+		{
+			name: "does not set an instance profile",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
 			if err != nil {
 				t.Fatalf("Failed to create test context: %v", err)
 			}
-			machineScope.AWSMachine.Spec = *tc.machineConfig
-			tc.expect(ec2Mock.EXPECT())
 
-			s := NewService(clusterScope)
+			ec2Mock.EXPECT().
+				RunInstancesWithContext(context.TODO(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+					if !cmp.Equal(input.IamInstanceProfile, tc.expectedProfile) {
+						t.Fatalf("expected instance profile %v, got %v", tc.expectedProfile, input.IamInstanceProfile)
+					}
+
+					return &ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								InstanceId: aws.String("i-1"),
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: aws.String("us-east-1a"),
+								},
+							},
+						},
+					}, nil
+				})
+
+			s := NewService(scope)
 			s.EC2Client = ec2Mock
 
-			instance, err := s.CreateInstance(machineScope, data, "")
-			tc.check(instance, err)
+			_, err = s.runInstance("node", &infrav1.Instance{
+				Type:       "m5.large",
+				ImageID:    "ami-1",
+				SubnetID:   "subnet-1",
+				UserData:   aws.String(""),
+				IAMProfile: tc.iamProfile,
+			})
+			if err != nil {
+				t.Fatalf("did not expect error: %v", err)
+			}
+		})
+	}
+}
+
+func TestVolumeEncryptionKey(t *testing.T) {
+	const defaultKey = "arn:aws:kms:us-east-1:111111111111:key/default"
+
+	testCases := []struct {
+		name          string
+		volume        infrav1.Volume
+		wantEncrypted *bool
+		wantKey       *string
+	}{
+		{
+			name:   "unencrypted volume doesn't use the default key",
+			volume: infrav1.Volume{DeviceName: "/dev/sda1", Size: 8},
+		},
+		{
+			name:          "encrypted volume uses the default key",
+			volume:        infrav1.Volume{DeviceName: "/dev/sda1", Size: 8, Encrypted: aws.Bool(true)},
+			wantEncrypted: aws.Bool(true),
+			wantKey:       aws.String(defaultKey),
+		},
+		{
+			name:          "encrypted volume uses its own key",
+			volume:        infrav1.Volume{DeviceName: "/dev/sda1", Size: 8, Encrypted: aws.Bool(true), EncryptionKey: "alias/volume"},
+			wantEncrypted: aws.Bool(true),
+			wantKey:       aws.String("alias/volume"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mapping := volumeToBlockDeviceMapping(&tc.volume, defaultKey)
+			g.Expect(mapping.Ebs.Encrypted).To(Equal(tc.wantEncrypted))
+			g.Expect(mapping.Ebs.KmsKeyId).To(Equal(tc.wantKey))
+
+			request := volumeToLaunchTemplateBlockDeviceMappingRequest(&tc.volume, defaultKey)
+			g.Expect(request.Ebs.Encrypted).To(Equal(tc.wantEncrypted))
+			g.Expect(request.Ebs.KmsKeyId).To(Equal(tc.wantKey))
 		})
 	}
 }
@@ -3728,6 +5823,49 @@ func TestGetInstanceMarketOptionsRequest(t *testing.T) {
 	}
 }
 
+func TestGetInstanceMetadataOptionsRequest(t *testing.T) {
+	testCases := []struct {
+		name            string
+		metadataOptions *infrav1.InstanceMetadataOptions
+		expectedRequest *ec2.InstanceMetadataOptionsRequest
+	}{
+		{
+			name:            "with no metadata options specified",
+			metadataOptions: nil,
+			expectedRequest: nil,
+		},
+		{
+			name:            "with empty metadata options specified",
+			metadataOptions: &infrav1.InstanceMetadataOptions{},
+			expectedRequest: &ec2.InstanceMetadataOptionsRequest{},
+		},
+		{
+			name: "with instance metadata tags enabled",
+			metadataOptions: &infrav1.InstanceMetadataOptions{
+				HTTPEndpoint:            infrav1.InstanceMetadataEndpointStateEnabled,
+				HTTPPutResponseHopLimit: 2,
+				HTTPTokens:              infrav1.HTTPTokensStateRequired,
+				InstanceMetadataTags:    infrav1.InstanceMetadataEndpointStateEnabled,
+			},
+			expectedRequest: &ec2.InstanceMetadataOptionsRequest{
+				HttpEndpoint:            aws.String("enabled"),
+				HttpPutResponseHopLimit: aws.Int64(2),
+				HttpTokens:              aws.String("required"),
+				InstanceMetadataTags:    aws.String("enabled"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := getInstanceMetadataOptionsRequest(tc.metadataOptions)
+			if !cmp.Equal(request, tc.expectedRequest) {
+				t.Errorf("Case: %s. Got: %v, expected: %v", tc.name, request, tc.expectedRequest)
+			}
+		})
+	}
+}
+
 func TestGetFilteredSecurityGroupID(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -3822,6 +5960,34 @@ func TestGetFilteredSecurityGroupID(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "security groups matching the filters are sorted",
+			securityGroup: infrav1.AWSResourceReference{
+				Filters: []infrav1.Filter{
+					{
+						Name: securityGroupFilterName, Values: securityGroupFilterValues,
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroupsWithContext(context.TODO(), gomock.Any()).Return(
+					&ec2.DescribeSecurityGroupsOutput{
+						SecurityGroups: []*ec2.SecurityGroup{
+							{GroupId: aws.String("sg-3")},
+							{GroupId: aws.String("sg-1")},
+							{GroupId: aws.String("sg-2")},
+						},
+					}, nil)
+			},
+			check: func(ids []string, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if !reflect.DeepEqual(ids, []string{"sg-1", "sg-2", "sg-3"}) {
+					t.Fatalf("expected sorted security group ids but got: %v", ids)
+				}
+			},
+		},
 		{
 			name:          "return early when filters are missing",
 			securityGroup: infrav1.AWSResourceReference{},
@@ -3908,3 +6074,327 @@ func TestGetFilteredSecurityGroupID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCoreSecurityGroups(t *testing.T) {
+	securityGroups := map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+		infrav1.SecurityGroupControlPlane: {ID: "sg-control-plane"},
+		infrav1.SecurityGroupNode:         {ID: "sg-node"},
+		infrav1.SecurityGroupLB:           {ID: "sg-lb"},
+	}
+
+	testCases := []struct {
+		name         string
+		controlPlane bool
+		additional   []infrav1.AWSResourceReference
+		externally   bool
+		want         []string
+	}{
+		{
+			name: "node security group comes first for nodes",
+			want: []string{"sg-node", "sg-lb"},
+		},
+		{
+			name:         "control plane security group comes first for control plane machines",
+			controlPlane: true,
+			want:         []string{"sg-control-plane", "sg-node", "sg-lb"},
+		},
+		{
+			name:       "additional security groups are kept in spec order for externally managed clusters",
+			externally: true,
+			additional: []infrav1.AWSResourceReference{
+				{ID: aws.String("sg-2")},
+				{ID: aws.String("sg-1")},
+				{ID: aws.String("sg-3")},
+			},
+			want: []string{"sg-2", "sg-1", "sg-3"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			awsCluster := newAWSCluster()
+			awsCluster.Status.Network.SecurityGroups = securityGroups
+			if tc.externally {
+				awsCluster.Annotations = map[string]string{clusterv1.ManagedByAnnotation: ""}
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    newCluster(),
+				AWSCluster: awsCluster,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "test",
+					Labels: map[string]string{},
+				},
+			}
+			if tc.controlPlane {
+				machine.Labels[clusterv1.MachineControlPlaneLabel] = ""
+			}
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       client,
+				Cluster:      clusterScope.Cluster,
+				Machine:      machine,
+				InfraCluster: clusterScope,
+				AWSMachine: &infrav1.AWSMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec:       infrav1.AWSMachineSpec{AdditionalSecurityGroups: tc.additional},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			ids, err := s.GetCoreSecurityGroups(machineScope)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(ids).To(Equal(tc.want))
+		})
+	}
+}
+
+func TestCheckUserDataSize(t *testing.T) {
+	testCases := []struct {
+		name               string
+		userDataSize       int
+		userDataFormat     string
+		skipSecretsManager bool
+		wantErr            bool
+	}{
+		{
+			name:               "user data within the limit passes",
+			userDataSize:       MaxUserDataSize,
+			skipSecretsManager: true,
+		},
+		{
+			name:               "user data over the limit fails when it isn't offloaded",
+			userDataSize:       MaxUserDataSize + 1,
+			skipSecretsManager: true,
+			wantErr:            true,
+		},
+		{
+			name:         "user data over the limit passes when it is offloaded to AWS Secrets Manager",
+			userDataSize: MaxUserDataSize + 1,
+		},
+		{
+			name:               "user data over the limit passes when it is offloaded to S3",
+			userDataSize:       MaxUserDataSize + 1,
+			userDataFormat:     "ignition",
+			skipSecretsManager: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machineScope := &scope.MachineScope{
+				AWSMachine: &infrav1.AWSMachine{
+					Spec: infrav1.AWSMachineSpec{
+						CloudInit: infrav1.CloudInit{
+							InsecureSkipSecretsManager: tc.skipSecretsManager,
+						},
+					},
+				},
+			}
+
+			err := checkUserDataSize(machineScope, make([]byte, tc.userDataSize), tc.userDataFormat)
+			if !tc.wantErr {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(conditions.Get(machineScope.AWSMachine, infrav1.InstanceReadyCondition)).To(BeNil())
+				return
+			}
+			g.Expect(err).To(MatchError(ContainSubstring("exceeds the EC2 limit of 16384 bytes")))
+
+			condition := conditions.Get(machineScope.AWSMachine, infrav1.InstanceReadyCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			g.Expect(condition.Reason).To(Equal(infrav1.UserDataTooLargeReason))
+			g.Expect(condition.Severity).To(Equal(clusterv1.ConditionSeverityError))
+			g.Expect(condition.Message).To(ContainSubstring("user data is 16385 bytes"))
+			g.Expect(condition.Message).To(ContainSubstring("spec.cloudInit.insecureSkipSecretsManager to false"))
+		})
+	}
+}
+
+func TestValidateGPUInstanceType(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name           string
+		instanceType   string
+		expect         func(m *mocks.MockEC2APIMockRecorder)
+		wantErr        bool
+		wantMissingGPU bool
+	}{
+		{
+			name:         "instance type with GPUs passes",
+			instanceType: "g5.xlarge",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("g5.xlarge")},
+				})).Return(&ec2.DescribeInstanceTypesOutput{
+					InstanceTypes: []*ec2.InstanceTypeInfo{
+						{
+							InstanceType: aws.String("g5.xlarge"),
+							GpuInfo: &ec2.GpuInfo{
+								Gpus: []*ec2.GpuDeviceInfo{
+									{
+										Count:        aws.Int64(1),
+										Manufacturer: aws.String("NVIDIA"),
+										Name:         aws.String("A10G"),
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:         "instance type without GPUs fails",
+			instanceType: "m5.large",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType: aws.String("m5.large"),
+							},
+						},
+					}, nil)
+			},
+			wantErr:        true,
+			wantMissingGPU: true,
+		},
+		{
+			name:         "validation is skipped without permissions to describe instance types",
+			instanceType: "g5.xlarge",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New(awserrors.UnauthorizedOperation, "not authorized", nil)).
+					Times(2) // errors are not cached.
+			},
+		},
+		{
+			name:         "empty instance type result",
+			instanceType: "g5.xlarge",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeInstanceTypesOutput{}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			cs, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+			mockEC2Client := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(mockEC2Client.EXPECT())
+
+			s := NewService(cs)
+			s.EC2Client = mockEC2Client
+
+			// The instance type is only described once, the second validation uses the cached result.
+			for i := 0; i < 2; i++ {
+				err = s.ValidateGPUInstanceType(tc.instanceType)
+				if tc.wantErr {
+					g.Expect(err).To(HaveOccurred())
+					g.Expect(errors.Is(err, errMissingGPUs)).To(Equal(tc.wantMissingGPU))
+					continue
+				}
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestGetConsoleDiagnostics(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name           string
+		expect         func(m *mocks.MockEC2APIMockRecorder)
+		wantOutput     []byte
+		wantScreenshot []byte
+		wantErr        bool
+	}{
+		{
+			name: "returns the console output and screenshot",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.GetConsoleOutputWithContext(context.TODO(), gomock.Eq(&ec2.GetConsoleOutputInput{
+					InstanceId: aws.String("i-12345"),
+					Latest:     aws.Bool(true),
+				})).Return(&ec2.GetConsoleOutputOutput{
+					Output: aws.String(base64.StdEncoding.EncodeToString([]byte("kernel panic"))),
+				}, nil)
+				m.GetConsoleScreenshotWithContext(context.TODO(), gomock.Eq(&ec2.GetConsoleScreenshotInput{
+					InstanceId: aws.String("i-12345"),
+					WakeUp:     aws.Bool(true),
+				})).Return(&ec2.GetConsoleScreenshotOutput{
+					ImageData: aws.String(base64.StdEncoding.EncodeToString([]byte("jpeg"))),
+				}, nil)
+			},
+			wantOutput:     []byte("kernel panic"),
+			wantScreenshot: []byte("jpeg"),
+		},
+		{
+			name: "skips the screenshot when the instance type doesn't support it",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.GetConsoleOutputWithContext(context.TODO(), gomock.Any()).Return(&ec2.GetConsoleOutputOutput{
+					Output: aws.String(base64.StdEncoding.EncodeToString([]byte("kernel panic"))),
+				}, nil)
+				m.GetConsoleScreenshotWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New(awserrors.UnsupportedOperation, "not supported", nil))
+			},
+			wantOutput: []byte("kernel panic"),
+		},
+		{
+			name: "fails when the console output can't be read",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.GetConsoleOutputWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New(awserrors.UnauthorizedOperation, "not authorized", nil))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			cs, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+			mockEC2Client := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(mockEC2Client.EXPECT())
+
+			s := NewService(cs)
+			s.EC2Client = mockEC2Client
+
+			output, screenshot, err := s.GetConsoleDiagnostics("i-12345")
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(output).To(Equal(tc.wantOutput))
+			g.Expect(screenshot).To(Equal(tc.wantScreenshot))
+		})
+	}
+}
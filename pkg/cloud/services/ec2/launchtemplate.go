@@ -20,11 +20,13 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -34,8 +36,10 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/userdata"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/cloudinit"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -49,6 +53,19 @@ const (
 	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
 	// for annotation formatting rules.
 	TagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-aws-last-applied-tags"
+
+	// InstanceTagsLastAppliedAnnotation is the key for the AWSMachinePool object annotation
+	// which tracks the InstanceTags of the launch template data of the latest
+	// version of the launch template.
+	InstanceTagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-aws-last-applied-instance-tags"
+
+	// LaunchTemplateTagsLastAppliedAnnotation is the key for the AWSMachinePool object
+	// annotation which tracks the LaunchTemplateTags applied to the launch template.
+	LaunchTemplateTagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-aws-last-applied-launch-template-tags"
+
+	// launchTemplateVersionTagKey is the tag EC2 sets on instances to the version of the launch template
+	// they were launched from.
+	launchTemplateVersionTagKey = "aws:ec2launchtemplate:version"
 )
 
 func (s *Service) ReconcileLaunchTemplate(
@@ -61,10 +78,38 @@ func (s *Service) ReconcileLaunchTemplate(
 		record.Eventf(scope.GetMachinePool(), corev1.EventTypeWarning, "FailedGetBootstrapData", err.Error())
 		return err
 	}
-	bootstrapDataHash := userdata.ComputeHash(bootstrapData)
+
+	if name := scope.KubeadmNodeName(); name != "" {
+		var updated bool
+		if bootstrapData, updated = cloudinit.SetKubeadmNodeName(bootstrapData, name); !updated {
+			scope.Info("Not setting the node name to the private DNS name, bootstrap data isn't a kubeadm cloud-config rendered as a Jinja template")
+		}
+	}
 
 	ec2svc := NewService(scope.GetEC2Scope())
 
+	if instanceType := scope.GetLaunchTemplate().InstanceType; scope.RequiresGPU() && instanceType != "" {
+		if err := ec2svc.ValidateGPUInstanceType(instanceType); err != nil {
+			record.Warnf(scope.GetMachinePool(), "FailedValidateGPUInstanceType", "%v", err)
+			conditions.MarkFalse(scope.GetSetter(), expinfrav1.LaunchTemplateReadyCondition, expinfrav1.LaunchTemplateCreateFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			return err
+		}
+	}
+
+	kubeletExtraArgs, err := ec2svc.launchTemplateKubeletExtraArgs(scope)
+	if err != nil {
+		record.Eventf(scope.GetMachinePool(), corev1.EventTypeWarning, "FailedComputeMaxPods", err.Error())
+		return err
+	}
+	if len(kubeletExtraArgs) > 0 {
+		bootstrapData, err = userdata.AddKubeletExtraArgs(bootstrapData, kubeletExtraArgs)
+		if err != nil {
+			record.Eventf(scope.GetMachinePool(), corev1.EventTypeWarning, "FailedAddKubeletExtraArgs", err.Error())
+			return err
+		}
+	}
+	bootstrapDataHash := userdata.ComputeHash(bootstrapData)
+
 	scope.Info("checking for existing launch template")
 	launchTemplate, launchTemplateUserDataHash, err := ec2svc.GetLaunchTemplate(scope.LaunchTemplateName())
 	if err != nil {
@@ -87,6 +132,9 @@ func (s *Service) ReconcileLaunchTemplate(
 		}
 
 		scope.SetLaunchTemplateIDStatus(launchTemplateID)
+		if err := updateInstanceTagsAnnotation(scope); err != nil {
+			return err
+		}
 		return scope.PatchObject()
 	}
 
@@ -117,8 +165,15 @@ func (s *Service) ReconcileLaunchTemplate(
 		return err
 	}
 
+	instanceTagsAnnotation, err := MachinePoolAnnotationJSON(scope, InstanceTagsLastAppliedAnnotation)
+	if err != nil {
+		return err
+	}
+
 	// Check if the instance tags were changed. If they were, create a new LaunchTemplate.
-	tagsChanged, _, _, _ := tagsChanged(annotation, scope.AdditionalTags()) //nolint:dogsled
+	additionalTagsChanged, _, _, _ := tagsChanged(annotation, scope.AdditionalTags())         //nolint:dogsled
+	instanceTagsChanged, _, _, _ := tagsChanged(instanceTagsAnnotation, scope.InstanceTags()) //nolint:dogsled
+	tagsChanged := additionalTagsChanged || instanceTagsChanged
 
 	needsUpdate, err := ec2svc.LaunchTemplateNeedsUpdate(scope, scope.GetLaunchTemplate(), launchTemplate)
 	if err != nil {
@@ -142,9 +197,14 @@ func (s *Service) ReconcileLaunchTemplate(
 		scope.Info("creating new version for launch template", "existing", launchTemplate, "incoming", scope.GetLaunchTemplate())
 		// There is a limit to the number of Launch Template Versions.
 		// We ensure that the number of versions does not grow without bound by following a simple rule: Before we create a new version, we delete one old version, if there is at least one old version that is not in use.
-		if err := ec2svc.PruneLaunchTemplateVersions(scope.GetLaunchTemplateIDStatus()); err != nil {
-			return err
+		// When the maximum number of versions is configured, the versions in excess are deleted after the new version is created instead.
+		maxVersions := scope.MaxLaunchTemplateVersions()
+		if maxVersions == nil {
+			if err := ec2svc.PruneLaunchTemplateVersions(scope.GetLaunchTemplateIDStatus()); err != nil {
+				return err
+			}
 		}
+		previousVersion := scope.GetLaunchTemplateLatestVersionStatus()
 		if err := ec2svc.CreateLaunchTemplateVersion(scope.GetLaunchTemplateIDStatus(), scope, imageID, bootstrapData); err != nil {
 			return err
 		}
@@ -154,9 +214,19 @@ func (s *Service) ReconcileLaunchTemplate(
 		}
 
 		scope.SetLaunchTemplateLatestVersionStatus(version)
+		if err := updateInstanceTagsAnnotation(scope); err != nil {
+			return err
+		}
 		if err := scope.PatchObject(); err != nil {
 			return err
 		}
+
+		if maxVersions != nil {
+			if err := ec2svc.pruneExcessLaunchTemplateVersions(scope.GetLaunchTemplateIDStatus(), int(*maxVersions), previousVersion); err != nil {
+				record.Warnf(scope.GetMachinePool(), "FailedPruneLaunchTemplateVersions", "Failed to prune versions of launch template %q: %v", scope.GetLaunchTemplateIDStatus(), err)
+				return err
+			}
+		}
 	}
 
 	if needsUpdate || tagsChanged || *imageID != *launchTemplate.AMI.ID {
@@ -191,15 +261,43 @@ func (s *Service) ensureTags(scope scope.LaunchTemplateScope, resourceServicesTo
 	// moment we send everything, even if only a single tag was created or
 	// upated.
 	changed, created, deleted, newAnnotation := tagsChanged(annotation, additionalTags)
-	if changed {
-		for _, resourceServiceToUpdate := range resourceServicesToUpdate {
-			err := resourceServiceToUpdate.ResourceService.UpdateResourceTags(resourceServiceToUpdate.ResourceID, created, deleted)
+	for _, resourceServiceToUpdate := range resourceServicesToUpdate {
+		resourceChanged, resourceCreated, resourceDeleted, newResourceAnnotation, err := resourceTagsChanged(scope, resourceServiceToUpdate, additionalTags)
+		if err != nil {
+			return false, err
+		}
+		if !changed && !resourceChanged {
+			continue
+		}
+
+		// The tags specific to the resource take precedence over the additional tags.
+		for k, v := range created {
+			if _, ok := resourceServiceToUpdate.ResourceTags[k]; !ok {
+				resourceCreated[k] = v
+			}
+		}
+		for k, v := range deleted {
+			if _, ok := resourceServiceToUpdate.ResourceTags[k]; !ok {
+				resourceDeleted[k] = v
+			}
+		}
+		if len(resourceCreated) > 0 || len(resourceDeleted) > 0 {
+			err = resourceServiceToUpdate.ResourceService.UpdateResourceTags(resourceServiceToUpdate.ResourceID, resourceCreated, resourceDeleted)
 			if err != nil {
 				return false, err
 			}
 		}
 
-		// We also need to update the annotation if anything changed.
+		if resourceChanged {
+			err = UpdateMachinePoolAnnotationJSON(scope, resourceServiceToUpdate.ResourceTagsAnnotation, newResourceAnnotation)
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+
+	// We also need to update the annotation if anything changed.
+	if changed {
 		err = UpdateMachinePoolAnnotationJSON(scope, TagsLastAppliedAnnotation, newAnnotation)
 		if err != nil {
 			return false, err
@@ -209,6 +307,41 @@ func (s *Service) ensureTags(scope scope.LaunchTemplateScope, resourceServicesTo
 	return changed, nil
 }
 
+// resourceTagsChanged determines which of the tags specific to a resource to create and delete, and the new
+// annotation of the resource to set. The tags no longer specific to the resource are reset to their value in the
+// additional tags, if any.
+func resourceTagsChanged(scope scope.LaunchTemplateScope, resourceServiceToUpdate scope.ResourceServiceToUpdate, additionalTags map[string]string) (bool, map[string]string, map[string]string, map[string]interface{}, error) {
+	created, deleted := map[string]string{}, map[string]string{}
+	if resourceServiceToUpdate.ResourceTagsAnnotation == "" {
+		return false, created, deleted, nil, nil
+	}
+
+	annotation, err := MachinePoolAnnotationJSON(scope, resourceServiceToUpdate.ResourceTagsAnnotation)
+	if err != nil {
+		return false, nil, nil, nil, err
+	}
+
+	changed, created, resourceDeleted, newAnnotation := tagsChanged(annotation, resourceServiceToUpdate.ResourceTags)
+	for k, v := range resourceDeleted {
+		if additionalValue, ok := additionalTags[k]; ok {
+			created[k] = additionalValue
+		} else {
+			deleted[k] = v
+		}
+	}
+	return changed, created, deleted, newAnnotation, nil
+}
+
+// updateInstanceTagsAnnotation records the InstanceTags of the launch template data of the latest version of the
+// launch template.
+func updateInstanceTagsAnnotation(scope scope.LaunchTemplateScope) error {
+	if len(scope.InstanceTags()) == 0 && machinePoolAnnotation(scope, InstanceTagsLastAppliedAnnotation) == "" {
+		return nil
+	}
+	_, _, _, newAnnotation := tagsChanged(map[string]interface{}{}, scope.InstanceTags()) //nolint:dogsled
+	return UpdateMachinePoolAnnotationJSON(scope, InstanceTagsLastAppliedAnnotation, newAnnotation)
+}
+
 func MachinePoolAnnotationJSON(lts scope.LaunchTemplateScope, annotation string) (map[string]interface{}, error) {
 	out := map[string]interface{}{}
 
@@ -392,6 +525,8 @@ func (s *Service) CreateLaunchTemplate(scope scope.LaunchTemplateScope, imageID
 	}
 
 	additionalTags := scope.AdditionalTags()
+	// The launch template specific tags take precedence over the additional tags
+	additionalTags.Merge(scope.LaunchTemplateTags())
 	// Set the cloud provider tag
 	additionalTags[infrav1.ClusterAWSCloudProviderTagKey(s.scope.KubernetesClusterName())] = string(infrav1.ResourceLifecycleOwned)
 
@@ -473,8 +608,15 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 	}
 
 	if len(lt.IamInstanceProfile) > 0 {
-		data.IamInstanceProfile = &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{
-			Name: aws.String(lt.IamInstanceProfile),
+		// Instance profiles of other accounts can only be referenced by their ARN.
+		if arn.IsARN(lt.IamInstanceProfile) {
+			data.IamInstanceProfile = &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{
+				Arn: aws.String(lt.IamInstanceProfile),
+			}
+		} else {
+			data.IamInstanceProfile = &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{
+				Name: aws.String(lt.IamInstanceProfile),
+			}
 		}
 	}
 
@@ -508,7 +650,7 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 
 		lt.RootVolume.DeviceName = aws.StringValue(rootDeviceName)
 
-		req := volumeToLaunchTemplateBlockDeviceMappingRequest(lt.RootVolume)
+		req := volumeToLaunchTemplateBlockDeviceMappingRequest(lt.RootVolume, s.scope.EBSEncryptionKeyARN())
 		data.BlockDeviceMappings = []*ec2.LaunchTemplateBlockDeviceMappingRequest{
 			req,
 		}
@@ -516,10 +658,86 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 
 	data.TagSpecifications = s.buildLaunchTemplateTagSpecificationRequest(scope)
 
+	if id := scope.BaseLaunchTemplateID(); id != nil {
+		return s.inheritBaseLaunchTemplate(*id, data)
+	}
+
 	return data, nil
 }
 
-func volumeToLaunchTemplateBlockDeviceMappingRequest(v *infrav1.Volume) *ec2.LaunchTemplateBlockDeviceMappingRequest {
+// inheritBaseLaunchTemplate returns the settings of the default version of the base launch template, overridden by
+// the settings set in the given launch template data. The tags of each resource type are merged.
+func (s *Service) inheritBaseLaunchTemplate(id string, data *ec2.RequestLaunchTemplateData) (*ec2.RequestLaunchTemplateData, error) {
+	out, err := s.EC2Client.DescribeLaunchTemplateVersionsWithContext(context.TODO(), &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+		Versions:         aws.StringSlice([]string{"$Default"}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe base launch template %q", id)
+	}
+	if len(out.LaunchTemplateVersions) == 0 || out.LaunchTemplateVersions[0].LaunchTemplateData == nil {
+		return nil, errors.Errorf("base launch template %q has no default version", id)
+	}
+
+	// The launch template data of the requests and of the responses only differ by the types of their nested
+	// structures, which have the same fields.
+	raw, err := json.Marshal(out.LaunchTemplateVersions[0].LaunchTemplateData)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal the data of base launch template %q", id)
+	}
+	inherited := &ec2.RequestLaunchTemplateData{}
+	if err := json.Unmarshal(raw, inherited); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal the data of base launch template %q", id)
+	}
+
+	tagSpecifications := mergeLaunchTemplateTagSpecifications(inherited.TagSpecifications, data.TagSpecifications)
+
+	dst, src := reflect.ValueOf(inherited).Elem(), reflect.ValueOf(data).Elem()
+	for i := 0; i < src.NumField(); i++ {
+		if dst.Field(i).CanSet() && !src.Field(i).IsZero() {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+	inherited.TagSpecifications = tagSpecifications
+
+	return inherited, nil
+}
+
+// mergeLaunchTemplateTagSpecifications merges the tags of each resource type, the overrides taking precedence.
+func mergeLaunchTemplateTagSpecifications(base, overrides []*ec2.LaunchTemplateTagSpecificationRequest) []*ec2.LaunchTemplateTagSpecificationRequest {
+	var resourceTypes []string
+	tags := map[string]infrav1.Tags{}
+	for _, spec := range append(base, overrides...) {
+		resourceType := aws.StringValue(spec.ResourceType)
+		if _, ok := tags[resourceType]; !ok {
+			resourceTypes = append(resourceTypes, resourceType)
+			tags[resourceType] = infrav1.Tags{}
+		}
+		for _, tag := range spec.Tags {
+			tags[resourceType][aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+
+	tagSpecifications := make([]*ec2.LaunchTemplateTagSpecificationRequest, 0, len(resourceTypes))
+	for _, resourceType := range resourceTypes {
+		spec := &ec2.LaunchTemplateTagSpecificationRequest{ResourceType: aws.String(resourceType)}
+		keys := make([]string, 0, len(tags[resourceType]))
+		for key := range tags[resourceType] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			spec.Tags = append(spec.Tags, &ec2.Tag{
+				Key:   aws.String(key),
+				Value: aws.String(tags[resourceType][key]),
+			})
+		}
+		tagSpecifications = append(tagSpecifications, spec)
+	}
+	return tagSpecifications
+}
+
+func volumeToLaunchTemplateBlockDeviceMappingRequest(v *infrav1.Volume, defaultEncryptionKey string) *ec2.LaunchTemplateBlockDeviceMappingRequest {
 	ltEbsDevice := &ec2.LaunchTemplateEbsBlockDeviceRequest{
 		DeleteOnTermination: aws.Bool(true),
 		VolumeSize:          aws.Int64(v.Size),
@@ -534,9 +752,9 @@ func volumeToLaunchTemplateBlockDeviceMappingRequest(v *infrav1.Volume) *ec2.Lau
 		ltEbsDevice.Iops = aws.Int64(v.IOPS)
 	}
 
-	if v.EncryptionKey != "" {
+	if encryptionKey := volumeEncryptionKey(v, defaultEncryptionKey); encryptionKey != "" {
 		ltEbsDevice.Encrypted = aws.Bool(true)
-		ltEbsDevice.KmsKeyId = aws.String(v.EncryptionKey)
+		ltEbsDevice.KmsKeyId = aws.String(encryptionKey)
 	}
 
 	if v.Type != "" {
@@ -600,6 +818,114 @@ func (s *Service) PruneLaunchTemplateVersions(id string) error {
 	return s.deleteLaunchTemplateVersion(id, versionToPrune)
 }
 
+// pruneExcessLaunchTemplateVersions deletes the oldest launch template versions in excess of maxVersions.
+// It never deletes the latest version, the default version, which cannot be deleted, the previous version
+// the instances were launched from before the latest version was created, or any version that existing
+// instances were launched from, e.g. while an instance refresh is still in progress.
+func (s *Service) pruneExcessLaunchTemplateVersions(id string, maxVersions int, previousVersion string) error {
+	var versions []*ec2.LaunchTemplateVersion
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+	}
+	for {
+		out, err := s.EC2Client.DescribeLaunchTemplateVersionsWithContext(context.TODO(), input)
+		if err != nil {
+			return errors.Wrapf(err, "failed to describe versions of launch template %q", id)
+		}
+		versions = append(versions, out.LaunchTemplateVersions...)
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	if len(versions) <= maxVersions {
+		return nil
+	}
+
+	inUseVersions, err := s.launchTemplateVersionsInUse(id)
+	if err != nil {
+		return err
+	}
+	inUseVersions[previousVersion] = struct{}{}
+
+	// Keep the newest versions, which include the latest one.
+	sort.Slice(versions, func(i, j int) bool {
+		return aws.Int64Value(versions[i].VersionNumber) > aws.Int64Value(versions[j].VersionNumber)
+	})
+	var toPrune []string
+	for _, version := range versions[maxVersions:] {
+		number := strconv.FormatInt(aws.Int64Value(version.VersionNumber), 10)
+		if _, inUse := inUseVersions[number]; aws.BoolValue(version.DefaultVersion) || inUse {
+			continue
+		}
+		toPrune = append(toPrune, number)
+	}
+
+	// At most 200 versions can be deleted at once.
+	const maxVersionsPerDelete = 200
+	for len(toPrune) > 0 {
+		batch := toPrune
+		if len(batch) > maxVersionsPerDelete {
+			batch = batch[:maxVersionsPerDelete]
+		}
+		toPrune = toPrune[len(batch):]
+
+		out, err := s.EC2Client.DeleteLaunchTemplateVersionsWithContext(context.TODO(), &ec2.DeleteLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(id),
+			Versions:         aws.StringSlice(batch),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to delete versions of launch template %q", id)
+		}
+		if len(out.UnsuccessfullyDeletedLaunchTemplateVersions) > 0 {
+			failed := out.UnsuccessfullyDeletedLaunchTemplateVersions[0]
+			var message string
+			if failed.ResponseError != nil {
+				message = aws.StringValue(failed.ResponseError.Message)
+			}
+			return errors.Errorf("failed to delete version %d of launch template %q: %s", aws.Int64Value(failed.VersionNumber), id, message)
+		}
+		s.scope.Debug("Deleted launch template versions", "id", id, "versions", batch)
+	}
+
+	return nil
+}
+
+// launchTemplateVersionsInUse returns the versions of the launch template that the instances which are not
+// terminated were launched from.
+func (s *Service) launchTemplateVersionsInUse(id string) (map[string]struct{}, error) {
+	input := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.LaunchTemplateID(id),
+			filter.EC2.InstanceStates(
+				ec2.InstanceStateNamePending,
+				ec2.InstanceStateNameRunning,
+				ec2.InstanceStateNameStopping,
+				ec2.InstanceStateNameStopped,
+			),
+		},
+	}
+
+	versions := map[string]struct{}{}
+	if err := s.EC2Client.DescribeInstancesPagesWithContext(context.TODO(), input, func(out *ec2.DescribeInstancesOutput, _ bool) bool {
+		for _, reservation := range out.Reservations {
+			for _, instance := range reservation.Instances {
+				for _, tag := range instance.Tags {
+					if aws.StringValue(tag.Key) == launchTemplateVersionTagKey {
+						versions[aws.StringValue(tag.Value)] = struct{}{}
+					}
+				}
+			}
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to describe instances launched from launch template %q", id)
+	}
+
+	return versions, nil
+}
+
 func (s *Service) GetLaunchTemplateLatestVersion(id string) (string, error) {
 	input := &ec2.DescribeLaunchTemplateVersionsInput{
 		LaunchTemplateId: aws.String(id),
@@ -673,11 +999,13 @@ func (s *Service) SDKToLaunchTemplate(d *ec2.LaunchTemplateVersion) (*expinfrav1
 		i.IamInstanceProfile = aws.StringValue(v.IamInstanceProfile.Name)
 	}
 
-	// Extract IAM Instance Profile name from ARN
+	// Keep the ARN of an instance profile referenced by its ARN, which may be in another account, and extract the
+	// name of the instance profile from any other ARN.
 	if v.IamInstanceProfile != nil && v.IamInstanceProfile.Arn != nil {
-		split := strings.Split(aws.StringValue(v.IamInstanceProfile.Arn), "instance-profile/")
-		if len(split) > 1 && split[1] != "" {
-			i.IamInstanceProfile = split[1]
+		if profileARN := aws.StringValue(v.IamInstanceProfile.Arn); v.IamInstanceProfile.Name == nil && arn.IsARN(profileARN) {
+			i.IamInstanceProfile = profileARN
+		} else {
+			i.IamInstanceProfile = instanceProfileName(profileARN)
 		}
 	}
 
@@ -704,14 +1032,17 @@ func (s *Service) SDKToLaunchTemplate(d *ec2.LaunchTemplateVersion) (*expinfrav1
 // FIXME(dlipovetsky): This check should account for changed userdata, but does not yet do so.
 // Although userdata is stored in an EC2 Launch Template, it is not a field of AWSLaunchTemplate.
 func (s *Service) LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, incoming *expinfrav1.AWSLaunchTemplate, existing *expinfrav1.AWSLaunchTemplate) (bool, error) {
-	if incoming.IamInstanceProfile != existing.IamInstanceProfile {
+	// The settings which are not set are inherited from the base launch template, if any.
+	inheritsBase := scope.BaseLaunchTemplateID() != nil
+
+	if !sameInstanceProfile(incoming.IamInstanceProfile, existing.IamInstanceProfile) && (incoming.IamInstanceProfile != "" || !inheritsBase) {
 		return true, nil
 	}
 
 	if incoming.InstanceType != existing.InstanceType {
 		return true, nil
 	}
-	if !cmp.Equal(incoming.InstanceMetadataOptions, existing.InstanceMetadataOptions) {
+	if !cmp.Equal(incoming.InstanceMetadataOptions, existing.InstanceMetadataOptions) && (incoming.InstanceMetadataOptions != nil || !inheritsBase) {
 		return true, nil
 	}
 
@@ -780,8 +1111,8 @@ func (s *Service) DiscoverLaunchTemplateAMI(scope scope.LaunchTemplateScope) (*s
 	// We will set the default architecture to `x86_64` as a result.
 	imageArchitecture := Amd64ArchitectureTag
 
-	if instanceType != "" {
-		imageArchitecture, err = s.pickArchitectureForInstanceType(instanceType)
+	if instanceType != "" || lt.AMI.Architecture != "" {
+		imageArchitecture, err = s.pickArchitecture(lt.AMI, instanceType)
 		if err != nil {
 			return nil, err
 		}
@@ -834,6 +1165,8 @@ func (s *Service) GetAdditionalSecurityGroupsIDs(securityGroups []infrav1.AWSRes
 func (s *Service) buildLaunchTemplateTagSpecificationRequest(scope scope.LaunchTemplateScope) []*ec2.LaunchTemplateTagSpecificationRequest {
 	tagSpecifications := make([]*ec2.LaunchTemplateTagSpecificationRequest, 0)
 	additionalTags := scope.AdditionalTags()
+	// The instance specific tags take precedence over the additional tags
+	additionalTags.Merge(scope.InstanceTags())
 	// Set the cloud provider tag
 	additionalTags[infrav1.ClusterAWSCloudProviderTagKey(s.scope.KubernetesClusterName())] = string(infrav1.ResourceLifecycleOwned)
 
@@ -888,6 +1221,8 @@ func (s *Service) getFilteredSecurityGroupIDs(securityGroup infrav1.AWSResourceR
 	for _, sg := range sgs.SecurityGroups {
 		ids = append(ids, *sg.GroupId)
 	}
+	// The order of the results isn't guaranteed, sort them so the groups are always attached in the same order.
+	sort.Strings(ids)
 
 	return ids, nil
 }
@@ -914,3 +1249,23 @@ func getLaunchTemplateInstanceMarketOptionsRequest(spotMarketOptions *infrav1.Sp
 
 	return launchTemplateInstanceMarketOptionsRequest
 }
+
+// instanceProfileName returns the name of the instance profile referenced by the given name or ARN.
+func instanceProfileName(profile string) string {
+	if split := strings.Split(profile, "instance-profile/"); len(split) > 1 && split[1] != "" {
+		return split[1]
+	}
+	return profile
+}
+
+// sameInstanceProfile returns whether the given names or ARNs reference the same instance profile. A name and an ARN
+// reference the same instance profile when the ARN is of an instance profile with that name.
+func sameInstanceProfile(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if arn.IsARN(a) && arn.IsARN(b) {
+		return false
+	}
+	return instanceProfileName(a) == instanceProfileName(b)
+}
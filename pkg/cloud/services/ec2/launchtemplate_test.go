@@ -36,6 +36,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ssm/mock_ssmiface"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/userdata"
@@ -340,6 +341,26 @@ func TestServiceSDKToLaunchTemplate(t *testing.T) {
 			},
 			wantHash: testUserDataHash,
 		},
+		{
+			name: "instance profile referenced by its ARN",
+			input: &ec2.LaunchTemplateVersion{
+				LaunchTemplateId:   aws.String("lt-12345"),
+				LaunchTemplateName: aws.String("foo"),
+				LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+					IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecification{
+						Arn: aws.String("arn:aws:iam::210987654321:instance-profile/shared-profile"),
+					},
+					UserData: aws.String(base64.StdEncoding.EncodeToString([]byte(testUserData))),
+				},
+				VersionNumber: aws.Int64(1),
+			},
+			wantLT: &expinfrav1.AWSLaunchTemplate{
+				Name:               "foo",
+				IamInstanceProfile: "arn:aws:iam::210987654321:instance-profile/shared-profile",
+				VersionNumber:      aws.Int64(1),
+			},
+			wantHash: testUserDataHash,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -363,12 +384,13 @@ func TestServiceLaunchTemplateNeedsUpdate(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	tests := []struct {
-		name     string
-		incoming *expinfrav1.AWSLaunchTemplate
-		existing *expinfrav1.AWSLaunchTemplate
-		expect   func(m *mocks.MockEC2APIMockRecorder)
-		want     bool
-		wantErr  bool
+		name                 string
+		incoming             *expinfrav1.AWSLaunchTemplate
+		existing             *expinfrav1.AWSLaunchTemplate
+		baseLaunchTemplateID *string
+		expect               func(m *mocks.MockEC2APIMockRecorder)
+		want                 bool
+		wantErr              bool
 	}{
 		{
 			name: "the same security groups",
@@ -431,6 +453,30 @@ func TestServiceLaunchTemplateNeedsUpdate(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "Should return false if the existing IamInstanceProfile is the ARN of the incoming IamInstanceProfile",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				IamInstanceProfile: "foo-profile",
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				IamInstanceProfile: "arn:aws:iam::123456789012:instance-profile/foo-profile",
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "Should return true if incoming IamInstanceProfile is the ARN of a profile of another account",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				IamInstanceProfile: "arn:aws:iam::210987654321:instance-profile/foo-profile",
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				IamInstanceProfile: "arn:aws:iam::123456789012:instance-profile/foo-profile",
+			},
+			want: true,
+		},
 		{
 			name: "Should return true if incoming InstanceType is not same as existing InstanceType",
 			incoming: &expinfrav1.AWSLaunchTemplate{
@@ -486,6 +532,36 @@ func TestServiceLaunchTemplateNeedsUpdate(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name:     "instance metadata options and instance profile inherited from the base launch template",
+			incoming: &expinfrav1.AWSLaunchTemplate{},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				IamInstanceProfile: "org-instance-profile",
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+				InstanceMetadataOptions: &infrav1.InstanceMetadataOptions{
+					HTTPPutResponseHopLimit: 1,
+					HTTPTokens:              infrav1.HTTPTokensStateRequired,
+				},
+			},
+			baseLaunchTemplateID: aws.String("lt-0123456789abcdef0"),
+			want:                 false,
+			wantErr:              false,
+		},
+		{
+			name: "instance profile overriding the base launch template changed",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				IamInstanceProfile: "instance-profile",
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				IamInstanceProfile: "org-instance-profile",
+			},
+			baseLaunchTemplateID: aws.String("lt-0123456789abcdef0"),
+			want:                 true,
+			wantErr:              false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -514,6 +590,11 @@ func TestServiceLaunchTemplateNeedsUpdate(t *testing.T) {
 				InfraCluster: &scope.ClusterScope{
 					AWSCluster: ac,
 				},
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					Spec: expinfrav1.AWSMachinePoolSpec{
+						BaseLaunchTemplateID: tt.baseLaunchTemplateID,
+					},
+				},
 			}
 			mockEC2Client := mocks.NewMockEC2API(mockCtrl)
 			s.EC2Client = mockEC2Client
@@ -739,10 +820,11 @@ func TestCreateLaunchTemplate(t *testing.T) {
 
 	userData := []byte{1, 0, 0}
 	testCases := []struct {
-		name                 string
-		awsResourceReference []infrav1.AWSResourceReference
-		expect               func(g *WithT, m *mocks.MockEC2APIMockRecorder)
-		check                func(g *WithT, s string, e error)
+		name                    string
+		awsResourceReference    []infrav1.AWSResourceReference
+		instanceMetadataOptions *infrav1.InstanceMetadataOptions
+		expect                  func(g *WithT, m *mocks.MockEC2APIMockRecorder)
+		check                   func(g *WithT, s string, e error)
 	}{
 		{
 			name:                 "Should not return error if successfully created launch template id",
@@ -866,6 +948,74 @@ func TestCreateLaunchTemplate(t *testing.T) {
 				g.Expect(err).NotTo(HaveOccurred())
 			},
 		},
+		{
+			name:                 "Should forward instance metadata tags to the launch template",
+			awsResourceReference: []infrav1.AWSResourceReference{{ID: aws.String("1")}},
+			instanceMetadataOptions: &infrav1.InstanceMetadataOptions{
+				HTTPEndpoint:            infrav1.InstanceMetadataEndpointStateEnabled,
+				HTTPPutResponseHopLimit: 1,
+				HTTPTokens:              infrav1.HTTPTokensStateRequired,
+				InstanceMetadataTags:    infrav1.InstanceMetadataEndpointStateEnabled,
+			},
+			expect: func(g *WithT, m *mocks.MockEC2APIMockRecorder) {
+				expectedInput := &ec2.CreateLaunchTemplateInput{
+					LaunchTemplateData: &ec2.RequestLaunchTemplateData{
+						InstanceType: aws.String("t3.large"),
+						IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{
+							Name: aws.String("instance-profile"),
+						},
+						KeyName:  aws.String("default"),
+						UserData: ptr.To[string](base64.StdEncoding.EncodeToString(userData)),
+						MetadataOptions: &ec2.LaunchTemplateInstanceMetadataOptionsRequest{
+							HttpEndpoint:            aws.String("enabled"),
+							HttpPutResponseHopLimit: aws.Int64(1),
+							HttpTokens:              aws.String("required"),
+							InstanceMetadataTags:    aws.String("enabled"),
+						},
+						SecurityGroupIds: aws.StringSlice([]string{"nodeSG", "lbSG", "1"}),
+						ImageId:          aws.String("imageID"),
+						InstanceMarketOptions: &ec2.LaunchTemplateInstanceMarketOptionsRequest{
+							MarketType: aws.String("spot"),
+							SpotOptions: &ec2.LaunchTemplateSpotMarketOptionsRequest{
+								MaxPrice: aws.String("0.9"),
+							},
+						},
+						TagSpecifications: []*ec2.LaunchTemplateTagSpecificationRequest{
+							{
+								ResourceType: aws.String(ec2.ResourceTypeInstance),
+								Tags:         defaultEC2Tags("aws-mp-name", "cluster-name"),
+							},
+							{
+								ResourceType: aws.String(ec2.ResourceTypeVolume),
+								Tags:         defaultEC2Tags("aws-mp-name", "cluster-name"),
+							},
+						},
+					},
+					LaunchTemplateName: aws.String("aws-mp-name"),
+					TagSpecifications: []*ec2.TagSpecification{
+						{
+							ResourceType: aws.String(ec2.ResourceTypeLaunchTemplate),
+							Tags:         defaultEC2Tags("aws-mp-name", "cluster-name"),
+						},
+					},
+				}
+				m.CreateLaunchTemplateWithContext(context.TODO(), gomock.AssignableToTypeOf(expectedInput)).Return(&ec2.CreateLaunchTemplateOutput{
+					LaunchTemplate: &ec2.LaunchTemplate{
+						LaunchTemplateId: aws.String("launch-template-id"),
+					},
+				}, nil).Do(func(ctx context.Context, arg *ec2.CreateLaunchTemplateInput, requestOptions ...request.Option) {
+					// formatting added to match arrays during cmp.Equal
+					formatTagsInput(arg)
+					if !cmp.Equal(expectedInput, arg) {
+						t.Fatalf("mismatch in input expected: %+v, got: %+v", expectedInput, arg)
+					}
+				})
+			},
+			check: func(g *WithT, id string, err error) {
+				g.Expect(id).Should(Equal("launch-template-id"))
+				g.Expect(err).NotTo(HaveOccurred())
+			},
+		},
 		{
 			name:                 "Should return with error if failed to create launch template id",
 			awsResourceReference: []infrav1.AWSResourceReference{{ID: aws.String("1")}},
@@ -940,6 +1090,7 @@ func TestCreateLaunchTemplate(t *testing.T) {
 			g.Expect(err).NotTo(HaveOccurred())
 
 			ms.AWSMachinePool.Spec.AWSLaunchTemplate.AdditionalSecurityGroups = tc.awsResourceReference
+			ms.AWSMachinePool.Spec.AWSLaunchTemplate.InstanceMetadataOptions = tc.instanceMetadataOptions
 
 			s := NewService(cs)
 			s.EC2Client = mockEC2Client
@@ -978,6 +1129,44 @@ func TestLaunchTemplateDataCreation(t *testing.T) {
 	})
 }
 
+func TestCreateLaunchTemplateDataIAMInstanceProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile string
+		want    *ec2.LaunchTemplateIamInstanceProfileSpecificationRequest
+	}{
+		{
+			name:    "instance profile referenced by its name",
+			profile: "foo-profile",
+			want:    &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{Name: aws.String("foo-profile")},
+		},
+		{
+			name:    "instance profile referenced by its ARN",
+			profile: "arn:aws:iam::210987654321:instance-profile/shared-profile",
+			want:    &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{Arn: aws.String("arn:aws:iam::210987654321:instance-profile/shared-profile")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			cs, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+			ms, err := setupMachinePoolScope(client, cs)
+			g.Expect(err).NotTo(HaveOccurred())
+			ms.AWSMachinePool.Spec.AWSLaunchTemplate.IamInstanceProfile = tt.profile
+
+			s := NewService(cs)
+			data, err := s.createLaunchTemplateData(ms, aws.String("imageID"), nil)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(data.IamInstanceProfile).To(Equal(tt.want))
+		})
+	}
+}
+
 func TestCreateLaunchTemplateVersion(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -1128,12 +1317,128 @@ func TestCreateLaunchTemplateVersion(t *testing.T) {
 	}
 }
 
+func TestCreateLaunchTemplateVersionFromBaseLaunchTemplate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	userData := []byte{1, 0, 0}
+
+	scheme, err := setupScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	cs, err := setupClusterScope(client)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ms, err := setupMachinePoolScope(client, cs)
+	g.Expect(err).NotTo(HaveOccurred())
+	ms.AWSMachinePool.Spec.AWSLaunchTemplate.AdditionalSecurityGroups = []infrav1.AWSResourceReference{{ID: aws.String("1")}}
+	ms.AWSMachinePool.Spec.BaseLaunchTemplateID = aws.String("lt-0123456789abcdef0")
+
+	mockEC2Client := mocks.NewMockEC2API(mockCtrl)
+	s := NewService(cs)
+	s.EC2Client = mockEC2Client
+
+	mockEC2Client.EXPECT().DescribeLaunchTemplateVersionsWithContext(context.TODO(), &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String("lt-0123456789abcdef0"),
+		Versions:         aws.StringSlice([]string{"$Default"}),
+	}).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+		LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{
+			{
+				LaunchTemplateId: aws.String("lt-0123456789abcdef0"),
+				LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+					ImageId:      aws.String("ami-base"),
+					InstanceType: aws.String("m5.large"),
+					IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecification{
+						Name: aws.String("org-instance-profile"),
+					},
+					MetadataOptions: &ec2.LaunchTemplateInstanceMetadataOptions{
+						HttpTokens:              aws.String(ec2.LaunchTemplateHttpTokensStateRequired),
+						HttpPutResponseHopLimit: aws.Int64(1),
+					},
+					Monitoring:       &ec2.LaunchTemplatesMonitoring{Enabled: aws.Bool(true)},
+					SecurityGroupIds: aws.StringSlice([]string{"sg-org"}),
+					UserData:         aws.String("b3Jn"),
+					TagSpecifications: []*ec2.LaunchTemplateTagSpecification{
+						{
+							ResourceType: aws.String(ec2.ResourceTypeInstance),
+							Tags: []*ec2.Tag{
+								{Key: aws.String("Name"), Value: aws.String("org")},
+								{Key: aws.String("org:cost-center"), Value: aws.String("platform")},
+							},
+						},
+						{
+							ResourceType: aws.String(ec2.ResourceTypeNetworkInterface),
+							Tags: []*ec2.Tag{
+								{Key: aws.String("org:cost-center"), Value: aws.String("platform")},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+
+	instanceTags := append(defaultEC2Tags("aws-mp-name", "cluster-name"), &ec2.Tag{Key: aws.String("org:cost-center"), Value: aws.String("platform")})
+	sortTags(instanceTags)
+	expectedData := &ec2.RequestLaunchTemplateData{
+		// Inherited from the base launch template.
+		MetadataOptions: &ec2.LaunchTemplateInstanceMetadataOptionsRequest{
+			HttpTokens:              aws.String(ec2.LaunchTemplateHttpTokensStateRequired),
+			HttpPutResponseHopLimit: aws.Int64(1),
+		},
+		Monitoring: &ec2.LaunchTemplatesMonitoringRequest{Enabled: aws.Bool(true)},
+		// Managed by the AWS provider.
+		InstanceType: aws.String("t3.large"),
+		IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{
+			Name: aws.String("instance-profile"),
+		},
+		KeyName:          aws.String("default"),
+		UserData:         ptr.To[string](base64.StdEncoding.EncodeToString(userData)),
+		SecurityGroupIds: aws.StringSlice([]string{"nodeSG", "lbSG", "1"}),
+		ImageId:          aws.String("imageID"),
+		InstanceMarketOptions: &ec2.LaunchTemplateInstanceMarketOptionsRequest{
+			MarketType: aws.String("spot"),
+			SpotOptions: &ec2.LaunchTemplateSpotMarketOptionsRequest{
+				MaxPrice: aws.String("0.9"),
+			},
+		},
+		TagSpecifications: []*ec2.LaunchTemplateTagSpecificationRequest{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeInstance),
+				Tags:         instanceTags,
+			},
+			{
+				ResourceType: aws.String(ec2.ResourceTypeNetworkInterface),
+				Tags: []*ec2.Tag{
+					{Key: aws.String("org:cost-center"), Value: aws.String("platform")},
+				},
+			},
+			{
+				ResourceType: aws.String(ec2.ResourceTypeVolume),
+				Tags:         defaultEC2Tags("aws-mp-name", "cluster-name"),
+			},
+		},
+	}
+	mockEC2Client.EXPECT().CreateLaunchTemplateVersionWithContext(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg *ec2.CreateLaunchTemplateVersionInput, _ ...request.Option) (*ec2.CreateLaunchTemplateVersionOutput, error) {
+			if !cmp.Equal(expectedData, arg.LaunchTemplateData) {
+				t.Fatalf("mismatch in launch template data: %s", cmp.Diff(expectedData, arg.LaunchTemplateData))
+			}
+			return &ec2.CreateLaunchTemplateVersionOutput{}, nil
+		})
+
+	g.Expect(s.CreateLaunchTemplateVersion("launch-template-id", ms, aws.String("imageID"), userData)).To(Succeed())
+}
+
 func TestBuildLaunchTemplateTagSpecificationRequest(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 
 	testCases := []struct {
 		name  string
+		setup func(ms *scope.MachinePoolScope)
 		check func(g *WithT, m []*ec2.LaunchTemplateTagSpecificationRequest)
 	}{
 		{
@@ -1156,6 +1461,26 @@ func TestBuildLaunchTemplateTagSpecificationRequest(t *testing.T) {
 				g.Expect(res).Should(Equal(expected))
 			},
 		},
+		{
+			name: "Should tag instances and volumes with the instance tags over the additional tags",
+			setup: func(ms *scope.MachinePoolScope) {
+				ms.AWSMachinePool.Spec.AdditionalTags = infrav1.Tags{"env": "dev", "team": "platform"}
+				ms.AWSMachinePool.Spec.InstanceTags = infrav1.Tags{"env": "prod"}
+				ms.AWSMachinePool.Spec.LaunchTemplateTags = infrav1.Tags{"lt-only": "true"}
+			},
+			check: func(g *WithT, res []*ec2.LaunchTemplateTagSpecificationRequest) {
+				g.Expect(res).To(HaveLen(2))
+				for _, spec := range res {
+					tags := map[string]string{}
+					for _, tag := range spec.Tags {
+						tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+					}
+					g.Expect(tags).To(HaveKeyWithValue("env", "prod"))
+					g.Expect(tags).To(HaveKeyWithValue("team", "platform"))
+					g.Expect(tags).NotTo(HaveKey("lt-only"))
+				}
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1170,6 +1495,9 @@ func TestBuildLaunchTemplateTagSpecificationRequest(t *testing.T) {
 
 			ms, err := setupMachinePoolScope(client, cs)
 			g.Expect(err).NotTo(HaveOccurred())
+			if tc.setup != nil {
+				tc.setup(ms)
+			}
 
 			s := NewService(cs)
 			tc.check(g, s.buildLaunchTemplateTagSpecificationRequest(ms))
@@ -1589,3 +1917,268 @@ func TestDeleteLaunchTemplateVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestPruneExcessLaunchTemplateVersions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	launchTemplateVersions := func(numbers ...int64) []*ec2.LaunchTemplateVersion {
+		versions := make([]*ec2.LaunchTemplateVersion, 0, len(numbers))
+		for _, number := range numbers {
+			versions = append(versions, &ec2.LaunchTemplateVersion{
+				VersionNumber:  aws.Int64(number),
+				DefaultVersion: aws.Bool(number == 1),
+			})
+		}
+		return versions
+	}
+	describeInstancesInput := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.LaunchTemplateID("lt-1"),
+			filter.EC2.InstanceStates(ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning, ec2.InstanceStateNameStopping, ec2.InstanceStateNameStopped),
+		},
+	}
+	instancesLaunchedFrom := func(versions ...string) *ec2.DescribeInstancesOutput {
+		instances := make([]*ec2.Instance, 0, len(versions))
+		for _, version := range versions {
+			instances = append(instances, &ec2.Instance{
+				Tags: []*ec2.Tag{
+					{Key: aws.String("aws:ec2launchtemplate:id"), Value: aws.String("lt-1")},
+					{Key: aws.String("aws:ec2launchtemplate:version"), Value: aws.String(version)},
+				},
+			})
+		}
+		return &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{Instances: instances}}}
+	}
+
+	testCases := []struct {
+		name            string
+		maxVersions     int
+		previousVersion string
+		expect          func(m *mocks.MockEC2APIMockRecorder)
+		wantErr         bool
+	}{
+		{
+			name:        "nothing is pruned when the versions don't exceed the maximum",
+			maxVersions: 3,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersionsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateId: aws.String("lt-1"),
+				})).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+					LaunchTemplateVersions: launchTemplateVersions(1, 2, 3),
+				}, nil)
+			},
+		},
+		{
+			name:            "oldest versions are pruned while the default, previous and latest versions survive",
+			maxVersions:     2,
+			previousVersion: "3",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersionsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateId: aws.String("lt-1"),
+				})).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+					LaunchTemplateVersions: launchTemplateVersions(1, 2, 3),
+					NextToken:              aws.String("next"),
+				}, nil)
+				m.DescribeLaunchTemplateVersionsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateId: aws.String("lt-1"),
+					NextToken:        aws.String("next"),
+				})).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+					LaunchTemplateVersions: launchTemplateVersions(5, 7, 8),
+				}, nil)
+				m.DescribeInstancesPagesWithContext(context.TODO(), gomock.Eq(describeInstancesInput), gomock.Any()).
+					Return(nil)
+				m.DeleteLaunchTemplateVersionsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteLaunchTemplateVersionsInput{
+					LaunchTemplateId: aws.String("lt-1"),
+					Versions:         aws.StringSlice([]string{"5", "2"}),
+				})).Return(&ec2.DeleteLaunchTemplateVersionsOutput{}, nil)
+			},
+		},
+		{
+			name:            "versions instances were launched from survive, e.g. during an instance refresh",
+			maxVersions:     2,
+			previousVersion: "7",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersionsWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+					LaunchTemplateVersions: launchTemplateVersions(1, 2, 3, 5, 7, 8),
+				}, nil)
+				m.DescribeInstancesPagesWithContext(context.TODO(), gomock.Eq(describeInstancesInput), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool, _ ...request.Option) error {
+						fn(instancesLaunchedFrom("3", "7", "8"), true)
+						return nil
+					})
+				m.DeleteLaunchTemplateVersionsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteLaunchTemplateVersionsInput{
+					LaunchTemplateId: aws.String("lt-1"),
+					Versions:         aws.StringSlice([]string{"5", "2"}),
+				})).Return(&ec2.DeleteLaunchTemplateVersionsOutput{}, nil)
+			},
+		},
+		{
+			name:        "error if the instances can't be described",
+			maxVersions: 2,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersionsWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+					LaunchTemplateVersions: launchTemplateVersions(1, 2, 3, 4),
+				}, nil)
+				m.DescribeInstancesPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+					Return(awserrors.NewFailedDependency("dependency-failure"))
+			},
+			wantErr: true,
+		},
+		{
+			name:        "error if a version fails to be deleted",
+			maxVersions: 2,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersionsWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+					LaunchTemplateVersions: launchTemplateVersions(1, 2, 3, 4),
+				}, nil)
+				m.DescribeInstancesPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.DeleteLaunchTemplateVersionsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteLaunchTemplateVersionsInput{
+					LaunchTemplateId: aws.String("lt-1"),
+					Versions:         aws.StringSlice([]string{"2"}),
+				})).Return(&ec2.DeleteLaunchTemplateVersionsOutput{
+					UnsuccessfullyDeletedLaunchTemplateVersions: []*ec2.DeleteLaunchTemplateVersionsResponseErrorItem{
+						{
+							VersionNumber: aws.Int64(2),
+							ResponseError: &ec2.ResponseError{Message: aws.String("in use")},
+						},
+					},
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:        "error if the versions can't be described",
+			maxVersions: 2,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersionsWithContext(context.TODO(), gomock.Any()).Return(nil, awserrors.NewFailedDependency("dependency-failure"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			cs, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			s := NewService(cs)
+			s.EC2Client = ec2Mock
+			tc.expect(ec2Mock.EXPECT())
+
+			err = s.pruneExcessLaunchTemplateVersions("lt-1", tc.maxVersions, tc.previousVersion)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+type fakeResourceService struct {
+	created map[string]string
+	removed map[string]string
+}
+
+func (f *fakeResourceService) UpdateResourceTags(_ *string, create, remove map[string]string) error {
+	f.created, f.removed = create, remove
+	return nil
+}
+
+func TestReconcileTags(t *testing.T) {
+	testCases := []struct {
+		name               string
+		annotations        map[string]string
+		additionalTags     infrav1.Tags
+		launchTemplateTags infrav1.Tags
+		asgTags            infrav1.Tags
+		wantLTCreated      map[string]string
+		wantLTRemoved      map[string]string
+		wantASGCreated     map[string]string
+		wantASGRemoved     map[string]string
+	}{
+		{
+			name:               "resource tags take precedence over the additional tags",
+			additionalTags:     infrav1.Tags{"env": "dev", "team": "platform"},
+			launchTemplateTags: infrav1.Tags{"env": "prod"},
+			asgTags:            infrav1.Tags{"team": "autoscaling"},
+			wantLTCreated:      map[string]string{"env": "prod", "team": "platform"},
+			wantLTRemoved:      map[string]string{},
+			wantASGCreated:     map[string]string{"env": "dev"},
+			wantASGRemoved:     map[string]string{},
+		},
+		{
+			name: "removed resource tags are reset to the additional tags or removed",
+			annotations: map[string]string{
+				TagsLastAppliedAnnotation:               `{"env":"dev"}`,
+				LaunchTemplateTagsLastAppliedAnnotation: `{"env":"prod","lt-only":"true"}`,
+			},
+			additionalTags: infrav1.Tags{"env": "dev"},
+			wantLTCreated:  map[string]string{"env": "dev"},
+			wantLTRemoved:  map[string]string{"lt-only": "true"},
+		},
+		{
+			name: "removed additional tags are left alone on resources setting them",
+			annotations: map[string]string{
+				TagsLastAppliedAnnotation:               `{"env":"dev","team":"platform"}`,
+				LaunchTemplateTagsLastAppliedAnnotation: `{"env":"prod"}`,
+			},
+			additionalTags:     infrav1.Tags{"team": "platform"},
+			launchTemplateTags: infrav1.Tags{"env": "prod"},
+			asgTags:            infrav1.Tags{"env": "staging"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			cs, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			ms, err := setupMachinePoolScope(client, cs)
+			g.Expect(err).NotTo(HaveOccurred())
+			ms.AWSMachinePool.Annotations = tc.annotations
+			ms.AWSMachinePool.Spec.AdditionalTags = tc.additionalTags
+			ms.AWSMachinePool.Spec.LaunchTemplateTags = tc.launchTemplateTags
+
+			lt, asg := &fakeResourceService{}, &fakeResourceService{}
+			s := NewService(cs)
+			err = s.ReconcileTags(ms, []scope.ResourceServiceToUpdate{
+				{
+					ResourceID:             aws.String("launch-template-id"),
+					ResourceService:        lt,
+					ResourceTags:           ms.LaunchTemplateTags(),
+					ResourceTagsAnnotation: LaunchTemplateTagsLastAppliedAnnotation,
+				},
+				{
+					ResourceID:      aws.String("asg-name"),
+					ResourceService: asg,
+					ResourceTags:    tc.asgTags,
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			g.Expect(lt.created).To(Equal(tc.wantLTCreated))
+			g.Expect(lt.removed).To(Equal(tc.wantLTRemoved))
+			g.Expect(asg.created).To(Equal(tc.wantASGCreated))
+			g.Expect(asg.removed).To(Equal(tc.wantASGRemoved))
+
+			annotation, err := MachinePoolAnnotationJSON(ms, LaunchTemplateTagsLastAppliedAnnotation)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(annotation).To(HaveLen(len(tc.launchTemplateTags)))
+		})
+	}
+}
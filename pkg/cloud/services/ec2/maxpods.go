@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const (
+	// maxPodsKubeletArg is the kubelet argument limiting the number of pods of a node.
+	maxPodsKubeletArg = "max-pods"
+
+	// ipv4AddressesPerPrefix is the number of IPv4 addresses in the /28 prefixes assigned with prefix delegation.
+	ipv4AddressesPerPrefix = 16
+
+	// The maximum number of pods recommended by AWS for instances with less or more than maxPodsLargeInstanceVCPUs vCPUs,
+	// applied with prefix delegation where the network interfaces could otherwise fit thousands of pods.
+	maxPodsSmallInstance      = 110
+	maxPodsLargeInstance      = 250
+	maxPodsLargeInstanceVCPUs = 30
+)
+
+// MaxPodsForInstanceType returns the maximum number of pods the Amazon VPC CNI can assign an address to on an instance
+// of the given type, with or without prefix delegation.
+func (s *Service) MaxPodsForInstanceType(instanceType string, prefixDelegation bool) (int64, error) {
+	info, err := s.describeInstanceType(instanceType)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+
+	if info == nil || info.NetworkInfo == nil || info.VCpuInfo == nil {
+		return 0, fmt.Errorf("instance type result empty for type %q", instanceType)
+	}
+
+	return computeMaxPods(
+		aws.Int64Value(info.NetworkInfo.MaximumNetworkInterfaces),
+		aws.Int64Value(info.NetworkInfo.Ipv4AddressesPerInterface),
+		aws.Int64Value(info.VCpuInfo.DefaultVCpus),
+		prefixDelegation,
+	), nil
+}
+
+// computeMaxPods computes the maximum number of pods following the formula of the EKS AMI: the primary address of
+// each network interface is reserved for the node, and two pods (aws-node and kube-proxy) use the host network.
+func computeMaxPods(networkInterfaces, addressesPerInterface, vcpus int64, prefixDelegation bool) int64 {
+	if !prefixDelegation {
+		return networkInterfaces*(addressesPerInterface-1) + 2
+	}
+
+	maxPods := networkInterfaces*(addressesPerInterface-1)*ipv4AddressesPerPrefix + 2
+	limit := int64(maxPodsSmallInstance)
+	if vcpus > maxPodsLargeInstanceVCPUs {
+		limit = maxPodsLargeInstance
+	}
+	if maxPods > limit {
+		return limit
+	}
+	return maxPods
+}
+
+// launchTemplateKubeletExtraArgs returns the kubelet arguments to add to the bootstrap data of the launch template,
+// including the computed max pods when requested and not set explicitly.
+func (s *Service) launchTemplateKubeletExtraArgs(scope scope.LaunchTemplateScope) (map[string]string, error) {
+	args := scope.KubeletExtraArgs()
+	options := scope.MaxPods()
+	if options == nil {
+		return args, nil
+	}
+	if _, ok := args[maxPodsKubeletArg]; ok {
+		return args, nil
+	}
+
+	instanceType := scope.GetLaunchTemplate().InstanceType
+	maxPods, err := s.MaxPodsForInstanceType(instanceType, options.PrefixDelegation)
+	if err != nil {
+		// If the instance type can't be described due to a permissions error, leave the max pods to the bootstrap script.
+		if awserrors.IsPermissionsError(errors.Cause(err)) {
+			record.Warnf(scope.GetMachinePool(), "FailedDescribeInstanceTypes", "insufficient permissions to describe instance type %q, skipping computation of max pods: %v", instanceType, err)
+			return args, nil
+		}
+		return nil, err
+	}
+
+	merged := make(map[string]string, len(args)+1)
+	for key, value := range args {
+		merged[key] = value
+	}
+	merged[maxPodsKubeletArg] = strconv.FormatInt(maxPods, 10)
+	return merged, nil
+}
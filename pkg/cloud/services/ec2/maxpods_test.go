@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func describeInstanceTypeOutput(instanceType string, networkInterfaces, addressesPerInterface, vcpus int64) *ec2.DescribeInstanceTypesOutput {
+	return &ec2.DescribeInstanceTypesOutput{
+		InstanceTypes: []*ec2.InstanceTypeInfo{
+			{
+				InstanceType: aws.String(instanceType),
+				NetworkInfo: &ec2.NetworkInfo{
+					MaximumNetworkInterfaces:  aws.Int64(networkInterfaces),
+					Ipv4AddressesPerInterface: aws.Int64(addressesPerInterface),
+				},
+				VCpuInfo: &ec2.VCpuInfo{
+					DefaultVCpus: aws.Int64(vcpus),
+				},
+			},
+		},
+	}
+}
+
+func TestMaxPodsForInstanceType(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name             string
+		instanceType     string
+		prefixDelegation bool
+		expect           func(m *mocks.MockEC2APIMockRecorder)
+		want             int64
+		wantErr          bool
+	}{
+		{
+			name:         "t3.micro without prefix delegation",
+			instanceType: "t3.micro",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(describeInstanceTypeOutput("t3.micro", 2, 2, 2), nil)
+			},
+			want: 4,
+		},
+		{
+			name:             "t3.micro with prefix delegation",
+			instanceType:     "t3.micro",
+			prefixDelegation: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(describeInstanceTypeOutput("t3.micro", 2, 2, 2), nil)
+			},
+			want: 34,
+		},
+		{
+			name:         "m5.large without prefix delegation",
+			instanceType: "m5.large",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(describeInstanceTypeOutput("m5.large", 3, 10, 2), nil)
+			},
+			want: 29,
+		},
+		{
+			name:             "m5.large with prefix delegation is limited for instances with few vCPUs",
+			instanceType:     "m5.large",
+			prefixDelegation: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(describeInstanceTypeOutput("m5.large", 3, 10, 2), nil)
+			},
+			want: 110,
+		},
+		{
+			name:         "m5.24xlarge without prefix delegation",
+			instanceType: "m5.24xlarge",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(describeInstanceTypeOutput("m5.24xlarge", 15, 50, 96), nil)
+			},
+			want: 737,
+		},
+		{
+			name:             "m5.24xlarge with prefix delegation is limited for instances with many vCPUs",
+			instanceType:     "m5.24xlarge",
+			prefixDelegation: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(describeInstanceTypeOutput("m5.24xlarge", 15, 50, 96), nil)
+			},
+			want: 250,
+		},
+		{
+			name:         "empty instance type result",
+			instanceType: "m5.large",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeInstanceTypesOutput{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:         "failed to describe instance type",
+			instanceType: "m5.large",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			cs, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+			mockEC2Client := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(mockEC2Client.EXPECT())
+
+			s := NewService(cs)
+			s.EC2Client = mockEC2Client
+
+			maxPods, err := s.MaxPodsForInstanceType(tc.instanceType, tc.prefixDelegation)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(maxPods).To(Equal(tc.want))
+		})
+	}
+}
+
+func TestLaunchTemplateKubeletExtraArgs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name             string
+		kubeletExtraArgs map[string]string
+		maxPods          *expinfrav1.MaxPodsOptions
+		expect           func(m *mocks.MockEC2APIMockRecorder)
+		want             map[string]string
+		wantErr          bool
+	}{
+		{
+			name:             "max pods are not computed when not requested",
+			kubeletExtraArgs: map[string]string{"node-labels": "role=worker"},
+			want:             map[string]string{"node-labels": "role=worker"},
+		},
+		{
+			name:             "computed max pods are added to the kubelet extra args",
+			kubeletExtraArgs: map[string]string{"node-labels": "role=worker"},
+			maxPods:          &expinfrav1.MaxPodsOptions{},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("t3.large")},
+				})).Return(describeInstanceTypeOutput("t3.large", 3, 12, 2), nil)
+			},
+			want: map[string]string{"node-labels": "role=worker", "max-pods": "35"},
+		},
+		{
+			name:             "explicit max pods take precedence over the computed ones",
+			kubeletExtraArgs: map[string]string{"max-pods": "20"},
+			maxPods:          &expinfrav1.MaxPodsOptions{PrefixDelegation: true},
+			want:             map[string]string{"max-pods": "20"},
+		},
+		{
+			name:    "max pods are left to the bootstrap script without permissions to describe the instance type",
+			maxPods: &expinfrav1.MaxPodsOptions{},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New(awserrors.UnauthorizedOperation, "not authorized", nil))
+			},
+			want: nil,
+		},
+		{
+			name:    "failed to describe instance type",
+			maxPods: &expinfrav1.MaxPodsOptions{},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			cs, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+			mockEC2Client := mocks.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(mockEC2Client.EXPECT())
+			}
+
+			ms, err := setupMachinePoolScope(client, cs)
+			g.Expect(err).NotTo(HaveOccurred())
+			ms.AWSMachinePool.Spec.KubeletExtraArgs = tc.kubeletExtraArgs
+			ms.AWSMachinePool.Spec.MaxPods = tc.maxPods
+
+			s := NewService(cs)
+			s.EC2Client = mockEC2Client
+
+			args, err := s.launchTemplateKubeletExtraArgs(ms)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(args).To(Equal(tc.want))
+		})
+	}
+}
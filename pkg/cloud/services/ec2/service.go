@@ -17,6 +17,7 @@ limitations under the License.
 package ec2
 
 import (
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 
@@ -32,6 +33,9 @@ type Service struct {
 
 	// SSMClient is used to look up the official EKS AMI ID
 	SSMClient ssmiface.SSMAPI
+
+	// instanceTypes caches the described instance types, the service being created for each reconciliation.
+	instanceTypes map[string]*ec2.InstanceTypeInfo
 }
 
 // NewService returns a new service given the ec2 api client.
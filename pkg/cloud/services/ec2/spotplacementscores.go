@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+)
+
+// GetSpotPlacementScores returns the Spot placement scores of a request of the target capacity, in instances of
+// the given types, per availability zone ID of the region of the cluster.
+func (s *Service) GetSpotPlacementScores(instanceTypes []string, targetCapacity int32) (map[string]int64, error) {
+	input := &ec2.GetSpotPlacementScoresInput{
+		InstanceTypes:          aws.StringSlice(instanceTypes),
+		TargetCapacity:         aws.Int64(int64(targetCapacity)),
+		TargetCapacityUnitType: aws.String(ec2.TargetCapacityUnitTypeUnits),
+		RegionNames:            aws.StringSlice([]string{s.scope.Region()}),
+		SingleAvailabilityZone: aws.Bool(true),
+	}
+
+	scores := map[string]int64{}
+	if err := s.EC2Client.GetSpotPlacementScoresPagesWithContext(context.TODO(), input, func(out *ec2.GetSpotPlacementScoresOutput, _ bool) bool {
+		for _, score := range out.SpotPlacementScores {
+			if score.AvailabilityZoneId == nil {
+				continue
+			}
+			scores[*score.AvailabilityZoneId] = aws.Int64Value(score.Score)
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Spot placement scores of instance types %v", instanceTypes)
+	}
+
+	return scores, nil
+}
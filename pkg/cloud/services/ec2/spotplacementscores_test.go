@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestGetSpotPlacementScores(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	expectedInput := &ec2.GetSpotPlacementScoresInput{
+		InstanceTypes:          aws.StringSlice([]string{"m5.large", "m5a.large"}),
+		TargetCapacity:         aws.Int64(50),
+		TargetCapacityUnitType: aws.String(ec2.TargetCapacityUnitTypeUnits),
+		RegionNames:            aws.StringSlice([]string{"us-east-1"}),
+		SingleAvailabilityZone: aws.Bool(true),
+	}
+
+	testCases := []struct {
+		name    string
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		want    map[string]int64
+		wantErr bool
+	}{
+		{
+			name: "scores are returned per availability zone ID",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.GetSpotPlacementScoresPagesWithContext(context.TODO(), expectedInput, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *ec2.GetSpotPlacementScoresInput, fn func(*ec2.GetSpotPlacementScoresOutput, bool) bool, _ ...request.Option) error {
+						fn(&ec2.GetSpotPlacementScoresOutput{
+							SpotPlacementScores: []*ec2.SpotPlacementScore{
+								{Region: aws.String("us-east-1"), AvailabilityZoneId: aws.String("use1-az1"), Score: aws.Int64(9)},
+								{Region: aws.String("us-east-1"), AvailabilityZoneId: aws.String("use1-az2"), Score: aws.Int64(6)},
+							},
+						}, false)
+						fn(&ec2.GetSpotPlacementScoresOutput{
+							SpotPlacementScores: []*ec2.SpotPlacementScore{
+								{Region: aws.String("us-east-1"), AvailabilityZoneId: aws.String("use1-az4"), Score: aws.Int64(1)},
+							},
+						}, true)
+						return nil
+					})
+			},
+			want: map[string]int64{
+				"use1-az1": 9,
+				"use1-az2": 6,
+				"use1-az4": 1,
+			},
+		},
+		{
+			name: "failed to get the scores",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.GetSpotPlacementScoresPagesWithContext(context.TODO(), expectedInput, gomock.Any()).
+					Return(awserrors.NewFailedDependency("dependency failure"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			cs, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+			cs.AWSCluster.Spec.Region = "us-east-1"
+			mockEC2Client := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(mockEC2Client.EXPECT())
+
+			s := NewService(cs)
+			s.EC2Client = mockEC2Client
+
+			scores, err := s.GetSpotPlacementScores([]string{"m5.large", "m5a.large"}, 50)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scores).To(Equal(tc.want))
+		})
+	}
+}
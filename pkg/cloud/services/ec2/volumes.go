@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+)
+
+// ModifyInstanceVolumes brings the size, type, IOPS and throughput of the EBS volumes of an instance to the ones of
+// the given root and non-root volumes, modifying the volumes in place. It returns true while volume modifications are
+// in progress, in which case no new modification is requested. Only the block device is modified: growing the
+// partition and the filesystem of the volume is left to the instance.
+func (s *Service) ModifyInstanceVolumes(instanceID string, rootVolume *infrav1.Volume, nonRootVolumes []infrav1.Volume) (bool, error) {
+	out, err := s.EC2Client.DescribeInstancesWithContext(context.TODO(), &ec2.DescribeInstancesInput{
+		InstanceIds: aws.StringSlice([]string{instanceID}),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to describe instance %q", instanceID)
+	}
+	if len(out.Reservations) == 0 || len(out.Reservations[0].Instances) == 0 {
+		return false, errors.Errorf("instance %q not found", instanceID)
+	}
+	instance := out.Reservations[0].Instances[0]
+
+	// The volumes of the spec are matched with the volumes of the instance by device name.
+	desired := make(map[string]infrav1.Volume, len(nonRootVolumes)+1)
+	if rootVolume != nil {
+		deviceName := rootVolume.DeviceName
+		if deviceName == "" {
+			deviceName = aws.StringValue(instance.RootDeviceName)
+		}
+		desired[deviceName] = *rootVolume
+	}
+	for _, volume := range nonRootVolumes {
+		desired[volume.DeviceName] = volume
+	}
+
+	// deviceNames maps the IDs of the volumes of the instance in the spec to their device name.
+	deviceNames := map[string]string{}
+	var ids []*string
+	for _, mapping := range instance.BlockDeviceMappings {
+		if mapping.Ebs == nil {
+			continue
+		}
+		if _, ok := desired[aws.StringValue(mapping.DeviceName)]; ok {
+			deviceNames[aws.StringValue(mapping.Ebs.VolumeId)] = aws.StringValue(mapping.DeviceName)
+			ids = append(ids, mapping.Ebs.VolumeId)
+		}
+	}
+	if len(ids) == 0 {
+		return false, nil
+	}
+
+	modifications, err := s.EC2Client.DescribeVolumesModificationsWithContext(context.TODO(), &ec2.DescribeVolumesModificationsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VolumeIDs(aws.StringValueSlice(ids)...),
+			filter.EC2.VolumeModificationStates(ec2.VolumeModificationStateModifying),
+		},
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to describe modifications of the volumes of instance %q", instanceID)
+	}
+	if len(modifications.VolumesModifications) > 0 {
+		return true, nil
+	}
+
+	volumes, err := s.EC2Client.DescribeVolumesWithContext(context.TODO(), &ec2.DescribeVolumesInput{VolumeIds: ids})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to describe the volumes of instance %q", instanceID)
+	}
+
+	modifying := false
+	for _, volume := range volumes.Volumes {
+		input := volumeModificationInput(volume, desired[deviceNames[aws.StringValue(volume.VolumeId)]])
+		if input == nil {
+			continue
+		}
+
+		s.scope.Info("Modifying volume", "instance-id", instanceID, "volume-id", aws.StringValue(volume.VolumeId), "modification", input)
+		if _, err := s.EC2Client.ModifyVolumeWithContext(context.TODO(), input); err != nil {
+			return false, errors.Wrapf(err, "failed to modify volume %q of instance %q", aws.StringValue(volume.VolumeId), instanceID)
+		}
+		modifying = true
+	}
+
+	return modifying, nil
+}
+
+// volumeModificationInput returns the modification bringing a volume to the desired size, type, IOPS and throughput,
+// nil if the volume already matches. Volumes are never shrunk, and the fields not set in the desired volume are left
+// alone.
+func volumeModificationInput(volume *ec2.Volume, desired infrav1.Volume) *ec2.ModifyVolumeInput {
+	input := &ec2.ModifyVolumeInput{VolumeId: volume.VolumeId}
+	changed := false
+
+	if desired.Size > aws.Int64Value(volume.Size) {
+		input.Size = aws.Int64(desired.Size)
+		changed = true
+	}
+	if desired.Type != "" && string(desired.Type) != aws.StringValue(volume.VolumeType) {
+		input.VolumeType = aws.String(string(desired.Type))
+		changed = true
+	}
+	if desired.IOPS != 0 && desired.IOPS != aws.Int64Value(volume.Iops) {
+		input.Iops = aws.Int64(desired.IOPS)
+		changed = true
+	}
+	if desired.Throughput != nil && *desired.Throughput != aws.Int64Value(volume.Throughput) {
+		input.Throughput = desired.Throughput
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return input
+}
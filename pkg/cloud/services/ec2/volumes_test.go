@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestServiceModifyInstanceVolumes(t *testing.T) {
+	describeInstances := func(m *mocks.MockEC2APIMockRecorder) {
+		m.DescribeInstancesWithContext(context.TODO(), &ec2.DescribeInstancesInput{
+			InstanceIds: aws.StringSlice([]string{"i-1"}),
+		}).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []*ec2.Reservation{
+				{
+					Instances: []*ec2.Instance{
+						{
+							InstanceId:     aws.String("i-1"),
+							RootDeviceName: aws.String("/dev/xvda"),
+							BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+								{
+									DeviceName: aws.String("/dev/xvda"),
+									Ebs:        &ec2.EbsInstanceBlockDevice{VolumeId: aws.String("vol-root")},
+								},
+								{
+									DeviceName: aws.String("/dev/sdb"),
+									Ebs:        &ec2.EbsInstanceBlockDevice{VolumeId: aws.String("vol-data")},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, nil)
+	}
+	describeModifications := func(m *mocks.MockEC2APIMockRecorder, modifications ...*ec2.VolumeModification) {
+		m.DescribeVolumesModificationsWithContext(context.TODO(), &ec2.DescribeVolumesModificationsInput{
+			Filters: []*ec2.Filter{
+				filter.EC2.VolumeIDs("vol-root", "vol-data"),
+				filter.EC2.VolumeModificationStates(ec2.VolumeModificationStateModifying),
+			},
+		}).Return(&ec2.DescribeVolumesModificationsOutput{VolumesModifications: modifications}, nil)
+	}
+	describeVolumes := func(m *mocks.MockEC2APIMockRecorder) {
+		m.DescribeVolumesWithContext(context.TODO(), &ec2.DescribeVolumesInput{
+			VolumeIds: aws.StringSlice([]string{"vol-root", "vol-data"}),
+		}).Return(&ec2.DescribeVolumesOutput{
+			Volumes: []*ec2.Volume{
+				{
+					VolumeId:   aws.String("vol-root"),
+					Size:       aws.Int64(20),
+					VolumeType: aws.String("gp2"),
+					Iops:       aws.Int64(100),
+				},
+				{
+					VolumeId:   aws.String("vol-data"),
+					Size:       aws.Int64(50),
+					VolumeType: aws.String("gp3"),
+					Iops:       aws.Int64(3000),
+					Throughput: aws.Int64(125),
+				},
+			},
+		}, nil)
+	}
+
+	tests := []struct {
+		name            string
+		rootVolume      *infrav1.Volume
+		nonRootVolumes  []infrav1.Volume
+		expect          func(m *mocks.MockEC2APIMockRecorder)
+		expectModifying bool
+	}{
+		{
+			name:       "does not modify volumes matching the spec",
+			rootVolume: &infrav1.Volume{Size: 20, Type: infrav1.VolumeTypeGP2},
+			nonRootVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/sdb", Size: 50, Type: infrav1.VolumeTypeGP3, Throughput: aws.Int64(125)},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeInstances(m)
+				describeModifications(m)
+				describeVolumes(m)
+			},
+		},
+		{
+			name:       "does not shrink volumes",
+			rootVolume: &infrav1.Volume{Size: 10},
+			nonRootVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/sdb", Size: 8},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeInstances(m)
+				describeModifications(m)
+				describeVolumes(m)
+			},
+		},
+		{
+			name:       "modifies the root volume matched by the root device name of the instance",
+			rootVolume: &infrav1.Volume{Size: 40, Type: infrav1.VolumeTypeGP3},
+			nonRootVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/sdb", Size: 50},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeInstances(m)
+				describeModifications(m)
+				describeVolumes(m)
+				m.ModifyVolumeWithContext(context.TODO(), &ec2.ModifyVolumeInput{
+					VolumeId:   aws.String("vol-root"),
+					Size:       aws.Int64(40),
+					VolumeType: aws.String("gp3"),
+				}).Return(&ec2.ModifyVolumeOutput{}, nil)
+			},
+			expectModifying: true,
+		},
+		{
+			name:       "modifies the non-root volumes matched by device name",
+			rootVolume: &infrav1.Volume{Size: 20},
+			nonRootVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/sdb", Size: 50, IOPS: 6000, Throughput: aws.Int64(500)},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeInstances(m)
+				describeModifications(m)
+				describeVolumes(m)
+				m.ModifyVolumeWithContext(context.TODO(), &ec2.ModifyVolumeInput{
+					VolumeId:   aws.String("vol-data"),
+					Iops:       aws.Int64(6000),
+					Throughput: aws.Int64(500),
+				}).Return(&ec2.ModifyVolumeOutput{}, nil)
+			},
+			expectModifying: true,
+		},
+		{
+			name:       "waits for volume modifications in progress",
+			rootVolume: &infrav1.Volume{Size: 40},
+			nonRootVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/sdb", Size: 50},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeInstances(m)
+				describeModifications(m, &ec2.VolumeModification{
+					VolumeId:          aws.String("vol-root"),
+					ModificationState: aws.String(ec2.VolumeModificationStateModifying),
+				})
+			},
+			expectModifying: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			clusterScope, err := setupClusterScope(fake.NewClientBuilder().WithScheme(scheme).Build())
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tt.expect(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			modifying, err := s.ModifyInstanceVolumes("i-1", tt.rootVolume, tt.nonRootVolumes)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(modifying).To(Equal(tt.expectModifying))
+		})
+	}
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package efs provides a service to manage the EFS file system of a cluster.
+package efs
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// Service holds a collection of interfaces.
+// The interfaces are broken down like this to group functions together.
+// One alternative is to have a large list of functions from the ec2 client.
+type Service struct {
+	scope     scope.EFSScope
+	EFSClient efsiface.EFSAPI
+}
+
+// NewService returns a new service given the api clients.
+func NewService(efsScope scope.EFSScope) *Service {
+	return &Service{
+		scope:     efsScope,
+		EFSClient: scope.NewEFSClient(efsScope, efsScope, efsScope, efsScope.InfraCluster()),
+	}
+}
+
+// ReconcileEFS ensures the EFS file system of the cluster exists, along with a mount target in the
+// availability zone of each node subnet.
+func (s *Service) ReconcileEFS() error {
+	spec := s.scope.EFS()
+	if spec == nil {
+		return nil
+	}
+
+	fs, err := s.reconcileFileSystem(spec)
+	if err != nil {
+		return err
+	}
+	s.scope.SetEFSFileSystemID(aws.StringValue(fs.FileSystemId))
+
+	switch state := aws.StringValue(fs.LifeCycleState); state {
+	case efs.LifeCycleStateAvailable:
+	case efs.LifeCycleStateCreating:
+		return wait.NewPending(fmt.Sprintf("EFS file system %q is being created", aws.StringValue(fs.FileSystemId)))
+	default:
+		return errors.Errorf("EFS file system %q is not available, state %q", aws.StringValue(fs.FileSystemId), state)
+	}
+
+	return s.reconcileMountTargets(aws.StringValue(fs.FileSystemId))
+}
+
+// DeleteEFS deletes the mount targets of the EFS file system of the cluster, then the file system if it
+// was created for the cluster. The file system is deleted only once its mount targets are gone, a pending
+// error is returned while they are being deleted.
+func (s *Service) DeleteEFS() error {
+	spec := s.scope.EFS()
+	if spec == nil && s.scope.EFSFileSystemID() == "" {
+		return nil
+	}
+
+	if spec != nil && spec.FileSystemID != "" {
+		return s.deleteUnmanagedMountTargets(spec.FileSystemID)
+	}
+
+	fs, err := s.findFileSystem()
+	if err != nil {
+		return err
+	}
+	if fs == nil {
+		s.scope.Debug("EFS file system already removed")
+		s.scope.SetEFSFileSystemID("")
+		return nil
+	}
+	if !s.isOwned(fs) {
+		s.scope.Debug("EFS file system not owned by the cluster, skipping removal", "file-system-id", aws.StringValue(fs.FileSystemId))
+		return nil
+	}
+
+	fsID := aws.StringValue(fs.FileSystemId)
+	mountTargets, err := s.describeMountTargets(fsID)
+	if err != nil {
+		return err
+	}
+	if len(mountTargets) > 0 {
+		if err := s.deleteMountTargets(mountTargets); err != nil {
+			return err
+		}
+		return wait.NewPending(fmt.Sprintf("waiting for the mount targets of EFS file system %q to be deleted", fsID))
+	}
+
+	s.scope.Info("Deleting EFS file system", "file-system-id", fsID)
+	if _, err := s.EFSClient.DeleteFileSystem(&efs.DeleteFileSystemInput{FileSystemId: aws.String(fsID)}); err != nil {
+		if code, _ := awserrors.Code(err); code != efs.ErrCodeFileSystemNotFound {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteEFSFileSystem", "Failed to delete EFS file system %q: %v", fsID, err)
+			return errors.Wrapf(err, "failed to delete EFS file system %q", fsID)
+		}
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteEFSFileSystem", "Deleted EFS file system %q", fsID)
+	s.scope.SetEFSFileSystemID("")
+
+	return nil
+}
+
+// reconcileFileSystem returns the file system of the cluster, creating it if needed.
+func (s *Service) reconcileFileSystem(spec *infrav1.EFSSpec) (*efs.FileSystemDescription, error) {
+	if spec.FileSystemID != "" {
+		fs, err := s.describeFileSystem(spec.FileSystemID)
+		if err != nil {
+			return nil, err
+		}
+		if fs == nil {
+			return nil, errors.Errorf("EFS file system %q not found", spec.FileSystemID)
+		}
+		return fs, nil
+	}
+
+	fs, err := s.findFileSystem()
+	if err != nil || fs != nil {
+		return fs, err
+	}
+
+	input := &efs.CreateFileSystemInput{
+		CreationToken:   aws.String(s.creationToken()),
+		PerformanceMode: aws.String(string(spec.PerformanceMode)),
+		ThroughputMode:  aws.String(string(spec.ThroughputMode)),
+		Encrypted:       spec.Encrypted,
+		Tags:            s.getTags(),
+	}
+	if spec.PerformanceMode == "" {
+		input.PerformanceMode = aws.String(efs.PerformanceModeGeneralPurpose)
+	}
+	if spec.ThroughputMode == "" {
+		input.ThroughputMode = aws.String(efs.ThroughputModeBursting)
+	}
+
+	fs, err = s.EFSClient.CreateFileSystem(input)
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateEFSFileSystem", "Failed to create EFS file system: %v", err)
+		return nil, errors.Wrap(err, "failed to create EFS file system")
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateEFSFileSystem", "Created EFS file system %q", aws.StringValue(fs.FileSystemId))
+
+	return fs, nil
+}
+
+// reconcileMountTargets creates a mount target in the availability zones of the node subnets without one.
+// A file system can only have a single mount target per availability zone.
+func (s *Service) reconcileMountTargets(fsID string) error {
+	sg, ok := s.scope.SecurityGroups()[infrav1.SecurityGroupEFS]
+	if !ok || sg.ID == "" {
+		return errors.New("EFS security group not found")
+	}
+
+	mountTargets, err := s.describeMountTargets(fsID)
+	if err != nil {
+		return err
+	}
+	zones := sets.New[string]()
+	for _, mt := range mountTargets {
+		zones.Insert(aws.StringValue(mt.AvailabilityZoneName))
+	}
+
+	for _, subnet := range s.nodeSubnets() {
+		if zones.Has(subnet.AvailabilityZone) {
+			continue
+		}
+
+		subnetID := subnet.GetResourceID()
+		out, err := s.EFSClient.CreateMountTarget(&efs.CreateMountTargetInput{
+			FileSystemId:   aws.String(fsID),
+			SubnetId:       aws.String(subnetID),
+			SecurityGroups: aws.StringSlice([]string{sg.ID}),
+		})
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateEFSMountTarget", "Failed to create EFS mount target in subnet %q: %v", subnetID, err)
+			return errors.Wrapf(err, "failed to create EFS mount target in subnet %q", subnetID)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateEFSMountTarget", "Created EFS mount target %q in subnet %q", aws.StringValue(out.MountTargetId), subnetID)
+		zones.Insert(subnet.AvailabilityZone)
+	}
+
+	return nil
+}
+
+// deleteUnmanagedMountTargets deletes the mount targets created for the cluster in a file system it doesn't own,
+// which are the mount targets in the cluster subnets using the EFS security group of the cluster.
+func (s *Service) deleteUnmanagedMountTargets(fsID string) error {
+	sg, ok := s.scope.SecurityGroups()[infrav1.SecurityGroupEFS]
+	if !ok || sg.ID == "" {
+		return nil
+	}
+
+	mountTargets, err := s.describeMountTargets(fsID)
+	if err != nil {
+		return err
+	}
+
+	subnetIDs := sets.New[string]()
+	for _, subnet := range s.scope.Subnets() {
+		subnetIDs.Insert(subnet.GetResourceID())
+	}
+
+	owned := []*efs.MountTargetDescription{}
+	for _, mt := range mountTargets {
+		if !subnetIDs.Has(aws.StringValue(mt.SubnetId)) {
+			continue
+		}
+		out, err := s.EFSClient.DescribeMountTargetSecurityGroups(&efs.DescribeMountTargetSecurityGroupsInput{MountTargetId: mt.MountTargetId})
+		if err != nil {
+			if code, _ := awserrors.Code(err); code == efs.ErrCodeMountTargetNotFound {
+				continue
+			}
+			return errors.Wrapf(err, "failed to describe security groups of EFS mount target %q", aws.StringValue(mt.MountTargetId))
+		}
+		if sets.New(aws.StringValueSlice(out.SecurityGroups)...).Has(sg.ID) {
+			owned = append(owned, mt)
+		}
+	}
+
+	return s.deleteMountTargets(owned)
+}
+
+func (s *Service) deleteMountTargets(mountTargets []*efs.MountTargetDescription) error {
+	for _, mt := range mountTargets {
+		if aws.StringValue(mt.LifeCycleState) == efs.LifeCycleStateDeleting {
+			continue
+		}
+
+		id := aws.StringValue(mt.MountTargetId)
+		s.scope.Info("Deleting EFS mount target", "mount-target-id", id)
+		if _, err := s.EFSClient.DeleteMountTarget(&efs.DeleteMountTargetInput{MountTargetId: mt.MountTargetId}); err != nil {
+			if code, _ := awserrors.Code(err); code == efs.ErrCodeMountTargetNotFound {
+				continue
+			}
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteEFSMountTarget", "Failed to delete EFS mount target %q: %v", id, err)
+			return errors.Wrapf(err, "failed to delete EFS mount target %q", id)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteEFSMountTarget", "Deleted EFS mount target %q", id)
+	}
+	return nil
+}
+
+// findFileSystem returns the file system created for the cluster, or nil if there is none.
+func (s *Service) findFileSystem() (*efs.FileSystemDescription, error) {
+	if id := s.scope.EFSFileSystemID(); id != "" {
+		fs, err := s.describeFileSystem(id)
+		if err != nil || fs != nil {
+			return fs, err
+		}
+	}
+
+	out, err := s.EFSClient.DescribeFileSystems(&efs.DescribeFileSystemsInput{CreationToken: aws.String(s.creationToken())})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe EFS file systems")
+	}
+	if len(out.FileSystems) == 0 {
+		return nil, nil
+	}
+	return out.FileSystems[0], nil
+}
+
+// describeFileSystem returns the file system with the given ID, or nil if it doesn't exist.
+func (s *Service) describeFileSystem(id string) (*efs.FileSystemDescription, error) {
+	out, err := s.EFSClient.DescribeFileSystems(&efs.DescribeFileSystemsInput{FileSystemId: aws.String(id)})
+	if err != nil {
+		if code, _ := awserrors.Code(err); code == efs.ErrCodeFileSystemNotFound {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe EFS file system %q", id)
+	}
+	if len(out.FileSystems) == 0 {
+		return nil, nil
+	}
+	return out.FileSystems[0], nil
+}
+
+func (s *Service) describeMountTargets(fsID string) ([]*efs.MountTargetDescription, error) {
+	out, err := s.EFSClient.DescribeMountTargets(&efs.DescribeMountTargetsInput{FileSystemId: aws.String(fsID)})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe mount targets of EFS file system %q", fsID)
+	}
+	return out.MountTargets, nil
+}
+
+// nodeSubnets returns the subnets of the nodes, which are the private subnets unless the cluster has none.
+func (s *Service) nodeSubnets() infrav1.Subnets {
+	subnets := s.scope.Subnets().FilterPrivate()
+	if len(subnets) == 0 {
+		subnets = s.scope.Subnets().FilterPublic()
+	}
+	return subnets
+}
+
+// creationToken returns the idempotency token of the file system created for the cluster.
+func (s *Service) creationToken() string {
+	return s.scope.Name()
+}
+
+func (s *Service) isOwned(fs *efs.FileSystemDescription) bool {
+	for _, tag := range fs.Tags {
+		if aws.StringValue(tag.Key) == infrav1.ClusterTagKey(s.scope.Name()) {
+			return aws.StringValue(tag.Value) == string(infrav1.ResourceLifecycleOwned)
+		}
+	}
+	return false
+}
+
+func (s *Service) getTags() []*efs.Tag {
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(s.scope.Name() + "-efs"),
+		Role:        aws.String(string(infrav1.SecurityGroupEFS)),
+		Additional:  s.scope.AdditionalTags(),
+	})
+
+	efsTags := make([]*efs.Tag, 0, len(tags))
+	for key, value := range tags {
+		efsTags = append(efsTags, &efs.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	sort.Slice(efsTags, func(i, j int) bool {
+		return *efsTags[i].Key < *efsTags[j].Key
+	})
+	return efsTags
+}
@@ -0,0 +1,363 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package efs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/efs/mock_efsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	testClusterName = "test-cluster"
+	testFileSystem  = "fs-0123456789abcdef0"
+	testEFSGroup    = "sg-efs"
+)
+
+func TestReconcileEFS(t *testing.T) {
+	subnets := infrav1.Subnets{
+		{ID: "subnet-private-a", AvailabilityZone: "us-west-2a", IsPublic: false},
+		{ID: "subnet-private-a-2", AvailabilityZone: "us-west-2a", IsPublic: false},
+		{ID: "subnet-private-b", AvailabilityZone: "us-west-2b", IsPublic: false},
+		{ID: "subnet-public-c", AvailabilityZone: "us-west-2c", IsPublic: true},
+	}
+
+	tests := []struct {
+		name               string
+		spec               *infrav1.EFSSpec
+		securityGroups     map[infrav1.SecurityGroupRole]infrav1.SecurityGroup
+		expect             func(m *mock_efsiface.MockEFSAPIMockRecorder)
+		wantErr            bool
+		wantPending        bool
+		wantFileSystemID   string
+		wantNoFileSystemID bool
+	}{
+		{
+			name:               "does nothing when EFS isn't configured",
+			spec:               nil,
+			expect:             func(m *mock_efsiface.MockEFSAPIMockRecorder) {},
+			wantNoFileSystemID: true,
+		},
+		{
+			name: "creates the file system and a mount target in each availability zone of the node subnets",
+			spec: &infrav1.EFSSpec{
+				PerformanceMode: infrav1.EFSPerformanceModeMaxIO,
+				ThroughputMode:  infrav1.EFSThroughputModeElastic,
+				Encrypted:       aws.Bool(true),
+			},
+			expect: func(m *mock_efsiface.MockEFSAPIMockRecorder) {
+				m.DescribeFileSystems(&efs.DescribeFileSystemsInput{CreationToken: aws.String(testClusterName)}).
+					Return(&efs.DescribeFileSystemsOutput{}, nil)
+				m.CreateFileSystem(gomock.AssignableToTypeOf(&efs.CreateFileSystemInput{})).
+					DoAndReturn(func(input *efs.CreateFileSystemInput) (*efs.FileSystemDescription, error) {
+						g := NewWithT(t)
+						g.Expect(input.CreationToken).To(Equal(aws.String(testClusterName)))
+						g.Expect(input.PerformanceMode).To(Equal(aws.String(efs.PerformanceModeMaxIo)))
+						g.Expect(input.ThroughputMode).To(Equal(aws.String(efs.ThroughputModeElastic)))
+						g.Expect(input.Encrypted).To(Equal(aws.Bool(true)))
+						g.Expect(input.Tags).To(ContainElement(&efs.Tag{
+							Key:   aws.String(infrav1.ClusterTagKey(testClusterName)),
+							Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+						}))
+						return availableFileSystem(true), nil
+					})
+				m.DescribeMountTargets(&efs.DescribeMountTargetsInput{FileSystemId: aws.String(testFileSystem)}).
+					Return(&efs.DescribeMountTargetsOutput{}, nil)
+				m.CreateMountTarget(&efs.CreateMountTargetInput{
+					FileSystemId:   aws.String(testFileSystem),
+					SubnetId:       aws.String("subnet-private-a"),
+					SecurityGroups: aws.StringSlice([]string{testEFSGroup}),
+				}).Return(&efs.MountTargetDescription{MountTargetId: aws.String("fsmt-a")}, nil)
+				m.CreateMountTarget(&efs.CreateMountTargetInput{
+					FileSystemId:   aws.String(testFileSystem),
+					SubnetId:       aws.String("subnet-private-b"),
+					SecurityGroups: aws.StringSlice([]string{testEFSGroup}),
+				}).Return(&efs.MountTargetDescription{MountTargetId: aws.String("fsmt-b")}, nil)
+			},
+			wantFileSystemID: testFileSystem,
+		},
+		{
+			name: "creates mount targets only in the availability zones without one",
+			spec: &infrav1.EFSSpec{},
+			expect: func(m *mock_efsiface.MockEFSAPIMockRecorder) {
+				m.DescribeFileSystems(&efs.DescribeFileSystemsInput{CreationToken: aws.String(testClusterName)}).
+					Return(&efs.DescribeFileSystemsOutput{FileSystems: []*efs.FileSystemDescription{availableFileSystem(true)}}, nil)
+				m.DescribeMountTargets(&efs.DescribeMountTargetsInput{FileSystemId: aws.String(testFileSystem)}).
+					Return(&efs.DescribeMountTargetsOutput{MountTargets: []*efs.MountTargetDescription{
+						{MountTargetId: aws.String("fsmt-a"), SubnetId: aws.String("subnet-private-a-2"), AvailabilityZoneName: aws.String("us-west-2a")},
+					}}, nil)
+				m.CreateMountTarget(&efs.CreateMountTargetInput{
+					FileSystemId:   aws.String(testFileSystem),
+					SubnetId:       aws.String("subnet-private-b"),
+					SecurityGroups: aws.StringSlice([]string{testEFSGroup}),
+				}).Return(&efs.MountTargetDescription{MountTargetId: aws.String("fsmt-b")}, nil)
+			},
+			wantFileSystemID: testFileSystem,
+		},
+		{
+			name: "uses an existing file system",
+			spec: &infrav1.EFSSpec{FileSystemID: testFileSystem},
+			expect: func(m *mock_efsiface.MockEFSAPIMockRecorder) {
+				m.DescribeFileSystems(&efs.DescribeFileSystemsInput{FileSystemId: aws.String(testFileSystem)}).
+					Return(&efs.DescribeFileSystemsOutput{FileSystems: []*efs.FileSystemDescription{availableFileSystem(false)}}, nil)
+				m.DescribeMountTargets(&efs.DescribeMountTargetsInput{FileSystemId: aws.String(testFileSystem)}).
+					Return(&efs.DescribeMountTargetsOutput{MountTargets: []*efs.MountTargetDescription{
+						{MountTargetId: aws.String("fsmt-a"), SubnetId: aws.String("subnet-private-a"), AvailabilityZoneName: aws.String("us-west-2a")},
+						{MountTargetId: aws.String("fsmt-b"), SubnetId: aws.String("subnet-private-b"), AvailabilityZoneName: aws.String("us-west-2b")},
+					}}, nil)
+			},
+			wantFileSystemID: testFileSystem,
+		},
+		{
+			name: "fails when the existing file system doesn't exist",
+			spec: &infrav1.EFSSpec{FileSystemID: testFileSystem},
+			expect: func(m *mock_efsiface.MockEFSAPIMockRecorder) {
+				m.DescribeFileSystems(&efs.DescribeFileSystemsInput{FileSystemId: aws.String(testFileSystem)}).
+					Return(nil, awserr.New(efs.ErrCodeFileSystemNotFound, "not found", nil))
+			},
+			wantErr:            true,
+			wantNoFileSystemID: true,
+		},
+		{
+			name: "waits for the file system to be available before creating mount targets",
+			spec: &infrav1.EFSSpec{},
+			expect: func(m *mock_efsiface.MockEFSAPIMockRecorder) {
+				fs := availableFileSystem(true)
+				fs.LifeCycleState = aws.String(efs.LifeCycleStateCreating)
+				m.DescribeFileSystems(&efs.DescribeFileSystemsInput{CreationToken: aws.String(testClusterName)}).
+					Return(&efs.DescribeFileSystemsOutput{FileSystems: []*efs.FileSystemDescription{fs}}, nil)
+			},
+			wantPending:      true,
+			wantFileSystemID: testFileSystem,
+		},
+		{
+			name:           "fails when the EFS security group doesn't exist",
+			spec:           &infrav1.EFSSpec{},
+			securityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{},
+			expect: func(m *mock_efsiface.MockEFSAPIMockRecorder) {
+				m.DescribeFileSystems(&efs.DescribeFileSystemsInput{CreationToken: aws.String(testClusterName)}).
+					Return(&efs.DescribeFileSystemsOutput{FileSystems: []*efs.FileSystemDescription{availableFileSystem(true)}}, nil)
+			},
+			wantErr:          true,
+			wantFileSystemID: testFileSystem,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			efsMock := mock_efsiface.NewMockEFSAPI(mockCtrl)
+
+			clusterScope := newClusterScope(t, tt.spec, subnets, tt.securityGroups)
+			tt.expect(efsMock.EXPECT())
+
+			s := NewService(clusterScope)
+			s.EFSClient = efsMock
+
+			err := s.ReconcileEFS()
+			switch {
+			case tt.wantPending:
+				g.Expect(wait.IsPending(err)).To(BeTrue(), "expected a pending error, got %v", err)
+			case tt.wantErr:
+				g.Expect(err).To(HaveOccurred())
+			default:
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			if tt.wantNoFileSystemID {
+				g.Expect(clusterScope.AWSCluster.Status.EFS).To(BeNil())
+			} else {
+				g.Expect(clusterScope.EFSFileSystemID()).To(Equal(tt.wantFileSystemID))
+			}
+		})
+	}
+}
+
+func TestDeleteEFS(t *testing.T) {
+	subnets := infrav1.Subnets{
+		{ID: "subnet-private-a", AvailabilityZone: "us-west-2a", IsPublic: false},
+		{ID: "subnet-private-b", AvailabilityZone: "us-west-2b", IsPublic: false},
+	}
+
+	t.Run("deletes the mount targets before the file system", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		efsMock := mock_efsiface.NewMockEFSAPI(mockCtrl)
+
+		clusterScope := newClusterScope(t, &infrav1.EFSSpec{}, subnets, nil)
+		clusterScope.SetEFSFileSystemID(testFileSystem)
+
+		s := NewService(clusterScope)
+		s.EFSClient = efsMock
+
+		describeFileSystem := func() *gomock.Call {
+			return efsMock.EXPECT().DescribeFileSystems(&efs.DescribeFileSystemsInput{FileSystemId: aws.String(testFileSystem)}).
+				Return(&efs.DescribeFileSystemsOutput{FileSystems: []*efs.FileSystemDescription{availableFileSystem(true)}}, nil)
+		}
+		gomock.InOrder(
+			describeFileSystem(),
+			efsMock.EXPECT().DescribeMountTargets(&efs.DescribeMountTargetsInput{FileSystemId: aws.String(testFileSystem)}).
+				Return(&efs.DescribeMountTargetsOutput{MountTargets: []*efs.MountTargetDescription{
+					{MountTargetId: aws.String("fsmt-a"), SubnetId: aws.String("subnet-private-a"), LifeCycleState: aws.String(efs.LifeCycleStateAvailable)},
+					{MountTargetId: aws.String("fsmt-b"), SubnetId: aws.String("subnet-private-b"), LifeCycleState: aws.String(efs.LifeCycleStateDeleting)},
+				}}, nil),
+			efsMock.EXPECT().DeleteMountTarget(&efs.DeleteMountTargetInput{MountTargetId: aws.String("fsmt-a")}).
+				Return(&efs.DeleteMountTargetOutput{}, nil),
+			describeFileSystem(),
+			efsMock.EXPECT().DescribeMountTargets(&efs.DescribeMountTargetsInput{FileSystemId: aws.String(testFileSystem)}).
+				Return(&efs.DescribeMountTargetsOutput{}, nil),
+			efsMock.EXPECT().DeleteFileSystem(&efs.DeleteFileSystemInput{FileSystemId: aws.String(testFileSystem)}).
+				Return(&efs.DeleteFileSystemOutput{}, nil),
+		)
+
+		// The file system can't be deleted while its mount targets are being deleted.
+		g.Expect(wait.IsPending(s.DeleteEFS())).To(BeTrue())
+		g.Expect(clusterScope.EFSFileSystemID()).To(Equal(testFileSystem))
+
+		g.Expect(s.DeleteEFS()).To(Succeed())
+		g.Expect(clusterScope.AWSCluster.Status.EFS).To(BeNil())
+	})
+
+	t.Run("keeps a file system not owned by the cluster", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		efsMock := mock_efsiface.NewMockEFSAPI(mockCtrl)
+
+		clusterScope := newClusterScope(t, &infrav1.EFSSpec{}, subnets, nil)
+
+		s := NewService(clusterScope)
+		s.EFSClient = efsMock
+
+		efsMock.EXPECT().DescribeFileSystems(&efs.DescribeFileSystemsInput{CreationToken: aws.String(testClusterName)}).
+			Return(&efs.DescribeFileSystemsOutput{FileSystems: []*efs.FileSystemDescription{availableFileSystem(false)}}, nil)
+
+		g.Expect(s.DeleteEFS()).To(Succeed())
+	})
+
+	t.Run("clears the status when the file system is already gone", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		efsMock := mock_efsiface.NewMockEFSAPI(mockCtrl)
+
+		clusterScope := newClusterScope(t, nil, subnets, nil)
+		clusterScope.SetEFSFileSystemID(testFileSystem)
+
+		s := NewService(clusterScope)
+		s.EFSClient = efsMock
+
+		efsMock.EXPECT().DescribeFileSystems(&efs.DescribeFileSystemsInput{FileSystemId: aws.String(testFileSystem)}).
+			Return(nil, awserr.New(efs.ErrCodeFileSystemNotFound, "not found", nil))
+		efsMock.EXPECT().DescribeFileSystems(&efs.DescribeFileSystemsInput{CreationToken: aws.String(testClusterName)}).
+			Return(&efs.DescribeFileSystemsOutput{}, nil)
+
+		g.Expect(s.DeleteEFS()).To(Succeed())
+		g.Expect(clusterScope.AWSCluster.Status.EFS).To(BeNil())
+	})
+
+	t.Run("deletes only the mount targets of the cluster from an existing file system", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		efsMock := mock_efsiface.NewMockEFSAPI(mockCtrl)
+
+		clusterScope := newClusterScope(t, &infrav1.EFSSpec{FileSystemID: testFileSystem}, subnets, nil)
+
+		s := NewService(clusterScope)
+		s.EFSClient = efsMock
+
+		efsMock.EXPECT().DescribeMountTargets(&efs.DescribeMountTargetsInput{FileSystemId: aws.String(testFileSystem)}).
+			Return(&efs.DescribeMountTargetsOutput{MountTargets: []*efs.MountTargetDescription{
+				{MountTargetId: aws.String("fsmt-a"), SubnetId: aws.String("subnet-private-a")},
+				{MountTargetId: aws.String("fsmt-b"), SubnetId: aws.String("subnet-private-b")},
+				{MountTargetId: aws.String("fsmt-other"), SubnetId: aws.String("subnet-other-cluster")},
+			}}, nil)
+		efsMock.EXPECT().DescribeMountTargetSecurityGroups(&efs.DescribeMountTargetSecurityGroupsInput{MountTargetId: aws.String("fsmt-a")}).
+			Return(&efs.DescribeMountTargetSecurityGroupsOutput{SecurityGroups: aws.StringSlice([]string{testEFSGroup})}, nil)
+		efsMock.EXPECT().DescribeMountTargetSecurityGroups(&efs.DescribeMountTargetSecurityGroupsInput{MountTargetId: aws.String("fsmt-b")}).
+			Return(&efs.DescribeMountTargetSecurityGroupsOutput{SecurityGroups: aws.StringSlice([]string{"sg-shared"})}, nil)
+		efsMock.EXPECT().DeleteMountTarget(&efs.DeleteMountTargetInput{MountTargetId: aws.String("fsmt-a")}).
+			Return(&efs.DeleteMountTargetOutput{}, nil)
+
+		g.Expect(s.DeleteEFS()).To(Succeed())
+	})
+}
+
+func availableFileSystem(owned bool) *efs.FileSystemDescription {
+	fs := &efs.FileSystemDescription{
+		FileSystemId:   aws.String(testFileSystem),
+		CreationToken:  aws.String(testClusterName),
+		LifeCycleState: aws.String(efs.LifeCycleStateAvailable),
+	}
+	if owned {
+		fs.Tags = []*efs.Tag{{
+			Key:   aws.String(infrav1.ClusterTagKey(testClusterName)),
+			Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+		}}
+	}
+	return fs
+}
+
+func newClusterScope(t *testing.T, spec *infrav1.EFSSpec, subnets infrav1.Subnets, securityGroups map[infrav1.SecurityGroupRole]infrav1.SecurityGroup) *scope.ClusterScope {
+	t.Helper()
+
+	if securityGroups == nil {
+		securityGroups = map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+			infrav1.SecurityGroupEFS: {ID: testEFSGroup},
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: testClusterName, Namespace: "default"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: testClusterName, Namespace: "default"},
+			Spec: infrav1.AWSClusterSpec{
+				EFS: spec,
+				NetworkSpec: infrav1.NetworkSpec{
+					Subnets: subnets,
+				},
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					SecurityGroups: securityGroups,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
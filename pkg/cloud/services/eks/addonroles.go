@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const (
+	ebsCSIDriverAddonName = "aws-ebs-csi-driver"
+	efsCSIDriverAddonName = "aws-efs-csi-driver"
+)
+
+// addonServiceAccountRole describes the IAM role an addon needs for its service accounts.
+type addonServiceAccountRole struct {
+	// serviceAccount is the name (or pattern) of the service accounts in kube-system that assume the role.
+	serviceAccount string
+	// policy is the name of the AWS managed policy attached to the role.
+	policy string
+}
+
+// addonServiceAccountRoles are the addons for which an IAM role for service accounts
+// is created when the addon doesn't specify one.
+var addonServiceAccountRoles = map[string]addonServiceAccountRole{
+	ebsCSIDriverAddonName: {
+		serviceAccount: "ebs-csi-controller-sa",
+		policy:         "service-role/AmazonEBSCSIDriverPolicy",
+	},
+	efsCSIDriverAddonName: {
+		serviceAccount: "efs-csi-*",
+		policy:         "service-role/AmazonEFSCSIDriverPolicy",
+	},
+}
+
+// addonServiceAccountRolesEnabled returns whether CAPA can manage IAM roles for the addons, this requires
+// IAM to be enabled and an OIDC provider to be associated with the cluster.
+func (s *Service) addonServiceAccountRolesEnabled() bool {
+	return s.scope.EnableIAM() &&
+		s.scope.ControlPlane.Spec.AssociateOIDCProvider &&
+		s.scope.ControlPlane.Status.OIDCProvider.ARN != ""
+}
+
+// addonsWithoutServiceAccountRole returns the addons that need an IAM role for their service accounts and don't
+// specify one, when CAPA can't create it because EKS IAM or the OIDC provider are disabled. The addons whose
+// service accounts have a pod identity association don't need the role.
+func (s *Service) addonsWithoutServiceAccountRole() []string {
+	if s.scope.EnableIAM() && s.scope.ControlPlane.Spec.AssociateOIDCProvider {
+		return nil
+	}
+
+	var addonNames []string
+	for _, addon := range s.scope.Addons() {
+		role, ok := addonServiceAccountRoles[addon.Name]
+		if !ok || addon.ServiceAccountRoleArn != nil || s.hasPodIdentityAssociation(role.serviceAccount) {
+			continue
+		}
+		addonNames = append(addonNames, addon.Name)
+	}
+
+	return addonNames
+}
+
+// hasPodIdentityAssociation returns whether a pod identity association exists for the kube-system service accounts
+// matching the given name pattern.
+func (s *Service) hasPodIdentityAssociation(serviceAccount string) bool {
+	for _, association := range s.scope.ControlPlane.Spec.PodIdentityAssociations {
+		if association.ServiceAccountNamespace != metav1.NamespaceSystem {
+			continue
+		}
+		if matched, _ := path.Match(serviceAccount, association.ServiceAccountName); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Service) addonServiceAccountRoleName(addonName string) (string, error) {
+	roleName, err := eks.GenerateEKSName(addonName, s.scope.KubernetesClusterName(), maxIAMRoleNameLength)
+	if err != nil {
+		return "", fmt.Errorf("generating role name for addon %s: %w", addonName, err)
+	}
+	return roleName, nil
+}
+
+// reconcileAddonServiceAccountRoles creates or updates the IAM roles for the desired addons that need
+// one and don't specify it. It returns the role ARNs keyed by addon name.
+func (s *Service) reconcileAddonServiceAccountRoles() (map[string]string, error) {
+	roleARNs := map[string]string{}
+	if !s.addonServiceAccountRolesEnabled() {
+		return roleARNs, nil
+	}
+
+	for _, addon := range s.scope.Addons() {
+		if addon.ServiceAccountRoleArn != nil {
+			continue
+		}
+		if _, ok := addonServiceAccountRoles[addon.Name]; !ok {
+			continue
+		}
+
+		roleARN, err := s.reconcileAddonServiceAccountRole(addon.Name)
+		if err != nil {
+			return nil, err
+		}
+		roleARNs[addon.Name] = roleARN
+	}
+
+	return roleARNs, nil
+}
+
+func (s *Service) reconcileAddonServiceAccountRole(addonName string) (string, error) {
+	roleName, err := s.addonServiceAccountRoleName(addonName)
+	if err != nil {
+		return "", err
+	}
+	trustPolicy := s.buildAddonTrustPolicy(addonServiceAccountRoles[addonName].serviceAccount)
+	s.scope.Debug("Reconciling EKS addon IAM role", "addon", addonName, "role-name", roleName)
+
+	role, err := s.GetIAMRole(roleName)
+	if err != nil {
+		if !isNotFound(err) {
+			return "", fmt.Errorf("getting role %s for addon %s: %w", roleName, addonName, err)
+		}
+
+		role, err = s.CreateRole(roleName, s.scope.Name(), trustPolicy, s.scope.AdditionalTags())
+		if err != nil {
+			record.Warnf(s.scope.ControlPlane, "FailedIAMRoleCreation", "Failed to create IAM role %q for addon %s: %v", roleName, addonName, err)
+			return "", fmt.Errorf("creating role %s for addon %s: %w", roleName, addonName, err)
+		}
+		record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleCreation", "Created IAM role %q for addon %s", roleName, addonName)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) {
+		s.scope.Debug("Skipping, EKS addon role policy assignment as role is unmanaged", "addon", addonName)
+		return aws.StringValue(role.Arn), nil
+	}
+
+	if _, err := s.EnsureTagsAndPolicy(role, s.scope.Name(), trustPolicy, s.scope.AdditionalTags()); err != nil {
+		return "", fmt.Errorf("ensuring tags and trust policy on role %s: %w", roleName, err)
+	}
+
+	policies := []*string{
+		aws.String(fmt.Sprintf("arn:%s:iam::aws:policy/%s", s.scope.Partition(), addonServiceAccountRoles[addonName].policy)),
+	}
+	if _, err := s.EnsurePoliciesAttached(role, policies); err != nil {
+		return "", fmt.Errorf("ensuring policies are attached to role %s: %w", roleName, err)
+	}
+
+	return aws.StringValue(role.Arn), nil
+}
+
+// deleteAddonServiceAccountRoles deletes the IAM roles CAPA created for addons, except for the ones in use.
+func (s *Service) deleteAddonServiceAccountRoles(inUse map[string]string) error {
+	if !s.addonServiceAccountRolesEnabled() {
+		return nil
+	}
+
+	addonNames := make([]string, 0, len(addonServiceAccountRoles))
+	for addonName := range addonServiceAccountRoles {
+		addonNames = append(addonNames, addonName)
+	}
+	sort.Strings(addonNames)
+
+	for _, addonName := range addonNames {
+		if _, ok := inUse[addonName]; ok {
+			continue
+		}
+		if err := s.deleteAddonServiceAccountRole(addonName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) deleteAddonServiceAccountRole(addonName string) error {
+	roleName, err := s.addonServiceAccountRoleName(addonName)
+	if err != nil {
+		return err
+	}
+
+	role, err := s.GetIAMRole(roleName)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("getting role %s for addon %s: %w", roleName, addonName, err)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) {
+		s.scope.Debug("Skipping, EKS addon iam role deletion as role is unmanaged", "addon", addonName)
+		return nil
+	}
+
+	if err := s.DeleteRole(roleName); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedIAMRoleDeletion", "Failed to delete IAM role %q for addon %s: %v", roleName, addonName, err)
+		return err
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleDeletion", "Deleted IAM role %q for addon %s", roleName, addonName)
+
+	return nil
+}
+
+// buildAddonTrustPolicy returns a trust policy allowing the given kube-system service accounts to assume
+// the role through the cluster's OIDC provider.
+func (s *Service) buildAddonTrustPolicy(serviceAccount string) *iamv1.PolicyDocument {
+	providerARN := s.scope.ControlPlane.Status.OIDCProvider.ARN
+	issuer := providerARN[strings.Index(providerARN, "/")+1:]
+
+	return &iamv1.PolicyDocument{
+		Version: "2012-10-17",
+		Statement: iamv1.Statements{
+			iamv1.StatementEntry{
+				Effect: iamv1.EffectAllow,
+				Principal: iamv1.Principals{
+					iamv1.PrincipalFederated: iamv1.PrincipalID{providerARN},
+				},
+				Action: iamv1.Actions{"sts:AssumeRoleWithWebIdentity"},
+				Condition: iamv1.Conditions{
+					iamv1.StringEquals: map[string]interface{}{
+						issuer + ":aud": "sts.amazonaws.com",
+					},
+					iamv1.StringLike: map[string]interface{}{
+						issuer + ":sub": "system:serviceaccount:kube-system:" + serviceAccount,
+					},
+				},
+			},
+		},
+	}
+}
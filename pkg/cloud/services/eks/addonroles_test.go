@@ -0,0 +1,350 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/converters"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	testAddonOIDCProviderARN = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/ABCDEF"
+	testEBSRoleName          = "default.cluster_aws-ebs-csi-driver"
+	testEFSRoleName          = "default.cluster_aws-efs-csi-driver"
+)
+
+func TestReconcileAddonServiceAccountRoles(t *testing.T) {
+	ownedTags := []*iam.Tag{
+		{Key: aws.String(infrav1.ClusterAWSCloudProviderTagKey("capi-cluster")), Value: aws.String(string(infrav1.ResourceLifecycleOwned))},
+	}
+	ebsRoleARN := "arn:aws:iam::123456789012:role/" + testEBSRoleName
+	noSuchEntity := awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil)
+
+	tests := []struct {
+		name        string
+		addons      []ekscontrolplanev1.Addon
+		disableIAM  bool
+		expect      func(s *Service, m *mock_iamauth.MockIAMAPIMockRecorder)
+		expectARNs  map[string]string
+		expectError bool
+	}{
+		{
+			name:   "creates the role of an addon without one",
+			addons: []ekscontrolplanev1.Addon{{Name: ebsCSIDriverAddonName}},
+			expect: func(s *Service, m *mock_iamauth.MockIAMAPIMockRecorder) {
+				trustPolicy, _ := converters.IAMPolicyDocumentToJSON(*s.buildAddonTrustPolicy("ebs-csi-controller-sa"))
+				m.GetRole(&iam.GetRoleInput{RoleName: aws.String(testEBSRoleName)}).Return(nil, noSuchEntity)
+				m.CreateRole(gomock.Any()).DoAndReturn(func(input *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
+					if *input.AssumeRolePolicyDocument != trustPolicy {
+						t.Errorf("unexpected trust policy %s", *input.AssumeRolePolicyDocument)
+					}
+					return &iam.CreateRoleOutput{Role: &iam.Role{
+						Arn:                      aws.String(ebsRoleARN),
+						RoleName:                 input.RoleName,
+						AssumeRolePolicyDocument: input.AssumeRolePolicyDocument,
+						Tags:                     ownedTags,
+					}}, nil
+				})
+				m.ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{RoleName: aws.String(testEBSRoleName)}).Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+				m.GetPolicy(&iam.GetPolicyInput{PolicyArn: aws.String("arn:aws:iam::aws:policy/service-role/AmazonEBSCSIDriverPolicy")}).Return(&iam.GetPolicyOutput{}, nil)
+				m.AttachRolePolicy(&iam.AttachRolePolicyInput{
+					RoleName:  aws.String(testEBSRoleName),
+					PolicyArn: aws.String("arn:aws:iam::aws:policy/service-role/AmazonEBSCSIDriverPolicy"),
+				}).Return(&iam.AttachRolePolicyOutput{}, nil)
+			},
+			expectARNs: map[string]string{ebsCSIDriverAddonName: ebsRoleARN},
+		},
+		{
+			name:   "uses an existing unmanaged role as is",
+			addons: []ekscontrolplanev1.Addon{{Name: ebsCSIDriverAddonName}},
+			expect: func(s *Service, m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRole(&iam.GetRoleInput{RoleName: aws.String(testEBSRoleName)}).Return(&iam.GetRoleOutput{Role: &iam.Role{
+					Arn:      aws.String(ebsRoleARN),
+					RoleName: aws.String(testEBSRoleName),
+				}}, nil)
+			},
+			expectARNs: map[string]string{ebsCSIDriverAddonName: ebsRoleARN},
+		},
+		{
+			name: "skips addons with a role or without a known role",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: ebsCSIDriverAddonName, ServiceAccountRoleArn: aws.String("arn:aws:iam::123456789012:role/custom")},
+				{Name: vpcCniAddonName},
+			},
+			expectARNs: map[string]string{},
+		},
+		{
+			name:       "skips roles when IAM is disabled",
+			addons:     []ekscontrolplanev1.Addon{{Name: ebsCSIDriverAddonName}},
+			disableIAM: true,
+			expectARNs: map[string]string{},
+		},
+		{
+			name:   "fails when the role can't be created",
+			addons: []ekscontrolplanev1.Addon{{Name: efsCSIDriverAddonName}},
+			expect: func(s *Service, m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRole(&iam.GetRoleInput{RoleName: aws.String(testEFSRoleName)}).Return(nil, noSuchEntity)
+				m.CreateRole(gomock.Any()).Return(nil, awserr.New(iam.ErrCodeLimitExceededException, "limit exceeded", nil))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+			s := NewService(newAddonRolesTestScope(g, tc.addons, !tc.disableIAM))
+			s.IAMClient = iamMock
+			if tc.expect != nil {
+				tc.expect(s, iamMock.EXPECT())
+			}
+
+			roleARNs, err := s.reconcileAddonServiceAccountRoles()
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(roleARNs).To(Equal(tc.expectARNs))
+		})
+	}
+}
+
+func TestDeleteAddonServiceAccountRoles(t *testing.T) {
+	ownedTags := []*iam.Tag{
+		{Key: aws.String(infrav1.ClusterAWSCloudProviderTagKey("capi-cluster")), Value: aws.String(string(infrav1.ResourceLifecycleOwned))},
+	}
+	noSuchEntity := awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil)
+
+	tests := []struct {
+		name   string
+		inUse  map[string]string
+		expect func(m *mock_iamauth.MockIAMAPIMockRecorder)
+	}{
+		{
+			name:  "deletes the owned roles of addons no longer in use",
+			inUse: map[string]string{ebsCSIDriverAddonName: "arn:aws:iam::123456789012:role/" + testEBSRoleName},
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRole(&iam.GetRoleInput{RoleName: aws.String(testEFSRoleName)}).Return(&iam.GetRoleOutput{Role: &iam.Role{
+					RoleName: aws.String(testEFSRoleName),
+					Tags:     ownedTags,
+				}}, nil)
+				m.ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{RoleName: aws.String(testEFSRoleName)}).Return(&iam.ListAttachedRolePoliciesOutput{
+					AttachedPolicies: []*iam.AttachedPolicy{{PolicyArn: aws.String("arn:aws:iam::aws:policy/service-role/AmazonEFSCSIDriverPolicy")}},
+				}, nil)
+				m.DetachRolePolicy(&iam.DetachRolePolicyInput{
+					RoleName:  aws.String(testEFSRoleName),
+					PolicyArn: aws.String("arn:aws:iam::aws:policy/service-role/AmazonEFSCSIDriverPolicy"),
+				}).Return(&iam.DetachRolePolicyOutput{}, nil)
+				m.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String(testEFSRoleName)}).Return(&iam.DeleteRoleOutput{}, nil)
+			},
+		},
+		{
+			name: "leaves missing and unmanaged roles alone",
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRole(&iam.GetRoleInput{RoleName: aws.String(testEBSRoleName)}).Return(nil, noSuchEntity)
+				m.GetRole(&iam.GetRoleInput{RoleName: aws.String(testEFSRoleName)}).Return(&iam.GetRoleOutput{Role: &iam.Role{
+					RoleName: aws.String(testEFSRoleName),
+				}}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+			tc.expect(iamMock.EXPECT())
+			s := NewService(newAddonRolesTestScope(g, nil, true))
+			s.IAMClient = iamMock
+
+			g.Expect(s.deleteAddonServiceAccountRoles(tc.inUse)).To(Succeed())
+		})
+	}
+}
+
+func TestAddonsWithoutServiceAccountRole(t *testing.T) {
+	addons := []ekscontrolplanev1.Addon{
+		{Name: ebsCSIDriverAddonName},
+		{Name: efsCSIDriverAddonName},
+		{Name: vpcCniAddonName},
+	}
+
+	tests := []struct {
+		name                    string
+		addons                  []ekscontrolplanev1.Addon
+		disableIAM              bool
+		disableOIDC             bool
+		podIdentityAssociations []ekscontrolplanev1.PodIdentityAssociation
+		expected                []string
+	}{
+		{
+			name:   "returns nothing when the roles can be created",
+			addons: addons,
+		},
+		{
+			name:        "returns the addons needing a role when the OIDC provider is disabled",
+			addons:      addons,
+			disableOIDC: true,
+			expected:    []string{ebsCSIDriverAddonName, efsCSIDriverAddonName},
+		},
+		{
+			name:       "returns the addons needing a role when IAM is disabled",
+			addons:     addons,
+			disableIAM: true,
+			expected:   []string{ebsCSIDriverAddonName, efsCSIDriverAddonName},
+		},
+		{
+			name: "skips the addons with a role or a pod identity association",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: ebsCSIDriverAddonName, ServiceAccountRoleArn: aws.String("arn:aws:iam::123456789012:role/custom")},
+				{Name: efsCSIDriverAddonName},
+			},
+			disableOIDC: true,
+			podIdentityAssociations: []ekscontrolplanev1.PodIdentityAssociation{{
+				ServiceAccountNamespace: "kube-system",
+				ServiceAccountName:      "efs-csi-controller-sa",
+				RoleARN:                 "arn:aws:iam::123456789012:role/efs",
+			}},
+		},
+		{
+			name:        "ignores pod identity associations in other namespaces",
+			addons:      []ekscontrolplanev1.Addon{{Name: ebsCSIDriverAddonName}},
+			disableOIDC: true,
+			podIdentityAssociations: []ekscontrolplanev1.PodIdentityAssociation{{
+				ServiceAccountNamespace: "default",
+				ServiceAccountName:      "ebs-csi-controller-sa",
+				RoleARN:                 "arn:aws:iam::123456789012:role/ebs",
+			}},
+			expected: []string{ebsCSIDriverAddonName},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			controlPlaneScope := newAddonRolesTestScope(g, tc.addons, !tc.disableIAM)
+			controlPlaneScope.ControlPlane.Spec.AssociateOIDCProvider = !tc.disableOIDC
+			controlPlaneScope.ControlPlane.Spec.PodIdentityAssociations = tc.podIdentityAssociations
+			s := NewService(controlPlaneScope)
+
+			g.Expect(s.addonsWithoutServiceAccountRole()).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestReconcileAddonsBindsServiceAccountRole(t *testing.T) {
+	g := NewWithT(t)
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	ebsRoleARN := "arn:aws:iam::123456789012:role/" + testEBSRoleName
+	noSuchEntity := awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil)
+
+	iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+	eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+	s := NewService(newAddonRolesTestScope(g, []ekscontrolplanev1.Addon{{
+		Name:               ebsCSIDriverAddonName,
+		Version:            "v1.30.0-eksbuild.1",
+		ConflictResolution: &ekscontrolplanev1.AddonResolutionOverwrite,
+	}}, true))
+	s.IAMClient = iamMock
+	s.EKSClient = eksMock
+
+	iamMock.EXPECT().GetRole(&iam.GetRoleInput{RoleName: aws.String(testEBSRoleName)}).Return(&iam.GetRoleOutput{Role: &iam.Role{
+		Arn:      aws.String(ebsRoleARN),
+		RoleName: aws.String(testEBSRoleName),
+	}}, nil)
+	iamMock.EXPECT().GetRole(&iam.GetRoleInput{RoleName: aws.String(testEFSRoleName)}).Return(nil, noSuchEntity)
+
+	eksMock.EXPECT().ListAddons(gomock.Any()).Return(&eks.ListAddonsOutput{}, nil)
+	eksMock.EXPECT().CreateAddon(gomock.Any()).DoAndReturn(func(input *eks.CreateAddonInput) (*eks.CreateAddonOutput, error) {
+		g.Expect(input.AddonName).To(Equal(aws.String(ebsCSIDriverAddonName)))
+		g.Expect(input.ServiceAccountRoleArn).To(Equal(aws.String(ebsRoleARN)))
+		return &eks.CreateAddonOutput{Addon: &eks.Addon{AddonName: input.AddonName}}, nil
+	})
+	eksMock.EXPECT().DescribeAddon(gomock.Any()).Return(&eks.DescribeAddonOutput{Addon: &eks.Addon{
+		AddonName: aws.String(ebsCSIDriverAddonName),
+		Status:    aws.String(eks.AddonStatusActive),
+	}}, nil)
+
+	g.Expect(s.reconcileAddons(context.TODO())).To(Succeed())
+}
+
+func newAddonRolesTestScope(g *WithT, addons []ekscontrolplanev1.Addon, enableIAM bool) *scope.ManagedControlPlaneScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "capi-cluster-control-plane",
+			Namespace: "ns",
+		},
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			EKSClusterName:        "default.cluster",
+			AssociateOIDCProvider: true,
+			Addons:                &addons,
+		},
+		Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+			OIDCProvider: ekscontrolplanev1.OIDCProviderStatus{
+				ARN: testAddonOIDCProviderARN,
+			},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlane).WithStatusSubresource(controlPlane).Build()
+
+	controlPlaneScope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "capi-cluster",
+			},
+		},
+		ControlPlane: controlPlane,
+		EnableIAM:    enableIAM,
+	})
+	g.Expect(err).To(BeNil())
+	return controlPlaneScope
+}
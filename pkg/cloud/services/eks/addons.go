@@ -18,18 +18,28 @@ package eks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/awsnode"
 	eksaddons "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/addons"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 )
 
+const (
+	vpcCniAddonName    = "vpc-cni"
+	coreDNSAddonName   = "coredns"
+	kubeProxyAddonName = "kube-proxy"
+)
+
 func (s *Service) reconcileAddons(ctx context.Context) error {
 	s.scope.Info("Reconciling EKS addons")
 
@@ -50,12 +60,38 @@ func (s *Service) reconcileAddons(ctx context.Context) error {
 	}
 
 	// Get the addons from the spec we want for the cluster
-	desiredAddons := s.translateAPIToAddon(s.scope.Addons())
+	desiredAddons, err := s.translateAPIToAddon(s.scope.Addons())
+	if err != nil {
+		return fmt.Errorf("translating eks addons: %w", err)
+	}
+
+	// Bind the IAM roles created for addons that need one and don't specify it
+	addonRoleARNs, err := s.reconcileAddonServiceAccountRoles()
+	if err != nil {
+		return fmt.Errorf("reconciling eks addon iam roles: %w", err)
+	}
+	for _, addon := range desiredAddons {
+		if roleARN, ok := addonRoleARNs[*addon.Name]; ok && addon.ServiceAccountRoleARN == nil {
+			addon.ServiceAccountRoleARN = aws.String(roleARN)
+		}
+	}
+
+	// Pod identity associations need the pod identity agent, install it if it isn't specified
+	podIdentityAgent, err := s.podIdentityAgentAddon(desiredAddons, installed)
+	if err != nil {
+		return fmt.Errorf("getting eks pod identity agent addon: %w", err)
+	}
+	if podIdentityAgent != nil {
+		desiredAddons = append(desiredAddons, podIdentityAgent)
+	}
+
+	// Leave the default addons that aren't managed untouched, and don't recreate the removed ones
+	desiredAddons, installed = s.filterDefaultAddons(desiredAddons, installed)
 
 	// If there are no addons desired or installed then do nothing
 	if len(installed) == 0 && len(desiredAddons) == 0 {
 		s.scope.Info("no addons installed and no addons to install, no action needed")
-		return nil
+		return s.deleteAddonServiceAccountRoles(addonRoleARNs)
 	}
 
 	//  Compute operations to move installed to desired
@@ -77,6 +113,11 @@ func (s *Service) reconcileAddons(ctx context.Context) error {
 		}
 	}
 
+	// Remove the IAM roles of addons that are no longer desired
+	if err := s.deleteAddonServiceAccountRoles(addonRoleARNs); err != nil {
+		return fmt.Errorf("deleting unused eks addon iam roles: %w", err)
+	}
+
 	// Update status with addons installed details
 	// Note: we are not relying on the computed state from the operations as we still want
 	// to update the state even if there are no operations to capture things like status changes
@@ -97,6 +138,36 @@ func (s *Service) reconcileAddons(ctx context.Context) error {
 	return nil
 }
 
+// filterDefaultAddons applies the management of the default addons to the desired and installed addons: an
+// addon that isn't managed is neither installed, updated nor deleted, and an addon that is removed is never
+// installed, so it is deleted if installed.
+func (s *Service) filterDefaultAddons(desired, installed []*eksaddons.EKSAddon) ([]*eksaddons.EKSAddon, []*eksaddons.EKSAddon) {
+	defaultAddons := map[string]ekscontrolplanev1.DefaultAddon{
+		coreDNSAddonName:   s.scope.DefaultAddons().CoreDNS,
+		kubeProxyAddonName: s.scope.DefaultAddons().KubeProxy,
+	}
+
+	filteredDesired := []*eksaddons.EKSAddon{}
+	for _, addon := range desired {
+		if defaultAddon, ok := defaultAddons[*addon.Name]; ok && (defaultAddon.Remove || !defaultAddon.IsManaged()) {
+			s.scope.Debug("Not reconciling removed or unmanaged eks addon", "addon", *addon.Name)
+			continue
+		}
+		filteredDesired = append(filteredDesired, addon)
+	}
+
+	filteredInstalled := []*eksaddons.EKSAddon{}
+	for _, addon := range installed {
+		if defaultAddon, ok := defaultAddons[*addon.Name]; ok && !defaultAddon.IsManaged() {
+			s.scope.Debug("Leaving unmanaged eks addon untouched", "addon", *addon.Name)
+			continue
+		}
+		filteredInstalled = append(filteredInstalled, addon)
+	}
+
+	return filteredDesired, filteredInstalled
+}
+
 func (s *Service) getClusterAddonsInstalled(eksClusterName string, addonNames []*string) ([]*eksaddons.EKSAddon, error) {
 	s.Debug("getting eks addons installed")
 
@@ -189,15 +260,32 @@ func (s *Service) listAddons(eksClusterName string) ([]*string, error) {
 	return addons, nil
 }
 
-func (s *Service) translateAPIToAddon(addons []ekscontrolplanev1.Addon) []*eksaddons.EKSAddon {
+func (s *Service) translateAPIToAddon(addons []ekscontrolplanev1.Addon) ([]*eksaddons.EKSAddon, error) {
 	converted := []*eksaddons.EKSAddon{}
 
 	for i := range addons {
 		addon := addons[i]
+		configuration := addon.Configuration
+		if addon.Name == vpcCniAddonName {
+			env := []corev1.EnvVar{}
+			if s.scope.VpcCni().IsCustomNetworkingEnabled() {
+				env = append(env, awsnode.CustomNetworkingEnv()...)
+			}
+			if s.scope.IPFamily() == ekscontrolplanev1.IPFamilyIPv6 {
+				env = append(env, awsnode.IPv6Env()...)
+			}
+			if len(env) > 0 {
+				var err error
+				if configuration, err = vpcCniConfiguration(configuration, env); err != nil {
+					return nil, fmt.Errorf("configuring addon %s: %w", addon.Name, err)
+				}
+			}
+		}
+
 		convertedAddon := &eksaddons.EKSAddon{
 			Name:                  &addon.Name,
 			Version:               &addon.Version,
-			Configuration:         &addon.Configuration,
+			Configuration:         &configuration,
 			Tags:                  ngTags(s.scope.Cluster.Name, s.scope.AdditionalTags()),
 			ResolveConflict:       convertConflictResolution(*addon.ConflictResolution),
 			ServiceAccountRoleARN: addon.ServiceAccountRoleArn,
@@ -206,7 +294,39 @@ func (s *Service) translateAPIToAddon(addons []ekscontrolplanev1.Addon) []*eksad
 		converted = append(converted, convertedAddon)
 	}
 
-	return converted
+	return converted, nil
+}
+
+// vpcCniConfiguration adds aws-node environment variables, e.g. the ones that enable custom networking,
+// to the configuration values of the vpc-cni addon. Environment variables already set in the configuration
+// are left untouched.
+func vpcCniConfiguration(configuration string, envVars []corev1.EnvVar) (string, error) {
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(configuration), &values); err != nil {
+		return "", fmt.Errorf("parsing configuration values: %w", err)
+	}
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+
+	env := map[string]interface{}{}
+	if existing, ok := values["env"]; ok {
+		if env, ok = existing.(map[string]interface{}); !ok {
+			return "", fmt.Errorf("configuration values field env must be an object")
+		}
+	}
+	for _, e := range envVars {
+		if _, ok := env[e.Name]; !ok {
+			env[e.Name] = e.Value
+		}
+	}
+	values["env"] = env
+
+	out, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("marshalling configuration values: %w", err)
+	}
+	return string(out), nil
 }
 
 func convertConflictResolution(conflict ekscontrolplanev1.AddonResolution) *string {
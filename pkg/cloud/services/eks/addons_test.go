@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/awsnode"
+	eksaddons "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/addons"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestVpcCniConfiguration(t *testing.T) {
+	testCases := []struct {
+		name          string
+		configuration string
+		env           []corev1.EnvVar
+		expect        string
+		expectError   bool
+	}{
+		{
+			name:   "empty configuration",
+			expect: `{"env":{"AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG":"true","ENI_CONFIG_LABEL_DEF":"topology.kubernetes.io/zone"}}`,
+		},
+		{
+			name:          "json configuration is merged",
+			configuration: `{"env":{"WARM_IP_TARGET":"5"},"nodeAgent":{"enabled":"true"}}`,
+			expect:        `{"env":{"AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG":"true","ENI_CONFIG_LABEL_DEF":"topology.kubernetes.io/zone","WARM_IP_TARGET":"5"},"nodeAgent":{"enabled":"true"}}`,
+		},
+		{
+			name:          "yaml configuration is merged",
+			configuration: "env:\n  WARM_IP_TARGET: \"5\"\n",
+			expect:        `{"env":{"AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG":"true","ENI_CONFIG_LABEL_DEF":"topology.kubernetes.io/zone","WARM_IP_TARGET":"5"}}`,
+		},
+		{
+			name:          "environment variables set by the user are kept",
+			configuration: `{"env":{"ENI_CONFIG_LABEL_DEF":"example.com/eniconfig"}}`,
+			expect:        `{"env":{"AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG":"true","ENI_CONFIG_LABEL_DEF":"example.com/eniconfig"}}`,
+		},
+		{
+			name:   "ipv6 and custom networking",
+			env:    append(awsnode.CustomNetworkingEnv(), awsnode.IPv6Env()...),
+			expect: `{"env":{"AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG":"true","ENI_CONFIG_LABEL_DEF":"topology.kubernetes.io/zone","ENABLE_IPv6":"true","ENABLE_IPv4":"false","ENABLE_PREFIX_DELEGATION":"true"}}`,
+		},
+		{
+			name:          "invalid env field",
+			configuration: `{"env":["WARM_IP_TARGET=5"]}`,
+			expectError:   true,
+		},
+		{
+			name:          "invalid configuration",
+			configuration: `{"env":`,
+			expectError:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			env := tc.env
+			if env == nil {
+				env = awsnode.CustomNetworkingEnv()
+			}
+			configuration, err := vpcCniConfiguration(tc.configuration, env)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(configuration).To(MatchJSON(tc.expect))
+		})
+	}
+}
+
+func TestFilterDefaultAddons(t *testing.T) {
+	addon := func(name string) *eksaddons.EKSAddon {
+		return &eksaddons.EKSAddon{
+			Name:    aws.String(name),
+			Version: aws.String("v1.0.0"),
+			Status:  aws.String(eks.AddonStatusActive),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		defaultAddons ekscontrolplanev1.DefaultAddons
+		desired       []*eksaddons.EKSAddon
+		installed     []*eksaddons.EKSAddon
+		procedures    []string
+	}{
+		{
+			name:       "managed addon that isn't desired is deleted",
+			desired:    []*eksaddons.EKSAddon{},
+			installed:  []*eksaddons.EKSAddon{addon("kube-proxy")},
+			procedures: []string{"addon_delete", "addon_wait_delete"},
+		},
+		{
+			name: "unmanaged addon is left untouched",
+			defaultAddons: ekscontrolplanev1.DefaultAddons{
+				KubeProxy: ekscontrolplanev1.DefaultAddon{Manage: aws.Bool(false)},
+			},
+			desired:    []*eksaddons.EKSAddon{},
+			installed:  []*eksaddons.EKSAddon{addon("kube-proxy")},
+			procedures: []string{},
+		},
+		{
+			name: "unmanaged addon that isn't installed is not installed",
+			defaultAddons: ekscontrolplanev1.DefaultAddons{
+				CoreDNS: ekscontrolplanev1.DefaultAddon{Manage: aws.Bool(false)},
+			},
+			desired:    []*eksaddons.EKSAddon{addon("coredns")},
+			installed:  []*eksaddons.EKSAddon{},
+			procedures: []string{},
+		},
+		{
+			name: "removed addon is deleted",
+			defaultAddons: ekscontrolplanev1.DefaultAddons{
+				CoreDNS:   ekscontrolplanev1.DefaultAddon{Remove: true},
+				KubeProxy: ekscontrolplanev1.DefaultAddon{Remove: true},
+			},
+			desired:    []*eksaddons.EKSAddon{addon("kube-proxy"), addon("vpc-cni")},
+			installed:  []*eksaddons.EKSAddon{addon("kube-proxy"), addon("coredns"), addon("vpc-cni")},
+			procedures: []string{"addon_delete", "addon_wait_delete", "addon_delete", "addon_wait_delete"},
+		},
+		{
+			name: "removed addon is not recreated once deleted",
+			defaultAddons: ekscontrolplanev1.DefaultAddons{
+				CoreDNS:   ekscontrolplanev1.DefaultAddon{Remove: true},
+				KubeProxy: ekscontrolplanev1.DefaultAddon{Remove: true},
+			},
+			desired:    []*eksaddons.EKSAddon{addon("kube-proxy"), addon("vpc-cni")},
+			installed:  []*eksaddons.EKSAddon{addon("vpc-cni")},
+			procedures: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client:  fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "control-plane"},
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName: "eks-cluster",
+						DefaultAddons:  tc.defaultAddons,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			s := NewService(scope)
+
+			desired, installed := s.filterDefaultAddons(tc.desired, tc.installed)
+			procedures, err := eksaddons.NewPlan("eks-cluster", desired, installed, nil).Create(context.TODO())
+			g.Expect(err).NotTo(HaveOccurred())
+
+			names := []string{}
+			for _, procedure := range procedures {
+				names = append(names, procedure.Name())
+			}
+			g.Expect(names).To(Equal(tc.procedures))
+		})
+	}
+}
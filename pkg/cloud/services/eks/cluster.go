@@ -54,12 +54,17 @@ func (s *Service) reconcileCluster(ctx context.Context) error {
 		return errors.Wrap(err, "failed to describe eks clusters")
 	}
 
-	if cluster == nil {
+	switch {
+	case cluster == nil && s.scope.ControlPlane.Spec.ExternalManaged:
+		return errors.Errorf("externally managed EKS cluster %q not found", eksClusterName)
+	case cluster == nil:
 		cluster, err = s.createCluster(eksClusterName)
 		if err != nil {
 			return errors.Wrap(err, "failed to create cluster")
 		}
-	} else {
+	case s.scope.ControlPlane.Spec.ExternalManaged:
+		s.scope.Debug("Found externally managed EKS cluster in AWS", "cluster", klog.KRef("", eksClusterName))
+	default:
 		tagKey := infrav1.ClusterAWSCloudProviderTagKey(eksClusterName)
 		ownedTag := cluster.Tags[tagKey]
 		// Prior to https://github.com/kubernetes-sigs/cluster-api-provider-aws/pull/3573,
@@ -82,6 +87,10 @@ func (s *Service) reconcileCluster(ctx context.Context) error {
 	// Wait for our cluster to be ready if necessary
 	switch *cluster.Status {
 	case eks.ClusterStatusUpdating, eks.ClusterStatusCreating:
+		if !s.scope.UseBlockingWaiters() {
+			s.scope.Info("Waiting for EKS control plane to be active", "cluster", klog.KRef("", eksClusterName), "status", *cluster.Status)
+			return wait.NewPending(fmt.Sprintf("EKS control plane %q is in %s state", eksClusterName, *cluster.Status))
+		}
 		cluster, err = s.waitForClusterActive()
 	default:
 		break
@@ -113,29 +122,58 @@ func (s *Service) reconcileCluster(ctx context.Context) error {
 		return errors.Wrap(err, "failed reconciling additional kubeconfigs")
 	}
 
-	if err := s.reconcileClusterVersion(cluster); err != nil {
-		return errors.Wrap(err, "failed reconciling cluster version")
-	}
+	// The version, configuration, tags, OIDC provider and network of an externally managed cluster are left
+	// to the tool managing it, they are only recorded.
+	if s.scope.ControlPlane.Spec.ExternalManaged {
+		s.setExternalNetwork(cluster)
 
-	if err := s.reconcileClusterConfig(cluster); err != nil {
-		return errors.Wrap(err, "failed reconciling cluster config")
-	}
+		if err := s.reconcileExternalOIDCProvider(cluster); err != nil {
+			return errors.Wrap(err, "failed reconciling OIDC provider for cluster")
+		}
+	} else {
+		if err := s.reconcileClusterVersion(cluster); err != nil {
+			return errors.Wrap(err, "failed reconciling cluster version")
+		}
 
-	if err := s.reconcileEKSEncryptionConfig(cluster.EncryptionConfig); err != nil {
-		return errors.Wrap(err, "failed reconciling eks encryption config")
-	}
+		if err := s.reconcileClusterConfig(cluster); err != nil {
+			return errors.Wrap(err, "failed reconciling cluster config")
+		}
 
-	if err := s.reconcileTags(cluster); err != nil {
-		return errors.Wrap(err, "failed updating cluster tags")
-	}
+		if err := s.reconcileEKSEncryptionConfig(cluster.EncryptionConfig); err != nil {
+			return errors.Wrap(err, "failed reconciling eks encryption config")
+		}
+
+		if err := s.reconcileTags(cluster); err != nil {
+			return errors.Wrap(err, "failed updating cluster tags")
+		}
 
-	if err := s.reconcileOIDCProvider(cluster); err != nil {
-		return errors.Wrap(err, "failed reconciling OIDC provider for cluster")
+		if err := s.reconcileOIDCProvider(cluster); err != nil {
+			return errors.Wrap(err, "failed reconciling OIDC provider for cluster")
+		}
 	}
 
 	return nil
 }
 
+// setExternalNetwork records the IDs of the VPC and subnets of an externally managed cluster in the network spec,
+// for the network service to discover them.
+func (s *Service) setExternalNetwork(cluster *eks.Cluster) {
+	if cluster.ResourcesVpcConfig == nil {
+		return
+	}
+	s.scope.VPC().ID = aws.StringValue(cluster.ResourcesVpcConfig.VpcId)
+
+	subnets := make(infrav1.Subnets, 0, len(cluster.ResourcesVpcConfig.SubnetIds))
+	for _, id := range aws.StringValueSlice(cluster.ResourcesVpcConfig.SubnetIds) {
+		if sn := s.scope.Subnets().FindByID(id); sn != nil {
+			subnets = append(subnets, *sn)
+			continue
+		}
+		subnets = append(subnets, infrav1.SubnetSpec{ID: id, ResourceID: id})
+	}
+	s.scope.SetSubnets(subnets)
+}
+
 func (s *Service) setStatus(cluster *eks.Cluster) error {
 	switch *cluster.Status {
 	case eks.ClusterStatusDeleting:
@@ -164,6 +202,7 @@ func (s *Service) setStatus(cluster *eks.Cluster) error {
 	default:
 		return errors.Errorf("unexpected EKS cluster status %s", *cluster.Status)
 	}
+	s.scope.ControlPlane.Status.Version = cluster.Version
 	if err := s.scope.PatchObject(); err != nil {
 		return errors.Wrap(err, "failed to update control plane")
 	}
@@ -246,6 +285,25 @@ func makeEksEncryptionConfigs(encryptionConfig *ekscontrolplanev1.EncryptionConf
 	})
 }
 
+// encryptionConfig returns the encryption configuration of the cluster. The secrets are encrypted with the managed
+// key of the cluster, if any, unless the encryption configuration sets its own provider.
+func (s *Service) encryptionConfig() *ekscontrolplanev1.EncryptionConfig {
+	spec := s.scope.ControlPlane.Spec
+	keyARN := s.scope.ControlPlane.Status.ManagedEncryptionKeyARN
+	if spec.HasEncryptionProvider() || !spec.ManagedEncryptionKey || keyARN == "" {
+		return spec.EncryptionConfig
+	}
+
+	resources := []*string{aws.String("secrets")}
+	if spec.EncryptionConfig != nil && len(spec.EncryptionConfig.Resources) > 0 {
+		resources = spec.EncryptionConfig.Resources
+	}
+	return &ekscontrolplanev1.EncryptionConfig{
+		Provider:  aws.String(keyARN),
+		Resources: resources,
+	}
+}
+
 func makeKubernetesNetworkConfig(serviceCidrs *clusterv1.NetworkRanges) (*eks.KubernetesNetworkConfigRequest, error) {
 	if serviceCidrs == nil || len(serviceCidrs.CIDRBlocks) == 0 {
 		return nil, nil
@@ -265,6 +323,19 @@ func makeKubernetesNetworkConfig(serviceCidrs *clusterv1.NetworkRanges) (*eks.Ku
 	}, nil
 }
 
+// validateIPv6Network checks that the VPC and subnets of an IPv6 cluster have IPv6 CIDR blocks.
+func validateIPv6Network(vpc *infrav1.VPCSpec, subnets infrav1.Subnets) error {
+	if !vpc.IsIPv6Enabled() || vpc.IPv6.CidrBlock == "" {
+		return fmt.Errorf("vpc %s has no IPv6 CIDR block", vpc.ID)
+	}
+	for _, subnet := range subnets {
+		if subnet.IPv6CidrBlock == "" {
+			return fmt.Errorf("subnet %s has no IPv6 CIDR block", subnet.GetResourceID())
+		}
+	}
+	return nil
+}
+
 func makeVpcConfig(subnets infrav1.Subnets, endpointAccess ekscontrolplanev1.EndpointAccess, securityGroups map[infrav1.SecurityGroupRole]infrav1.SecurityGroup) (*eks.VpcConfigRequest, error) {
 	// TODO: Do we need to just add the private subnets?
 	if len(subnets) < 2 {
@@ -356,18 +427,26 @@ func makeEksLogging(loggingSpec *ekscontrolplanev1.ControlPlaneLoggingSpec) *eks
 
 func (s *Service) createCluster(eksClusterName string) (*eks.Cluster, error) {
 	logging := makeEksLogging(s.scope.ControlPlane.Spec.Logging)
-	encryptionConfigs := makeEksEncryptionConfigs(s.scope.ControlPlane.Spec.EncryptionConfig)
+	encryptionConfigs := makeEksEncryptionConfigs(s.encryptionConfig())
 	vpcConfig, err := makeVpcConfig(s.scope.Subnets(), s.scope.ControlPlane.Spec.EndpointAccess, s.scope.SecurityGroups())
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't create vpc config for cluster")
 	}
 
 	var netConfig *eks.KubernetesNetworkConfigRequest
-	if s.scope.VPC().IsIPv6Enabled() {
+	switch {
+	case s.scope.IPFamily() == ekscontrolplanev1.IPFamilyIPv6:
+		if err := validateIPv6Network(s.scope.VPC(), s.scope.Subnets()); err != nil {
+			return nil, errors.Wrap(err, "couldn't create IPv6 cluster")
+		}
 		netConfig = &eks.KubernetesNetworkConfigRequest{
 			IpFamily: aws.String(eks.IpFamilyIpv6),
 		}
-	} else {
+	case s.scope.ControlPlane.Spec.KubeNetwork.ServiceCIDR != "":
+		netConfig = &eks.KubernetesNetworkConfigRequest{
+			ServiceIpv4Cidr: aws.String(s.scope.ControlPlane.Spec.KubeNetwork.ServiceCIDR),
+		}
+	default:
 		netConfig, err = makeKubernetesNetworkConfig(s.scope.ServiceCidrs())
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't create Kubernetes network config for cluster")
@@ -535,8 +614,7 @@ func (s *Service) reconcileEKSEncryptionConfig(currentClusterConfig []*eks.Encry
 		currentClusterConfig = []*eks.EncryptionConfig{}
 	}
 
-	encryptionConfigs := s.scope.ControlPlane.Spec.EncryptionConfig
-	updatedEncryptionConfigs := makeEksEncryptionConfigs(encryptionConfigs)
+	updatedEncryptionConfigs := makeEksEncryptionConfigs(s.encryptionConfig())
 
 	if compareEncryptionConfig(currentClusterConfig, updatedEncryptionConfigs) {
 		s.Debug("encryption configuration unchanged, no action")
@@ -570,46 +648,80 @@ func (s *Service) reconcileClusterVersion(cluster *eks.Cluster) error {
 	specVersion := parseEKSVersion(*s.scope.ControlPlane.Spec.Version)
 	clusterVersion := version.MustParseGeneric(*cluster.Version)
 
-	if clusterVersion.LessThan(specVersion) {
-		// NOTE: you can only upgrade increments of minor versions. If you want to upgrade 1.14 to 1.16 we
-		// need to go 1.14-> 1.15 and then 1.15 -> 1.16.
-		nextVersionString := versionToEKS(clusterVersion.WithMinor(clusterVersion.Minor() + 1))
-
-		input := &eks.UpdateClusterVersionInput{
-			Name:    aws.String(s.scope.KubernetesClusterName()),
-			Version: &nextVersionString,
+	if !clusterVersion.LessThan(specVersion) {
+		if aws.StringValue(cluster.Status) == eks.ClusterStatusActive {
+			s.markControlPlaneUpgraded(*cluster.Version)
 		}
+		return nil
+	}
 
-		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-			if _, err := s.EKSClient.UpdateClusterVersion(input); err != nil {
-				if aerr, ok := err.(awserr.Error); ok {
-					return false, aerr
-				}
-				return false, err
-			}
+	// NOTE: you can only upgrade increments of minor versions. If you want to upgrade 1.14 to 1.16 we
+	// need to go 1.14-> 1.15 and then 1.15 -> 1.16.
+	nextVersion := clusterVersion.WithMinor(clusterVersion.Minor() + 1)
+	nextVersionString := versionToEKS(nextVersion)
 
-			// Wait until status transitions to UPDATING because there's a short
-			// window after UpdateClusterVersion returns where the cluster
-			// status is ACTIVE and the update would be tried again
-			if err := s.EKSClient.WaitUntilClusterUpdating(
-				&eks.DescribeClusterInput{Name: aws.String(s.scope.KubernetesClusterName())},
-				request.WithWaiterLogger(&awslog{s.GetLogger()}),
-			); err != nil {
-				return false, err
-			}
+	input := &eks.UpdateClusterVersionInput{
+		Name:    aws.String(s.scope.KubernetesClusterName()),
+		Version: &nextVersionString,
+	}
 
-			conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
-			record.Eventf(s.scope.ControlPlane, "InitiatedUpdateEKSControlPlane", "Initiated update of EKS control plane %s to version %s", s.scope.KubernetesClusterName(), nextVersionString)
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.EKSClient.UpdateClusterVersion(input); err != nil {
+			if aerr, ok := err.(awserr.Error); ok {
+				return false, aerr
+			}
+			return false, err
+		}
 
-			return true, nil
-		}); err != nil {
-			record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "failed to update the EKS control plane: %v", err)
-			return errors.Wrapf(err, "failed to update EKS cluster")
+		// Wait until status transitions to UPDATING because there's a short
+		// window after UpdateClusterVersion returns where the cluster
+		// status is ACTIVE and the update would be tried again
+		if err := s.EKSClient.WaitUntilClusterUpdating(
+			&eks.DescribeClusterInput{Name: aws.String(s.scope.KubernetesClusterName())},
+			request.WithWaiterLogger(&awslog{s.GetLogger()}),
+		); err != nil {
+			return false, err
 		}
+
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.ControlPlaneUpgradingCondition)
+		record.Eventf(s.scope.ControlPlane, "InitiatedUpdateEKSControlPlane", "Initiated update of EKS control plane %s to version %s", s.scope.KubernetesClusterName(), nextVersionString)
+
+		return true, nil
+	}); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "failed to update the EKS control plane: %v", err)
+		return errors.Wrapf(err, "failed to update EKS cluster")
 	}
+
+	// Wait for the control plane to be active at the new version, so that node groups
+	// are not upgraded against a control plane that is still upgrading.
+	if !s.scope.UseBlockingWaiters() {
+		return wait.NewPending(fmt.Sprintf("EKS control plane %q is updating to version %s", s.scope.KubernetesClusterName(), nextVersionString))
+	}
+	cluster, err := s.waitForClusterActive()
+	if err != nil {
+		return errors.Wrap(err, "failed to wait for cluster to be active after version update")
+	}
+	if version.MustParseGeneric(*cluster.Version).LessThan(nextVersion) {
+		return errors.Errorf("EKS cluster is active at version %s, expected version %s", *cluster.Version, nextVersionString)
+	}
+
+	if !nextVersion.LessThan(specVersion) {
+		s.markControlPlaneUpgraded(*cluster.Version)
+	}
+
 	return nil
 }
 
+// markControlPlaneUpgraded marks the end of a Kubernetes version upgrade of the control plane.
+func (s *Service) markControlPlaneUpgraded(clusterVersion string) {
+	if !conditions.IsTrue(s.scope.ControlPlane, ekscontrolplanev1.ControlPlaneUpgradingCondition) {
+		return
+	}
+	conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.ControlPlaneUpgradingCondition, ekscontrolplanev1.ControlPlaneUpgradedReason, clusterv1.ConditionSeverityInfo, "")
+	record.Eventf(s.scope.ControlPlane, "SuccessfulUpgradeEKSControlPlane", "Upgraded EKS control plane %s to version %s", s.scope.KubernetesClusterName(), clusterVersion)
+}
+
 func (s *Service) describeEKSCluster(eksClusterName string) (*eks.Cluster, error) {
 	input := &eks.DescribeClusterInput{
 		Name: aws.String(eksClusterName),
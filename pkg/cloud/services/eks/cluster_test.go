@@ -17,16 +17,24 @@ limitations under the License.
 package eks
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -36,7 +44,11 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts/mock_stsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestMakeEKSEncryptionConfigs(t *testing.T) {
@@ -358,9 +370,11 @@ func TestMakeEKSLogging(t *testing.T) {
 func TestReconcileClusterVersion(t *testing.T) {
 	clusterName := "default.cluster"
 	tests := []struct {
-		name        string
-		expect      func(m *mock_eksiface.MockEKSAPIMockRecorder)
-		expectError bool
+		name            string
+		upgrading       bool
+		expect          func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectError     bool
+		expectUpgrading bool
 	}{
 		{
 			name: "no upgrade necessary",
@@ -393,8 +407,122 @@ func TestReconcileClusterVersion(t *testing.T) {
 				m.
 					UpdateClusterVersion(gomock.AssignableToTypeOf(&eks.UpdateClusterVersionInput{})).
 					Return(&eks.UpdateClusterVersionOutput{}, nil)
+				m.WaitUntilClusterActive(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).Return(nil)
+				m.
+					DescribeCluster(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(&eks.DescribeClusterOutput{
+						Cluster: &eks.Cluster{
+							Name:    aws.String("default.cluster"),
+							Version: aws.String("1.15"),
+							Status:  aws.String(eks.ClusterStatusActive),
+						},
+					}, nil)
 			},
-			expectError: false,
+			expectError:     false,
+			expectUpgrading: true,
+		},
+		{
+			name: "upgrade to the spec version waits for the cluster to be active",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					DescribeCluster(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(&eks.DescribeClusterOutput{
+						Cluster: &eks.Cluster{
+							Name:    aws.String("default.cluster"),
+							Version: aws.String("1.15"),
+							Status:  aws.String(eks.ClusterStatusActive),
+						},
+					}, nil)
+				gomock.InOrder(
+					m.
+						UpdateClusterVersion(&eks.UpdateClusterVersionInput{
+							Name:    aws.String("default.cluster"),
+							Version: aws.String("1.16"),
+						}).
+						Return(&eks.UpdateClusterVersionOutput{}, nil),
+					m.WaitUntilClusterUpdating(
+						gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}), gomock.Any(),
+					).Return(nil),
+					m.WaitUntilClusterActive(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).Return(nil),
+					m.
+						DescribeCluster(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+						Return(&eks.DescribeClusterOutput{
+							Cluster: &eks.Cluster{
+								Name:    aws.String("default.cluster"),
+								Version: aws.String("1.16"),
+								Status:  aws.String(eks.ClusterStatusActive),
+							},
+						}, nil),
+				)
+			},
+			expectError:     false,
+			expectUpgrading: false,
+		},
+		{
+			name: "cluster active at the old version after upgrade",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					DescribeCluster(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(&eks.DescribeClusterOutput{
+						Cluster: &eks.Cluster{
+							Name:    aws.String("default.cluster"),
+							Version: aws.String("1.15"),
+							Status:  aws.String(eks.ClusterStatusActive),
+						},
+					}, nil)
+				m.WaitUntilClusterUpdating(
+					gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}), gomock.Any(),
+				).Return(nil)
+				m.
+					UpdateClusterVersion(gomock.AssignableToTypeOf(&eks.UpdateClusterVersionInput{})).
+					Return(&eks.UpdateClusterVersionOutput{}, nil)
+				m.WaitUntilClusterActive(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).Return(nil)
+				m.
+					DescribeCluster(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(&eks.DescribeClusterOutput{
+						Cluster: &eks.Cluster{
+							Name:    aws.String("default.cluster"),
+							Version: aws.String("1.15"),
+							Status:  aws.String(eks.ClusterStatusActive),
+						},
+					}, nil)
+			},
+			expectError:     true,
+			expectUpgrading: true,
+		},
+		{
+			name:      "upgrading is cleared once the cluster is active at the spec version",
+			upgrading: true,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					DescribeCluster(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(&eks.DescribeClusterOutput{
+						Cluster: &eks.Cluster{
+							Name:    aws.String("default.cluster"),
+							Version: aws.String("1.16"),
+							Status:  aws.String(eks.ClusterStatusActive),
+						},
+					}, nil)
+			},
+			expectError:     false,
+			expectUpgrading: false,
+		},
+		{
+			name:      "upgrading is kept while the cluster is updating",
+			upgrading: true,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					DescribeCluster(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(&eks.DescribeClusterOutput{
+						Cluster: &eks.Cluster{
+							Name:    aws.String("default.cluster"),
+							Version: aws.String("1.16"),
+							Status:  aws.String(eks.ClusterStatusUpdating),
+						},
+					}, nil)
+			},
+			expectError:     false,
+			expectUpgrading: true,
 		},
 		{
 			name: "api error",
@@ -427,7 +555,20 @@ func TestReconcileClusterVersion(t *testing.T) {
 			scheme := runtime.NewScheme()
 			_ = infrav1.AddToScheme(scheme)
 			_ = ekscontrolplanev1.AddToScheme(scheme)
-			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "control-plane",
+				},
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: clusterName,
+					Version:        aws.String("1.16"),
+				},
+			}
+			if tc.upgrading {
+				conditions.MarkTrue(controlPlane, ekscontrolplanev1.ControlPlaneUpgradingCondition)
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlane).WithStatusSubresource(controlPlane).Build()
 			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
 				Client: client,
 				Cluster: &clusterv1.Cluster{
@@ -436,11 +577,8 @@ func TestReconcileClusterVersion(t *testing.T) {
 						Name:      clusterName,
 					},
 				},
-				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
-					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
-						Version: aws.String("1.16"),
-					},
-				},
+				ControlPlane:       controlPlane,
+				UseBlockingWaiters: true,
 			})
 			g.Expect(err).To(BeNil())
 
@@ -452,6 +590,7 @@ func TestReconcileClusterVersion(t *testing.T) {
 			g.Expect(err).To(BeNil())
 
 			err = s.reconcileClusterVersion(cluster)
+			g.Expect(conditions.IsTrue(controlPlane, ekscontrolplanev1.ControlPlaneUpgradingCondition)).To(Equal(tc.expectUpgrading))
 			if tc.expectError {
 				g.Expect(err).To(HaveOccurred())
 				return
@@ -471,6 +610,8 @@ func TestCreateCluster(t *testing.T) {
 		role        *string
 		tags        map[string]*string
 		subnets     []infrav1.SubnetSpec
+		serviceCIDR string
+		netConfig   *eks.KubernetesNetworkConfigRequest
 	}{
 		{
 			name:        "cluster create with 2 subnets",
@@ -484,6 +625,22 @@ func TestCreateCluster(t *testing.T) {
 				{ID: "1", AvailabilityZone: "us-west-2a"}, {ID: "2", AvailabilityZone: "us-west-2b"},
 			},
 		},
+		{
+			name:        "cluster create with service cidr",
+			expectEKS:   func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectError: false,
+			role:        aws.String("arn:role"),
+			tags: map[string]*string{
+				"kubernetes.io/cluster/" + clusterName: aws.String("owned"),
+			},
+			subnets: []infrav1.SubnetSpec{
+				{ID: "1", AvailabilityZone: "us-west-2a"}, {ID: "2", AvailabilityZone: "us-west-2b"},
+			},
+			serviceCIDR: "172.20.0.0/16",
+			netConfig: &eks.KubernetesNetworkConfigRequest{
+				ServiceIpv4Cidr: aws.String("172.20.0.0/16"),
+			},
+		},
 		{
 			name:        "cluster create without subnets",
 			expectEKS:   func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
@@ -521,6 +678,7 @@ func TestCreateCluster(t *testing.T) {
 						Version:        version,
 						RoleName:       tc.role,
 						NetworkSpec:    infrav1.NetworkSpec{Subnets: tc.subnets},
+						KubeNetwork:    ekscontrolplanev1.KubeNetwork{ServiceCIDR: tc.serviceCIDR},
 					},
 				},
 			})
@@ -539,9 +697,10 @@ func TestCreateCluster(t *testing.T) {
 					ResourcesVpcConfig: &eks.VpcConfigRequest{
 						SubnetIds: subnetIds,
 					},
-					RoleArn: tc.role,
-					Tags:    tc.tags,
-					Version: version,
+					KubernetesNetworkConfig: tc.netConfig,
+					RoleArn:                 tc.role,
+					Tags:                    tc.tags,
+					Version:                 version,
 				}).Return(&eks.CreateClusterOutput{}, nil)
 			}
 			s := NewService(scope)
@@ -564,6 +723,7 @@ func TestReconcileEKSEncryptionConfig(t *testing.T) {
 		name                string
 		oldEncryptionConfig *ekscontrolplanev1.EncryptionConfig
 		newEncryptionConfig *ekscontrolplanev1.EncryptionConfig
+		managedKeyARN       string
 		expect              func(m *mock_eksiface.MockEKSAPIMockRecorder)
 		expectError         bool
 	}{
@@ -625,6 +785,44 @@ func TestReconcileEKSEncryptionConfig(t *testing.T) {
 			expect:      func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
 			expectError: true,
 		},
+		{
+			name:          "secrets encrypted with the managed key",
+			managedKeyARN: "arn:aws:kms:us-east-1:000000000000:key/managed",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.WaitUntilClusterUpdating(
+					gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}), gomock.Any(),
+				).Return(nil)
+				m.AssociateEncryptionConfig(&eks.AssociateEncryptionConfigInput{
+					ClusterName: aws.String(""),
+					EncryptionConfig: []*eks.EncryptionConfig{{
+						Provider:  &eks.Provider{KeyArn: aws.String("arn:aws:kms:us-east-1:000000000000:key/managed")},
+						Resources: []*string{aws.String("secrets")},
+					}},
+				}).Return(&eks.AssociateEncryptionConfigOutput{}, nil)
+			},
+		},
+		{
+			name: "no upgrade necessary - secrets encrypted with the managed key",
+			oldEncryptionConfig: &ekscontrolplanev1.EncryptionConfig{
+				Provider:  ptr.To[string]("arn:aws:kms:us-east-1:000000000000:key/managed"),
+				Resources: []*string{ptr.To[string]("secrets")},
+			},
+			managedKeyARN: "arn:aws:kms:us-east-1:000000000000:key/managed",
+			expect:        func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name: "no upgrade necessary - declared provider takes precedence over the managed key",
+			oldEncryptionConfig: &ekscontrolplanev1.EncryptionConfig{
+				Provider:  ptr.To[string]("provider"),
+				Resources: []*string{ptr.To[string]("secrets")},
+			},
+			newEncryptionConfig: &ekscontrolplanev1.EncryptionConfig{
+				Provider:  ptr.To[string]("provider"),
+				Resources: []*string{ptr.To[string]("secrets")},
+			},
+			managedKeyARN: "arn:aws:kms:us-east-1:000000000000:key/managed",
+			expect:        func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
 	}
 
 	for _, tc := range tests {
@@ -650,8 +848,12 @@ func TestReconcileEKSEncryptionConfig(t *testing.T) {
 				},
 				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
 					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
-						Version:          aws.String("1.16"),
-						EncryptionConfig: tc.newEncryptionConfig,
+						Version:              aws.String("1.16"),
+						EncryptionConfig:     tc.newEncryptionConfig,
+						ManagedEncryptionKey: tc.managedKeyARN != "",
+					},
+					Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+						ManagedEncryptionKeyARN: tc.managedKeyARN,
 					},
 				},
 			})
@@ -768,3 +970,418 @@ func TestCreateIPv6Cluster(t *testing.T) {
 	_, err = s.createCluster("cluster-name")
 	g.Expect(err).To(BeNil())
 }
+
+func TestReconcileExternallyManagedCluster(t *testing.T) {
+	clusterName := "adopted-cluster"
+	tests := []struct {
+		name        string
+		expect      func(m *mock_eksiface.MockEKSAPIMockRecorder, e *mocks.MockEC2APIMockRecorder, s *mock_stsiface.MockSTSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder)
+		expectError bool
+	}{
+		{
+			name: "cluster is not found",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, e *mocks.MockEC2APIMockRecorder, s *mock_stsiface.MockSTSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.
+					DescribeCluster(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(nil, awserr.New(eks.ErrCodeResourceNotFoundException, "not found", nil))
+			},
+			expectError: true,
+		},
+		{
+			name: "cluster is adopted without being changed",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, e *mocks.MockEC2APIMockRecorder, s *mock_stsiface.MockSTSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.
+					DescribeCluster(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(&eks.DescribeClusterOutput{
+						Cluster: &eks.Cluster{
+							Name:     aws.String(clusterName),
+							Version:  aws.String("1.27"),
+							Status:   aws.String(eks.ClusterStatusActive),
+							Endpoint: aws.String("https://adopted.eks.amazonaws.com"),
+							CertificateAuthority: &eks.Certificate{
+								Data: aws.String("Y2E="),
+							},
+							ResourcesVpcConfig: &eks.VpcConfigResponse{
+								ClusterSecurityGroupId: aws.String("sg-cluster"),
+								VpcId:                  aws.String("vpc-adopted"),
+								SubnetIds:              aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+							},
+							Identity: &eks.Identity{
+								Oidc: &eks.OIDC{
+									Issuer: aws.String("https://oidc.eks.us-east-1.amazonaws.com/id/ADOPTED"),
+								},
+							},
+						},
+					}, nil)
+				e.
+					DescribeSecurityGroupsWithContext(gomock.Any(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+					Return(&ec2.DescribeSecurityGroupsOutput{
+						SecurityGroups: []*ec2.SecurityGroup{{GroupId: aws.String("sg-node"), GroupName: aws.String("node")}},
+					}, nil)
+				e.
+					DescribeSecurityGroupsWithContext(gomock.Any(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+					Return(&ec2.DescribeSecurityGroupsOutput{
+						SecurityGroups: []*ec2.SecurityGroup{{GroupId: aws.String("sg-cluster"), GroupName: aws.String("cluster")}},
+					}, nil)
+				stsClient := sts.New(session.Must(session.NewSession(&aws.Config{
+					Region:      aws.String("us-east-1"),
+					Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+				})))
+				s.
+					GetCallerIdentityRequest(gomock.AssignableToTypeOf(&sts.GetCallerIdentityInput{})).
+					DoAndReturn(stsClient.GetCallerIdentityRequest)
+				// The existing OIDC provider is looked up, not created nor tagged.
+				i.
+					ListOpenIDConnectProviders(&iam.ListOpenIDConnectProvidersInput{}).
+					Return(&iam.ListOpenIDConnectProvidersOutput{
+						OpenIDConnectProviderList: []*iam.OpenIDConnectProviderListEntry{
+							{Arn: aws.String("arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/OTHER")},
+							{Arn: aws.String("arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/ADOPTED")},
+						},
+					}, nil)
+				i.
+					GetOpenIDConnectProvider(&iam.GetOpenIDConnectProviderInput{
+						OpenIDConnectProviderArn: aws.String("arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/OTHER"),
+					}).
+					Return(&iam.GetOpenIDConnectProviderOutput{Url: aws.String("oidc.eks.us-east-1.amazonaws.com/id/OTHER")}, nil)
+				i.
+					GetOpenIDConnectProvider(&iam.GetOpenIDConnectProviderInput{
+						OpenIDConnectProviderArn: aws.String("arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/ADOPTED"),
+					}).
+					Return(&iam.GetOpenIDConnectProviderOutput{Url: aws.String("oidc.eks.us-east-1.amazonaws.com/id/ADOPTED")}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+			stsMock := mock_stsiface.NewMockSTSAPI(mockControl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = corev1.AddToScheme(scheme)
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "control-plane",
+				},
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName:  clusterName,
+					ExternalManaged: true,
+					// The version in the spec is not applied to an externally managed cluster.
+					Version: aws.String("1.28"),
+					// The OIDC provider of an externally managed cluster is only looked up.
+					AssociateOIDCProvider: true,
+				},
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlane).WithStatusSubresource(controlPlane).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "capi-cluster",
+					},
+				},
+				ControlPlane: controlPlane,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(eksMock.EXPECT(), ec2Mock.EXPECT(), stsMock.EXPECT(), iamMock.EXPECT())
+			s := NewService(scope)
+			s.EKSClient = eksMock
+			s.EC2Client = ec2Mock
+			s.STSClient = stsMock
+			s.IAMClient = iamMock
+
+			err = s.reconcileCluster(context.TODO())
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(controlPlane.Status.Ready).To(BeTrue())
+			g.Expect(controlPlane.Status.Version).To(Equal(aws.String("1.27")))
+			g.Expect(controlPlane.Spec.ControlPlaneEndpoint.Host).To(Equal("https://adopted.eks.amazonaws.com"))
+			g.Expect(controlPlane.Status.Network.SecurityGroups[ekscontrolplanev1.SecurityGroupCluster].ID).To(Equal("sg-cluster"))
+			g.Expect(controlPlane.Status.OIDCProvider.ARN).To(Equal("arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/ADOPTED"))
+			g.Expect(controlPlane.Spec.NetworkSpec.VPC.ID).To(Equal("vpc-adopted"))
+			g.Expect(controlPlane.Spec.NetworkSpec.Subnets.IDs()).To(ConsistOf("subnet-1", "subnet-2"))
+
+			kubeconfig := &corev1.Secret{}
+			g.Expect(client.Get(context.TODO(), types.NamespacedName{Namespace: "ns", Name: "capi-cluster-kubeconfig"}, kubeconfig)).To(Succeed())
+		})
+	}
+}
+
+func TestReconcileClusterPolling(t *testing.T) {
+	g := NewWithT(t)
+	clusterName := "adopted-cluster"
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+	ec2Mock := mocks.NewMockEC2API(mockControl)
+	stsMock := mock_stsiface.NewMockSTSAPI(mockControl)
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	// The cluster is externally managed to keep the reconciliation of the active cluster short.
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "control-plane",
+		},
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			EKSClusterName:  clusterName,
+			ExternalManaged: true,
+			Version:         aws.String("1.27"),
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlane).WithStatusSubresource(controlPlane).Build()
+	scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "capi-cluster",
+			},
+		},
+		ControlPlane: controlPlane,
+	})
+	g.Expect(err).To(BeNil())
+
+	s := NewService(scope)
+	s.EKSClient = eksMock
+	s.EC2Client = ec2Mock
+	s.STSClient = stsMock
+
+	expectCluster := func(status string) {
+		eksMock.EXPECT().
+			DescribeCluster(gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+			Return(&eks.DescribeClusterOutput{
+				Cluster: &eks.Cluster{
+					Name:     aws.String(clusterName),
+					Version:  aws.String("1.27"),
+					Status:   aws.String(status),
+					Endpoint: aws.String("https://adopted.eks.amazonaws.com"),
+					CertificateAuthority: &eks.Certificate{
+						Data: aws.String("Y2E="),
+					},
+					ResourcesVpcConfig: &eks.VpcConfigResponse{
+						ClusterSecurityGroupId: aws.String("sg-cluster"),
+					},
+				},
+			}, nil)
+	}
+
+	// The reconciles don't wait for the cluster being created.
+	for i := 0; i < 2; i++ {
+		expectCluster(eks.ClusterStatusCreating)
+
+		err = s.reconcileCluster(context.TODO())
+		g.Expect(wait.IsPending(err)).To(BeTrue(), "expected a pending error, got %v", err)
+		g.Expect(controlPlane.Status.Ready).To(BeFalse())
+		g.Expect(controlPlane.Spec.ControlPlaneEndpoint.Host).To(BeEmpty())
+	}
+
+	// Once the cluster is active the reconcile completes.
+	expectCluster(eks.ClusterStatusActive)
+	ec2Mock.EXPECT().
+		DescribeSecurityGroupsWithContext(gomock.Any(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+		Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []*ec2.SecurityGroup{{GroupId: aws.String("sg-node"), GroupName: aws.String("node")}},
+		}, nil)
+	ec2Mock.EXPECT().
+		DescribeSecurityGroupsWithContext(gomock.Any(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+		Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []*ec2.SecurityGroup{{GroupId: aws.String("sg-cluster"), GroupName: aws.String("cluster")}},
+		}, nil)
+	stsClient := sts.New(session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("us-east-1"),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	})))
+	stsMock.EXPECT().
+		GetCallerIdentityRequest(gomock.AssignableToTypeOf(&sts.GetCallerIdentityInput{})).
+		DoAndReturn(stsClient.GetCallerIdentityRequest)
+
+	g.Expect(s.reconcileCluster(context.TODO())).To(Succeed())
+	g.Expect(controlPlane.Status.Ready).To(BeTrue())
+	g.Expect(controlPlane.Spec.ControlPlaneEndpoint.Host).To(Equal("https://adopted.eks.amazonaws.com"))
+}
+
+func TestDeleteExternallyManagedControlPlane(t *testing.T) {
+	g := NewWithT(t)
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	// No calls are expected: the cluster, its IAM role and its OIDC provider are left in place.
+	eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+	iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "capi-cluster",
+			},
+		},
+		ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+			Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName:        "adopted-cluster",
+				ExternalManaged:       true,
+				AssociateOIDCProvider: true,
+			},
+		},
+	})
+	g.Expect(err).To(BeNil())
+
+	s := NewService(scope)
+	s.EKSClient = eksMock
+	s.IAMClient = iamMock
+
+	g.Expect(s.DeleteControlPlane()).To(Succeed())
+}
+
+func TestCreateClusterIPFamily(t *testing.T) {
+	ipv6Subnets := []infrav1.SubnetSpec{
+		{ID: "sub-1", AvailabilityZone: "us-west-2a", IsIPv6: true, IPv6CidrBlock: "2001:db8:85a3:1::/64"},
+		{ID: "sub-2", AvailabilityZone: "us-west-2b", IsIPv6: true, IPv6CidrBlock: "2001:db8:85a3:2::/64"},
+	}
+	ipv6VPC := infrav1.VPCSpec{
+		ID:   "vpc-1",
+		IPv6: &infrav1.IPv6{CidrBlock: "2001:db8:85a3::/56"},
+	}
+	tests := []struct {
+		name        string
+		ipFamily    ekscontrolplanev1.IPFamily
+		vpc         infrav1.VPCSpec
+		subnets     []infrav1.SubnetSpec
+		netConfig   *eks.KubernetesNetworkConfigRequest
+		expectError bool
+	}{
+		{
+			name:      "ipv6 cluster",
+			ipFamily:  ekscontrolplanev1.IPFamilyIPv6,
+			vpc:       ipv6VPC,
+			subnets:   ipv6Subnets,
+			netConfig: &eks.KubernetesNetworkConfigRequest{IpFamily: aws.String(eks.IpFamilyIpv6)},
+		},
+		{
+			name:    "ipv4 cluster in an ipv6 vpc",
+			vpc:     ipv6VPC,
+			subnets: ipv6Subnets,
+			// The IP family is explicitly ipv4, EKS defaults to it.
+			ipFamily: ekscontrolplanev1.IPFamilyIPv4,
+		},
+		{
+			name:     "ipv6 cluster in a vpc without an ipv6 cidr",
+			ipFamily: ekscontrolplanev1.IPFamilyIPv6,
+			vpc: infrav1.VPCSpec{
+				ID:   "vpc-1",
+				IPv6: &infrav1.IPv6{},
+			},
+			subnets:     ipv6Subnets,
+			expectError: true,
+		},
+		{
+			name:     "ipv6 cluster with a subnet without an ipv6 cidr",
+			ipFamily: ekscontrolplanev1.IPFamilyIPv6,
+			vpc:      ipv6VPC,
+			subnets: []infrav1.SubnetSpec{
+				ipv6Subnets[0],
+				{ID: "sub-2", AvailabilityZone: "us-west-2b"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "cluster-name",
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						RoleName: ptr.To[string]("arn-role"),
+						Version:  aws.String("1.28"),
+						IPFamily: tc.ipFamily,
+						NetworkSpec: infrav1.NetworkSpec{
+							Subnets: tc.subnets,
+							VPC:     tc.vpc,
+						},
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			if !tc.expectError {
+				iamMock.EXPECT().GetRole(&iam.GetRoleInput{
+					RoleName: aws.String("arn-role"),
+				}).Return(&iam.GetRoleOutput{
+					Role: &iam.Role{
+						RoleName: ptr.To[string]("arn-role"),
+					},
+				}, nil)
+				eksMock.EXPECT().CreateCluster(&eks.CreateClusterInput{
+					Name:             aws.String("cluster-name"),
+					Version:          aws.String("1.28"),
+					EncryptionConfig: []*eks.EncryptionConfig{},
+					ResourcesVpcConfig: &eks.VpcConfigRequest{
+						SubnetIds: []*string{ptr.To[string]("sub-1"), ptr.To[string]("sub-2")},
+					},
+					KubernetesNetworkConfig: tc.netConfig,
+					Tags: map[string]*string{
+						"kubernetes.io/cluster/cluster-name": ptr.To[string]("owned"),
+					},
+				}).Return(&eks.CreateClusterOutput{}, nil)
+			}
+
+			s := NewService(scope)
+			s.EKSClient = eksMock
+			s.IAMClient = iamMock
+
+			_, err = s.createCluster("cluster-name")
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package eks
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"fmt"
@@ -84,8 +85,9 @@ func (s *Service) reconcileAdditionalKubeconfigs(ctx context.Context, cluster *e
 		Namespace: s.scope.Cluster.Namespace,
 	}
 
-	// Create the additional kubeconfig for users. This doesn't need updating on every sync
-	_, err := secret.GetFromNamespacedName(ctx, s.scope.Client, clusterRef, secret.Kubeconfig)
+	// Create the additional kubeconfig for users. This doesn't need updating on every sync, unless the
+	// certificate authority of the cluster changed.
+	configSecret, err := secret.GetFromNamespacedName(ctx, s.scope.Client, clusterRef, secret.Kubeconfig)
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
 			return errors.Wrap(err, "failed to get kubeconfig (user) secret")
@@ -99,6 +101,11 @@ func (s *Service) reconcileAdditionalKubeconfigs(ctx context.Context, cluster *e
 		if createErr != nil {
 			return err
 		}
+		return nil
+	}
+
+	if updateErr := s.updateUserKubeconfigSecret(ctx, configSecret, cluster); updateErr != nil {
+		return fmt.Errorf("updating kubeconfig (user) secret: %w", updateErr)
 	}
 
 	return nil
@@ -153,12 +160,29 @@ func (s *Service) updateCAPIKubeconfigSecret(ctx context.Context, configSecret *
 		return errors.Wrap(err, "failed to convert kubeconfig Secret into a clientcmdapi.Config")
 	}
 
+	userName := s.getKubeConfigUserName(*cluster.Name, false)
+
+	// The kubeconfig is regenerated when the certificate authority of the cluster rotated, as the
+	// workload cluster can't be reached with the stale one.
+	caRotated, err := s.kubeconfigCAChanged(config, cluster)
+	if err != nil {
+		return err
+	}
+	if caRotated {
+		s.scope.Info("Certificate authority of the EKS cluster changed, regenerating the kubeconfig", "cluster-name", s.scope.KubernetesClusterName())
+		config, err = s.createBaseKubeConfig(cluster, userName)
+		if err != nil {
+			return fmt.Errorf("creating base kubeconfig: %w", err)
+		}
+		config.AuthInfos = map[string]*api.AuthInfo{
+			userName: {},
+		}
+	}
+
 	token, err := s.generateToken()
 	if err != nil {
 		return fmt.Errorf("generating presigned token: %w", err)
 	}
-
-	userName := s.getKubeConfigUserName(*cluster.Name, false)
 	config.AuthInfos[userName].Token = token
 
 	out, err := clientcmd.Write(*config)
@@ -173,18 +197,84 @@ func (s *Service) updateCAPIKubeconfigSecret(ctx context.Context, configSecret *
 		return fmt.Errorf("updating kubeconfig secret: %w", err)
 	}
 
+	if caRotated {
+		record.Eventf(s.scope.ControlPlane, "SuccessfulRotateKubeconfigCA", "Regenerated kubeconfig for cluster %q after its certificate authority changed", s.scope.Name())
+	}
 	return nil
 }
 
+// kubeconfigCAChanged returns whether the certificate authority of the cluster in a kubeconfig generated for it
+// differs from the current certificate authority of the EKS cluster.
+func (s *Service) kubeconfigCAChanged(config *api.Config, cluster *eks.Cluster) (bool, error) {
+	certData, err := base64.StdEncoding.DecodeString(*cluster.CertificateAuthority.Data)
+	if err != nil {
+		return false, fmt.Errorf("decoding cluster CA cert: %w", err)
+	}
+
+	kubeconfigCluster, ok := config.Clusters[s.scope.KubernetesClusterName()]
+	if !ok {
+		return true, nil
+	}
+	return !bytes.Equal(kubeconfigCluster.CertificateAuthorityData, certData), nil
+}
+
 func (s *Service) createUserKubeconfigSecret(ctx context.Context, cluster *eks.Cluster, clusterRef *types.NamespacedName) error {
 	controllerOwnerRef := *metav1.NewControllerRef(s.scope.ControlPlane, ekscontrolplanev1.GroupVersion.WithKind("AWSManagedControlPlane"))
 
+	out, err := s.createUserKubeconfig(cluster)
+	if err != nil {
+		return err
+	}
+
+	kubeconfigSecret := kubeconfig.GenerateSecretWithOwner(*clusterRef, out, controllerOwnerRef)
+	if err := s.scope.Client.Create(ctx, kubeconfigSecret); err != nil {
+		return errors.Wrap(err, "failed to create kubeconfig secret")
+	}
+
+	record.Eventf(s.scope.ControlPlane, "SucessfulCreateUserKubeconfig", "Created user kubeconfig for cluster %q", s.scope.Name())
+	return nil
+}
+
+func (s *Service) updateUserKubeconfigSecret(ctx context.Context, configSecret *corev1.Secret, cluster *eks.Cluster) error {
+	data, ok := configSecret.Data[secret.KubeconfigDataName]
+	if !ok {
+		return errors.Errorf("missing key %q in secret data", secret.KubeconfigDataName)
+	}
+
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert kubeconfig Secret into a clientcmdapi.Config")
+	}
+
+	caRotated, err := s.kubeconfigCAChanged(config, cluster)
+	if err != nil || !caRotated {
+		return err
+	}
+
+	s.scope.Info("Certificate authority of the EKS cluster changed, regenerating the user kubeconfig", "cluster-name", s.scope.KubernetesClusterName())
+	out, err := s.createUserKubeconfig(cluster)
+	if err != nil {
+		return err
+	}
+
+	configSecret.Data[secret.KubeconfigDataName] = out
+	if err := s.scope.Client.Update(ctx, configSecret); err != nil {
+		return fmt.Errorf("updating kubeconfig (user) secret: %w", err)
+	}
+
+	record.Eventf(s.scope.ControlPlane, "SuccessfulRotateUserKubeconfigCA", "Regenerated user kubeconfig for cluster %q after its certificate authority changed", s.scope.Name())
+	return nil
+}
+
+// createUserKubeconfig returns the serialized kubeconfig for users, which gets its tokens from the token method
+// of the control plane.
+func (s *Service) createUserKubeconfig(cluster *eks.Cluster) ([]byte, error) {
 	clusterName := s.scope.KubernetesClusterName()
 	userName := s.getKubeConfigUserName(clusterName, true)
 
 	cfg, err := s.createBaseKubeConfig(cluster, userName)
 	if err != nil {
-		return fmt.Errorf("creating base kubeconfig: %w", err)
+		return nil, fmt.Errorf("creating base kubeconfig: %w", err)
 	}
 
 	// Version v1alpha1 was removed in Kubernetes v1.23.
@@ -209,7 +299,7 @@ func (s *Service) createUserKubeconfigSecret(ctx context.Context, cluster *eks.C
 			clusterName,
 		}
 	default:
-		return fmt.Errorf("using token method %s: %w", s.scope.TokenMethod(), ErrUnknownTokenMethod)
+		return nil, fmt.Errorf("using token method %s: %w", s.scope.TokenMethod(), ErrUnknownTokenMethod)
 	}
 	cfg.AuthInfos = map[string]*api.AuthInfo{
 		userName: {
@@ -219,16 +309,9 @@ func (s *Service) createUserKubeconfigSecret(ctx context.Context, cluster *eks.C
 
 	out, err := clientcmd.Write(*cfg)
 	if err != nil {
-		return errors.Wrap(err, "failed to serialize config to yaml")
+		return nil, errors.Wrap(err, "failed to serialize config to yaml")
 	}
-
-	kubeconfigSecret := kubeconfig.GenerateSecretWithOwner(*clusterRef, out, controllerOwnerRef)
-	if err := s.scope.Client.Create(ctx, kubeconfigSecret); err != nil {
-		return errors.Wrap(err, "failed to create kubeconfig secret")
-	}
-
-	record.Eventf(s.scope.ControlPlane, "SucessfulCreateUserKubeconfig", "Created user kubeconfig for cluster %q", s.scope.Name())
-	return nil
+	return out, nil
 }
 
 func (s *Service) createBaseKubeConfig(cluster *eks.Cluster, userName string) (*api.Config, error) {
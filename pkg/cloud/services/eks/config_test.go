@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts/mock_stsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+// reasonRecorder records the reasons of the events.
+type reasonRecorder struct {
+	sync.Mutex
+	reasons []string
+}
+
+func (r *reasonRecorder) Event(_ runtime.Object, _, reason, _ string) {
+	r.Lock()
+	defer r.Unlock()
+	r.reasons = append(r.reasons, reason)
+}
+
+func (r *reasonRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *reasonRecorder) AnnotatedEventf(object runtime.Object, _ map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Eventf(object, eventtype, reason, messageFmt, args...)
+}
+
+func (r *reasonRecorder) count(reason string) int {
+	r.Lock()
+	defer r.Unlock()
+	count := 0
+	for _, recorded := range r.reasons {
+		if recorded == reason {
+			count++
+		}
+	}
+	return count
+}
+
+func TestReconcileKubeconfigCARotation(t *testing.T) {
+	g := NewWithT(t)
+	clusterName := "eks-cluster"
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	recorder := &reasonRecorder{}
+	record.InitFromRecorder(recorder)
+
+	stsClient := sts.New(session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("us-east-1"),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	})))
+	stsMock := mock_stsiface.NewMockSTSAPI(mockControl)
+	stsMock.EXPECT().
+		GetCallerIdentityRequest(gomock.AssignableToTypeOf(&sts.GetCallerIdentityInput{})).
+		DoAndReturn(stsClient.GetCallerIdentityRequest).
+		AnyTimes()
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "control-plane",
+		},
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			EKSClusterName: clusterName,
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "capi-cluster",
+			},
+		},
+		ControlPlane: controlPlane,
+	})
+	g.Expect(err).To(BeNil())
+
+	s := NewService(scope)
+	s.STSClient = stsMock
+
+	cluster := func(caData string) *eks.Cluster {
+		return &eks.Cluster{
+			Name:     aws.String(clusterName),
+			Endpoint: aws.String("https://eks-cluster.eks.amazonaws.com"),
+			CertificateAuthority: &eks.Certificate{
+				Data: aws.String(caData),
+			},
+		}
+	}
+	reconcile := func(c *eks.Cluster) {
+		g.Expect(s.reconcileKubeconfig(context.TODO(), c)).To(Succeed())
+		g.Expect(s.reconcileAdditionalKubeconfigs(context.TODO(), c)).To(Succeed())
+	}
+	kubeconfigCA := func(name string) string {
+		configSecret := &corev1.Secret{}
+		g.Expect(client.Get(context.TODO(), types.NamespacedName{Namespace: "ns", Name: name}, configSecret)).To(Succeed())
+		config, err := clientcmd.Load(configSecret.Data[secret.KubeconfigDataName])
+		g.Expect(err).To(BeNil())
+		g.Expect(config.Clusters).To(HaveKey(clusterName))
+		return string(config.Clusters[clusterName].CertificateAuthorityData)
+	}
+
+	// The kubeconfigs are created, and not regenerated while the certificate authority is unchanged.
+	reconcile(cluster("b2xkLWNh"))
+	reconcile(cluster("b2xkLWNh"))
+	g.Expect(kubeconfigCA("capi-cluster-kubeconfig")).To(Equal("old-ca"))
+	g.Expect(kubeconfigCA("capi-cluster-user-kubeconfig")).To(Equal("old-ca"))
+	g.Expect(recorder.count("SuccessfulRotateKubeconfigCA")).To(Equal(0))
+	g.Expect(recorder.count("SuccessfulRotateUserKubeconfigCA")).To(Equal(0))
+
+	// The kubeconfigs are regenerated once after the certificate authority rotated.
+	reconcile(cluster("bmV3LWNh"))
+	reconcile(cluster("bmV3LWNh"))
+	g.Expect(kubeconfigCA("capi-cluster-kubeconfig")).To(Equal("new-ca"))
+	g.Expect(kubeconfigCA("capi-cluster-user-kubeconfig")).To(Equal("new-ca"))
+	g.Expect(recorder.count("SuccessfulRotateKubeconfigCA")).To(Equal(1))
+	g.Expect(recorder.count("SuccessfulRotateUserKubeconfigCA")).To(Equal(1))
+}
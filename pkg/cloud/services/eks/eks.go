@@ -18,6 +18,8 @@ package eks
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
@@ -25,6 +27,7 @@ import (
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -35,14 +38,21 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 	s.scope.Debug("Reconciling EKS control plane", "cluster", klog.KRef(s.scope.Cluster.Namespace, s.scope.Cluster.Name))
 
 	// Control Plane IAM Role
-	if err := s.reconcileControlPlaneIAMRole(); err != nil {
-		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition, ekscontrolplanev1.IAMControlPlaneRolesReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
-		return err
+	// The IAM role of an externally managed cluster is managed along with the cluster.
+	if !s.scope.ControlPlane.Spec.ExternalManaged {
+		if err := s.reconcileControlPlaneIAMRole(); err != nil {
+			conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition, ekscontrolplanev1.IAMControlPlaneRolesReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			return err
+		}
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition)
 	}
-	conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition)
 
 	// EKS Cluster
 	if err := s.reconcileCluster(ctx); err != nil {
+		if wait.IsPending(err) {
+			conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition, ekscontrolplanev1.WaitingForEKSControlPlaneActiveReason, clusterv1.ConditionSeverityInfo, err.Error())
+			return err
+		}
 		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition, ekscontrolplanev1.EKSControlPlaneReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return err
 	}
@@ -53,7 +63,29 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition, ekscontrolplanev1.EKSAddonsConfiguredFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return errors.Wrap(err, "failed reconciling eks addons")
 	}
-	conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition)
+	// The addons are installed without the IAM roles of their service accounts when CAPA can't create them.
+	if addonNames := s.addonsWithoutServiceAccountRole(); len(addonNames) > 0 {
+		message := fmt.Sprintf("IAM roles for the service accounts of addons %s can't be created as EKS IAM or associateOIDCProvider is disabled, "+
+			"set their serviceAccountRoleARN or a pod identity association", strings.Join(addonNames, ", "))
+		record.Warnf(s.scope.ControlPlane, "MissingAddonIAMRoles", "%s", message)
+		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition, ekscontrolplanev1.EKSAddonServiceAccountRolesMissingReason, clusterv1.ConditionSeverityWarning, "%s", message)
+	} else {
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition)
+	}
+
+	// EKS Pod Identity Associations
+	conflicting, err := s.reconcilePodIdentityAssociations(ctx)
+	if err != nil {
+		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredCondition, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return errors.Wrap(err, "failed reconciling eks pod identity associations")
+	}
+	if len(conflicting) > 0 {
+		message := fmt.Sprintf("Service accounts %s already have a pod identity association not created by CAPA", strings.Join(conflicting, ", "))
+		record.Warnf(s.scope.ControlPlane, "ConflictingEKSPodIdentityAssociations", "%s", message)
+		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredCondition, ekscontrolplanev1.EKSPodIdentityAssociationsConflictReason, clusterv1.ConditionSeverityWarning, "%s", message)
+	} else {
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredCondition)
+	}
 
 	// EKS Identity Provider
 	if err := s.reconcileIdentityProvider(ctx); err != nil {
@@ -70,6 +102,11 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 func (s *Service) DeleteControlPlane() (err error) {
 	s.scope.Debug("Deleting EKS control plane")
 
+	if s.scope.ControlPlane.Spec.ExternalManaged {
+		s.scope.Info("EKS control plane is externally managed, skipping deletion", "cluster", s.scope.KubernetesClusterName())
+		return nil
+	}
+
 	// EKS Cluster
 	if err := s.deleteCluster(); err != nil {
 		return err
@@ -80,6 +117,11 @@ func (s *Service) DeleteControlPlane() (err error) {
 		return err
 	}
 
+	// Addon IAM roles, these are trusted by the OIDC provider so they go first
+	if err := s.deleteAddonServiceAccountRoles(nil); err != nil {
+		return err
+	}
+
 	// OIDC Provider
 	if err := s.deleteOIDCProvider(); err != nil {
 		return err
@@ -106,6 +148,10 @@ func (s *NodegroupService) ReconcilePool(ctx context.Context) error {
 	conditions.MarkTrue(s.scope.ManagedMachinePool, expinfrav1.IAMNodegroupRolesReadyCondition)
 
 	if err := s.reconcileNodegroup(ctx); err != nil {
+		if wait.IsPending(err) {
+			// The EKSNodegroupActive condition reports the progress of the nodegroup.
+			return err
+		}
 		conditions.MarkFalse(
 			s.scope.ManagedMachinePool,
 			expinfrav1.EKSNodegroupReadyCondition,
@@ -476,6 +476,26 @@ func (s *IAMService) FindAndVerifyOIDCProvider(cluster *eks.Cluster) (string, er
 	return "", nil
 }
 
+// FindOIDCProvider will try to find the OIDC provider of the given issuer URL, without verifying it. It returns an
+// empty ARN if there is no such provider.
+func (s *IAMService) FindOIDCProvider(issuer string) (string, error) {
+	output, err := s.IAMClient.ListOpenIDConnectProviders(&iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return "", errors.Wrap(err, "error listing providers")
+	}
+	for _, r := range output.OpenIDConnectProviderList {
+		provider, err := s.IAMClient.GetOpenIDConnectProvider(&iam.GetOpenIDConnectProviderInput{OpenIDConnectProviderArn: r.Arn})
+		if err != nil {
+			return "", errors.Wrap(err, "error getting provider")
+		}
+		// The provider URL doesn't contain the scheme of the issuer.
+		if aws.StringValue(provider.Url) == issuer || aws.StringValue(provider.Url) == strings.TrimPrefix(issuer, "https://") {
+			return *r.Arn, nil
+		}
+	}
+	return "", nil
+}
+
 func fetchRootCAThumbprint(issuerURL string, client *http.Client) (string, error) {
 	// needed to appease noctx.
 	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, issuerURL, nil)
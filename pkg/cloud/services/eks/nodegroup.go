@@ -19,6 +19,8 @@ package eks
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -35,9 +37,11 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	eksutil "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func (s *NodegroupService) describeNodegroup() (*eks.Nodegroup, error) {
@@ -248,6 +252,11 @@ func (s *NodegroupService) createNodegroup() (*eks.Nodegroup, error) {
 			Version: s.scope.ManagedMachinePool.Status.LaunchTemplateVersion,
 		}
 	}
+	releaseVersion, err := s.amiReleaseVersion()
+	if err != nil {
+		return nil, err
+	}
+	input.ReleaseVersion = releaseVersion
 
 	if err := input.Validate(); err != nil {
 		return nil, errors.Wrap(err, "created invalid CreateNodegroupInput")
@@ -324,13 +333,53 @@ func (s *NodegroupService) deleteNodegroupAndWait() (reterr error) {
 	return nil
 }
 
+// amiReleaseVersion returns the AMI release version the node group is pinned to, if any. The AMI must be
+// built for the Kubernetes minor version of the node group.
+func (s *NodegroupService) amiReleaseVersion() (*string, error) {
+	releaseVersion := s.scope.ManagedMachinePool.Spec.AMIVersion
+	if releaseVersion == nil {
+		return nil, nil
+	}
+
+	// Bottlerocket release versions are versions of Bottlerocket, which can't be checked against the
+	// Kubernetes version.
+	if amiType := s.scope.ManagedMachinePool.Spec.AMIType; amiType != nil && amiType.IsBottlerocket() {
+		return releaseVersion, nil
+	}
+
+	k8sVersion := s.scope.Version()
+	if k8sVersion == nil {
+		k8sVersion = s.scope.ControlPlane.Spec.Version
+	}
+	if k8sVersion == nil {
+		return releaseVersion, nil
+	}
+
+	amiVersion, err := eksutil.ParseAMIReleaseVersion(*releaseVersion)
+	if err != nil {
+		return nil, err
+	}
+	if versionToEKS(amiVersion) != versionToEKS(parseEKSVersion(*k8sVersion)) {
+		return nil, fmt.Errorf("AMI release version %s doesn't match the Kubernetes version %s of the node group", *releaseVersion, *k8sVersion)
+	}
+	return releaseVersion, nil
+}
+
 func (s *NodegroupService) reconcileNodegroupVersion(ng *eks.Nodegroup) error {
+	if conditions.IsTrue(s.scope.ControlPlane, ekscontrolplanev1.ControlPlaneUpgradingCondition) {
+		s.scope.Info("EKS control plane is upgrading, postponing the node group version update", "cluster", s.scope.KubernetesClusterName(), "nodegroup", s.scope.NodegroupName())
+		return nil
+	}
+
 	var specVersion *version.Version
 	if s.scope.Version() != nil {
 		specVersion = parseEKSVersion(*s.scope.Version())
 	}
 	ngVersion := version.MustParseGeneric(*ng.Version)
-	specAMI := s.scope.ManagedMachinePool.Spec.AMIVersion
+	specAMI, err := s.amiReleaseVersion()
+	if err != nil {
+		return err
+	}
 	ngAMI := *ng.ReleaseVersion
 	statusLaunchTemplateVersion := s.scope.ManagedMachinePool.Status.LaunchTemplateVersion
 	var ngLaunchTemplateVersion *string
@@ -351,8 +400,14 @@ func (s *NodegroupService) reconcileNodegroupVersion(ng *eks.Nodegroup) error {
 		case specVersion != nil && ngVersion.LessThan(specVersion):
 			// NOTE: you can only upgrade increments of minor versions. If you want to upgrade 1.14 to 1.16 we
 			// need to go 1.14-> 1.15 and then 1.15 -> 1.16.
-			input.Version = aws.String(versionToEKS(ngVersion.WithMinor(ngVersion.Minor() + 1)))
+			nextVersion := ngVersion.WithMinor(ngVersion.Minor() + 1)
+			input.Version = aws.String(versionToEKS(nextVersion))
 			updateMsg = fmt.Sprintf("to version %s", *input.Version)
+			// Pin the AMI once the node group reaches the Kubernetes version it was built for.
+			if specAMI != nil && !nextVersion.LessThan(specVersion) {
+				input.ReleaseVersion = specAMI
+				updateMsg = fmt.Sprintf("to version %s and AMI version %s", *input.Version, *input.ReleaseVersion)
+			}
 		case specAMI != nil && *specAMI != ngAMI:
 			input.ReleaseVersion = specAMI
 			updateMsg = fmt.Sprintf("to AMI version %s", *input.ReleaseVersion)
@@ -526,13 +581,6 @@ func (s *NodegroupService) reconcileNodegroup(ctx context.Context) error {
 		return errors.Wrap(err, "failed to set status")
 	}
 
-	switch *ng.Status {
-	case eks.NodegroupStatusCreating, eks.NodegroupStatusUpdating:
-		ng, err = s.waitForNodegroupActive()
-	default:
-		break
-	}
-
 	if annotations.ReplicasManagedByExternalAutoscaler(s.scope.MachinePool) {
 		// Set MachinePool replicas to the node group DesiredCapacity
 		ngDesiredCapacity := int32(aws.Int64Value(ng.ScalingConfig.DesiredSize))
@@ -547,8 +595,13 @@ func (s *NodegroupService) reconcileNodegroup(ctx context.Context) error {
 		}
 	}
 
-	if err != nil {
-		return errors.Wrap(err, "failed to wait for nodegroup to be active")
+	// Creating or updating a nodegroup can take a long time, so rather than waiting for it the status reporting
+	// the progress is recorded and the nodegroup is reconciled again later.
+	switch *ng.Status {
+	case eks.NodegroupStatusCreating, eks.NodegroupStatusUpdating:
+		return wait.NewPending(fmt.Sprintf("EKS nodegroup %s is %s", s.scope.NodegroupName(), strings.ToLower(*ng.Status)))
+	default:
+		break
 	}
 
 	if err := s.reconcileNodegroupVersion(ng); err != nil {
@@ -575,19 +628,36 @@ func (s *NodegroupService) setStatus(ng *eks.Nodegroup) error {
 	switch *ng.Status {
 	case eks.NodegroupStatusDeleting:
 		managedPool.Status.Ready = false
+		conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupActiveCondition, expinfrav1.EKSNodegroupDeletingReason, clusterv1.ConditionSeverityInfo, "")
 	case eks.NodegroupStatusCreateFailed, eks.NodegroupStatusDeleteFailed:
 		managedPool.Status.Ready = false
 		// TODO FailureReason
 		failureMsg := fmt.Sprintf("EKS nodegroup in failed %s status", *ng.Status)
+		if issues := nodegroupHealthIssues(ng); issues != "" {
+			failureMsg = fmt.Sprintf("%s: %s", failureMsg, issues)
+		}
 		managedPool.Status.FailureMessage = &failureMsg
-	case eks.NodegroupStatusActive:
+		conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupActiveCondition, expinfrav1.EKSNodegroupFailedReason, clusterv1.ConditionSeverityError, "%s", failureMsg)
+	case eks.NodegroupStatusActive, eks.NodegroupStatusDegraded:
 		managedPool.Status.Ready = true
 		managedPool.Status.FailureMessage = nil
 		// TODO FailureReason
+		if issues := nodegroupHealthIssues(ng); issues != "" {
+			conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupActiveCondition, expinfrav1.EKSNodegroupDegradedReason, clusterv1.ConditionSeverityWarning, "%s", issues)
+		} else {
+			conditions.MarkTrue(managedPool, expinfrav1.EKSNodegroupActiveCondition)
+		}
 	case eks.NodegroupStatusCreating:
 		managedPool.Status.Ready = false
+		if deadline, ok := s.creationDeadline(ng); ok && time.Now().After(deadline) {
+			conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupActiveCondition, expinfrav1.NodegroupCreationTimedOutReason, clusterv1.ConditionSeverityError,
+				"EKS nodegroup is still being created after %s", managedPool.Spec.CreationTimeout.Duration)
+		} else {
+			conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupActiveCondition, expinfrav1.EKSNodegroupCreatingReason, clusterv1.ConditionSeverityInfo, "")
+		}
 	case eks.NodegroupStatusUpdating:
 		managedPool.Status.Ready = true
+		conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupActiveCondition, expinfrav1.EKSNodegroupUpdatingReason, clusterv1.ConditionSeverityInfo, "")
 	default:
 		return errors.Errorf("unexpected EKS nodegroup status %s", *ng.Status)
 	}
@@ -618,26 +688,27 @@ func (s *NodegroupService) setStatus(ng *eks.Nodegroup) error {
 	return nil
 }
 
-func (s *NodegroupService) waitForNodegroupActive() (*eks.Nodegroup, error) {
-	eksClusterName := s.scope.KubernetesClusterName()
-	eksNodegroupName := s.scope.NodegroupName()
-	req := eks.DescribeNodegroupInput{
-		ClusterName:   aws.String(eksClusterName),
-		NodegroupName: aws.String(eksNodegroupName),
-	}
-	if err := s.EKSClient.WaitUntilNodegroupActive(&req); err != nil {
-		return nil, errors.Wrapf(err, "failed to wait for EKS nodegroup %q", *req.NodegroupName)
+// creationDeadline returns when the creation of the nodegroup times out, and false if its creation doesn't time out.
+func (s *NodegroupService) creationDeadline(ng *eks.Nodegroup) (time.Time, bool) {
+	timeout := s.scope.ManagedMachinePool.Spec.CreationTimeout
+	if timeout == nil || ng.CreatedAt == nil {
+		return time.Time{}, false
 	}
+	return ng.CreatedAt.Add(timeout.Duration), true
+}
 
-	s.scope.Info("EKS nodegroup is now available", "nodegroup-name", eksNodegroupName)
-
-	ng, err := s.describeNodegroup()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to describe EKS nodegroup")
+// nodegroupHealthIssues returns the health issues of the nodegroup, with their AWS codes verbatim.
+func nodegroupHealthIssues(ng *eks.Nodegroup) string {
+	if ng.Health == nil {
+		return ""
 	}
-	if err := s.setStatus(ng); err != nil {
-		return nil, errors.Wrap(err, "failed to set status")
+	issues := make([]string, 0, len(ng.Health.Issues))
+	for _, issue := range ng.Health.Issues {
+		msg := fmt.Sprintf("%s: %s", aws.StringValue(issue.Code), aws.StringValue(issue.Message))
+		if len(issue.ResourceIds) > 0 {
+			msg = fmt.Sprintf("%s (%s)", msg, strings.Join(aws.StringValueSlice(issue.ResourceIds), ", "))
+		}
+		issues = append(issues, msg)
 	}
-
-	return ng, nil
+	return strings.Join(issues, "; ")
 }
@@ -0,0 +1,810 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	awswait "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestCreateNodegroupCapacityType(t *testing.T) {
+	tests := []struct {
+		name               string
+		capacityType       *expinfrav1.ManagedMachinePoolCapacityType
+		expectCapacityType *string
+	}{
+		{
+			name:               "capacity type is not set",
+			capacityType:       nil,
+			expectCapacityType: nil,
+		},
+		{
+			name:               "onDemand capacity type is forwarded",
+			capacityType:       ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeOnDemand),
+			expectCapacityType: aws.String(eks.CapacityTypesOnDemand),
+		},
+		{
+			name:               "spot capacity type is forwarded",
+			capacityType:       ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeSpot),
+			expectCapacityType: aws.String(eks.CapacityTypesSpot),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+			machinePoolScope := newNodegroupTestScope(g, &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "nodegroup-name",
+					RoleName:         "nodegroup-role",
+					SubnetIDs:        []string{"subnet-1"},
+					CapacityType:     tc.capacityType,
+				},
+			})
+
+			iamMock.EXPECT().GetRole(&iam.GetRoleInput{
+				RoleName: aws.String("nodegroup-role"),
+			}).Return(&iam.GetRoleOutput{
+				Role: &iam.Role{
+					Arn:      aws.String("arn:aws:iam::123456789012:role/nodegroup-role"),
+					RoleName: aws.String("nodegroup-role"),
+				},
+			}, nil)
+			eksMock.EXPECT().CreateNodegroup(gomock.AssignableToTypeOf(&eks.CreateNodegroupInput{})).
+				DoAndReturn(func(input *eks.CreateNodegroupInput) (*eks.CreateNodegroupOutput, error) {
+					g.Expect(input.CapacityType).To(Equal(tc.expectCapacityType))
+					return &eks.CreateNodegroupOutput{Nodegroup: &eks.Nodegroup{}}, nil
+				})
+
+			s := NewNodegroupService(machinePoolScope)
+			s.EKSClient = eksMock
+			s.IAMClient = iamMock
+
+			_, err := s.createNodegroup()
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
+
+func TestCreateNodegroupAMIType(t *testing.T) {
+	tests := []struct {
+		name          string
+		amiType       *expinfrav1.ManagedMachineAMIType
+		expectAMIType *string
+	}{
+		{
+			name:          "ami type is not set",
+			amiType:       nil,
+			expectAMIType: nil,
+		},
+		{
+			name:          "AL2 ami type is forwarded",
+			amiType:       ptr.To(expinfrav1.Al2x86_64),
+			expectAMIType: aws.String("AL2_x86_64"),
+		},
+		{
+			name:          "AL2 GPU ami type is forwarded",
+			amiType:       ptr.To(expinfrav1.Al2x86_64GPU),
+			expectAMIType: aws.String("AL2_x86_64_GPU"),
+		},
+		{
+			name:          "AL2 Arm ami type is forwarded",
+			amiType:       ptr.To(expinfrav1.Al2Arm64),
+			expectAMIType: aws.String("AL2_ARM_64"),
+		},
+		{
+			name:          "AL2023 ami type is forwarded",
+			amiType:       ptr.To(expinfrav1.Al2023x86_64Standard),
+			expectAMIType: aws.String("AL2023_x86_64_STANDARD"),
+		},
+		{
+			name:          "AL2023 Arm ami type is forwarded",
+			amiType:       ptr.To(expinfrav1.Al2023Arm64Standard),
+			expectAMIType: aws.String("AL2023_ARM_64_STANDARD"),
+		},
+		{
+			name:          "AL2023 NVIDIA ami type is forwarded",
+			amiType:       ptr.To(expinfrav1.Al2023x86_64Nvidia),
+			expectAMIType: aws.String("AL2023_x86_64_NVIDIA"),
+		},
+		{
+			name:          "AL2023 Neuron ami type is forwarded",
+			amiType:       ptr.To(expinfrav1.Al2023x86_64Neuron),
+			expectAMIType: aws.String("AL2023_x86_64_NEURON"),
+		},
+		{
+			name:          "Bottlerocket ami type is forwarded",
+			amiType:       ptr.To(expinfrav1.BottlerocketX86_64),
+			expectAMIType: aws.String("BOTTLEROCKET_x86_64"),
+		},
+		{
+			name:          "Bottlerocket Arm ami type is forwarded",
+			amiType:       ptr.To(expinfrav1.BottlerocketArm64),
+			expectAMIType: aws.String("BOTTLEROCKET_ARM_64"),
+		},
+		{
+			name:          "Bottlerocket NVIDIA ami type is forwarded",
+			amiType:       ptr.To(expinfrav1.BottlerocketX86_64Nvidia),
+			expectAMIType: aws.String("BOTTLEROCKET_x86_64_NVIDIA"),
+		},
+		{
+			name:          "Bottlerocket Arm NVIDIA ami type is forwarded",
+			amiType:       ptr.To(expinfrav1.BottlerocketArm64Nvidia),
+			expectAMIType: aws.String("BOTTLEROCKET_ARM_64_NVIDIA"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+			machinePoolScope := newNodegroupTestScope(g, &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "nodegroup-name",
+					RoleName:         "nodegroup-role",
+					SubnetIDs:        []string{"subnet-1"},
+					AMIType:          tc.amiType,
+				},
+			})
+
+			iamMock.EXPECT().GetRole(&iam.GetRoleInput{
+				RoleName: aws.String("nodegroup-role"),
+			}).Return(&iam.GetRoleOutput{
+				Role: &iam.Role{
+					Arn:      aws.String("arn:aws:iam::123456789012:role/nodegroup-role"),
+					RoleName: aws.String("nodegroup-role"),
+				},
+			}, nil)
+			eksMock.EXPECT().CreateNodegroup(gomock.AssignableToTypeOf(&eks.CreateNodegroupInput{})).
+				DoAndReturn(func(input *eks.CreateNodegroupInput) (*eks.CreateNodegroupOutput, error) {
+					g.Expect(input.AmiType).To(Equal(tc.expectAMIType))
+					return &eks.CreateNodegroupOutput{Nodegroup: &eks.Nodegroup{}}, nil
+				})
+
+			s := NewNodegroupService(machinePoolScope)
+			s.EKSClient = eksMock
+			s.IAMClient = iamMock
+
+			_, err := s.createNodegroup()
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
+
+func TestCreateNodegroupAMIVersion(t *testing.T) {
+	tests := []struct {
+		name                 string
+		amiType              *expinfrav1.ManagedMachineAMIType
+		amiVersion           *string
+		expectReleaseVersion *string
+		expectError          bool
+	}{
+		{
+			name:                 "ami version is not set",
+			amiVersion:           nil,
+			expectReleaseVersion: nil,
+		},
+		{
+			name:                 "ami version is pinned",
+			amiVersion:           aws.String("1.16.8-20240101"),
+			expectReleaseVersion: aws.String("1.16.8-20240101"),
+		},
+		{
+			name:        "ami version for another kubernetes version is rejected",
+			amiVersion:  aws.String("1.15.11-20240101"),
+			expectError: true,
+		},
+		{
+			name:                 "bottlerocket ami version is pinned",
+			amiType:              ptr.To(expinfrav1.BottlerocketX86_64),
+			amiVersion:           aws.String("1.19.2-29cc92cc"),
+			expectReleaseVersion: aws.String("1.19.2-29cc92cc"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+			machinePoolScope := newNodegroupTestScope(g, &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "nodegroup-name",
+					RoleName:         "nodegroup-role",
+					SubnetIDs:        []string{"subnet-1"},
+					AMIType:          tc.amiType,
+					AMIVersion:       tc.amiVersion,
+				},
+			})
+			machinePoolScope.MachinePool.Spec.Template.Spec.Version = aws.String("v1.16.8")
+
+			iamMock.EXPECT().GetRole(&iam.GetRoleInput{
+				RoleName: aws.String("nodegroup-role"),
+			}).Return(&iam.GetRoleOutput{
+				Role: &iam.Role{
+					Arn:      aws.String("arn:aws:iam::123456789012:role/nodegroup-role"),
+					RoleName: aws.String("nodegroup-role"),
+				},
+			}, nil)
+			if !tc.expectError {
+				eksMock.EXPECT().CreateNodegroup(gomock.AssignableToTypeOf(&eks.CreateNodegroupInput{})).
+					DoAndReturn(func(input *eks.CreateNodegroupInput) (*eks.CreateNodegroupOutput, error) {
+						g.Expect(input.ReleaseVersion).To(Equal(tc.expectReleaseVersion))
+						return &eks.CreateNodegroupOutput{Nodegroup: &eks.Nodegroup{}}, nil
+					})
+			}
+
+			s := NewNodegroupService(machinePoolScope)
+			s.EKSClient = eksMock
+			s.IAMClient = iamMock
+
+			_, err := s.createNodegroup()
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
+
+func TestCreateNodegroupUpdateConfig(t *testing.T) {
+	tests := []struct {
+		name               string
+		updateConfig       *expinfrav1.UpdateConfig
+		expectUpdateConfig *eks.NodegroupUpdateConfig
+	}{
+		{
+			name:               "update config is not set",
+			updateConfig:       nil,
+			expectUpdateConfig: nil,
+		},
+		{
+			name:               "max unavailable is forwarded",
+			updateConfig:       &expinfrav1.UpdateConfig{MaxUnavailable: aws.Int(2)},
+			expectUpdateConfig: &eks.NodegroupUpdateConfig{MaxUnavailable: aws.Int64(2)},
+		},
+		{
+			name:               "max unavailable percentage is forwarded",
+			updateConfig:       &expinfrav1.UpdateConfig{MaxUnavailablePercentage: aws.Int(25)},
+			expectUpdateConfig: &eks.NodegroupUpdateConfig{MaxUnavailablePercentage: aws.Int64(25)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+			machinePoolScope := newNodegroupTestScope(g, &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "nodegroup-name",
+					RoleName:         "nodegroup-role",
+					SubnetIDs:        []string{"subnet-1"},
+					UpdateConfig:     tc.updateConfig,
+				},
+			})
+
+			iamMock.EXPECT().GetRole(&iam.GetRoleInput{
+				RoleName: aws.String("nodegroup-role"),
+			}).Return(&iam.GetRoleOutput{
+				Role: &iam.Role{
+					Arn:      aws.String("arn:aws:iam::123456789012:role/nodegroup-role"),
+					RoleName: aws.String("nodegroup-role"),
+				},
+			}, nil)
+			eksMock.EXPECT().CreateNodegroup(gomock.AssignableToTypeOf(&eks.CreateNodegroupInput{})).
+				DoAndReturn(func(input *eks.CreateNodegroupInput) (*eks.CreateNodegroupOutput, error) {
+					g.Expect(input.UpdateConfig).To(Equal(tc.expectUpdateConfig))
+					return &eks.CreateNodegroupOutput{Nodegroup: &eks.Nodegroup{}}, nil
+				})
+
+			s := NewNodegroupService(machinePoolScope)
+			s.EKSClient = eksMock
+			s.IAMClient = iamMock
+
+			_, err := s.createNodegroup()
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
+
+func TestReconcileNodegroupUpdateConfig(t *testing.T) {
+	tests := []struct {
+		name                string
+		updateConfig        *expinfrav1.UpdateConfig
+		currentUpdateConfig *eks.NodegroupUpdateConfig
+		expect              func(m *mock_eksiface.MockEKSAPIMockRecorder)
+	}{
+		{
+			name:                "update config matches",
+			updateConfig:        &expinfrav1.UpdateConfig{MaxUnavailable: aws.Int(1)},
+			currentUpdateConfig: &eks.NodegroupUpdateConfig{MaxUnavailable: aws.Int64(1)},
+			expect:              func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name:                "max unavailable is updated in place",
+			updateConfig:        &expinfrav1.UpdateConfig{MaxUnavailable: aws.Int(3)},
+			currentUpdateConfig: &eks.NodegroupUpdateConfig{MaxUnavailable: aws.Int64(1)},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+					ClusterName:   aws.String("cluster-name"),
+					NodegroupName: aws.String("nodegroup-name"),
+					UpdateConfig:  &eks.NodegroupUpdateConfig{MaxUnavailable: aws.Int64(3)},
+				}).Return(&eks.UpdateNodegroupConfigOutput{}, nil)
+			},
+		},
+		{
+			name:                "max unavailable is replaced by max unavailable percentage",
+			updateConfig:        &expinfrav1.UpdateConfig{MaxUnavailablePercentage: aws.Int(50)},
+			currentUpdateConfig: &eks.NodegroupUpdateConfig{MaxUnavailable: aws.Int64(1)},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+					ClusterName:   aws.String("cluster-name"),
+					NodegroupName: aws.String("nodegroup-name"),
+					UpdateConfig:  &eks.NodegroupUpdateConfig{MaxUnavailablePercentage: aws.Int64(50)},
+				}).Return(&eks.UpdateNodegroupConfigOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			tc.expect(eksMock.EXPECT())
+
+			machinePoolScope := newNodegroupTestScope(g, &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "nodegroup-name",
+					UpdateConfig:     tc.updateConfig,
+				},
+			})
+
+			s := NewNodegroupService(machinePoolScope)
+			s.EKSClient = eksMock
+
+			err := s.reconcileNodegroupConfig(&eks.Nodegroup{
+				NodegroupName: aws.String("nodegroup-name"),
+				ScalingConfig: &eks.NodegroupScalingConfig{DesiredSize: aws.Int64(1)},
+				UpdateConfig:  tc.currentUpdateConfig,
+			})
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
+
+func TestReconcileNodegroupVersionGatedOnControlPlaneUpgrade(t *testing.T) {
+	tests := []struct {
+		name                  string
+		controlPlaneUpgrading bool
+		expect                func(m *mock_eksiface.MockEKSAPIMockRecorder)
+	}{
+		{
+			name:                  "node group version is not updated while the control plane is upgrading",
+			controlPlaneUpgrading: true,
+			expect:                func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name:                  "node group version is updated once the control plane is upgraded",
+			controlPlaneUpgrading: false,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateNodegroupVersion(&eks.UpdateNodegroupVersionInput{
+					ClusterName:   aws.String("cluster-name"),
+					NodegroupName: aws.String("nodegroup-name"),
+					Version:       aws.String("1.16"),
+				}).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			tc.expect(eksMock.EXPECT())
+
+			machinePoolScope := newNodegroupTestScope(g, &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "nodegroup-name",
+				},
+			})
+			machinePoolScope.MachinePool.Spec.Template.Spec.Version = aws.String("v1.16.0")
+			if tc.controlPlaneUpgrading {
+				conditions.MarkTrue(machinePoolScope.ControlPlane, ekscontrolplanev1.ControlPlaneUpgradingCondition)
+			} else {
+				conditions.MarkFalse(machinePoolScope.ControlPlane, ekscontrolplanev1.ControlPlaneUpgradingCondition, ekscontrolplanev1.ControlPlaneUpgradedReason, clusterv1.ConditionSeverityInfo, "")
+			}
+
+			s := NewNodegroupService(machinePoolScope)
+			s.EKSClient = eksMock
+
+			err := s.reconcileNodegroupVersion(&eks.Nodegroup{
+				NodegroupName:  aws.String("nodegroup-name"),
+				Version:        aws.String("1.15"),
+				ReleaseVersion: aws.String("1.15.0-20240101"),
+			})
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
+
+func TestReconcileNodegroupAMIVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     string
+		amiVersion  *string
+		ngVersion   string
+		ngAMI       string
+		expect      func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectError bool
+	}{
+		{
+			name:       "node group is not updated when the ami version matches",
+			version:    "v1.16.8",
+			amiVersion: aws.String("1.16.8-20240101"),
+			ngVersion:  "1.16",
+			ngAMI:      "1.16.8-20240101",
+			expect:     func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name:       "node group is not updated when the ami version isn't pinned",
+			version:    "v1.16.8",
+			amiVersion: nil,
+			ngVersion:  "1.16",
+			ngAMI:      "1.16.8-20240101",
+			expect:     func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name:       "node group ami version is updated when the ami version changes",
+			version:    "v1.16.8",
+			amiVersion: aws.String("1.16.8-20240301"),
+			ngVersion:  "1.16",
+			ngAMI:      "1.16.8-20240101",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateNodegroupVersion(&eks.UpdateNodegroupVersionInput{
+					ClusterName:    aws.String("cluster-name"),
+					NodegroupName:  aws.String("nodegroup-name"),
+					ReleaseVersion: aws.String("1.16.8-20240301"),
+				}).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+			},
+		},
+		{
+			name:       "node group version and ami version are updated together",
+			version:    "v1.16.8",
+			amiVersion: aws.String("1.16.8-20240301"),
+			ngVersion:  "1.15",
+			ngAMI:      "1.15.11-20240101",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateNodegroupVersion(&eks.UpdateNodegroupVersionInput{
+					ClusterName:    aws.String("cluster-name"),
+					NodegroupName:  aws.String("nodegroup-name"),
+					Version:        aws.String("1.16"),
+					ReleaseVersion: aws.String("1.16.8-20240301"),
+				}).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+			},
+		},
+		{
+			name:       "ami version is not set while upgrading through intermediate versions",
+			version:    "v1.17.4",
+			amiVersion: aws.String("1.17.4-20240301"),
+			ngVersion:  "1.15",
+			ngAMI:      "1.15.11-20240101",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateNodegroupVersion(&eks.UpdateNodegroupVersionInput{
+					ClusterName:   aws.String("cluster-name"),
+					NodegroupName: aws.String("nodegroup-name"),
+					Version:       aws.String("1.16"),
+				}).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+			},
+		},
+		{
+			name:        "ami version for another kubernetes version is rejected",
+			version:     "v1.16.8",
+			amiVersion:  aws.String("1.15.11-20240301"),
+			ngVersion:   "1.16",
+			ngAMI:       "1.16.8-20240101",
+			expect:      func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			tc.expect(eksMock.EXPECT())
+
+			machinePoolScope := newNodegroupTestScope(g, &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "nodegroup-name",
+					AMIVersion:       tc.amiVersion,
+				},
+			})
+			machinePoolScope.MachinePool.Spec.Template.Spec.Version = aws.String(tc.version)
+
+			s := NewNodegroupService(machinePoolScope)
+			s.EKSClient = eksMock
+
+			err := s.reconcileNodegroupVersion(&eks.Nodegroup{
+				NodegroupName:  aws.String("nodegroup-name"),
+				Version:        aws.String(tc.ngVersion),
+				ReleaseVersion: aws.String(tc.ngAMI),
+			})
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
+
+func TestReconcileNodegroupStatus(t *testing.T) {
+	healthIssues := &eks.NodegroupHealth{
+		Issues: []*eks.Issue{
+			{
+				Code:        aws.String(eks.NodegroupIssueCodeEc2subnetInvalidConfiguration),
+				Message:     aws.String("One or more Amazon EC2 Subnets for the nodegroup do not automatically assign public IP addresses."),
+				ResourceIds: aws.StringSlice([]string{"subnet-1"}),
+			},
+		},
+	}
+
+	type observation struct {
+		status        string
+		health        *eks.NodegroupHealth
+		expectPending bool
+		expectReady   bool
+		expectStatus  corev1.ConditionStatus
+		expectReason  string
+		expectMessage string
+	}
+
+	tests := []struct {
+		name             string
+		creationTimeout  *metav1.Duration
+		createdAt        time.Time
+		observations     []observation
+		expectFailureMsg string
+	}{
+		{
+			name:      "creating nodegroup becomes active",
+			createdAt: time.Now(),
+			observations: []observation{
+				{
+					status:        eks.NodegroupStatusCreating,
+					expectPending: true,
+					expectStatus:  corev1.ConditionFalse,
+					expectReason:  expinfrav1.EKSNodegroupCreatingReason,
+				},
+				{
+					status:       eks.NodegroupStatusActive,
+					expectReady:  true,
+					expectStatus: corev1.ConditionTrue,
+				},
+			},
+		},
+		{
+			name:      "creating nodegroup fails with health issues",
+			createdAt: time.Now(),
+			observations: []observation{
+				{
+					status:        eks.NodegroupStatusCreating,
+					expectPending: true,
+					expectStatus:  corev1.ConditionFalse,
+					expectReason:  expinfrav1.EKSNodegroupCreatingReason,
+				},
+				{
+					status:        eks.NodegroupStatusCreateFailed,
+					health:        healthIssues,
+					expectStatus:  corev1.ConditionFalse,
+					expectReason:  expinfrav1.EKSNodegroupFailedReason,
+					expectMessage: "EKS nodegroup in failed CREATE_FAILED status: Ec2SubnetInvalidConfiguration: One or more Amazon EC2 Subnets for the nodegroup do not automatically assign public IP addresses. (subnet-1)",
+				},
+			},
+			expectFailureMsg: "EKS nodegroup in failed CREATE_FAILED status: Ec2SubnetInvalidConfiguration",
+		},
+		{
+			name:            "creating nodegroup times out",
+			creationTimeout: &metav1.Duration{Duration: 15 * time.Minute},
+			createdAt:       time.Now().Add(-time.Hour),
+			observations: []observation{
+				{
+					status:        eks.NodegroupStatusCreating,
+					expectPending: true,
+					expectStatus:  corev1.ConditionFalse,
+					expectReason:  expinfrav1.NodegroupCreationTimedOutReason,
+					expectMessage: "EKS nodegroup is still being created after 15m0s",
+				},
+			},
+		},
+		{
+			name:      "updating nodegroup is reconciled again later",
+			createdAt: time.Now().Add(-time.Hour),
+			observations: []observation{
+				{
+					status:        eks.NodegroupStatusUpdating,
+					expectPending: true,
+					expectReady:   true,
+					expectStatus:  corev1.ConditionFalse,
+					expectReason:  expinfrav1.EKSNodegroupUpdatingReason,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+
+			machinePoolScope := newNodegroupTestScope(g, &expinfrav1.AWSManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pool",
+					Namespace: "ns",
+				},
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "nodegroup-name",
+					CreationTimeout:  tc.creationTimeout,
+				},
+			})
+
+			s := NewNodegroupService(machinePoolScope)
+			s.EKSClient = eksMock
+
+			for _, o := range tc.observations {
+				ng := &eks.Nodegroup{
+					NodegroupName: aws.String("nodegroup-name"),
+					Status:        aws.String(o.status),
+					Health:        o.health,
+					CreatedAt:     aws.Time(tc.createdAt),
+					Tags:          aws.StringMap(map[string]string{infrav1.ClusterAWSCloudProviderTagKey("cluster-name"): string(infrav1.ResourceLifecycleOwned)}),
+				}
+				eksMock.EXPECT().DescribeNodegroup(gomock.Any()).Return(&eks.DescribeNodegroupOutput{Nodegroup: ng}, nil)
+
+				if o.expectPending {
+					// The reconcile returns without waiting for the nodegroup.
+					err := s.reconcileNodegroup(context.TODO())
+					g.Expect(awswait.IsPending(err)).To(BeTrue())
+				} else {
+					// The rest of the reconcile of the nodegroup isn't under test.
+					ng, err := s.describeNodegroup()
+					g.Expect(err).To(BeNil())
+					g.Expect(s.setStatus(ng)).To(Succeed())
+				}
+
+				managedPool := machinePoolScope.ManagedMachinePool
+				g.Expect(managedPool.Status.Ready).To(Equal(o.expectReady))
+				condition := conditions.Get(managedPool, expinfrav1.EKSNodegroupActiveCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(o.expectStatus))
+				g.Expect(condition.Reason).To(Equal(o.expectReason))
+				g.Expect(condition.Message).To(Equal(o.expectMessage))
+			}
+			if tc.expectFailureMsg != "" {
+				g.Expect(machinePoolScope.ManagedMachinePool.Status.FailureMessage).To(HaveValue(ContainSubstring(tc.expectFailureMsg)))
+			}
+		})
+	}
+}
+
+func newNodegroupTestScope(g *WithT, managedMachinePool *expinfrav1.AWSManagedMachinePool) *scope.ManagedMachinePoolScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	_ = expinfrav1.AddToScheme(scheme)
+	_ = expclusterv1.AddToScheme(scheme)
+	clientBuilder := fake.NewClientBuilder().WithScheme(scheme)
+	if managedMachinePool.Name != "" {
+		// Setting the status of the nodegroup patches the AWSManagedMachinePool.
+		clientBuilder = clientBuilder.WithObjects(managedMachinePool).WithStatusSubresource(managedMachinePool)
+	}
+	client := clientBuilder.Build()
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "cluster-name",
+		},
+	}
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			EKSClusterName: "cluster-name",
+		},
+	}
+	controlPlaneScope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client:       client,
+		Cluster:      cluster,
+		ControlPlane: controlPlane,
+	})
+	g.Expect(err).To(BeNil())
+
+	machinePoolScope, err := scope.NewManagedMachinePoolScope(scope.ManagedMachinePoolScopeParams{
+		Client:             client,
+		Cluster:            cluster,
+		ControlPlane:       controlPlane,
+		InfraCluster:       controlPlaneScope,
+		ManagedMachinePool: managedMachinePool,
+		MachinePool:        &expclusterv1.MachinePool{},
+	})
+	g.Expect(err).To(BeNil())
+
+	return machinePoolScope
+}
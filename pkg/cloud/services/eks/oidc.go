@@ -22,6 +22,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/pkg/errors"
@@ -92,6 +93,39 @@ func (s *Service) reconcileOIDCProvider(cluster *eks.Cluster) error {
 	return nil
 }
 
+// reconcileExternalOIDCProvider records the existing OIDC provider of an externally managed cluster in the status,
+// without creating or tagging it.
+func (s *Service) reconcileExternalOIDCProvider(cluster *eks.Cluster) error {
+	if !s.scope.ControlPlane.Spec.AssociateOIDCProvider || s.scope.ControlPlane.Status.OIDCProvider.ARN != "" {
+		return nil
+	}
+	if cluster.Identity == nil || cluster.Identity.Oidc == nil || aws.StringValue(cluster.Identity.Oidc.Issuer) == "" {
+		return errors.New("externally managed cluster has no OIDC issuer")
+	}
+
+	oidcProvider, err := s.FindOIDCProvider(*cluster.Identity.Oidc.Issuer)
+	if err != nil {
+		return errors.Wrap(err, "failed to find OIDC provider")
+	}
+	if oidcProvider == "" {
+		s.scope.Info("No OIDC provider associated with the externally managed EKS cluster", "cluster-name", cluster.Name)
+		return nil
+	}
+
+	s.scope.ControlPlane.Status.OIDCProvider.ARN = oidcProvider
+
+	policy, err := converters.IAMPolicyDocumentToJSON(s.buildOIDCTrustPolicy())
+	if err != nil {
+		return errors.Wrap(err, "failed to parse IAM policy")
+	}
+	s.scope.ControlPlane.Status.OIDCProvider.TrustPolicy = whitespaceRe.ReplaceAllString(policy, "")
+	if err := s.scope.PatchObject(); err != nil {
+		return errors.Wrap(err, "failed to update control plane with OIDC provider ARN")
+	}
+
+	return nil
+}
+
 func (s *Service) reconcileTrustPolicy() error {
 	ctx := context.Background()
 
@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	eksaddons "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/addons"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const podIdentityAgentAddonName = "eks-pod-identity-agent"
+
+// podIdentityAssociation is an EKS Pod Identity association of a cluster.
+type podIdentityAssociation struct {
+	id      string
+	roleARN string
+}
+
+func podIdentityAssociationKey(namespace, serviceAccount string) string {
+	return namespace + "/" + serviceAccount
+}
+
+// reconcilePodIdentityAssociations reconciles the pod identity associations of the cluster created by CAPA. The
+// service accounts of the desired associations that already have an association created by other tools are skipped,
+// and returned.
+func (s *Service) reconcilePodIdentityAssociations(_ context.Context) ([]string, error) {
+	s.scope.Debug("Reconciling EKS pod identity associations")
+
+	eksClusterName := s.scope.KubernetesClusterName()
+
+	current, unowned, err := s.listPodIdentityAssociations(eksClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("listing eks pod identity associations: %w", err)
+	}
+
+	desired := map[string]ekscontrolplanev1.PodIdentityAssociation{}
+	var conflicting []string
+	for _, association := range s.scope.PodIdentityAssociations() {
+		key := podIdentityAssociationKey(association.ServiceAccountNamespace, association.ServiceAccountName)
+		desired[key] = association
+
+		existing, ok := current[key]
+		switch {
+		case !ok && unowned.Has(key):
+			// EKS allows a single association per service account.
+			s.scope.Info("Skipping pod identity association, the service account already has an association not created by CAPA", "serviceAccount", key)
+			conflicting = append(conflicting, key)
+		case !ok:
+			if err := s.createPodIdentityAssociation(eksClusterName, association); err != nil {
+				return nil, err
+			}
+		case existing.roleARN != association.RoleARN:
+			if err := s.updatePodIdentityAssociation(eksClusterName, existing.id, association); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for key, existing := range current {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if err := s.deletePodIdentityAssociation(eksClusterName, key, existing.id); err != nil {
+			return nil, err
+		}
+	}
+
+	s.scope.Debug("Reconcile EKS pod identity associations completed successfully")
+	return conflicting, nil
+}
+
+// listPodIdentityAssociations returns the pod identity associations of the cluster that were created by CAPA, keyed by
+// service account, and the service accounts of the associations created by other tools, which are left untouched.
+func (s *Service) listPodIdentityAssociations(eksClusterName string) (map[string]podIdentityAssociation, sets.Set[string], error) {
+	summaries := []*eks.PodIdentityAssociationSummary{}
+	input := &eks.ListPodIdentityAssociationsInput{
+		ClusterName: aws.String(eksClusterName),
+	}
+	if err := s.EKSClient.ListPodIdentityAssociationsPages(input, func(output *eks.ListPodIdentityAssociationsOutput, _ bool) bool {
+		summaries = append(summaries, output.Associations...)
+		return true
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	ownedTagKey := infrav1.ClusterAWSCloudProviderTagKey(s.scope.Cluster.Name)
+	associations := map[string]podIdentityAssociation{}
+	unowned := sets.New[string]()
+	for _, summary := range summaries {
+		output, err := s.EKSClient.DescribePodIdentityAssociation(&eks.DescribePodIdentityAssociationInput{
+			AssociationId: summary.AssociationId,
+			ClusterName:   aws.String(eksClusterName),
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("describing eks pod identity association %s: %w", aws.StringValue(summary.AssociationId), err)
+		}
+
+		association := output.Association
+		key := podIdentityAssociationKey(aws.StringValue(association.Namespace), aws.StringValue(association.ServiceAccount))
+		if aws.StringValue(association.Tags[ownedTagKey]) != string(infrav1.ResourceLifecycleOwned) {
+			unowned.Insert(key)
+			continue
+		}
+		associations[key] = podIdentityAssociation{
+			id:      aws.StringValue(association.AssociationId),
+			roleARN: aws.StringValue(association.RoleArn),
+		}
+	}
+
+	return associations, unowned, nil
+}
+
+func (s *Service) createPodIdentityAssociation(eksClusterName string, association ekscontrolplanev1.PodIdentityAssociation) error {
+	key := podIdentityAssociationKey(association.ServiceAccountNamespace, association.ServiceAccountName)
+	if _, err := s.EKSClient.CreatePodIdentityAssociation(&eks.CreatePodIdentityAssociationInput{
+		ClusterName:    aws.String(eksClusterName),
+		Namespace:      aws.String(association.ServiceAccountNamespace),
+		ServiceAccount: aws.String(association.ServiceAccountName),
+		RoleArn:        aws.String(association.RoleARN),
+		Tags:           aws.StringMap(ngTags(s.scope.Cluster.Name, s.scope.AdditionalTags())),
+	}); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedCreateEKSPodIdentityAssociation", "Failed to create pod identity association for service account %s: %v", key, err)
+		return fmt.Errorf("creating eks pod identity association for service account %s: %w", key, err)
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulCreateEKSPodIdentityAssociation", "Created pod identity association for service account %s", key)
+	return nil
+}
+
+func (s *Service) updatePodIdentityAssociation(eksClusterName, id string, association ekscontrolplanev1.PodIdentityAssociation) error {
+	key := podIdentityAssociationKey(association.ServiceAccountNamespace, association.ServiceAccountName)
+	if _, err := s.EKSClient.UpdatePodIdentityAssociation(&eks.UpdatePodIdentityAssociationInput{
+		AssociationId: aws.String(id),
+		ClusterName:   aws.String(eksClusterName),
+		RoleArn:       aws.String(association.RoleARN),
+	}); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSPodIdentityAssociation", "Failed to update pod identity association for service account %s: %v", key, err)
+		return fmt.Errorf("updating eks pod identity association for service account %s: %w", key, err)
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulUpdateEKSPodIdentityAssociation", "Updated pod identity association for service account %s", key)
+	return nil
+}
+
+func (s *Service) deletePodIdentityAssociation(eksClusterName, key, id string) error {
+	if _, err := s.EKSClient.DeletePodIdentityAssociation(&eks.DeletePodIdentityAssociationInput{
+		AssociationId: aws.String(id),
+		ClusterName:   aws.String(eksClusterName),
+	}); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedDeleteEKSPodIdentityAssociation", "Failed to delete pod identity association for service account %s: %v", key, err)
+		return fmt.Errorf("deleting eks pod identity association for service account %s: %w", key, err)
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulDeleteEKSPodIdentityAssociation", "Deleted pod identity association for service account %s", key)
+	return nil
+}
+
+// podIdentityAgentAddon returns the eks-pod-identity-agent addon to install when pod identity associations
+// are specified but the addon isn't. An installed agent is kept at its version, otherwise the default version
+// for the Kubernetes version of the cluster is used.
+func (s *Service) podIdentityAgentAddon(desired, installed []*eksaddons.EKSAddon) (*eksaddons.EKSAddon, error) {
+	if len(s.scope.PodIdentityAssociations()) == 0 {
+		return nil, nil
+	}
+	for _, addon := range desired {
+		if aws.StringValue(addon.Name) == podIdentityAgentAddonName {
+			return nil, nil
+		}
+	}
+
+	addon := &eksaddons.EKSAddon{
+		Name:            aws.String(podIdentityAgentAddonName),
+		Configuration:   aws.String(""),
+		Tags:            ngTags(s.scope.Cluster.Name, s.scope.AdditionalTags()),
+		ResolveConflict: aws.String(eks.ResolveConflictsOverwrite),
+	}
+	for _, i := range installed {
+		if aws.StringValue(i.Name) == podIdentityAgentAddonName {
+			addon.Version = i.Version
+			return addon, nil
+		}
+	}
+
+	version, err := s.defaultAddonVersion(podIdentityAgentAddonName)
+	if err != nil {
+		return nil, err
+	}
+	addon.Version = version
+	return addon, nil
+}
+
+// defaultAddonVersion returns the default version of an addon for the Kubernetes version of the cluster.
+func (s *Service) defaultAddonVersion(addonName string) (*string, error) {
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName:         aws.String(addonName),
+		KubernetesVersion: s.scope.ControlPlane.Status.Version,
+	}
+
+	var version *string
+	if err := s.EKSClient.DescribeAddonVersionsPages(input, func(output *eks.DescribeAddonVersionsOutput, _ bool) bool {
+		for _, addon := range output.Addons {
+			for _, addonVersion := range addon.AddonVersions {
+				for _, compatibility := range addonVersion.Compatibilities {
+					if aws.BoolValue(compatibility.DefaultVersion) {
+						version = addonVersion.AddonVersion
+						return false
+					}
+				}
+			}
+		}
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("describing versions of eks addon %s: %w", addonName, err)
+	}
+
+	if version == nil {
+		return nil, fmt.Errorf("no default version of eks addon %s found", addonName)
+	}
+	return version, nil
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	eksaddons "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/addons"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestReconcilePodIdentityAssociations(t *testing.T) {
+	clusterName := "default.cluster"
+	ownedTags := map[string]*string{
+		infrav1.ClusterAWSCloudProviderTagKey("capi-cluster"): aws.String(string(infrav1.ResourceLifecycleOwned)),
+	}
+	listAssociations := func(m *mock_eksiface.MockEKSAPIMockRecorder, associations ...*eks.PodIdentityAssociation) {
+		summaries := []*eks.PodIdentityAssociationSummary{}
+		for _, association := range associations {
+			summaries = append(summaries, &eks.PodIdentityAssociationSummary{
+				AssociationId:  association.AssociationId,
+				Namespace:      association.Namespace,
+				ServiceAccount: association.ServiceAccount,
+			})
+		}
+		m.
+			ListPodIdentityAssociationsPages(&eks.ListPodIdentityAssociationsInput{ClusterName: aws.String(clusterName)}, gomock.Any()).
+			DoAndReturn(func(_ *eks.ListPodIdentityAssociationsInput, fn func(*eks.ListPodIdentityAssociationsOutput, bool) bool) error {
+				fn(&eks.ListPodIdentityAssociationsOutput{Associations: summaries}, true)
+				return nil
+			})
+		for _, association := range associations {
+			m.
+				DescribePodIdentityAssociation(&eks.DescribePodIdentityAssociationInput{
+					AssociationId: association.AssociationId,
+					ClusterName:   aws.String(clusterName),
+				}).
+				Return(&eks.DescribePodIdentityAssociationOutput{Association: association}, nil)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		associations []ekscontrolplanev1.PodIdentityAssociation
+		expect       func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectError  bool
+		conflicting  []string
+	}{
+		{
+			name: "no associations desired or existing",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				listAssociations(m)
+			},
+		},
+		{
+			name: "missing association is created",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{ServiceAccountNamespace: "kube-system", ServiceAccountName: "sa", RoleARN: "arn:aws:iam::123456789012:role/role"},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				listAssociations(m)
+				m.
+					CreatePodIdentityAssociation(&eks.CreatePodIdentityAssociationInput{
+						ClusterName:    aws.String(clusterName),
+						Namespace:      aws.String("kube-system"),
+						ServiceAccount: aws.String("sa"),
+						RoleArn:        aws.String("arn:aws:iam::123456789012:role/role"),
+						Tags:           ownedTags,
+					}).
+					Return(&eks.CreatePodIdentityAssociationOutput{}, nil)
+			},
+		},
+		{
+			name: "association with a different role is updated",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{ServiceAccountNamespace: "kube-system", ServiceAccountName: "sa", RoleARN: "arn:aws:iam::123456789012:role/new"},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				listAssociations(m, &eks.PodIdentityAssociation{
+					AssociationId:  aws.String("a-1"),
+					Namespace:      aws.String("kube-system"),
+					ServiceAccount: aws.String("sa"),
+					RoleArn:        aws.String("arn:aws:iam::123456789012:role/old"),
+					Tags:           ownedTags,
+				})
+				m.
+					UpdatePodIdentityAssociation(&eks.UpdatePodIdentityAssociationInput{
+						AssociationId: aws.String("a-1"),
+						ClusterName:   aws.String(clusterName),
+						RoleArn:       aws.String("arn:aws:iam::123456789012:role/new"),
+					}).
+					Return(&eks.UpdatePodIdentityAssociationOutput{}, nil)
+			},
+		},
+		{
+			name: "association with the same role is left untouched",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{ServiceAccountNamespace: "kube-system", ServiceAccountName: "sa", RoleARN: "arn:aws:iam::123456789012:role/role"},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				listAssociations(m, &eks.PodIdentityAssociation{
+					AssociationId:  aws.String("a-1"),
+					Namespace:      aws.String("kube-system"),
+					ServiceAccount: aws.String("sa"),
+					RoleArn:        aws.String("arn:aws:iam::123456789012:role/role"),
+					Tags:           ownedTags,
+				})
+			},
+		},
+		{
+			name: "owned association that isn't desired is deleted",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				listAssociations(m, &eks.PodIdentityAssociation{
+					AssociationId:  aws.String("a-1"),
+					Namespace:      aws.String("kube-system"),
+					ServiceAccount: aws.String("sa"),
+					RoleArn:        aws.String("arn:aws:iam::123456789012:role/role"),
+					Tags:           ownedTags,
+				})
+				m.
+					DeletePodIdentityAssociation(&eks.DeletePodIdentityAssociationInput{
+						AssociationId: aws.String("a-1"),
+						ClusterName:   aws.String(clusterName),
+					}).
+					Return(&eks.DeletePodIdentityAssociationOutput{}, nil)
+			},
+		},
+		{
+			name: "association that isn't owned is left untouched",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				listAssociations(m, &eks.PodIdentityAssociation{
+					AssociationId:  aws.String("a-1"),
+					Namespace:      aws.String("kube-system"),
+					ServiceAccount: aws.String("sa"),
+					RoleArn:        aws.String("arn:aws:iam::123456789012:role/role"),
+				})
+			},
+		},
+		{
+			name: "desired association is skipped when the service account has an association that isn't owned",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{ServiceAccountNamespace: "kube-system", ServiceAccountName: "sa", RoleARN: "arn:aws:iam::123456789012:role/role"},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				listAssociations(m, &eks.PodIdentityAssociation{
+					AssociationId:  aws.String("a-1"),
+					Namespace:      aws.String("kube-system"),
+					ServiceAccount: aws.String("sa"),
+					RoleArn:        aws.String("arn:aws:iam::123456789012:role/other"),
+				})
+			},
+			conflicting: []string{"kube-system/sa"},
+		},
+		{
+			name: "failure to create association is returned",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{ServiceAccountNamespace: "kube-system", ServiceAccountName: "sa", RoleARN: "arn:aws:iam::123456789012:role/role"},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				listAssociations(m)
+				m.
+					CreatePodIdentityAssociation(gomock.AssignableToTypeOf(&eks.CreatePodIdentityAssociationInput{})).
+					Return(nil, &eks.InvalidParameterException{})
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			tc.expect(eksMock.EXPECT())
+
+			s := NewService(newPodIdentityTestScope(g, tc.associations, clusterName))
+			s.EKSClient = eksMock
+
+			conflicting, err := s.reconcilePodIdentityAssociations(context.TODO())
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(conflicting).To(Equal(tc.conflicting))
+		})
+	}
+}
+
+func TestPodIdentityAgentAddon(t *testing.T) {
+	clusterName := "default.cluster"
+	associations := []ekscontrolplanev1.PodIdentityAssociation{
+		{ServiceAccountNamespace: "kube-system", ServiceAccountName: "sa", RoleARN: "arn:aws:iam::123456789012:role/role"},
+	}
+
+	tests := []struct {
+		name          string
+		associations  []ekscontrolplanev1.PodIdentityAssociation
+		desired       []*eksaddons.EKSAddon
+		installed     []*eksaddons.EKSAddon
+		expect        func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectVersion *string
+	}{
+		{
+			name: "no associations",
+		},
+		{
+			name:         "agent is specified",
+			associations: associations,
+			desired:      []*eksaddons.EKSAddon{{Name: aws.String(podIdentityAgentAddonName), Version: aws.String("v1.0.0")}},
+		},
+		{
+			name:          "agent is installed",
+			associations:  associations,
+			installed:     []*eksaddons.EKSAddon{{Name: aws.String(podIdentityAgentAddonName), Version: aws.String("v1.0.0")}},
+			expectVersion: aws.String("v1.0.0"),
+		},
+		{
+			name:         "agent is not installed",
+			associations: associations,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					DescribeAddonVersionsPages(&eks.DescribeAddonVersionsInput{
+						AddonName:         aws.String(podIdentityAgentAddonName),
+						KubernetesVersion: aws.String("1.28"),
+					}, gomock.Any()).
+					DoAndReturn(func(_ *eks.DescribeAddonVersionsInput, fn func(*eks.DescribeAddonVersionsOutput, bool) bool) error {
+						fn(&eks.DescribeAddonVersionsOutput{
+							Addons: []*eks.AddonInfo{{
+								AddonName: aws.String(podIdentityAgentAddonName),
+								AddonVersions: []*eks.AddonVersionInfo{
+									{
+										AddonVersion:    aws.String("v1.1.0"),
+										Compatibilities: []*eks.Compatibility{{ClusterVersion: aws.String("1.28"), DefaultVersion: aws.Bool(false)}},
+									},
+									{
+										AddonVersion:    aws.String("v1.0.0"),
+										Compatibilities: []*eks.Compatibility{{ClusterVersion: aws.String("1.28"), DefaultVersion: aws.Bool(true)}},
+									},
+								},
+							}},
+						}, true)
+						return nil
+					})
+			},
+			expectVersion: aws.String("v1.0.0"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			if tc.expect != nil {
+				tc.expect(eksMock.EXPECT())
+			}
+
+			controlPlaneScope := newPodIdentityTestScope(g, tc.associations, clusterName)
+			controlPlaneScope.ControlPlane.Status.Version = aws.String("1.28")
+			s := NewService(controlPlaneScope)
+			s.EKSClient = eksMock
+
+			addon, err := s.podIdentityAgentAddon(tc.desired, tc.installed)
+			g.Expect(err).To(BeNil())
+			if tc.expectVersion == nil {
+				g.Expect(addon).To(BeNil())
+				return
+			}
+			g.Expect(addon.Name).To(Equal(aws.String(podIdentityAgentAddonName)))
+			g.Expect(addon.Version).To(Equal(tc.expectVersion))
+		})
+	}
+}
+
+func newPodIdentityTestScope(g *WithT, associations []ekscontrolplanev1.PodIdentityAssociation, clusterName string) *scope.ManagedControlPlaneScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	controlPlaneScope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "capi-cluster",
+			},
+		},
+		ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+			Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName:          clusterName,
+				PodIdentityAssociations: associations,
+			},
+		},
+	})
+	g.Expect(err).To(BeNil())
+	return controlPlaneScope
+}
@@ -18,20 +18,47 @@ package eks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
-	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
 )
 
+// TagsLastAppliedAnnotation is the key for the AWSManagedControlPlane object annotation which tracks the
+// additional tags applied to the EKS cluster and propagated to its resources, so the tags removed from the
+// additional tags can be removed without removing the tags set by others.
+const TagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-aws-last-applied-tags"
+
+// oidcProviderTagsResyncPeriod is the period after which the tags of the OIDC provider are listed again when the
+// additional tags didn't change, as IAM is global and rate-limited.
+const oidcProviderTagsResyncPeriod = time.Hour
+
+// oidcProviderTagsSyncs records the last propagation of the additional tags to each OIDC provider, by ARN.
+var oidcProviderTagsSyncs sync.Map
+
+// oidcProviderTagsSync is the last propagation of the additional tags to an OIDC provider.
+type oidcProviderTagsSync struct {
+	tags infrav1.Tags
+	time time.Time
+}
+
 const (
 	eksClusterNameTag              = "eks:cluster-name"
 	eksNodeGroupNameTag            = "eks:nodegroup-name"
@@ -39,13 +66,210 @@ const (
 )
 
 func (s *Service) reconcileTags(cluster *eks.Cluster) error {
-	clusterTags := converters.MapPtrToMap(cluster.Tags)
-	buildParams := s.getEKSTagParams(*cluster.Arn)
-	tagsBuilder := tags.New(buildParams, tags.WithEKS(s.EKSClient))
-	if err := tagsBuilder.Ensure(clusterTags); err != nil {
+	previous, err := s.lastAppliedTags()
+	if err != nil {
+		return fmt.Errorf("failed to parse annotation %q: %w", TagsLastAppliedAnnotation, err)
+	}
+	additional := s.scope.AdditionalTags()
+	current := converters.MapPtrToMap(cluster.Tags)
+
+	// Only the tags previously applied are removed, the tags set by others are preserved.
+	params := s.getEKSTagParams(*cluster.Arn)
+	desired := infrav1.Build(*params)
+	clusterPrevious := previous
+	_, recorded := s.scope.ControlPlane.GetAnnotations()[TagsLastAppliedAnnotation]
+	if !recorded {
+		// The additional tags applied before the annotation existed weren't recorded: the tags of the cluster CAPA
+		// doesn't always apply are assumed to be additional tags, so the ones removed from the spec are removed.
+		params.Additional = nil
+		clusterPrevious = untrackedTags(current, infrav1.Build(*params))
+	}
+	untagKeys, newTags := getOwnedTagUpdates(current, desired, clusterPrevious)
+	if err := tagEKSResource(s.EKSClient, cluster.Arn, untagKeys, newTags); err != nil {
 		return fmt.Errorf("failed ensuring tags on cluster: %w", err)
 	}
 
+	if err := s.propagateTags(previous, additional); err != nil {
+		return err
+	}
+
+	if !recorded || !cmp.Equal(previous, additional, cmpopts.EquateEmpty()) {
+		annotation, err := json.Marshal(additional)
+		if err != nil {
+			return err
+		}
+		annotations := s.scope.ControlPlane.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[TagsLastAppliedAnnotation] = string(annotation)
+		s.scope.ControlPlane.SetAnnotations(annotations)
+	}
+
+	return nil
+}
+
+// lastAppliedTags returns the additional tags recorded in the TagsLastAppliedAnnotation annotation.
+func (s *Service) lastAppliedTags() (infrav1.Tags, error) {
+	annotation := s.scope.ControlPlane.GetAnnotations()[TagsLastAppliedAnnotation]
+	if annotation == "" {
+		return infrav1.Tags{}, nil
+	}
+	tags := infrav1.Tags{}
+	if err := json.Unmarshal([]byte(annotation), &tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// propagateTags propagates the additional tags of the cluster to the OIDC provider and the cluster security group
+// the managed nodes use, removing the additional tags previously propagated that are no longer set.
+func (s *Service) propagateTags(previous, additional infrav1.Tags) error {
+	if sg, ok := s.scope.ControlPlane.Status.Network.SecurityGroups[ekscontrolplanev1.SecurityGroupCluster]; ok && sg.ID != "" {
+		untagKeys, newTags := getOwnedTagUpdates(sg.Tags, additional, previous)
+		if len(newTags) > 0 {
+			if _, err := s.EC2Client.CreateTagsWithContext(context.TODO(), &ec2.CreateTagsInput{
+				Resources: aws.StringSlice([]string{sg.ID}),
+				Tags:      converters.MapToTags(newTags),
+			}); err != nil {
+				return fmt.Errorf("failed tagging cluster security group %s: %w", sg.ID, err)
+			}
+		}
+		if len(untagKeys) > 0 {
+			tags := make([]*ec2.Tag, 0, len(untagKeys))
+			for _, key := range untagKeys {
+				tags = append(tags, &ec2.Tag{Key: aws.String(key)})
+			}
+			if _, err := s.EC2Client.DeleteTagsWithContext(context.TODO(), &ec2.DeleteTagsInput{
+				Resources: aws.StringSlice([]string{sg.ID}),
+				Tags:      tags,
+			}); err != nil {
+				return fmt.Errorf("failed untagging cluster security group %s: %w", sg.ID, err)
+			}
+		}
+	}
+
+	if arn := s.scope.ControlPlane.Status.OIDCProvider.ARN; arn != "" {
+		if err := s.propagateOIDCProviderTags(arn, previous, additional); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// propagateOIDCProviderTags propagates the additional tags of the cluster to the OIDC provider with the given ARN. The
+// tags of the provider are only listed when the additional tags changed since the last propagation to the provider,
+// or after the oidcProviderTagsResyncPeriod.
+func (s *Service) propagateOIDCProviderTags(arn string, previous, additional infrav1.Tags) error {
+	if last, ok := oidcProviderTagsSyncs.Load(arn); ok {
+		last := last.(oidcProviderTagsSync)
+		if cmp.Equal(last.tags, additional, cmpopts.EquateEmpty()) && time.Since(last.time) < oidcProviderTagsResyncPeriod {
+			return nil
+		}
+	}
+
+	current, err := s.listOIDCProviderTags(arn)
+	if err != nil {
+		return err
+	}
+	untagKeys, newTags := getOwnedTagUpdates(current, additional, previous)
+	if len(newTags) > 0 {
+		if _, err := s.IAMClient.TagOpenIDConnectProvider(&iam.TagOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(arn),
+			Tags:                     converters.MapToIAMTags(newTags),
+		}); err != nil {
+			return fmt.Errorf("failed tagging OIDC provider: %w", err)
+		}
+	}
+	if len(untagKeys) > 0 {
+		if _, err := s.IAMClient.UntagOpenIDConnectProvider(&iam.UntagOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(arn),
+			TagKeys:                  aws.StringSlice(untagKeys),
+		}); err != nil {
+			return fmt.Errorf("failed untagging OIDC provider: %w", err)
+		}
+	}
+	oidcProviderTagsSyncs.Store(arn, oidcProviderTagsSync{tags: additional, time: time.Now()})
+
+	return nil
+}
+
+// listOIDCProviderTags returns the current tags of the OIDC provider with the given ARN.
+func (s *Service) listOIDCProviderTags(arn string) (infrav1.Tags, error) {
+	tags := infrav1.Tags{}
+	input := &iam.ListOpenIDConnectProviderTagsInput{
+		OpenIDConnectProviderArn: aws.String(arn),
+	}
+	for {
+		out, err := s.IAMClient.ListOpenIDConnectProviderTags(input)
+		if err != nil {
+			return nil, fmt.Errorf("failed listing tags of OIDC provider: %w", err)
+		}
+		for _, tag := range out.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return tags, nil
+		}
+		input.Marker = out.Marker
+	}
+}
+
+// untrackedTags returns the tags of a resource which aren't in the given base tags, ignoring the tags reserved by AWS.
+func untrackedTags(current, base infrav1.Tags) infrav1.Tags {
+	tags := infrav1.Tags{}
+	for key, value := range current {
+		if _, ok := base[key]; ok || strings.HasPrefix(key, "aws:") {
+			continue
+		}
+		tags[key] = value
+	}
+	return tags
+}
+
+// getOwnedTagUpdates returns the keys of the tags to remove and the tags to create or update to go from the current
+// tags of a resource to the desired ones. Only the tags previously applied are removed, so the tags set by others
+// are preserved.
+func getOwnedTagUpdates(current, desired, previous infrav1.Tags) (untagKeys []string, newTags infrav1.Tags) {
+	untagKeys = []string{}
+	newTags = infrav1.Tags{}
+	for key := range previous {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if _, ok := current[key]; ok {
+			untagKeys = append(untagKeys, key)
+		}
+	}
+	sort.Strings(untagKeys)
+	for key, value := range desired {
+		if currentValue, ok := current[key]; !ok || value != currentValue {
+			newTags[key] = value
+		}
+	}
+	return untagKeys, newTags
+}
+
+func tagEKSResource(client eksiface.EKSAPI, arn *string, untagKeys []string, newTags infrav1.Tags) error {
+	if len(newTags) > 0 {
+		if _, err := client.TagResource(&eks.TagResourceInput{
+			ResourceArn: arn,
+			Tags:        aws.StringMap(newTags),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(untagKeys) > 0 {
+		if _, err := client.UntagResource(&eks.UntagResourceInput{
+			ResourceArn: arn,
+			TagKeys:     aws.StringSlice(untagKeys),
+		}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
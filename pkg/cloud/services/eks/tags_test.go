@@ -17,10 +17,29 @@ limitations under the License.
 package eks
 
 import (
+	"context"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
 func TestGetTagUpdates(t *testing.T) {
@@ -52,3 +71,288 @@ func TestGetTagUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOwnedTagUpdates(t *testing.T) {
+	testCases := []struct {
+		name        string
+		current     infrav1.Tags
+		desired     infrav1.Tags
+		previous    infrav1.Tags
+		expectUntag []string
+		expectTag   infrav1.Tags
+	}{
+		{
+			name:        "tag is added",
+			current:     infrav1.Tags{"foreign": "x"},
+			desired:     infrav1.Tags{"team": "a"},
+			previous:    infrav1.Tags{},
+			expectUntag: []string{},
+			expectTag:   infrav1.Tags{"team": "a"},
+		},
+		{
+			name:        "tag is updated",
+			current:     infrav1.Tags{"foreign": "x", "team": "a"},
+			desired:     infrav1.Tags{"team": "b"},
+			previous:    infrav1.Tags{"team": "a"},
+			expectUntag: []string{},
+			expectTag:   infrav1.Tags{"team": "b"},
+		},
+		{
+			name:        "tag previously applied is removed and foreign tag is preserved",
+			current:     infrav1.Tags{"foreign": "x", "team": "a", "env": "dev"},
+			desired:     infrav1.Tags{"team": "a"},
+			previous:    infrav1.Tags{"team": "a", "env": "dev"},
+			expectUntag: []string{"env"},
+			expectTag:   infrav1.Tags{},
+		},
+		{
+			name:        "tag previously applied that is already removed is not removed",
+			current:     infrav1.Tags{"foreign": "x"},
+			desired:     infrav1.Tags{},
+			previous:    infrav1.Tags{"env": "dev"},
+			expectUntag: []string{},
+			expectTag:   infrav1.Tags{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			untag, tag := getOwnedTagUpdates(tc.current, tc.desired, tc.previous)
+			g.Expect(untag).To(Equal(tc.expectUntag))
+			g.Expect(tag).To(Equal(tc.expectTag))
+		})
+	}
+}
+
+func TestReconcileClusterTags(t *testing.T) {
+	clusterName := "eks-cluster"
+	clusterARN := "arn:aws:eks:us-east-1:123456789012:cluster/eks-cluster"
+	oidcProviderARN := "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/1"
+	securityGroupID := "sg-cluster"
+
+	testCases := []struct {
+		name           string
+		previous       string
+		additionalTags infrav1.Tags
+		clusterTags    infrav1.Tags
+		sgTags         infrav1.Tags
+		oidcTags       infrav1.Tags
+		expect         func(eksRec *mock_eksiface.MockEKSAPIMockRecorder, ec2Rec *mocks.MockEC2APIMockRecorder, iamRec *mock_iamauth.MockIAMAPIMockRecorder)
+	}{
+		{
+			name:           "tag is added to the cluster and propagated",
+			previous:       `{}`,
+			additionalTags: infrav1.Tags{"team": "a"},
+			clusterTags:    infrav1.Tags{"foreign": "x"},
+			sgTags:         infrav1.Tags{"foreign": "x"},
+			oidcTags:       infrav1.Tags{"foreign": "x"},
+			expect: func(eksRec *mock_eksiface.MockEKSAPIMockRecorder, ec2Rec *mocks.MockEC2APIMockRecorder, iamRec *mock_iamauth.MockIAMAPIMockRecorder) {
+				eksRec.TagResource(gomock.Eq(&eks.TagResourceInput{
+					ResourceArn: aws.String(clusterARN),
+					Tags:        aws.StringMap(map[string]string{"team": "a"}),
+				})).Return(&eks.TagResourceOutput{}, nil)
+				ec2Rec.CreateTagsWithContext(context.TODO(), gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{securityGroupID}),
+					Tags:      []*ec2.Tag{{Key: aws.String("team"), Value: aws.String("a")}},
+				})).Return(&ec2.CreateTagsOutput{}, nil)
+				iamRec.TagOpenIDConnectProvider(gomock.Eq(&iam.TagOpenIDConnectProviderInput{
+					OpenIDConnectProviderArn: aws.String(oidcProviderARN),
+					Tags:                     []*iam.Tag{{Key: aws.String("team"), Value: aws.String("a")}},
+				})).Return(&iam.TagOpenIDConnectProviderOutput{}, nil)
+			},
+		},
+		{
+			name:           "tag is updated on the cluster and propagated",
+			previous:       `{"team":"a"}`,
+			additionalTags: infrav1.Tags{"team": "b"},
+			clusterTags:    infrav1.Tags{"foreign": "x", "team": "a"},
+			sgTags:         infrav1.Tags{"foreign": "x", "team": "a"},
+			oidcTags:       infrav1.Tags{"foreign": "x", "team": "a"},
+			expect: func(eksRec *mock_eksiface.MockEKSAPIMockRecorder, ec2Rec *mocks.MockEC2APIMockRecorder, iamRec *mock_iamauth.MockIAMAPIMockRecorder) {
+				eksRec.TagResource(gomock.Eq(&eks.TagResourceInput{
+					ResourceArn: aws.String(clusterARN),
+					Tags:        aws.StringMap(map[string]string{"team": "b"}),
+				})).Return(&eks.TagResourceOutput{}, nil)
+				ec2Rec.CreateTagsWithContext(context.TODO(), gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{securityGroupID}),
+					Tags:      []*ec2.Tag{{Key: aws.String("team"), Value: aws.String("b")}},
+				})).Return(&ec2.CreateTagsOutput{}, nil)
+				iamRec.TagOpenIDConnectProvider(gomock.Eq(&iam.TagOpenIDConnectProviderInput{
+					OpenIDConnectProviderArn: aws.String(oidcProviderARN),
+					Tags:                     []*iam.Tag{{Key: aws.String("team"), Value: aws.String("b")}},
+				})).Return(&iam.TagOpenIDConnectProviderOutput{}, nil)
+			},
+		},
+		{
+			name:           "tag is removed from the cluster and its resources, foreign tags are preserved",
+			previous:       `{"env":"dev","team":"a"}`,
+			additionalTags: infrav1.Tags{"team": "a"},
+			clusterTags:    infrav1.Tags{"foreign": "x", "team": "a", "env": "dev"},
+			sgTags:         infrav1.Tags{"foreign": "x", "team": "a", "env": "dev"},
+			oidcTags:       infrav1.Tags{"foreign": "x", "team": "a", "env": "dev"},
+			expect: func(eksRec *mock_eksiface.MockEKSAPIMockRecorder, ec2Rec *mocks.MockEC2APIMockRecorder, iamRec *mock_iamauth.MockIAMAPIMockRecorder) {
+				eksRec.UntagResource(gomock.Eq(&eks.UntagResourceInput{
+					ResourceArn: aws.String(clusterARN),
+					TagKeys:     aws.StringSlice([]string{"env"}),
+				})).Return(&eks.UntagResourceOutput{}, nil)
+				ec2Rec.DeleteTagsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteTagsInput{
+					Resources: aws.StringSlice([]string{securityGroupID}),
+					Tags:      []*ec2.Tag{{Key: aws.String("env")}},
+				})).Return(&ec2.DeleteTagsOutput{}, nil)
+				iamRec.UntagOpenIDConnectProvider(gomock.Eq(&iam.UntagOpenIDConnectProviderInput{
+					OpenIDConnectProviderArn: aws.String(oidcProviderARN),
+					TagKeys:                  aws.StringSlice([]string{"env"}),
+				})).Return(&iam.UntagOpenIDConnectProviderOutput{}, nil)
+			},
+		},
+		{
+			name:           "tag removed from the OIDC provider outside of Cluster API is re-applied",
+			previous:       `{"team":"a"}`,
+			additionalTags: infrav1.Tags{"team": "a"},
+			clusterTags:    infrav1.Tags{"foreign": "x", "team": "a"},
+			sgTags:         infrav1.Tags{"foreign": "x", "team": "a"},
+			oidcTags:       infrav1.Tags{"foreign": "x"},
+			expect: func(eksRec *mock_eksiface.MockEKSAPIMockRecorder, ec2Rec *mocks.MockEC2APIMockRecorder, iamRec *mock_iamauth.MockIAMAPIMockRecorder) {
+				iamRec.TagOpenIDConnectProvider(gomock.Eq(&iam.TagOpenIDConnectProviderInput{
+					OpenIDConnectProviderArn: aws.String(oidcProviderARN),
+					Tags:                     []*iam.Tag{{Key: aws.String("team"), Value: aws.String("a")}},
+				})).Return(&iam.TagOpenIDConnectProviderOutput{}, nil)
+			},
+		},
+		{
+			name:           "tags applied before the last applied tags were recorded are removed from the cluster",
+			additionalTags: infrav1.Tags{"team": "a"},
+			clusterTags:    infrav1.Tags{"team": "a", "env": "dev"},
+			sgTags:         infrav1.Tags{"foreign": "x", "team": "a"},
+			oidcTags:       infrav1.Tags{"foreign": "x", "team": "a"},
+			expect: func(eksRec *mock_eksiface.MockEKSAPIMockRecorder, ec2Rec *mocks.MockEC2APIMockRecorder, iamRec *mock_iamauth.MockIAMAPIMockRecorder) {
+				eksRec.UntagResource(gomock.Eq(&eks.UntagResourceInput{
+					ResourceArn: aws.String(clusterARN),
+					TagKeys:     aws.StringSlice([]string{"env"}),
+				})).Return(&eks.UntagResourceOutput{}, nil)
+			},
+		},
+		{
+			name:           "nothing is done when the tags are up to date",
+			previous:       `{"team":"a"}`,
+			additionalTags: infrav1.Tags{"team": "a"},
+			clusterTags:    infrav1.Tags{"foreign": "x", "team": "a"},
+			sgTags:         infrav1.Tags{"foreign": "x", "team": "a"},
+			oidcTags:       infrav1.Tags{"foreign": "x", "team": "a"},
+			expect: func(eksRec *mock_eksiface.MockEKSAPIMockRecorder, ec2Rec *mocks.MockEC2APIMockRecorder, iamRec *mock_iamauth.MockIAMAPIMockRecorder) {
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+			oidcProviderTagsSyncs.Delete(oidcProviderARN)
+
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "control-plane",
+				},
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: clusterName,
+					AdditionalTags: tc.additionalTags,
+				},
+				Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+					OIDCProvider: ekscontrolplanev1.OIDCProviderStatus{ARN: oidcProviderARN},
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							ekscontrolplanev1.SecurityGroupCluster: {ID: securityGroupID, Tags: tc.sgTags},
+						},
+					},
+				},
+			}
+			if tc.previous != "" {
+				controlPlane.Annotations = map[string]string{TagsLastAppliedAnnotation: tc.previous}
+			}
+
+			scheme := runtime.NewScheme()
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client:       fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "capi-cluster"}},
+				ControlPlane: controlPlane,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+			tc.expect(eksMock.EXPECT(), ec2Mock.EXPECT(), iamMock.EXPECT())
+			iamMock.EXPECT().ListOpenIDConnectProviderTags(gomock.Eq(&iam.ListOpenIDConnectProviderTagsInput{
+				OpenIDConnectProviderArn: aws.String(oidcProviderARN),
+			})).Return(&iam.ListOpenIDConnectProviderTagsOutput{Tags: converters.MapToIAMTags(tc.oidcTags)}, nil)
+
+			s := NewService(scope)
+			s.EKSClient = eksMock
+			s.EC2Client = ec2Mock
+			s.IAMClient = iamMock
+
+			// The cluster has the tags CAPA always applies, the additional tags previously applied and the foreign tags.
+			clusterTags := infrav1.Build(*s.getEKSTagParams(clusterARN))
+			for k := range tc.additionalTags {
+				delete(clusterTags, k)
+			}
+			for k, v := range tc.clusterTags {
+				clusterTags[k] = v
+			}
+			cluster := &eks.Cluster{
+				Name: aws.String(clusterName),
+				Arn:  aws.String(clusterARN),
+				Tags: aws.StringMap(clusterTags),
+			}
+
+			g.Expect(s.reconcileTags(cluster)).To(Succeed())
+			g.Expect(controlPlane.Annotations).To(HaveKey(TagsLastAppliedAnnotation))
+			previous, err := s.lastAppliedTags()
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(previous).To(Equal(tc.additionalTags))
+		})
+	}
+}
+
+func TestPropagateOIDCProviderTagsResync(t *testing.T) {
+	g := NewWithT(t)
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	oidcProviderARN := "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/resync"
+	oidcProviderTagsSyncs.Delete(oidcProviderARN)
+	iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+	s := &Service{}
+	s.IAMClient = iamMock
+
+	// The tags of a provider not propagated to yet are listed.
+	iamMock.EXPECT().ListOpenIDConnectProviderTags(gomock.Eq(&iam.ListOpenIDConnectProviderTagsInput{
+		OpenIDConnectProviderArn: aws.String(oidcProviderARN),
+	})).Return(&iam.ListOpenIDConnectProviderTagsOutput{Tags: converters.MapToIAMTags(infrav1.Tags{"team": "a"})}, nil)
+	g.Expect(s.propagateOIDCProviderTags(oidcProviderARN, infrav1.Tags{"team": "a"}, infrav1.Tags{"team": "a"})).To(Succeed())
+
+	// They aren't listed again while the additional tags don't change.
+	g.Expect(s.propagateOIDCProviderTags(oidcProviderARN, infrav1.Tags{"team": "a"}, infrav1.Tags{"team": "a"})).To(Succeed())
+
+	// They are listed again when the additional tags change.
+	iamMock.EXPECT().ListOpenIDConnectProviderTags(gomock.Any()).
+		Return(&iam.ListOpenIDConnectProviderTagsOutput{Tags: converters.MapToIAMTags(infrav1.Tags{"team": "a"})}, nil)
+	iamMock.EXPECT().TagOpenIDConnectProvider(gomock.Eq(&iam.TagOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(oidcProviderARN),
+		Tags:                     []*iam.Tag{{Key: aws.String("team"), Value: aws.String("b")}},
+	})).Return(&iam.TagOpenIDConnectProviderOutput{}, nil)
+	g.Expect(s.propagateOIDCProviderTags(oidcProviderARN, infrav1.Tags{"team": "a"}, infrav1.Tags{"team": "b"})).To(Succeed())
+
+	// They are listed again after the resync period.
+	oidcProviderTagsSyncs.Store(oidcProviderARN, oidcProviderTagsSync{
+		tags: infrav1.Tags{"team": "b"},
+		time: time.Now().Add(-oidcProviderTagsResyncPeriod),
+	})
+	iamMock.EXPECT().ListOpenIDConnectProviderTags(gomock.Any()).
+		Return(&iam.ListOpenIDConnectProviderTagsOutput{Tags: converters.MapToIAMTags(infrav1.Tags{"team": "b"})}, nil)
+	g.Expect(s.propagateOIDCProviderTags(oidcProviderARN, infrav1.Tags{"team": "b"}, infrav1.Tags{"team": "b"})).To(Succeed())
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elb
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// RegisterInstanceWithGlobalAccelerator registers an instance as an endpoint of the Global Accelerator endpoint group
+// of the cluster. It is used for classic ELBs, which can't be registered as Global Accelerator endpoints.
+func (s *Service) RegisterInstanceWithGlobalAccelerator(i *infrav1.Instance) error {
+	return s.registerGlobalAcceleratorEndpoint(i.ID)
+}
+
+// DeregisterInstanceFromGlobalAccelerator de-registers an instance from the Global Accelerator endpoint group of the cluster.
+func (s *Service) DeregisterInstanceFromGlobalAccelerator(i *infrav1.Instance) error {
+	return s.deregisterGlobalAcceleratorEndpoint(i.ID)
+}
+
+// registerGlobalAcceleratorEndpoint adds the given load balancer ARN or instance ID to the Global Accelerator
+// endpoint group of the cluster, if it isn't an endpoint of the group already.
+func (s *Service) registerGlobalAcceleratorEndpoint(endpointID string) error {
+	groupARN := s.scope.GlobalAcceleratorEndpointGroupARN()
+	if groupARN == "" {
+		return nil
+	}
+
+	group, err := s.describeEndpointGroup(groupARN)
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return errors.Errorf("Global Accelerator endpoint group %q not found", groupARN)
+	}
+	// Endpoints must be in the region of their endpoint group.
+	if region := aws.StringValue(group.EndpointGroupRegion); region != s.scope.Region() {
+		return errors.Errorf("Global Accelerator endpoint group %q is in region %q, not in the region of the cluster %q", groupARN, region, s.scope.Region())
+	}
+	if hasGlobalAcceleratorEndpoint(group, endpointID) {
+		return nil
+	}
+
+	s.scope.Debug("Registering endpoint with Global Accelerator endpoint group", "endpoint-id", endpointID, "endpoint-group-arn", groupARN)
+	if _, err := s.GlobalAcceleratorClient.AddEndpoints(&globalaccelerator.AddEndpointsInput{
+		EndpointGroupArn: aws.String(groupARN),
+		EndpointConfigurations: []*globalaccelerator.EndpointConfiguration{
+			{EndpointId: aws.String(endpointID)},
+		},
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedRegisterGlobalAcceleratorEndpoint", "Failed to register %q with Global Accelerator endpoint group %q: %v", endpointID, groupARN, err)
+		return errors.Wrapf(err, "failed to register %q with Global Accelerator endpoint group %q", endpointID, groupARN)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulRegisterGlobalAcceleratorEndpoint", "Registered %q with Global Accelerator endpoint group %q", endpointID, groupARN)
+
+	return nil
+}
+
+// deregisterGlobalAcceleratorEndpoint removes the given load balancer ARN or instance ID from the Global Accelerator
+// endpoint group of the cluster. A missing endpoint or endpoint group isn't an error.
+func (s *Service) deregisterGlobalAcceleratorEndpoint(endpointID string) error {
+	groupARN := s.scope.GlobalAcceleratorEndpointGroupARN()
+	if groupARN == "" {
+		return nil
+	}
+
+	group, err := s.describeEndpointGroup(groupARN)
+	if err != nil {
+		return err
+	}
+	if group == nil || !hasGlobalAcceleratorEndpoint(group, endpointID) {
+		return nil
+	}
+
+	s.scope.Debug("Deregistering endpoint from Global Accelerator endpoint group", "endpoint-id", endpointID, "endpoint-group-arn", groupARN)
+	if _, err := s.GlobalAcceleratorClient.RemoveEndpoints(&globalaccelerator.RemoveEndpointsInput{
+		EndpointGroupArn: aws.String(groupARN),
+		EndpointIdentifiers: []*globalaccelerator.EndpointIdentifier{
+			{EndpointId: aws.String(endpointID)},
+		},
+	}); err != nil {
+		switch code, _ := awserrors.Code(err); code {
+		case globalaccelerator.ErrCodeEndpointGroupNotFoundException, globalaccelerator.ErrCodeEndpointNotFoundException:
+			return nil
+		}
+		record.Warnf(s.scope.InfraCluster(), "FailedDeregisterGlobalAcceleratorEndpoint", "Failed to deregister %q from Global Accelerator endpoint group %q: %v", endpointID, groupARN, err)
+		return errors.Wrapf(err, "failed to deregister %q from Global Accelerator endpoint group %q", endpointID, groupARN)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeregisterGlobalAcceleratorEndpoint", "Deregistered %q from Global Accelerator endpoint group %q", endpointID, groupARN)
+
+	return nil
+}
+
+// describeEndpointGroup returns the Global Accelerator endpoint group with the given ARN, or nil if it doesn't exist.
+func (s *Service) describeEndpointGroup(groupARN string) (*globalaccelerator.EndpointGroup, error) {
+	out, err := s.GlobalAcceleratorClient.DescribeEndpointGroup(&globalaccelerator.DescribeEndpointGroupInput{
+		EndpointGroupArn: aws.String(groupARN),
+	})
+	if err != nil {
+		if code, _ := awserrors.Code(err); code == globalaccelerator.ErrCodeEndpointGroupNotFoundException {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe Global Accelerator endpoint group %q", groupARN)
+	}
+	return out.EndpointGroup, nil
+}
+
+func hasGlobalAcceleratorEndpoint(group *globalaccelerator.EndpointGroup, endpointID string) bool {
+	for _, endpoint := range group.EndpointDescriptions {
+		if aws.StringValue(endpoint.EndpointId) == endpointID {
+			return true
+		}
+	}
+	return false
+}
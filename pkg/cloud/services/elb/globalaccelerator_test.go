@@ -0,0 +1,305 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elb
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	testEndpointGroupARN = "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd/listener/0123vxyz/endpoint-group/098765zyxwvu"
+	testLoadBalancerARN  = "arn:aws:elasticloadbalancing:us-west-1:123456789012:loadbalancer/net/bar-apiserver/50dc6c495c0c9188"
+)
+
+func TestRegisterGlobalAcceleratorEndpoint(t *testing.T) {
+	tests := []struct {
+		name             string
+		endpointGroupARN string
+		endpointID       string
+		expect           func(m *mocks.MockGlobalAcceleratorAPIMockRecorder)
+		wantErr          bool
+	}{
+		{
+			name:       "does nothing when no endpoint group is configured",
+			endpointID: testLoadBalancerARN,
+			expect:     func(m *mocks.MockGlobalAcceleratorAPIMockRecorder) {},
+		},
+		{
+			name:             "registers the load balancer with the endpoint group",
+			endpointGroupARN: testEndpointGroupARN,
+			endpointID:       testLoadBalancerARN,
+			expect: func(m *mocks.MockGlobalAcceleratorAPIMockRecorder) {
+				m.DescribeEndpointGroup(&globalaccelerator.DescribeEndpointGroupInput{EndpointGroupArn: aws.String(testEndpointGroupARN)}).
+					Return(&globalaccelerator.DescribeEndpointGroupOutput{
+						EndpointGroup: &globalaccelerator.EndpointGroup{
+							EndpointGroupArn:    aws.String(testEndpointGroupARN),
+							EndpointGroupRegion: aws.String("us-west-1"),
+							EndpointDescriptions: []*globalaccelerator.EndpointDescription{
+								{EndpointId: aws.String("i-other")},
+							},
+						},
+					}, nil)
+				m.AddEndpoints(&globalaccelerator.AddEndpointsInput{
+					EndpointGroupArn: aws.String(testEndpointGroupARN),
+					EndpointConfigurations: []*globalaccelerator.EndpointConfiguration{
+						{EndpointId: aws.String(testLoadBalancerARN)},
+					},
+				}).Return(&globalaccelerator.AddEndpointsOutput{}, nil)
+			},
+		},
+		{
+			name:             "registers an instance with the endpoint group",
+			endpointGroupARN: testEndpointGroupARN,
+			endpointID:       "i-controlplane",
+			expect: func(m *mocks.MockGlobalAcceleratorAPIMockRecorder) {
+				m.DescribeEndpointGroup(gomock.Any()).
+					Return(&globalaccelerator.DescribeEndpointGroupOutput{
+						EndpointGroup: &globalaccelerator.EndpointGroup{
+							EndpointGroupArn:    aws.String(testEndpointGroupARN),
+							EndpointGroupRegion: aws.String("us-west-1"),
+						},
+					}, nil)
+				m.AddEndpoints(&globalaccelerator.AddEndpointsInput{
+					EndpointGroupArn: aws.String(testEndpointGroupARN),
+					EndpointConfigurations: []*globalaccelerator.EndpointConfiguration{
+						{EndpointId: aws.String("i-controlplane")},
+					},
+				}).Return(&globalaccelerator.AddEndpointsOutput{}, nil)
+			},
+		},
+		{
+			name:             "does nothing when the endpoint is already registered",
+			endpointGroupARN: testEndpointGroupARN,
+			endpointID:       testLoadBalancerARN,
+			expect: func(m *mocks.MockGlobalAcceleratorAPIMockRecorder) {
+				m.DescribeEndpointGroup(gomock.Any()).
+					Return(&globalaccelerator.DescribeEndpointGroupOutput{
+						EndpointGroup: &globalaccelerator.EndpointGroup{
+							EndpointGroupArn:    aws.String(testEndpointGroupARN),
+							EndpointGroupRegion: aws.String("us-west-1"),
+							EndpointDescriptions: []*globalaccelerator.EndpointDescription{
+								{EndpointId: aws.String(testLoadBalancerARN)},
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name:             "fails when the endpoint group is in another region",
+			endpointGroupARN: testEndpointGroupARN,
+			endpointID:       testLoadBalancerARN,
+			expect: func(m *mocks.MockGlobalAcceleratorAPIMockRecorder) {
+				m.DescribeEndpointGroup(gomock.Any()).
+					Return(&globalaccelerator.DescribeEndpointGroupOutput{
+						EndpointGroup: &globalaccelerator.EndpointGroup{
+							EndpointGroupArn:    aws.String(testEndpointGroupARN),
+							EndpointGroupRegion: aws.String("eu-west-1"),
+						},
+					}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:             "fails when the endpoint group doesn't exist",
+			endpointGroupARN: testEndpointGroupARN,
+			endpointID:       testLoadBalancerARN,
+			expect: func(m *mocks.MockGlobalAcceleratorAPIMockRecorder) {
+				m.DescribeEndpointGroup(gomock.Any()).
+					Return(nil, awserr.New(globalaccelerator.ErrCodeEndpointGroupNotFoundException, "not found", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			gaMock := mocks.NewMockGlobalAcceleratorAPI(mockCtrl)
+			tc.expect(gaMock.EXPECT())
+
+			s := &Service{
+				scope:                   newGlobalAcceleratorTestScope(t, tc.endpointGroupARN),
+				GlobalAcceleratorClient: gaMock,
+			}
+
+			err := s.registerGlobalAcceleratorEndpoint(tc.endpointID)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestDeregisterGlobalAcceleratorEndpoint(t *testing.T) {
+	tests := []struct {
+		name             string
+		endpointGroupARN string
+		expect           func(m *mocks.MockGlobalAcceleratorAPIMockRecorder)
+		wantErr          bool
+	}{
+		{
+			name:   "does nothing when no endpoint group is configured",
+			expect: func(m *mocks.MockGlobalAcceleratorAPIMockRecorder) {},
+		},
+		{
+			name:             "deregisters the instance from the endpoint group",
+			endpointGroupARN: testEndpointGroupARN,
+			expect: func(m *mocks.MockGlobalAcceleratorAPIMockRecorder) {
+				m.DescribeEndpointGroup(gomock.Any()).
+					Return(&globalaccelerator.DescribeEndpointGroupOutput{
+						EndpointGroup: &globalaccelerator.EndpointGroup{
+							EndpointGroupArn: aws.String(testEndpointGroupARN),
+							EndpointDescriptions: []*globalaccelerator.EndpointDescription{
+								{EndpointId: aws.String("i-other")},
+								{EndpointId: aws.String("i-controlplane")},
+							},
+						},
+					}, nil)
+				m.RemoveEndpoints(&globalaccelerator.RemoveEndpointsInput{
+					EndpointGroupArn: aws.String(testEndpointGroupARN),
+					EndpointIdentifiers: []*globalaccelerator.EndpointIdentifier{
+						{EndpointId: aws.String("i-controlplane")},
+					},
+				}).Return(&globalaccelerator.RemoveEndpointsOutput{}, nil)
+			},
+		},
+		{
+			name:             "does nothing when the instance isn't registered",
+			endpointGroupARN: testEndpointGroupARN,
+			expect: func(m *mocks.MockGlobalAcceleratorAPIMockRecorder) {
+				m.DescribeEndpointGroup(gomock.Any()).
+					Return(&globalaccelerator.DescribeEndpointGroupOutput{
+						EndpointGroup: &globalaccelerator.EndpointGroup{
+							EndpointGroupArn: aws.String(testEndpointGroupARN),
+							EndpointDescriptions: []*globalaccelerator.EndpointDescription{
+								{EndpointId: aws.String("i-other")},
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name:             "does nothing when the endpoint group doesn't exist",
+			endpointGroupARN: testEndpointGroupARN,
+			expect: func(m *mocks.MockGlobalAcceleratorAPIMockRecorder) {
+				m.DescribeEndpointGroup(gomock.Any()).
+					Return(nil, awserr.New(globalaccelerator.ErrCodeEndpointGroupNotFoundException, "not found", nil))
+			},
+		},
+		{
+			name:             "ignores an endpoint removed concurrently",
+			endpointGroupARN: testEndpointGroupARN,
+			expect: func(m *mocks.MockGlobalAcceleratorAPIMockRecorder) {
+				m.DescribeEndpointGroup(gomock.Any()).
+					Return(&globalaccelerator.DescribeEndpointGroupOutput{
+						EndpointGroup: &globalaccelerator.EndpointGroup{
+							EndpointGroupArn: aws.String(testEndpointGroupARN),
+							EndpointDescriptions: []*globalaccelerator.EndpointDescription{
+								{EndpointId: aws.String("i-controlplane")},
+							},
+						},
+					}, nil)
+				m.RemoveEndpoints(gomock.Any()).
+					Return(nil, awserr.New(globalaccelerator.ErrCodeEndpointNotFoundException, "not found", nil))
+			},
+		},
+		{
+			name:             "fails when the endpoint can't be removed",
+			endpointGroupARN: testEndpointGroupARN,
+			expect: func(m *mocks.MockGlobalAcceleratorAPIMockRecorder) {
+				m.DescribeEndpointGroup(gomock.Any()).
+					Return(&globalaccelerator.DescribeEndpointGroupOutput{
+						EndpointGroup: &globalaccelerator.EndpointGroup{
+							EndpointGroupArn: aws.String(testEndpointGroupARN),
+							EndpointDescriptions: []*globalaccelerator.EndpointDescription{
+								{EndpointId: aws.String("i-controlplane")},
+							},
+						},
+					}, nil)
+				m.RemoveEndpoints(gomock.Any()).
+					Return(nil, awserr.New(globalaccelerator.ErrCodeAccessDeniedException, "denied", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			gaMock := mocks.NewMockGlobalAcceleratorAPI(mockCtrl)
+			tc.expect(gaMock.EXPECT())
+
+			s := &Service{
+				scope:                   newGlobalAcceleratorTestScope(t, tc.endpointGroupARN),
+				GlobalAcceleratorClient: gaMock,
+			}
+
+			err := s.DeregisterInstanceFromGlobalAccelerator(&infrav1.Instance{ID: "i-controlplane"})
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func newGlobalAcceleratorTestScope(t *testing.T, endpointGroupARN string) *scope.ClusterScope {
+	t.Helper()
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			Region:                            "us-west-1",
+			GlobalAcceleratorEndpointGroupARN: endpointGroupARN,
+		},
+	}
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+		},
+		AWSCluster: awsCluster,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return clusterScope
+}
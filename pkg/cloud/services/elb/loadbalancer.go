@@ -19,6 +19,7 @@ package elb
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -35,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
@@ -55,6 +57,13 @@ const maxELBsDescribeTagsRequest = 20
 
 // ReconcileLoadbalancers reconciles the load balancers for the given cluster.
 func (s *Service) ReconcileLoadbalancers() error {
+	if annotations.Has(s.scope.InfraCluster(), infrav1.PauseLoadBalancerAnnotation) {
+		s.scope.Info("Reconciliation of the load balancers is paused", "annotation", infrav1.PauseLoadBalancerAnnotation)
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.LoadBalancerPausedCondition)
+		return nil
+	}
+	conditions.Delete(s.scope.InfraCluster(), infrav1.LoadBalancerPausedCondition)
+
 	s.scope.Debug("Reconciling load balancers")
 
 	// do a switch and reconcile different load-balancer types
@@ -112,6 +121,10 @@ func (s *Service) reconcileV2LB() error {
 			return errors.Wrapf(err, "failed to reconcile tags for apiserver load balancer %q", lb.Name)
 		}
 
+		if err := s.reconcileV2LBListenerCertificates(lb, spec.ELBListeners); err != nil {
+			return errors.Wrapf(err, "failed to reconcile listener certificates for apiserver load balancer %q", lb.Name)
+		}
+
 		// Reconcile the subnets and availability zones from the spec
 		// and the ones currently attached to the load balancer.
 		if len(lb.SubnetIDs) != len(spec.SubnetIDs) {
@@ -128,7 +141,7 @@ func (s *Service) reconcileV2LB() error {
 		}
 
 		// Reconcile the security groups from the spec and the ones currently attached to the load balancer
-		if s.scope.ControlPlaneLoadBalancer().LoadBalancerType != infrav1.LoadBalancerTypeNLB && !sets.NewString(lb.SecurityGroupIDs...).Equal(sets.NewString(spec.SecurityGroupIDs...)) {
+		if s.shouldReconcileSGs(lb, spec.SecurityGroupIDs) {
 			_, err := s.ELBV2Client.SetSecurityGroups(&elbv2.SetSecurityGroupsInput{
 				LoadBalancerArn: &lb.ARN,
 				SecurityGroups:  aws.StringSlice(spec.SecurityGroupIDs),
@@ -140,6 +153,10 @@ func (s *Service) reconcileV2LB() error {
 	} else {
 		s.scope.Trace("Unmanaged control plane load balancer, skipping load balancer configuration", "api-server-elb", lb)
 	}
+
+	if err := s.registerGlobalAcceleratorEndpoint(lb.ARN); err != nil {
+		return errors.Wrapf(err, "failed to register apiserver load balancer %q with Global Accelerator", lb.Name)
+	}
 	lb.DeepCopyInto(&s.scope.Network().APIServerELB)
 	return nil
 }
@@ -147,7 +164,7 @@ func (s *Service) reconcileV2LB() error {
 func (s *Service) getAPIServerLBSpec(elbName string) (*infrav1.LoadBalancer, error) {
 	var securityGroupIDs []string
 	controlPlaneLoadBalancer := s.scope.ControlPlaneLoadBalancer()
-	if controlPlaneLoadBalancer != nil && controlPlaneLoadBalancer.LoadBalancerType != infrav1.LoadBalancerTypeNLB {
+	if controlPlaneLoadBalancer != nil {
 		securityGroupIDs = append(securityGroupIDs, controlPlaneLoadBalancer.AdditionalSecurityGroups...)
 		securityGroupIDs = append(securityGroupIDs, s.scope.SecurityGroups()[infrav1.SecurityGroupAPIServerLB].ID)
 	}
@@ -159,15 +176,15 @@ func (s *Service) getAPIServerLBSpec(elbName string) (*infrav1.LoadBalancer, err
 		ELBListeners: []infrav1.Listener{
 			{
 				Protocol: infrav1.ELBProtocolTCP,
-				Port:     infrav1.DefaultAPIServerPort,
+				Port:     controlPlaneLoadBalancer.TargetPort(),
 				TargetGroup: infrav1.TargetGroupSpec{
 					Name:     fmt.Sprintf("apiserver-target-%d", time.Now().Unix()),
-					Port:     infrav1.DefaultAPIServerPort,
+					Port:     controlPlaneLoadBalancer.TargetPort(),
 					Protocol: infrav1.ELBProtocolTCP,
 					VpcID:    s.scope.VPC().ID,
 					HealthCheck: &infrav1.TargetGroupHealthCheck{
 						Protocol: aws.String(string(infrav1.ELBProtocolTCP)),
-						Port:     aws.String(infrav1.DefaultAPIServerPortString),
+						Port:     aws.String(strconv.FormatInt(controlPlaneLoadBalancer.TargetPort(), 10)),
 					},
 				},
 			},
@@ -175,6 +192,23 @@ func (s *Service) getAPIServerLBSpec(elbName string) (*infrav1.LoadBalancer, err
 		SecurityGroupIDs: securityGroupIDs,
 	}
 
+	if controlPlaneLoadBalancer != nil && controlPlaneLoadBalancer.LoadBalancerType == infrav1.LoadBalancerTypeALB && controlPlaneLoadBalancer.SSLCertificateARN != nil {
+		sslPolicy := infrav1.DefaultLoadBalancerSSLPolicy
+		if controlPlaneLoadBalancer.SSLPolicy != nil {
+			sslPolicy = *controlPlaneLoadBalancer.SSLPolicy
+		}
+		listener := &res.ELBListeners[0]
+		listener.Protocol = infrav1.ELBProtocolHTTPS
+		listener.CertificateARN = *controlPlaneLoadBalancer.SSLCertificateARN
+		listener.SSLPolicy = sslPolicy
+		listener.TargetGroup.Protocol = infrav1.ELBProtocolHTTPS
+		listener.TargetGroup.HealthCheck = &infrav1.TargetGroupHealthCheck{
+			Protocol: aws.String(string(infrav1.ELBProtocolHTTPS)),
+			Path:     aws.String("/readyz"),
+			Port:     aws.String(strconv.FormatInt(controlPlaneLoadBalancer.TargetPort(), 10)),
+		}
+	}
+
 	if s.scope.ControlPlaneLoadBalancer() != nil {
 		for _, additionalListeners := range controlPlaneLoadBalancer.AdditionalListeners {
 			res.ELBListeners = append(res.ELBListeners, infrav1.Listener{
@@ -268,7 +302,9 @@ func (s *Service) createLB(spec *infrav1.LoadBalancer) (*infrav1.LoadBalancer, e
 		Scheme:  aws.String(string(spec.Scheme)),
 		Type:    t,
 	}
-	if s.scope.ControlPlaneLoadBalancer().LoadBalancerType != infrav1.LoadBalancerTypeNLB {
+	// Network load balancers only accept security groups at creation time, so they
+	// are always attached here if the spec has any.
+	if s.scope.ControlPlaneLoadBalancer().LoadBalancerType != infrav1.LoadBalancerTypeNLB || len(spec.SecurityGroupIDs) > 0 {
 		input.SecurityGroups = aws.StringSlice(spec.SecurityGroupIDs)
 	}
 
@@ -285,8 +321,6 @@ func (s *Service) createLB(spec *infrav1.LoadBalancer) (*infrav1.LoadBalancer, e
 		return nil, errors.New("no new network load balancer was created; the returned list is empty")
 	}
 
-	// TODO(Skarlso): Add options to set up SSL.
-	// https://github.com/kubernetes-sigs/cluster-api-provider-aws/issues/3899
 	for _, ln := range spec.ELBListeners {
 		// create the target group first
 		targetGroupInput := &elbv2.CreateTargetGroupInput{
@@ -303,6 +337,7 @@ func (s *Service) createLB(spec *infrav1.LoadBalancer) (*infrav1.LoadBalancer, e
 			targetGroupInput.HealthCheckEnabled = aws.Bool(true)
 			targetGroupInput.HealthCheckProtocol = ln.TargetGroup.HealthCheck.Protocol
 			targetGroupInput.HealthCheckPort = ln.TargetGroup.HealthCheck.Port
+			targetGroupInput.HealthCheckPath = ln.TargetGroup.HealthCheck.Path
 		}
 		s.scope.Debug("creating target group", "group", targetGroupInput, "listener", ln)
 		group, err := s.ELBV2Client.CreateTargetGroup(targetGroupInput)
@@ -340,6 +375,10 @@ func (s *Service) createLB(spec *infrav1.LoadBalancer) (*infrav1.LoadBalancer, e
 			Protocol:        aws.String(string(ln.Protocol)),
 			Tags:            converters.MapToV2Tags(spec.Tags),
 		}
+		if ln.CertificateARN != "" {
+			listenerInput.Certificates = []*elbv2.Certificate{{CertificateArn: aws.String(ln.CertificateARN)}}
+			listenerInput.SslPolicy = aws.String(ln.SSLPolicy)
+		}
 		// Create ClassicELBListeners
 		listener, err := s.ELBV2Client.CreateListener(listenerInput)
 		if err != nil {
@@ -355,6 +394,7 @@ func (s *Service) createLB(spec *infrav1.LoadBalancer) (*infrav1.LoadBalancer, e
 	res := spec.DeepCopy()
 	s.scope.Debug("applying load balancer DNS to result", "dns", *out.LoadBalancers[0].DNSName)
 	res.DNSName = *out.LoadBalancers[0].DNSName
+	res.CanonicalHostedZoneID = aws.StringValue(out.LoadBalancers[0].CanonicalHostedZoneId)
 	return res, nil
 }
 
@@ -412,6 +452,72 @@ func (s *Service) describeLB(name string) (*infrav1.LoadBalancer, error) {
 	return fromSDKTypeToLB(out.LoadBalancers[0], outAtt.Attributes, tags), nil
 }
 
+// reconcileV2LBListenerCertificates updates the certificate and security policy of the
+// HTTPS listeners of the load balancer in place when they differ from the spec.
+func (s *Service) reconcileV2LBListenerCertificates(lb *infrav1.LoadBalancer, specListeners []infrav1.Listener) error {
+	desired := map[int64]infrav1.Listener{}
+	for _, ln := range specListeners {
+		if ln.CertificateARN != "" {
+			desired[ln.Port] = ln
+		}
+	}
+	if len(desired) == 0 {
+		return nil
+	}
+
+	out, err := s.ELBV2Client.DescribeListeners(&elbv2.DescribeListenersInput{
+		LoadBalancerArn: aws.String(lb.ARN),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe listeners for load balancer %q", lb.Name)
+	}
+
+	for _, listener := range out.Listeners {
+		ln, ok := desired[aws.Int64Value(listener.Port)]
+		if !ok || aws.StringValue(listener.Protocol) != string(infrav1.ELBProtocolHTTPS) {
+			continue
+		}
+
+		var certificateARN string
+		if len(listener.Certificates) > 0 {
+			certificateARN = aws.StringValue(listener.Certificates[0].CertificateArn)
+		}
+		if certificateARN == ln.CertificateARN && aws.StringValue(listener.SslPolicy) == ln.SSLPolicy {
+			continue
+		}
+
+		s.scope.Debug("Updating listener certificate", "listener-arn", aws.StringValue(listener.ListenerArn), "certificate-arn", ln.CertificateARN, "ssl-policy", ln.SSLPolicy)
+		if _, err := s.ELBV2Client.ModifyListener(&elbv2.ModifyListenerInput{
+			ListenerArn:  listener.ListenerArn,
+			Certificates: []*elbv2.Certificate{{CertificateArn: aws.String(ln.CertificateARN)}},
+			SslPolicy:    aws.String(ln.SSLPolicy),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to modify listener %q", aws.StringValue(listener.ListenerArn))
+		}
+	}
+
+	return nil
+}
+
+// shouldReconcileSGs returns true if the security groups attached to the load balancer
+// differ from the ones in the spec and can be updated in place.
+func (s *Service) shouldReconcileSGs(lb *infrav1.LoadBalancer, specSGs []string) bool {
+	if sets.NewString(lb.SecurityGroupIDs...).Equal(sets.NewString(specSGs...)) {
+		return false
+	}
+
+	// Network load balancers created without security groups can never have any added,
+	// the load balancer has to be recreated instead.
+	// See: https://docs.aws.amazon.com/elasticloadbalancing/latest/network/load-balancer-security-groups.html
+	if lb.LoadBalancerType == infrav1.LoadBalancerTypeNLB && len(lb.SecurityGroupIDs) == 0 {
+		s.scope.Info("Network load balancer was created without security groups, it has to be recreated to attach them", "api-server-lb-name", lb.Name)
+		record.Warnf(s.scope.InfraCluster(), "NLBSecurityGroupsRequireRecreate", "Network load balancer %q was created without security groups and must be recreated to attach them", lb.Name)
+		return false
+	}
+
+	return true
+}
+
 func (s *Service) reconcileClassicLoadBalancer() error {
 	// Generate a default control plane load balancer name. The load balancer name cannot be
 	// generated by the defaulting webhook, because it is derived from the cluster name, and that
@@ -611,6 +717,10 @@ func (s *Service) deleteExistingNLBs() error {
 		return err
 	}
 
+	if err := s.deregisterGlobalAcceleratorEndpoint(lb.ARN); err != nil {
+		return errors.Wrapf(err, "failed to deregister apiserver load balancer %q from Global Accelerator", lb.Name)
+	}
+
 	if lb.IsUnmanaged(s.scope.Name()) {
 		s.scope.Debug("Found unmanaged load balancer for apiserver, skipping deletion", "api-server-elb-name", lb.Name)
 		return nil
@@ -665,9 +775,50 @@ func (s *Service) IsInstanceRegisteredWithAPIServerELB(i *infrav1.Instance) (boo
 
 // IsInstanceRegisteredWithAPIServerLB returns true if the instance is already registered with the APIServer LB.
 func (s *Service) IsInstanceRegisteredWithAPIServerLB(i *infrav1.Instance) ([]string, bool, error) {
+	targets, err := s.describeInstanceTargetHealth(i)
+	if err != nil {
+		return nil, false, err
+	}
+
+	targetGroupARNs := []string{}
+	for _, target := range targets {
+		targetGroupARNs = append(targetGroupARNs, target.targetGroupARN)
+	}
+	if len(targetGroupARNs) > 0 {
+		return targetGroupARNs, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// IsInstanceDrainingFromAPIServerLB returns true if the instance is still being deregistered from any of
+// the APIServer LB's target groups, i.e. the deregistration delay of the target group has not elapsed yet.
+func (s *Service) IsInstanceDrainingFromAPIServerLB(i *infrav1.Instance) (bool, error) {
+	targets, err := s.describeInstanceTargetHealth(i)
+	if err != nil {
+		return false, err
+	}
+
+	for _, target := range targets {
+		if target.state == elbv2.TargetHealthStateEnumDraining {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+type targetGroupHealth struct {
+	targetGroupARN string
+	state          string
+}
+
+// describeInstanceTargetHealth returns the state of the instance in every target group of the APIServer LB
+// the instance is a target of.
+func (s *Service) describeInstanceTargetHealth(i *infrav1.Instance) ([]targetGroupHealth, error) {
 	name, err := LBName(s.scope)
 	if err != nil {
-		return nil, false, errors.Wrap(err, "failed to get control plane load balancer name")
+		return nil, errors.Wrap(err, "failed to get control plane load balancer name")
 	}
 
 	input := &elbv2.DescribeLoadBalancersInput{
@@ -676,10 +827,10 @@ func (s *Service) IsInstanceRegisteredWithAPIServerLB(i *infrav1.Instance) ([]st
 
 	output, err := s.ELBV2Client.DescribeLoadBalancers(input)
 	if err != nil {
-		return nil, false, errors.Wrapf(err, "error describing ELB %q", name)
+		return nil, errors.Wrapf(err, "error describing ELB %q", name)
 	}
 	if len(output.LoadBalancers) != 1 {
-		return nil, false, errors.Errorf("expected 1 ELB description for %q, got %d", name, len(output.LoadBalancers))
+		return nil, errors.Errorf("expected 1 ELB description for %q, got %d", name, len(output.LoadBalancers))
 	}
 
 	describeTargetGroupInput := &elbv2.DescribeTargetGroupsInput{
@@ -688,29 +839,30 @@ func (s *Service) IsInstanceRegisteredWithAPIServerLB(i *infrav1.Instance) ([]st
 
 	targetGroups, err := s.ELBV2Client.DescribeTargetGroups(describeTargetGroupInput)
 	if err != nil {
-		return nil, false, errors.Wrapf(err, "error describing ELB's target groups %q", name)
+		return nil, errors.Wrapf(err, "error describing ELB's target groups %q", name)
 	}
 
-	targetGroupARNs := []string{}
+	targets := []targetGroupHealth{}
 	for _, tg := range targetGroups.TargetGroups {
 		healthInput := &elbv2.DescribeTargetHealthInput{
 			TargetGroupArn: tg.TargetGroupArn,
 		}
 		instanceHealth, err := s.ELBV2Client.DescribeTargetHealth(healthInput)
 		if err != nil {
-			return nil, false, errors.Wrapf(err, "error describing ELB's target groups health %q", name)
+			return nil, errors.Wrapf(err, "error describing ELB's target groups health %q", name)
 		}
 		for _, id := range instanceHealth.TargetHealthDescriptions {
 			if aws.StringValue(id.Target.Id) == i.ID {
-				targetGroupARNs = append(targetGroupARNs, aws.StringValue(tg.TargetGroupArn))
+				target := targetGroupHealth{targetGroupARN: aws.StringValue(tg.TargetGroupArn)}
+				if id.TargetHealth != nil {
+					target.state = aws.StringValue(id.TargetHealth.State)
+				}
+				targets = append(targets, target)
 			}
 		}
 	}
-	if len(targetGroupARNs) > 0 {
-		return targetGroupARNs, true, nil
-	}
 
-	return nil, false, nil
+	return targets, nil
 }
 
 // RegisterInstanceWithAPIServerELB registers an instance with a classic ELB.
@@ -964,7 +1116,7 @@ func (s *Service) getAPIServerClassicELBSpec(elbName string) (*infrav1.LoadBalan
 				Protocol:         infrav1.ELBProtocolTCP,
 				Port:             int64(s.scope.APIServerPort()),
 				InstanceProtocol: infrav1.ELBProtocolTCP,
-				InstancePort:     infrav1.DefaultAPIServerPort,
+				InstancePort:     controlPlaneLoadBalancer.TargetPort(),
 			},
 		},
 		HealthCheck: &infrav1.ClassicELBHealthCheck{
@@ -982,6 +1134,9 @@ func (s *Service) getAPIServerClassicELBSpec(elbName string) (*infrav1.LoadBalan
 
 	if s.scope.ControlPlaneLoadBalancer() != nil {
 		res.ClassicElbAttributes.CrossZoneLoadBalancing = s.scope.ControlPlaneLoadBalancer().CrossZoneLoadBalancing
+		if s.scope.ControlPlaneLoadBalancer().IdleTimeout != nil {
+			res.ClassicElbAttributes.IdleTimeout = s.scope.ControlPlaneLoadBalancer().IdleTimeout.Duration
+		}
 	}
 
 	res.Tags = infrav1.Build(infrav1.BuildParams{
@@ -1390,20 +1545,12 @@ func (s *Service) reconcileELBTags(lb *infrav1.LoadBalancer, desiredTags map[str
 		LoadBalancerNames: []*string{aws.String(lb.Name)},
 	}
 
-	currentTags := infrav1.Tags(lb.Tags)
-
-	for k, v := range desiredTags {
-		if val, ok := currentTags[k]; !ok || val != v {
-			s.scope.Trace("adding tag to load balancer", "elb-name", lb.Name, "key", k, "value", v)
-			addTagsInput.Tags = append(addTagsInput.Tags, &elb.Tag{Key: aws.String(k), Value: aws.String(v)})
-		}
+	add, remove := s.lbTagChanges(lb, desiredTags)
+	for k, v := range add {
+		addTagsInput.Tags = append(addTagsInput.Tags, &elb.Tag{Key: aws.String(k), Value: aws.String(v)})
 	}
-
-	for k := range currentTags {
-		if _, ok := desiredTags[k]; !ok {
-			s.scope.Trace("removing tag from load balancer", "elb-name", lb.Name, "key", k)
-			removeTagsInput.Tags = append(removeTagsInput.Tags, &elb.TagKeyOnly{Key: aws.String(k)})
-		}
+	for _, k := range remove {
+		removeTagsInput.Tags = append(removeTagsInput.Tags, &elb.TagKeyOnly{Key: aws.String(k)})
 	}
 
 	if len(addTagsInput.Tags) > 0 {
@@ -1430,35 +1577,74 @@ func (s *Service) reconcileV2LBTags(lb *infrav1.LoadBalancer, desiredTags map[st
 		ResourceArns: []*string{aws.String(lb.ARN)},
 	}
 
+	add, remove := s.lbTagChanges(lb, desiredTags)
+	for k, v := range add {
+		addTagsInput.Tags = append(addTagsInput.Tags, &elbv2.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	removeTagsInput.TagKeys = aws.StringSlice(remove)
+
+	if len(addTagsInput.Tags) > 0 {
+		if _, err := s.ELBV2Client.AddTags(addTagsInput); err != nil {
+			return err
+		}
+	}
+
+	if len(removeTagsInput.TagKeys) > 0 {
+		if _, err := s.ELBV2Client.RemoveTags(removeTagsInput); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lbTagChanges returns the tags to add to a load balancer and the keys of the tags to remove from it, sorted, so
+// that its tags match the desired tags. With the observe tag reconcile policy, only the ownership tag of the
+// cluster is re-applied, and the other differences are reported by a warning event and the ResourceTagsSynced
+// condition of the cluster.
+func (s *Service) lbTagChanges(lb *infrav1.LoadBalancer, desiredTags map[string]string) (infrav1.Tags, []string) {
 	currentTags := infrav1.Tags(lb.Tags)
 
+	add := infrav1.Tags{}
 	for k, v := range desiredTags {
 		if val, ok := currentTags[k]; !ok || val != v {
-			s.scope.Trace("adding tag to load balancer", "elb-name", lb.Name, "key", k, "value", v)
-			addTagsInput.Tags = append(addTagsInput.Tags, &elbv2.Tag{Key: aws.String(k), Value: aws.String(v)})
+			add[k] = v
 		}
 	}
 
+	remove := []string{}
 	for k := range currentTags {
 		if _, ok := desiredTags[k]; !ok {
-			s.scope.Trace("removing tag from load balancer", "elb-name", lb.Name, "key", k)
-			removeTagsInput.TagKeys = append(removeTagsInput.TagKeys, aws.String(k))
+			remove = append(remove, k)
 		}
 	}
+	sort.Strings(remove)
 
-	if len(addTagsInput.Tags) > 0 {
-		if _, err := s.ELBV2Client.AddTags(addTagsInput); err != nil {
-			return err
+	if s.scope.TagReconcilePolicy() == infrav1.TagReconcilePolicyObserve {
+		drifted := append([]string{}, remove...)
+		clusterTagKey := infrav1.ClusterTagKey(s.scope.Name())
+		for k := range add {
+			if k != clusterTagKey {
+				drifted = append(drifted, k)
+				delete(add, k)
+			}
+		}
+		remove = nil
+		if len(drifted) > 0 {
+			sort.Strings(drifted)
+			record.Warnf(s.scope.InfraCluster(), "TagsDrifted", "Tags %s of load balancer %q were removed or changed outside of Cluster API", strings.Join(drifted, ", "), lb.Name)
+			s.scope.RecordTagDrift(lb.Name, drifted)
 		}
 	}
 
-	if len(removeTagsInput.TagKeys) > 0 {
-		if _, err := s.ELBV2Client.RemoveTags(removeTagsInput); err != nil {
-			return err
-		}
+	for k, v := range add {
+		s.scope.Trace("adding tag to load balancer", "elb-name", lb.Name, "key", k, "value", v)
+	}
+	for _, k := range remove {
+		s.scope.Trace("removing tag from load balancer", "elb-name", lb.Name, "key", k)
 	}
 
-	return nil
+	return add, remove
 }
 
 func (s *Service) getHealthCheckTarget() string {
@@ -1467,21 +1653,22 @@ func (s *Service) getHealthCheckTarget() string {
 	if controlPlaneELB != nil && controlPlaneELB.HealthCheckProtocol != nil {
 		protocol = controlPlaneELB.HealthCheckProtocol
 		if protocol.String() == infrav1.ELBProtocolHTTP.String() || protocol.String() == infrav1.ELBProtocolHTTPS.String() {
-			return fmt.Sprintf("%v:%d/readyz", protocol, infrav1.DefaultAPIServerPort)
+			return fmt.Sprintf("%v:%d/readyz", protocol, controlPlaneELB.TargetPort())
 		}
 	}
-	return fmt.Sprintf("%v:%d", protocol, infrav1.DefaultAPIServerPort)
+	return fmt.Sprintf("%v:%d", protocol, controlPlaneELB.TargetPort())
 }
 
 func fromSDKTypeToClassicELB(v *elb.LoadBalancerDescription, attrs *elb.LoadBalancerAttributes, tags []*elb.Tag) *infrav1.LoadBalancer {
 	res := &infrav1.LoadBalancer{
-		Name:             aws.StringValue(v.LoadBalancerName),
-		Scheme:           infrav1.ELBScheme(*v.Scheme),
-		SubnetIDs:        aws.StringValueSlice(v.Subnets),
-		SecurityGroupIDs: aws.StringValueSlice(v.SecurityGroups),
-		DNSName:          aws.StringValue(v.DNSName),
-		Tags:             converters.ELBTagsToMap(tags),
-		LoadBalancerType: infrav1.LoadBalancerTypeClassic,
+		Name:                  aws.StringValue(v.LoadBalancerName),
+		Scheme:                infrav1.ELBScheme(*v.Scheme),
+		SubnetIDs:             aws.StringValueSlice(v.Subnets),
+		SecurityGroupIDs:      aws.StringValueSlice(v.SecurityGroups),
+		DNSName:               aws.StringValue(v.DNSName),
+		CanonicalHostedZoneID: aws.StringValue(v.CanonicalHostedZoneNameID),
+		Tags:                  converters.ELBTagsToMap(tags),
+		LoadBalancerType:      infrav1.LoadBalancerTypeClassic,
 	}
 
 	if attrs.ConnectionSettings != nil && attrs.ConnectionSettings.IdleTimeout != nil {
@@ -1501,14 +1688,15 @@ func fromSDKTypeToLB(v *elbv2.LoadBalancer, attrs []*elbv2.LoadBalancerAttribute
 		availabilityZones[i] = az.ZoneName
 	}
 	res := &infrav1.LoadBalancer{
-		ARN:       aws.StringValue(v.LoadBalancerArn),
-		Name:      aws.StringValue(v.LoadBalancerName),
-		Scheme:    infrav1.ELBScheme(aws.StringValue(v.Scheme)),
-		SubnetIDs: aws.StringValueSlice(subnetIds),
-		// SecurityGroupIDs: aws.StringValueSlice(v.SecurityGroups),
-		AvailabilityZones: aws.StringValueSlice(availabilityZones),
-		DNSName:           aws.StringValue(v.DNSName),
-		Tags:              converters.V2TagsToMap(tags),
+		ARN:                   aws.StringValue(v.LoadBalancerArn),
+		Name:                  aws.StringValue(v.LoadBalancerName),
+		Scheme:                infrav1.ELBScheme(aws.StringValue(v.Scheme)),
+		SubnetIDs:             aws.StringValueSlice(subnetIds),
+		SecurityGroupIDs:      aws.StringValueSlice(v.SecurityGroups),
+		AvailabilityZones:     aws.StringValueSlice(availabilityZones),
+		DNSName:               aws.StringValue(v.DNSName),
+		CanonicalHostedZoneID: aws.StringValue(v.CanonicalHostedZoneId),
+		Tags:                  converters.V2TagsToMap(tags),
 	}
 
 	infraAttrs := make(map[string]*string, len(attrs))
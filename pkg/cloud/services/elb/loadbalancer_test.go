@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -181,6 +182,40 @@ func TestGetAPIServerClassicELBSpecControlPlaneLoadBalancer(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:  "load balancer config without idle timeout uses the default",
+			lb:    &infrav1.AWSLoadBalancerSpec{},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ClassicElbAttributes.IdleTimeout).To(Equal(10 * time.Minute))
+			},
+		},
+		{
+			name: "load balancer config with custom port",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				Port: aws.Int64(8443),
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ClassicELBListeners).To(HaveLen(1))
+				g.Expect(res.ClassicELBListeners[0].Port).To(Equal(int64(8443)))
+				g.Expect(res.ClassicELBListeners[0].InstancePort).To(Equal(int64(8443)))
+				g.Expect(res.HealthCheck.Target).To(Equal("SSL:8443"))
+			},
+		},
+		{
+			name: "load balancer config with custom idle timeout",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				IdleTimeout: &metav1.Duration{Duration: 2 * time.Minute},
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ClassicElbAttributes.IdleTimeout).To(Equal(2 * time.Minute))
+			},
+		},
 		{
 			name: "load balancer config with subnets specified",
 			lb: &infrav1.AWSLoadBalancerSpec{
@@ -380,7 +415,7 @@ func TestGetAPIServerV2ELBSpecControlPlaneLoadBalancer(t *testing.T) {
 			},
 		},
 		{
-			name: "load balancer config with additional security groups specified for NLB has no security groups",
+			name: "load balancer config with additional security groups specified for NLB",
 			lb: &infrav1.AWSLoadBalancerSpec{
 				AdditionalSecurityGroups: []string{"sg-00001", "sg-00002"},
 				LoadBalancerType:         infrav1.LoadBalancerTypeNLB,
@@ -388,11 +423,57 @@ func TestGetAPIServerV2ELBSpecControlPlaneLoadBalancer(t *testing.T) {
 			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
 			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
 				t.Helper()
-				if len(res.SecurityGroupIDs) != 0 {
-					t.Errorf("Expected load balancer to be configured for 0 security groups, got %v", len(res.SecurityGroupIDs))
+				if len(res.SecurityGroupIDs) != 3 {
+					t.Errorf("Expected load balancer to be configured for 3 security groups, got %v", len(res.SecurityGroupIDs))
 				}
 			},
 		},
+		{
+			name: "load balancer config with custom port",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				Port:             aws.Int64(8443),
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ELBListeners).To(HaveLen(1))
+				g.Expect(res.ELBListeners[0].Port).To(Equal(int64(8443)))
+				g.Expect(res.ELBListeners[0].TargetGroup.Port).To(Equal(int64(8443)))
+				g.Expect(res.ELBListeners[0].TargetGroup.HealthCheck.Port).To(Equal(aws.String("8443")))
+			},
+		},
+		{
+			name: "An HTTPS listener is set up for ALB with an SSL certificate",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType:  infrav1.LoadBalancerTypeALB,
+				SSLCertificateARN: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ELBListeners).To(HaveLen(1))
+				g.Expect(res.ELBListeners[0].Protocol).To(Equal(infrav1.ELBProtocolHTTPS))
+				g.Expect(res.ELBListeners[0].CertificateARN).To(Equal("arn:aws:acm:us-east-1:123456789012:certificate/abc"))
+				g.Expect(res.ELBListeners[0].SSLPolicy).To(Equal(infrav1.DefaultLoadBalancerSSLPolicy))
+				g.Expect(res.ELBListeners[0].TargetGroup.Protocol).To(Equal(infrav1.ELBProtocolHTTPS))
+				g.Expect(res.ELBListeners[0].TargetGroup.HealthCheck.Path).To(Equal(aws.String("/readyz")))
+			},
+		},
+		{
+			name: "A custom SSL policy is used for the ALB HTTPS listener",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType:  infrav1.LoadBalancerTypeALB,
+				SSLCertificateARN: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+				SSLPolicy:         aws.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ELBListeners).To(HaveLen(1))
+				g.Expect(res.ELBListeners[0].SSLPolicy).To(Equal("ELBSecurityPolicy-TLS-1-2-2017-01"))
+			},
+		},
 		{
 			name: "A base listener is set up for NLB",
 			lb: &infrav1.AWSLoadBalancerSpec{
@@ -1153,6 +1234,168 @@ func TestRegisterInstanceWithAPIServerNLB(t *testing.T) {
 	}
 }
 
+func TestIsInstanceDrainingFromAPIServerLB(t *testing.T) {
+	const (
+		namespace      = "foo"
+		clusterName    = "bar"
+		elbName        = "bar-apiserver"
+		elbArn         = "arn::apiserver"
+		targetGroupArn = "target-group::arn"
+		// additionalTargetGroupArn is the target group of an additional listener of the load balancer.
+		additionalTargetGroupArn = "additional-target-group::arn"
+		instanceID               = "test-instance"
+	)
+
+	tests := []struct {
+		name          string
+		targetGroups  []string
+		elbV2APIMocks func(m *mocks.MockELBV2APIMockRecorder)
+		expected      bool
+		expectErr     bool
+	}{
+		{
+			name: "instance is draining from a target group",
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetHealth(gomock.Eq(&elbv2.DescribeTargetHealthInput{
+					TargetGroupArn: aws.String(targetGroupArn),
+				})).Return(&elbv2.DescribeTargetHealthOutput{
+					TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+						{
+							Target:       &elbv2.TargetDescription{Id: aws.String(instanceID)},
+							TargetHealth: &elbv2.TargetHealth{State: aws.String(elbv2.TargetHealthStateEnumDraining)},
+						},
+					},
+				}, nil)
+			},
+			expected: true,
+		},
+		{
+			name: "instance is still healthy in a target group",
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetHealth(gomock.Eq(&elbv2.DescribeTargetHealthInput{
+					TargetGroupArn: aws.String(targetGroupArn),
+				})).Return(&elbv2.DescribeTargetHealthOutput{
+					TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+						{
+							Target:       &elbv2.TargetDescription{Id: aws.String(instanceID)},
+							TargetHealth: &elbv2.TargetHealth{State: aws.String(elbv2.TargetHealthStateEnumHealthy)},
+						},
+					},
+				}, nil)
+			},
+			expected: false,
+		},
+		{
+			name:         "instance is draining from the target group of an additional listener",
+			targetGroups: []string{targetGroupArn, additionalTargetGroupArn},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetHealth(gomock.Eq(&elbv2.DescribeTargetHealthInput{
+					TargetGroupArn: aws.String(targetGroupArn),
+				})).Return(&elbv2.DescribeTargetHealthOutput{}, nil)
+				m.DescribeTargetHealth(gomock.Eq(&elbv2.DescribeTargetHealthInput{
+					TargetGroupArn: aws.String(additionalTargetGroupArn),
+				})).Return(&elbv2.DescribeTargetHealthOutput{
+					TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+						{
+							Target:       &elbv2.TargetDescription{Id: aws.String(instanceID)},
+							TargetHealth: &elbv2.TargetHealth{State: aws.String(elbv2.TargetHealthStateEnumDraining)},
+						},
+					},
+				}, nil)
+			},
+			expected: true,
+		},
+		{
+			name: "instance is no longer a target",
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetHealth(gomock.Eq(&elbv2.DescribeTargetHealthInput{
+					TargetGroupArn: aws.String(targetGroupArn),
+				})).Return(&elbv2.DescribeTargetHealthOutput{
+					TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+						{
+							Target:       &elbv2.TargetDescription{Id: aws.String("other-instance")},
+							TargetHealth: &elbv2.TargetHealth{State: aws.String(elbv2.TargetHealthStateEnumDraining)},
+						},
+					},
+				}, nil)
+			},
+			expected: false,
+		},
+		{
+			name: "target health can't be described",
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetHealth(gomock.Any()).Return(nil, errors.New("error describing target health"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			elbV2APIMocks := mocks.NewMockELBV2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).ToNot(HaveOccurred())
+
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+						Name:      clusterName,
+					},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+					Spec: infrav1.AWSClusterSpec{
+						ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+							Name:             aws.String(elbName),
+							LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+						},
+					},
+				},
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			elbV2APIMocks.EXPECT().DescribeLoadBalancers(gomock.Eq(&elbv2.DescribeLoadBalancersInput{
+				Names: aws.StringSlice([]string{elbName}),
+			})).Return(&elbv2.DescribeLoadBalancersOutput{
+				LoadBalancers: []*elbv2.LoadBalancer{{LoadBalancerArn: aws.String(elbArn), LoadBalancerName: aws.String(elbName)}},
+			}, nil)
+			targetGroups := []*elbv2.TargetGroup{}
+			for _, arn := range tc.targetGroups {
+				targetGroups = append(targetGroups, &elbv2.TargetGroup{TargetGroupArn: aws.String(arn)})
+			}
+			if len(targetGroups) == 0 {
+				targetGroups = append(targetGroups, &elbv2.TargetGroup{TargetGroupArn: aws.String(targetGroupArn)})
+			}
+			elbV2APIMocks.EXPECT().DescribeTargetGroups(gomock.Eq(&elbv2.DescribeTargetGroupsInput{
+				LoadBalancerArn: aws.String(elbArn),
+			})).Return(&elbv2.DescribeTargetGroupsOutput{
+				TargetGroups: targetGroups,
+			}, nil)
+			tc.elbV2APIMocks(elbV2APIMocks.EXPECT())
+
+			s := &Service{
+				scope:       clusterScope,
+				ELBV2Client: elbV2APIMocks,
+			}
+
+			draining, err := s.IsInstanceDrainingFromAPIServerLB(&infrav1.Instance{ID: instanceID})
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(draining).To(Equal(tc.expected))
+		})
+	}
+}
+
 func TestCreateNLB(t *testing.T) {
 	const (
 		namespace       = "foo"
@@ -1595,20 +1838,19 @@ func TestCreateNLB(t *testing.T) {
 			},
 		},
 		{
-			name: "load balancer is not an NLB scope security groups will be added",
+			name: "NLB is created with security groups attached",
 			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
-				spec.SecurityGroupIDs = []string{"sg-id"}
+				spec.SecurityGroupIDs = []string{"sg-apiserver-lb", "sg-additional"}
 				return spec
 			},
 			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
-				acl.Spec.ControlPlaneLoadBalancer.LoadBalancerType = infrav1.LoadBalancerTypeALB
 				return acl
 			},
 			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
 				m.CreateLoadBalancer(gomock.Eq(&elbv2.CreateLoadBalancerInput{
 					Name:    aws.String(elbName),
 					Scheme:  aws.String("internet-facing"),
-					Type:    aws.String("application"),
+					Type:    aws.String("network"),
 					Subnets: aws.StringSlice([]string{clusterSubnetID}),
 					Tags: []*elbv2.Tag{
 						{
@@ -1616,8 +1858,63 @@ func TestCreateNLB(t *testing.T) {
 							Value: aws.String("tag"),
 						},
 					},
-					SecurityGroups: aws.StringSlice([]string{"sg-id"}),
+					SecurityGroups: aws.StringSlice([]string{"sg-apiserver-lb", "sg-additional"}),
 				})).Return(&elbv2.CreateLoadBalancerOutput{
+					LoadBalancers: []*elbv2.LoadBalancer{
+						{
+							LoadBalancerArn:  aws.String(elbArn),
+							LoadBalancerName: aws.String(elbName),
+							Scheme:           aws.String(string(infrav1.ELBSchemeInternetFacing)),
+							DNSName:          aws.String(dns),
+							SecurityGroups:   aws.StringSlice([]string{"sg-apiserver-lb", "sg-additional"}),
+						},
+					},
+				}, nil)
+				m.CreateTargetGroup(gomock.Any()).Return(&elbv2.CreateTargetGroupOutput{
+					TargetGroups: []*elbv2.TargetGroup{
+						{
+							TargetGroupArn:  aws.String("target-group::arn"),
+							TargetGroupName: aws.String("name"),
+							VpcId:           aws.String(vpcID),
+						},
+					},
+				}, nil)
+				m.ModifyTargetGroupAttributes(gomock.Any()).Return(nil, nil)
+				m.CreateListener(gomock.Any()).Return(&elbv2.CreateListenerOutput{
+					Listeners: []*elbv2.Listener{
+						{
+							ListenerArn: aws.String("listener::arn"),
+						},
+					},
+				}, nil)
+			},
+			check: func(t *testing.T, lb *infrav1.LoadBalancer, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if len(lb.SecurityGroupIDs) != 2 {
+					t.Fatalf("Expected load balancer to have 2 security groups, got %v", len(lb.SecurityGroupIDs))
+				}
+			},
+		},
+		{
+			name: "ALB with an SSL certificate creates an HTTPS listener",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				spec.SecurityGroupIDs = []string{"sg-id"}
+				spec.ELBListeners[0].Protocol = infrav1.ELBProtocolHTTPS
+				spec.ELBListeners[0].CertificateARN = "arn:aws:acm:us-east-1:123456789012:certificate/abc"
+				spec.ELBListeners[0].SSLPolicy = infrav1.DefaultLoadBalancerSSLPolicy
+				spec.ELBListeners[0].TargetGroup.Protocol = infrav1.ELBProtocolHTTPS
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				acl.Spec.ControlPlaneLoadBalancer.LoadBalancerType = infrav1.LoadBalancerTypeALB
+				acl.Spec.ControlPlaneLoadBalancer.SSLCertificateARN = aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc")
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.CreateLoadBalancer(gomock.Any()).Return(&elbv2.CreateLoadBalancerOutput{
 					LoadBalancers: []*elbv2.LoadBalancer{
 						{
 							LoadBalancerArn:  aws.String(elbArn),
@@ -1633,7 +1930,7 @@ func TestCreateNLB(t *testing.T) {
 					HealthCheckProtocol: aws.String("tcp"),
 					Name:                aws.String("name"),
 					Port:                aws.Int64(infrav1.DefaultAPIServerPort),
-					Protocol:            aws.String("TCP"),
+					Protocol:            aws.String("HTTPS"),
 					VpcId:               aws.String(vpcID),
 					Tags: []*elbv2.Tag{
 						{
@@ -1650,15 +1947,7 @@ func TestCreateNLB(t *testing.T) {
 						},
 					},
 				}, nil)
-				m.ModifyTargetGroupAttributes(gomock.Eq(&elbv2.ModifyTargetGroupAttributesInput{
-					TargetGroupArn: aws.String("target-group::arn"),
-					Attributes: []*elbv2.TargetGroupAttribute{
-						{
-							Key:   aws.String(infrav1.TargetGroupAttributeEnablePreserveClientIP),
-							Value: aws.String("false"),
-						},
-					},
-				})).Return(nil, nil)
+				m.ModifyTargetGroupAttributes(gomock.Any()).Return(nil, nil)
 				m.CreateListener(gomock.Eq(&elbv2.CreateListenerInput{
 					DefaultActions: []*elbv2.Action{
 						{
@@ -1668,7 +1957,13 @@ func TestCreateNLB(t *testing.T) {
 					},
 					LoadBalancerArn: aws.String(elbArn),
 					Port:            aws.Int64(infrav1.DefaultAPIServerPort),
-					Protocol:        aws.String("TCP"),
+					Protocol:        aws.String("HTTPS"),
+					Certificates: []*elbv2.Certificate{
+						{
+							CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+						},
+					},
+					SslPolicy: aws.String(infrav1.DefaultLoadBalancerSSLPolicy),
 					Tags: []*elbv2.Tag{
 						{
 							Key:   aws.String("test"),
@@ -1688,43 +1983,139 @@ func TestCreateNLB(t *testing.T) {
 				if err != nil {
 					t.Fatalf("did not expect error: %v", err)
 				}
-				if lb.DNSName != dns {
-					t.Fatalf("DNSName did not equal expected value; was: '%s'", lb.DNSName)
-				}
 			},
 		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			mockCtrl := gomock.NewController(t)
-			defer mockCtrl.Finish()
-			elbV2APIMocks := mocks.NewMockELBV2API(mockCtrl)
-
-			scheme, err := setupScheme()
-			if err != nil {
-				t.Fatal(err)
-			}
-			awsCluster := &infrav1.AWSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: clusterName},
-				Spec: infrav1.AWSClusterSpec{
-					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
-						Name:             aws.String(elbName),
-						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+		{
+			name: "load balancer is not an NLB scope security groups will be added",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				spec.SecurityGroupIDs = []string{"sg-id"}
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				acl.Spec.ControlPlaneLoadBalancer.LoadBalancerType = infrav1.LoadBalancerTypeALB
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.CreateLoadBalancer(gomock.Eq(&elbv2.CreateLoadBalancerInput{
+					Name:    aws.String(elbName),
+					Scheme:  aws.String("internet-facing"),
+					Type:    aws.String("application"),
+					Subnets: aws.StringSlice([]string{clusterSubnetID}),
+					Tags: []*elbv2.Tag{
+						{
+							Key:   aws.String("test"),
+							Value: aws.String("tag"),
+						},
 					},
-					NetworkSpec: infrav1.NetworkSpec{
-						VPC: infrav1.VPCSpec{
-							ID: vpcID,
+					SecurityGroups: aws.StringSlice([]string{"sg-id"}),
+				})).Return(&elbv2.CreateLoadBalancerOutput{
+					LoadBalancers: []*elbv2.LoadBalancer{
+						{
+							LoadBalancerArn:  aws.String(elbArn),
+							LoadBalancerName: aws.String(elbName),
+							Scheme:           aws.String(string(infrav1.ELBSchemeInternetFacing)),
+							DNSName:          aws.String(dns),
 						},
 					},
-				},
-			}
-			client := fake.NewClientBuilder().WithScheme(scheme).Build()
-			cluster := tc.awsCluster(*awsCluster)
-			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
-				Client: client,
-				Cluster: &clusterv1.Cluster{
-					ObjectMeta: metav1.ObjectMeta{
+				}, nil)
+				m.CreateTargetGroup(gomock.Eq(&elbv2.CreateTargetGroupInput{
+					HealthCheckEnabled:  aws.Bool(true),
+					HealthCheckPort:     aws.String("infrav1.DefaultAPIServerPort"),
+					HealthCheckProtocol: aws.String("tcp"),
+					Name:                aws.String("name"),
+					Port:                aws.Int64(infrav1.DefaultAPIServerPort),
+					Protocol:            aws.String("TCP"),
+					VpcId:               aws.String(vpcID),
+					Tags: []*elbv2.Tag{
+						{
+							Key:   aws.String("test"),
+							Value: aws.String("tag"),
+						},
+					},
+				})).Return(&elbv2.CreateTargetGroupOutput{
+					TargetGroups: []*elbv2.TargetGroup{
+						{
+							TargetGroupArn:  aws.String("target-group::arn"),
+							TargetGroupName: aws.String("name"),
+							VpcId:           aws.String(vpcID),
+						},
+					},
+				}, nil)
+				m.ModifyTargetGroupAttributes(gomock.Eq(&elbv2.ModifyTargetGroupAttributesInput{
+					TargetGroupArn: aws.String("target-group::arn"),
+					Attributes: []*elbv2.TargetGroupAttribute{
+						{
+							Key:   aws.String(infrav1.TargetGroupAttributeEnablePreserveClientIP),
+							Value: aws.String("false"),
+						},
+					},
+				})).Return(nil, nil)
+				m.CreateListener(gomock.Eq(&elbv2.CreateListenerInput{
+					DefaultActions: []*elbv2.Action{
+						{
+							TargetGroupArn: aws.String("target-group::arn"),
+							Type:           aws.String(elbv2.ActionTypeEnumForward),
+						},
+					},
+					LoadBalancerArn: aws.String(elbArn),
+					Port:            aws.Int64(infrav1.DefaultAPIServerPort),
+					Protocol:        aws.String("TCP"),
+					Tags: []*elbv2.Tag{
+						{
+							Key:   aws.String("test"),
+							Value: aws.String("tag"),
+						},
+					},
+				})).Return(&elbv2.CreateListenerOutput{
+					Listeners: []*elbv2.Listener{
+						{
+							ListenerArn: aws.String("listener::arn"),
+						},
+					},
+				}, nil)
+			},
+			check: func(t *testing.T, lb *infrav1.LoadBalancer, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if lb.DNSName != dns {
+					t.Fatalf("DNSName did not equal expected value; was: '%s'", lb.DNSName)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			elbV2APIMocks := mocks.NewMockELBV2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			if err != nil {
+				t.Fatal(err)
+			}
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						Name:             aws.String(elbName),
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+					},
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{
+							ID: vpcID,
+						},
+					},
+				},
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			cluster := tc.awsCluster(*awsCluster)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
 						Namespace: namespace,
 						Name:      clusterName,
 					},
@@ -1856,6 +2247,291 @@ func TestReconcileV2LB(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "managed ALB listener certificate is updated in place",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				acl.Spec.ControlPlaneLoadBalancer.LoadBalancerType = infrav1.LoadBalancerTypeALB
+				acl.Spec.ControlPlaneLoadBalancer.SSLCertificateARN = aws.String("arn:aws:acm:us-east-1:123456789012:certificate/new")
+				acl.Spec.NetworkSpec.Subnets = infrav1.Subnets{
+					{
+						ID:               clusterSubnetID,
+						AvailabilityZone: az,
+						IsPublic:         true,
+					},
+				}
+				acl.Status.Network.SecurityGroups = map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupAPIServerLB: {ID: "sg-apiserver-lb"},
+				}
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Eq(&elbv2.DescribeLoadBalancersInput{
+					Names: aws.StringSlice([]string{elbName}),
+				})).
+					Return(&elbv2.DescribeLoadBalancersOutput{
+						LoadBalancers: []*elbv2.LoadBalancer{
+							{
+								LoadBalancerArn:  aws.String(elbArn),
+								LoadBalancerName: aws.String(elbName),
+								Scheme:           aws.String(string(infrav1.ELBSchemeInternetFacing)),
+								AvailabilityZones: []*elbv2.AvailabilityZone{
+									{
+										SubnetId: aws.String(clusterSubnetID),
+										ZoneName: aws.String(az),
+									},
+								},
+								VpcId:          aws.String(vpcID),
+								SecurityGroups: aws.StringSlice([]string{"sg-apiserver-lb"}),
+							},
+						},
+					}, nil)
+				m.DescribeLoadBalancerAttributes(&elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String(elbArn)}).Return(
+					&elbv2.DescribeLoadBalancerAttributesOutput{
+						Attributes: []*elbv2.LoadBalancerAttribute{
+							{
+								Key:   aws.String("load_balancing.cross_zone.enabled"),
+								Value: aws.String("false"),
+							},
+							{
+								Key:   aws.String(infrav1.LoadBalancerAttributeIdleTimeTimeoutSeconds),
+								Value: aws.String(infrav1.LoadBalancerAttributeIdleTimeDefaultTimeoutSecondsInSeconds),
+							},
+						},
+					},
+					nil,
+				)
+				m.DescribeTags(&elbv2.DescribeTagsInput{ResourceArns: []*string{aws.String(elbArn)}}).Return(
+					&elbv2.DescribeTagsOutput{
+						TagDescriptions: []*elbv2.TagDescription{
+							{
+								ResourceArn: aws.String(elbArn),
+								Tags: []*elbv2.Tag{
+									{
+										Key:   aws.String(infrav1.ClusterTagKey(clusterName)),
+										Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+									},
+									{
+										Key:   aws.String(infrav1.NameAWSClusterAPIRole),
+										Value: aws.String(infrav1.APIServerRoleTagValue),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String(elbName),
+									},
+								},
+							},
+						},
+					},
+					nil,
+				)
+				m.DescribeListeners(gomock.Eq(&elbv2.DescribeListenersInput{
+					LoadBalancerArn: aws.String(elbArn),
+				})).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []*elbv2.Listener{
+						{
+							ListenerArn: aws.String("listener::arn"),
+							Port:        aws.Int64(infrav1.DefaultAPIServerPort),
+							Protocol:    aws.String("HTTPS"),
+							Certificates: []*elbv2.Certificate{
+								{
+									CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/old"),
+								},
+							},
+							SslPolicy: aws.String(infrav1.DefaultLoadBalancerSSLPolicy),
+						},
+					},
+				}, nil)
+				m.ModifyListener(gomock.Eq(&elbv2.ModifyListenerInput{
+					ListenerArn: aws.String("listener::arn"),
+					Certificates: []*elbv2.Certificate{
+						{
+							CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/new"),
+						},
+					},
+					SslPolicy: aws.String(infrav1.DefaultLoadBalancerSSLPolicy),
+				})).Return(&elbv2.ModifyListenerOutput{}, nil)
+			},
+			check: func(t *testing.T, lb *infrav1.LoadBalancer, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "managed NLB created without security groups is not updated in place",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				acl.Spec.NetworkSpec.Subnets = infrav1.Subnets{
+					{
+						ID:               clusterSubnetID,
+						AvailabilityZone: az,
+						IsPublic:         true,
+					},
+				}
+				acl.Status.Network.SecurityGroups = map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupAPIServerLB: {ID: "sg-apiserver-lb"},
+				}
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Eq(&elbv2.DescribeLoadBalancersInput{
+					Names: aws.StringSlice([]string{elbName}),
+				})).
+					Return(&elbv2.DescribeLoadBalancersOutput{
+						LoadBalancers: []*elbv2.LoadBalancer{
+							{
+								LoadBalancerArn:  aws.String(elbArn),
+								LoadBalancerName: aws.String(elbName),
+								Scheme:           aws.String(string(infrav1.ELBSchemeInternetFacing)),
+								AvailabilityZones: []*elbv2.AvailabilityZone{
+									{
+										SubnetId: aws.String(clusterSubnetID),
+										ZoneName: aws.String(az),
+									},
+								},
+								VpcId:          aws.String(vpcID),
+								SecurityGroups: nil,
+							},
+						},
+					}, nil)
+				m.DescribeLoadBalancerAttributes(&elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String(elbArn)}).Return(
+					&elbv2.DescribeLoadBalancerAttributesOutput{
+						Attributes: []*elbv2.LoadBalancerAttribute{
+							{
+								Key:   aws.String("load_balancing.cross_zone.enabled"),
+								Value: aws.String("false"),
+							},
+						},
+					},
+					nil,
+				)
+				m.DescribeTags(&elbv2.DescribeTagsInput{ResourceArns: []*string{aws.String(elbArn)}}).Return(
+					&elbv2.DescribeTagsOutput{
+						TagDescriptions: []*elbv2.TagDescription{
+							{
+								ResourceArn: aws.String(elbArn),
+								Tags: []*elbv2.Tag{
+									{
+										Key:   aws.String(infrav1.ClusterTagKey(clusterName)),
+										Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+									},
+									{
+										Key:   aws.String(infrav1.NameAWSClusterAPIRole),
+										Value: aws.String(infrav1.APIServerRoleTagValue),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String(elbName),
+									},
+								},
+							},
+						},
+					},
+					nil,
+				)
+			},
+			check: func(t *testing.T, lb *infrav1.LoadBalancer, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if len(lb.SecurityGroupIDs) != 0 {
+					t.Errorf("Expected LB to contain no security groups, got %v", lb.SecurityGroupIDs)
+				}
+			},
+		},
+		{
+			name: "managed NLB security groups are reconciled when they drift",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				acl.Spec.NetworkSpec.Subnets = infrav1.Subnets{
+					{
+						ID:               clusterSubnetID,
+						AvailabilityZone: az,
+						IsPublic:         true,
+					},
+				}
+				acl.Status.Network.SecurityGroups = map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupAPIServerLB: {ID: "sg-apiserver-lb"},
+				}
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Eq(&elbv2.DescribeLoadBalancersInput{
+					Names: aws.StringSlice([]string{elbName}),
+				})).
+					Return(&elbv2.DescribeLoadBalancersOutput{
+						LoadBalancers: []*elbv2.LoadBalancer{
+							{
+								LoadBalancerArn:  aws.String(elbArn),
+								LoadBalancerName: aws.String(elbName),
+								Scheme:           aws.String(string(infrav1.ELBSchemeInternetFacing)),
+								AvailabilityZones: []*elbv2.AvailabilityZone{
+									{
+										SubnetId: aws.String(clusterSubnetID),
+										ZoneName: aws.String(az),
+									},
+								},
+								VpcId:          aws.String(vpcID),
+								SecurityGroups: aws.StringSlice([]string{"sg-old"}),
+							},
+						},
+					}, nil)
+				m.DescribeLoadBalancerAttributes(&elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String(elbArn)}).Return(
+					&elbv2.DescribeLoadBalancerAttributesOutput{
+						Attributes: []*elbv2.LoadBalancerAttribute{
+							{
+								Key:   aws.String("load_balancing.cross_zone.enabled"),
+								Value: aws.String("false"),
+							},
+						},
+					},
+					nil,
+				)
+				m.DescribeTags(&elbv2.DescribeTagsInput{ResourceArns: []*string{aws.String(elbArn)}}).Return(
+					&elbv2.DescribeTagsOutput{
+						TagDescriptions: []*elbv2.TagDescription{
+							{
+								ResourceArn: aws.String(elbArn),
+								Tags: []*elbv2.Tag{
+									{
+										Key:   aws.String(infrav1.ClusterTagKey(clusterName)),
+										Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+									},
+									{
+										Key:   aws.String(infrav1.NameAWSClusterAPIRole),
+										Value: aws.String(infrav1.APIServerRoleTagValue),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String(elbName),
+									},
+								},
+							},
+						},
+					},
+					nil,
+				)
+				m.SetSecurityGroups(gomock.Eq(&elbv2.SetSecurityGroupsInput{
+					LoadBalancerArn: aws.String(elbArn),
+					SecurityGroups:  aws.StringSlice([]string{"sg-apiserver-lb"}),
+				})).Return(&elbv2.SetSecurityGroupsOutput{}, nil)
+			},
+			check: func(t *testing.T, lb *infrav1.LoadBalancer, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1911,6 +2587,322 @@ func TestReconcileV2LB(t *testing.T) {
 	}
 }
 
+func TestReconcileV2LBTags(t *testing.T) {
+	const (
+		clusterName = "bar"
+		elbArn      = "arn::apiserver"
+	)
+
+	tests := []struct {
+		name          string
+		policy        infrav1.TagReconcilePolicy
+		currentTags   infrav1.Tags
+		desiredTags   infrav1.Tags
+		elbV2APIMocks func(m *mocks.MockELBV2APIMockRecorder)
+	}{
+		{
+			name:        "adds missing and removes unknown tags by default",
+			currentTags: infrav1.Tags{"Name": "old", "team": "a"},
+			desiredTags: infrav1.Tags{"Name": "new", infrav1.ClusterTagKey(clusterName): "owned"},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.AddTags(gomock.AssignableToTypeOf(&elbv2.AddTagsInput{})).
+					Do(func(input *elbv2.AddTagsInput) {
+						if len(input.Tags) != 2 {
+							t.Errorf("expected 2 tags to be added, got %v", input.Tags)
+						}
+					}).Return(&elbv2.AddTagsOutput{}, nil)
+				m.RemoveTags(gomock.Eq(&elbv2.RemoveTagsInput{
+					ResourceArns: aws.StringSlice([]string{elbArn}),
+					TagKeys:      aws.StringSlice([]string{"team"}),
+				})).Return(&elbv2.RemoveTagsOutput{}, nil)
+			},
+		},
+		{
+			name:        "only re-applies the ownership tag with the observe policy",
+			policy:      infrav1.TagReconcilePolicyObserve,
+			currentTags: infrav1.Tags{"Name": "old", "team": "a"},
+			desiredTags: infrav1.Tags{"Name": "new", infrav1.ClusterTagKey(clusterName): "owned"},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.AddTags(gomock.Eq(&elbv2.AddTagsInput{
+					ResourceArns: aws.StringSlice([]string{elbArn}),
+					Tags:         []*elbv2.Tag{{Key: aws.String(infrav1.ClusterTagKey(clusterName)), Value: aws.String("owned")}},
+				})).Return(&elbv2.AddTagsOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			elbV2APIMocks := mocks.NewMockELBV2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			if err != nil {
+				t.Fatal(err)
+			}
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+			}
+			if tc.policy != "" {
+				awsCluster.Annotations = map[string]string{infrav1.TagReconcilePolicyAnnotation: string(tc.policy)}
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+				},
+				AWSCluster: awsCluster,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tc.elbV2APIMocks(elbV2APIMocks.EXPECT())
+
+			s := &Service{
+				scope:       clusterScope,
+				ELBV2Client: elbV2APIMocks,
+			}
+			lb := &infrav1.LoadBalancer{ARN: elbArn, Tags: tc.currentTags}
+			if err := s.reconcileV2LBTags(lb, tc.desiredTags); err != nil {
+				t.Fatalf("did not expect error: %v", err)
+			}
+		})
+	}
+}
+
+func TestReconcileClassicLoadBalancer(t *testing.T) {
+	const (
+		namespace   = "foo"
+		clusterName = "bar"
+		elbName     = "bar-apiserver"
+		vpcID       = "vpc-id"
+		sgID        = "sg-apiserver-lb"
+	)
+
+	tests := []struct {
+		name        string
+		idleTimeout *metav1.Duration
+		elbAPIMocks func(m *mocks.MockELBAPIMockRecorder)
+		check       func(t *testing.T, lb *infrav1.LoadBalancer, err error)
+	}{
+		{
+			name:        "managed classic ELB idle timeout is updated in place when it drifts",
+			idleTimeout: &metav1.Duration{Duration: 2 * time.Minute},
+			elbAPIMocks: func(m *mocks.MockELBAPIMockRecorder) {
+				m.ModifyLoadBalancerAttributes(gomock.Eq(&elb.ModifyLoadBalancerAttributesInput{
+					LoadBalancerName: aws.String(elbName),
+					LoadBalancerAttributes: &elb.LoadBalancerAttributes{
+						CrossZoneLoadBalancing: &elb.CrossZoneLoadBalancing{
+							Enabled: aws.Bool(false),
+						},
+						ConnectionSettings: &elb.ConnectionSettings{
+							IdleTimeout: aws.Int64(120),
+						},
+					},
+				})).Return(&elb.ModifyLoadBalancerAttributesOutput{}, nil)
+			},
+			check: func(t *testing.T, lb *infrav1.LoadBalancer, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "managed classic ELB with the default idle timeout is not modified",
+			elbAPIMocks: func(m *mocks.MockELBAPIMockRecorder) {
+				m.ModifyLoadBalancerAttributes(gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, lb *infrav1.LoadBalancer, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if lb.ClassicElbAttributes.IdleTimeout != 10*time.Minute {
+					t.Errorf("expected idle timeout to be %v, got %v", 10*time.Minute, lb.ClassicElbAttributes.IdleTimeout)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			elbAPIMocks := mocks.NewMockELBAPI(mockCtrl)
+
+			scheme, err := setupScheme()
+			if err != nil {
+				t.Fatal(err)
+			}
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						Name:             aws.String(elbName),
+						LoadBalancerType: infrav1.LoadBalancerTypeClassic,
+						IdleTimeout:      tc.idleTimeout,
+					},
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{
+							ID: vpcID,
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupAPIServerLB: {
+								ID: sgID,
+							},
+						},
+					},
+				},
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+						Name:      clusterName,
+					},
+				},
+				AWSCluster: awsCluster,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			elbAPIMocks.EXPECT().DescribeLoadBalancers(gomock.Eq(&elb.DescribeLoadBalancersInput{
+				LoadBalancerNames: aws.StringSlice([]string{elbName}),
+			})).Return(&elb.DescribeLoadBalancersOutput{
+				LoadBalancerDescriptions: []*elb.LoadBalancerDescription{
+					{
+						LoadBalancerName: aws.String(elbName),
+						Scheme:           aws.String(string(infrav1.ELBSchemeInternetFacing)),
+						VPCId:            aws.String(vpcID),
+						SecurityGroups:   aws.StringSlice([]string{sgID}),
+					},
+				},
+			}, nil)
+			elbAPIMocks.EXPECT().DescribeLoadBalancerAttributes(gomock.Eq(&elb.DescribeLoadBalancerAttributesInput{
+				LoadBalancerName: aws.String(elbName),
+			})).Return(&elb.DescribeLoadBalancerAttributesOutput{
+				LoadBalancerAttributes: &elb.LoadBalancerAttributes{
+					CrossZoneLoadBalancing: &elb.CrossZoneLoadBalancing{
+						Enabled: aws.Bool(false),
+					},
+					ConnectionSettings: &elb.ConnectionSettings{
+						IdleTimeout: aws.Int64(600),
+					},
+				},
+			}, nil)
+			elbAPIMocks.EXPECT().DescribeTags(gomock.Eq(&elb.DescribeTagsInput{
+				LoadBalancerNames: aws.StringSlice([]string{elbName}),
+			})).Return(&elb.DescribeTagsOutput{
+				TagDescriptions: []*elb.TagDescription{
+					{
+						LoadBalancerName: aws.String(elbName),
+						Tags: []*elb.Tag{
+							{
+								Key:   aws.String(infrav1.ClusterTagKey(clusterName)),
+								Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+							},
+							{
+								Key:   aws.String(infrav1.NameAWSClusterAPIRole),
+								Value: aws.String(infrav1.APIServerRoleTagValue),
+							},
+							{
+								Key:   aws.String("Name"),
+								Value: aws.String(elbName),
+							},
+						},
+					},
+				},
+			}, nil)
+			tc.elbAPIMocks(elbAPIMocks.EXPECT())
+
+			s := &Service{
+				scope:     clusterScope,
+				ELBClient: elbAPIMocks,
+			}
+			err = s.reconcileClassicLoadBalancer()
+			lb := s.scope.Network().APIServerELB
+			tc.check(t, &lb, err)
+		})
+	}
+}
+
+func TestReconcileLoadbalancersPaused(t *testing.T) {
+	const (
+		namespace   = "foo"
+		clusterName = "bar"
+		elbName     = "bar-apiserver"
+	)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	elbAPIMocks := mocks.NewMockELBAPI(mockCtrl)
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        clusterName,
+			Annotations: map[string]string{infrav1.PauseLoadBalancerAnnotation: ""},
+		},
+		Spec: infrav1.AWSClusterSpec{
+			ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+				Name:             aws.String(elbName),
+				LoadBalancerType: infrav1.LoadBalancerTypeClassic,
+			},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      clusterName,
+			},
+		},
+		AWSCluster: awsCluster,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Service{
+		scope:     clusterScope,
+		ELBClient: elbAPIMocks,
+	}
+
+	// No AWS API is called while the load balancers are paused.
+	if err := s.ReconcileLoadbalancers(); err != nil {
+		t.Fatalf("did not expect error: %v", err)
+	}
+	if !conditions.IsTrue(awsCluster, infrav1.LoadBalancerPausedCondition) {
+		t.Fatalf("expected the %s condition to be true", infrav1.LoadBalancerPausedCondition)
+	}
+
+	// The load balancers are reconciled again once the annotation is removed.
+	delete(awsCluster.Annotations, infrav1.PauseLoadBalancerAnnotation)
+	elbAPIMocks.EXPECT().DescribeLoadBalancers(gomock.Eq(&elb.DescribeLoadBalancersInput{
+		LoadBalancerNames: aws.StringSlice([]string{elbName}),
+	})).Return(nil, errors.New("reconciling the load balancers"))
+	if err := s.ReconcileLoadbalancers(); err == nil {
+		t.Fatal("expected the load balancers to be reconciled")
+	}
+	if conditions.Has(awsCluster, infrav1.LoadBalancerPausedCondition) {
+		t.Fatalf("expected the %s condition to be removed", infrav1.LoadBalancerPausedCondition)
+	}
+}
+
 func TestDeleteAPIServerELB(t *testing.T) {
 	clusterName := "bar" //nolint:goconst // does not need to be a package-level const
 	elbName := "bar-apiserver"
@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator/globalacceleratoriface"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
@@ -29,20 +30,22 @@ import (
 // The interfaces are broken down like this to group functions together.
 // One alternative is to have a large list of functions from the ec2 client.
 type Service struct {
-	scope                 scope.ELBScope
-	EC2Client             ec2iface.EC2API
-	ELBClient             elbiface.ELBAPI
-	ELBV2Client           elbv2iface.ELBV2API
-	ResourceTaggingClient resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	scope                   scope.ELBScope
+	EC2Client               ec2iface.EC2API
+	ELBClient               elbiface.ELBAPI
+	ELBV2Client             elbv2iface.ELBV2API
+	ResourceTaggingClient   resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	GlobalAcceleratorClient globalacceleratoriface.GlobalAcceleratorAPI
 }
 
 // NewService returns a new service given the api clients.
 func NewService(elbScope scope.ELBScope) *Service {
 	return &Service{
-		scope:                 elbScope,
-		EC2Client:             scope.NewEC2Client(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
-		ELBClient:             scope.NewELBClient(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
-		ELBV2Client:           scope.NewELBv2Client(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
-		ResourceTaggingClient: scope.NewResourgeTaggingClient(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
+		scope:                   elbScope,
+		EC2Client:               scope.NewEC2Client(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
+		ELBClient:               scope.NewELBClient(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
+		ELBV2Client:             scope.NewELBv2Client(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
+		ResourceTaggingClient:   scope.NewResourgeTaggingClient(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
+		GlobalAcceleratorClient: scope.NewGlobalAcceleratorClient(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
 	}
 }
@@ -18,14 +18,17 @@ package iamauth
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
@@ -38,6 +41,10 @@ const (
 
 	roleKey  = "mapRoles"
 	usersKey = "mapUsers"
+
+	// lastAppliedMappingsAnnotation records the mappings that were declared the last time the
+	// config map was reconciled.
+	lastAppliedMappingsAnnotation = "sigs.k8s.io/cluster-api-provider-aws-last-applied-mappings"
 )
 
 type configMapBackend struct {
@@ -88,6 +95,156 @@ func (b *configMapBackend) MapUser(mapping ekscontrolplanev1.UserMapping) error
 	return b.saveAuthConfig(authConfig)
 }
 
+// ReconcileMappings makes the mappings of the aws-auth config map match the declared role and user mappings.
+// Mappings that were declared the last time but have since been removed are deleted, while the mappings
+// CAPA doesn't declare are kept. The node role mappings are never deleted: the roles of the nodes that
+// aren't declared are mapped with the given node mappings instead. It returns the ARNs of the declared
+// mappings that had been changed outside of CAPA and were restored.
+func (b *configMapBackend) ReconcileMappings(declared ekscontrolplanev1.IAMAuthenticatorConfig, nodeMappings []ekscontrolplanev1.RoleMapping) ([]string, error) {
+	ctx := context.Background()
+
+	errs := []error{}
+	for _, mapping := range declared.RoleMappings {
+		errs = append(errs, mapping.Validate()...)
+	}
+	for _, mapping := range declared.UserMappings {
+		errs = append(errs, mapping.Validate()...)
+	}
+	if len(errs) > 0 {
+		return nil, kerrors.NewAggregate(errs)
+	}
+
+	configMapRef := types.NamespacedName{
+		Name:      configMapName,
+		Namespace: configMapNS,
+	}
+
+	authConfigMap := &corev1.ConfigMap{}
+	if err := b.client.Get(ctx, configMapRef, authConfigMap); err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("getting %s/%s config map: %w", configMapName, configMapNS, err)
+	}
+
+	existingRoles, err := b.getMappedRoles(authConfigMap)
+	if err != nil {
+		return nil, fmt.Errorf("getting mapped roles: %w", err)
+	}
+	existingUsers, err := b.getMappedUsers(authConfigMap)
+	if err != nil {
+		return nil, fmt.Errorf("getting mapped users: %w", err)
+	}
+
+	lastApplied := ekscontrolplanev1.IAMAuthenticatorConfig{}
+	if value, ok := authConfigMap.Annotations[lastAppliedMappingsAnnotation]; ok {
+		if err := json.Unmarshal([]byte(value), &lastApplied); err != nil {
+			return nil, fmt.Errorf("unmarshalling last applied mappings: %w", err)
+		}
+	}
+
+	roleARN := func(mapping ekscontrolplanev1.RoleMapping) string { return mapping.RoleARN }
+	userARN := func(mapping ekscontrolplanev1.UserMapping) string { return mapping.UserARN }
+	roles, driftedRoles := reconcileMappings(existingRoles, declared.RoleMappings, lastApplied.RoleMappings, nodeMappings, roleARN)
+	users, driftedUsers := reconcileMappings(existingUsers, declared.UserMappings, lastApplied.UserMappings, nil, userARN)
+
+	if cmp.Equal(existingRoles, roles, cmpopts.EquateEmpty()) &&
+		cmp.Equal(existingUsers, users, cmpopts.EquateEmpty()) &&
+		cmp.Equal(lastApplied, declared, cmpopts.EquateEmpty()) {
+		return nil, nil
+	}
+
+	applied, err := json.Marshal(declared)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling applied mappings: %w", err)
+	}
+
+	if authConfigMap.Annotations == nil {
+		authConfigMap.Annotations = map[string]string{}
+	}
+	authConfigMap.Annotations[lastAppliedMappingsAnnotation] = string(applied)
+
+	authConfig := &ekscontrolplanev1.IAMAuthenticatorConfig{
+		RoleMappings: roles,
+		UserMappings: users,
+	}
+	if err := b.writeAuthConfig(ctx, authConfigMap, authConfig); err != nil {
+		return nil, err
+	}
+
+	return append(driftedRoles, driftedUsers...), nil
+}
+
+// reconcileMappings replaces the existing mappings of the declared ARNs with the declared ones and removes
+// the mappings of the ARNs that were declared the last time but no longer are. The existing mappings of
+// the ARNs of the node mappings that aren't declared are replaced with the node mappings instead of being
+// removed. It also returns the declared ARNs whose existing mappings differ from the last applied ones.
+func reconcileMappings[T any](existing, declared, lastApplied, nodeMappings []T, arnOf func(T) string) ([]T, []string) {
+	declaredARNs := []string{}
+	declaredByARN := map[string][]T{}
+	for _, mapping := range declared {
+		arn := arnOf(mapping)
+		if _, ok := declaredByARN[arn]; !ok {
+			declaredARNs = append(declaredARNs, arn)
+		}
+		declaredByARN[arn] = append(declaredByARN[arn], mapping)
+	}
+	wantedARNs := append([]string{}, declaredARNs...)
+	wantedByARN := map[string][]T{}
+	for arn, mappings := range declaredByARN {
+		wantedByARN[arn] = mappings
+	}
+	for _, mapping := range nodeMappings {
+		arn := arnOf(mapping)
+		if _, ok := declaredByARN[arn]; ok {
+			// The declared mappings of a node role take precedence.
+			continue
+		}
+		if _, ok := wantedByARN[arn]; !ok {
+			wantedARNs = append(wantedARNs, arn)
+		}
+		wantedByARN[arn] = append(wantedByARN[arn], mapping)
+	}
+	existingByARN := map[string][]T{}
+	for _, mapping := range existing {
+		existingByARN[arnOf(mapping)] = append(existingByARN[arnOf(mapping)], mapping)
+	}
+	lastAppliedByARN := map[string][]T{}
+	for _, mapping := range lastApplied {
+		lastAppliedByARN[arnOf(mapping)] = append(lastAppliedByARN[arnOf(mapping)], mapping)
+	}
+
+	mappings := []T{}
+	added := sets.New[string]()
+	for _, mapping := range existing {
+		arn := arnOf(mapping)
+		switch {
+		case wantedByARN[arn] != nil:
+			// Keep the wanted mappings where the existing ones were.
+			if !added.Has(arn) {
+				mappings = append(mappings, wantedByARN[arn]...)
+				added.Insert(arn)
+			}
+		case lastAppliedByARN[arn] != nil:
+			// The mapping is no longer declared, so remove it.
+		default:
+			mappings = append(mappings, mapping)
+		}
+	}
+
+	for _, arn := range wantedARNs {
+		if !added.Has(arn) {
+			mappings = append(mappings, wantedByARN[arn]...)
+		}
+	}
+
+	drifted := []string{}
+	for _, arn := range declaredARNs {
+		if applied, ok := lastAppliedByARN[arn]; ok && !cmp.Equal(existingByARN[arn], applied, cmpopts.EquateEmpty()) {
+			drifted = append(drifted, arn)
+		}
+	}
+
+	return mappings, drifted
+}
+
 func (b *configMapBackend) getAuthConfig() (*ekscontrolplanev1.IAMAuthenticatorConfig, error) {
 	ctx := context.Background()
 
@@ -141,6 +298,10 @@ func (b *configMapBackend) saveAuthConfig(authConfig *ekscontrolplanev1.IAMAuthe
 		return fmt.Errorf("getting %s/%s config map: %w", configMapName, configMapNS, err)
 	}
 
+	return b.writeAuthConfig(ctx, authConfigMap, authConfig)
+}
+
+func (b *configMapBackend) writeAuthConfig(ctx context.Context, authConfigMap *corev1.ConfigMap, authConfig *ekscontrolplanev1.IAMAuthenticatorConfig) error {
 	if authConfigMap.Data == nil {
 		authConfigMap.Data = make(map[string]string)
 	}
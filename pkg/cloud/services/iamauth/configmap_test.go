@@ -18,9 +18,11 @@ package iamauth
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -315,6 +317,265 @@ func TestAddUserMappingCM(t *testing.T) {
 	}
 }
 
+func TestReconcileMappingsCM(t *testing.T) {
+	nodeRoleMapping := ekscontrolplanev1.RoleMapping{
+		RoleARN: "arn:aws:iam::000000000000:role/KubernetesNode",
+		KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+			UserName: "system:node:{{EC2PrivateDNSName}}",
+			Groups:   []string{"system:bootstrappers", "system:nodes"},
+		},
+	}
+	adminRoleMapping := ekscontrolplanev1.RoleMapping{
+		RoleARN: "arn:aws:iam::000000000000:role/KubernetesAdmin",
+		KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+			UserName: "admin:{{SessionName}}",
+			Groups:   []string{"system:masters"},
+		},
+	}
+	viewerRoleMapping := ekscontrolplanev1.RoleMapping{
+		RoleARN: "arn:aws:iam::000000000000:role/KubernetesAdmin",
+		KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+			UserName: "admin:{{SessionName}}",
+			Groups:   []string{"view"},
+		},
+	}
+	nodeAdminRoleMapping := ekscontrolplanev1.RoleMapping{
+		RoleARN: nodeRoleMapping.RoleARN,
+		KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+			UserName: "system:node:{{EC2PrivateDNSName}}",
+			Groups:   []string{"system:bootstrappers", "system:nodes", "system:masters"},
+		},
+	}
+	aliceUserMapping := ekscontrolplanev1.UserMapping{
+		UserARN: "arn:aws:iam::000000000000:user/Alice",
+		KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+			UserName: "alice",
+			Groups:   []string{"system:masters"},
+		},
+	}
+
+	existingAdminRoleMap := `
+    - groups:
+      - system:masters
+      rolearn: arn:aws:iam::000000000000:role/KubernetesAdmin
+      username: admin:{{SessionName}}
+`
+	editedAdminRoleMap := `
+    - groups:
+      - system:masters
+      - view
+      rolearn: arn:aws:iam::000000000000:role/KubernetesAdmin
+      username: admin:{{SessionName}}
+`
+	existingNodeAdminRoleMap := `
+    - groups:
+      - system:bootstrappers
+      - system:nodes
+      - system:masters
+      rolearn: arn:aws:iam::000000000000:role/KubernetesNode
+      username: system:node:{{EC2PrivateDNSName}}
+`
+	existingManualRoleMap := `
+    - groups:
+      - view
+      rolearn: arn:aws:iam::000000000000:role/KubernetesViewer
+      username: viewer
+`
+
+	testCases := []struct {
+		name                  string
+		existingAuthConfigMap *corev1.ConfigMap
+		declared              ekscontrolplanev1.IAMAuthenticatorConfig
+		nodeMappings          []ekscontrolplanev1.RoleMapping
+		expectedRoleMaps      []ekscontrolplanev1.RoleMapping
+		expectedUserMaps      []ekscontrolplanev1.UserMapping
+		expectedDrifted       []string
+		expectError           bool
+	}{
+		{
+			name: "no existing config map, add declared mappings",
+			declared: ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{adminRoleMapping},
+				UserMappings: []ekscontrolplanev1.UserMapping{aliceUserMapping},
+			},
+			expectedRoleMaps: []ekscontrolplanev1.RoleMapping{adminRoleMapping},
+			expectedUserMaps: []ekscontrolplanev1.UserMapping{aliceUserMapping},
+		},
+		{
+			name:                  "existing node mapping, add declared role mapping",
+			existingAuthConfigMap: createFakeConfigMap(existingNodeRoleMap, ""),
+			declared: ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{adminRoleMapping},
+			},
+			expectedRoleMaps: []ekscontrolplanev1.RoleMapping{nodeRoleMapping, adminRoleMapping},
+		},
+		{
+			name:                  "existing mapping not declared by capa, keep mapping",
+			existingAuthConfigMap: createFakeConfigMap(existingNodeRoleMap+existingManualRoleMap, ""),
+			declared: ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{adminRoleMapping},
+			},
+			expectedRoleMaps: []ekscontrolplanev1.RoleMapping{
+				nodeRoleMapping,
+				{
+					RoleARN: "arn:aws:iam::000000000000:role/KubernetesViewer",
+					KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+						UserName: "viewer",
+						Groups:   []string{"view"},
+					},
+				},
+				adminRoleMapping,
+			},
+		},
+		{
+			name: "declared role mapping changed, update mapping",
+			existingAuthConfigMap: withLastAppliedMappings(createFakeConfigMap(existingNodeRoleMap+existingAdminRoleMap, ""), ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{adminRoleMapping},
+			}),
+			declared: ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{viewerRoleMapping},
+			},
+			expectedRoleMaps: []ekscontrolplanev1.RoleMapping{nodeRoleMapping, viewerRoleMapping},
+		},
+		{
+			name: "declared mappings removed, remove mappings and keep node mapping",
+			existingAuthConfigMap: withLastAppliedMappings(createFakeConfigMap(existingNodeRoleMap+existingAdminRoleMap, existingUserMap), ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{adminRoleMapping},
+				UserMappings: []ekscontrolplanev1.UserMapping{aliceUserMapping},
+			}),
+			declared:         ekscontrolplanev1.IAMAuthenticatorConfig{},
+			expectedRoleMaps: []ekscontrolplanev1.RoleMapping{nodeRoleMapping},
+		},
+		{
+			name:         "no existing config map, add node and declared mappings",
+			nodeMappings: []ekscontrolplanev1.RoleMapping{nodeRoleMapping},
+			declared: ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{adminRoleMapping},
+			},
+			expectedRoleMaps: []ekscontrolplanev1.RoleMapping{adminRoleMapping, nodeRoleMapping},
+		},
+		{
+			name: "declared node role mapping removed, map node role instead of removing it",
+			existingAuthConfigMap: withLastAppliedMappings(createFakeConfigMap(existingNodeAdminRoleMap, ""), ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{nodeAdminRoleMapping},
+			}),
+			nodeMappings:     []ekscontrolplanev1.RoleMapping{nodeRoleMapping},
+			declared:         ekscontrolplanev1.IAMAuthenticatorConfig{},
+			expectedRoleMaps: []ekscontrolplanev1.RoleMapping{nodeRoleMapping},
+		},
+		{
+			name:                  "node role mapping declared, declared mapping takes precedence",
+			existingAuthConfigMap: createFakeConfigMap(existingNodeRoleMap, ""),
+			nodeMappings:          []ekscontrolplanev1.RoleMapping{nodeRoleMapping},
+			declared: ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{nodeAdminRoleMapping},
+			},
+			expectedRoleMaps: []ekscontrolplanev1.RoleMapping{nodeAdminRoleMapping},
+		},
+		{
+			name: "declared role mapping edited outside of capa, restore mapping",
+			existingAuthConfigMap: withLastAppliedMappings(createFakeConfigMap(existingNodeRoleMap+editedAdminRoleMap, ""), ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{adminRoleMapping},
+			}),
+			declared: ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{adminRoleMapping},
+			},
+			expectedRoleMaps: []ekscontrolplanev1.RoleMapping{nodeRoleMapping, adminRoleMapping},
+			expectedDrifted:  []string{adminRoleMapping.RoleARN},
+		},
+		{
+			name: "declared mappings deleted outside of capa, restore mappings",
+			existingAuthConfigMap: withLastAppliedMappings(createFakeConfigMap(existingNodeRoleMap, ""), ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{adminRoleMapping},
+				UserMappings: []ekscontrolplanev1.UserMapping{aliceUserMapping},
+			}),
+			declared: ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{adminRoleMapping},
+				UserMappings: []ekscontrolplanev1.UserMapping{aliceUserMapping},
+			},
+			expectedRoleMaps: []ekscontrolplanev1.RoleMapping{nodeRoleMapping, adminRoleMapping},
+			expectedUserMaps: []ekscontrolplanev1.UserMapping{aliceUserMapping},
+			expectedDrifted:  []string{adminRoleMapping.RoleARN, aliceUserMapping.UserARN},
+		},
+		{
+			name:                  "invalid declared mapping",
+			existingAuthConfigMap: createFakeConfigMap(existingNodeRoleMap, ""),
+			declared: ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{
+					{
+						RoleARN: "arn:aws:iam::000000000000:role/KubernetesAdmin",
+					},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			var client crclient.Client
+			if tc.existingAuthConfigMap == nil {
+				client = fake.NewClientBuilder().Build()
+			} else {
+				client = fake.NewClientBuilder().WithObjects(tc.existingAuthConfigMap).Build()
+			}
+			backend, err := NewBackend(BackendTypeConfigMap, client)
+			g.Expect(err).To(BeNil())
+			reconciler, ok := backend.(MappingsReconciler)
+			g.Expect(ok).To(BeTrue())
+
+			drifted, err := reconciler.ReconcileMappings(tc.declared, tc.nodeMappings)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+
+			g.Expect(err).To(BeNil())
+			g.Expect(drifted).To(ConsistOf(tc.expectedDrifted))
+
+			key := types.NamespacedName{
+				Name:      "aws-auth",
+				Namespace: "kube-system",
+			}
+
+			cm := &corev1.ConfigMap{}
+
+			err = client.Get(context.TODO(), key, cm)
+			g.Expect(err).To(BeNil())
+
+			roles := []ekscontrolplanev1.RoleMapping{}
+			g.Expect(yaml.Unmarshal([]byte(cm.Data["mapRoles"]), &roles)).To(Succeed())
+			g.Expect(roles).To(BeComparableTo(tc.expectedRoleMaps, cmpopts.EquateEmpty()))
+
+			users := []ekscontrolplanev1.UserMapping{}
+			g.Expect(yaml.Unmarshal([]byte(cm.Data["mapUsers"]), &users)).To(Succeed())
+			g.Expect(users).To(BeComparableTo(tc.expectedUserMaps, cmpopts.EquateEmpty()))
+
+			lastApplied := ekscontrolplanev1.IAMAuthenticatorConfig{}
+			g.Expect(json.Unmarshal([]byte(cm.Annotations[lastAppliedMappingsAnnotation]), &lastApplied)).To(Succeed())
+			g.Expect(lastApplied).To(BeComparableTo(tc.declared, cmpopts.EquateEmpty()))
+
+			// Reconciling again must not report any drift.
+			drifted, err = reconciler.ReconcileMappings(tc.declared, tc.nodeMappings)
+			g.Expect(err).To(BeNil())
+			g.Expect(drifted).To(BeEmpty())
+		})
+	}
+}
+
+func withLastAppliedMappings(cm *corev1.ConfigMap, lastApplied ekscontrolplanev1.IAMAuthenticatorConfig) *corev1.ConfigMap {
+	value, err := json.Marshal(lastApplied)
+	if err != nil {
+		panic(err)
+	}
+	cm.Annotations = map[string]string{
+		lastAppliedMappingsAnnotation: string(value),
+	}
+	return cm
+}
+
 func createFakeConfigMap(roleMappings string, userMappings string) *corev1.ConfigMap {
 	cm := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -40,6 +40,15 @@ type AuthenticatorBackend interface {
 	MapUser(mapping ekscontrolplanev1.UserMapping) error
 }
 
+// MappingsReconciler is implemented by the aws-iam-authenticator backends that can reconcile the
+// declared mappings, removing the ones that are no longer declared.
+type MappingsReconciler interface {
+	// ReconcileMappings is used to make the mappings match the declared role and user mappings, and the
+	// node role mappings, which are never removed. It returns the ARNs of the mappings that had drifted
+	// and were corrected.
+	ReconcileMappings(declared ekscontrolplanev1.IAMAuthenticatorConfig, nodeMappings []ekscontrolplanev1.RoleMapping) ([]string, error)
+}
+
 // BackendType is a type that represents the different aws-iam-authenticator backends.
 type BackendType string
 
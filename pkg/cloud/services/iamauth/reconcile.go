@@ -19,19 +19,23 @@ package iamauth
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 // ReconcileIAMAuthenticator is used to create the aws-iam-authenticator in a cluster.
@@ -53,37 +57,61 @@ func (s *Service) ReconcileIAMAuthenticator(ctx context.Context) error {
 		s.scope.Error(err, "getting roles for remote workers")
 		return fmt.Errorf("getting roles for remote workers: %w", err)
 	}
+	iamCfg := s.scope.IAMAuthConfig()
+	declaredRoles := sets.New[string]()
+	for _, roleMapping := range iamCfg.RoleMappings {
+		declaredRoles.Insert(roleMapping.RoleARN)
+	}
+
+	nodeMappings := []ekscontrolplanev1.RoleMapping{}
 	for roleName := range nodeRoles {
 		roleARN, err := s.getARNForRole(roleName)
 		if err != nil {
 			return fmt.Errorf("failed to get ARN for role %s: %w", roleARN, err)
 		}
-		nodesRoleMapping := ekscontrolplanev1.RoleMapping{
+		if declaredRoles.Has(roleARN) {
+			// The declared mapping of the role takes precedence.
+			continue
+		}
+		nodeMappings = append(nodeMappings, ekscontrolplanev1.RoleMapping{
 			RoleARN: roleARN,
 			KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
 				UserName: EC2NodeUserName,
 				Groups:   NodeGroups,
 			},
+		})
+	}
+
+	if mappingsReconciler, ok := authBackend.(MappingsReconciler); ok {
+		s.scope.Debug("Reconciling node and additional IAM role and user mappings")
+		drifted, err := mappingsReconciler.ReconcileMappings(*iamCfg, nodeMappings)
+		if err != nil {
+			return fmt.Errorf("reconciling iam role and user mappings: %w", err)
 		}
-		s.scope.Debug("Mapping node IAM role", "iam-role", nodesRoleMapping.RoleARN, "user", nodesRoleMapping.UserName)
-		if err := authBackend.MapRole(nodesRoleMapping); err != nil {
-			return fmt.Errorf("mapping iam node role: %w", err)
+		if len(drifted) > 0 {
+			s.markDriftCorrected(drifted)
+		}
+	} else {
+		for _, nodesRoleMapping := range nodeMappings {
+			s.scope.Debug("Mapping node IAM role", "iam-role", nodesRoleMapping.RoleARN, "user", nodesRoleMapping.UserName)
+			if err := authBackend.MapRole(nodesRoleMapping); err != nil {
+				return fmt.Errorf("mapping iam node role: %w", err)
+			}
 		}
-	}
 
-	s.scope.Debug("Mapping additional IAM roles and users")
-	iamCfg := s.scope.IAMAuthConfig()
-	for _, roleMapping := range iamCfg.RoleMappings {
-		s.scope.Debug("Mapping IAM role", "iam-role", roleMapping.RoleARN, "user", roleMapping.UserName)
-		if err := authBackend.MapRole(roleMapping); err != nil {
-			return fmt.Errorf("mapping iam role: %w", err)
+		s.scope.Debug("Mapping additional IAM roles and users")
+		for _, roleMapping := range iamCfg.RoleMappings {
+			s.scope.Debug("Mapping IAM role", "iam-role", roleMapping.RoleARN, "user", roleMapping.UserName)
+			if err := authBackend.MapRole(roleMapping); err != nil {
+				return fmt.Errorf("mapping iam role: %w", err)
+			}
 		}
-	}
 
-	for _, userMapping := range iamCfg.UserMappings {
-		s.scope.Debug("Mapping IAM user", "iam-user", userMapping.UserARN, "user", userMapping.UserName)
-		if err := authBackend.MapUser(userMapping); err != nil {
-			return fmt.Errorf("mapping iam user: %w", err)
+		for _, userMapping := range iamCfg.UserMappings {
+			s.scope.Debug("Mapping IAM user", "iam-user", userMapping.UserARN, "user", userMapping.UserName)
+			if err := authBackend.MapUser(userMapping); err != nil {
+				return fmt.Errorf("mapping iam user: %w", err)
+			}
 		}
 	}
 
@@ -92,6 +120,20 @@ func (s *Service) ReconcileIAMAuthenticator(ctx context.Context) error {
 	return nil
 }
 
+// markDriftCorrected reports that mappings changed outside of CAPA were restored to the declared ones.
+// The IAMAuthenticatorConfigured condition keeps reporting the last correction until the next failure.
+func (s *Service) markDriftCorrected(arns []string) {
+	s.scope.Info("Corrected drifted aws-iam-authenticator mappings", "arns", arns)
+	record.Warnf(s.scope.InfraCluster(), "IAMAuthenticatorDriftCorrected", "Restored drifted aws-iam-authenticator mappings for %s", strings.Join(arns, ", "))
+	conditions.Set(s.scope.InfraCluster(), &clusterv1.Condition{
+		Type:     ekscontrolplanev1.IAMAuthenticatorConfiguredCondition,
+		Status:   corev1.ConditionTrue,
+		Severity: clusterv1.ConditionSeverityNone,
+		Reason:   ekscontrolplanev1.IAMAuthenticatorDriftCorrectedReason,
+		Message:  fmt.Sprintf("Restored drifted mappings for %s", strings.Join(arns, ", ")),
+	})
+}
+
 func (s *Service) getARNForRole(role string) (string, error) {
 	input := &iam.GetRoleInput{
 		RoleName: aws.String(role),
@@ -36,7 +36,7 @@ func setupCluster(clusterName string) (*scope.ClusterScope, error) {
 	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).Build()
 	return scope.NewClusterScope(scope.ClusterScopeParams{
 		Cluster: &clusterv1.Cluster{
-			ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+			ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: "default"},
 		},
 		AWSCluster: awsCluster,
 		Client:     client,
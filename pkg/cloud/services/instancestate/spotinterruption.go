@@ -0,0 +1,340 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/hash"
+)
+
+const (
+	// Ec2SpotInstanceInterruptionWarning defines the EC2 spot instance interruption warning, sent two minutes before
+	// a spot instance is interrupted.
+	Ec2SpotInstanceInterruptionWarning = "EC2 Spot Instance Interruption Warning"
+
+	// Ec2InstanceRebalanceRecommendation defines the EC2 rebalance recommendation, sent when a spot instance is at an
+	// elevated risk of interruption.
+	Ec2InstanceRebalanceRecommendation = "EC2 Instance Rebalance Recommendation"
+
+	// AutoScalingInstanceTerminateLifecycleAction defines the lifecycle action sent by an ASG when one of its
+	// instances enters the terminating state of a lifecycle hook.
+	AutoScalingInstanceTerminateLifecycleAction = "EC2 Instance-terminate Lifecycle Action"
+
+	// spotInterruptionResourcePrefixMaxLength is the maximum length of the prefix of the names of the spot
+	// interruption queue and rules, so the longest rule name fits the 64 characters allowed by EventBridge.
+	spotInterruptionResourcePrefixMaxLength = 64 - len("-spot-interruption-rule")
+
+	// spotInterruptionQueueRetentionPeriod is the number of seconds the interruption notices are kept in the queue,
+	// they are useless once the instances are gone.
+	spotInterruptionQueueRetentionPeriod = "300"
+)
+
+// spotInterruptionRule is an EventBridge rule sending the interruption notices of instances to an SQS queue.
+type spotInterruptionRule struct {
+	name         string
+	eventPattern spotInterruptionEventPattern
+}
+
+type spotInterruptionEventPattern struct {
+	Source     []string                     `json:"source"`
+	DetailType []string                     `json:"detail-type"`
+	Detail     *spotInterruptionEventDetail `json:"detail,omitempty"`
+}
+
+type spotInterruptionEventDetail struct {
+	AutoScalingGroupName []string `json:"AutoScalingGroupName,omitempty"`
+}
+
+// ReconcileSpotInterruptionHandling creates the SQS queue of the machine pool with the given name, and the EventBridge
+// rules sending the spot interruption warnings, the rebalance recommendations and the termination lifecycle actions of
+// its ASG to the queue. The rules, their targets and the policy of the queue are only updated when they differ from
+// the desired ones. It returns the URL of the queue.
+func (s Service) ReconcileSpotInterruptionHandling(name, asgName string) (string, error) {
+	prefix, err := s.spotInterruptionResourcePrefix(name)
+	if err != nil {
+		return "", err
+	}
+	queueName := spotInterruptionQueueName(prefix)
+	queueURL, err := s.reconcileSpotInterruptionQueue(queueName)
+	if err != nil {
+		return "", err
+	}
+
+	queueAttrs, err := s.SQSClient.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameQueueArn, sqs.QueueAttributeNamePolicy}),
+		QueueUrl:       aws.String(queueURL),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to get attributes of queue %s", queueName)
+	}
+	queueArn := aws.StringValue(queueAttrs.Attributes[sqs.QueueAttributeNameQueueArn])
+
+	ruleArns := []string{}
+	for _, rule := range spotInterruptionRules(prefix, asgName) {
+		ruleArn, err := s.reconcileSpotInterruptionRule(rule, queueName, queueArn)
+		if err != nil {
+			return "", err
+		}
+		ruleArns = append(ruleArns, ruleArn)
+	}
+
+	// Allow the rules to send messages to the queue.
+	policy := iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		ID:      queueArn,
+		Statement: iamv1.Statements{
+			iamv1.StatementEntry{
+				Sid:       fmt.Sprintf("CAPAEvents_%s", queueName),
+				Effect:    iamv1.EffectAllow,
+				Principal: iamv1.Principals{iamv1.PrincipalService: iamv1.PrincipalID{"events.amazonaws.com"}},
+				Action:    iamv1.Actions{"sqs:SendMessage"},
+				Resource:  iamv1.Resources{queueArn},
+				Condition: iamv1.Conditions{
+					"ArnEquals": map[string][]string{"aws:SourceArn": ruleArns},
+				},
+			},
+		},
+	}
+	policyData, err := json.Marshal(policy)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to JSON marshal policy")
+	}
+	if policyDocumentsEqual(aws.StringValue(queueAttrs.Attributes[sqs.QueueAttributeNamePolicy]), string(policyData)) {
+		return queueURL, nil
+	}
+	if _, err := s.SQSClient.SetQueueAttributes(&sqs.SetQueueAttributesInput{
+		QueueUrl:   aws.String(queueURL),
+		Attributes: aws.StringMap(map[string]string{sqs.QueueAttributeNamePolicy: string(policyData)}),
+	}); err != nil {
+		return "", errors.Wrapf(err, "unable to update attributes of queue %s", queueName)
+	}
+
+	return queueURL, nil
+}
+
+// DeleteSpotInterruptionHandling deletes the EventBridge rules and the SQS queue of the machine pool with the given name.
+func (s Service) DeleteSpotInterruptionHandling(name string) error {
+	prefix, err := s.spotInterruptionResourcePrefix(name)
+	if err != nil {
+		return err
+	}
+	queueName := spotInterruptionQueueName(prefix)
+	for _, rule := range spotInterruptionRules(prefix, "") {
+		_, err := s.EventBridgeClient.RemoveTargets(&eventbridge.RemoveTargetsInput{
+			Rule: aws.String(rule.name),
+			Ids:  aws.StringSlice([]string{queueName}),
+		})
+		if err != nil && !resourceNotFoundError(err) {
+			return errors.Wrapf(err, "unable to remove target %s for rule %s", queueName, rule.name)
+		}
+		_, err = s.EventBridgeClient.DeleteRule(&eventbridge.DeleteRuleInput{
+			Name: aws.String(rule.name),
+		})
+		if err != nil && !resourceNotFoundError(err) {
+			return errors.Wrapf(err, "unable to delete rule %s", rule.name)
+		}
+	}
+
+	resp, err := s.SQSClient.GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String(queueName)})
+	if err != nil {
+		if queueNotFoundError(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to get URL of queue %s", queueName)
+	}
+	_, err = s.SQSClient.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: resp.QueueUrl})
+	if err != nil && !queueNotFoundError(err) {
+		return errors.Wrapf(err, "unable to delete queue %s", queueName)
+	}
+
+	return nil
+}
+
+// reconcileSpotInterruptionQueue creates the queue with the given name if it doesn't exist, and returns its URL.
+func (s Service) reconcileSpotInterruptionQueue(queueName string) (string, error) {
+	out, err := s.SQSClient.CreateQueue(&sqs.CreateQueueInput{
+		QueueName: aws.String(queueName),
+		Attributes: aws.StringMap(map[string]string{
+			sqs.QueueAttributeNameMessageRetentionPeriod: spotInterruptionQueueRetentionPeriod,
+		}),
+	})
+	if err == nil {
+		return aws.StringValue(out.QueueUrl), nil
+	}
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != sqs.ErrCodeQueueNameExists {
+		return "", errors.Wrapf(err, "unable to create queue %s", queueName)
+	}
+
+	// The queue exists with different attributes, which are left alone.
+	resp, err := s.SQSClient.GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String(queueName)})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to get URL of queue %s", queueName)
+	}
+	return aws.StringValue(resp.QueueUrl), nil
+}
+
+// reconcileSpotInterruptionRule creates or updates an EventBridge rule and makes the queue its target, unless they
+// already match. It returns the ARN of the rule.
+func (s Service) reconcileSpotInterruptionRule(rule spotInterruptionRule, queueName, queueArn string) (string, error) {
+	data, err := json.Marshal(rule.eventPattern)
+	if err != nil {
+		return "", err
+	}
+
+	ruleResp, err := s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String(rule.name),
+	})
+	if err != nil && !resourceNotFoundError(err) {
+		return "", errors.Wrapf(err, "unable to describe rule %s", rule.name)
+	}
+
+	var ruleArn string
+	if err == nil && eventPatternEqual(aws.StringValue(ruleResp.EventPattern), rule.eventPattern) && aws.StringValue(ruleResp.State) == eventbridge.RuleStateEnabled {
+		ruleArn = aws.StringValue(ruleResp.Arn)
+	} else {
+		out, err := s.EventBridgeClient.PutRule(&eventbridge.PutRuleInput{
+			Name:         aws.String(rule.name),
+			EventPattern: aws.String(string(data)),
+			State:        aws.String(eventbridge.RuleStateEnabled),
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to put rule %s", rule.name)
+		}
+		ruleArn = aws.StringValue(out.RuleArn)
+	}
+
+	targetsResp, err := s.EventBridgeClient.ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{
+		Rule: aws.String(rule.name),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to list targets for rule %s", rule.name)
+	}
+	for _, target := range targetsResp.Targets {
+		if aws.StringValue(target.Id) == queueName && aws.StringValue(target.Arn) == queueArn {
+			return ruleArn, nil
+		}
+	}
+
+	targets, err := s.EventBridgeClient.PutTargets(&eventbridge.PutTargetsInput{
+		Rule: aws.String(rule.name),
+		Targets: []*eventbridge.Target{{
+			Arn: aws.String(queueArn),
+			Id:  aws.String(queueName),
+		}},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to add SQS target %s to rule %s", queueName, rule.name)
+	}
+	if aws.Int64Value(targets.FailedEntryCount) > 0 {
+		return "", errors.Errorf("unable to add SQS target %s to rule %s: %s", queueName, rule.name, aws.StringValue(targets.FailedEntries[0].ErrorMessage))
+	}
+
+	return ruleArn, nil
+}
+
+// policyDocumentsEqual returns whether two JSON policy documents are equivalent, regardless of their formatting.
+func policyDocumentsEqual(a, b string) bool {
+	var docA, docB iamv1.PolicyDocument
+	if json.Unmarshal([]byte(a), &docA) != nil || json.Unmarshal([]byte(b), &docB) != nil {
+		return false
+	}
+	return cmp.Equal(docA, docB)
+}
+
+// eventPatternEqual returns whether the JSON event pattern of a rule is equivalent to the given one.
+func eventPatternEqual(current string, desired spotInterruptionEventPattern) bool {
+	var pattern spotInterruptionEventPattern
+	if err := json.Unmarshal([]byte(current), &pattern); err != nil {
+		return false
+	}
+	return cmp.Equal(pattern, desired)
+}
+
+// spotInterruptionResourcePrefix returns the prefix of the names of the queue and the rules of the machine pool with
+// the given name. As they are regional resources, machine pools of different clusters with the same name would share
+// them, so the prefix includes the namespace and the name of the cluster.
+func (s Service) spotInterruptionResourcePrefix(name string) (string, error) {
+	return GenerateSpotInterruptionResourcePrefix(s.scope.Namespace(), s.scope.Name(), name)
+}
+
+// spotInterruptionRules returns the EventBridge rules of the machine pool with the given resource prefix. The spot
+// interruption warnings and rebalance recommendations can't be filtered by ASG, the node termination handler ignores
+// the notices of instances that aren't part of its cluster.
+func spotInterruptionRules(prefix, asgName string) []spotInterruptionRule {
+	return []spotInterruptionRule{
+		{
+			name: fmt.Sprintf("%s-spot-interruption-rule", prefix),
+			eventPattern: spotInterruptionEventPattern{
+				Source:     []string{"aws.ec2"},
+				DetailType: []string{Ec2SpotInstanceInterruptionWarning},
+			},
+		},
+		{
+			name: fmt.Sprintf("%s-rebalance-rule", prefix),
+			eventPattern: spotInterruptionEventPattern{
+				Source:     []string{"aws.ec2"},
+				DetailType: []string{Ec2InstanceRebalanceRecommendation},
+			},
+		},
+		{
+			name: fmt.Sprintf("%s-termination-rule", prefix),
+			eventPattern: spotInterruptionEventPattern{
+				Source:     []string{"aws.autoscaling"},
+				DetailType: []string{AutoScalingInstanceTerminateLifecycleAction},
+				Detail: &spotInterruptionEventDetail{
+					AutoScalingGroupName: []string{asgName},
+				},
+			},
+		},
+	}
+}
+
+// GenerateSpotInterruptionResourcePrefix generates the prefix of the names of the spot interruption queue and rules
+// of a machine pool, by concatenating the namespace and the name of its cluster with its name, or by computing a hash
+// when the names of the rules would exceed the 64 characters allowed by EventBridge.
+//
+// WARNING If this function's output is changed, a controller using the new function will leak the queue and the
+// rules of existing machine pools whose names were generated using the old function.
+func GenerateSpotInterruptionResourcePrefix(namespace, clusterName, name string) (string, error) {
+	prefix := strings.ReplaceAll(fmt.Sprintf("%s-%s-%s", namespace, clusterName, name), ".", "-")
+	if len(prefix) <= spotInterruptionResourcePrefixMaxLength {
+		return prefix, nil
+	}
+
+	shortName, err := hash.Base36TruncatedHash(prefix, spotInterruptionResourcePrefixMaxLength)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to create spot interruption resource prefix")
+	}
+	return shortName, nil
+}
+
+// spotInterruptionQueueName returns the name of the spot interruption queue of the machine pool with the given
+// resource prefix.
+func spotInterruptionQueueName(prefix string) string {
+	return fmt.Sprintf("%s-spot-queue", prefix)
+}
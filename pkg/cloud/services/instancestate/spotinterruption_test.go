@@ -0,0 +1,321 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate/mock_eventbridgeiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate/mock_sqsiface"
+)
+
+func TestReconcileSpotInterruptionHandling(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ruleNames := []string{
+		"default-test-cluster-pool-spot-interruption-rule",
+		"default-test-cluster-pool-rebalance-rule",
+		"default-test-cluster-pool-termination-rule",
+	}
+	rules := spotInterruptionRules("default-test-cluster-pool", "pool")
+	policy := `{"Version":"2012-10-17","Statement":[{"Sid":"CAPAEvents_default-test-cluster-pool-spot-queue",` +
+		`"Principal":{"Service":["events.amazonaws.com"]},"Effect":"Allow","Action":["sqs:SendMessage"],"Resource":["queue-arn"],` +
+		`"Condition":{"ArnEquals":{"aws:SourceArn":["` + ruleNames[0] + `-arn","` + ruleNames[1] + `-arn","` + ruleNames[2] + `-arn"]}}}],"Id":"queue-arn"}`
+
+	testCases := []struct {
+		name              string
+		sqsExpect         func(m *mock_sqsiface.MockSQSAPIMockRecorder)
+		eventBridgeExpect func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder)
+		expectErr         bool
+	}{
+		{
+			name: "creates the queue and routes the rules to it",
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.CreateQueue(&sqs.CreateQueueInput{
+					QueueName:  aws.String("default-test-cluster-pool-spot-queue"),
+					Attributes: aws.StringMap(map[string]string{sqs.QueueAttributeNameMessageRetentionPeriod: "300"}),
+				}).Return(&sqs.CreateQueueOutput{QueueUrl: aws.String("queue-url")}, nil)
+				m.GetQueueAttributes(gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: aws.StringMap(map[string]string{sqs.QueueAttributeNameQueueArn: "queue-arn"}),
+				}, nil)
+				m.SetQueueAttributes(&sqs.SetQueueAttributesInput{
+					QueueUrl:   aws.String("queue-url"),
+					Attributes: aws.StringMap(map[string]string{sqs.QueueAttributeNamePolicy: policy}),
+				}).Return(nil, nil)
+			},
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				for _, ruleName := range ruleNames {
+					m.DescribeRule(&eventbridge.DescribeRuleInput{Name: aws.String(ruleName)}).
+						Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil))
+					m.PutRule(gomock.Any()).Return(&eventbridge.PutRuleOutput{RuleArn: aws.String(ruleName + "-arn")}, nil)
+					m.ListTargetsByRule(gomock.Any()).Return(&eventbridge.ListTargetsByRuleOutput{}, nil)
+					m.PutTargets(&eventbridge.PutTargetsInput{
+						Rule: aws.String(ruleName),
+						Targets: []*eventbridge.Target{{
+							Arn: aws.String("queue-arn"),
+							Id:  aws.String("default-test-cluster-pool-spot-queue"),
+						}},
+					}).Return(&eventbridge.PutTargetsOutput{}, nil)
+				}
+			},
+			expectErr: false,
+		},
+		{
+			name: "doesn't update the rules, their targets and the policy of the queue when they are up to date",
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.CreateQueue(gomock.Any()).Return(nil, awserr.New(sqs.ErrCodeQueueNameExists, "", nil))
+				m.GetQueueUrl(&sqs.GetQueueUrlInput{
+					QueueName: aws.String("default-test-cluster-pool-spot-queue"),
+				}).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("queue-url")}, nil)
+				m.GetQueueAttributes(gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: aws.StringMap(map[string]string{
+						sqs.QueueAttributeNameQueueArn: "queue-arn",
+						sqs.QueueAttributeNamePolicy:   policy,
+					}),
+				}, nil)
+			},
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				for i, ruleName := range ruleNames {
+					eventPattern, _ := json.Marshal(rules[i].eventPattern)
+					m.DescribeRule(&eventbridge.DescribeRuleInput{Name: aws.String(ruleName)}).Return(&eventbridge.DescribeRuleOutput{
+						Arn:          aws.String(ruleName + "-arn"),
+						EventPattern: aws.String(string(eventPattern)),
+						State:        aws.String(eventbridge.RuleStateEnabled),
+					}, nil)
+					m.ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{Rule: aws.String(ruleName)}).Return(&eventbridge.ListTargetsByRuleOutput{
+						Targets: []*eventbridge.Target{{
+							Arn: aws.String("queue-arn"),
+							Id:  aws.String("default-test-cluster-pool-spot-queue"),
+						}},
+					}, nil)
+				}
+			},
+			expectErr: false,
+		},
+		{
+			name: "updates a disabled rule",
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.CreateQueue(gomock.Any()).Return(&sqs.CreateQueueOutput{QueueUrl: aws.String("queue-url")}, nil)
+				m.GetQueueAttributes(gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: aws.StringMap(map[string]string{
+						sqs.QueueAttributeNameQueueArn: "queue-arn",
+						sqs.QueueAttributeNamePolicy:   policy,
+					}),
+				}, nil)
+			},
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				for i, ruleName := range ruleNames {
+					eventPattern, _ := json.Marshal(rules[i].eventPattern)
+					state := eventbridge.RuleStateEnabled
+					if i == 0 {
+						state = eventbridge.RuleStateDisabled
+						m.PutRule(&eventbridge.PutRuleInput{
+							Name:         aws.String(ruleName),
+							EventPattern: aws.String(string(eventPattern)),
+							State:        aws.String(eventbridge.RuleStateEnabled),
+						}).Return(&eventbridge.PutRuleOutput{RuleArn: aws.String(ruleName + "-arn")}, nil)
+					}
+					m.DescribeRule(&eventbridge.DescribeRuleInput{Name: aws.String(ruleName)}).Return(&eventbridge.DescribeRuleOutput{
+						Arn:          aws.String(ruleName + "-arn"),
+						EventPattern: aws.String(string(eventPattern)),
+						State:        aws.String(state),
+					}, nil)
+					m.ListTargetsByRule(gomock.Any()).Return(&eventbridge.ListTargetsByRuleOutput{
+						Targets: []*eventbridge.Target{{
+							Arn: aws.String("queue-arn"),
+							Id:  aws.String("default-test-cluster-pool-spot-queue"),
+						}},
+					}, nil)
+				}
+			},
+			expectErr: false,
+		},
+		{
+			name: "errors when the queue can't be added as a target",
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.CreateQueue(gomock.Any()).Return(&sqs.CreateQueueOutput{QueueUrl: aws.String("queue-url")}, nil)
+				m.GetQueueAttributes(gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: aws.StringMap(map[string]string{sqs.QueueAttributeNameQueueArn: "queue-arn"}),
+				}, nil)
+			},
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.DescribeRule(gomock.Any()).Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil))
+				m.PutRule(gomock.Any()).Return(&eventbridge.PutRuleOutput{RuleArn: aws.String("rule-arn")}, nil)
+				m.ListTargetsByRule(gomock.Any()).Return(&eventbridge.ListTargetsByRuleOutput{}, nil)
+				m.PutTargets(gomock.Any()).Return(&eventbridge.PutTargetsOutput{
+					FailedEntryCount: aws.Int64(1),
+					FailedEntries:    []*eventbridge.PutTargetsResultEntry{{ErrorMessage: aws.String("some error")}},
+				}, nil)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			sqsMock := mock_sqsiface.NewMockSQSAPI(mockCtrl)
+			eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+			clusterScope, err := setupCluster("test-cluster")
+			g.Expect(err).To(Not(HaveOccurred()))
+
+			tc.sqsExpect(sqsMock.EXPECT())
+			tc.eventBridgeExpect(eventBridgeMock.EXPECT())
+			s := NewService(clusterScope)
+			s.SQSClient = sqsMock
+			s.EventBridgeClient = eventBridgeMock
+
+			queueURL, err := s.ReconcileSpotInterruptionHandling("pool", "pool")
+
+			if tc.expectErr {
+				g.Expect(err).NotTo(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(queueURL).To(Equal("queue-url"))
+			}
+		})
+	}
+}
+
+func TestDeleteSpotInterruptionHandling(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name              string
+		sqsExpect         func(m *mock_sqsiface.MockSQSAPIMockRecorder)
+		eventBridgeExpect func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder)
+		expectErr         bool
+	}{
+		{
+			name: "deletes the rules and the queue",
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(&sqs.GetQueueUrlInput{
+					QueueName: aws.String("default-test-cluster-pool-spot-queue"),
+				}).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("queue-url")}, nil)
+				m.DeleteQueue(&sqs.DeleteQueueInput{
+					QueueUrl: aws.String("queue-url"),
+				}).Return(nil, nil)
+			},
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				for _, ruleName := range []string{
+					"default-test-cluster-pool-spot-interruption-rule",
+					"default-test-cluster-pool-rebalance-rule",
+					"default-test-cluster-pool-termination-rule",
+				} {
+					m.RemoveTargets(&eventbridge.RemoveTargetsInput{
+						Rule: aws.String(ruleName),
+						Ids:  aws.StringSlice([]string{"default-test-cluster-pool-spot-queue"}),
+					}).Return(nil, nil)
+					m.DeleteRule(&eventbridge.DeleteRuleInput{
+						Name: aws.String(ruleName),
+					}).Return(nil, nil)
+				}
+			},
+			expectErr: false,
+		},
+		{
+			name: "doesn't return an error if the rules and the queue are already gone",
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.Any()).Return(nil, awserr.New(sqs.ErrCodeQueueDoesNotExist, "", nil))
+			},
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.RemoveTargets(gomock.Any()).Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil)).Times(3)
+				m.DeleteRule(gomock.Any()).Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil)).Times(3)
+			},
+			expectErr: false,
+		},
+		{
+			name:      "returns an error if a rule can't be deleted",
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {},
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.RemoveTargets(gomock.Any()).Return(nil, nil)
+				m.DeleteRule(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			sqsMock := mock_sqsiface.NewMockSQSAPI(mockCtrl)
+			eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+			clusterScope, err := setupCluster("test-cluster")
+			g.Expect(err).To(Not(HaveOccurred()))
+
+			tc.sqsExpect(sqsMock.EXPECT())
+			tc.eventBridgeExpect(eventBridgeMock.EXPECT())
+			s := NewService(clusterScope)
+			s.SQSClient = sqsMock
+			s.EventBridgeClient = eventBridgeMock
+
+			err = s.DeleteSpotInterruptionHandling("pool")
+
+			if tc.expectErr {
+				g.Expect(err).NotTo(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestGenerateSpotInterruptionResourcePrefix(t *testing.T) {
+	testCases := []struct {
+		name        string
+		namespace   string
+		clusterName string
+		poolName    string
+		expected    string
+	}{
+		{
+			name:        "concatenates the namespace, the cluster name and the pool name",
+			namespace:   "default",
+			clusterName: "my.cluster",
+			poolName:    "pool-0",
+			expected:    "default-my-cluster-pool-0",
+		},
+		{
+			name:        "hashes long names",
+			namespace:   "a-namespace-with-a-long-name",
+			clusterName: "a-cluster-with-a-long-name",
+			poolName:    "pool-0",
+			expected:    "wi6xh201o29ay8lxtxz3hgb2z9dn1oboinf29vx3y",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			prefix, err := GenerateSpotInterruptionResourcePrefix(tc.namespace, tc.clusterName, tc.poolName)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(prefix).To(Equal(tc.expected))
+			g.Expect(len(spotInterruptionRules(prefix, "")[0].name)).To(BeNumerically("<=", 64))
+		})
+	}
+}
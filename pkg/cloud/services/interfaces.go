@@ -41,9 +41,13 @@ type ASGInterface interface {
 	StartASGInstanceRefresh(scope *scope.MachinePoolScope) error
 	CanStartASGInstanceRefresh(scope *scope.MachinePoolScope) (bool, error)
 	UpdateResourceTags(resourceID *string, create, remove map[string]string) error
+	UpdateASGTags(name string, create []expinfrav1.ASGTag, remove map[string]string) error
 	DeleteASGAndWait(id string) error
 	SuspendProcesses(name string, processes []string) error
 	ResumeProcesses(name string, processes []string) error
+	ReconcileTerminationLifecycleHook(asgName, hookName string, heartbeatTimeout int64) error
+	DeleteLifecycleHook(asgName, hookName string) error
+	TerminateInstanceAndDecrementCapacity(instanceID string) error
 	SubnetIDs(scope *scope.MachinePoolScope) ([]string, error)
 }
 
@@ -61,6 +65,12 @@ type EC2Interface interface {
 	UpdateInstanceSecurityGroups(id string, securityGroups []string) error
 	UpdateResourceTags(resourceID *string, create, remove map[string]string) error
 	ModifyInstanceMetadataOptions(instanceID string, options *infrav1.InstanceMetadataOptions) error
+	ModifyInstanceVolumes(instanceID string, rootVolume *infrav1.Volume, nonRootVolumes []infrav1.Volume) (bool, error)
+	DisableInstanceDeletionProtection(instanceID string) error
+	ModifyInstanceSourceDestCheck(instanceID string, enabled bool) error
+	ValidateGPUInstanceType(instanceType string) error
+	GetConsoleDiagnostics(instanceID string) (output []byte, screenshot []byte, err error)
+	GetSpotPlacementScores(instanceTypes []string, targetCapacity int32) (map[string]int64, error)
 
 	TerminateInstanceAndWait(instanceID string) error
 	DetachSecurityGroupsFromNetworkInterface(groups []string, interfaceID string) error
@@ -96,10 +106,13 @@ type ELBInterface interface {
 	ReconcileLoadbalancers() error
 	IsInstanceRegisteredWithAPIServerELB(i *infrav1.Instance) (bool, error)
 	IsInstanceRegisteredWithAPIServerLB(i *infrav1.Instance) ([]string, bool, error)
+	IsInstanceDrainingFromAPIServerLB(i *infrav1.Instance) (bool, error)
 	DeregisterInstanceFromAPIServerELB(i *infrav1.Instance) error
 	DeregisterInstanceFromAPIServerLB(targetGroupArn string, i *infrav1.Instance) error
 	RegisterInstanceWithAPIServerELB(i *infrav1.Instance) error
 	RegisterInstanceWithAPIServerLB(i *infrav1.Instance) error
+	RegisterInstanceWithGlobalAccelerator(i *infrav1.Instance) error
+	DeregisterInstanceFromGlobalAccelerator(i *infrav1.Instance) error
 }
 
 // NetworkInterface encapsulates the methods exposed to the cluster
@@ -122,4 +135,5 @@ type ObjectStoreInterface interface {
 	ReconcileBucket() error
 	Delete(m *scope.MachineScope) error
 	Create(m *scope.MachineScope, data []byte) (objectURL string, err error)
+	CreateBootDiagnostics(m *scope.MachineScope, files map[string][]byte) (location string, err error)
 }
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kms provides a service to manage the KMS grants of a cluster.
+package kms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// autoScalingServiceLinkedRole is the path and name of the service-linked role Auto Scaling uses to launch
+// instances, which needs a grant to use a customer managed key of another account for EBS encryption.
+const autoScalingServiceLinkedRole = "role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling"
+
+// ebsEncryptionGrantOperations are the operations needed to launch instances with EBS volumes encrypted with the key.
+var ebsEncryptionGrantOperations = []string{
+	kms.GrantOperationEncrypt,
+	kms.GrantOperationDecrypt,
+	kms.GrantOperationReEncryptFrom,
+	kms.GrantOperationReEncryptTo,
+	kms.GrantOperationGenerateDataKey,
+	kms.GrantOperationGenerateDataKeyWithoutPlaintext,
+	kms.GrantOperationDescribeKey,
+	kms.GrantOperationCreateGrant,
+}
+
+// Service holds a collection of interfaces.
+// The interfaces are broken down like this to group functions together.
+// One alternative is to have a large list of functions from the ec2 client.
+type Service struct {
+	scope                 scope.KMSScope
+	KMSClient             kmsiface.KMSAPI
+	STSClient             stsiface.STSAPI
+	ResourceTaggingClient resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+}
+
+// NewService returns a new service given the api clients.
+func NewService(kmsScope scope.KMSScope) *Service {
+	return &Service{
+		scope:                 kmsScope,
+		KMSClient:             scope.NewKMSClient(kmsScope, kmsScope, kmsScope, kmsScope.InfraCluster()),
+		STSClient:             scope.NewSTSClient(kmsScope, kmsScope, kmsScope, kmsScope.InfraCluster()),
+		ResourceTaggingClient: scope.NewResourgeTaggingClient(kmsScope, kmsScope, kmsScope, kmsScope.InfraCluster()),
+	}
+}
+
+// ReconcileEBSEncryptionKeyGrant ensures the identities launching the instances of the cluster have grants to use the
+// cross-account EBS encryption key of the cluster: the Auto Scaling service-linked role of the cluster account for the
+// instances of Auto Scaling groups, and the controller identity for the instances it launches itself. No grant is
+// needed for keys of the cluster account. Recorded grants are checked again on every reconcile, so grants revoked, or
+// no longer allowed by the key policy, are detected.
+func (s *Service) ReconcileEBSEncryptionKeyGrant() error {
+	keyARN := s.scope.EBSEncryptionKeyARN()
+	if keyARN == "" {
+		return nil
+	}
+
+	key, err := arn.Parse(keyARN)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse EBS encryption key ARN %q", keyARN)
+	}
+	identity, err := s.STSClient.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get account ID")
+	}
+	accountID := aws.StringValue(identity.Account)
+	if key.AccountID == accountID {
+		s.scope.Debug("EBS encryption key is owned by the cluster account, no grant needed", "key-arn", keyARN)
+		return nil
+	}
+
+	autoScalingGrantee := fmt.Sprintf("arn:%s:iam::%s:%s", key.Partition, accountID, autoScalingServiceLinkedRole)
+	grantID, err := s.reconcileGrant(keyARN, autoScalingGrantee, s.grantName(), s.scope.EBSEncryptionKeyGrantID())
+	if err != nil {
+		return err
+	}
+	s.scope.SetEBSEncryptionKeyGrantID(grantID)
+
+	ec2Grantee, err := granteePrincipal(aws.StringValue(identity.Arn))
+	if err != nil {
+		return err
+	}
+	grantID, err = s.reconcileGrant(keyARN, ec2Grantee, s.grantName()+"_ec2", s.scope.EBSEncryptionKeyEC2GrantID())
+	if err != nil {
+		return err
+	}
+	s.scope.SetEBSEncryptionKeyEC2GrantID(grantID)
+
+	return nil
+}
+
+// reconcileGrant returns the ID of the grant of the key for the grantee, creating the grant if the recorded grant
+// doesn't exist anymore.
+func (s *Service) reconcileGrant(keyARN, grantee, name, grantID string) (string, error) {
+	if grantID != "" {
+		// Listing the grants of the key also fails when the key policy doesn't allow the controller anymore.
+		out, err := s.KMSClient.ListGrants(&kms.ListGrantsInput{
+			KeyId:   aws.String(keyARN),
+			GrantId: aws.String(grantID),
+		})
+		if code, _ := awserrors.Code(err); err != nil && code != kms.ErrCodeNotFoundException {
+			return "", errors.Wrapf(err, "failed to describe grant %q of EBS encryption key %q", grantID, keyARN)
+		}
+		if err == nil && len(out.Grants) > 0 {
+			return grantID, nil
+		}
+		s.scope.Info("Grant for EBS encryption key not found, creating it again", "key-arn", keyARN, "grant-id", grantID)
+	}
+
+	s.scope.Debug("Creating grant for EBS encryption key", "key-arn", keyARN, "grantee", grantee)
+	// Creating a grant with the same name and parameters returns the existing grant, so retries don't create duplicates.
+	out, err := s.KMSClient.CreateGrant(&kms.CreateGrantInput{
+		KeyId:            aws.String(keyARN),
+		GranteePrincipal: aws.String(grantee),
+		Operations:       aws.StringSlice(ebsEncryptionGrantOperations),
+		Name:             aws.String(name),
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateKMSGrant", "Failed to create grant for EBS encryption key %q: %v", keyARN, err)
+		return "", errors.Wrapf(err, "failed to create grant for EBS encryption key %q", keyARN)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateKMSGrant", "Created grant %q for EBS encryption key %q", aws.StringValue(out.GrantId), keyARN)
+
+	return aws.StringValue(out.GrantId), nil
+}
+
+// DeleteEBSEncryptionKeyGrant revokes the grants for the cross-account EBS encryption key of the cluster, if any.
+func (s *Service) DeleteEBSEncryptionKeyGrant() error {
+	if err := s.revokeGrant(s.scope.EBSEncryptionKeyGrantID()); err != nil {
+		return err
+	}
+	s.scope.SetEBSEncryptionKeyGrantID("")
+
+	if err := s.revokeGrant(s.scope.EBSEncryptionKeyEC2GrantID()); err != nil {
+		return err
+	}
+	s.scope.SetEBSEncryptionKeyEC2GrantID("")
+
+	return nil
+}
+
+// revokeGrant revokes the grant of the EBS encryption key, a grant which doesn't exist is ignored.
+func (s *Service) revokeGrant(grantID string) error {
+	if grantID == "" {
+		return nil
+	}
+	keyARN := s.scope.EBSEncryptionKeyARN()
+
+	s.scope.Debug("Revoking grant for EBS encryption key", "key-arn", keyARN, "grant-id", grantID)
+	if _, err := s.KMSClient.RevokeGrant(&kms.RevokeGrantInput{
+		KeyId:   aws.String(keyARN),
+		GrantId: aws.String(grantID),
+	}); err != nil {
+		if code, _ := awserrors.Code(err); code != kms.ErrCodeNotFoundException {
+			record.Warnf(s.scope.InfraCluster(), "FailedRevokeKMSGrant", "Failed to revoke grant %q for EBS encryption key %q: %v", grantID, keyARN, err)
+			return errors.Wrapf(err, "failed to revoke grant %q for EBS encryption key %q", grantID, keyARN)
+		}
+		return nil
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulRevokeKMSGrant", "Revoked grant %q for EBS encryption key %q", grantID, keyARN)
+
+	return nil
+}
+
+// IsPermissionDenied returns true if the error is caused by the controller not being allowed to use the key.
+func IsPermissionDenied(err error) bool {
+	code, _ := awserrors.Code(errors.Cause(err))
+	return code == "AccessDeniedException"
+}
+
+// grantName returns the name of the grant of the cluster. Grant names can't contain dots.
+func (s *Service) grantName() string {
+	return strings.ReplaceAll(fmt.Sprintf("%s_%s", s.scope.Namespace(), s.scope.InfraClusterName()), ".", "-")
+}
+
+// granteePrincipal returns the ARN of the IAM principal of the caller. Grants can't be given to assumed role sessions,
+// so the role of the session is returned for them.
+func granteePrincipal(callerARN string) (string, error) {
+	caller, err := arn.Parse(callerARN)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse caller ARN %q", callerARN)
+	}
+	if caller.Service != "sts" || !strings.HasPrefix(caller.Resource, "assumed-role/") {
+		return callerARN, nil
+	}
+	parts := strings.Split(caller.Resource, "/")
+	if len(parts) != 3 {
+		return "", errors.Errorf("unexpected assumed role ARN %q", callerARN)
+	}
+	return fmt.Sprintf("arn:%s:iam::%s:role/%s", caller.Partition, caller.AccountID, parts[1]), nil
+}
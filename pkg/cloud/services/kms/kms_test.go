@@ -0,0 +1,341 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms/mock_kmsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts/mock_stsiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	testClusterName   = "test-cluster"
+	testMemberAccount = "111111111111"
+	testCallerARN     = "arn:aws:sts::111111111111:assumed-role/controllers.cluster-api-provider-aws.sigs.k8s.io/1700000000000000000"
+	testKeyARN        = "arn:aws:kms:us-east-1:222222222222:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	testGrantID       = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	testEC2GrantID    = "fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+)
+
+func TestReconcileEBSEncryptionKeyGrant(t *testing.T) {
+	callerIdentity := func(m *mock_stsiface.MockSTSAPIMockRecorder) {
+		m.GetCallerIdentity(&sts.GetCallerIdentityInput{}).
+			Return(&sts.GetCallerIdentityOutput{Account: aws.String(testMemberAccount), Arn: aws.String(testCallerARN)}, nil)
+	}
+	autoScalingGrant := &kms.CreateGrantInput{
+		KeyId:            aws.String(testKeyARN),
+		GranteePrincipal: aws.String("arn:aws:iam::111111111111:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling"),
+		Operations:       aws.StringSlice(ebsEncryptionGrantOperations),
+		Name:             aws.String("default_test-cluster"),
+	}
+	ec2Grant := &kms.CreateGrantInput{
+		KeyId:            aws.String(testKeyARN),
+		GranteePrincipal: aws.String("arn:aws:iam::111111111111:role/controllers.cluster-api-provider-aws.sigs.k8s.io"),
+		Operations:       aws.StringSlice(ebsEncryptionGrantOperations),
+		Name:             aws.String("default_test-cluster_ec2"),
+	}
+	existingGrant := func(grantID string) *kms.ListGrantsResponse {
+		return &kms.ListGrantsResponse{Grants: []*kms.GrantListEntry{{GrantId: aws.String(grantID), KeyId: aws.String(testKeyARN)}}}
+	}
+
+	tests := []struct {
+		name           string
+		keyARN         string
+		grantID        string
+		ec2GrantID     string
+		expectKMS      func(m *mock_kmsiface.MockKMSAPIMockRecorder)
+		expectSTS      func(m *mock_stsiface.MockSTSAPIMockRecorder)
+		wantErr        bool
+		wantDenied     bool
+		wantGrantID    string
+		wantEC2GrantID string
+	}{
+		{
+			name:      "does nothing when no encryption key is configured",
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {},
+			expectSTS: func(m *mock_stsiface.MockSTSAPIMockRecorder) {},
+		},
+		{
+			name:      "creates grants for the Auto Scaling service-linked role and the controller role of the cluster account",
+			keyARN:    testKeyARN,
+			expectSTS: callerIdentity,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.CreateGrant(autoScalingGrant).Return(&kms.CreateGrantOutput{GrantId: aws.String(testGrantID)}, nil)
+				m.CreateGrant(ec2Grant).Return(&kms.CreateGrantOutput{GrantId: aws.String(testEC2GrantID)}, nil)
+			},
+			wantGrantID:    testGrantID,
+			wantEC2GrantID: testEC2GrantID,
+		},
+		{
+			name:       "checks the grants which already exist",
+			keyARN:     testKeyARN,
+			grantID:    testGrantID,
+			ec2GrantID: testEC2GrantID,
+			expectSTS:  callerIdentity,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.ListGrants(&kms.ListGrantsInput{KeyId: aws.String(testKeyARN), GrantId: aws.String(testGrantID)}).
+					Return(existingGrant(testGrantID), nil)
+				m.ListGrants(&kms.ListGrantsInput{KeyId: aws.String(testKeyARN), GrantId: aws.String(testEC2GrantID)}).
+					Return(existingGrant(testEC2GrantID), nil)
+			},
+			wantGrantID:    testGrantID,
+			wantEC2GrantID: testEC2GrantID,
+		},
+		{
+			name:       "creates a grant again when it was revoked",
+			keyARN:     testKeyARN,
+			grantID:    "revoked",
+			ec2GrantID: testEC2GrantID,
+			expectSTS:  callerIdentity,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.ListGrants(&kms.ListGrantsInput{KeyId: aws.String(testKeyARN), GrantId: aws.String("revoked")}).
+					Return(nil, awserr.New(kms.ErrCodeNotFoundException, "grant not found", nil))
+				m.CreateGrant(autoScalingGrant).Return(&kms.CreateGrantOutput{GrantId: aws.String(testGrantID)}, nil)
+				m.ListGrants(&kms.ListGrantsInput{KeyId: aws.String(testKeyARN), GrantId: aws.String(testEC2GrantID)}).
+					Return(existingGrant(testEC2GrantID), nil)
+			},
+			wantGrantID:    testGrantID,
+			wantEC2GrantID: testEC2GrantID,
+		},
+		{
+			name:       "returns a permission error when the key policy doesn't allow the controller anymore",
+			keyARN:     testKeyARN,
+			grantID:    testGrantID,
+			ec2GrantID: testEC2GrantID,
+			expectSTS:  callerIdentity,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.ListGrants(gomock.AssignableToTypeOf(&kms.ListGrantsInput{})).
+					Return(nil, awserr.New("AccessDeniedException", "not authorized to perform kms:ListGrants", nil))
+			},
+			wantErr:    true,
+			wantDenied: true,
+		},
+		{
+			name:   "does not create a grant for a key of the cluster account",
+			keyARN: "arn:aws:kms:us-east-1:111111111111:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			expectSTS: func(m *mock_stsiface.MockSTSAPIMockRecorder) {
+				m.GetCallerIdentity(&sts.GetCallerIdentityInput{}).
+					Return(&sts.GetCallerIdentityOutput{Account: aws.String(testMemberAccount), Arn: aws.String(testCallerARN)}, nil)
+			},
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {},
+		},
+		{
+			name:      "returns a permission error when the grant can't be created",
+			keyARN:    testKeyARN,
+			expectSTS: callerIdentity,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.CreateGrant(gomock.AssignableToTypeOf(&kms.CreateGrantInput{})).
+					Return(nil, awserr.New("AccessDeniedException", "not authorized to perform kms:CreateGrant", nil))
+			},
+			wantErr:    true,
+			wantDenied: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			kmsMock := mock_kmsiface.NewMockKMSAPI(mockCtrl)
+			stsMock := mock_stsiface.NewMockSTSAPI(mockCtrl)
+			tt.expectKMS(kmsMock.EXPECT())
+			tt.expectSTS(stsMock.EXPECT())
+
+			clusterScope := newClusterScope(t, tt.keyARN, tt.grantID, tt.ec2GrantID)
+			s := &Service{
+				scope:     clusterScope,
+				KMSClient: kmsMock,
+				STSClient: stsMock,
+			}
+
+			err := s.ReconcileEBSEncryptionKeyGrant()
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(IsPermissionDenied(err)).To(Equal(tt.wantDenied))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.EBSEncryptionKeyGrantID()).To(Equal(tt.wantGrantID))
+			g.Expect(clusterScope.EBSEncryptionKeyEC2GrantID()).To(Equal(tt.wantEC2GrantID))
+		})
+	}
+}
+
+func TestGranteePrincipal(t *testing.T) {
+	tests := []struct {
+		name      string
+		callerARN string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "returns the role of an assumed role session",
+			callerARN: testCallerARN,
+			want:      "arn:aws:iam::111111111111:role/controllers.cluster-api-provider-aws.sigs.k8s.io",
+		},
+		{
+			name:      "returns an IAM user as is",
+			callerARN: "arn:aws:iam::111111111111:user/bootstrapper",
+			want:      "arn:aws:iam::111111111111:user/bootstrapper",
+		},
+		{
+			name:      "fails on an invalid ARN",
+			callerARN: "controllers",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := granteePrincipal(tt.callerARN)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestDeleteEBSEncryptionKeyGrant(t *testing.T) {
+	tests := []struct {
+		name       string
+		grantID    string
+		ec2GrantID string
+		expectKMS  func(m *mock_kmsiface.MockKMSAPIMockRecorder)
+		wantErr    bool
+	}{
+		{
+			name:      "does nothing when there is no grant",
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {},
+		},
+		{
+			name:    "revokes the grant",
+			grantID: testGrantID,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.RevokeGrant(&kms.RevokeGrantInput{
+					KeyId:   aws.String(testKeyARN),
+					GrantId: aws.String(testGrantID),
+				}).Return(&kms.RevokeGrantOutput{}, nil)
+			},
+		},
+		{
+			name:       "revokes the grants of the Auto Scaling service-linked role and the controller role",
+			grantID:    testGrantID,
+			ec2GrantID: testEC2GrantID,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.RevokeGrant(&kms.RevokeGrantInput{
+					KeyId:   aws.String(testKeyARN),
+					GrantId: aws.String(testGrantID),
+				}).Return(&kms.RevokeGrantOutput{}, nil)
+				m.RevokeGrant(&kms.RevokeGrantInput{
+					KeyId:   aws.String(testKeyARN),
+					GrantId: aws.String(testEC2GrantID),
+				}).Return(&kms.RevokeGrantOutput{}, nil)
+			},
+		},
+		{
+			name:    "ignores grants which were already revoked",
+			grantID: testGrantID,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.RevokeGrant(gomock.AssignableToTypeOf(&kms.RevokeGrantInput{})).
+					Return(nil, awserr.New(kms.ErrCodeNotFoundException, "grant not found", nil))
+			},
+		},
+		{
+			name:    "returns an error when the grant can't be revoked",
+			grantID: testGrantID,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.RevokeGrant(gomock.AssignableToTypeOf(&kms.RevokeGrantInput{})).
+					Return(nil, awserr.New("AccessDeniedException", "not authorized to perform kms:RevokeGrant", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			kmsMock := mock_kmsiface.NewMockKMSAPI(mockCtrl)
+			tt.expectKMS(kmsMock.EXPECT())
+
+			clusterScope := newClusterScope(t, testKeyARN, tt.grantID, tt.ec2GrantID)
+			s := &Service{
+				scope:     clusterScope,
+				KMSClient: kmsMock,
+			}
+
+			err := s.DeleteEBSEncryptionKeyGrant()
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(clusterScope.EBSEncryptionKeyGrantID()).To(Equal(tt.grantID))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.EBSEncryptionKeyGrantID()).To(BeEmpty())
+			g.Expect(clusterScope.EBSEncryptionKeyEC2GrantID()).To(BeEmpty())
+		})
+	}
+}
+
+func newClusterScope(t *testing.T, keyARN, grantID, ec2GrantID string) *scope.ClusterScope {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: testClusterName, Namespace: "default"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: testClusterName, Namespace: "default"},
+			Spec: infrav1.AWSClusterSpec{
+				EBSEncryptionKeyARN: keyARN,
+			},
+			Status: infrav1.AWSClusterStatus{
+				EBSEncryptionKeyGrantID:    grantID,
+				EBSEncryptionKeyEC2GrantID: ec2GrantID,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
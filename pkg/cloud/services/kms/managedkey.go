@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	rgapi "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// managedKeyDeletionWindowDays is the waiting period before KMS deletes the managed key of a deleted cluster.
+// It is the minimum allowed, the deletion can be cancelled during this period to recover the key.
+const managedKeyDeletionWindowDays = 7
+
+// kmsKeyResourceType is the type of the KMS keys in the resource groups tagging API.
+const kmsKeyResourceType = "kms:key"
+
+// ReconcileManagedEncryptionKey ensures the KMS key dedicated to the cluster exists, with automatic rotation
+// enabled and its alias pointing at it.
+func (s *Service) ReconcileManagedEncryptionKey() error {
+	if !s.scope.ManagedEncryptionKey() {
+		return nil
+	}
+
+	aliasName := s.managedKeyAliasName()
+	keyARN := s.scope.ManagedEncryptionKeyARN()
+	if keyARN == "" {
+		// The key is only recorded in the status, so look for a key created by a previous reconciliation whose
+		// status couldn't be patched before creating a new one.
+		existingARN, err := s.findManagedKey()
+		if err != nil {
+			return err
+		}
+		if existingARN != "" {
+			s.scope.Debug("Found existing managed KMS key", "key-arn", existingARN)
+			keyARN = existingARN
+			s.scope.SetManagedEncryptionKeyARN(keyARN)
+		}
+	}
+	if keyARN == "" {
+		s.scope.Debug("Creating managed KMS key", "alias", aliasName)
+		out, err := s.KMSClient.CreateKey(&kms.CreateKeyInput{
+			Description: aws.String(fmt.Sprintf("EBS encryption key of cluster %s", s.scope.Name())),
+			KeySpec:     aws.String(kms.KeySpecSymmetricDefault),
+			KeyUsage:    aws.String(kms.KeyUsageTypeEncryptDecrypt),
+			Tags:        s.managedKeyTags(),
+		})
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateKMSKey", "Failed to create managed KMS key: %v", err)
+			return errors.Wrap(err, "failed to create managed KMS key")
+		}
+		keyARN = aws.StringValue(out.KeyMetadata.Arn)
+		s.scope.SetManagedEncryptionKeyARN(keyARN)
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateKMSKey", "Created managed KMS key %q", keyARN)
+	}
+
+	// The alias is created last, so an alias pointing at the key means the key is fully configured.
+	out, err := s.KMSClient.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(aliasName)})
+	switch {
+	case err == nil && aws.StringValue(out.KeyMetadata.Arn) == keyARN:
+		return nil
+	case err == nil:
+		return errors.Errorf("alias %q already points at KMS key %q", aliasName, aws.StringValue(out.KeyMetadata.Arn))
+	case !isNotFound(err):
+		return errors.Wrapf(err, "failed to describe KMS alias %q", aliasName)
+	}
+
+	if _, err := s.KMSClient.EnableKeyRotation(&kms.EnableKeyRotationInput{KeyId: aws.String(keyARN)}); err != nil {
+		return errors.Wrapf(err, "failed to enable rotation of managed KMS key %q", keyARN)
+	}
+	if _, err := s.KMSClient.CreateAlias(&kms.CreateAliasInput{
+		AliasName:   aws.String(aliasName),
+		TargetKeyId: aws.String(keyARN),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateKMSAlias", "Failed to create alias %q for managed KMS key %q: %v", aliasName, keyARN, err)
+		return errors.Wrapf(err, "failed to create alias %q for managed KMS key %q", aliasName, keyARN)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateKMSAlias", "Created alias %q for managed KMS key %q", aliasName, keyARN)
+
+	return nil
+}
+
+// DeleteManagedEncryptionKey deletes the alias of the KMS key created for the cluster, if it still points at the
+// key, and schedules the deletion of the key. KMS deletes the key at the end of the waiting period.
+func (s *Service) DeleteManagedEncryptionKey() error {
+	keyARN := s.scope.ManagedEncryptionKeyARN()
+	if keyARN == "" {
+		return nil
+	}
+
+	aliasName := s.managedKeyAliasName()
+	out, err := s.KMSClient.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(aliasName)})
+	switch {
+	case err == nil && aws.StringValue(out.KeyMetadata.Arn) == keyARN:
+		s.scope.Debug("Deleting alias of managed KMS key", "alias", aliasName, "key-arn", keyARN)
+		if _, err := s.KMSClient.DeleteAlias(&kms.DeleteAliasInput{AliasName: aws.String(aliasName)}); err != nil && !isNotFound(err) {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteKMSAlias", "Failed to delete alias %q of managed KMS key %q: %v", aliasName, keyARN, err)
+			return errors.Wrapf(err, "failed to delete alias %q of managed KMS key %q", aliasName, keyARN)
+		}
+	case err != nil && !isNotFound(err):
+		return errors.Wrapf(err, "failed to describe KMS alias %q", aliasName)
+	}
+
+	s.scope.Debug("Scheduling deletion of managed KMS key", "key-arn", keyARN)
+	if _, err := s.KMSClient.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{
+		KeyId:               aws.String(keyARN),
+		PendingWindowInDays: aws.Int64(managedKeyDeletionWindowDays),
+	}); err != nil {
+		// The key is in an invalid state when its deletion is already scheduled.
+		if code, _ := awserrors.Code(err); code != kms.ErrCodeNotFoundException && code != kms.ErrCodeInvalidStateException {
+			record.Warnf(s.scope.InfraCluster(), "FailedScheduleKMSKeyDeletion", "Failed to schedule deletion of managed KMS key %q: %v", keyARN, err)
+			return errors.Wrapf(err, "failed to schedule deletion of managed KMS key %q", keyARN)
+		}
+	} else {
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulScheduleKMSKeyDeletion", "Scheduled deletion of managed KMS key %q", keyARN)
+	}
+	s.scope.SetManagedEncryptionKeyARN("")
+
+	return nil
+}
+
+// findManagedKey returns the ARN of the enabled KMS key tagged as the managed key of the cluster, if any. Keys
+// pending deletion, e.g. the key of a deleted cluster with the same name, are ignored.
+func (s *Service) findManagedKey() (string, error) {
+	input := &rgapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{kmsKeyResourceType}),
+		TagFilters: []*rgapi.TagFilter{
+			{
+				Key:    aws.String(infrav1.ClusterTagKey(s.scope.Name())),
+				Values: aws.StringSlice([]string{string(infrav1.ResourceLifecycleOwned)}),
+			},
+			{
+				Key:    aws.String("Name"),
+				Values: aws.StringSlice([]string{s.managedKeyName()}),
+			},
+		},
+	}
+
+	var keyARNs []string
+	if err := s.ResourceTaggingClient.GetResourcesPages(input, func(out *rgapi.GetResourcesOutput, _ bool) bool {
+		for _, mapping := range out.ResourceTagMappingList {
+			keyARNs = append(keyARNs, aws.StringValue(mapping.ResourceARN))
+		}
+		return true
+	}); err != nil {
+		return "", errors.Wrap(err, "failed to look up managed KMS key")
+	}
+
+	for _, keyARN := range keyARNs {
+		out, err := s.KMSClient.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(keyARN)})
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return "", errors.Wrapf(err, "failed to describe KMS key %q", keyARN)
+		}
+		if aws.StringValue(out.KeyMetadata.KeyState) == kms.KeyStateEnabled {
+			return keyARN, nil
+		}
+	}
+
+	return "", nil
+}
+
+// managedKeyName returns the value of the Name tag of the managed key of the cluster.
+func (s *Service) managedKeyName() string {
+	return s.scope.Name() + "-ebs"
+}
+
+// managedKeyAliasName returns the alias of the managed key of the cluster. Alias names can't contain dots.
+func (s *Service) managedKeyAliasName() string {
+	return fmt.Sprintf("alias/%s-ebs", strings.ReplaceAll(s.scope.Name(), ".", "-"))
+}
+
+func (s *Service) managedKeyTags() []*kms.Tag {
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(s.managedKeyName()),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	})
+
+	kmsTags := make([]*kms.Tag, 0, len(tags))
+	for key, value := range tags {
+		kmsTags = append(kmsTags, &kms.Tag{TagKey: aws.String(key), TagValue: aws.String(value)})
+	}
+	sort.Slice(kmsTags, func(i, j int) bool {
+		return *kmsTags[i].TagKey < *kmsTags[j].TagKey
+	})
+	return kmsTags
+}
+
+func isNotFound(err error) bool {
+	code, _ := awserrors.Code(err)
+	return code == kms.ErrCodeNotFoundException
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kms"
+	rgapi "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms/mock_kmsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+const (
+	testManagedKeyARN      = "arn:aws:kms:us-east-1:111111111111:key/abcd1234-ab12-cd34-ef56-abcdef123456"
+	testOtherKeyARN        = "arn:aws:kms:us-east-1:111111111111:key/ffff1234-ab12-cd34-ef56-abcdef123456"
+	testManagedKeyAlias    = "alias/test-cluster-ebs"
+	testClusterOwnedTagKey = "sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"
+)
+
+// expectManagedKeyLookup expects the lookup of the managed key of the cluster by its tags, returning the given keys.
+func expectManagedKeyLookup(m *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder, keyARNs ...string) {
+	m.GetResourcesPages(&rgapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{kmsKeyResourceType}),
+		TagFilters: []*rgapi.TagFilter{
+			{Key: aws.String(testClusterOwnedTagKey), Values: aws.StringSlice([]string{"owned"})},
+			{Key: aws.String("Name"), Values: aws.StringSlice([]string{"test-cluster-ebs"})},
+		},
+	}, gomock.Any()).Do(func(_ *rgapi.GetResourcesInput, fn func(*rgapi.GetResourcesOutput, bool) bool) {
+		out := &rgapi.GetResourcesOutput{}
+		for _, keyARN := range keyARNs {
+			out.ResourceTagMappingList = append(out.ResourceTagMappingList, &rgapi.ResourceTagMapping{ResourceARN: aws.String(keyARN)})
+		}
+		fn(out, true)
+	}).Return(nil)
+}
+
+func TestReconcileManagedEncryptionKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		enabled   bool
+		keyARN    string
+		expectRG  func(m *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder)
+		expectKMS func(m *mock_kmsiface.MockKMSAPIMockRecorder)
+		wantErr   bool
+		wantARN   string
+	}{
+		{
+			name:      "does nothing when the managed key is disabled",
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {},
+		},
+		{
+			name:    "creates the key, enables its rotation and creates its alias",
+			enabled: true,
+			expectRG: func(m *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder) {
+				expectManagedKeyLookup(m)
+			},
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.CreateKey(gomock.AssignableToTypeOf(&kms.CreateKeyInput{})).
+					DoAndReturn(func(input *kms.CreateKeyInput) (*kms.CreateKeyOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValue(input.KeySpec)).To(Equal(kms.KeySpecSymmetricDefault))
+						g.Expect(aws.StringValue(input.KeyUsage)).To(Equal(kms.KeyUsageTypeEncryptDecrypt))
+						g.Expect(input.Tags).To(ContainElement(&kms.Tag{TagKey: aws.String(testClusterOwnedTagKey), TagValue: aws.String("owned")}))
+						return &kms.CreateKeyOutput{KeyMetadata: &kms.KeyMetadata{Arn: aws.String(testManagedKeyARN)}}, nil
+					})
+				m.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(testManagedKeyAlias)}).
+					Return(nil, awserr.New(kms.ErrCodeNotFoundException, "alias not found", nil))
+				m.EnableKeyRotation(&kms.EnableKeyRotationInput{KeyId: aws.String(testManagedKeyARN)}).
+					Return(&kms.EnableKeyRotationOutput{}, nil)
+				m.CreateAlias(&kms.CreateAliasInput{
+					AliasName:   aws.String(testManagedKeyAlias),
+					TargetKeyId: aws.String(testManagedKeyARN),
+				}).Return(&kms.CreateAliasOutput{}, nil)
+			},
+			wantARN: testManagedKeyARN,
+		},
+		{
+			name:    "adopts a key created by a previous reconciliation whose status wasn't patched",
+			enabled: true,
+			expectRG: func(m *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder) {
+				expectManagedKeyLookup(m, testOtherKeyARN, testManagedKeyARN)
+			},
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(testOtherKeyARN)}).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{
+						Arn:      aws.String(testOtherKeyARN),
+						KeyState: aws.String(kms.KeyStatePendingDeletion),
+					}}, nil)
+				m.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(testManagedKeyARN)}).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{
+						Arn:      aws.String(testManagedKeyARN),
+						KeyState: aws.String(kms.KeyStateEnabled),
+					}}, nil)
+				m.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(testManagedKeyAlias)}).
+					Return(nil, awserr.New(kms.ErrCodeNotFoundException, "alias not found", nil))
+				m.EnableKeyRotation(&kms.EnableKeyRotationInput{KeyId: aws.String(testManagedKeyARN)}).
+					Return(&kms.EnableKeyRotationOutput{}, nil)
+				m.CreateAlias(&kms.CreateAliasInput{
+					AliasName:   aws.String(testManagedKeyAlias),
+					TargetKeyId: aws.String(testManagedKeyARN),
+				}).Return(&kms.CreateAliasOutput{}, nil)
+			},
+			wantARN: testManagedKeyARN,
+		},
+		{
+			name:    "creates the alias of a key created by a previous reconciliation",
+			enabled: true,
+			keyARN:  testManagedKeyARN,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(testManagedKeyAlias)}).
+					Return(nil, awserr.New(kms.ErrCodeNotFoundException, "alias not found", nil))
+				m.EnableKeyRotation(&kms.EnableKeyRotationInput{KeyId: aws.String(testManagedKeyARN)}).
+					Return(&kms.EnableKeyRotationOutput{}, nil)
+				m.CreateAlias(&kms.CreateAliasInput{
+					AliasName:   aws.String(testManagedKeyAlias),
+					TargetKeyId: aws.String(testManagedKeyARN),
+				}).Return(&kms.CreateAliasOutput{}, nil)
+			},
+			wantARN: testManagedKeyARN,
+		},
+		{
+			name:    "does nothing when the alias points at the key",
+			enabled: true,
+			keyARN:  testManagedKeyARN,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(testManagedKeyAlias)}).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{Arn: aws.String(testManagedKeyARN)}}, nil)
+			},
+			wantARN: testManagedKeyARN,
+		},
+		{
+			name:    "returns an error when the alias points at another key",
+			enabled: true,
+			keyARN:  testManagedKeyARN,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(testManagedKeyAlias)}).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{Arn: aws.String(testOtherKeyARN)}}, nil)
+			},
+			wantErr: true,
+			wantARN: testManagedKeyARN,
+		},
+		{
+			name:    "returns an error when the key can't be created",
+			enabled: true,
+			expectRG: func(m *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder) {
+				expectManagedKeyLookup(m)
+			},
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.CreateKey(gomock.AssignableToTypeOf(&kms.CreateKeyInput{})).
+					Return(nil, awserr.New("AccessDeniedException", "not authorized to perform kms:CreateKey", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			kmsMock := mock_kmsiface.NewMockKMSAPI(mockCtrl)
+			tt.expectKMS(kmsMock.EXPECT())
+			rgMock := mocks.NewMockResourceGroupsTaggingAPIAPI(mockCtrl)
+			if tt.expectRG != nil {
+				tt.expectRG(rgMock.EXPECT())
+			}
+
+			clusterScope := newClusterScope(t, "", "", "")
+			clusterScope.AWSCluster.Spec.ManagedEncryptionKey = tt.enabled
+			clusterScope.SetManagedEncryptionKeyARN(tt.keyARN)
+			s := &Service{
+				scope:                 clusterScope,
+				KMSClient:             kmsMock,
+				ResourceTaggingClient: rgMock,
+			}
+
+			err := s.ReconcileManagedEncryptionKey()
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(clusterScope.ManagedEncryptionKeyARN()).To(Equal(tt.wantARN))
+			g.Expect(clusterScope.EBSEncryptionKeyARN()).To(Equal(tt.wantARN))
+		})
+	}
+}
+
+func TestDeleteManagedEncryptionKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		keyARN    string
+		expectKMS func(m *mock_kmsiface.MockKMSAPIMockRecorder)
+		wantErr   bool
+	}{
+		{
+			name:      "does nothing when no key was created",
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {},
+		},
+		{
+			name:   "deletes the alias and schedules the deletion of the key",
+			keyARN: testManagedKeyARN,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(testManagedKeyAlias)}).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{Arn: aws.String(testManagedKeyARN)}}, nil)
+				m.DeleteAlias(&kms.DeleteAliasInput{AliasName: aws.String(testManagedKeyAlias)}).
+					Return(&kms.DeleteAliasOutput{}, nil)
+				m.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{
+					KeyId:               aws.String(testManagedKeyARN),
+					PendingWindowInDays: aws.Int64(managedKeyDeletionWindowDays),
+				}).Return(&kms.ScheduleKeyDeletionOutput{}, nil)
+			},
+		},
+		{
+			name:   "keeps an alias pointing at another key",
+			keyARN: testManagedKeyARN,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(testManagedKeyAlias)}).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{Arn: aws.String(testOtherKeyARN)}}, nil)
+				m.ScheduleKeyDeletion(gomock.AssignableToTypeOf(&kms.ScheduleKeyDeletionInput{})).
+					Return(&kms.ScheduleKeyDeletionOutput{}, nil)
+			},
+		},
+		{
+			name:   "ignores keys whose deletion is already scheduled",
+			keyARN: testManagedKeyARN,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(testManagedKeyAlias)}).
+					Return(nil, awserr.New(kms.ErrCodeNotFoundException, "alias not found", nil))
+				m.ScheduleKeyDeletion(gomock.AssignableToTypeOf(&kms.ScheduleKeyDeletionInput{})).
+					Return(nil, awserr.New(kms.ErrCodeInvalidStateException, "key is pending deletion", nil))
+			},
+		},
+		{
+			name:   "returns an error when the deletion of the key can't be scheduled",
+			keyARN: testManagedKeyARN,
+			expectKMS: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(testManagedKeyAlias)}).
+					Return(nil, awserr.New(kms.ErrCodeNotFoundException, "alias not found", nil))
+				m.ScheduleKeyDeletion(gomock.AssignableToTypeOf(&kms.ScheduleKeyDeletionInput{})).
+					Return(nil, awserr.New("AccessDeniedException", "not authorized to perform kms:ScheduleKeyDeletion", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			kmsMock := mock_kmsiface.NewMockKMSAPI(mockCtrl)
+			tt.expectKMS(kmsMock.EXPECT())
+
+			clusterScope := newClusterScope(t, "", "", "")
+			clusterScope.AWSCluster.Spec.ManagedEncryptionKey = true
+			clusterScope.SetManagedEncryptionKeyARN(tt.keyARN)
+			s := &Service{
+				scope:     clusterScope,
+				KMSClient: kmsMock,
+			}
+
+			err := s.DeleteManagedEncryptionKey()
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(clusterScope.ManagedEncryptionKeyARN()).To(Equal(tt.keyARN))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.ManagedEncryptionKeyARN()).To(BeEmpty())
+		})
+	}
+}
@@ -21,12 +21,12 @@ import (
 	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/workload"
 )
 
 const (
@@ -56,23 +56,15 @@ func (s *Service) ReconcileKubeProxy(ctx context.Context) error {
 func (s *Service) deleteKubeProxy(ctx context.Context, remoteClient client.Client) error {
 	s.scope.Info("Ensuring the kube-proxy DaemonSet in cluster is deleted", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
 
-	ds := &appsv1.DaemonSet{}
-	if err := remoteClient.Get(ctx, types.NamespacedName{Namespace: kubeProxyNamespace, Name: kubeProxyName}, ds); err != nil {
-		if apierrors.IsNotFound(err) {
-			s.scope.Debug("The kube-proxy DaemonSet is not found, no action")
-			return nil
-		}
-		return fmt.Errorf("getting kube-proxy daemonset: %w", err)
-	}
-
-	s.scope.Debug("The kube-proxy DaemonSet found, deleting")
-	if err := remoteClient.Delete(ctx, ds, &client.DeleteOptions{}); err != nil {
-		if apierrors.IsNotFound(err) {
-			s.scope.Debug("The kube-proxy DaemonSet is not found, not deleted")
-			return nil
-		}
+	ds := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: kubeProxyNamespace, Name: kubeProxyName}}
+	deleted, err := workload.DeleteIfExists(ctx, remoteClient, ds)
+	if err != nil {
 		return fmt.Errorf("deleting kube-proxy DaemonSet: %w", err)
 	}
+	if !deleted {
+		s.scope.Debug("The kube-proxy DaemonSet is not found, no action")
+		return nil
+	}
 	record.Eventf(s.scope.InfraCluster(), "DeletedKubeProxy", "Kube-proxy has been removed from the cluster. Ensure you enable kube-proxy functionality via another mechanism")
 
 	return nil
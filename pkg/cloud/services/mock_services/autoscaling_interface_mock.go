@@ -110,6 +110,20 @@ func (mr *MockASGInterfaceMockRecorder) DeleteASGAndWait(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteASGAndWait", reflect.TypeOf((*MockASGInterface)(nil).DeleteASGAndWait), arg0)
 }
 
+// DeleteLifecycleHook mocks base method.
+func (m *MockASGInterface) DeleteLifecycleHook(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLifecycleHook", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLifecycleHook indicates an expected call of DeleteLifecycleHook.
+func (mr *MockASGInterfaceMockRecorder) DeleteLifecycleHook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLifecycleHook", reflect.TypeOf((*MockASGInterface)(nil).DeleteLifecycleHook), arg0, arg1)
+}
+
 // GetASGByName mocks base method.
 func (m *MockASGInterface) GetASGByName(arg0 *scope.MachinePoolScope) (*v1beta2.AutoScalingGroup, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +139,20 @@ func (mr *MockASGInterfaceMockRecorder) GetASGByName(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetASGByName", reflect.TypeOf((*MockASGInterface)(nil).GetASGByName), arg0)
 }
 
+// ReconcileTerminationLifecycleHook mocks base method.
+func (m *MockASGInterface) ReconcileTerminationLifecycleHook(arg0, arg1 string, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileTerminationLifecycleHook", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileTerminationLifecycleHook indicates an expected call of ReconcileTerminationLifecycleHook.
+func (mr *MockASGInterfaceMockRecorder) ReconcileTerminationLifecycleHook(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileTerminationLifecycleHook", reflect.TypeOf((*MockASGInterface)(nil).ReconcileTerminationLifecycleHook), arg0, arg1, arg2)
+}
+
 // ResumeProcesses mocks base method.
 func (m *MockASGInterface) ResumeProcesses(arg0 string, arg1 []string) error {
 	m.ctrl.T.Helper()
@@ -182,6 +210,20 @@ func (mr *MockASGInterfaceMockRecorder) SuspendProcesses(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendProcesses", reflect.TypeOf((*MockASGInterface)(nil).SuspendProcesses), arg0, arg1)
 }
 
+// TerminateInstanceAndDecrementCapacity mocks base method.
+func (m *MockASGInterface) TerminateInstanceAndDecrementCapacity(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateInstanceAndDecrementCapacity", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TerminateInstanceAndDecrementCapacity indicates an expected call of TerminateInstanceAndDecrementCapacity.
+func (mr *MockASGInterfaceMockRecorder) TerminateInstanceAndDecrementCapacity(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateInstanceAndDecrementCapacity", reflect.TypeOf((*MockASGInterface)(nil).TerminateInstanceAndDecrementCapacity), arg0)
+}
+
 // UpdateASG mocks base method.
 func (m *MockASGInterface) UpdateASG(arg0 *scope.MachinePoolScope) error {
 	m.ctrl.T.Helper()
@@ -196,6 +238,20 @@ func (mr *MockASGInterfaceMockRecorder) UpdateASG(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateASG", reflect.TypeOf((*MockASGInterface)(nil).UpdateASG), arg0)
 }
 
+// UpdateASGTags mocks base method.
+func (m *MockASGInterface) UpdateASGTags(arg0 string, arg1 []v1beta2.ASGTag, arg2 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateASGTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateASGTags indicates an expected call of UpdateASGTags.
+func (mr *MockASGInterfaceMockRecorder) UpdateASGTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateASGTags", reflect.TypeOf((*MockASGInterface)(nil).UpdateASGTags), arg0, arg1, arg2)
+}
+
 // UpdateResourceTags mocks base method.
 func (m *MockASGInterface) UpdateResourceTags(arg0 *string, arg1, arg2 map[string]string) error {
 	m.ctrl.T.Helper()
@@ -138,6 +138,20 @@ func (mr *MockEC2InterfaceMockRecorder) DetachSecurityGroupsFromNetworkInterface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachSecurityGroupsFromNetworkInterface", reflect.TypeOf((*MockEC2Interface)(nil).DetachSecurityGroupsFromNetworkInterface), arg0, arg1)
 }
 
+// DisableInstanceDeletionProtection mocks base method.
+func (m *MockEC2Interface) DisableInstanceDeletionProtection(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableInstanceDeletionProtection", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableInstanceDeletionProtection indicates an expected call of DisableInstanceDeletionProtection.
+func (mr *MockEC2InterfaceMockRecorder) DisableInstanceDeletionProtection(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableInstanceDeletionProtection", reflect.TypeOf((*MockEC2Interface)(nil).DisableInstanceDeletionProtection), arg0)
+}
+
 // DiscoverLaunchTemplateAMI mocks base method.
 func (m *MockEC2Interface) DiscoverLaunchTemplateAMI(arg0 scope.LaunchTemplateScope) (*string, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +182,22 @@ func (mr *MockEC2InterfaceMockRecorder) GetAdditionalSecurityGroupsIDs(arg0 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdditionalSecurityGroupsIDs", reflect.TypeOf((*MockEC2Interface)(nil).GetAdditionalSecurityGroupsIDs), arg0)
 }
 
+// GetConsoleDiagnostics mocks base method.
+func (m *MockEC2Interface) GetConsoleDiagnostics(arg0 string) ([]byte, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsoleDiagnostics", arg0)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetConsoleDiagnostics indicates an expected call of GetConsoleDiagnostics.
+func (mr *MockEC2InterfaceMockRecorder) GetConsoleDiagnostics(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsoleDiagnostics", reflect.TypeOf((*MockEC2Interface)(nil).GetConsoleDiagnostics), arg0)
+}
+
 // GetCoreSecurityGroups mocks base method.
 func (m *MockEC2Interface) GetCoreSecurityGroups(arg0 *scope.MachineScope) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -259,6 +289,21 @@ func (mr *MockEC2InterfaceMockRecorder) GetRunningInstanceByTags(arg0 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningInstanceByTags", reflect.TypeOf((*MockEC2Interface)(nil).GetRunningInstanceByTags), arg0)
 }
 
+// GetSpotPlacementScores mocks base method.
+func (m *MockEC2Interface) GetSpotPlacementScores(arg0 []string, arg1 int32) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpotPlacementScores", arg0, arg1)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpotPlacementScores indicates an expected call of GetSpotPlacementScores.
+func (mr *MockEC2InterfaceMockRecorder) GetSpotPlacementScores(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpotPlacementScores", reflect.TypeOf((*MockEC2Interface)(nil).GetSpotPlacementScores), arg0, arg1)
+}
+
 // InstanceIfExists mocks base method.
 func (m *MockEC2Interface) InstanceIfExists(arg0 *string) (*v1beta2.Instance, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +348,35 @@ func (mr *MockEC2InterfaceMockRecorder) ModifyInstanceMetadataOptions(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyInstanceMetadataOptions", reflect.TypeOf((*MockEC2Interface)(nil).ModifyInstanceMetadataOptions), arg0, arg1)
 }
 
+// ModifyInstanceSourceDestCheck mocks base method.
+func (m *MockEC2Interface) ModifyInstanceSourceDestCheck(arg0 string, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyInstanceSourceDestCheck", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyInstanceSourceDestCheck indicates an expected call of ModifyInstanceSourceDestCheck.
+func (mr *MockEC2InterfaceMockRecorder) ModifyInstanceSourceDestCheck(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyInstanceSourceDestCheck", reflect.TypeOf((*MockEC2Interface)(nil).ModifyInstanceSourceDestCheck), arg0, arg1)
+}
+
+// ModifyInstanceVolumes mocks base method.
+func (m *MockEC2Interface) ModifyInstanceVolumes(arg0 string, arg1 *v1beta2.Volume, arg2 []v1beta2.Volume) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyInstanceVolumes", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyInstanceVolumes indicates an expected call of ModifyInstanceVolumes.
+func (mr *MockEC2InterfaceMockRecorder) ModifyInstanceVolumes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyInstanceVolumes", reflect.TypeOf((*MockEC2Interface)(nil).ModifyInstanceVolumes), arg0, arg1, arg2)
+}
+
 // PruneLaunchTemplateVersions mocks base method.
 func (m *MockEC2Interface) PruneLaunchTemplateVersions(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -414,3 +488,17 @@ func (mr *MockEC2InterfaceMockRecorder) UpdateResourceTags(arg0, arg1, arg2 inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceTags", reflect.TypeOf((*MockEC2Interface)(nil).UpdateResourceTags), arg0, arg1, arg2)
 }
+
+// ValidateGPUInstanceType mocks base method.
+func (m *MockEC2Interface) ValidateGPUInstanceType(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateGPUInstanceType", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateGPUInstanceType indicates an expected call of ValidateGPUInstanceType.
+func (mr *MockEC2InterfaceMockRecorder) ValidateGPUInstanceType(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateGPUInstanceType", reflect.TypeOf((*MockEC2Interface)(nil).ValidateGPUInstanceType), arg0)
+}
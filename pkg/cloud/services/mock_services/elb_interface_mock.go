@@ -92,6 +92,35 @@ func (mr *MockELBInterfaceMockRecorder) DeregisterInstanceFromAPIServerLB(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterInstanceFromAPIServerLB", reflect.TypeOf((*MockELBInterface)(nil).DeregisterInstanceFromAPIServerLB), arg0, arg1)
 }
 
+// DeregisterInstanceFromGlobalAccelerator mocks base method.
+func (m *MockELBInterface) DeregisterInstanceFromGlobalAccelerator(arg0 *v1beta2.Instance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterInstanceFromGlobalAccelerator", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterInstanceFromGlobalAccelerator indicates an expected call of DeregisterInstanceFromGlobalAccelerator.
+func (mr *MockELBInterfaceMockRecorder) DeregisterInstanceFromGlobalAccelerator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterInstanceFromGlobalAccelerator", reflect.TypeOf((*MockELBInterface)(nil).DeregisterInstanceFromGlobalAccelerator), arg0)
+}
+
+// IsInstanceDrainingFromAPIServerLB mocks base method.
+func (m *MockELBInterface) IsInstanceDrainingFromAPIServerLB(arg0 *v1beta2.Instance) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsInstanceDrainingFromAPIServerLB", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsInstanceDrainingFromAPIServerLB indicates an expected call of IsInstanceDrainingFromAPIServerLB.
+func (mr *MockELBInterfaceMockRecorder) IsInstanceDrainingFromAPIServerLB(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsInstanceDrainingFromAPIServerLB", reflect.TypeOf((*MockELBInterface)(nil).IsInstanceDrainingFromAPIServerLB), arg0)
+}
+
 // IsInstanceRegisteredWithAPIServerELB mocks base method.
 func (m *MockELBInterface) IsInstanceRegisteredWithAPIServerELB(arg0 *v1beta2.Instance) (bool, error) {
 	m.ctrl.T.Helper()
@@ -164,3 +193,17 @@ func (mr *MockELBInterfaceMockRecorder) RegisterInstanceWithAPIServerLB(arg0 int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterInstanceWithAPIServerLB", reflect.TypeOf((*MockELBInterface)(nil).RegisterInstanceWithAPIServerLB), arg0)
 }
+
+// RegisterInstanceWithGlobalAccelerator mocks base method.
+func (m *MockELBInterface) RegisterInstanceWithGlobalAccelerator(arg0 *v1beta2.Instance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterInstanceWithGlobalAccelerator", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterInstanceWithGlobalAccelerator indicates an expected call of RegisterInstanceWithGlobalAccelerator.
+func (mr *MockELBInterfaceMockRecorder) RegisterInstanceWithGlobalAccelerator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterInstanceWithGlobalAccelerator", reflect.TypeOf((*MockELBInterface)(nil).RegisterInstanceWithGlobalAccelerator), arg0)
+}
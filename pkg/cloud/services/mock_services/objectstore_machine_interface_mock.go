@@ -65,6 +65,21 @@ func (mr *MockObjectStoreInterfaceMockRecorder) Create(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockObjectStoreInterface)(nil).Create), arg0, arg1)
 }
 
+// CreateBootDiagnostics mocks base method.
+func (m *MockObjectStoreInterface) CreateBootDiagnostics(arg0 *scope.MachineScope, arg1 map[string][]byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBootDiagnostics", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBootDiagnostics indicates an expected call of CreateBootDiagnostics.
+func (mr *MockObjectStoreInterfaceMockRecorder) CreateBootDiagnostics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBootDiagnostics", reflect.TypeOf((*MockObjectStoreInterface)(nil).CreateBootDiagnostics), arg0, arg1)
+}
+
 // Delete mocks base method.
 func (m *MockObjectStoreInterface) Delete(arg0 *scope.MachineScope) error {
 	m.ctrl.T.Helper()
@@ -145,6 +145,37 @@ func (s *Service) releaseAddresses() error {
 	return nil
 }
 
+// releaseOwnedAddresses releases the Elastic IPs of the cluster with the given allocation IDs, once the resources
+// they were associated with are gone. Addresses which aren't tagged for the cluster are left alone.
+func (s *Service) releaseOwnedAddresses(allocationIDs []string) error {
+	if len(allocationIDs) == 0 {
+		return nil
+	}
+
+	out, err := s.EC2Client.DescribeAddressesWithContext(context.TODO(), &ec2.DescribeAddressesInput{
+		AllocationIds: aws.StringSlice(allocationIDs),
+		Filters:       []*ec2.Filter{filter.EC2.Cluster(s.scope.Name())},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe elastic IPs %q", allocationIDs)
+	}
+
+	for _, ip := range out.Addresses {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if _, err := s.EC2Client.ReleaseAddressWithContext(context.TODO(), &ec2.ReleaseAddressInput{AllocationId: ip.AllocationId}); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.AuthFailure, awserrors.InUseIPAddress); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedReleaseEIP", "Failed to release Elastic IP %q: %v", *ip.AllocationId, err)
+			return errors.Wrapf(err, "failed to release ElasticIP %q", *ip.AllocationId)
+		}
+
+		s.scope.Info("released ElasticIP", "eip", aws.StringValue(ip.PublicIp), "allocation-id", *ip.AllocationId)
+	}
+	return nil
+}
+
 func (s *Service) getEIPTagParams(role string) infrav1.BuildParams {
 	name := fmt.Sprintf("%s-eip-%s", s.scope.Name(), role)
 
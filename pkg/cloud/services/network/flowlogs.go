@@ -0,0 +1,419 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/hash"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// maxIAMRoleNameLength is the maximum length of the name of an IAM role.
+	maxIAMRoleNameLength = 64
+
+	// flowLogsRolePolicyName is the name of the inline policy of the flow logs delivery role.
+	flowLogsRolePolicyName = "flow-logs-delivery"
+)
+
+func (s *Service) reconcileFlowLogs() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping flow logs reconcile in unmanaged mode")
+		return nil
+	}
+
+	spec := s.scope.FlowLogs()
+	if spec == nil {
+		// The flow log has been disabled, remove the one created before, if any.
+		return s.deleteFlowLogs()
+	}
+
+	s.scope.Debug("Reconciling flow logs")
+
+	roleName, err := flowLogsRoleName(s.scope.Name(), s.scope.Region())
+	if err != nil {
+		return err
+	}
+
+	flowLog, err := s.describeFlowLog()
+	if err != nil {
+		return err
+	}
+
+	// Flow logs can't be modified, so an outdated flow log is replaced.
+	if flowLog != nil && !flowLogMatchesSpec(flowLog, spec, roleName) {
+		if err := s.deleteFlowLog(aws.StringValue(flowLog.FlowLogId)); err != nil {
+			return err
+		}
+		// The replaced flow log may have used the delivery role the new one doesn't need.
+		if !usesFlowLogsRole(spec) {
+			if err := s.deleteFlowLogsRole(roleName); err != nil {
+				return err
+			}
+		}
+		flowLog = nil
+	}
+
+	if flowLog == nil {
+		roleARN := spec.IAMRoleARN
+		if usesFlowLogsRole(spec) {
+			if roleARN, err = s.reconcileFlowLogsRole(roleName, spec.LogGroupName); err != nil {
+				return err
+			}
+		}
+		if flowLog, err = s.createFlowLog(spec, roleARN); err != nil {
+			return err
+		}
+	}
+
+	s.scope.Network().FlowLogID = aws.StringValue(flowLog.FlowLogId)
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.FlowLogsReadyCondition)
+	return nil
+}
+
+func (s *Service) deleteFlowLogs() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping flow logs deletion in unmanaged mode")
+		return nil
+	}
+
+	// Nothing was created for clusters which never had a flow log.
+	if s.scope.FlowLogs() == nil && s.scope.Network().FlowLogID == "" {
+		return nil
+	}
+
+	roleName, err := flowLogsRoleName(s.scope.Name(), s.scope.Region())
+	if err != nil {
+		return err
+	}
+
+	flowLog, err := s.describeFlowLog()
+	if err != nil {
+		return err
+	}
+	if flowLog != nil {
+		if err := s.deleteFlowLog(aws.StringValue(flowLog.FlowLogId)); err != nil {
+			return err
+		}
+	}
+
+	if err := s.deleteFlowLogsRole(roleName); err != nil {
+		return err
+	}
+
+	s.scope.Network().FlowLogID = ""
+	return nil
+}
+
+// describeFlowLog returns the flow log created by the provider for the VPC, if any.
+func (s *Service) describeFlowLog() (*ec2.FlowLog, error) {
+	if s.scope.VPC().ID == "" {
+		return nil, nil
+	}
+
+	out, err := s.EC2Client.DescribeFlowLogsWithContext(context.TODO(), &ec2.DescribeFlowLogsInput{
+		Filter: []*ec2.Filter{
+			{
+				Name:   aws.String("resource-id"),
+				Values: aws.StringSlice([]string{s.scope.VPC().ID}),
+			},
+			filter.EC2.Cluster(s.scope.Name()),
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeFlowLogs", "Failed to describe flow logs of vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe flow logs of vpc %q", s.scope.VPC().ID)
+	}
+
+	for _, flowLog := range out.FlowLogs {
+		if converters.TagsToMap(flowLog.Tags).HasOwned(s.scope.Name()) {
+			return flowLog, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *Service) createFlowLog(spec *infrav1.FlowLogsSpec, roleARN string) (*ec2.FlowLog, error) {
+	input := &ec2.CreateFlowLogsInput{
+		ResourceIds:        aws.StringSlice([]string{s.scope.VPC().ID}),
+		ResourceType:       aws.String(ec2.FlowLogsResourceTypeVpc),
+		TrafficType:        aws.String(string(spec.GetTrafficType())),
+		LogDestinationType: aws.String(string(spec.GetDestinationType())),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeVpcFlowLog, s.getFlowLogTagParams(services.TemporaryResourceID)),
+		},
+	}
+	switch spec.GetDestinationType() {
+	case infrav1.FlowLogsDestinationTypeCloudWatchLogs:
+		input.LogGroupName = aws.String(spec.LogGroupName)
+		input.DeliverLogsPermissionArn = aws.String(roleARN)
+	case infrav1.FlowLogsDestinationTypeS3:
+		input.LogDestination = aws.String(spec.BucketARN)
+	}
+
+	out, err := s.EC2Client.CreateFlowLogsWithContext(context.TODO(), input)
+	if err == nil && len(out.Unsuccessful) > 0 {
+		// A newly created delivery role can take a while to be usable by the flow logs service,
+		// in which case the creation is retried on the next reconciliation.
+		err = errors.New(aws.StringValue(out.Unsuccessful[0].Error.Message))
+	}
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateFlowLog", "Failed to create flow log for vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to create flow log for vpc %q", s.scope.VPC().ID)
+	}
+	if len(out.FlowLogIds) == 0 {
+		return nil, errors.Errorf("no flow log was created for vpc %q", s.scope.VPC().ID)
+	}
+
+	flowLogID := aws.StringValue(out.FlowLogIds[0])
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateFlowLog", "Created flow log %q for vpc %q", flowLogID, s.scope.VPC().ID)
+	s.scope.Info("Created flow log", "flow-log-id", flowLogID)
+
+	return &ec2.FlowLog{
+		FlowLogId:                out.FlowLogIds[0],
+		LogDestinationType:       input.LogDestinationType,
+		LogDestination:           input.LogDestination,
+		LogGroupName:             input.LogGroupName,
+		TrafficType:              input.TrafficType,
+		DeliverLogsPermissionArn: input.DeliverLogsPermissionArn,
+	}, nil
+}
+
+func (s *Service) deleteFlowLog(flowLogID string) error {
+	out, err := s.EC2Client.DeleteFlowLogsWithContext(context.TODO(), &ec2.DeleteFlowLogsInput{
+		FlowLogIds: aws.StringSlice([]string{flowLogID}),
+	})
+	if err == nil && len(out.Unsuccessful) > 0 {
+		err = errors.New(aws.StringValue(out.Unsuccessful[0].Error.Message))
+	}
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteFlowLog", "Failed to delete flow log %q: %v", flowLogID, err)
+		return errors.Wrapf(err, "failed to delete flow log %q", flowLogID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteFlowLog", "Deleted flow log %q", flowLogID)
+	s.scope.Info("Deleted flow log", "flow-log-id", flowLogID)
+
+	return nil
+}
+
+// reconcileFlowLogsRole creates the IAM role allowing the flow log to publish to its CloudWatch Logs log group if it
+// doesn't exist, and returns its ARN.
+func (s *Service) reconcileFlowLogsRole(roleName, logGroupName string) (string, error) {
+	role, err := s.getFlowLogsRole(roleName)
+	if err != nil {
+		return "", err
+	}
+
+	if role == nil {
+		trustPolicy, err := json.Marshal(iamv1.PolicyDocument{
+			Version: iamv1.CurrentVersion,
+			Statement: iamv1.Statements{
+				iamv1.StatementEntry{
+					Effect:    iamv1.EffectAllow,
+					Principal: iamv1.Principals{iamv1.PrincipalService: iamv1.PrincipalID{"vpc-flow-logs.amazonaws.com"}},
+					Action:    iamv1.Actions{"sts:AssumeRole"},
+				},
+			},
+		})
+		if err != nil {
+			return "", errors.Wrap(err, "unable to JSON marshal trust policy")
+		}
+
+		out, err := s.IAMClient.CreateRole(&iam.CreateRoleInput{
+			RoleName:                 aws.String(roleName),
+			AssumeRolePolicyDocument: aws.String(string(trustPolicy)),
+			Tags:                     converters.MapToIAMTags(infrav1.Build(s.getFlowLogsRoleTagParams(roleName))),
+		})
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateFlowLogsRole", "Failed to create flow logs delivery role %q: %v", roleName, err)
+			return "", errors.Wrapf(err, "failed to create flow logs delivery role %q", roleName)
+		}
+		role = out.Role
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateFlowLogsRole", "Created flow logs delivery role %q", roleName)
+		s.scope.Info("Created flow logs delivery role", "role", roleName)
+	}
+
+	// The log groups are in the account of the role, the partition and account are taken from its ARN.
+	roleARN, err := arn.Parse(aws.StringValue(role.Arn))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse ARN of flow logs delivery role %q", roleName)
+	}
+	logGroupsARN := fmt.Sprintf("arn:%s:logs:%s:%s:log-group:", roleARN.Partition, s.scope.Region(), roleARN.AccountID)
+	rolePolicy, err := json.Marshal(iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: iamv1.Statements{
+			iamv1.StatementEntry{
+				Effect: iamv1.EffectAllow,
+				Action: iamv1.Actions{
+					"logs:CreateLogGroup",
+					"logs:CreateLogStream",
+					"logs:PutLogEvents",
+					"logs:DescribeLogStreams",
+				},
+				Resource: iamv1.Resources{logGroupsARN + logGroupName, logGroupsARN + logGroupName + ":*"},
+			},
+			iamv1.StatementEntry{
+				Effect:   iamv1.EffectAllow,
+				Action:   iamv1.Actions{"logs:DescribeLogGroups"},
+				Resource: iamv1.Resources{logGroupsARN + "*"},
+			},
+		},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to JSON marshal role policy")
+	}
+	if _, err := s.IAMClient.PutRolePolicy(&iam.PutRolePolicyInput{
+		RoleName:       aws.String(roleName),
+		PolicyName:     aws.String(flowLogsRolePolicyName),
+		PolicyDocument: aws.String(string(rolePolicy)),
+	}); err != nil {
+		return "", errors.Wrapf(err, "failed to put policy of flow logs delivery role %q", roleName)
+	}
+
+	return aws.StringValue(role.Arn), nil
+}
+
+// deleteFlowLogsRole deletes the IAM role created for the flow log, if any. Roles with the same name which weren't
+// created by the provider are left alone.
+func (s *Service) deleteFlowLogsRole(roleName string) error {
+	role, err := s.getFlowLogsRole(roleName)
+	if err != nil {
+		return err
+	}
+	if role == nil || !converters.IAMTagsToMap(role.Tags).HasOwned(s.scope.Name()) {
+		return nil
+	}
+
+	if _, err := s.IAMClient.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+		RoleName:   aws.String(roleName),
+		PolicyName: aws.String(flowLogsRolePolicyName),
+	}); err != nil && !isIAMNotFound(err) {
+		return errors.Wrapf(err, "failed to delete policy of flow logs delivery role %q", roleName)
+	}
+	if _, err := s.IAMClient.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String(roleName)}); err != nil && !isIAMNotFound(err) {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteFlowLogsRole", "Failed to delete flow logs delivery role %q: %v", roleName, err)
+		return errors.Wrapf(err, "failed to delete flow logs delivery role %q", roleName)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteFlowLogsRole", "Deleted flow logs delivery role %q", roleName)
+	s.scope.Info("Deleted flow logs delivery role", "role", roleName)
+
+	return nil
+}
+
+// getFlowLogsRole returns the IAM role with the given name, or nil if it doesn't exist.
+func (s *Service) getFlowLogsRole(roleName string) (*iam.Role, error) {
+	out, err := s.IAMClient.GetRole(&iam.GetRoleInput{RoleName: aws.String(roleName)})
+	if err != nil {
+		if isIAMNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get flow logs delivery role %q", roleName)
+	}
+	return out.Role, nil
+}
+
+func (s *Service) getFlowLogTagParams(id string) infrav1.BuildParams {
+	name := fmt.Sprintf("%s-flow-log", s.scope.Name())
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  id,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+func (s *Service) getFlowLogsRoleTagParams(roleName string) infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(roleName),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+// flowLogsRoleName returns the name of the flow logs delivery role of the cluster. IAM is global, so the name
+// includes the region to not collide with the role of a cluster of the same name in another region. Names exceeding
+// the maximum length of an IAM role name are replaced by a hash of the cluster name and region.
+func flowLogsRoleName(clusterName, region string) (string, error) {
+	const suffix = "-flow-logs"
+
+	name := fmt.Sprintf("%s-%s%s", clusterName, region, suffix)
+	if len(name) <= maxIAMRoleNameLength {
+		return name, nil
+	}
+
+	hashed, err := hash.Base36TruncatedHash(clusterName+"-"+region, 32)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to create flow logs delivery role name")
+	}
+	return hashed + suffix, nil
+}
+
+// usesFlowLogsRole returns whether the flow log of the spec is delivered using the role created by the provider.
+func usesFlowLogsRole(spec *infrav1.FlowLogsSpec) bool {
+	return spec.GetDestinationType() == infrav1.FlowLogsDestinationTypeCloudWatchLogs && spec.IAMRoleARN == ""
+}
+
+// flowLogMatchesSpec returns whether the flow log was created for the spec.
+func flowLogMatchesSpec(flowLog *ec2.FlowLog, spec *infrav1.FlowLogsSpec, roleName string) bool {
+	if aws.StringValue(flowLog.LogDestinationType) != string(spec.GetDestinationType()) ||
+		aws.StringValue(flowLog.TrafficType) != string(spec.GetTrafficType()) {
+		return false
+	}
+
+	switch spec.GetDestinationType() {
+	case infrav1.FlowLogsDestinationTypeS3:
+		return aws.StringValue(flowLog.LogDestination) == spec.BucketARN
+	default:
+		if aws.StringValue(flowLog.LogGroupName) != spec.LogGroupName {
+			return false
+		}
+		if spec.IAMRoleARN != "" {
+			return aws.StringValue(flowLog.DeliverLogsPermissionArn) == spec.IAMRoleARN
+		}
+		return strings.HasSuffix(aws.StringValue(flowLog.DeliverLogsPermissionArn), "/"+roleName)
+	}
+}
+
+func isIAMNotFound(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == iam.ErrCodeNoSuchEntityException
+	}
+	return false
+}
@@ -0,0 +1,422 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestReconcileFlowLogs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	managedVPC := infrav1.VPCSpec{
+		ID: "vpc-flow-logs",
+		Tags: infrav1.Tags{
+			infrav1.ClusterTagKey("test-cluster"): "owned",
+		},
+	}
+	ownedTags := []*ec2.Tag{
+		{
+			Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+			Value: aws.String("owned"),
+		},
+	}
+	describeInput := &ec2.DescribeFlowLogsInput{
+		Filter: []*ec2.Filter{
+			{Name: aws.String("resource-id"), Values: aws.StringSlice([]string{"vpc-flow-logs"})},
+			{Name: aws.String("tag-key"), Values: aws.StringSlice([]string{"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"})},
+		},
+	}
+	tagSpecifications := []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String("vpc-flow-log"),
+			Tags: []*ec2.Tag{
+				{Key: aws.String("Name"), Value: aws.String("test-cluster-flow-log")},
+				{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+				{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/role"), Value: aws.String("common")},
+			},
+		},
+	}
+	notFound := awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil)
+
+	testCases := []struct {
+		name          string
+		input         infrav1.NetworkSpec
+		flowLogID     string
+		expect        func(m *mocks.MockEC2APIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder)
+		wantFlowLogID string
+		wantErr       bool
+	}{
+		{
+			name: "does nothing without flow logs",
+			input: infrav1.NetworkSpec{
+				VPC: managedVPC,
+			},
+		},
+		{
+			name: "does nothing in an unmanaged vpc",
+			input: infrav1.NetworkSpec{
+				VPC:      infrav1.VPCSpec{ID: "vpc-flow-logs"},
+				FlowLogs: &infrav1.FlowLogsSpec{LogGroupName: "flow-logs"},
+			},
+		},
+		{
+			name: "creates a flow log delivered to s3",
+			input: infrav1.NetworkSpec{
+				VPC: managedVPC,
+				FlowLogs: &infrav1.FlowLogsSpec{
+					DestinationType: infrav1.FlowLogsDestinationTypeS3,
+					BucketARN:       "arn:aws:s3:::flow-logs/cluster/",
+					TrafficType:     infrav1.FlowLogsTrafficTypeReject,
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DescribeFlowLogsWithContext(context.TODO(), gomock.Eq(describeInput)).Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				m.CreateFlowLogsWithContext(context.TODO(), gomock.Eq(&ec2.CreateFlowLogsInput{
+					ResourceIds:        aws.StringSlice([]string{"vpc-flow-logs"}),
+					ResourceType:       aws.String("VPC"),
+					TrafficType:        aws.String("REJECT"),
+					LogDestinationType: aws.String("s3"),
+					LogDestination:     aws.String("arn:aws:s3:::flow-logs/cluster/"),
+					TagSpecifications:  tagSpecifications,
+				})).Return(&ec2.CreateFlowLogsOutput{FlowLogIds: aws.StringSlice([]string{"fl-s3"})}, nil)
+			},
+			wantFlowLogID: "fl-s3",
+		},
+		{
+			name: "creates a flow log delivered to cloudwatch logs with the given role",
+			input: infrav1.NetworkSpec{
+				VPC: managedVPC,
+				FlowLogs: &infrav1.FlowLogsSpec{
+					LogGroupName: "flow-logs",
+					IAMRoleARN:   "arn:aws:iam::123456789012:role/flow-logs",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DescribeFlowLogsWithContext(context.TODO(), gomock.Eq(describeInput)).Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				m.CreateFlowLogsWithContext(context.TODO(), gomock.Eq(&ec2.CreateFlowLogsInput{
+					ResourceIds:              aws.StringSlice([]string{"vpc-flow-logs"}),
+					ResourceType:             aws.String("VPC"),
+					TrafficType:              aws.String("ALL"),
+					LogDestinationType:       aws.String("cloud-watch-logs"),
+					LogGroupName:             aws.String("flow-logs"),
+					DeliverLogsPermissionArn: aws.String("arn:aws:iam::123456789012:role/flow-logs"),
+					TagSpecifications:        tagSpecifications,
+				})).Return(&ec2.CreateFlowLogsOutput{FlowLogIds: aws.StringSlice([]string{"fl-cloudwatch"})}, nil)
+			},
+			wantFlowLogID: "fl-cloudwatch",
+		},
+		{
+			name: "creates the delivery role of a flow log delivered to cloudwatch logs",
+			input: infrav1.NetworkSpec{
+				VPC:      managedVPC,
+				FlowLogs: &infrav1.FlowLogsSpec{LogGroupName: "flow-logs"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DescribeFlowLogsWithContext(context.TODO(), gomock.Eq(describeInput)).Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				gomock.InOrder(
+					i.GetRole(gomock.Eq(&iam.GetRoleInput{RoleName: aws.String("test-cluster-us-east-1-flow-logs")})).Return(nil, notFound),
+					i.CreateRole(gomock.AssignableToTypeOf(&iam.CreateRoleInput{})).DoAndReturn(func(input *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValue(input.RoleName)).To(Equal("test-cluster-us-east-1-flow-logs"))
+						g.Expect(aws.StringValue(input.AssumeRolePolicyDocument)).To(ContainSubstring("vpc-flow-logs.amazonaws.com"))
+						g.Expect(input.Tags).To(ContainElement(&iam.Tag{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+							Value: aws.String("owned"),
+						}))
+						return &iam.CreateRoleOutput{Role: &iam.Role{
+							RoleName: input.RoleName,
+							Arn:      aws.String("arn:aws:iam::123456789012:role/test-cluster-us-east-1-flow-logs"),
+						}}, nil
+					}),
+					i.PutRolePolicy(gomock.AssignableToTypeOf(&iam.PutRolePolicyInput{})).DoAndReturn(func(input *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValue(input.RoleName)).To(Equal("test-cluster-us-east-1-flow-logs"))
+						g.Expect(aws.StringValue(input.PolicyDocument)).To(ContainSubstring("logs:PutLogEvents"))
+						g.Expect(aws.StringValue(input.PolicyDocument)).To(ContainSubstring(`"arn:aws:logs:us-east-1:123456789012:log-group:flow-logs:*"`))
+						g.Expect(aws.StringValue(input.PolicyDocument)).NotTo(ContainSubstring(`"*"`))
+						return &iam.PutRolePolicyOutput{}, nil
+					}),
+					m.CreateFlowLogsWithContext(context.TODO(), gomock.Eq(&ec2.CreateFlowLogsInput{
+						ResourceIds:              aws.StringSlice([]string{"vpc-flow-logs"}),
+						ResourceType:             aws.String("VPC"),
+						TrafficType:              aws.String("ALL"),
+						LogDestinationType:       aws.String("cloud-watch-logs"),
+						LogGroupName:             aws.String("flow-logs"),
+						DeliverLogsPermissionArn: aws.String("arn:aws:iam::123456789012:role/test-cluster-us-east-1-flow-logs"),
+						TagSpecifications:        tagSpecifications,
+					})).Return(&ec2.CreateFlowLogsOutput{FlowLogIds: aws.StringSlice([]string{"fl-cloudwatch"})}, nil),
+				)
+			},
+			wantFlowLogID: "fl-cloudwatch",
+		},
+		{
+			name: "returns an error when the delivery role can't be used yet",
+			input: infrav1.NetworkSpec{
+				VPC:      managedVPC,
+				FlowLogs: &infrav1.FlowLogsSpec{LogGroupName: "flow-logs"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DescribeFlowLogsWithContext(context.TODO(), gomock.Eq(describeInput)).Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				i.GetRole(gomock.Any()).Return(&iam.GetRoleOutput{Role: &iam.Role{
+					Arn: aws.String("arn:aws:iam::123456789012:role/test-cluster-us-east-1-flow-logs"),
+				}}, nil)
+				i.PutRolePolicy(gomock.AssignableToTypeOf(&iam.PutRolePolicyInput{})).Return(&iam.PutRolePolicyOutput{}, nil)
+				m.CreateFlowLogsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+					Return(&ec2.CreateFlowLogsOutput{Unsuccessful: []*ec2.UnsuccessfulItem{{
+						Error:      &ec2.UnsuccessfulItemError{Code: aws.String("400"), Message: aws.String("Unable to assume given IAM role.")},
+						ResourceId: aws.String("vpc-flow-logs"),
+					}}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "keeps a flow log matching the spec",
+			input: infrav1.NetworkSpec{
+				VPC:      managedVPC,
+				FlowLogs: &infrav1.FlowLogsSpec{LogGroupName: "flow-logs"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DescribeFlowLogsWithContext(context.TODO(), gomock.Eq(describeInput)).Return(&ec2.DescribeFlowLogsOutput{FlowLogs: []*ec2.FlowLog{{
+					FlowLogId:                aws.String("fl-cloudwatch"),
+					LogDestinationType:       aws.String("cloud-watch-logs"),
+					LogGroupName:             aws.String("flow-logs"),
+					TrafficType:              aws.String("ALL"),
+					DeliverLogsPermissionArn: aws.String("arn:aws:iam::123456789012:role/test-cluster-us-east-1-flow-logs"),
+					Tags:                     ownedTags,
+				}}}, nil)
+			},
+			wantFlowLogID: "fl-cloudwatch",
+		},
+		{
+			name: "replaces an outdated flow log and deletes the delivery role it doesn't use anymore",
+			input: infrav1.NetworkSpec{
+				VPC: managedVPC,
+				FlowLogs: &infrav1.FlowLogsSpec{
+					DestinationType: infrav1.FlowLogsDestinationTypeS3,
+					BucketARN:       "arn:aws:s3:::flow-logs",
+				},
+			},
+			flowLogID: "fl-cloudwatch",
+			expect: func(m *mocks.MockEC2APIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				gomock.InOrder(
+					m.DescribeFlowLogsWithContext(context.TODO(), gomock.Eq(describeInput)).Return(&ec2.DescribeFlowLogsOutput{FlowLogs: []*ec2.FlowLog{{
+						FlowLogId:                aws.String("fl-cloudwatch"),
+						LogDestinationType:       aws.String("cloud-watch-logs"),
+						LogGroupName:             aws.String("flow-logs"),
+						TrafficType:              aws.String("ALL"),
+						DeliverLogsPermissionArn: aws.String("arn:aws:iam::123456789012:role/test-cluster-us-east-1-flow-logs"),
+						Tags:                     ownedTags,
+					}}}, nil),
+					m.DeleteFlowLogsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteFlowLogsInput{
+						FlowLogIds: aws.StringSlice([]string{"fl-cloudwatch"}),
+					})).Return(&ec2.DeleteFlowLogsOutput{}, nil),
+					i.GetRole(gomock.Eq(&iam.GetRoleInput{RoleName: aws.String("test-cluster-us-east-1-flow-logs")})).Return(&iam.GetRoleOutput{Role: &iam.Role{
+						RoleName: aws.String("test-cluster-us-east-1-flow-logs"),
+						Tags:     []*iam.Tag{{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")}},
+					}}, nil),
+					i.DeleteRolePolicy(gomock.Eq(&iam.DeleteRolePolicyInput{
+						RoleName:   aws.String("test-cluster-us-east-1-flow-logs"),
+						PolicyName: aws.String("flow-logs-delivery"),
+					})).Return(&iam.DeleteRolePolicyOutput{}, nil),
+					i.DeleteRole(gomock.Eq(&iam.DeleteRoleInput{RoleName: aws.String("test-cluster-us-east-1-flow-logs")})).Return(&iam.DeleteRoleOutput{}, nil),
+					m.CreateFlowLogsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+						Return(&ec2.CreateFlowLogsOutput{FlowLogIds: aws.StringSlice([]string{"fl-s3"})}, nil),
+				)
+			},
+			wantFlowLogID: "fl-s3",
+		},
+		{
+			name: "deletes the flow log once disabled",
+			input: infrav1.NetworkSpec{
+				VPC: managedVPC,
+			},
+			flowLogID: "fl-s3",
+			expect: func(m *mocks.MockEC2APIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DescribeFlowLogsWithContext(context.TODO(), gomock.Eq(describeInput)).Return(&ec2.DescribeFlowLogsOutput{FlowLogs: []*ec2.FlowLog{{
+					FlowLogId:          aws.String("fl-s3"),
+					LogDestinationType: aws.String("s3"),
+					Tags:               ownedTags,
+				}}}, nil)
+				m.DeleteFlowLogsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DeleteFlowLogsInput{})).Return(&ec2.DeleteFlowLogsOutput{}, nil)
+				i.GetRole(gomock.Any()).Return(nil, notFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						Region:      "us-east-1",
+						NetworkSpec: tc.input,
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{FlowLogID: tc.flowLogID},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT(), iamMock.EXPECT())
+			}
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+			s.IAMClient = iamMock
+
+			err = s.reconcileFlowLogs()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scope.Network().FlowLogID).To(Equal(tc.wantFlowLogID))
+		})
+	}
+}
+
+func TestDeleteFlowLogs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name   string
+		expect func(m *mocks.MockEC2APIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder)
+	}{
+		{
+			name: "deletes the flow log and its delivery role",
+			expect: func(m *mocks.MockEC2APIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DescribeFlowLogsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeFlowLogsInput{})).Return(&ec2.DescribeFlowLogsOutput{FlowLogs: []*ec2.FlowLog{{
+					FlowLogId: aws.String("fl-cloudwatch"),
+					Tags: []*ec2.Tag{{
+						Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+						Value: aws.String("owned"),
+					}},
+				}}}, nil)
+				m.DeleteFlowLogsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteFlowLogsInput{
+					FlowLogIds: aws.StringSlice([]string{"fl-cloudwatch"}),
+				})).Return(&ec2.DeleteFlowLogsOutput{}, nil)
+				i.GetRole(gomock.Any()).Return(&iam.GetRoleOutput{Role: &iam.Role{
+					RoleName: aws.String("test-cluster-us-east-1-flow-logs"),
+					Tags:     []*iam.Tag{{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")}},
+				}}, nil)
+				i.DeleteRolePolicy(gomock.AssignableToTypeOf(&iam.DeleteRolePolicyInput{})).Return(&iam.DeleteRolePolicyOutput{}, nil)
+				i.DeleteRole(gomock.Eq(&iam.DeleteRoleInput{RoleName: aws.String("test-cluster-us-east-1-flow-logs")})).Return(&iam.DeleteRoleOutput{}, nil)
+			},
+		},
+		{
+			name: "leaves a role it didn't create alone",
+			expect: func(m *mocks.MockEC2APIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DescribeFlowLogsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeFlowLogsInput{})).Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				i.GetRole(gomock.Any()).Return(&iam.GetRoleOutput{Role: &iam.Role{
+					RoleName: aws.String("test-cluster-us-east-1-flow-logs"),
+				}}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						Region: "us-east-1",
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								ID:   "vpc-flow-logs",
+								Tags: infrav1.Tags{infrav1.ClusterTagKey("test-cluster"): "owned"},
+							},
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{FlowLogID: "fl-cloudwatch"},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			tc.expect(ec2Mock.EXPECT(), iamMock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+			s.IAMClient = iamMock
+
+			g.Expect(s.deleteFlowLogs()).To(Succeed())
+			g.Expect(scope.Network().FlowLogID).To(BeEmpty())
+		})
+	}
+}
+
+func TestFlowLogsRoleName(t *testing.T) {
+	g := NewWithT(t)
+
+	name, err := flowLogsRoleName("test-cluster", "us-east-1")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(name).To(Equal("test-cluster-us-east-1-flow-logs"))
+
+	otherRegion, err := flowLogsRoleName(strings.Repeat("a", 60), "us-west-2")
+	g.Expect(err).NotTo(HaveOccurred())
+	name, err = flowLogsRoleName(strings.Repeat("a", 60), "us-east-1")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(len(name)).To(BeNumerically("<=", maxIAMRoleNameLength))
+	g.Expect(name).To(HaveSuffix("-flow-logs"))
+	g.Expect(name).NotTo(Equal(otherRegion))
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// reconcileKarpenterDiscoveryTags removes the karpenter.sh/discovery tag previously added to the private subnets and
+// node security group when it is disabled or its value changes, and records the value of the tag in the status.
+// The tag itself is added with the other tags of the subnets and security groups.
+func (s *Service) reconcileKarpenterDiscoveryTags() error {
+	want := ""
+	if s.scope.VPC().IsManaged(s.scope.Name()) {
+		want = s.scope.KarpenterDiscovery().TagValue(s.scope.KubernetesClusterName())
+	}
+
+	applied := s.scope.Network().KarpenterDiscoveryTagValue
+	if applied != "" && applied != want {
+		var resources []string
+		for _, sn := range s.scope.Subnets().FilterPrivate() {
+			if id := sn.GetResourceID(); id != "" {
+				resources = append(resources, id)
+			}
+		}
+		if sg, ok := s.scope.SecurityGroups()[infrav1.SecurityGroupNode]; ok && sg.ID != "" {
+			resources = append(resources, sg.ID)
+		}
+
+		if len(resources) > 0 {
+			// Only the tags with the value added by CAPA are deleted.
+			if _, err := s.EC2Client.DeleteTagsWithContext(context.TODO(), &ec2.DeleteTagsInput{
+				Resources: aws.StringSlice(resources),
+				Tags: []*ec2.Tag{{
+					Key:   aws.String(infrav1.KarpenterDiscoveryTagKey),
+					Value: aws.String(applied),
+				}},
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedDeleteKarpenterDiscoveryTags", "Failed to delete the Karpenter discovery tags: %v", err)
+				return errors.Wrap(err, "failed to delete karpenter discovery tags")
+			}
+			s.scope.Debug("Deleted karpenter discovery tags", "value", applied, "resources", resources)
+		}
+	}
+
+	s.scope.Network().KarpenterDiscoveryTagValue = want
+	return nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestSubnetTagParamsKarpenterDiscovery(t *testing.T) {
+	testCases := []struct {
+		name         string
+		unmanagedVPC bool
+		public       bool
+		discovery    *infrav1.KarpenterDiscoverySpec
+		expected     string
+	}{
+		{
+			name: "no tag when not configured",
+		},
+		{
+			name:      "tag defaults to the cluster name",
+			discovery: &infrav1.KarpenterDiscoverySpec{},
+			expected:  "test-cluster",
+		},
+		{
+			name:      "tag with a custom value",
+			discovery: &infrav1.KarpenterDiscoverySpec{Value: "karpenter"},
+			expected:  "karpenter",
+		},
+		{
+			name:      "no tag on the public subnets",
+			public:    true,
+			discovery: &infrav1.KarpenterDiscoverySpec{},
+		},
+		{
+			name:         "no tag on the subnets of an unmanaged VPC",
+			unmanagedVPC: true,
+			discovery:    &infrav1.KarpenterDiscoverySpec{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scope, err := NewClusterScope().WithNetwork(&infrav1.NetworkSpec{
+				KarpenterDiscovery: tc.discovery,
+			}).Build()
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(scope)
+			tags := infrav1.Build(s.getSubnetTagParams(tc.unmanagedVPC, "subnet-1", tc.public, "us-east-1a", nil))
+			if tc.expected == "" {
+				g.Expect(tags).NotTo(HaveKey(infrav1.KarpenterDiscoveryTagKey))
+				return
+			}
+			g.Expect(tags).To(HaveKeyWithValue(infrav1.KarpenterDiscoveryTagKey, tc.expected))
+		})
+	}
+}
+
+func TestReconcileKarpenterDiscoveryTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		discovery     *infrav1.KarpenterDiscoverySpec
+		applied       string
+		expect        func(m *mocks.MockEC2APIMockRecorder)
+		expectedValue string
+	}{
+		{
+			name:          "records the value of the tag",
+			discovery:     &infrav1.KarpenterDiscoverySpec{},
+			expectedValue: "test-cluster",
+		},
+		{
+			name:          "leaves the tag alone when it is unchanged",
+			discovery:     &infrav1.KarpenterDiscoverySpec{},
+			applied:       "test-cluster",
+			expectedValue: "test-cluster",
+		},
+		{
+			name:    "deletes the tag added by CAPA when it is disabled",
+			applied: "test-cluster",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DeleteTagsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteTagsInput{
+					Resources: aws.StringSlice([]string{"subnet-1", "subnet-2", "sg-node"}),
+					Tags: []*ec2.Tag{{
+						Key:   aws.String(infrav1.KarpenterDiscoveryTagKey),
+						Value: aws.String("test-cluster"),
+					}},
+				})).Return(&ec2.DeleteTagsOutput{}, nil)
+			},
+		},
+		{
+			name:      "deletes the previous value of the tag when it changes",
+			discovery: &infrav1.KarpenterDiscoverySpec{Value: "karpenter"},
+			applied:   "test-cluster",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DeleteTagsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteTagsInput{
+					Resources: aws.StringSlice([]string{"subnet-1", "subnet-2", "sg-node"}),
+					Tags: []*ec2.Tag{{
+						Key:   aws.String(infrav1.KarpenterDiscoveryTagKey),
+						Value: aws.String("test-cluster"),
+					}},
+				})).Return(&ec2.DeleteTagsOutput{}, nil)
+			},
+			expectedValue: "karpenter",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			scope, err := NewClusterScope().WithNetwork(&infrav1.NetworkSpec{
+				Subnets: []infrav1.SubnetSpec{
+					{ID: "subnet-1", ResourceID: "subnet-1"},
+					{ID: "subnet-2", ResourceID: "subnet-2"},
+					{ID: "subnet-public", ResourceID: "subnet-public", IsPublic: true},
+				},
+				KarpenterDiscovery: tc.discovery,
+			}).Build()
+			g.Expect(err).NotTo(HaveOccurred())
+			scope.Network().SecurityGroups = map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+				infrav1.SecurityGroupNode: {ID: "sg-node"},
+			}
+			scope.Network().KarpenterDiscoveryTagValue = tc.applied
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.reconcileKarpenterDiscoveryTags()).To(Succeed())
+			g.Expect(scope.Network().KarpenterDiscoveryTagValue).To(Equal(tc.expectedValue))
+		})
+	}
+}
@@ -19,6 +19,8 @@ package network
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -72,20 +74,20 @@ func (s *Service) reconcileNatGateways() error {
 
 	natGatewaysIPs := []string{}
 	subnetIDs := []string{}
+	pendingIDs := []string{}
 
-	for _, sn := range s.scope.Subnets().FilterPublic() {
-		if sn.GetResourceID() == "" {
-			continue
-		}
-
+	for _, sn := range s.natGatewaySubnets() {
 		if ngw, ok := existing[sn.GetResourceID()]; ok {
+			if aws.StringValue(ngw.State) == ec2.NatGatewayStatePending {
+				pendingIDs = append(pendingIDs, *ngw.NatGatewayId)
+			}
 			if len(ngw.NatGatewayAddresses) > 0 && ngw.NatGatewayAddresses[0].PublicIp != nil {
 				natGatewaysIPs = append(natGatewaysIPs, *ngw.NatGatewayAddresses[0].PublicIp)
 			}
 			// Make sure tags are up to date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getNatGatewayTagParams(*ngw.NatGatewayId)
-				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithPolicy(s.scope.TagReconcilePolicy(), s.scope.InfraCluster(), s.scope))
 				if err := tagsBuilder.Ensure(converters.TagsToMap(ngw.Tags)); err != nil {
 					return false, err
 				}
@@ -115,19 +117,132 @@ func (s *Service) reconcileNatGateways() error {
 		ngws, err := s.createNatGateways(subnetIDs)
 
 		for _, ng := range ngws {
-			subnet := s.scope.Subnets().FindByID(*ng.SubnetId)
-			subnet.NatGatewayID = ng.NatGatewayId
+			s.setSubnetNatGatewayID(*ng.SubnetId, ng.NatGatewayId)
 		}
 
 		if err != nil {
 			return err
 		}
-		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition)
+		if s.scope.UseBlockingWaiters() {
+			conditions.MarkTrue(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition)
+			return nil
+		}
+		for _, ng := range ngws {
+			pendingIDs = append(pendingIDs, *ng.NatGatewayId)
+		}
+	}
+
+	if s.scope.UseBlockingWaiters() {
+		return nil
+	}
+
+	// The route tables are only pointed at the NAT gateways once they are available, the next reconciles check
+	// on them instead of waiting here.
+	if len(pendingIDs) > 0 {
+		sort.Strings(pendingIDs)
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition, infrav1.NatGatewaysCreationStartedReason, clusterv1.ConditionSeverityInfo,
+			"Waiting for NAT gateways %s to become available", strings.Join(pendingIDs, ", "))
+		return wait.NewPending(fmt.Sprintf("NAT gateways %s are not available yet", strings.Join(pendingIDs, ", ")))
+	}
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition)
+
+	return nil
+}
+
+// natGatewaySubnets returns the public subnets which should have a NAT gateway according to the NAT gateway
+// strategy of the cluster.
+func (s *Service) natGatewaySubnets() []infrav1.SubnetSpec {
+	subnets := []infrav1.SubnetSpec{}
+	for _, sn := range s.scope.Subnets().FilterPublic() {
+		if sn.GetResourceID() == "" {
+			continue
+		}
+		subnets = append(subnets, sn)
+	}
+
+	if s.scope.NatGatewayStrategy() != infrav1.NatGatewayStrategySingle || len(subnets) == 0 {
+		return subnets
+	}
+
+	// Keep a subnet which already has a NAT gateway, so that switching from PerZone to Single doesn't
+	// create a new gateway.
+	for _, sn := range subnets {
+		if sn.NatGatewayID != nil {
+			return []infrav1.SubnetSpec{sn}
+		}
+	}
+	return subnets[:1]
+}
+
+// deleteUnusedNatGateways deletes the NAT gateways of the public subnets which shouldn't have one anymore,
+// e.g. after switching from the PerZone to the Single NAT gateway strategy. It must be called once the
+// private route tables have been updated to use the remaining NAT gateway.
+func (s *Service) deleteUnusedNatGateways() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping NAT gateway cleanup in unmanaged mode")
+		return nil
+	}
+
+	// Every public subnet has a NAT gateway with the PerZone strategy.
+	if s.scope.NatGatewayStrategy() != infrav1.NatGatewayStrategySingle || len(s.scope.Subnets().FilterPrivate()) == 0 {
+		return nil
+	}
+
+	wanted := make(map[string]struct{})
+	for _, sn := range s.natGatewaySubnets() {
+		wanted[sn.GetResourceID()] = struct{}{}
+	}
+	if len(wanted) == 0 {
+		return nil
+	}
+
+	existing, err := s.describeNatGatewaysBySubnet()
+	if err != nil {
+		return err
+	}
+
+	for _, sn := range s.scope.Subnets().FilterPublic() {
+		if _, ok := wanted[sn.GetResourceID()]; ok {
+			continue
+		}
+
+		ngw, ok := existing[sn.GetResourceID()]
+		if !ok {
+			continue
+		}
+
+		if err := s.deleteNatGateway(*ngw.NatGatewayId); err != nil {
+			return err
+		}
+
+		s.setSubnetNatGatewayID(sn.GetResourceID(), nil)
+
+		// The Elastic IP of the NAT gateway would otherwise stay allocated, and billed, until the cluster is deleted.
+		allocationIDs := make([]string, 0, len(ngw.NatGatewayAddresses))
+		for _, address := range ngw.NatGatewayAddresses {
+			if address.AllocationId != nil {
+				allocationIDs = append(allocationIDs, *address.AllocationId)
+			}
+		}
+		if err := s.releaseOwnedAddresses(allocationIDs); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// setSubnetNatGatewayID records the NAT gateway of a subnet in the scope, so that the route tables reconciled
+// afterwards use it.
+func (s *Service) setSubnetNatGatewayID(subnetID string, natGatewayID *string) {
+	subnets := s.scope.Subnets()
+	for i := range subnets {
+		if subnets[i].GetResourceID() == subnetID {
+			subnets[i].NatGatewayID = natGatewayID
+		}
+	}
+}
+
 func (s *Service) deleteNatGateways() error {
 	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
 		s.scope.Trace("Skipping NAT gateway deletion in unmanaged mode")
@@ -263,6 +378,11 @@ func (s *Service) createNatGateway(subnetID, ip string) (*ec2.NatGateway, error)
 	}
 	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateNATGateway", "Created new NAT Gateway %q", *out.NatGateway.NatGatewayId)
 
+	if !s.scope.UseBlockingWaiters() {
+		s.scope.Info("Created NAT gateway for subnet, not waiting for it to become available", "nat-gateway-id", *out.NatGateway.NatGatewayId, "subnet-id", subnetID)
+		return out.NatGateway, nil
+	}
+
 	wReq := &ec2.DescribeNatGatewaysInput{NatGatewayIds: []*string{out.NatGateway.NatGatewayId}}
 	if err := s.EC2Client.WaitUntilNatGatewayAvailableWithContext(context.TODO(), wReq); err != nil {
 		return nil, errors.Wrapf(err, "failed to wait for nat gateway %q in subnet %q", *out.NatGateway.NatGatewayId, subnetID)
@@ -322,6 +442,15 @@ func (s *Service) getNatGatewayForSubnet(sn *infrav1.SubnetSpec) (string, error)
 		return "", errors.Errorf("cannot get NAT gateway for a public subnet, got id %q", sn.GetResourceID())
 	}
 
+	if s.scope.NatGatewayStrategy() == infrav1.NatGatewayStrategySingle {
+		for _, psn := range s.natGatewaySubnets() {
+			if psn.NatGatewayID != nil {
+				return *psn.NatGatewayID, nil
+			}
+		}
+		return "", errors.Errorf("no nat gateway available for private subnet %q", sn.GetResourceID())
+	}
+
 	azGateways := make(map[string][]string)
 	for _, psn := range s.scope.Subnets().FilterPublic() {
 		if psn.NatGatewayID == nil {
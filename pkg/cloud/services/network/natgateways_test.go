@@ -32,8 +32,10 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 const (
@@ -388,8 +390,9 @@ func TestReconcileNatGateways(t *testing.T) {
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
 				},
-				AWSCluster: awsCluster,
-				Client:     client,
+				AWSCluster:         awsCluster,
+				Client:             client,
+				UseBlockingWaiters: true,
 			})
 			if err != nil {
 				t.Fatalf("Failed to create test context: %v", err)
@@ -728,3 +731,357 @@ var mockDescribeNatGatewaysOutput = func(ctx context.Context, _, y interface{},
 		SubnetId:     aws.String("subnet-1"),
 	}}}, true)
 }
+
+func TestReconcileNatGatewaysStrategy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	subnets := func(natGatewayIDs map[string]string) []infrav1.SubnetSpec {
+		subnets := []infrav1.SubnetSpec{
+			{ID: "subnet-1", AvailabilityZone: "us-east-1a", CidrBlock: "10.0.10.0/24", IsPublic: true},
+			{ID: "subnet-2", AvailabilityZone: "us-east-1a", CidrBlock: "10.0.12.0/24", IsPublic: false},
+			{ID: "subnet-3", AvailabilityZone: "us-east-1b", CidrBlock: "10.0.13.0/24", IsPublic: true},
+			{ID: "subnet-4", AvailabilityZone: "us-east-1b", CidrBlock: "10.0.14.0/24", IsPublic: false},
+		}
+		for i := range subnets {
+			if id, ok := natGatewayIDs[subnets[i].ID]; ok {
+				subnets[i].NatGatewayID = aws.String(id)
+			}
+		}
+		return subnets
+	}
+
+	testCases := []struct {
+		name     string
+		strategy infrav1.NatGatewayStrategy
+		input    []infrav1.SubnetSpec
+		existing []*ec2.NatGateway
+		// want maps the public subnets to the ID of their NAT gateway after the reconciliation.
+		want map[string]*string
+	}{
+		{
+			name:     "single strategy without NAT gateway, should create 1 NAT gateway in the first public subnet",
+			strategy: infrav1.NatGatewayStrategySingle,
+			input:    subnets(nil),
+			want: map[string]*string{
+				"subnet-1": aws.String("nat-subnet-1"),
+				"subnet-3": nil,
+			},
+		},
+		{
+			name:     "single strategy with a NAT gateway, should keep the existing NAT gateway",
+			strategy: infrav1.NatGatewayStrategySingle,
+			input:    subnets(map[string]string{"subnet-3": "nat-existing"}),
+			existing: []*ec2.NatGateway{
+				{NatGatewayId: aws.String("nat-existing"), SubnetId: aws.String("subnet-3")},
+			},
+			want: map[string]*string{
+				"subnet-1": nil,
+				"subnet-3": aws.String("nat-existing"),
+			},
+		},
+		{
+			name:     "switching from single to per zone strategy, should create the missing NAT gateway",
+			strategy: infrav1.NatGatewayStrategyPerZone,
+			input:    subnets(map[string]string{"subnet-1": "nat-existing"}),
+			existing: []*ec2.NatGateway{
+				{NatGatewayId: aws.String("nat-existing"), SubnetId: aws.String("subnet-1")},
+			},
+			want: map[string]*string{
+				"subnet-1": aws.String("nat-existing"),
+				"subnet-3": aws.String("nat-subnet-3"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			clusterScope := natGatewayStrategyTestScope(g, tc.strategy, tc.input, true)
+
+			ec2Mock.EXPECT().DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, _, y interface{}, requestOptions ...request.Option) {
+					funct := y.(func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool)
+					for _, ngw := range tc.existing {
+						ngw.Tags = []*ec2.Tag{
+							{Key: aws.String("Name"), Value: aws.String("test-cluster-nat")},
+							{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+							{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/role"), Value: aws.String("common")},
+						}
+					}
+					funct(&ec2.DescribeNatGatewaysOutput{NatGateways: tc.existing}, true)
+				}).Return(nil)
+			ec2Mock.EXPECT().DescribeAddressesWithContext(context.TODO(), gomock.Any()).
+				Return(&ec2.DescribeAddressesOutput{}, nil).AnyTimes()
+			ec2Mock.EXPECT().AllocateAddressWithContext(context.TODO(), gomock.Any()).
+				Return(&ec2.AllocateAddressOutput{AllocationId: aws.String(ElasticIPAllocationID)}, nil).AnyTimes()
+			ec2Mock.EXPECT().CreateNatGatewayWithContext(context.TODO(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, input *ec2.CreateNatGatewayInput, _ ...request.Option) (*ec2.CreateNatGatewayOutput, error) {
+					return &ec2.CreateNatGatewayOutput{
+						NatGateway: &ec2.NatGateway{
+							NatGatewayId: aws.String("nat-" + aws.StringValue(input.SubnetId)),
+							SubnetId:     input.SubnetId,
+						},
+					}, nil
+				}).AnyTimes()
+			ec2Mock.EXPECT().WaitUntilNatGatewayAvailableWithContext(context.TODO(), gomock.Any()).Return(nil).AnyTimes()
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.reconcileNatGateways()).To(Succeed())
+			for id, want := range tc.want {
+				g.Expect(clusterScope.Subnets().FindByID(id).NatGatewayID).To(Equal(want), "subnet %s", id)
+			}
+		})
+	}
+}
+
+func TestReconcileNatGatewaysPolling(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	clusterScope := natGatewayStrategyTestScope(g, infrav1.NatGatewayStrategyPerZone, []infrav1.SubnetSpec{
+		{ID: "subnet-1", AvailabilityZone: "us-east-1a", CidrBlock: "10.0.10.0/24", IsPublic: true},
+		{ID: "subnet-2", AvailabilityZone: "us-east-1a", CidrBlock: "10.0.12.0/24", IsPublic: false},
+	}, false)
+	s := NewService(clusterScope)
+	s.EC2Client = ec2Mock
+
+	expectNatGateways := func(state string) {
+		ec2Mock.EXPECT().DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+			Do(func(ctx context.Context, _, y interface{}, requestOptions ...request.Option) {
+				funct := y.(func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool)
+				page := &ec2.DescribeNatGatewaysOutput{}
+				if state != "" {
+					page.NatGateways = []*ec2.NatGateway{{
+						NatGatewayId: aws.String("nat-subnet-1"),
+						SubnetId:     aws.String("subnet-1"),
+						State:        aws.String(state),
+						NatGatewayAddresses: []*ec2.NatGatewayAddress{
+							{PublicIp: aws.String("10.0.10.1")},
+						},
+						Tags: []*ec2.Tag{
+							{Key: aws.String("Name"), Value: aws.String("test-cluster-nat")},
+							{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+							{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/role"), Value: aws.String("common")},
+						},
+					}}
+				}
+				funct(page, true)
+			}).Return(nil)
+	}
+
+	// The first reconcile creates the NAT gateway without waiting for it.
+	expectNatGateways("")
+	ec2Mock.EXPECT().DescribeAddressesWithContext(context.TODO(), gomock.Any()).
+		Return(&ec2.DescribeAddressesOutput{}, nil)
+	ec2Mock.EXPECT().AllocateAddressWithContext(context.TODO(), gomock.Any()).
+		Return(&ec2.AllocateAddressOutput{AllocationId: aws.String(ElasticIPAllocationID)}, nil)
+	ec2Mock.EXPECT().CreateNatGatewayWithContext(context.TODO(), gomock.Any()).
+		Return(&ec2.CreateNatGatewayOutput{
+			NatGateway: &ec2.NatGateway{
+				NatGatewayId: aws.String("nat-subnet-1"),
+				SubnetId:     aws.String("subnet-1"),
+				State:        aws.String(ec2.NatGatewayStatePending),
+			},
+		}, nil)
+
+	err := s.reconcileNatGateways()
+	g.Expect(wait.IsPending(err)).To(BeTrue(), "expected a pending error, got %v", err)
+	g.Expect(clusterScope.Subnets().FindByID("subnet-1").NatGatewayID).To(Equal(aws.String("nat-subnet-1")))
+	g.Expect(conditions.IsFalse(clusterScope.AWSCluster, infrav1.NatGatewaysReadyCondition)).To(BeTrue())
+	g.Expect(conditions.GetReason(clusterScope.AWSCluster, infrav1.NatGatewaysReadyCondition)).To(Equal(infrav1.NatGatewaysCreationStartedReason))
+
+	// The next reconcile finds the NAT gateway still pending and doesn't create another one.
+	expectNatGateways(ec2.NatGatewayStatePending)
+
+	err = s.reconcileNatGateways()
+	g.Expect(wait.IsPending(err)).To(BeTrue(), "expected a pending error, got %v", err)
+	g.Expect(conditions.GetReason(clusterScope.AWSCluster, infrav1.NatGatewaysReadyCondition)).To(Equal(infrav1.NatGatewaysCreationStartedReason))
+
+	// Once the NAT gateway is available the reconcile completes.
+	expectNatGateways(ec2.NatGatewayStateAvailable)
+
+	g.Expect(s.reconcileNatGateways()).To(Succeed())
+	g.Expect(conditions.IsTrue(clusterScope.AWSCluster, infrav1.NatGatewaysReadyCondition)).To(BeTrue())
+	g.Expect(clusterScope.GetNatGatewaysIPs()).To(ConsistOf("10.0.10.1"))
+}
+
+func TestGetNatGatewayForSubnetStrategy(t *testing.T) {
+	subnets := []infrav1.SubnetSpec{
+		{ID: "subnet-1", AvailabilityZone: "us-east-1a", IsPublic: true, NatGatewayID: aws.String("nat-1")},
+		{ID: "subnet-2", AvailabilityZone: "us-east-1a", IsPublic: false},
+		{ID: "subnet-3", AvailabilityZone: "us-east-1b", IsPublic: true, NatGatewayID: aws.String("nat-3")},
+		{ID: "subnet-4", AvailabilityZone: "us-east-1b", IsPublic: false},
+	}
+
+	testCases := []struct {
+		name     string
+		strategy infrav1.NatGatewayStrategy
+		want     map[string]string
+	}{
+		{
+			name: "per zone strategy by default, private subnets use the NAT gateway of their zone",
+			want: map[string]string{
+				"subnet-2": "nat-1",
+				"subnet-4": "nat-3",
+			},
+		},
+		{
+			name:     "single strategy, private subnets share the same NAT gateway",
+			strategy: infrav1.NatGatewayStrategySingle,
+			want: map[string]string{
+				"subnet-2": "nat-1",
+				"subnet-4": "nat-1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope := natGatewayStrategyTestScope(g, tc.strategy, subnets, true)
+			s := NewService(clusterScope)
+
+			for id, want := range tc.want {
+				got, err := s.getNatGatewayForSubnet(clusterScope.Subnets().FindByID(id))
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(got).To(Equal(want), "subnet %s", id)
+			}
+		})
+	}
+}
+
+func TestDeleteUnusedNatGateways(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	subnets := func() []infrav1.SubnetSpec {
+		return []infrav1.SubnetSpec{
+			{ID: "subnet-1", AvailabilityZone: "us-east-1a", IsPublic: true, NatGatewayID: aws.String("nat-1")},
+			{ID: "subnet-2", AvailabilityZone: "us-east-1a", IsPublic: false},
+			{ID: "subnet-3", AvailabilityZone: "us-east-1b", IsPublic: true, NatGatewayID: aws.String("nat-3")},
+			{ID: "subnet-4", AvailabilityZone: "us-east-1b", IsPublic: false},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		strategy infrav1.NatGatewayStrategy
+		expect   func(m *mocks.MockEC2APIMockRecorder)
+		want     map[string]*string
+	}{
+		{
+			name:     "per zone strategy, should not delete any NAT gateway",
+			strategy: infrav1.NatGatewayStrategyPerZone,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).Times(0)
+				m.DeleteNatGatewayWithContext(context.TODO(), gomock.Any()).Times(0)
+			},
+			want: map[string]*string{
+				"subnet-1": aws.String("nat-1"),
+				"subnet-3": aws.String("nat-3"),
+			},
+		},
+		{
+			name:     "switching from per zone to single strategy, should delete the NAT gateways which aren't used anymore",
+			strategy: infrav1.NatGatewayStrategySingle,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+					Do(func(ctx context.Context, _, y interface{}, requestOptions ...request.Option) {
+						funct := y.(func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool)
+						funct(&ec2.DescribeNatGatewaysOutput{NatGateways: []*ec2.NatGateway{
+							{NatGatewayId: aws.String("nat-1"), SubnetId: aws.String("subnet-1")},
+							{
+								NatGatewayId:        aws.String("nat-3"),
+								SubnetId:            aws.String("subnet-3"),
+								NatGatewayAddresses: []*ec2.NatGatewayAddress{{AllocationId: aws.String("eipalloc-3")}},
+							},
+						}}, true)
+					}).Return(nil)
+				gomock.InOrder(
+					m.DeleteNatGatewayWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNatGatewayInput{
+						NatGatewayId: aws.String("nat-3"),
+					})).Return(&ec2.DeleteNatGatewayOutput{}, nil),
+					m.DescribeNatGatewaysWithContext(context.TODO(), gomock.Eq(&ec2.DescribeNatGatewaysInput{
+						NatGatewayIds: []*string{aws.String("nat-3")},
+					})).Return(&ec2.DescribeNatGatewaysOutput{
+						NatGateways: []*ec2.NatGateway{
+							{
+								State: aws.String("deleted"),
+							},
+						},
+					}, nil),
+					m.DescribeAddressesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeAddressesInput{
+						AllocationIds: aws.StringSlice([]string{"eipalloc-3"}),
+						Filters: []*ec2.Filter{
+							{Name: aws.String("tag-key"), Values: aws.StringSlice([]string{"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"})},
+						},
+					})).Return(&ec2.DescribeAddressesOutput{
+						Addresses: []*ec2.Address{{AllocationId: aws.String("eipalloc-3"), PublicIp: aws.String("10.0.0.3")}},
+					}, nil),
+					m.ReleaseAddressWithContext(context.TODO(), gomock.Eq(&ec2.ReleaseAddressInput{
+						AllocationId: aws.String("eipalloc-3"),
+					})).Return(&ec2.ReleaseAddressOutput{}, nil),
+				)
+			},
+			want: map[string]*string{
+				"subnet-1": aws.String("nat-1"),
+				"subnet-3": nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			clusterScope := natGatewayStrategyTestScope(g, tc.strategy, subnets(), true)
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.deleteUnusedNatGateways()).To(Succeed())
+			for id, want := range tc.want {
+				g.Expect(clusterScope.Subnets().FindByID(id).NatGatewayID).To(Equal(want), "subnet %s", id)
+			}
+		})
+	}
+}
+
+func natGatewayStrategyTestScope(g *WithT, strategy infrav1.NatGatewayStrategy, subnets []infrav1.SubnetSpec, useBlockingWaiters bool) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: subnetsVPCID,
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets:            subnets,
+				NatGatewayStrategy: strategy,
+			},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).WithStatusSubresource(awsCluster).Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster:         awsCluster,
+		Client:             client,
+		UseBlockingWaiters: useBlockingWaiters,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	return clusterScope
+}
@@ -17,10 +17,13 @@ limitations under the License.
 package network
 
 import (
+	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	infrautilconditions "sigs.k8s.io/cluster-api-provider-aws/v2/util/conditions"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -28,6 +31,13 @@ import (
 
 // ReconcileNetwork reconciles the network of the given cluster.
 func (s *Service) ReconcileNetwork() (err error) {
+	if annotations.Has(s.scope.InfraCluster(), infrav1.PauseNetworkAnnotation) {
+		s.scope.Info("Reconciliation of the network is paused", "annotation", infrav1.PauseNetworkAnnotation)
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.NetworkPausedCondition)
+		return nil
+	}
+	conditions.Delete(s.scope.InfraCluster(), infrav1.NetworkPausedCondition)
+
 	s.scope.Debug("Reconciling network for cluster", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
 
 	// VPC.
@@ -63,7 +73,16 @@ func (s *Service) ReconcileNetwork() (err error) {
 
 	// NAT Gateways.
 	if err := s.reconcileNatGateways(); err != nil {
-		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition, infrav1.NatGatewaysReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
+		// The condition of NAT gateways which aren't available yet has already been set.
+		if !wait.IsPending(err) {
+			conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition, infrav1.NatGatewaysReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
+		}
+		return err
+	}
+
+	// Transit Gateway Attachment.
+	if err := s.reconcileTransitGatewayAttachment(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.TransitGatewayAttachmentReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
 		return err
 	}
 
@@ -73,16 +92,81 @@ func (s *Service) ReconcileNetwork() (err error) {
 		return err
 	}
 
+	// NAT gateways which aren't used by the route tables anymore.
+	if err := s.deleteUnusedNatGateways(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition, infrav1.NatGatewaysReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
+		return err
+	}
+
+	// Network ACL.
+	if err := s.reconcileNetworkACL(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NetworkACLReadyCondition, infrav1.NetworkACLReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
+		return err
+	}
+
+	// Flow logs.
+	if err := s.reconcileFlowLogs(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.FlowLogsReadyCondition, infrav1.FlowLogsReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
+		return err
+	}
+
+	// VPC Peerings.
+	if err := s.reconcileVPCPeerings(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcPeeringsReadyCondition, infrav1.VpcPeeringsReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
+		return err
+	}
+
 	// VPC Endpoints.
 	if err := s.reconcileVPCEndpoints(); err != nil {
 		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition, infrav1.VpcEndpointsReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
 		return err
 	}
 
+	// Karpenter discovery tags.
+	if err := s.reconcileKarpenterDiscoveryTags(); err != nil {
+		return err
+	}
+
 	s.scope.Debug("Reconcile network completed successfully")
 	return nil
 }
 
+// DiscoverNetwork fills in the VPC and subnets whose IDs are set in the network spec from their state in AWS, without
+// changing them. It is used for the network of externally managed clusters.
+func (s *Service) DiscoverNetwork() error {
+	s.scope.Debug("Discovering network for cluster", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
+
+	vpc, err := s.describeVPCByID()
+	if err != nil {
+		return errors.Wrap(err, "failed to describe VPC")
+	}
+	s.scope.VPC().CidrBlock = vpc.CidrBlock
+	s.scope.VPC().Tags = vpc.Tags
+	if vpc.IPv6 != nil {
+		if s.scope.VPC().IPv6 == nil {
+			s.scope.VPC().IPv6 = &infrav1.IPv6{}
+		}
+		s.scope.VPC().IPv6.CidrBlock = vpc.IPv6.CidrBlock
+		s.scope.VPC().IPv6.PoolID = vpc.IPv6.PoolID
+	}
+
+	existing, err := s.describeVpcSubnets()
+	if err != nil {
+		return errors.Wrap(err, "failed to describe subnets")
+	}
+	subnets := make(infrav1.Subnets, 0, len(s.scope.Subnets()))
+	for _, sn := range s.scope.Subnets() {
+		if found := existing.FindByID(sn.GetResourceID()); found != nil {
+			subnets = append(subnets, *found)
+			continue
+		}
+		subnets = append(subnets, sn)
+	}
+	s.scope.SetSubnets(subnets)
+
+	return nil
+}
+
 // DeleteNetwork deletes the network of the given cluster.
 func (s *Service) DeleteNetwork() (err error) {
 	s.scope.Debug("Deleting network")
@@ -170,6 +254,59 @@ func (s *Service) DeleteNetwork() (err error) {
 	}
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.EgressOnlyInternetGatewayReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
 
+	// Transit Gateway Attachments.
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+	if err := s.scope.PatchObject(); err != nil {
+		return err
+	}
+
+	if err := s.deleteTransitGatewayAttachments(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+		return err
+	}
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+
+	// VPC Peerings.
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcPeeringsReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+	if err := s.scope.PatchObject(); err != nil {
+		return err
+	}
+
+	if err := s.deleteVPCPeerings(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcPeeringsReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+		return err
+	}
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcPeeringsReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+
+	// Orphaned network interfaces.
+	if err := s.deleteOrphanedNetworkInterfaces(); err != nil {
+		return err
+	}
+
+	// Flow logs.
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.FlowLogsReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+	if err := s.scope.PatchObject(); err != nil {
+		return err
+	}
+
+	if err := s.deleteFlowLogs(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.FlowLogsReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+		return err
+	}
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.FlowLogsReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+
+	// Network ACL.
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NetworkACLReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+	if err := s.scope.PatchObject(); err != nil {
+		return err
+	}
+
+	if err := s.deleteNetworkACL(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NetworkACLReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+		return err
+	}
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NetworkACLReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+
 	// Subnets.
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.SubnetsReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
 	if err := s.scope.PatchObject(); err != nil {
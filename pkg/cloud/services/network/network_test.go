@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileNetworkPaused(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	clusterScope, err := getClusterScope(&infrav1.VPCSpec{ID: "vpc-exists"}, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	s := NewService(clusterScope)
+	s.EC2Client = ec2Mock
+
+	// No AWS API is called while the network is paused.
+	annotations.Set(clusterScope.AWSCluster, infrav1.PauseNetworkAnnotation, "")
+	g.Expect(s.ReconcileNetwork()).To(Succeed())
+	g.Expect(conditions.IsTrue(clusterScope.AWSCluster, infrav1.NetworkPausedCondition)).To(BeTrue())
+
+	// The network is reconciled again once the annotation is removed.
+	annotations.Delete(clusterScope.AWSCluster, infrav1.PauseNetworkAnnotation)
+	ec2Mock.EXPECT().DescribeVpcsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcsInput{})).
+		Return(nil, errors.New("reconciling the network"))
+	g.Expect(s.ReconcileNetwork()).NotTo(Succeed())
+	g.Expect(conditions.Has(clusterScope.AWSCluster, infrav1.NetworkPausedCondition)).To(BeFalse())
+}
+
+func TestDiscoverNetwork(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	clusterScope, err := getClusterScope(&infrav1.VPCSpec{ID: "vpc-adopted"}, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	clusterScope.SetSubnets(infrav1.Subnets{{ID: "subnet-private"}, {ID: "subnet-public"}})
+	s := NewService(clusterScope)
+	s.EC2Client = ec2Mock
+
+	// The VPC and subnets are only described.
+	ec2Mock.EXPECT().DescribeVpcsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcsInput{})).
+		Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-adopted"), CidrBlock: aws.String("10.0.0.0/16"), State: aws.String(ec2.VpcStateAvailable)}},
+		}, nil)
+	ec2Mock.EXPECT().DescribeSubnetsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSubnetsInput{})).
+		Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []*ec2.Subnet{
+				{SubnetId: aws.String("subnet-private"), AvailabilityZone: aws.String("us-east-1a"), CidrBlock: aws.String("10.0.0.0/24")},
+				{SubnetId: aws.String("subnet-public"), AvailabilityZone: aws.String("us-east-1b"), CidrBlock: aws.String("10.0.1.0/24")},
+				{SubnetId: aws.String("subnet-other"), AvailabilityZone: aws.String("us-east-1c"), CidrBlock: aws.String("10.0.2.0/24")},
+			},
+		}, nil)
+	ec2Mock.EXPECT().DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+		Return(&ec2.DescribeRouteTablesOutput{
+			RouteTables: []*ec2.RouteTable{{
+				RouteTableId: aws.String("rtb-public"),
+				Associations: []*ec2.RouteTableAssociation{{SubnetId: aws.String("subnet-public")}},
+				Routes:       []*ec2.Route{{GatewayId: aws.String("igw-1")}},
+			}},
+		}, nil)
+	ec2Mock.EXPECT().DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNatGatewaysInput{}), gomock.Any()).
+		Return(nil)
+
+	g.Expect(s.DiscoverNetwork()).To(Succeed())
+	g.Expect(clusterScope.VPC().CidrBlock).To(Equal("10.0.0.0/16"))
+	g.Expect(clusterScope.Subnets().IDs()).To(ConsistOf("subnet-private", "subnet-public"))
+	g.Expect(clusterScope.Subnets().FindByID("subnet-private").AvailabilityZone).To(Equal("us-east-1a"))
+	g.Expect(clusterScope.Subnets().FilterPublic().IDs()).To(ConsistOf("subnet-public"))
+}
+
+func TestDeleteNetworkDeletesTransitGatewayAttachmentBeforeSubnets(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	m := ec2Mock.EXPECT()
+
+	clusterScope, err := getClusterScope(&transitGatewayAttachmentsVPC, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	clusterScope.AWSCluster.Spec.NetworkSpec.TransitGatewayAttachment = &infrav1.TransitGatewayAttachmentSpec{
+		TransitGatewayID: "tgw-01",
+	}
+	s := NewService(clusterScope)
+	s.EC2Client = ec2Mock
+
+	m.DescribeVpcsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcsInput{})).
+		Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []*ec2.Vpc{{
+				VpcId:     aws.String("vpc-tgw"),
+				CidrBlock: aws.String("10.0.0.0/16"),
+				State:     aws.String(ec2.VpcStateAvailable),
+				Tags:      []*ec2.Tag{{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")}},
+			}},
+		}, nil).AnyTimes()
+	m.DescribeVpcEndpointsPages(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	m.DescribeRouteTablesWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeRouteTablesOutput{}, nil).AnyTimes()
+	m.DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	m.DescribeAddressesWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeAddressesOutput{}, nil).AnyTimes()
+	m.DescribeInternetGatewaysWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeInternetGatewaysOutput{}, nil).AnyTimes()
+	m.DescribeEgressOnlyInternetGatewaysWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeEgressOnlyInternetGatewaysOutput{}, nil).AnyTimes()
+	m.DescribeNetworkInterfacesPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	m.DescribeNetworkAclsWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeNetworkAclsOutput{}, nil).AnyTimes()
+
+	// The attachment holds network interfaces in the subnets, so it is gone before the subnets are deleted.
+	gomock.InOrder(
+		describeClusterTransitGatewayAttachments(m, "", &ec2.TransitGatewayVpcAttachment{
+			TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+			State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+		}),
+		m.DeleteTransitGatewayVpcAttachmentWithContext(context.TODO(), gomock.Eq(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+			TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+		})).Return(&ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil),
+		describeTransitGatewayAttachmentState(m, ec2.TransitGatewayAttachmentStateDeleted),
+		m.DescribeSubnetsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSubnetsInput{})).
+			Return(&ec2.DescribeSubnetsOutput{
+				Subnets: []*ec2.Subnet{{SubnetId: aws.String("subnet-private-1a"), VpcId: aws.String("vpc-tgw")}},
+			}, nil),
+		m.DeleteSubnetWithContext(context.TODO(), gomock.Eq(&ec2.DeleteSubnetInput{SubnetId: aws.String("subnet-private-1a")})).
+			Return(&ec2.DeleteSubnetOutput{}, nil),
+		m.DeleteVpcWithContext(context.TODO(), gomock.Eq(&ec2.DeleteVpcInput{VpcId: aws.String("vpc-tgw")})).
+			Return(&ec2.DeleteVpcOutput{}, nil),
+	)
+
+	g.Expect(s.DeleteNetwork()).To(Succeed())
+}
@@ -0,0 +1,390 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// networkACLDefaultRuleNumber is the number of the rule that denies all the IPv4 traffic matching no other rule.
+	// Every network ACL has it, and it can't be modified nor deleted.
+	networkACLDefaultRuleNumber = 32767
+	// networkACLIPv6DefaultRuleNumber is the number of the rule that denies all the IPv6 traffic matching no other
+	// rule. Network ACLs of VPCs with an IPv6 CIDR block have it, and it can't be modified nor deleted either.
+	networkACLIPv6DefaultRuleNumber = 32768
+)
+
+// networkACLEntryKey identifies a rule of a network ACL.
+type networkACLEntryKey struct {
+	egress     bool
+	ruleNumber int64
+}
+
+func (s *Service) reconcileNetworkACL() error {
+	spec := s.scope.NetworkACL()
+	if spec == nil {
+		// The network ACL was removed from the spec: revert the subnets to the default network ACL of the VPC
+		// and delete the managed one. The condition is only set once a network ACL was reconciled, which avoids
+		// describing the network ACLs of every cluster that never had one.
+		if !conditions.Has(s.scope.InfraCluster(), infrav1.NetworkACLReadyCondition) {
+			return nil
+		}
+		if err := s.deleteNetworkACL(); err != nil {
+			return err
+		}
+		conditions.Delete(s.scope.InfraCluster(), infrav1.NetworkACLReadyCondition)
+		return nil
+	}
+
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping network ACL reconcile in unmanaged mode")
+		return nil
+	}
+
+	s.scope.Debug("Reconciling network ACL")
+
+	nacls, err := s.describeVpcNetworkACLs()
+	if err != nil {
+		return err
+	}
+
+	nacl := s.findManagedNetworkACL(nacls)
+	if nacl == nil {
+		if nacl, err = s.createNetworkACL(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.reconcileNetworkACLEntries(nacl, spec); err != nil {
+		return err
+	}
+
+	if err := s.reconcileNetworkACLAssociations(nacl, nacls); err != nil {
+		return err
+	}
+
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.NetworkACLReadyCondition)
+	return nil
+}
+
+// reconcileNetworkACLEntries creates, replaces and deletes the rules of the network ACL to match the spec.
+// Rules are created in ascending order of their numbers.
+func (s *Service) reconcileNetworkACLEntries(nacl *ec2.NetworkAcl, spec *infrav1.NetworkACLSpec) error {
+	current := make(map[networkACLEntryKey]*ec2.NetworkAclEntry, len(nacl.Entries))
+	for _, entry := range nacl.Entries {
+		switch aws.Int64Value(entry.RuleNumber) {
+		case networkACLDefaultRuleNumber, networkACLIPv6DefaultRuleNumber:
+			continue
+		}
+		current[networkACLEntryKey{egress: aws.BoolValue(entry.Egress), ruleNumber: aws.Int64Value(entry.RuleNumber)}] = entry
+	}
+
+	desired := make(map[networkACLEntryKey]struct{}, len(spec.Ingress)+len(spec.Egress))
+	for _, egress := range []bool{false, true} {
+		entries := spec.Ingress
+		if egress {
+			entries = spec.Egress
+		}
+		entries = append([]infrav1.NetworkACLEntry(nil), entries...)
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].RuleNumber < entries[j].RuleNumber
+		})
+
+		for i := range entries {
+			entry := getNetworkACLEntry(&entries[i], egress)
+			key := networkACLEntryKey{egress: egress, ruleNumber: entries[i].RuleNumber}
+			desired[key] = struct{}{}
+
+			currentEntry, ok := current[key]
+			switch {
+			case !ok:
+				if err := s.createNetworkACLEntry(nacl, entry); err != nil {
+					return err
+				}
+			case !networkACLEntriesEqual(currentEntry, entry):
+				if err := s.replaceNetworkACLEntry(nacl, entry); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for key := range current {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if _, err := s.EC2Client.DeleteNetworkAclEntryWithContext(context.TODO(), &ec2.DeleteNetworkAclEntryInput{
+			NetworkAclId: nacl.NetworkAclId,
+			Egress:       aws.Bool(key.egress),
+			RuleNumber:   aws.Int64(key.ruleNumber),
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteNetworkACLEntry", "Failed to delete rule %d from managed NetworkACL %q: %v", key.ruleNumber, *nacl.NetworkAclId, err)
+			return errors.Wrapf(err, "failed to delete rule %d from network acl %q", key.ruleNumber, *nacl.NetworkAclId)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteNetworkACLEntry", "Deleted rule %d from managed NetworkACL %q", key.ruleNumber, *nacl.NetworkAclId)
+	}
+
+	return nil
+}
+
+func (s *Service) createNetworkACLEntry(nacl *ec2.NetworkAcl, entry *ec2.NetworkAclEntry) error {
+	if _, err := s.EC2Client.CreateNetworkAclEntryWithContext(context.TODO(), &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId:  nacl.NetworkAclId,
+		Egress:        entry.Egress,
+		RuleNumber:    entry.RuleNumber,
+		Protocol:      entry.Protocol,
+		RuleAction:    entry.RuleAction,
+		CidrBlock:     entry.CidrBlock,
+		Ipv6CidrBlock: entry.Ipv6CidrBlock,
+		PortRange:     entry.PortRange,
+		IcmpTypeCode:  entry.IcmpTypeCode,
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateNetworkACLEntry", "Failed to create rule %d for managed NetworkACL %q: %v", *entry.RuleNumber, *nacl.NetworkAclId, err)
+		return errors.Wrapf(err, "failed to create rule %d in network acl %q", *entry.RuleNumber, *nacl.NetworkAclId)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateNetworkACLEntry", "Created rule %d for managed NetworkACL %q", *entry.RuleNumber, *nacl.NetworkAclId)
+	return nil
+}
+
+func (s *Service) replaceNetworkACLEntry(nacl *ec2.NetworkAcl, entry *ec2.NetworkAclEntry) error {
+	if _, err := s.EC2Client.ReplaceNetworkAclEntryWithContext(context.TODO(), &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId:  nacl.NetworkAclId,
+		Egress:        entry.Egress,
+		RuleNumber:    entry.RuleNumber,
+		Protocol:      entry.Protocol,
+		RuleAction:    entry.RuleAction,
+		CidrBlock:     entry.CidrBlock,
+		Ipv6CidrBlock: entry.Ipv6CidrBlock,
+		PortRange:     entry.PortRange,
+		IcmpTypeCode:  entry.IcmpTypeCode,
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedReplaceNetworkACLEntry", "Failed to replace outdated rule %d on managed NetworkACL %q: %v", *entry.RuleNumber, *nacl.NetworkAclId, err)
+		return errors.Wrapf(err, "failed to replace outdated rule %d on network acl %q", *entry.RuleNumber, *nacl.NetworkAclId)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulReplaceNetworkACLEntry", "Replaced rule %d for managed NetworkACL %q", *entry.RuleNumber, *nacl.NetworkAclId)
+	return nil
+}
+
+// reconcileNetworkACLAssociations associates the managed subnets with the network ACL. As a subnet is always
+// associated with exactly one network ACL, this replaces its association with the default network ACL.
+func (s *Service) reconcileNetworkACLAssociations(nacl *ec2.NetworkAcl, nacls []*ec2.NetworkAcl) error {
+	associations := make(map[string]*ec2.NetworkAclAssociation)
+	for _, n := range nacls {
+		for _, as := range n.Associations {
+			if as.SubnetId != nil {
+				associations[*as.SubnetId] = as
+			}
+		}
+	}
+
+	for _, sn := range s.scope.Subnets() {
+		subnetID := sn.GetResourceID()
+		if subnetID == "" {
+			continue
+		}
+		as, ok := associations[subnetID]
+		if !ok {
+			return errors.Errorf("failed to find the network acl association of subnet %q", subnetID)
+		}
+		if aws.StringValue(as.NetworkAclId) == *nacl.NetworkAclId {
+			continue
+		}
+		if err := s.replaceNetworkACLAssociation(as, *nacl.NetworkAclId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) replaceNetworkACLAssociation(as *ec2.NetworkAclAssociation, naclID string) error {
+	if _, err := s.EC2Client.ReplaceNetworkAclAssociationWithContext(context.TODO(), &ec2.ReplaceNetworkAclAssociationInput{
+		AssociationId: as.NetworkAclAssociationId,
+		NetworkAclId:  aws.String(naclID),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAssociateNetworkACL", "Failed to associate NetworkACL %q with Subnet %q: %v", naclID, aws.StringValue(as.SubnetId), err)
+		return errors.Wrapf(err, "failed to associate network acl %q to subnet %q", naclID, aws.StringValue(as.SubnetId))
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAssociateNetworkACL", "Associated NetworkACL %q with subnet %q", naclID, aws.StringValue(as.SubnetId))
+	return nil
+}
+
+func (s *Service) deleteNetworkACL() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping network ACL deletion in unmanaged mode")
+		return nil
+	}
+
+	nacls, err := s.describeVpcNetworkACLs()
+	if err != nil {
+		return err
+	}
+
+	nacl := s.findManagedNetworkACL(nacls)
+	if nacl == nil {
+		return nil
+	}
+
+	// A network ACL can only be deleted once no subnet is associated with it anymore,
+	// so the subnets are reverted to the default network ACL of the VPC first.
+	if len(nacl.Associations) > 0 {
+		var defaultNACL *ec2.NetworkAcl
+		for _, n := range nacls {
+			if aws.BoolValue(n.IsDefault) {
+				defaultNACL = n
+				break
+			}
+		}
+		if defaultNACL == nil {
+			return errors.Errorf("failed to find the default network acl of vpc %q", s.scope.VPC().ID)
+		}
+		for _, as := range nacl.Associations {
+			if err := s.replaceNetworkACLAssociation(as, *defaultNACL.NetworkAclId); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := s.EC2Client.DeleteNetworkAclWithContext(context.TODO(), &ec2.DeleteNetworkAclInput{NetworkAclId: nacl.NetworkAclId}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteNetworkACL", "Failed to delete managed NetworkACL %q: %v", *nacl.NetworkAclId, err)
+		return errors.Wrapf(err, "failed to delete network acl %q", *nacl.NetworkAclId)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteNetworkACL", "Deleted managed NetworkACL %q", *nacl.NetworkAclId)
+	s.scope.Info("Deleted network ACL", "network-acl-id", *nacl.NetworkAclId)
+
+	return nil
+}
+
+// describeVpcNetworkACLs returns all the network ACLs of the VPC, including the default one.
+func (s *Service) describeVpcNetworkACLs() ([]*ec2.NetworkAcl, error) {
+	out, err := s.EC2Client.DescribeNetworkAclsWithContext(context.TODO(), &ec2.DescribeNetworkAclsInput{
+		Filters: []*ec2.Filter{filter.EC2.VPC(s.scope.VPC().ID)},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeNetworkACL", "Failed to describe network ACLs in vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe network acls in vpc %q", s.scope.VPC().ID)
+	}
+	return out.NetworkAcls, nil
+}
+
+// findManagedNetworkACL returns the network ACL created by the provider for the cluster, if any.
+func (s *Service) findManagedNetworkACL(nacls []*ec2.NetworkAcl) *ec2.NetworkAcl {
+	for _, nacl := range nacls {
+		if converters.TagsToMap(nacl.Tags).HasOwned(s.scope.Name()) {
+			return nacl
+		}
+	}
+	return nil
+}
+
+func (s *Service) createNetworkACL() (*ec2.NetworkAcl, error) {
+	out, err := s.EC2Client.CreateNetworkAclWithContext(context.TODO(), &ec2.CreateNetworkAclInput{
+		VpcId: aws.String(s.scope.VPC().ID),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeNetworkAcl, s.getNetworkACLTagParams(services.TemporaryResourceID)),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateNetworkACL", "Failed to create managed NetworkACL: %v", err)
+		return nil, errors.Wrapf(err, "failed to create network acl in vpc %q", s.scope.VPC().ID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateNetworkACL", "Created managed NetworkACL %q", *out.NetworkAcl.NetworkAclId)
+	s.scope.Info("Created network ACL", "network-acl-id", *out.NetworkAcl.NetworkAclId)
+
+	return out.NetworkAcl, nil
+}
+
+func (s *Service) getNetworkACLTagParams(id string) infrav1.BuildParams {
+	name := fmt.Sprintf("%s-nacl", s.scope.Name())
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  id,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+func getNetworkACLEntry(spec *infrav1.NetworkACLEntry, egress bool) *ec2.NetworkAclEntry {
+	entry := &ec2.NetworkAclEntry{
+		Egress:     aws.Bool(egress),
+		RuleNumber: aws.Int64(spec.RuleNumber),
+		Protocol:   aws.String(networkACLProtocolNumber(spec.Protocol, spec.IPv6CidrBlock != "")),
+		RuleAction: aws.String(string(spec.RuleAction)),
+	}
+	if spec.IPv6CidrBlock != "" {
+		entry.Ipv6CidrBlock = aws.String(spec.IPv6CidrBlock)
+	} else {
+		entry.CidrBlock = aws.String(spec.CidrBlock)
+	}
+	switch spec.Protocol {
+	case infrav1.NetworkACLProtocolTCP, infrav1.NetworkACLProtocolUDP:
+		entry.PortRange = &ec2.PortRange{From: spec.FromPort, To: spec.ToPort}
+	case infrav1.NetworkACLProtocolICMP:
+		entry.IcmpTypeCode = &ec2.IcmpTypeCode{Type: aws.Int64(-1), Code: aws.Int64(-1)}
+	}
+	return entry
+}
+
+// networkACLProtocolNumber returns the protocol number used by the EC2 API for a network ACL protocol.
+// The rules matching IPv6 traffic use ICMPv6 for the icmp protocol.
+func networkACLProtocolNumber(protocol infrav1.NetworkACLProtocol, ipv6 bool) string {
+	switch protocol {
+	case infrav1.NetworkACLProtocolTCP:
+		return "6"
+	case infrav1.NetworkACLProtocolUDP:
+		return "17"
+	case infrav1.NetworkACLProtocolICMP:
+		if ipv6 {
+			return "58"
+		}
+		return "1"
+	default:
+		return "-1"
+	}
+}
+
+func networkACLEntriesEqual(a, b *ec2.NetworkAclEntry) bool {
+	if aws.StringValue(a.Protocol) != aws.StringValue(b.Protocol) ||
+		aws.StringValue(a.RuleAction) != aws.StringValue(b.RuleAction) ||
+		aws.StringValue(a.CidrBlock) != aws.StringValue(b.CidrBlock) ||
+		aws.StringValue(a.Ipv6CidrBlock) != aws.StringValue(b.Ipv6CidrBlock) {
+		return false
+	}
+	if a.PortRange == nil || b.PortRange == nil {
+		return a.PortRange == nil && b.PortRange == nil
+	}
+	return aws.Int64Value(a.PortRange.From) == aws.Int64Value(b.PortRange.From) &&
+		aws.Int64Value(a.PortRange.To) == aws.Int64Value(b.PortRange.To)
+}
@@ -0,0 +1,434 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileNetworkACL(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	managedVPC := infrav1.VPCSpec{
+		ID: "vpc-nacl",
+		Tags: infrav1.Tags{
+			infrav1.ClusterTagKey("test-cluster"): "owned",
+		},
+	}
+	subnets := infrav1.Subnets{
+		{ID: "subnet-private", ResourceID: "subnet-private", AvailabilityZone: "us-east-1a"},
+		{ID: "subnet-public", ResourceID: "subnet-public", AvailabilityZone: "us-east-1a", IsPublic: true},
+	}
+	managedNACLTags := []*ec2.Tag{
+		{
+			Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+			Value: aws.String("owned"),
+		},
+	}
+	defaultNACL := &ec2.NetworkAcl{
+		NetworkAclId: aws.String("acl-default"),
+		IsDefault:    aws.Bool(true),
+		Associations: []*ec2.NetworkAclAssociation{
+			{NetworkAclAssociationId: aws.String("aclassoc-private"), NetworkAclId: aws.String("acl-default"), SubnetId: aws.String("subnet-private")},
+			{NetworkAclAssociationId: aws.String("aclassoc-public"), NetworkAclId: aws.String("acl-default"), SubnetId: aws.String("subnet-public")},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		input      infrav1.NetworkSpec
+		conditions clusterv1.Conditions
+		expect     func(m *mocks.MockEC2APIMockRecorder)
+		wantErr    bool
+	}{
+		{
+			name: "does nothing without a network acl",
+			input: infrav1.NetworkSpec{
+				VPC:     managedVPC,
+				Subnets: subnets,
+			},
+		},
+		{
+			name: "reverts the subnets to the default network acl and deletes the network acl removed from the spec",
+			input: infrav1.NetworkSpec{
+				VPC:     managedVPC,
+				Subnets: subnets,
+			},
+			conditions: clusterv1.Conditions{{Type: infrav1.NetworkACLReadyCondition, Status: corev1.ConditionTrue}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					m.DescribeNetworkAclsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNetworkAclsInput{})).
+						Return(&ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{
+							{NetworkAclId: aws.String("acl-default"), IsDefault: aws.Bool(true)},
+							{
+								NetworkAclId: aws.String("acl-managed"),
+								Tags:         managedNACLTags,
+								Associations: []*ec2.NetworkAclAssociation{
+									{NetworkAclAssociationId: aws.String("aclassoc-private"), NetworkAclId: aws.String("acl-managed"), SubnetId: aws.String("subnet-private")},
+									{NetworkAclAssociationId: aws.String("aclassoc-public"), NetworkAclId: aws.String("acl-managed"), SubnetId: aws.String("subnet-public")},
+								},
+							},
+						}}, nil),
+					m.ReplaceNetworkAclAssociationWithContext(context.TODO(), gomock.Eq(&ec2.ReplaceNetworkAclAssociationInput{
+						AssociationId: aws.String("aclassoc-private"),
+						NetworkAclId:  aws.String("acl-default"),
+					})).Return(&ec2.ReplaceNetworkAclAssociationOutput{}, nil),
+					m.ReplaceNetworkAclAssociationWithContext(context.TODO(), gomock.Eq(&ec2.ReplaceNetworkAclAssociationInput{
+						AssociationId: aws.String("aclassoc-public"),
+						NetworkAclId:  aws.String("acl-default"),
+					})).Return(&ec2.ReplaceNetworkAclAssociationOutput{}, nil),
+					m.DeleteNetworkAclWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNetworkAclInput{
+						NetworkAclId: aws.String("acl-managed"),
+					})).Return(&ec2.DeleteNetworkAclOutput{}, nil),
+				)
+			},
+		},
+		{
+			name: "does nothing in an unmanaged vpc",
+			input: infrav1.NetworkSpec{
+				VPC:        infrav1.VPCSpec{ID: "vpc-nacl"},
+				Subnets:    subnets,
+				NetworkACL: &infrav1.NetworkACLSpec{},
+			},
+		},
+		{
+			name: "creates the network acl, its rules in ascending order, and associates the subnets",
+			input: infrav1.NetworkSpec{
+				VPC:     managedVPC,
+				Subnets: subnets,
+				NetworkACL: &infrav1.NetworkACLSpec{
+					Ingress: []infrav1.NetworkACLEntry{
+						{RuleNumber: 200, Protocol: infrav1.NetworkACLProtocolAll, RuleAction: infrav1.NetworkACLRuleActionDeny, CidrBlock: "0.0.0.0/0"},
+						{RuleNumber: 100, Protocol: infrav1.NetworkACLProtocolTCP, RuleAction: infrav1.NetworkACLRuleActionAllow, CidrBlock: "10.0.0.0/16", FromPort: aws.Int64(443), ToPort: aws.Int64(443)},
+					},
+					Egress: []infrav1.NetworkACLEntry{
+						{RuleNumber: 100, Protocol: infrav1.NetworkACLProtocolICMP, RuleAction: infrav1.NetworkACLRuleActionAllow, CidrBlock: "0.0.0.0/0"},
+						{RuleNumber: 101, Protocol: infrav1.NetworkACLProtocolICMP, RuleAction: infrav1.NetworkACLRuleActionAllow, IPv6CidrBlock: "::/0"},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					m.DescribeNetworkAclsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeNetworkAclsInput{
+						Filters: []*ec2.Filter{{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{"vpc-nacl"})}},
+					})).Return(&ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{defaultNACL}}, nil),
+					m.CreateNetworkAclWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateNetworkAclInput{})).
+						Return(&ec2.CreateNetworkAclOutput{NetworkAcl: &ec2.NetworkAcl{NetworkAclId: aws.String("acl-managed")}}, nil),
+					m.CreateNetworkAclEntryWithContext(context.TODO(), gomock.Eq(&ec2.CreateNetworkAclEntryInput{
+						NetworkAclId: aws.String("acl-managed"),
+						Egress:       aws.Bool(false),
+						RuleNumber:   aws.Int64(100),
+						Protocol:     aws.String("6"),
+						RuleAction:   aws.String("allow"),
+						CidrBlock:    aws.String("10.0.0.0/16"),
+						PortRange:    &ec2.PortRange{From: aws.Int64(443), To: aws.Int64(443)},
+					})).Return(&ec2.CreateNetworkAclEntryOutput{}, nil),
+					m.CreateNetworkAclEntryWithContext(context.TODO(), gomock.Eq(&ec2.CreateNetworkAclEntryInput{
+						NetworkAclId: aws.String("acl-managed"),
+						Egress:       aws.Bool(false),
+						RuleNumber:   aws.Int64(200),
+						Protocol:     aws.String("-1"),
+						RuleAction:   aws.String("deny"),
+						CidrBlock:    aws.String("0.0.0.0/0"),
+					})).Return(&ec2.CreateNetworkAclEntryOutput{}, nil),
+					m.CreateNetworkAclEntryWithContext(context.TODO(), gomock.Eq(&ec2.CreateNetworkAclEntryInput{
+						NetworkAclId: aws.String("acl-managed"),
+						Egress:       aws.Bool(true),
+						RuleNumber:   aws.Int64(100),
+						Protocol:     aws.String("1"),
+						RuleAction:   aws.String("allow"),
+						CidrBlock:    aws.String("0.0.0.0/0"),
+						IcmpTypeCode: &ec2.IcmpTypeCode{Type: aws.Int64(-1), Code: aws.Int64(-1)},
+					})).Return(&ec2.CreateNetworkAclEntryOutput{}, nil),
+					m.CreateNetworkAclEntryWithContext(context.TODO(), gomock.Eq(&ec2.CreateNetworkAclEntryInput{
+						NetworkAclId:  aws.String("acl-managed"),
+						Egress:        aws.Bool(true),
+						RuleNumber:    aws.Int64(101),
+						Protocol:      aws.String("58"),
+						RuleAction:    aws.String("allow"),
+						Ipv6CidrBlock: aws.String("::/0"),
+						IcmpTypeCode:  &ec2.IcmpTypeCode{Type: aws.Int64(-1), Code: aws.Int64(-1)},
+					})).Return(&ec2.CreateNetworkAclEntryOutput{}, nil),
+					m.ReplaceNetworkAclAssociationWithContext(context.TODO(), gomock.Eq(&ec2.ReplaceNetworkAclAssociationInput{
+						AssociationId: aws.String("aclassoc-private"),
+						NetworkAclId:  aws.String("acl-managed"),
+					})).Return(&ec2.ReplaceNetworkAclAssociationOutput{}, nil),
+					m.ReplaceNetworkAclAssociationWithContext(context.TODO(), gomock.Eq(&ec2.ReplaceNetworkAclAssociationInput{
+						AssociationId: aws.String("aclassoc-public"),
+						NetworkAclId:  aws.String("acl-managed"),
+					})).Return(&ec2.ReplaceNetworkAclAssociationOutput{}, nil),
+				)
+			},
+		},
+		{
+			name: "replaces outdated rules and deletes rules removed from the spec",
+			input: infrav1.NetworkSpec{
+				VPC:     managedVPC,
+				Subnets: subnets,
+				NetworkACL: &infrav1.NetworkACLSpec{
+					Ingress: []infrav1.NetworkACLEntry{
+						{RuleNumber: 100, Protocol: infrav1.NetworkACLProtocolAll, RuleAction: infrav1.NetworkACLRuleActionAllow, CidrBlock: "10.0.0.0/16"},
+						{RuleNumber: 200, Protocol: infrav1.NetworkACLProtocolUDP, RuleAction: infrav1.NetworkACLRuleActionAllow, CidrBlock: "10.1.0.0/16", FromPort: aws.Int64(53), ToPort: aws.Int64(53)},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAclsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNetworkAclsInput{})).
+					Return(&ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{
+						{NetworkAclId: aws.String("acl-default"), IsDefault: aws.Bool(true)},
+						{
+							NetworkAclId: aws.String("acl-managed"),
+							Tags:         managedNACLTags,
+							Associations: []*ec2.NetworkAclAssociation{
+								{NetworkAclAssociationId: aws.String("aclassoc-private"), NetworkAclId: aws.String("acl-managed"), SubnetId: aws.String("subnet-private")},
+								{NetworkAclAssociationId: aws.String("aclassoc-public"), NetworkAclId: aws.String("acl-managed"), SubnetId: aws.String("subnet-public")},
+							},
+							Entries: []*ec2.NetworkAclEntry{
+								{Egress: aws.Bool(false), RuleNumber: aws.Int64(100), Protocol: aws.String("-1"), RuleAction: aws.String("allow"), CidrBlock: aws.String("10.0.0.0/16")},
+								{Egress: aws.Bool(false), RuleNumber: aws.Int64(200), Protocol: aws.String("17"), RuleAction: aws.String("allow"), CidrBlock: aws.String("10.1.0.0/16"), PortRange: &ec2.PortRange{From: aws.Int64(53), To: aws.Int64(54)}},
+								{Egress: aws.Bool(true), RuleNumber: aws.Int64(100), Protocol: aws.String("-1"), RuleAction: aws.String("allow"), CidrBlock: aws.String("0.0.0.0/0")},
+								{Egress: aws.Bool(false), RuleNumber: aws.Int64(32767), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), CidrBlock: aws.String("0.0.0.0/0")},
+								{Egress: aws.Bool(true), RuleNumber: aws.Int64(32767), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), CidrBlock: aws.String("0.0.0.0/0")},
+								{Egress: aws.Bool(false), RuleNumber: aws.Int64(32768), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), Ipv6CidrBlock: aws.String("::/0")},
+								{Egress: aws.Bool(true), RuleNumber: aws.Int64(32768), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), Ipv6CidrBlock: aws.String("::/0")},
+							},
+						},
+					}}, nil)
+				m.ReplaceNetworkAclEntryWithContext(context.TODO(), gomock.Eq(&ec2.ReplaceNetworkAclEntryInput{
+					NetworkAclId: aws.String("acl-managed"),
+					Egress:       aws.Bool(false),
+					RuleNumber:   aws.Int64(200),
+					Protocol:     aws.String("17"),
+					RuleAction:   aws.String("allow"),
+					CidrBlock:    aws.String("10.1.0.0/16"),
+					PortRange:    &ec2.PortRange{From: aws.Int64(53), To: aws.Int64(53)},
+				})).Return(&ec2.ReplaceNetworkAclEntryOutput{}, nil)
+				m.DeleteNetworkAclEntryWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNetworkAclEntryInput{
+					NetworkAclId: aws.String("acl-managed"),
+					Egress:       aws.Bool(true),
+					RuleNumber:   aws.Int64(100),
+				})).Return(&ec2.DeleteNetworkAclEntryOutput{}, nil)
+			},
+		},
+		{
+			name: "returns an error when creating a rule fails",
+			input: infrav1.NetworkSpec{
+				VPC:     managedVPC,
+				Subnets: subnets,
+				NetworkACL: &infrav1.NetworkACLSpec{
+					Ingress: []infrav1.NetworkACLEntry{
+						{RuleNumber: 100, Protocol: infrav1.NetworkACLProtocolAll, RuleAction: infrav1.NetworkACLRuleActionAllow, CidrBlock: "10.0.0.0/16"},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAclsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNetworkAclsInput{})).
+					Return(&ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{
+						defaultNACL,
+						{NetworkAclId: aws.String("acl-managed"), Tags: managedNACLTags},
+					}}, nil)
+				m.CreateNetworkAclEntryWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateNetworkAclEntryInput{})).
+					Return(nil, errors.New("NetworkAclEntryLimitExceeded"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: tc.input,
+					},
+					Status: infrav1.AWSClusterStatus{
+						Conditions: tc.conditions,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileNetworkACL()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.input.NetworkACL == nil {
+				g.Expect(conditions.Has(scope.InfraCluster(), infrav1.NetworkACLReadyCondition)).To(BeFalse())
+			}
+		})
+	}
+}
+
+func TestDeleteNetworkACL(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	managedVPC := infrav1.VPCSpec{
+		ID: "vpc-nacl",
+		Tags: infrav1.Tags{
+			infrav1.ClusterTagKey("test-cluster"): "owned",
+		},
+	}
+	managedNACLTags := []*ec2.Tag{
+		{
+			Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+			Value: aws.String("owned"),
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		input   infrav1.NetworkSpec
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name:  "does nothing in an unmanaged vpc",
+			input: infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: "vpc-nacl"}},
+		},
+		{
+			name:  "does nothing without a managed network acl",
+			input: infrav1.NetworkSpec{VPC: managedVPC},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAclsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNetworkAclsInput{})).
+					Return(&ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{
+						{NetworkAclId: aws.String("acl-default"), IsDefault: aws.Bool(true)},
+					}}, nil)
+			},
+		},
+		{
+			name:  "reverts the subnets to the default network acl before deleting the network acl",
+			input: infrav1.NetworkSpec{VPC: managedVPC},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					m.DescribeNetworkAclsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNetworkAclsInput{})).
+						Return(&ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{
+							{NetworkAclId: aws.String("acl-default"), IsDefault: aws.Bool(true)},
+							{
+								NetworkAclId: aws.String("acl-managed"),
+								Tags:         managedNACLTags,
+								Associations: []*ec2.NetworkAclAssociation{
+									{NetworkAclAssociationId: aws.String("aclassoc-private"), NetworkAclId: aws.String("acl-managed"), SubnetId: aws.String("subnet-private")},
+								},
+							},
+						}}, nil),
+					m.ReplaceNetworkAclAssociationWithContext(context.TODO(), gomock.Eq(&ec2.ReplaceNetworkAclAssociationInput{
+						AssociationId: aws.String("aclassoc-private"),
+						NetworkAclId:  aws.String("acl-default"),
+					})).Return(&ec2.ReplaceNetworkAclAssociationOutput{}, nil),
+					m.DeleteNetworkAclWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNetworkAclInput{
+						NetworkAclId: aws.String("acl-managed"),
+					})).Return(&ec2.DeleteNetworkAclOutput{}, nil),
+				)
+			},
+		},
+		{
+			name:  "does not delete the network acl when reverting a subnet fails",
+			input: infrav1.NetworkSpec{VPC: managedVPC},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAclsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNetworkAclsInput{})).
+					Return(&ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{
+						{NetworkAclId: aws.String("acl-default"), IsDefault: aws.Bool(true)},
+						{
+							NetworkAclId: aws.String("acl-managed"),
+							Tags:         managedNACLTags,
+							Associations: []*ec2.NetworkAclAssociation{
+								{NetworkAclAssociationId: aws.String("aclassoc-private"), NetworkAclId: aws.String("acl-managed"), SubnetId: aws.String("subnet-private")},
+							},
+						},
+					}}, nil)
+				m.ReplaceNetworkAclAssociationWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.ReplaceNetworkAclAssociationInput{})).
+					Return(nil, errors.New("InvalidAssociationID.NotFound"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: tc.input,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.deleteNetworkACL()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
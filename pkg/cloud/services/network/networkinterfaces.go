@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// deleteOrphanedNetworkInterfaces deletes the detached network interfaces owned by the cluster,
+// e.g. those left behind by terminated instances, which would otherwise block the deletion of the subnets.
+func (s *Service) deleteOrphanedNetworkInterfaces() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping network interface deletion in unmanaged mode")
+		return nil
+	}
+
+	if s.scope.VPC().ID == "" {
+		s.scope.Trace("Skipping network interface deletion, VPC ID is missing")
+		return nil
+	}
+
+	enis, err := s.describeOrphanedNetworkInterfaces()
+	if err != nil {
+		return err
+	}
+
+	for _, eni := range enis {
+		deleteReq := &ec2.DeleteNetworkInterfaceInput{
+			NetworkInterfaceId: eni.NetworkInterfaceId,
+		}
+
+		if _, err := s.EC2Client.DeleteNetworkInterfaceWithContext(context.TODO(), deleteReq); err != nil {
+			if code, ok := awserrors.Code(err); ok && code == awserrors.NetworkInterfaceNotFound {
+				continue
+			}
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteNetworkInterface", "Failed to delete orphaned Network Interface %q in VPC %q: %v", *eni.NetworkInterfaceId, s.scope.VPC().ID, err)
+			return errors.Wrapf(err, "failed to delete network interface %q", *eni.NetworkInterfaceId)
+		}
+
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteNetworkInterface", "Deleted orphaned Network Interface %q in VPC %q", *eni.NetworkInterfaceId, s.scope.VPC().ID)
+		s.scope.Info("Deleted orphaned network interface in VPC", "network-interface-id", *eni.NetworkInterfaceId, "vpc-id", s.scope.VPC().ID)
+	}
+
+	return nil
+}
+
+// describeOrphanedNetworkInterfaces returns the network interfaces in the cluster VPC
+// that are owned by the cluster and not attached to anything.
+func (s *Service) describeOrphanedNetworkInterfaces() ([]*ec2.NetworkInterface, error) {
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.NetworkInterfaceStatuses(ec2.NetworkInterfaceStatusAvailable),
+		},
+	}
+
+	enis := []*ec2.NetworkInterface{}
+	err := s.EC2Client.DescribeNetworkInterfacesPagesWithContext(context.TODO(), input, func(out *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		for _, eni := range out.NetworkInterfaces {
+			// Only ever delete network interfaces that are owned by the cluster and not in use,
+			// regardless of what the filters returned.
+			if aws.StringValue(eni.Status) != ec2.NetworkInterfaceStatusAvailable || eni.Attachment != nil {
+				continue
+			}
+			if !converters.TagsToMap(eni.TagSet).HasOwned(s.scope.Name()) {
+				continue
+			}
+			enis = append(enis, eni)
+		}
+		return true
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeNetworkInterfaces", "Failed to describe network interfaces in vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe network interfaces in vpc %q", s.scope.VPC().ID)
+	}
+
+	return enis, nil
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestDeleteOrphanedNetworkInterfaces(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	managedVPC := infrav1.VPCSpec{
+		ID: "vpc-enis",
+		Tags: infrav1.Tags{
+			infrav1.ClusterTagKey("test-cluster"): "owned",
+		},
+	}
+	ownedTags := []*ec2.Tag{
+		{
+			Key:   aws.String(infrav1.ClusterTagKey("test-cluster")),
+			Value: aws.String("owned"),
+		},
+	}
+	describeNetworkInterfaces := func(m *mocks.MockEC2APIMockRecorder, enis ...*ec2.NetworkInterface) {
+		m.DescribeNetworkInterfacesPagesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeNetworkInterfacesInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("vpc-id"),
+					Values: aws.StringSlice([]string{"vpc-enis"}),
+				},
+				{
+					Name:   aws.String("tag:" + infrav1.ClusterTagKey("test-cluster")),
+					Values: aws.StringSlice([]string{"owned"}),
+				},
+				{
+					Name:   aws.String("status"),
+					Values: aws.StringSlice([]string{ec2.NetworkInterfaceStatusAvailable}),
+				},
+			},
+		}), gomock.Any()).Do(func(_ context.Context, _ *ec2.DescribeNetworkInterfacesInput, fn func(*ec2.DescribeNetworkInterfacesOutput, bool) bool, _ ...request.Option) {
+			fn(&ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: enis}, true)
+		}).Return(nil)
+	}
+
+	testCases := []struct {
+		name    string
+		input   *infrav1.NetworkSpec
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "Should ignore deletion if vpc is unmanaged",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-enis",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should delete available network interfaces owned by the cluster",
+			input: &infrav1.NetworkSpec{
+				VPC: managedVPC,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeNetworkInterfaces(m, &ec2.NetworkInterface{
+					NetworkInterfaceId: aws.String("eni-0"),
+					Status:             aws.String(ec2.NetworkInterfaceStatusAvailable),
+					TagSet:             ownedTags,
+				}, &ec2.NetworkInterface{
+					NetworkInterfaceId: aws.String("eni-1"),
+					Status:             aws.String(ec2.NetworkInterfaceStatusAvailable),
+					TagSet:             ownedTags,
+				})
+				m.DeleteNetworkInterfaceWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNetworkInterfaceInput{
+					NetworkInterfaceId: aws.String("eni-0"),
+				})).Return(&ec2.DeleteNetworkInterfaceOutput{}, nil)
+				m.DeleteNetworkInterfaceWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNetworkInterfaceInput{
+					NetworkInterfaceId: aws.String("eni-1"),
+				})).Return(&ec2.DeleteNetworkInterfaceOutput{}, nil)
+			},
+		},
+		{
+			name: "Should not delete network interfaces that are in use",
+			input: &infrav1.NetworkSpec{
+				VPC: managedVPC,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeNetworkInterfaces(m, &ec2.NetworkInterface{
+					NetworkInterfaceId: aws.String("eni-in-use"),
+					Status:             aws.String(ec2.NetworkInterfaceStatusInUse),
+					TagSet:             ownedTags,
+				}, &ec2.NetworkInterface{
+					NetworkInterfaceId: aws.String("eni-attached"),
+					Status:             aws.String(ec2.NetworkInterfaceStatusAvailable),
+					Attachment: &ec2.NetworkInterfaceAttachment{
+						InstanceId: aws.String("i-0"),
+					},
+					TagSet: ownedTags,
+				})
+			},
+		},
+		{
+			name: "Should not delete network interfaces that are not owned by the cluster",
+			input: &infrav1.NetworkSpec{
+				VPC: managedVPC,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeNetworkInterfaces(m, &ec2.NetworkInterface{
+					NetworkInterfaceId: aws.String("eni-untagged"),
+					Status:             aws.String(ec2.NetworkInterfaceStatusAvailable),
+				}, &ec2.NetworkInterface{
+					NetworkInterfaceId: aws.String("eni-other-cluster"),
+					Status:             aws.String(ec2.NetworkInterfaceStatusAvailable),
+					TagSet: []*ec2.Tag{
+						{
+							Key:   aws.String(infrav1.ClusterTagKey("other-cluster")),
+							Value: aws.String("owned"),
+						},
+					},
+				}, &ec2.NetworkInterface{
+					NetworkInterfaceId: aws.String("eni-shared"),
+					Status:             aws.String(ec2.NetworkInterfaceStatusAvailable),
+					TagSet: []*ec2.Tag{
+						{
+							Key:   aws.String(infrav1.ClusterTagKey("test-cluster")),
+							Value: aws.String("shared"),
+						},
+					},
+				})
+			},
+		},
+		{
+			name: "Should ignore network interfaces that were already deleted",
+			input: &infrav1.NetworkSpec{
+				VPC: managedVPC,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeNetworkInterfaces(m, &ec2.NetworkInterface{
+					NetworkInterfaceId: aws.String("eni-0"),
+					Status:             aws.String(ec2.NetworkInterfaceStatusAvailable),
+					TagSet:             ownedTags,
+				})
+				m.DeleteNetworkInterfaceWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNetworkInterfaceInput{
+					NetworkInterfaceId: aws.String("eni-0"),
+				})).Return(nil, awserr.New(awserrors.NetworkInterfaceNotFound, "not found", nil))
+			},
+		},
+		{
+			name: "Should return an error if the network interface deletion fails",
+			input: &infrav1.NetworkSpec{
+				VPC: managedVPC,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeNetworkInterfaces(m, &ec2.NetworkInterface{
+					NetworkInterfaceId: aws.String("eni-0"),
+					Status:             aws.String(ec2.NetworkInterfaceStatusAvailable),
+					TagSet:             ownedTags,
+				})
+				m.DeleteNetworkInterfaceWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNetworkInterfaceInput{
+					NetworkInterfaceId: aws.String("eni-0"),
+				})).Return(nil, awserr.New("DependencyViolation", "in use", nil))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: *tc.input,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.deleteOrphanedNetworkInterfaces()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
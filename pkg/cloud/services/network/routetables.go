@@ -18,6 +18,7 @@ package network
 
 import (
 	"context"
+	"net"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -37,6 +38,10 @@ import (
 
 const (
 	mainRouteTableInVPCKey = "main"
+
+	// additionalRouteTagKeyPrefix is the prefix of the route table tags that record the
+	// additional routes created by the provider, keyed by destination CIDR block.
+	additionalRouteTagKeyPrefix = infrav1.NameAWSProviderPrefix + "additional-route/"
 )
 
 func (s *Service) reconcileRouteTables() error {
@@ -55,6 +60,13 @@ func (s *Service) reconcileRouteTables() error {
 	subnets := s.scope.Subnets()
 	for i := range subnets {
 		sn := subnets[i]
+		if sn.ExistingRouteTableID != nil {
+			if err := s.associateExistingRouteTable(&sn, subnetRouteMap); err != nil {
+				return err
+			}
+			continue
+		}
+
 		// We need to compile the minimum routes for this subnet first, so we can compare it or create them.
 		var routes []*ec2.Route
 		if sn.IsPublic {
@@ -102,7 +114,7 @@ func (s *Service) reconcileRouteTables() error {
 			// Make sure tags are up-to-date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getRouteTableTagParams(*rt.RouteTableId, sn.IsPublic, sn.AvailabilityZone)
-				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithPolicy(s.scope.TagReconcilePolicy(), s.scope.InfraCluster(), s.scope))
 				if err := tagsBuilder.Ensure(converters.TagsToMap(rt.Tags)); err != nil {
 					return false, err
 				}
@@ -113,6 +125,12 @@ func (s *Service) reconcileRouteTables() error {
 			}
 
 			// Not recording "SuccessfulTagRouteTable" here as we don't know if this was a no-op or an actual change
+
+			if !sn.IsPublic {
+				if err := s.reconcileAdditionalRoutes(rt); err != nil {
+					return err
+				}
+			}
 			continue
 		}
 		s.scope.Debug("Subnet isn't associated with route table", "subnet-id", sn.GetResourceID())
@@ -136,11 +154,77 @@ func (s *Service) reconcileRouteTables() error {
 
 		s.scope.Debug("Subnet has been associated with route table", "subnet-id", sn.GetResourceID(), "route-table-id", rt.ID)
 		sn.RouteTableID = aws.String(rt.ID)
+
+		if !sn.IsPublic {
+			if err := s.reconcileAdditionalRoutes(&ec2.RouteTable{RouteTableId: aws.String(rt.ID)}); err != nil {
+				return err
+			}
+		}
 	}
 	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition)
 	return nil
 }
 
+// associateExistingRouteTable associates a subnet with the existing route table set in its spec, replacing
+// the association with a route table created by the provider if there is one. The routes and tags of the
+// existing route table are left untouched.
+func (s *Service) associateExistingRouteTable(sn *infrav1.SubnetSpec, subnetRouteMap map[string]*ec2.RouteTable) error {
+	routeTableID := *sn.ExistingRouteTableID
+	subnetID := sn.GetResourceID()
+
+	out, err := s.EC2Client.DescribeRouteTablesWithContext(context.TODO(), &ec2.DescribeRouteTablesInput{
+		RouteTableIds: []*string{aws.String(routeTableID)},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDescribeRouteTable", "Failed to describe existing RouteTable %q: %v", routeTableID, err)
+		return errors.Wrapf(err, "failed to describe route table %q", routeTableID)
+	}
+	if len(out.RouteTables) == 0 {
+		return errors.Errorf("existing route table %q for subnet %q not found", routeTableID, subnetID)
+	}
+	rt := out.RouteTables[0]
+	if vpcID := aws.StringValue(rt.VpcId); vpcID != s.scope.VPC().ID {
+		return errors.Errorf("existing route table %q for subnet %q is in vpc %q, not in vpc %q", routeTableID, subnetID, vpcID, s.scope.VPC().ID)
+	}
+
+	for _, as := range rt.Associations {
+		if aws.StringValue(as.SubnetId) == subnetID {
+			s.scope.Debug("Subnet is already associated with existing route table", "subnet-id", subnetID, "route-table-id", routeTableID)
+			return nil
+		}
+	}
+
+	// Replace the association with the route table created by the provider, if any, as a subnet can only be
+	// associated with a single route table.
+	if current, ok := subnetRouteMap[subnetID]; ok {
+		for _, as := range current.Associations {
+			if aws.StringValue(as.SubnetId) != subnetID {
+				continue
+			}
+			if _, err := s.EC2Client.ReplaceRouteTableAssociationWithContext(context.TODO(), &ec2.ReplaceRouteTableAssociationInput{
+				AssociationId: as.RouteTableAssociationId,
+				RouteTableId:  aws.String(routeTableID),
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedReplaceRouteTableAssociation", "Failed to associate existing RouteTable %q with Subnet %q: %v", routeTableID, subnetID, err)
+				return errors.Wrapf(err, "failed to replace route table association of subnet %q with route table %q", subnetID, routeTableID)
+			}
+			record.Eventf(s.scope.InfraCluster(), "SuccessfulReplaceRouteTableAssociation", "Associated existing RouteTable %q with subnet %q", routeTableID, subnetID)
+			return nil
+		}
+	}
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if err := s.associateRouteTable(&infrav1.RouteTable{ID: routeTableID}, subnetID); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, awserrors.SubnetNotFound); err != nil {
+		return err
+	}
+	s.scope.Debug("Subnet has been associated with existing route table", "subnet-id", subnetID, "route-table-id", routeTableID)
+	return nil
+}
+
 func (s *Service) fixMismatchedRouting(specRoute *ec2.Route, currentRoute *ec2.Route, rt *ec2.RouteTable) error {
 	var input *ec2.ReplaceRouteInput
 	if specRoute.DestinationCidrBlock != nil {
@@ -185,6 +269,160 @@ func (s *Service) fixMismatchedRouting(specRoute *ec2.Route, currentRoute *ec2.R
 	return nil
 }
 
+// reconcileAdditionalRoutes upserts the additional routes of the spec into a managed route table, and removes the
+// additional routes previously created by the provider that were since removed from the spec.
+// Routes that were not created as additional routes by the provider are never replaced nor removed.
+func (s *Service) reconcileAdditionalRoutes(rt *ec2.RouteTable) error {
+	currentRoutes := make(map[string]*ec2.Route, len(rt.Routes))
+	for _, route := range rt.Routes {
+		if route.DestinationCidrBlock != nil {
+			currentRoutes[*route.DestinationCidrBlock] = route
+		}
+	}
+	currentTags := converters.TagsToMap(rt.Tags)
+
+	desiredRoutes := make(map[string]struct{})
+	for _, spec := range s.scope.AdditionalRoutes() {
+		route := getAdditionalRoute(spec)
+		destination := aws.StringValue(route.DestinationCidrBlock)
+		desiredRoutes[destination] = struct{}{}
+
+		tagKey := additionalRouteTagKeyPrefix + destination
+		currentRoute, ok := currentRoutes[destination]
+		switch {
+		case !ok:
+			if err := s.createRoute(rt, route); err != nil {
+				return err
+			}
+		case getRouteTarget(currentRoute) != getRouteTarget(route):
+			// Only replace the route if it still points to the target the provider applied, as recorded
+			// in the route table tags, so that routes created outside of the provider are never overwritten.
+			if value, ok := currentTags[tagKey]; !ok || value != getRouteTarget(currentRoute) {
+				record.Warnf(s.scope.InfraCluster(), "ConflictingRoute", "Route to %q on managed RouteTable %q points to %q, which wasn't set by the provider", destination, *rt.RouteTableId, getRouteTarget(currentRoute))
+				return errors.Errorf("route to %q on route table %q points to %q which wasn't set by the provider, refusing to replace it", destination, *rt.RouteTableId, getRouteTarget(currentRoute))
+			}
+			if err := s.replaceRoute(rt, route); err != nil {
+				return err
+			}
+		}
+
+		if value, ok := currentTags[tagKey]; ok && value == getRouteTarget(route) {
+			continue
+		}
+		if _, err := s.EC2Client.CreateTagsWithContext(context.TODO(), &ec2.CreateTagsInput{
+			Resources: []*string{rt.RouteTableId},
+			Tags:      []*ec2.Tag{{Key: aws.String(tagKey), Value: aws.String(getRouteTarget(route))}},
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedTagRouteTable", "Failed to tag managed RouteTable %q: %v", *rt.RouteTableId, err)
+			return errors.Wrapf(err, "failed to tag route table %q", *rt.RouteTableId)
+		}
+	}
+
+	for tagKey, target := range currentTags {
+		destination := strings.TrimPrefix(tagKey, additionalRouteTagKeyPrefix)
+		if destination == tagKey {
+			continue
+		}
+		if _, ok := desiredRoutes[destination]; ok {
+			continue
+		}
+
+		// Only remove the route if it still points to the target the provider created it with.
+		if currentRoute, ok := currentRoutes[destination]; ok && getRouteTarget(currentRoute) == target {
+			if _, err := s.EC2Client.DeleteRouteWithContext(context.TODO(), &ec2.DeleteRouteInput{
+				RouteTableId:         rt.RouteTableId,
+				DestinationCidrBlock: aws.String(destination),
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedDeleteRoute", "Failed to delete route to %q from managed RouteTable %q: %v", destination, *rt.RouteTableId, err)
+				return errors.Wrapf(err, "failed to delete route to %q from route table %q", destination, *rt.RouteTableId)
+			}
+			record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteRoute", "Deleted route to %q from managed RouteTable %q", destination, *rt.RouteTableId)
+		}
+
+		if _, err := s.EC2Client.DeleteTagsWithContext(context.TODO(), &ec2.DeleteTagsInput{
+			Resources: []*string{rt.RouteTableId},
+			Tags:      []*ec2.Tag{{Key: aws.String(tagKey)}},
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedUntagRouteTable", "Failed to untag managed RouteTable %q: %v", *rt.RouteTableId, err)
+			return errors.Wrapf(err, "failed to untag route table %q", *rt.RouteTableId)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) createRoute(rt *ec2.RouteTable, route *ec2.Route) error {
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.EC2Client.CreateRouteWithContext(context.TODO(), &ec2.CreateRouteInput{
+			RouteTableId:           rt.RouteTableId,
+			DestinationCidrBlock:   route.DestinationCidrBlock,
+			NetworkInterfaceId:     route.NetworkInterfaceId,
+			TransitGatewayId:       route.TransitGatewayId,
+			VpcPeeringConnectionId: route.VpcPeeringConnectionId,
+		}); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, awserrors.RouteTableNotFound); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateRoute", "Failed to create route %s for RouteTable %q: %v", route.GoString(), *rt.RouteTableId, err)
+		return errors.Wrapf(err, "failed to create route in route table %q: %s", *rt.RouteTableId, route.GoString())
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateRoute", "Created route %s for RouteTable %q", route.GoString(), *rt.RouteTableId)
+	return nil
+}
+
+func (s *Service) replaceRoute(rt *ec2.RouteTable, route *ec2.Route) error {
+	if _, err := s.EC2Client.ReplaceRouteWithContext(context.TODO(), &ec2.ReplaceRouteInput{
+		RouteTableId:           rt.RouteTableId,
+		DestinationCidrBlock:   route.DestinationCidrBlock,
+		NetworkInterfaceId:     route.NetworkInterfaceId,
+		TransitGatewayId:       route.TransitGatewayId,
+		VpcPeeringConnectionId: route.VpcPeeringConnectionId,
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedReplaceRoute", "Failed to replace outdated route on managed RouteTable %q: %v", *rt.RouteTableId, err)
+		return errors.Wrapf(err, "failed to replace outdated route on route table %q", *rt.RouteTableId)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulReplaceRoute", "Replaced route %s for RouteTable %q", route.GoString(), *rt.RouteTableId)
+	return nil
+}
+
+func getAdditionalRoute(spec infrav1.RouteSpec) *ec2.Route {
+	return &ec2.Route{
+		DestinationCidrBlock:   aws.String(canonicalCidrBlock(spec.DestinationCidrBlock)),
+		NetworkInterfaceId:     spec.NetworkInterfaceID,
+		TransitGatewayId:       spec.TransitGatewayID,
+		VpcPeeringConnectionId: spec.VpcPeeringConnectionID,
+	}
+}
+
+// canonicalCidrBlock returns the CIDR block with the host bits of its address cleared, which is how EC2 reports the
+// destinations of routes, e.g. 10.0.0.0/8 for 10.0.0.1/8. CIDR blocks that can't be parsed are returned as is.
+func canonicalCidrBlock(cidrBlock string) string {
+	_, network, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return cidrBlock
+	}
+	return network.String()
+}
+
+// getRouteTarget returns the ID of the resource a route sends its traffic to.
+func getRouteTarget(route *ec2.Route) string {
+	for _, target := range []*string{
+		route.TransitGatewayId,
+		route.VpcPeeringConnectionId,
+		route.NetworkInterfaceId,
+		route.NatGatewayId,
+		route.GatewayId,
+		route.EgressOnlyInternetGatewayId,
+		route.InstanceId,
+	} {
+		if target != nil && *target != "" {
+			return *target
+		}
+	}
+	return ""
+}
+
 func (s *Service) describeVpcRouteTablesBySubnet() (map[string]*ec2.RouteTable, error) {
 	rts, err := s.describeVpcRouteTables()
 	if err != nil {
@@ -221,7 +459,21 @@ func (s *Service) deleteRouteTables() error {
 		return errors.Wrapf(err, "failed to describe route tables in vpc %q", s.scope.VPC().ID)
 	}
 
+	// Existing route tables were not created by the provider, and must survive the deletion of the cluster
+	// even if they are tagged for it.
+	existingRouteTables := make(map[string]struct{})
+	for _, sn := range s.scope.Subnets() {
+		if sn.ExistingRouteTableID != nil {
+			existingRouteTables[*sn.ExistingRouteTableID] = struct{}{}
+		}
+	}
+
 	for _, rt := range rts {
+		if _, ok := existingRouteTables[aws.StringValue(rt.RouteTableId)]; ok {
+			s.scope.Debug("Skipping deletion of existing route table", "route-table-id", *rt.RouteTableId)
+			continue
+		}
+
 		for _, as := range rt.Associations {
 			if as.SubnetId == nil {
 				continue
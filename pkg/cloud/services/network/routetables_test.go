@@ -283,6 +283,93 @@ func TestReconcileRouteTables(t *testing.T) {
 					After(publicRouteTable)
 			},
 		},
+		{
+			name: "no routes existing, additional transit gateway route is added to the private route table only",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID:                "vpc-routetables",
+					InternetGatewayID: aws.String("igw-01"),
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-private",
+						IsPublic:         false,
+						AvailabilityZone: "us-east-1a",
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-public",
+						IsPublic:         true,
+						NatGatewayID:     aws.String("nat-01"),
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+				AdditionalRoutes: []infrav1.RouteSpec{
+					{
+						DestinationCidrBlock: "10.0.0.0/8",
+						TransitGatewayID:     aws.String("tgw-01"),
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{}, nil)
+
+				privateRouteTable := m.CreateRouteTableWithContext(context.TODO(), matchRouteTableInput(&ec2.CreateRouteTableInput{VpcId: aws.String("vpc-routetables")})).
+					Return(&ec2.CreateRouteTableOutput{RouteTable: &ec2.RouteTable{RouteTableId: aws.String("rt-1")}}, nil)
+
+				m.CreateRouteWithContext(context.TODO(), gomock.Eq(&ec2.CreateRouteInput{
+					NatGatewayId:         aws.String("nat-01"),
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					RouteTableId:         aws.String("rt-1"),
+				})).
+					After(privateRouteTable)
+
+				privateRouteTableAssociation := m.AssociateRouteTableWithContext(context.TODO(), gomock.Eq(&ec2.AssociateRouteTableInput{
+					RouteTableId: aws.String("rt-1"),
+					SubnetId:     aws.String("subnet-routetables-private"),
+				})).
+					Return(&ec2.AssociateRouteTableOutput{}, nil).
+					After(privateRouteTable)
+
+				m.CreateRouteWithContext(context.TODO(), gomock.Eq(&ec2.CreateRouteInput{
+					TransitGatewayId:     aws.String("tgw-01"),
+					DestinationCidrBlock: aws.String("10.0.0.0/8"),
+					RouteTableId:         aws.String("rt-1"),
+				})).
+					After(privateRouteTableAssociation)
+
+				m.CreateTagsWithContext(context.TODO(), gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{"rt-1"}),
+					Tags: []*ec2.Tag{
+						{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/additional-route/10.0.0.0/8"),
+							Value: aws.String("tgw-01"),
+						},
+					},
+				})).
+					After(privateRouteTableAssociation)
+
+				publicRouteTable := m.CreateRouteTableWithContext(context.TODO(), matchRouteTableInput(&ec2.CreateRouteTableInput{VpcId: aws.String("vpc-routetables")})).
+					Return(&ec2.CreateRouteTableOutput{RouteTable: &ec2.RouteTable{RouteTableId: aws.String("rt-2")}}, nil)
+
+				m.CreateRouteWithContext(context.TODO(), gomock.Eq(&ec2.CreateRouteInput{
+					GatewayId:            aws.String("igw-01"),
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					RouteTableId:         aws.String("rt-2"),
+				})).
+					After(publicRouteTable)
+
+				m.AssociateRouteTableWithContext(context.TODO(), gomock.Eq(&ec2.AssociateRouteTableInput{
+					RouteTableId: aws.String("rt-2"),
+					SubnetId:     aws.String("subnet-routetables-public"),
+				})).
+					Return(&ec2.AssociateRouteTableOutput{}, nil).
+					After(publicRouteTable)
+			},
+		},
 		{
 			name: "subnets in different availability zones, returns error",
 			input: &infrav1.NetworkSpec{
@@ -419,6 +506,112 @@ func TestReconcileRouteTables(t *testing.T) {
 					Return(nil, nil)
 			},
 		},
+		{
+			name: "single nat gateway strategy, routes exist with the nat gateway of the zone, replaces it with the single nat gateway",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					InternetGatewayID: aws.String("igw-01"),
+					ID:                "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				NatGatewayStrategy: infrav1.NatGatewayStrategySingle,
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-private",
+						IsPublic:         false,
+						AvailabilityZone: "us-east-1a",
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-public",
+						IsPublic:         true,
+						NatGatewayID:     aws.String("nat-01"),
+						AvailabilityZone: "us-east-1b",
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{
+							{
+								RouteTableId: aws.String("route-table-private"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-private"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										NatGatewayId:         aws.String("nat-02"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-private-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+							{
+								RouteTableId: aws.String("route-table-public"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-public"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										GatewayId:            aws.String("igw-01"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-public-us-east-1b"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+						},
+					}, nil)
+
+				m.ReplaceRouteWithContext(context.TODO(), gomock.Eq(
+					&ec2.ReplaceRouteInput{
+						DestinationCidrBlock: aws.String("0.0.0.0/0"),
+						RouteTableId:         aws.String("route-table-private"),
+						NatGatewayId:         aws.String("nat-01"),
+					},
+				)).
+					Return(nil, nil)
+			},
+		},
 		{
 			name: "extra routes exist, do nothing",
 			input: &infrav1.NetworkSpec{
@@ -519,6 +712,153 @@ func TestReconcileRouteTables(t *testing.T) {
 					}, nil)
 			},
 		},
+		{
+			name: "private subnet with existing route table, associates subnet with existing route table",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:                   "subnet-routetables-private",
+						IsPublic:             false,
+						AvailabilityZone:     "us-east-1a",
+						ExistingRouteTableID: aws.String("rtb-shared"),
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{}, nil)
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeRouteTablesInput{
+					RouteTableIds: aws.StringSlice([]string{"rtb-shared"}),
+				})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{{RouteTableId: aws.String("rtb-shared"), VpcId: aws.String("vpc-routetables")}},
+					}, nil)
+
+				m.AssociateRouteTableWithContext(context.TODO(), gomock.Eq(&ec2.AssociateRouteTableInput{
+					RouteTableId: aws.String("rtb-shared"),
+					SubnetId:     aws.String("subnet-routetables-private"),
+				})).
+					Return(&ec2.AssociateRouteTableOutput{}, nil)
+			},
+		},
+		{
+			name: "private subnet already associated with existing route table, does nothing",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:                   "subnet-routetables-private",
+						IsPublic:             false,
+						AvailabilityZone:     "us-east-1a",
+						ExistingRouteTableID: aws.String("rtb-shared"),
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{}, nil)
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeRouteTablesInput{
+					RouteTableIds: aws.StringSlice([]string{"rtb-shared"}),
+				})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{{
+							RouteTableId: aws.String("rtb-shared"),
+							VpcId:        aws.String("vpc-routetables"),
+							Associations: []*ec2.RouteTableAssociation{{SubnetId: aws.String("subnet-routetables-private")}},
+							Routes: []*ec2.Route{
+								{
+									DestinationCidrBlock: aws.String("0.0.0.0/0"),
+									TransitGatewayId:     aws.String("tgw-shared"),
+								},
+							},
+						}},
+					}, nil)
+			},
+		},
+		{
+			name: "private subnet associated with managed route table, replaces association with existing route table",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:                   "subnet-routetables-private",
+						IsPublic:             false,
+						AvailabilityZone:     "us-east-1a",
+						ExistingRouteTableID: aws.String("rtb-shared"),
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{{
+							RouteTableId: aws.String("route-table-private"),
+							Associations: []*ec2.RouteTableAssociation{{
+								SubnetId:                aws.String("subnet-routetables-private"),
+								RouteTableAssociationId: aws.String("rtbassoc-private"),
+							}},
+						}},
+					}, nil)
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeRouteTablesInput{
+					RouteTableIds: aws.StringSlice([]string{"rtb-shared"}),
+				})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{{RouteTableId: aws.String("rtb-shared"), VpcId: aws.String("vpc-routetables")}},
+					}, nil)
+
+				m.ReplaceRouteTableAssociationWithContext(context.TODO(), gomock.Eq(&ec2.ReplaceRouteTableAssociationInput{
+					AssociationId: aws.String("rtbassoc-private"),
+					RouteTableId:  aws.String("rtb-shared"),
+				})).
+					Return(&ec2.ReplaceRouteTableAssociationOutput{}, nil)
+			},
+		},
+		{
+			name: "private subnet with existing route table in another vpc, returns error",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:                   "subnet-routetables-private",
+						IsPublic:             false,
+						AvailabilityZone:     "us-east-1a",
+						ExistingRouteTableID: aws.String("rtb-shared"),
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{}, nil)
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeRouteTablesInput{
+					RouteTableIds: aws.StringSlice([]string{"rtb-shared"}),
+				})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{{RouteTableId: aws.String("rtb-shared"), VpcId: aws.String("vpc-other")}},
+					}, nil)
+			},
+			err: errors.New(`existing route table "rtb-shared" for subnet "subnet-routetables-private" is in vpc "vpc-other"`),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -560,6 +900,328 @@ func TestReconcileRouteTables(t *testing.T) {
 	}
 }
 
+func TestReconcileAdditionalRoutes(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	transitGatewayRoute := infrav1.RouteSpec{
+		DestinationCidrBlock: "10.0.0.0/8",
+		TransitGatewayID:     aws.String("tgw-01"),
+	}
+	transitGatewayRouteTag := &ec2.Tag{
+		Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/additional-route/10.0.0.0/8"),
+		Value: aws.String("tgw-01"),
+	}
+	defaultRoute := &ec2.Route{
+		DestinationCidrBlock: aws.String("0.0.0.0/0"),
+		NatGatewayId:         aws.String("nat-01"),
+	}
+
+	testCases := []struct {
+		name             string
+		additionalRoutes []infrav1.RouteSpec
+		routeTable       *ec2.RouteTable
+		expect           func(m *mocks.MockEC2APIMockRecorder)
+		wantErr          bool
+	}{
+		{
+			name:             "Should add a transit gateway route and record it in the route table tags",
+			additionalRoutes: []infrav1.RouteSpec{transitGatewayRoute},
+			routeTable: &ec2.RouteTable{
+				RouteTableId: aws.String("route-table-private"),
+				Routes:       []*ec2.Route{defaultRoute},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.CreateRouteWithContext(context.TODO(), gomock.Eq(&ec2.CreateRouteInput{
+					RouteTableId:         aws.String("route-table-private"),
+					DestinationCidrBlock: aws.String("10.0.0.0/8"),
+					TransitGatewayId:     aws.String("tgw-01"),
+				})).Return(&ec2.CreateRouteOutput{}, nil)
+				m.CreateTagsWithContext(context.TODO(), gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{"route-table-private"}),
+					Tags:      []*ec2.Tag{transitGatewayRouteTag},
+				})).Return(&ec2.CreateTagsOutput{}, nil)
+			},
+		},
+		{
+			name:             "Should do nothing if the transit gateway route already exists",
+			additionalRoutes: []infrav1.RouteSpec{transitGatewayRoute},
+			routeTable: &ec2.RouteTable{
+				RouteTableId: aws.String("route-table-private"),
+				Routes: []*ec2.Route{
+					defaultRoute,
+					{
+						DestinationCidrBlock: aws.String("10.0.0.0/8"),
+						TransitGatewayId:     aws.String("tgw-01"),
+					},
+				},
+				Tags: []*ec2.Tag{transitGatewayRouteTag},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should match the existing route of a destination that isn't in its canonical form",
+			additionalRoutes: []infrav1.RouteSpec{
+				{
+					DestinationCidrBlock: "10.0.0.1/8",
+					TransitGatewayID:     aws.String("tgw-01"),
+				},
+			},
+			routeTable: &ec2.RouteTable{
+				RouteTableId: aws.String("route-table-private"),
+				Routes: []*ec2.Route{
+					defaultRoute,
+					{
+						DestinationCidrBlock: aws.String("10.0.0.0/8"),
+						TransitGatewayId:     aws.String("tgw-01"),
+					},
+				},
+				Tags: []*ec2.Tag{transitGatewayRouteTag},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should remove the tag recorded for a destination that isn't in its canonical form",
+			additionalRoutes: []infrav1.RouteSpec{
+				{
+					DestinationCidrBlock: "10.0.0.1/8",
+					TransitGatewayID:     aws.String("tgw-01"),
+				},
+			},
+			routeTable: &ec2.RouteTable{
+				RouteTableId: aws.String("route-table-private"),
+				Routes: []*ec2.Route{
+					defaultRoute,
+					{
+						DestinationCidrBlock: aws.String("10.0.0.0/8"),
+						TransitGatewayId:     aws.String("tgw-01"),
+					},
+				},
+				Tags: []*ec2.Tag{
+					{
+						Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/additional-route/10.0.0.1/8"),
+						Value: aws.String("tgw-01"),
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.CreateTagsWithContext(context.TODO(), gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{"route-table-private"}),
+					Tags:      []*ec2.Tag{transitGatewayRouteTag},
+				})).Return(&ec2.CreateTagsOutput{}, nil)
+				m.DeleteTagsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteTagsInput{
+					Resources: aws.StringSlice([]string{"route-table-private"}),
+					Tags: []*ec2.Tag{
+						{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/additional-route/10.0.0.1/8")},
+					},
+				})).Return(&ec2.DeleteTagsOutput{}, nil)
+			},
+		},
+		{
+			name: "Should replace the route if it points to a different target",
+			additionalRoutes: []infrav1.RouteSpec{
+				{
+					DestinationCidrBlock:   "10.0.0.0/8",
+					VpcPeeringConnectionID: aws.String("pcx-01"),
+				},
+			},
+			routeTable: &ec2.RouteTable{
+				RouteTableId: aws.String("route-table-private"),
+				Routes: []*ec2.Route{
+					defaultRoute,
+					{
+						DestinationCidrBlock: aws.String("10.0.0.0/8"),
+						TransitGatewayId:     aws.String("tgw-01"),
+					},
+				},
+				Tags: []*ec2.Tag{transitGatewayRouteTag},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.ReplaceRouteWithContext(context.TODO(), gomock.Eq(&ec2.ReplaceRouteInput{
+					RouteTableId:           aws.String("route-table-private"),
+					DestinationCidrBlock:   aws.String("10.0.0.0/8"),
+					VpcPeeringConnectionId: aws.String("pcx-01"),
+				})).Return(&ec2.ReplaceRouteOutput{}, nil)
+				m.CreateTagsWithContext(context.TODO(), gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{"route-table-private"}),
+					Tags: []*ec2.Tag{
+						{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/additional-route/10.0.0.0/8"),
+							Value: aws.String("pcx-01"),
+						},
+					},
+				})).Return(&ec2.CreateTagsOutput{}, nil)
+			},
+		},
+		{
+			name: "Should return an error if the route to replace was not created by the provider",
+			additionalRoutes: []infrav1.RouteSpec{
+				{
+					DestinationCidrBlock:   "10.0.0.0/8",
+					VpcPeeringConnectionID: aws.String("pcx-01"),
+				},
+			},
+			routeTable: &ec2.RouteTable{
+				RouteTableId: aws.String("route-table-private"),
+				Routes: []*ec2.Route{
+					defaultRoute,
+					{
+						DestinationCidrBlock: aws.String("10.0.0.0/8"),
+						TransitGatewayId:     aws.String("tgw-01"),
+					},
+				},
+			},
+			expect:  func(m *mocks.MockEC2APIMockRecorder) {},
+			wantErr: true,
+		},
+		{
+			name: "Should return an error if the route to replace was changed outside of the provider",
+			additionalRoutes: []infrav1.RouteSpec{
+				{
+					DestinationCidrBlock:   "10.0.0.0/8",
+					VpcPeeringConnectionID: aws.String("pcx-01"),
+				},
+			},
+			routeTable: &ec2.RouteTable{
+				RouteTableId: aws.String("route-table-private"),
+				Routes: []*ec2.Route{
+					defaultRoute,
+					{
+						DestinationCidrBlock: aws.String("10.0.0.0/8"),
+						TransitGatewayId:     aws.String("tgw-02"),
+					},
+				},
+				Tags: []*ec2.Tag{transitGatewayRouteTag},
+			},
+			expect:  func(m *mocks.MockEC2APIMockRecorder) {},
+			wantErr: true,
+		},
+		{
+			name: "Should clean up a transit gateway route removed from the spec",
+			routeTable: &ec2.RouteTable{
+				RouteTableId: aws.String("route-table-private"),
+				Routes: []*ec2.Route{
+					defaultRoute,
+					{
+						DestinationCidrBlock: aws.String("10.0.0.0/8"),
+						TransitGatewayId:     aws.String("tgw-01"),
+					},
+				},
+				Tags: []*ec2.Tag{transitGatewayRouteTag},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DeleteRouteWithContext(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+					RouteTableId:         aws.String("route-table-private"),
+					DestinationCidrBlock: aws.String("10.0.0.0/8"),
+				})).Return(&ec2.DeleteRouteOutput{}, nil)
+				m.DeleteTagsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteTagsInput{
+					Resources: aws.StringSlice([]string{"route-table-private"}),
+					Tags: []*ec2.Tag{
+						{
+							Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/additional-route/10.0.0.0/8"),
+						},
+					},
+				})).Return(&ec2.DeleteTagsOutput{}, nil)
+			},
+		},
+		{
+			name: "Should not delete a route removed from the spec that was changed outside of the provider",
+			routeTable: &ec2.RouteTable{
+				RouteTableId: aws.String("route-table-private"),
+				Routes: []*ec2.Route{
+					defaultRoute,
+					{
+						DestinationCidrBlock: aws.String("10.0.0.0/8"),
+						TransitGatewayId:     aws.String("tgw-02"),
+					},
+				},
+				Tags: []*ec2.Tag{transitGatewayRouteTag},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DeleteTagsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteTagsInput{
+					Resources: aws.StringSlice([]string{"route-table-private"}),
+					Tags: []*ec2.Tag{
+						{
+							Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/additional-route/10.0.0.0/8"),
+						},
+					},
+				})).Return(&ec2.DeleteTagsOutput{}, nil)
+			},
+		},
+		{
+			name: "Should not delete routes that were not created by the provider",
+			routeTable: &ec2.RouteTable{
+				RouteTableId: aws.String("route-table-private"),
+				Routes: []*ec2.Route{
+					defaultRoute,
+					{
+						DestinationCidrBlock: aws.String("10.0.0.0/8"),
+						TransitGatewayId:     aws.String("tgw-01"),
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:             "Should return an error if the route cannot be created",
+			additionalRoutes: []infrav1.RouteSpec{transitGatewayRoute},
+			routeTable: &ec2.RouteTable{
+				RouteTableId: aws.String("route-table-private"),
+				Routes:       []*ec2.Route{defaultRoute},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.CreateRouteWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateRouteInput{})).
+					Return(nil, awserrors.NewFailedDependency("failed dependency"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								ID: "vpc-routetables",
+								Tags: infrav1.Tags{
+									infrav1.ClusterTagKey("test-cluster"): "owned",
+								},
+							},
+							AdditionalRoutes: tc.additionalRoutes,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileAdditionalRoutes(tc.routeTable)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
 func TestDeleteRouteTables(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -651,6 +1313,46 @@ func TestDeleteRouteTables(t *testing.T) {
 				})).Return(&ec2.DeleteRouteTableOutput{}, nil)
 			},
 		},
+		{
+			name: "Should not delete existing route tables of subnets",
+			input: &infrav1.NetworkSpec{
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:                   "subnet-routetables-private",
+						ExistingRouteTableID: aws.String("route-table-shared"),
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{
+							{
+								RouteTableId: aws.String("route-table-shared"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId:                aws.String("subnet-routetables-private"),
+										RouteTableAssociationId: aws.String("rtbassoc-shared"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("shared"),
+									},
+								},
+							},
+							{
+								RouteTableId: aws.String("route-table-public"),
+							},
+						},
+					}, nil)
+
+				m.DeleteRouteTableWithContext(context.TODO(), gomock.Eq(&ec2.DeleteRouteTableInput{
+					RouteTableId: aws.String("route-table-public"),
+				})).Return(&ec2.DeleteRouteTableOutput{}, nil)
+			},
+		},
 		{
 			name:  "Should return error if describe route table fails",
 			input: &infrav1.NetworkSpec{},
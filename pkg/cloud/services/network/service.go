@@ -18,6 +18,7 @@ package network
 
 import (
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 )
@@ -28,6 +29,7 @@ import (
 type Service struct {
 	scope     scope.NetworkScope
 	EC2Client ec2iface.EC2API
+	IAMClient iamiface.IAMAPI
 }
 
 // NewService returns a new service given the ec2 api client.
@@ -35,5 +37,6 @@ func NewService(networkScope scope.NetworkScope) *Service {
 	return &Service{
 		scope:     networkScope,
 		EC2Client: scope.NewEC2Client(networkScope, networkScope, networkScope, networkScope.InfraCluster()),
+		IAMClient: scope.NewIAMClient(networkScope, networkScope, networkScope, networkScope.InfraCluster()),
 	}
 }
@@ -37,6 +37,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/cidr"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
@@ -55,14 +56,15 @@ func (s *Service) reconcileSubnets() error {
 	}()
 
 	var (
-		err      error
-		existing infrav1.Subnets
+		err                  error
+		existing             infrav1.Subnets
+		availableIPAddresses map[string]int64
 	)
 
 	// Describing the VPC Subnets tags the resources.
 	if s.scope.TagUnmanagedNetworkResources() {
 		// Describe subnets in the vpc.
-		if existing, err = s.describeVpcSubnets(); err != nil {
+		if existing, availableIPAddresses, err = s.describeVpcSubnetsWithAvailableIPAddresses(); err != nil {
 			return err
 		}
 	}
@@ -97,7 +99,7 @@ func (s *Service) reconcileSubnets() error {
 	// Describing the VPC Subnets tags the resources.
 	if !s.scope.TagUnmanagedNetworkResources() {
 		// Describe subnets in the vpc.
-		if existing, err = s.describeVpcSubnets(); err != nil {
+		if existing, availableIPAddresses, err = s.describeVpcSubnetsWithAvailableIPAddresses(); err != nil {
 			return err
 		}
 	}
@@ -137,7 +139,7 @@ func (s *Service) reconcileSubnets() error {
 			// Make sure tags are up-to-date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getSubnetTagParams(unmanagedVPC, existingSubnet.GetResourceID(), existingSubnet.IsPublic, existingSubnet.AvailabilityZone, subnetTags)
-				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithPolicy(s.scope.TagReconcilePolicy(), s.scope.InfraCluster(), s.scope))
 				if err := tagsBuilder.Ensure(existingSubnet.Tags); err != nil {
 					return false, err
 				}
@@ -161,6 +163,8 @@ func (s *Service) reconcileSubnets() error {
 				// if we have a subnet ID specified in the spec, we need to restore it.
 				existingSubnet.ID = sub.ID
 			}
+			// The existing route table to associate the subnet with is only known from the spec.
+			existingSubnet.ExistingRouteTableID = sub.ExistingRouteTableID
 
 			// Update subnet spec with the existing subnet details
 			existingSubnet.DeepCopyInto(sub)
@@ -206,11 +210,50 @@ func (s *Service) reconcileSubnets() error {
 		}
 	}
 
+	s.reconcileSubnetsAvailableIPAddresses(subnets, availableIPAddresses)
+
 	s.scope.Debug("Reconciled subnets", "subnets", subnets)
 	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.SubnetsReadyCondition)
 	return nil
 }
 
+// reconcileSubnetsAvailableIPAddresses records the number of available IP addresses of each subnet of the cluster in
+// the network status, from the description of the subnets of the VPC. Subnets that were not described, because they were
+// just created or no longer exist, are skipped until the next reconcile. When the network spec sets a threshold, the
+// subnets with fewer available IP addresses are reported by the SubnetsAvailableIPAddresses condition.
+func (s *Service) reconcileSubnetsAvailableIPAddresses(subnets infrav1.Subnets, availableIPAddresses map[string]int64) {
+	threshold := s.scope.SubnetAvailableIPAddressThreshold()
+	var (
+		statuses []infrav1.SubnetStatus
+		low      []string
+	)
+	for _, subnet := range subnets {
+		id := subnet.GetResourceID()
+		count, ok := availableIPAddresses[id]
+		if !ok {
+			continue
+		}
+		statuses = append(statuses, infrav1.SubnetStatus{ID: id, AvailableIPAddressCount: count})
+		if threshold != nil && count < *threshold {
+			low = append(low, fmt.Sprintf("%s (%d)", id, count))
+		}
+	}
+	s.scope.Network().Subnets = statuses
+
+	switch {
+	case threshold == nil:
+		conditions.Delete(s.scope.InfraCluster(), infrav1.SubnetsAvailableIPAddressesCondition)
+	case len(low) > 0:
+		msg := fmt.Sprintf("Subnets with fewer than %d available IP addresses: %s", *threshold, strings.Join(low, ", "))
+		if !conditions.IsFalse(s.scope.InfraCluster(), infrav1.SubnetsAvailableIPAddressesCondition) {
+			record.Warnf(s.scope.InfraCluster(), "SubnetsLowOnIPAddresses", msg)
+		}
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.SubnetsAvailableIPAddressesCondition, infrav1.SubnetsLowOnIPAddressesReason, clusterv1.ConditionSeverityWarning, msg)
+	default:
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.SubnetsAvailableIPAddressesCondition)
+	}
+}
+
 func (s *Service) getDefaultSubnets() (infrav1.Subnets, error) {
 	zones, err := s.getAvailableZones()
 	if err != nil {
@@ -246,20 +289,37 @@ func (s *Service) getDefaultSubnets() (infrav1.Subnets, error) {
 	var (
 		subnetCIDRs            []*net.IPNet
 		publicSubnetCIDRs      []*net.IPNet
+		privateSubnetCIDRs     []*net.IPNet
 		ipv6SubnetCIDRs        []*net.IPNet
 		publicIPv6SubnetCIDRs  []*net.IPNet
 		privateIPv6SubnetCIDRs []*net.IPNet
 	)
-	subnetCIDRs, err = cidr.SplitIntoSubnetsIPv4(s.scope.VPC().CidrBlock, numSubnets)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed splitting VPC CIDR %q into subnets", s.scope.VPC().CidrBlock)
-	}
+	if sizes := s.scope.SubnetCidrSizes(); sizes != nil {
+		// 1 public and 1 private subnet of the requested sizes for each AZ.
+		prefixLengths := make([]int, 0, 2*len(zones))
+		for range zones {
+			prefixLengths = append(prefixLengths, sizes.PublicPrefixLength, sizes.PrivatePrefixLength)
+		}
+		subnetCIDRs, err = cidr.SplitIntoSubnetsIPv4WithPrefixLengths(s.scope.VPC().CidrBlock, prefixLengths)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed splitting VPC CIDR %q into subnets of the requested sizes", s.scope.VPC().CidrBlock)
+		}
+		for i := 0; i < len(subnetCIDRs); i += 2 {
+			publicSubnetCIDRs = append(publicSubnetCIDRs, subnetCIDRs[i])
+			privateSubnetCIDRs = append(privateSubnetCIDRs, subnetCIDRs[i+1])
+		}
+	} else {
+		subnetCIDRs, err = cidr.SplitIntoSubnetsIPv4(s.scope.VPC().CidrBlock, numSubnets)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed splitting VPC CIDR %q into subnets", s.scope.VPC().CidrBlock)
+		}
 
-	publicSubnetCIDRs, err = cidr.SplitIntoSubnetsIPv4(subnetCIDRs[0].String(), len(zones))
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed splitting CIDR %q into public subnets", subnetCIDRs[0].String())
+		publicSubnetCIDRs, err = cidr.SplitIntoSubnetsIPv4(subnetCIDRs[0].String(), len(zones))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed splitting CIDR %q into public subnets", subnetCIDRs[0].String())
+		}
+		privateSubnetCIDRs = append(subnetCIDRs[:0], subnetCIDRs[1:]...)
 	}
-	privateSubnetCIDRs := append(subnetCIDRs[:0], subnetCIDRs[1:]...)
 
 	if s.scope.VPC().IsIPv6Enabled() {
 		ipv6SubnetCIDRs, err = cidr.SplitIntoSubnetsIPv6(s.scope.VPC().IPv6.CidrBlock, numSubnets)
@@ -326,22 +386,30 @@ func (s *Service) deleteSubnets() error {
 }
 
 func (s *Service) describeVpcSubnets() (infrav1.Subnets, error) {
+	subnets, _, err := s.describeVpcSubnetsWithAvailableIPAddresses()
+	return subnets, err
+}
+
+// describeVpcSubnetsWithAvailableIPAddresses describes the subnets of the VPC, along with the number of available IP
+// addresses of each subnet indexed by subnet ID.
+func (s *Service) describeVpcSubnetsWithAvailableIPAddresses() (infrav1.Subnets, map[string]int64, error) {
 	sns, err := s.describeSubnets()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	routeTables, err := s.describeVpcRouteTablesBySubnet()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	natGateways, err := s.describeNatGatewaysBySubnet()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	subnets := make([]infrav1.SubnetSpec, 0, len(sns.Subnets))
+	availableIPAddresses := make(map[string]int64, len(sns.Subnets))
 	// Besides what the AWS API tells us directly about the subnets, we also want to discover whether the subnet is "public" (i.e. directly connected to the internet) and if there are any associated NAT gateways.
 	// We also look for a tag indicating that a particular subnet should be public, to try and determine whether a managed VPC's subnet should have such a route, but does not.
 	for _, ec2sn := range sns.Subnets {
@@ -384,9 +452,13 @@ func (s *Service) describeVpcSubnets() (infrav1.Subnets, error) {
 			spec.NatGatewayID = ngw.NatGatewayId
 		}
 		subnets = append(subnets, spec)
+
+		if ec2sn.AvailableIpAddressCount != nil {
+			availableIPAddresses[*ec2sn.SubnetId] = *ec2sn.AvailableIpAddressCount
+		}
 	}
 
-	return subnets, nil
+	return subnets, availableIPAddresses, nil
 }
 
 func (s *Service) describeSubnets() (*ec2.DescribeSubnetsOutput, error) {
@@ -550,6 +622,11 @@ func (s *Service) getSubnetTagParams(unmanagedVPC bool, id string, public bool,
 	}
 
 	if !unmanagedVPC {
+		// Karpenter launches the nodes in the private subnets.
+		if value := s.scope.KarpenterDiscovery().TagValue(s.scope.KubernetesClusterName()); value != "" && !public {
+			additionalTags[infrav1.KarpenterDiscoveryTagKey] = value
+		}
+
 		for k, v := range manualTags {
 			additionalTags[k] = v
 		}
@@ -26,6 +26,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -35,6 +37,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 const (
@@ -1166,6 +1169,197 @@ func TestReconcileSubnets(t *testing.T) {
 					After(secondSubnet)
 			},
 		},
+		{
+			name: "Managed VPC, no existing subnets exist, one az, subnet CIDR sizes specified, expect one private and one public of the requested sizes",
+			input: NewClusterScope().WithNetwork(&infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: subnetsVPCID,
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+					CidrBlock: defaultVPCCidr,
+				},
+				Subnets: []infrav1.SubnetSpec{},
+				SubnetCidrSizes: &infrav1.SubnetCidrSizes{
+					PublicPrefixLength:  24,
+					PrivatePrefixLength: 18,
+				},
+			}),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAvailabilityZonesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeAvailabilityZonesOutput{
+						AvailabilityZones: []*ec2.AvailabilityZone{
+							{
+								ZoneName: aws.String("us-east-1c"),
+							},
+						},
+					}, nil)
+
+				describeCall := m.DescribeSubnetsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeSubnetsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("state"),
+							Values: []*string{aws.String("pending"), aws.String("available")},
+						},
+						{
+							Name:   aws.String("vpc-id"),
+							Values: []*string{aws.String(subnetsVPCID)},
+						},
+					},
+				})).
+					Return(&ec2.DescribeSubnetsOutput{}, nil)
+
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{}, nil)
+
+				m.DescribeNatGatewaysPagesWithContext(context.TODO(),
+					gomock.Eq(&ec2.DescribeNatGatewaysInput{
+						Filter: []*ec2.Filter{
+							{
+								Name:   aws.String("vpc-id"),
+								Values: []*string{aws.String(subnetsVPCID)},
+							},
+							{
+								Name:   aws.String("state"),
+								Values: []*string{aws.String("pending"), aws.String("available")},
+							},
+						},
+					}),
+					gomock.Any()).Return(nil)
+
+				firstSubnet := m.CreateSubnetWithContext(context.TODO(), gomock.Eq(&ec2.CreateSubnetInput{
+					VpcId:            aws.String(subnetsVPCID),
+					CidrBlock:        aws.String("10.0.64.0/24"),
+					AvailabilityZone: aws.String("us-east-1c"),
+					TagSpecifications: []*ec2.TagSpecification{
+						{
+							ResourceType: aws.String("subnet"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("Name"),
+									Value: aws.String("test-cluster-subnet-public-us-east-1c"),
+								},
+								{
+									Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+									Value: aws.String("shared"),
+								},
+								{
+									Key:   aws.String("kubernetes.io/role/elb"),
+									Value: aws.String("1"),
+								},
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+									Value: aws.String("owned"),
+								},
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+									Value: aws.String("public"),
+								},
+							},
+						},
+					},
+				})).
+					Return(&ec2.CreateSubnetOutput{
+						Subnet: &ec2.Subnet{
+							VpcId:               aws.String(subnetsVPCID),
+							SubnetId:            aws.String("subnet-1"),
+							CidrBlock:           aws.String("10.0.64.0/24"),
+							AvailabilityZone:    aws.String("us-east-1c"),
+							MapPublicIpOnLaunch: aws.Bool(false),
+						},
+					}, nil).
+					After(describeCall)
+
+				m.WaitUntilSubnetAvailableWithContext(context.TODO(), gomock.Any()).
+					After(firstSubnet)
+
+				m.ModifySubnetAttributeWithContext(context.TODO(), &ec2.ModifySubnetAttributeInput{
+					MapPublicIpOnLaunch: &ec2.AttributeBooleanValue{
+						Value: aws.Bool(true),
+					},
+					SubnetId: aws.String("subnet-1"),
+				}).
+					Return(&ec2.ModifySubnetAttributeOutput{}, nil).
+					After(firstSubnet)
+
+				secondSubnet := m.CreateSubnetWithContext(context.TODO(), gomock.Eq(&ec2.CreateSubnetInput{
+					VpcId:            aws.String(subnetsVPCID),
+					CidrBlock:        aws.String("10.0.0.0/18"),
+					AvailabilityZone: aws.String("us-east-1c"),
+					TagSpecifications: []*ec2.TagSpecification{
+						{
+							ResourceType: aws.String("subnet"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("Name"),
+									Value: aws.String("test-cluster-subnet-private-us-east-1c"),
+								},
+								{
+									Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+									Value: aws.String("shared"),
+								},
+								{
+									Key:   aws.String("kubernetes.io/role/internal-elb"),
+									Value: aws.String("1"),
+								},
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+									Value: aws.String("owned"),
+								},
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+									Value: aws.String("private"),
+								},
+							},
+						},
+					},
+				})).
+					Return(&ec2.CreateSubnetOutput{
+						Subnet: &ec2.Subnet{
+							VpcId:               aws.String(subnetsVPCID),
+							SubnetId:            aws.String("subnet-2"),
+							CidrBlock:           aws.String("10.0.0.0/18"),
+							AvailabilityZone:    aws.String("us-east-1c"),
+							MapPublicIpOnLaunch: aws.Bool(false),
+						},
+					}, nil).
+					After(firstSubnet)
+
+				m.WaitUntilSubnetAvailableWithContext(context.TODO(), gomock.Any()).
+					After(secondSubnet)
+			},
+		},
+		{
+			name: "Managed VPC, no existing subnets exist, subnet CIDR sizes do not fit in the VPC CIDR, should fail",
+			input: NewClusterScope().WithNetwork(&infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: subnetsVPCID,
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+					CidrBlock: defaultVPCCidr,
+				},
+				Subnets: []infrav1.SubnetSpec{},
+				SubnetCidrSizes: &infrav1.SubnetCidrSizes{
+					PublicPrefixLength:  17,
+					PrivatePrefixLength: 17,
+				},
+			}),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAvailabilityZonesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeAvailabilityZonesOutput{
+						AvailabilityZones: []*ec2.AvailabilityZone{
+							{
+								ZoneName: aws.String("us-east-1c"),
+							},
+							{
+								ZoneName: aws.String("us-east-1d"),
+							},
+						},
+					}, nil)
+			},
+			errorExpected: true,
+		},
 		{
 			name: "Managed IPv6 VPC, no existing subnets exist, one az, expect one private and one public from default",
 			input: NewClusterScope().WithNetwork(&infrav1.NetworkSpec{
@@ -2677,6 +2871,88 @@ func TestDeleteSubnets(t *testing.T) {
 	}
 }
 
+func TestReconcileSubnetsAvailableIPAddresses(t *testing.T) {
+	testCases := []struct {
+		name              string
+		threshold         *int64
+		expectedStatuses  []infrav1.SubnetStatus
+		expectedCondition *clusterv1.Condition
+	}{
+		{
+			name: "records the available IP addresses of the subnets",
+			expectedStatuses: []infrav1.SubnetStatus{
+				{ID: "subnet-1", AvailableIPAddressCount: 250},
+				{ID: "subnet-2", AvailableIPAddressCount: 10},
+			},
+		},
+		{
+			name:      "reports the subnets with fewer available IP addresses than the threshold",
+			threshold: aws.Int64(16),
+			expectedStatuses: []infrav1.SubnetStatus{
+				{ID: "subnet-1", AvailableIPAddressCount: 250},
+				{ID: "subnet-2", AvailableIPAddressCount: 10},
+			},
+			expectedCondition: &clusterv1.Condition{
+				Type:     infrav1.SubnetsAvailableIPAddressesCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityWarning,
+				Reason:   infrav1.SubnetsLowOnIPAddressesReason,
+				Message:  "Subnets with fewer than 16 available IP addresses: subnet-2 (10)",
+			},
+		},
+		{
+			name:      "reports the subnets have enough available IP addresses",
+			threshold: aws.Int64(8),
+			expectedStatuses: []infrav1.SubnetStatus{
+				{ID: "subnet-1", AvailableIPAddressCount: 250},
+				{ID: "subnet-2", AvailableIPAddressCount: 10},
+			},
+			expectedCondition: &clusterv1.Condition{
+				Type:   infrav1.SubnetsAvailableIPAddressesCondition,
+				Status: corev1.ConditionTrue,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scope, err := NewClusterScope().WithNetwork(&infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: subnetsVPCID,
+				},
+				SubnetAvailableIPAddressThreshold: tc.threshold,
+			}).Build()
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(scope)
+			// The subnets that were just created or no longer exist are not described, and are skipped.
+			s.reconcileSubnetsAvailableIPAddresses(infrav1.Subnets{
+				{ID: "subnet-1", ResourceID: "subnet-1"},
+				{ID: "subnet-2", ResourceID: "subnet-2"},
+				{ID: "subnet-pending"},
+				{ID: "subnet-deleted", ResourceID: "subnet-deleted"},
+			}, map[string]int64{
+				"subnet-2": 10,
+				"subnet-1": 250,
+			})
+			g.Expect(scope.Network().Subnets).To(Equal(tc.expectedStatuses))
+
+			condition := conditions.Get(scope.InfraCluster(), infrav1.SubnetsAvailableIPAddressesCondition)
+			if tc.expectedCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tc.expectedCondition.Status))
+			g.Expect(condition.Severity).To(Equal(tc.expectedCondition.Severity))
+			g.Expect(condition.Reason).To(Equal(tc.expectedCondition.Reason))
+			g.Expect(condition.Message).To(Equal(tc.expectedCondition.Message))
+		})
+	}
+}
+
 // Test helpers
 
 type ScopeBuilder interface {
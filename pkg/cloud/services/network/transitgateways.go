@@ -0,0 +1,342 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// activeTransitGatewayAttachmentStates are the states of transit gateway attachments that are not being deleted.
+var activeTransitGatewayAttachmentStates = []string{
+	ec2.TransitGatewayAttachmentStateInitiating,
+	ec2.TransitGatewayAttachmentStateInitiatingRequest,
+	ec2.TransitGatewayAttachmentStatePendingAcceptance,
+	ec2.TransitGatewayAttachmentStatePending,
+	ec2.TransitGatewayAttachmentStateAvailable,
+	ec2.TransitGatewayAttachmentStateModifying,
+}
+
+func (s *Service) reconcileTransitGatewayAttachment() error {
+	spec := s.scope.TransitGatewayAttachment()
+	if spec == nil {
+		return nil
+	}
+
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping transit gateway attachment reconcile in unmanaged mode")
+		return nil
+	}
+
+	s.scope.Debug("Reconciling transit gateway attachment")
+
+	attachments, err := s.describeTransitGatewayAttachments(filter.EC2.TransitGateway(spec.TransitGatewayID))
+	if err != nil {
+		return err
+	}
+
+	var attachment *ec2.TransitGatewayVpcAttachment
+	if len(attachments) > 0 {
+		attachment = attachments[0]
+	} else {
+		attachment, err = s.createTransitGatewayAttachment(spec)
+		if err != nil {
+			return err
+		}
+	}
+
+	if aws.StringValue(attachment.State) != ec2.TransitGatewayAttachmentStateAvailable {
+		if err := s.waitForTransitGatewayAttachmentAvailable(*attachment.TransitGatewayAttachmentId); err != nil {
+			return err
+		}
+	}
+
+	if spec.RouteTableID != nil {
+		if err := s.reconcileTransitGatewayRouteTableAssociation(*attachment.TransitGatewayAttachmentId, *spec.RouteTableID); err != nil {
+			return err
+		}
+
+		if spec.EnableRouteTablePropagation {
+			if err := s.reconcileTransitGatewayRouteTablePropagation(*attachment.TransitGatewayAttachmentId, *spec.RouteTableID); err != nil {
+				return err
+			}
+		}
+	}
+
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)
+	return nil
+}
+
+func (s *Service) createTransitGatewayAttachment(spec *infrav1.TransitGatewayAttachmentSpec) (*ec2.TransitGatewayVpcAttachment, error) {
+	subnetIDs := spec.SubnetIDs
+	if len(subnetIDs) == 0 {
+		// An attachment can only be placed in a single subnet per availability zone.
+		zones := make(map[string]struct{})
+		for _, sn := range s.scope.Subnets().FilterPrivate() {
+			if _, ok := zones[sn.AvailabilityZone]; ok {
+				continue
+			}
+			zones[sn.AvailabilityZone] = struct{}{}
+			subnetIDs = append(subnetIDs, sn.GetResourceID())
+		}
+	}
+	if len(subnetIDs) == 0 {
+		return nil, errors.Errorf("failed to create transit gateway attachment for vpc %q: no subnets available", s.scope.VPC().ID)
+	}
+
+	out, err := s.EC2Client.CreateTransitGatewayVpcAttachmentWithContext(context.TODO(), &ec2.CreateTransitGatewayVpcAttachmentInput{
+		TransitGatewayId: aws.String(spec.TransitGatewayID),
+		VpcId:            aws.String(s.scope.VPC().ID),
+		SubnetIds:        aws.StringSlice(subnetIDs),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeTransitGatewayAttachment, s.getTransitGatewayAttachmentTagParams(services.TemporaryResourceID)),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateTransitGatewayAttachment", "Failed to create Transit Gateway Attachment to %q for VPC %q: %v", spec.TransitGatewayID, s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to create transit gateway attachment to %q for vpc %q", spec.TransitGatewayID, s.scope.VPC().ID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateTransitGatewayAttachment", "Created Transit Gateway Attachment %q to %q for VPC %q", *out.TransitGatewayVpcAttachment.TransitGatewayAttachmentId, spec.TransitGatewayID, s.scope.VPC().ID)
+	s.scope.Info("Created transit gateway attachment", "transit-gateway-attachment-id", *out.TransitGatewayVpcAttachment.TransitGatewayAttachmentId, "transit-gateway-id", spec.TransitGatewayID, "vpc-id", s.scope.VPC().ID)
+
+	return out.TransitGatewayVpcAttachment, nil
+}
+
+func (s *Service) waitForTransitGatewayAttachmentAvailable(id string) error {
+	s.scope.Debug("Waiting for transit gateway attachment to become available", "transit-gateway-attachment-id", id)
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		state, err := s.getTransitGatewayAttachmentState(id)
+		if err != nil {
+			return false, err
+		}
+		switch state {
+		case ec2.TransitGatewayAttachmentStateAvailable:
+			return true, nil
+		case ec2.TransitGatewayAttachmentStateFailed, ec2.TransitGatewayAttachmentStateFailing,
+			ec2.TransitGatewayAttachmentStateRejected, ec2.TransitGatewayAttachmentStateRejecting:
+			return false, errors.Errorf("transit gateway attachment %q is in state %q", id, state)
+		}
+		return false, nil
+	}); err != nil {
+		return errors.Wrapf(err, "failed to wait for transit gateway attachment %q to become available", id)
+	}
+
+	return nil
+}
+
+func (s *Service) reconcileTransitGatewayRouteTableAssociation(attachmentID, routeTableID string) error {
+	association, err := s.getTransitGatewayAttachmentAssociation(attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if association != nil && aws.StringValue(association.TransitGatewayRouteTableId) == routeTableID {
+		return nil
+	}
+
+	// An attachment can only be associated with a single route table, e.g. the default
+	// route table of the transit gateway, so the existing association is removed first.
+	if association != nil {
+		if _, err := s.EC2Client.DisassociateTransitGatewayRouteTableWithContext(context.TODO(), &ec2.DisassociateTransitGatewayRouteTableInput{
+			TransitGatewayAttachmentId: aws.String(attachmentID),
+			TransitGatewayRouteTableId: association.TransitGatewayRouteTableId,
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDisassociateTransitGatewayRouteTable", "Failed to disassociate Transit Gateway Attachment %q from Route Table %q: %v", attachmentID, *association.TransitGatewayRouteTableId, err)
+			return errors.Wrapf(err, "failed to disassociate transit gateway attachment %q from route table %q", attachmentID, *association.TransitGatewayRouteTableId)
+		}
+
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			association, err := s.getTransitGatewayAttachmentAssociation(attachmentID)
+			if err != nil {
+				return false, err
+			}
+			return association == nil, nil
+		}); err != nil {
+			return errors.Wrapf(err, "failed to wait for transit gateway attachment %q to be disassociated", attachmentID)
+		}
+	}
+
+	if _, err := s.EC2Client.AssociateTransitGatewayRouteTableWithContext(context.TODO(), &ec2.AssociateTransitGatewayRouteTableInput{
+		TransitGatewayAttachmentId: aws.String(attachmentID),
+		TransitGatewayRouteTableId: aws.String(routeTableID),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAssociateTransitGatewayRouteTable", "Failed to associate Transit Gateway Attachment %q with Route Table %q: %v", attachmentID, routeTableID, err)
+		return errors.Wrapf(err, "failed to associate transit gateway attachment %q with route table %q", attachmentID, routeTableID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAssociateTransitGatewayRouteTable", "Associated Transit Gateway Attachment %q with Route Table %q", attachmentID, routeTableID)
+
+	return nil
+}
+
+func (s *Service) reconcileTransitGatewayRouteTablePropagation(attachmentID, routeTableID string) error {
+	out, err := s.EC2Client.GetTransitGatewayAttachmentPropagationsWithContext(context.TODO(), &ec2.GetTransitGatewayAttachmentPropagationsInput{
+		TransitGatewayAttachmentId: aws.String(attachmentID),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get route table propagations of transit gateway attachment %q", attachmentID)
+	}
+
+	for _, propagation := range out.TransitGatewayAttachmentPropagations {
+		if aws.StringValue(propagation.TransitGatewayRouteTableId) != routeTableID {
+			continue
+		}
+		switch aws.StringValue(propagation.State) {
+		case ec2.TransitGatewayPropagationStateEnabled, ec2.TransitGatewayPropagationStateEnabling:
+			return nil
+		}
+	}
+
+	if _, err := s.EC2Client.EnableTransitGatewayRouteTablePropagationWithContext(context.TODO(), &ec2.EnableTransitGatewayRouteTablePropagationInput{
+		TransitGatewayAttachmentId: aws.String(attachmentID),
+		TransitGatewayRouteTableId: aws.String(routeTableID),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedEnableTransitGatewayRouteTablePropagation", "Failed to enable propagation of Transit Gateway Attachment %q to Route Table %q: %v", attachmentID, routeTableID, err)
+		return errors.Wrapf(err, "failed to enable propagation of transit gateway attachment %q to route table %q", attachmentID, routeTableID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulEnableTransitGatewayRouteTablePropagation", "Enabled propagation of Transit Gateway Attachment %q to Route Table %q", attachmentID, routeTableID)
+
+	return nil
+}
+
+func (s *Service) deleteTransitGatewayAttachments() error {
+	// Transit gateway APIs are only called when an attachment is configured,
+	// so that clusters not using transit gateways don't need the permissions.
+	if s.scope.TransitGatewayAttachment() == nil {
+		return nil
+	}
+
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping transit gateway attachment deletion in unmanaged mode")
+		return nil
+	}
+
+	if s.scope.VPC().ID == "" {
+		return nil
+	}
+
+	// Attachments are looked up by ownership only, so that attachments to a transit
+	// gateway that was since changed in the spec are removed as well.
+	attachments, err := s.describeTransitGatewayAttachments()
+	if err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments {
+		id := *attachment.TransitGatewayAttachmentId
+		if _, err := s.EC2Client.DeleteTransitGatewayVpcAttachmentWithContext(context.TODO(), &ec2.DeleteTransitGatewayVpcAttachmentInput{
+			TransitGatewayAttachmentId: attachment.TransitGatewayAttachmentId,
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteTransitGatewayAttachment", "Failed to delete Transit Gateway Attachment %q of VPC %q: %v", id, s.scope.VPC().ID, err)
+			return errors.Wrapf(err, "failed to delete transit gateway attachment %q", id)
+		}
+
+		// The attachment holds network interfaces in the subnets, so it needs to be gone before the subnets are deleted.
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			state, err := s.getTransitGatewayAttachmentState(id)
+			if err != nil {
+				return false, err
+			}
+			return state == "" || state == ec2.TransitGatewayAttachmentStateDeleted, nil
+		}); err != nil {
+			return errors.Wrapf(err, "failed to wait for transit gateway attachment %q to be deleted", id)
+		}
+
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteTransitGatewayAttachment", "Deleted Transit Gateway Attachment %q of VPC %q", id, s.scope.VPC().ID)
+		s.scope.Info("Deleted transit gateway attachment", "transit-gateway-attachment-id", id, "vpc-id", s.scope.VPC().ID)
+	}
+
+	return nil
+}
+
+// describeTransitGatewayAttachments returns the active transit gateway attachments of the VPC owned by the cluster.
+func (s *Service) describeTransitGatewayAttachments(filters ...*ec2.Filter) ([]*ec2.TransitGatewayVpcAttachment, error) {
+	input := &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: append([]*ec2.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.TransitGatewayAttachmentStates(activeTransitGatewayAttachmentStates...),
+		}, filters...),
+	}
+
+	out, err := s.EC2Client.DescribeTransitGatewayVpcAttachmentsWithContext(context.TODO(), input)
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeTransitGatewayAttachments", "Failed to describe transit gateway attachments of vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe transit gateway attachments of vpc %q", s.scope.VPC().ID)
+	}
+
+	return out.TransitGatewayVpcAttachments, nil
+}
+
+func (s *Service) getTransitGatewayAttachmentState(id string) (string, error) {
+	out, err := s.EC2Client.DescribeTransitGatewayVpcAttachmentsWithContext(context.TODO(), &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		TransitGatewayAttachmentIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe transit gateway attachment %q", id)
+	}
+	if len(out.TransitGatewayVpcAttachments) == 0 {
+		return "", nil
+	}
+
+	return aws.StringValue(out.TransitGatewayVpcAttachments[0].State), nil
+}
+
+// getTransitGatewayAttachmentAssociation returns the current route table association of an attachment,
+// or nil if the attachment is not associated with a route table.
+func (s *Service) getTransitGatewayAttachmentAssociation(id string) (*ec2.TransitGatewayAttachmentAssociation, error) {
+	out, err := s.EC2Client.DescribeTransitGatewayAttachmentsWithContext(context.TODO(), &ec2.DescribeTransitGatewayAttachmentsInput{
+		TransitGatewayAttachmentIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe transit gateway attachment %q", id)
+	}
+	if len(out.TransitGatewayAttachments) == 0 {
+		return nil, errors.Errorf("transit gateway attachment %q not found", id)
+	}
+
+	association := out.TransitGatewayAttachments[0].Association
+	if association == nil || aws.StringValue(association.State) == ec2.TransitGatewayAssociationStateDisassociated {
+		return nil, nil
+	}
+
+	return association, nil
+}
+
+func (s *Service) getTransitGatewayAttachmentTagParams(id string) infrav1.BuildParams {
+	name := s.scope.Name() + "-tgw-attachment"
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  id,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
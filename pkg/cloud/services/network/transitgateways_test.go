@@ -0,0 +1,436 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+var transitGatewayAttachmentsVPC = infrav1.VPCSpec{
+	ID: "vpc-tgw",
+	Tags: infrav1.Tags{
+		infrav1.ClusterTagKey("test-cluster"): "owned",
+	},
+}
+
+var transitGatewayAttachmentsSubnets = infrav1.Subnets{
+	{
+		ID:               "subnet-private-1a",
+		AvailabilityZone: "us-east-1a",
+	},
+	{
+		ID:               "subnet-private-1a-2",
+		AvailabilityZone: "us-east-1a",
+	},
+	{
+		ID:               "subnet-public-1a",
+		AvailabilityZone: "us-east-1a",
+		IsPublic:         true,
+	},
+	{
+		ID:               "subnet-private-1b",
+		AvailabilityZone: "us-east-1b",
+	},
+}
+
+func describeClusterTransitGatewayAttachments(m *mocks.MockEC2APIMockRecorder, transitGatewayID string, attachments ...*ec2.TransitGatewayVpcAttachment) *gomock.Call {
+	filters := []*ec2.Filter{
+		{
+			Name:   aws.String("vpc-id"),
+			Values: aws.StringSlice([]string{"vpc-tgw"}),
+		},
+		{
+			Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+			Values: aws.StringSlice([]string{"owned"}),
+		},
+		{
+			Name:   aws.String("state"),
+			Values: aws.StringSlice([]string{"initiating", "initiatingRequest", "pendingAcceptance", "pending", "available", "modifying"}),
+		},
+	}
+	if transitGatewayID != "" {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("transit-gateway-id"),
+			Values: aws.StringSlice([]string{transitGatewayID}),
+		})
+	}
+	return m.DescribeTransitGatewayVpcAttachmentsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeTransitGatewayVpcAttachmentsInput{Filters: filters})).
+		Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: attachments}, nil)
+}
+
+func describeTransitGatewayAttachmentState(m *mocks.MockEC2APIMockRecorder, state string) *gomock.Call {
+	return m.DescribeTransitGatewayVpcAttachmentsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		TransitGatewayAttachmentIds: aws.StringSlice([]string{"tgw-attach-01"}),
+	})).Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+		TransitGatewayVpcAttachments: []*ec2.TransitGatewayVpcAttachment{
+			{
+				TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+				State:                      aws.String(state),
+			},
+		},
+	}, nil)
+}
+
+func describeTransitGatewayAttachmentAssociation(m *mocks.MockEC2APIMockRecorder, association *ec2.TransitGatewayAttachmentAssociation) *gomock.Call {
+	return m.DescribeTransitGatewayAttachmentsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeTransitGatewayAttachmentsInput{
+		TransitGatewayAttachmentIds: aws.StringSlice([]string{"tgw-attach-01"}),
+	})).Return(&ec2.DescribeTransitGatewayAttachmentsOutput{
+		TransitGatewayAttachments: []*ec2.TransitGatewayAttachment{
+			{
+				TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+				Association:                association,
+			},
+		},
+	}, nil)
+}
+
+func TestReconcileTransitGatewayAttachment(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name    string
+		input   *infrav1.NetworkSpec
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "Should do nothing if no attachment is configured",
+			input: &infrav1.NetworkSpec{
+				VPC:     transitGatewayAttachmentsVPC,
+				Subnets: transitGatewayAttachmentsSubnets,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should skip the attachment if the vpc is unmanaged",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-tgw",
+				},
+				Subnets: transitGatewayAttachmentsSubnets,
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentSpec{
+					TransitGatewayID: "tgw-01",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should create the attachment in one private subnet per zone and wait for it to become available",
+			input: &infrav1.NetworkSpec{
+				VPC:     transitGatewayAttachmentsVPC,
+				Subnets: transitGatewayAttachmentsSubnets,
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentSpec{
+					TransitGatewayID: "tgw-01",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					describeClusterTransitGatewayAttachments(m, "tgw-01"),
+					m.CreateTransitGatewayVpcAttachmentWithContext(context.TODO(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, input *ec2.CreateTransitGatewayVpcAttachmentInput, _ ...interface{}) (*ec2.CreateTransitGatewayVpcAttachmentOutput, error) {
+							g := NewWithT(t)
+							g.Expect(input.TransitGatewayId).To(Equal(aws.String("tgw-01")))
+							g.Expect(input.VpcId).To(Equal(aws.String("vpc-tgw")))
+							g.Expect(aws.StringValueSlice(input.SubnetIds)).To(Equal([]string{"subnet-private-1a", "subnet-private-1b"}))
+							g.Expect(input.TagSpecifications).To(HaveLen(1))
+							g.Expect(input.TagSpecifications[0].ResourceType).To(Equal(aws.String(ec2.ResourceTypeTransitGatewayAttachment)))
+							return &ec2.CreateTransitGatewayVpcAttachmentOutput{
+								TransitGatewayVpcAttachment: &ec2.TransitGatewayVpcAttachment{
+									TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+									State:                      aws.String(ec2.TransitGatewayAttachmentStatePending),
+								},
+							}, nil
+						}),
+					describeTransitGatewayAttachmentState(m, ec2.TransitGatewayAttachmentStatePending),
+					describeTransitGatewayAttachmentState(m, ec2.TransitGatewayAttachmentStateAvailable),
+				)
+			},
+		},
+		{
+			name: "Should create the attachment in the configured subnets",
+			input: &infrav1.NetworkSpec{
+				VPC:     transitGatewayAttachmentsVPC,
+				Subnets: transitGatewayAttachmentsSubnets,
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentSpec{
+					TransitGatewayID: "tgw-01",
+					SubnetIDs:        []string{"subnet-private-1a-2"},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeClusterTransitGatewayAttachments(m, "tgw-01")
+				m.CreateTransitGatewayVpcAttachmentWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateTransitGatewayVpcAttachmentInput, _ ...interface{}) (*ec2.CreateTransitGatewayVpcAttachmentOutput, error) {
+						NewWithT(t).Expect(aws.StringValueSlice(input.SubnetIds)).To(Equal([]string{"subnet-private-1a-2"}))
+						return &ec2.CreateTransitGatewayVpcAttachmentOutput{
+							TransitGatewayVpcAttachment: &ec2.TransitGatewayVpcAttachment{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+								State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+							},
+						}, nil
+					})
+			},
+		},
+		{
+			name: "Should return an error if the attachment fails",
+			input: &infrav1.NetworkSpec{
+				VPC:     transitGatewayAttachmentsVPC,
+				Subnets: transitGatewayAttachmentsSubnets,
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentSpec{
+					TransitGatewayID: "tgw-01",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeClusterTransitGatewayAttachments(m, "tgw-01", &ec2.TransitGatewayVpcAttachment{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+					State:                      aws.String(ec2.TransitGatewayAttachmentStatePending),
+				})
+				describeTransitGatewayAttachmentState(m, ec2.TransitGatewayAttachmentStateFailed)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should replace the default association and enable propagation to the configured route table",
+			input: &infrav1.NetworkSpec{
+				VPC:     transitGatewayAttachmentsVPC,
+				Subnets: transitGatewayAttachmentsSubnets,
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentSpec{
+					TransitGatewayID:            "tgw-01",
+					RouteTableID:                aws.String("tgw-rtb-01"),
+					EnableRouteTablePropagation: true,
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					describeClusterTransitGatewayAttachments(m, "tgw-01", &ec2.TransitGatewayVpcAttachment{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+						State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+					}),
+					describeTransitGatewayAttachmentAssociation(m, &ec2.TransitGatewayAttachmentAssociation{
+						TransitGatewayRouteTableId: aws.String("tgw-rtb-default"),
+						State:                      aws.String(ec2.TransitGatewayAssociationStateAssociated),
+					}),
+					m.DisassociateTransitGatewayRouteTableWithContext(context.TODO(), gomock.Eq(&ec2.DisassociateTransitGatewayRouteTableInput{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+						TransitGatewayRouteTableId: aws.String("tgw-rtb-default"),
+					})).Return(&ec2.DisassociateTransitGatewayRouteTableOutput{}, nil),
+					describeTransitGatewayAttachmentAssociation(m, nil),
+					m.AssociateTransitGatewayRouteTableWithContext(context.TODO(), gomock.Eq(&ec2.AssociateTransitGatewayRouteTableInput{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+						TransitGatewayRouteTableId: aws.String("tgw-rtb-01"),
+					})).Return(&ec2.AssociateTransitGatewayRouteTableOutput{}, nil),
+					m.GetTransitGatewayAttachmentPropagationsWithContext(context.TODO(), gomock.Eq(&ec2.GetTransitGatewayAttachmentPropagationsInput{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+					})).Return(&ec2.GetTransitGatewayAttachmentPropagationsOutput{}, nil),
+					m.EnableTransitGatewayRouteTablePropagationWithContext(context.TODO(), gomock.Eq(&ec2.EnableTransitGatewayRouteTablePropagationInput{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+						TransitGatewayRouteTableId: aws.String("tgw-rtb-01"),
+					})).Return(&ec2.EnableTransitGatewayRouteTablePropagationOutput{}, nil),
+				)
+			},
+		},
+		{
+			name: "Should do nothing if the attachment is already associated and propagating",
+			input: &infrav1.NetworkSpec{
+				VPC:     transitGatewayAttachmentsVPC,
+				Subnets: transitGatewayAttachmentsSubnets,
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentSpec{
+					TransitGatewayID:            "tgw-01",
+					RouteTableID:                aws.String("tgw-rtb-01"),
+					EnableRouteTablePropagation: true,
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeClusterTransitGatewayAttachments(m, "tgw-01", &ec2.TransitGatewayVpcAttachment{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+					State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+				})
+				describeTransitGatewayAttachmentAssociation(m, &ec2.TransitGatewayAttachmentAssociation{
+					TransitGatewayRouteTableId: aws.String("tgw-rtb-01"),
+					State:                      aws.String(ec2.TransitGatewayAssociationStateAssociated),
+				})
+				m.GetTransitGatewayAttachmentPropagationsWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.GetTransitGatewayAttachmentPropagationsOutput{
+						TransitGatewayAttachmentPropagations: []*ec2.TransitGatewayAttachmentPropagation{
+							{
+								TransitGatewayRouteTableId: aws.String("tgw-rtb-01"),
+								State:                      aws.String(ec2.TransitGatewayPropagationStateEnabled),
+							},
+						},
+					}, nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: *tc.input,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileTransitGatewayAttachment()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.input.TransitGatewayAttachment != nil && tc.input.VPC.IsManaged("test-cluster") {
+				g.Expect(conditions.IsTrue(scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)).To(BeTrue())
+			}
+		})
+	}
+}
+
+func TestDeleteTransitGatewayAttachments(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name    string
+		input   *infrav1.NetworkSpec
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "Should do nothing if no attachment is configured",
+			input: &infrav1.NetworkSpec{
+				VPC: transitGatewayAttachmentsVPC,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should skip deletion if the vpc is unmanaged",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-tgw",
+				},
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentSpec{
+					TransitGatewayID: "tgw-01",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should delete the attachment and wait for it to be deleted",
+			input: &infrav1.NetworkSpec{
+				VPC: transitGatewayAttachmentsVPC,
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentSpec{
+					TransitGatewayID: "tgw-01",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					describeClusterTransitGatewayAttachments(m, "", &ec2.TransitGatewayVpcAttachment{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+						State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+					}),
+					m.DeleteTransitGatewayVpcAttachmentWithContext(context.TODO(), gomock.Eq(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-01"),
+					})).Return(&ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil),
+					describeTransitGatewayAttachmentState(m, ec2.TransitGatewayAttachmentStateDeleting),
+					describeTransitGatewayAttachmentState(m, ec2.TransitGatewayAttachmentStateDeleted),
+				)
+			},
+		},
+		{
+			name: "Should do nothing if there are no attachments",
+			input: &infrav1.NetworkSpec{
+				VPC: transitGatewayAttachmentsVPC,
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachmentSpec{
+					TransitGatewayID: "tgw-01",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeClusterTransitGatewayAttachments(m, "")
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: *tc.input,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.deleteTransitGatewayAttachments()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
@@ -80,7 +80,7 @@ func (s *Service) reconcileVPC() error {
 		// **Only** do this for managed VPCs. Make sure this logic is below the above `vpc.IsUnmanaged` check.
 		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 			buildParams := s.getVPCTagParams(s.scope.VPC().ID)
-			tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+			tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithPolicy(s.scope.TagReconcilePolicy(), s.scope.InfraCluster(), s.scope))
 			if err := tagsBuilder.Ensure(s.scope.VPC().Tags); err != nil {
 				return false, err
 			}
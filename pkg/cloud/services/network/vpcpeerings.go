@@ -0,0 +1,449 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// activeVPCPeeringConnectionStates are the states of VPC peering connections that are neither failed nor being deleted.
+var activeVPCPeeringConnectionStates = []string{
+	ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest,
+	ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+	ec2.VpcPeeringConnectionStateReasonCodeProvisioning,
+	ec2.VpcPeeringConnectionStateReasonCodeActive,
+}
+
+func (s *Service) reconcileVPCPeerings() error {
+	peerings := s.scope.VPCPeerings()
+	if len(peerings) == 0 {
+		return nil
+	}
+
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping VPC peering connections reconcile in unmanaged mode")
+		return nil
+	}
+
+	s.scope.Debug("Reconciling VPC peering connections")
+
+	existing, err := s.describeVPCPeeringConnections()
+	if err != nil {
+		return err
+	}
+
+	var routeTables []*ec2.RouteTable
+	pendingAcceptance := []string{}
+	for i := range peerings {
+		spec := &peerings[i]
+
+		pcx, ok := existing[spec.PeerVPCID]
+		if !ok {
+			pcx, err = s.createVPCPeeringConnection(spec)
+			if err != nil {
+				return err
+			}
+		}
+
+		if vpcPeeringConnectionState(pcx) == ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest {
+			if pcx, err = s.waitForVPCPeeringConnection(*pcx.VpcPeeringConnectionId); err != nil {
+				return err
+			}
+		}
+
+		if vpcPeeringConnectionState(pcx) == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
+			if !spec.AutoAccept || !s.canManageVPCPeeringAccepter(pcx) {
+				s.scope.Info("VPC peering connection is waiting to be accepted by the owner of the peer VPC", "vpc-peering-connection-id", *pcx.VpcPeeringConnectionId, "peer-vpc-id", spec.PeerVPCID)
+				pendingAcceptance = append(pendingAcceptance, *pcx.VpcPeeringConnectionId)
+				continue
+			}
+			if pcx, err = s.acceptVPCPeeringConnection(*pcx.VpcPeeringConnectionId); err != nil {
+				return err
+			}
+		}
+
+		if vpcPeeringConnectionState(pcx) != ec2.VpcPeeringConnectionStateReasonCodeActive {
+			if pcx, err = s.waitForVPCPeeringConnection(*pcx.VpcPeeringConnectionId); err != nil {
+				return err
+			}
+		}
+
+		// Routes are only added once the peering connection is active, as the CIDR blocks
+		// of the peer VPC are not known before.
+		peerCidrBlocks := vpcPeeringCidrBlocks(pcx.AccepterVpcInfo)
+		if err := s.checkVPCPeeringRouteConflicts(pcx, peerCidrBlocks); err != nil {
+			return err
+		}
+		if routeTables == nil {
+			if routeTables, err = s.describeVpcRouteTables(); err != nil {
+				return err
+			}
+		}
+		for _, rt := range routeTables {
+			if err := s.reconcileVPCPeeringRoutes(rt, pcx, peerCidrBlocks, true); err != nil {
+				return err
+			}
+		}
+
+		if s.canManageVPCPeeringAccepter(pcx) {
+			if err := s.reconcileVPCPeeringAccepterRoutes(pcx); err != nil {
+				if !awserrors.IsPermissionsError(errors.Cause(err)) {
+					return err
+				}
+				record.Warnf(s.scope.InfraCluster(), "FailedCreateVPCPeeringRoutes", "Not allowed to add routes for VPC Peering Connection %q to peer VPC %q: %v", *pcx.VpcPeeringConnectionId, spec.PeerVPCID, err)
+			}
+		}
+	}
+
+	if len(pendingAcceptance) > 0 {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcPeeringsReadyCondition, infrav1.VpcPeeringsPendingAcceptanceReason, clusterv1.ConditionSeverityInfo, "VPC peering connections %v are waiting to be accepted", pendingAcceptance)
+		return nil
+	}
+
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.VpcPeeringsReadyCondition)
+	return nil
+}
+
+func (s *Service) createVPCPeeringConnection(spec *infrav1.VPCPeeringSpec) (*ec2.VpcPeeringConnection, error) {
+	input := &ec2.CreateVpcPeeringConnectionInput{
+		VpcId:       aws.String(s.scope.VPC().ID),
+		PeerVpcId:   aws.String(spec.PeerVPCID),
+		PeerOwnerId: spec.PeerOwnerID,
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeVpcPeeringConnection, s.getVPCPeeringConnectionTagParams(services.TemporaryResourceID, spec.PeerVPCID)),
+		},
+	}
+	if spec.PeerRegion != "" {
+		input.PeerRegion = aws.String(spec.PeerRegion)
+	}
+
+	out, err := s.EC2Client.CreateVpcPeeringConnectionWithContext(context.TODO(), input)
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateVPCPeeringConnection", "Failed to create VPC Peering Connection from VPC %q to %q: %v", s.scope.VPC().ID, spec.PeerVPCID, err)
+		return nil, errors.Wrapf(err, "failed to create vpc peering connection from vpc %q to %q", s.scope.VPC().ID, spec.PeerVPCID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateVPCPeeringConnection", "Created VPC Peering Connection %q from VPC %q to %q", *out.VpcPeeringConnection.VpcPeeringConnectionId, s.scope.VPC().ID, spec.PeerVPCID)
+	s.scope.Info("Created VPC peering connection", "vpc-peering-connection-id", *out.VpcPeeringConnection.VpcPeeringConnectionId, "vpc-id", s.scope.VPC().ID, "peer-vpc-id", spec.PeerVPCID)
+
+	return out.VpcPeeringConnection, nil
+}
+
+func (s *Service) acceptVPCPeeringConnection(id string) (*ec2.VpcPeeringConnection, error) {
+	out, err := s.EC2Client.AcceptVpcPeeringConnectionWithContext(context.TODO(), &ec2.AcceptVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(id),
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAcceptVPCPeeringConnection", "Failed to accept VPC Peering Connection %q: %v", id, err)
+		return nil, errors.Wrapf(err, "failed to accept vpc peering connection %q", id)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAcceptVPCPeeringConnection", "Accepted VPC Peering Connection %q", id)
+	s.scope.Info("Accepted VPC peering connection", "vpc-peering-connection-id", id)
+
+	return out.VpcPeeringConnection, nil
+}
+
+// waitForVPCPeeringConnection waits for a VPC peering connection to settle in a state that
+// requires no further action from AWS, i.e. pending acceptance or active.
+func (s *Service) waitForVPCPeeringConnection(id string) (*ec2.VpcPeeringConnection, error) {
+	s.scope.Debug("Waiting for VPC peering connection", "vpc-peering-connection-id", id)
+
+	var pcx *ec2.VpcPeeringConnection
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		var err error
+		if pcx, err = s.getVPCPeeringConnection(id); err != nil {
+			return false, err
+		}
+		switch code := vpcPeeringConnectionState(pcx); code {
+		case ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, ec2.VpcPeeringConnectionStateReasonCodeActive:
+			return true, nil
+		case ec2.VpcPeeringConnectionStateReasonCodeFailed,
+			ec2.VpcPeeringConnectionStateReasonCodeRejected,
+			ec2.VpcPeeringConnectionStateReasonCodeExpired,
+			ec2.VpcPeeringConnectionStateReasonCodeDeleting,
+			ec2.VpcPeeringConnectionStateReasonCodeDeleted:
+			return false, errors.Errorf("vpc peering connection %q is in unexpected state %q: %s", id, code, aws.StringValue(pcx.Status.Message))
+		}
+		return false, nil
+	}, awserrors.VPCPeeringConnectionNotFound); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedVPCPeeringConnection", "VPC Peering Connection %q did not become ready: %v", id, err)
+		return nil, errors.Wrapf(err, "failed to wait for vpc peering connection %q", id)
+	}
+
+	return pcx, nil
+}
+
+// canManageVPCPeeringAccepter returns whether the accepter side of a VPC peering connection can be managed
+// with the credentials and region of the cluster, i.e. whether the peer VPC is in the same account and region.
+func (s *Service) canManageVPCPeeringAccepter(pcx *ec2.VpcPeeringConnection) bool {
+	if pcx.RequesterVpcInfo == nil || pcx.AccepterVpcInfo == nil {
+		return false
+	}
+	if aws.StringValue(pcx.RequesterVpcInfo.OwnerId) != aws.StringValue(pcx.AccepterVpcInfo.OwnerId) {
+		return false
+	}
+	region := aws.StringValue(pcx.AccepterVpcInfo.Region)
+	return region == "" || region == s.scope.Region()
+}
+
+// reconcileVPCPeeringAccepterRoutes adds the routes back to the cluster VPC to the route tables of the peer VPC.
+func (s *Service) reconcileVPCPeeringAccepterRoutes(pcx *ec2.VpcPeeringConnection) error {
+	routeTables, err := s.describePeerVpcRouteTables(*pcx.AccepterVpcInfo.VpcId)
+	if err != nil {
+		return err
+	}
+
+	for _, rt := range routeTables {
+		if err := s.reconcileVPCPeeringRoutes(rt, pcx, vpcPeeringCidrBlocks(pcx.RequesterVpcInfo), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkVPCPeeringRouteConflicts returns an error if an additional route of the spec has the same destination as one
+// of the CIDR blocks of the peer VPC, as the routes of the peering would otherwise overwrite the additional route
+// in the route tables of the cluster, and the other way around.
+func (s *Service) checkVPCPeeringRouteConflicts(pcx *ec2.VpcPeeringConnection, cidrBlocks []string) error {
+	additionalRoutes := make(map[string]struct{}, len(s.scope.AdditionalRoutes()))
+	for _, route := range s.scope.AdditionalRoutes() {
+		if _, destination, err := net.ParseCIDR(route.DestinationCidrBlock); err == nil {
+			additionalRoutes[destination.String()] = struct{}{}
+		}
+	}
+
+	for _, cidrBlock := range cidrBlocks {
+		_, destination, err := net.ParseCIDR(cidrBlock)
+		if err != nil {
+			continue
+		}
+		if _, ok := additionalRoutes[destination.String()]; ok {
+			record.Warnf(s.scope.InfraCluster(), "ConflictingVPCPeeringRoute", "Additional route to %q conflicts with the route to the peer VPC of VPC Peering Connection %q", cidrBlock, *pcx.VpcPeeringConnectionId)
+			return errors.Errorf("additional route to %q conflicts with the route to the peer VPC of vpc peering connection %q", cidrBlock, *pcx.VpcPeeringConnectionId)
+		}
+	}
+	return nil
+}
+
+// reconcileVPCPeeringRoutes makes sure a route table routes the given CIDR blocks through a VPC peering connection.
+// Conflicting routes are replaced only if replace is set, i.e. for the route tables managed by the provider.
+func (s *Service) reconcileVPCPeeringRoutes(rt *ec2.RouteTable, pcx *ec2.VpcPeeringConnection, cidrBlocks []string, replace bool) error {
+	currentRoutes := make(map[string]*ec2.Route, len(rt.Routes))
+	for _, route := range rt.Routes {
+		if route.DestinationCidrBlock != nil {
+			currentRoutes[*route.DestinationCidrBlock] = route
+		}
+	}
+
+	for _, cidrBlock := range cidrBlocks {
+		route := &ec2.Route{
+			DestinationCidrBlock:   aws.String(cidrBlock),
+			VpcPeeringConnectionId: pcx.VpcPeeringConnectionId,
+		}
+
+		currentRoute, ok := currentRoutes[cidrBlock]
+		switch {
+		case !ok:
+			if err := s.createRoute(rt, route); err != nil {
+				return err
+			}
+		case getRouteTarget(currentRoute) == *pcx.VpcPeeringConnectionId:
+			continue
+		case replace:
+			if err := s.replaceRoute(rt, route); err != nil {
+				return err
+			}
+		default:
+			s.scope.Info("Route table already routes the peered CIDR block to another target, skipping", "route-table-id", *rt.RouteTableId, "destination-cidr-block", cidrBlock, "target", getRouteTarget(currentRoute))
+		}
+	}
+	return nil
+}
+
+func (s *Service) deleteVPCPeerings() error {
+	if len(s.scope.VPCPeerings()) == 0 {
+		return nil
+	}
+
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping VPC peering connections deletion in unmanaged mode")
+		return nil
+	}
+
+	if s.scope.VPC().ID == "" {
+		s.scope.Trace("Skipping VPC peering connections deletion, VPC ID is missing")
+		return nil
+	}
+
+	existing, err := s.describeVPCPeeringConnections()
+	if err != nil {
+		return err
+	}
+
+	for _, pcx := range existing {
+		if vpcPeeringConnectionState(pcx) == ec2.VpcPeeringConnectionStateReasonCodeActive && s.canManageVPCPeeringAccepter(pcx) {
+			if err := s.deleteVPCPeeringAccepterRoutes(pcx); err != nil {
+				if !awserrors.IsPermissionsError(errors.Cause(err)) {
+					return err
+				}
+				record.Warnf(s.scope.InfraCluster(), "FailedDeleteVPCPeeringRoutes", "Not allowed to delete routes for VPC Peering Connection %q from peer VPC %q: %v", *pcx.VpcPeeringConnectionId, *pcx.AccepterVpcInfo.VpcId, err)
+			}
+		}
+
+		if _, err := s.EC2Client.DeleteVpcPeeringConnectionWithContext(context.TODO(), &ec2.DeleteVpcPeeringConnectionInput{
+			VpcPeeringConnectionId: pcx.VpcPeeringConnectionId,
+		}); err != nil {
+			if code, ok := awserrors.Code(err); ok && code == awserrors.VPCPeeringConnectionNotFound {
+				continue
+			}
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteVPCPeeringConnection", "Failed to delete VPC Peering Connection %q: %v", *pcx.VpcPeeringConnectionId, err)
+			return errors.Wrapf(err, "failed to delete vpc peering connection %q", *pcx.VpcPeeringConnectionId)
+		}
+
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteVPCPeeringConnection", "Deleted VPC Peering Connection %q", *pcx.VpcPeeringConnectionId)
+		s.scope.Info("Deleted VPC peering connection", "vpc-peering-connection-id", *pcx.VpcPeeringConnectionId)
+	}
+
+	return nil
+}
+
+// deleteVPCPeeringAccepterRoutes removes the routes through a VPC peering connection from the route tables of the peer VPC,
+// which would otherwise be left behind as blackhole routes.
+func (s *Service) deleteVPCPeeringAccepterRoutes(pcx *ec2.VpcPeeringConnection) error {
+	routeTables, err := s.describePeerVpcRouteTables(*pcx.AccepterVpcInfo.VpcId)
+	if err != nil {
+		return err
+	}
+
+	for _, rt := range routeTables {
+		for _, route := range rt.Routes {
+			if route.DestinationCidrBlock == nil || aws.StringValue(route.VpcPeeringConnectionId) != *pcx.VpcPeeringConnectionId {
+				continue
+			}
+			if _, err := s.EC2Client.DeleteRouteWithContext(context.TODO(), &ec2.DeleteRouteInput{
+				RouteTableId:         rt.RouteTableId,
+				DestinationCidrBlock: route.DestinationCidrBlock,
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedDeleteRoute", "Failed to delete route to %q from RouteTable %q: %v", *route.DestinationCidrBlock, *rt.RouteTableId, err)
+				return errors.Wrapf(err, "failed to delete route to %q from route table %q", *route.DestinationCidrBlock, *rt.RouteTableId)
+			}
+			record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteRoute", "Deleted route to %q from RouteTable %q", *route.DestinationCidrBlock, *rt.RouteTableId)
+		}
+	}
+	return nil
+}
+
+// describeVPCPeeringConnections returns the VPC peering connections requested by the cluster VPC, keyed by peer VPC ID.
+func (s *Service) describeVPCPeeringConnections() (map[string]*ec2.VpcPeeringConnection, error) {
+	out, err := s.EC2Client.DescribeVpcPeeringConnectionsWithContext(context.TODO(), &ec2.DescribeVpcPeeringConnectionsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPCPeeringRequester(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.VPCPeeringConnectionStates(activeVPCPeeringConnectionStates...),
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeVPCPeeringConnections", "Failed to describe VPC peering connections in vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe vpc peering connections in vpc %q", s.scope.VPC().ID)
+	}
+
+	res := make(map[string]*ec2.VpcPeeringConnection, len(out.VpcPeeringConnections))
+	for _, pcx := range out.VpcPeeringConnections {
+		if pcx.AccepterVpcInfo == nil || pcx.AccepterVpcInfo.VpcId == nil {
+			continue
+		}
+		res[*pcx.AccepterVpcInfo.VpcId] = pcx
+	}
+	return res, nil
+}
+
+func (s *Service) getVPCPeeringConnection(id string) (*ec2.VpcPeeringConnection, error) {
+	out, err := s.EC2Client.DescribeVpcPeeringConnectionsWithContext(context.TODO(), &ec2.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe vpc peering connection %q", id)
+	}
+	if len(out.VpcPeeringConnections) == 0 {
+		return nil, errors.Errorf("vpc peering connection %q not found", id)
+	}
+	return out.VpcPeeringConnections[0], nil
+}
+
+func (s *Service) describePeerVpcRouteTables(vpcID string) ([]*ec2.RouteTable, error) {
+	out, err := s.EC2Client.DescribeRouteTablesWithContext(context.TODO(), &ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(vpcID),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe route tables in peer vpc %q", vpcID)
+	}
+	return out.RouteTables, nil
+}
+
+func vpcPeeringConnectionState(pcx *ec2.VpcPeeringConnection) string {
+	if pcx.Status == nil {
+		return ""
+	}
+	return aws.StringValue(pcx.Status.Code)
+}
+
+// vpcPeeringCidrBlocks returns the IPv4 CIDR blocks of one side of a VPC peering connection.
+func vpcPeeringCidrBlocks(info *ec2.VpcPeeringConnectionVpcInfo) []string {
+	if info == nil {
+		return nil
+	}
+
+	cidrBlocks := []string{}
+	for _, block := range info.CidrBlockSet {
+		if block.CidrBlock != nil {
+			cidrBlocks = append(cidrBlocks, *block.CidrBlock)
+		}
+	}
+	if len(cidrBlocks) == 0 && info.CidrBlock != nil {
+		cidrBlocks = append(cidrBlocks, *info.CidrBlock)
+	}
+	return cidrBlocks
+}
+
+func (s *Service) getVPCPeeringConnectionTagParams(id, peerVPCID string) infrav1.BuildParams {
+	name := s.scope.Name() + "-pcx-" + peerVPCID
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  id,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
@@ -0,0 +1,469 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+var vpcPeeringsVPC = infrav1.VPCSpec{
+	ID:        "vpc-cluster",
+	CidrBlock: "10.0.0.0/16",
+	Tags: infrav1.Tags{
+		infrav1.ClusterTagKey("test-cluster"): "owned",
+	},
+}
+
+func newVPCPeeringConnection(status string, accepterOwnerID string) *ec2.VpcPeeringConnection {
+	return &ec2.VpcPeeringConnection{
+		VpcPeeringConnectionId: aws.String("pcx-01"),
+		Status: &ec2.VpcPeeringConnectionStateReason{
+			Code: aws.String(status),
+		},
+		RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+			VpcId:     aws.String("vpc-cluster"),
+			OwnerId:   aws.String("111111111111"),
+			Region:    aws.String("us-east-1"),
+			CidrBlock: aws.String("10.0.0.0/16"),
+		},
+		AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+			VpcId:   aws.String("vpc-peer"),
+			OwnerId: aws.String(accepterOwnerID),
+			Region:  aws.String("us-east-1"),
+			CidrBlockSet: []*ec2.CidrBlock{
+				{CidrBlock: aws.String("172.16.0.0/16")},
+			},
+		},
+	}
+}
+
+func describeClusterVPCPeeringConnections(m *mocks.MockEC2APIMockRecorder, pcxs ...*ec2.VpcPeeringConnection) *gomock.Call {
+	return m.DescribeVpcPeeringConnectionsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcPeeringConnectionsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("requester-vpc-info.vpc-id"),
+				Values: aws.StringSlice([]string{"vpc-cluster"}),
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: aws.StringSlice([]string{"owned"}),
+			},
+			{
+				Name:   aws.String("status-code"),
+				Values: aws.StringSlice([]string{"initiating-request", "pending-acceptance", "provisioning", "active"}),
+			},
+		},
+	})).Return(&ec2.DescribeVpcPeeringConnectionsOutput{VpcPeeringConnections: pcxs}, nil)
+}
+
+func describeVPCPeeringConnection(m *mocks.MockEC2APIMockRecorder, pcx *ec2.VpcPeeringConnection) *gomock.Call {
+	return m.DescribeVpcPeeringConnectionsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: aws.StringSlice([]string{"pcx-01"}),
+	})).Return(&ec2.DescribeVpcPeeringConnectionsOutput{VpcPeeringConnections: []*ec2.VpcPeeringConnection{pcx}}, nil)
+}
+
+func describeClusterRouteTablesForPeering(m *mocks.MockEC2APIMockRecorder, routes ...*ec2.Route) *gomock.Call {
+	return m.DescribeRouteTablesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{"vpc-cluster"}),
+			},
+			{
+				Name:   aws.String("tag-key"),
+				Values: aws.StringSlice([]string{"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"}),
+			},
+		},
+	})).Return(&ec2.DescribeRouteTablesOutput{
+		RouteTables: []*ec2.RouteTable{
+			{
+				RouteTableId: aws.String("rtb-cluster"),
+				Routes:       routes,
+			},
+		},
+	}, nil)
+}
+
+func describePeerRouteTables(m *mocks.MockEC2APIMockRecorder, routes ...*ec2.Route) *gomock.Call {
+	return m.DescribeRouteTablesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{"vpc-peer"}),
+			},
+		},
+	})).Return(&ec2.DescribeRouteTablesOutput{
+		RouteTables: []*ec2.RouteTable{
+			{
+				RouteTableId: aws.String("rtb-peer"),
+				Routes:       routes,
+			},
+		},
+	}, nil)
+}
+
+func TestReconcileVPCPeerings(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name          string
+		input         *infrav1.NetworkSpec
+		expect        func(m *mocks.MockEC2APIMockRecorder)
+		wantErr       bool
+		wantCondition *clusterv1.Condition
+	}{
+		{
+			name: "Should do nothing if no peering is configured",
+			input: &infrav1.NetworkSpec{
+				VPC: vpcPeeringsVPC,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should skip the peerings if the vpc is unmanaged",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-cluster",
+				},
+				VPCPeerings: []infrav1.VPCPeeringSpec{
+					{PeerVPCID: "vpc-peer", AutoAccept: true},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should create, accept and route a same-account peering in both directions",
+			input: &infrav1.NetworkSpec{
+				VPC: vpcPeeringsVPC,
+				VPCPeerings: []infrav1.VPCPeeringSpec{
+					{PeerVPCID: "vpc-peer", AutoAccept: true},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					describeClusterVPCPeeringConnections(m),
+					m.CreateVpcPeeringConnectionWithContext(context.TODO(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, input *ec2.CreateVpcPeeringConnectionInput, _ ...interface{}) (*ec2.CreateVpcPeeringConnectionOutput, error) {
+							g := NewWithT(t)
+							g.Expect(input.VpcId).To(Equal(aws.String("vpc-cluster")))
+							g.Expect(input.PeerVpcId).To(Equal(aws.String("vpc-peer")))
+							g.Expect(input.PeerOwnerId).To(BeNil())
+							g.Expect(input.PeerRegion).To(BeNil())
+							g.Expect(input.TagSpecifications).To(HaveLen(1))
+							g.Expect(input.TagSpecifications[0].ResourceType).To(Equal(aws.String(ec2.ResourceTypeVpcPeeringConnection)))
+							return &ec2.CreateVpcPeeringConnectionOutput{
+								VpcPeeringConnection: newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest, "111111111111"),
+							}, nil
+						}),
+					describeVPCPeeringConnection(m, newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, "111111111111")),
+					m.AcceptVpcPeeringConnectionWithContext(context.TODO(), gomock.Eq(&ec2.AcceptVpcPeeringConnectionInput{
+						VpcPeeringConnectionId: aws.String("pcx-01"),
+					})).Return(&ec2.AcceptVpcPeeringConnectionOutput{
+						VpcPeeringConnection: newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodeProvisioning, "111111111111"),
+					}, nil),
+					describeVPCPeeringConnection(m, newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive, "111111111111")),
+					describeClusterRouteTablesForPeering(m),
+					m.CreateRouteWithContext(context.TODO(), gomock.Eq(&ec2.CreateRouteInput{
+						RouteTableId:           aws.String("rtb-cluster"),
+						DestinationCidrBlock:   aws.String("172.16.0.0/16"),
+						VpcPeeringConnectionId: aws.String("pcx-01"),
+					})).Return(&ec2.CreateRouteOutput{}, nil),
+					describePeerRouteTables(m),
+					m.CreateRouteWithContext(context.TODO(), gomock.Eq(&ec2.CreateRouteInput{
+						RouteTableId:           aws.String("rtb-peer"),
+						DestinationCidrBlock:   aws.String("10.0.0.0/16"),
+						VpcPeeringConnectionId: aws.String("pcx-01"),
+					})).Return(&ec2.CreateRouteOutput{}, nil),
+				)
+			},
+			wantCondition: &clusterv1.Condition{Type: infrav1.VpcPeeringsReadyCondition, Status: "True"},
+		},
+		{
+			name: "Should return an error if an additional route has the same destination as the peer vpc",
+			input: &infrav1.NetworkSpec{
+				VPC: vpcPeeringsVPC,
+				VPCPeerings: []infrav1.VPCPeeringSpec{
+					{PeerVPCID: "vpc-peer", AutoAccept: true},
+				},
+				AdditionalRoutes: []infrav1.RouteSpec{
+					{
+						DestinationCidrBlock: "172.16.0.0/16",
+						TransitGatewayID:     aws.String("tgw-01"),
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeClusterVPCPeeringConnections(m, newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive, "111111111111"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should only create the requester side of a cross-account peering",
+			input: &infrav1.NetworkSpec{
+				VPC: vpcPeeringsVPC,
+				VPCPeerings: []infrav1.VPCPeeringSpec{
+					{PeerVPCID: "vpc-peer", PeerOwnerID: aws.String("222222222222"), AutoAccept: true},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					describeClusterVPCPeeringConnections(m),
+					m.CreateVpcPeeringConnectionWithContext(context.TODO(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, input *ec2.CreateVpcPeeringConnectionInput, _ ...interface{}) (*ec2.CreateVpcPeeringConnectionOutput, error) {
+							NewWithT(t).Expect(input.PeerOwnerId).To(Equal(aws.String("222222222222")))
+							return &ec2.CreateVpcPeeringConnectionOutput{
+								VpcPeeringConnection: newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest, "222222222222"),
+							}, nil
+						}),
+					describeVPCPeeringConnection(m, newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, "222222222222")),
+				)
+			},
+			wantCondition: &clusterv1.Condition{Type: infrav1.VpcPeeringsReadyCondition, Status: "False", Reason: infrav1.VpcPeeringsPendingAcceptanceReason},
+		},
+		{
+			name: "Should only route the requester side of an accepted cross-account peering",
+			input: &infrav1.NetworkSpec{
+				VPC: vpcPeeringsVPC,
+				VPCPeerings: []infrav1.VPCPeeringSpec{
+					{PeerVPCID: "vpc-peer", PeerOwnerID: aws.String("222222222222")},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeClusterVPCPeeringConnections(m, newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive, "222222222222"))
+				describeClusterRouteTablesForPeering(m)
+				m.CreateRouteWithContext(context.TODO(), gomock.Eq(&ec2.CreateRouteInput{
+					RouteTableId:           aws.String("rtb-cluster"),
+					DestinationCidrBlock:   aws.String("172.16.0.0/16"),
+					VpcPeeringConnectionId: aws.String("pcx-01"),
+				})).Return(&ec2.CreateRouteOutput{}, nil)
+			},
+			wantCondition: &clusterv1.Condition{Type: infrav1.VpcPeeringsReadyCondition, Status: "True"},
+		},
+		{
+			name: "Should not wait for acceptance of a same-account peering without auto-accept",
+			input: &infrav1.NetworkSpec{
+				VPC: vpcPeeringsVPC,
+				VPCPeerings: []infrav1.VPCPeeringSpec{
+					{PeerVPCID: "vpc-peer"},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeClusterVPCPeeringConnections(m, newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, "111111111111"))
+			},
+			wantCondition: &clusterv1.Condition{Type: infrav1.VpcPeeringsReadyCondition, Status: "False", Reason: infrav1.VpcPeeringsPendingAcceptanceReason},
+		},
+		{
+			name: "Should leave existing routes alone and not override routes of the peer vpc",
+			input: &infrav1.NetworkSpec{
+				VPC: vpcPeeringsVPC,
+				VPCPeerings: []infrav1.VPCPeeringSpec{
+					{PeerVPCID: "vpc-peer", AutoAccept: true},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeClusterVPCPeeringConnections(m, newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive, "111111111111"))
+				describeClusterRouteTablesForPeering(m, &ec2.Route{
+					DestinationCidrBlock:   aws.String("172.16.0.0/16"),
+					VpcPeeringConnectionId: aws.String("pcx-01"),
+				})
+				describePeerRouteTables(m, &ec2.Route{
+					DestinationCidrBlock: aws.String("10.0.0.0/16"),
+					TransitGatewayId:     aws.String("tgw-01"),
+				})
+			},
+			wantCondition: &clusterv1.Condition{Type: infrav1.VpcPeeringsReadyCondition, Status: "True"},
+		},
+		{
+			name: "Should return an error if the peering is rejected",
+			input: &infrav1.NetworkSpec{
+				VPC: vpcPeeringsVPC,
+				VPCPeerings: []infrav1.VPCPeeringSpec{
+					{PeerVPCID: "vpc-peer", AutoAccept: true},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeClusterVPCPeeringConnections(m, newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest, "111111111111"))
+				describeVPCPeeringConnection(m, newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodeFailed, "111111111111"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						Region:      "us-east-1",
+						NetworkSpec: *tc.input,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileVPCPeerings()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.wantCondition != nil {
+				condition := conditions.Get(scope.InfraCluster(), tc.wantCondition.Type)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(tc.wantCondition.Status))
+				g.Expect(condition.Reason).To(Equal(tc.wantCondition.Reason))
+			}
+		})
+	}
+}
+
+func TestDeleteVPCPeerings(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name    string
+		input   *infrav1.NetworkSpec
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "Should do nothing if no peering is configured",
+			input: &infrav1.NetworkSpec{
+				VPC: vpcPeeringsVPC,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should remove the routes of the peer vpc before deleting a same-account peering",
+			input: &infrav1.NetworkSpec{
+				VPC: vpcPeeringsVPC,
+				VPCPeerings: []infrav1.VPCPeeringSpec{
+					{PeerVPCID: "vpc-peer", AutoAccept: true},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					describeClusterVPCPeeringConnections(m, newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive, "111111111111")),
+					describePeerRouteTables(m,
+						&ec2.Route{
+							DestinationCidrBlock:   aws.String("10.0.0.0/16"),
+							VpcPeeringConnectionId: aws.String("pcx-01"),
+						},
+						&ec2.Route{
+							DestinationCidrBlock: aws.String("192.168.0.0/16"),
+							TransitGatewayId:     aws.String("tgw-01"),
+						},
+					),
+					m.DeleteRouteWithContext(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+						RouteTableId:         aws.String("rtb-peer"),
+						DestinationCidrBlock: aws.String("10.0.0.0/16"),
+					})).Return(&ec2.DeleteRouteOutput{}, nil),
+					m.DeleteVpcPeeringConnectionWithContext(context.TODO(), gomock.Eq(&ec2.DeleteVpcPeeringConnectionInput{
+						VpcPeeringConnectionId: aws.String("pcx-01"),
+					})).Return(&ec2.DeleteVpcPeeringConnectionOutput{}, nil),
+				)
+			},
+		},
+		{
+			name: "Should only delete the peering connection of a cross-account peering",
+			input: &infrav1.NetworkSpec{
+				VPC: vpcPeeringsVPC,
+				VPCPeerings: []infrav1.VPCPeeringSpec{
+					{PeerVPCID: "vpc-peer", PeerOwnerID: aws.String("222222222222")},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeClusterVPCPeeringConnections(m, newVPCPeeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive, "222222222222"))
+				m.DeleteVpcPeeringConnectionWithContext(context.TODO(), gomock.Eq(&ec2.DeleteVpcPeeringConnectionInput{
+					VpcPeeringConnectionId: aws.String("pcx-01"),
+				})).Return(&ec2.DeleteVpcPeeringConnectionOutput{}, nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						Region:      "us-east-1",
+						NetworkSpec: *tc.input,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.deleteVPCPeerings()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
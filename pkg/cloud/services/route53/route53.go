@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package route53 provides a service to manage the Route53 records of a cluster.
+package route53
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// Service holds a collection of interfaces.
+// The interfaces are broken down like this to group functions together.
+// One alternative is to have a large list of functions from the ec2 client.
+type Service struct {
+	scope         scope.Route53Scope
+	Route53Client route53iface.Route53API
+}
+
+// NewService returns a new service given the api clients.
+func NewService(route53Scope scope.Route53Scope) *Service {
+	return &Service{
+		scope:         route53Scope,
+		Route53Client: scope.NewRoute53Client(route53Scope, route53Scope, route53Scope, route53Scope.InfraCluster()),
+	}
+}
+
+// ReconcilePublicDNSRecord ensures the alias record of the control plane endpoint in the public hosted zone
+// points at the control plane load balancer. An existing record pointing elsewhere is never overwritten.
+func (s *Service) ReconcilePublicDNSRecord() error {
+	dns := s.scope.PublicDNS()
+	if dns == nil {
+		return nil
+	}
+
+	lb := s.scope.Network().APIServerELB
+	if lb.DNSName == "" || lb.CanonicalHostedZoneID == "" {
+		return errors.Errorf("DNS name or hosted zone of control plane load balancer %q is not known yet", lb.Name)
+	}
+
+	existing, err := s.describeRecord(dns)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		if isAliasOf(existing, &lb) {
+			return nil
+		}
+		// The record wasn't created by the provider, don't take it over.
+		record.Warnf(s.scope.InfraCluster(), "FailedUpsertDNSRecord", "Record %q in hosted zone %q already exists and doesn't point at the control plane load balancer", dns.RecordName, dns.HostedZoneID)
+		return errors.Errorf("record %q in hosted zone %q already exists and doesn't point at control plane load balancer %q", dns.RecordName, dns.HostedZoneID, lb.Name)
+	}
+
+	s.scope.Debug("Upserting control plane endpoint record", "hosted-zone-id", dns.HostedZoneID, "record-name", dns.RecordName, "dns-name", lb.DNSName)
+	if err := s.changeRecord(dns, route53.ChangeActionUpsert, &route53.ResourceRecordSet{
+		Name: aws.String(fqdn(dns.RecordName)),
+		Type: aws.String(route53.RRTypeA),
+		AliasTarget: &route53.AliasTarget{
+			DNSName:              aws.String(lb.DNSName),
+			HostedZoneId:         aws.String(lb.CanonicalHostedZoneID),
+			EvaluateTargetHealth: aws.Bool(false),
+		},
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedUpsertDNSRecord", "Failed to upsert record %q in hosted zone %q: %v", dns.RecordName, dns.HostedZoneID, err)
+		return errors.Wrapf(err, "failed to upsert record %q in hosted zone %q", dns.RecordName, dns.HostedZoneID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulUpsertDNSRecord", "Pointed record %q in hosted zone %q at %q", dns.RecordName, dns.HostedZoneID, lb.DNSName)
+
+	return nil
+}
+
+// DeletePublicDNSRecord removes the alias record of the control plane endpoint from the public hosted zone.
+// Records that don't point at the control plane load balancer are left untouched.
+func (s *Service) DeletePublicDNSRecord() error {
+	dns := s.scope.PublicDNS()
+	if dns == nil {
+		return nil
+	}
+
+	existing, err := s.describeRecord(dns)
+	if err != nil {
+		if code, _ := awserrors.Code(errors.Cause(err)); code == route53.ErrCodeNoSuchHostedZone {
+			return nil
+		}
+		return err
+	}
+	if existing == nil {
+		return nil
+	}
+	lb := s.scope.Network().APIServerELB
+	if !isAliasOf(existing, &lb) {
+		s.scope.Info("Record of the control plane endpoint doesn't point at the control plane load balancer, leaving it in place", "record-name", dns.RecordName)
+		return nil
+	}
+
+	s.scope.Debug("Deleting control plane endpoint record", "hosted-zone-id", dns.HostedZoneID, "record-name", dns.RecordName)
+	if err := s.changeRecord(dns, route53.ChangeActionDelete, existing); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteDNSRecord", "Failed to delete record %q in hosted zone %q: %v", dns.RecordName, dns.HostedZoneID, err)
+		return errors.Wrapf(err, "failed to delete record %q in hosted zone %q", dns.RecordName, dns.HostedZoneID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteDNSRecord", "Deleted record %q in hosted zone %q", dns.RecordName, dns.HostedZoneID)
+
+	return nil
+}
+
+// describeRecord returns the A record of the control plane endpoint, or nil if it doesn't exist.
+func (s *Service) describeRecord(dns *infrav1.PublicDNS) (*route53.ResourceRecordSet, error) {
+	out, err := s.Route53Client.ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(dns.HostedZoneID),
+		StartRecordName: aws.String(fqdn(dns.RecordName)),
+		StartRecordType: aws.String(route53.RRTypeA),
+		MaxItems:        aws.String("1"),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list records in hosted zone %q", dns.HostedZoneID)
+	}
+
+	// Records are listed starting at the given name and type, so the first one may be another record.
+	for _, rs := range out.ResourceRecordSets {
+		if fqdn(aws.StringValue(rs.Name)) == fqdn(dns.RecordName) && aws.StringValue(rs.Type) == route53.RRTypeA {
+			return rs, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Service) changeRecord(dns *infrav1.PublicDNS, action string, rs *route53.ResourceRecordSet) error {
+	_, err := s.Route53Client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(dns.HostedZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String(fmt.Sprintf("Control plane endpoint of cluster %s/%s", s.scope.Namespace(), s.scope.InfraClusterName())),
+			Changes: []*route53.Change{
+				{
+					Action:            aws.String(action),
+					ResourceRecordSet: rs,
+				},
+			},
+		},
+	})
+	return err
+}
+
+// isAliasOf returns true if the record is an alias of the given load balancer.
+// Aliases of load balancers created by the console are prefixed with "dualstack.".
+func isAliasOf(rs *route53.ResourceRecordSet, lb *infrav1.LoadBalancer) bool {
+	if rs.AliasTarget == nil || lb.DNSName == "" {
+		return false
+	}
+	return strings.TrimPrefix(fqdn(aws.StringValue(rs.AliasTarget.DNSName)), "dualstack.") == fqdn(lb.DNSName)
+}
+
+// fqdn returns the given DNS name in the lower case, fully qualified form returned by Route53.
+func fqdn(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, ".")) + "."
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/route53/mock_route53iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	testClusterName  = "test-cluster"
+	testHostedZoneID = "Z0123456789ABCDEFGHIJ"
+	testRecordName   = "api.mycluster.example.com"
+	testLBDNSName    = "test-cluster-apiserver-0123456789.elb.us-east-1.amazonaws.com"
+	testLBZoneID     = "Z35SXDOTRQ7X7K"
+)
+
+var testPublicDNS = &infrav1.PublicDNS{
+	HostedZoneID: testHostedZoneID,
+	RecordName:   testRecordName,
+}
+
+func listRecordsInput() *route53.ListResourceRecordSetsInput {
+	return &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(testHostedZoneID),
+		StartRecordName: aws.String(testRecordName + "."),
+		StartRecordType: aws.String(route53.RRTypeA),
+		MaxItems:        aws.String("1"),
+	}
+}
+
+func aliasRecord(name, dnsName string) *route53.ResourceRecordSet {
+	return &route53.ResourceRecordSet{
+		Name: aws.String(name),
+		Type: aws.String(route53.RRTypeA),
+		AliasTarget: &route53.AliasTarget{
+			DNSName:              aws.String(dnsName),
+			HostedZoneId:         aws.String(testLBZoneID),
+			EvaluateTargetHealth: aws.Bool(false),
+		},
+	}
+}
+
+func changeRecordInput(action string, rs *route53.ResourceRecordSet) *route53.ChangeResourceRecordSetsInput {
+	return &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(testHostedZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String("Control plane endpoint of cluster default/test-cluster"),
+			Changes: []*route53.Change{
+				{
+					Action:            aws.String(action),
+					ResourceRecordSet: rs,
+				},
+			},
+		},
+	}
+}
+
+func TestReconcilePublicDNSRecord(t *testing.T) {
+	tests := []struct {
+		name          string
+		publicDNS     *infrav1.PublicDNS
+		lb            infrav1.LoadBalancer
+		expectRoute53 func(m *mock_route53iface.MockRoute53APIMockRecorder)
+		wantErr       bool
+	}{
+		{
+			name:          "does nothing when no public DNS is configured",
+			lb:            infrav1.LoadBalancer{DNSName: testLBDNSName, CanonicalHostedZoneID: testLBZoneID},
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {},
+		},
+		{
+			name:          "returns an error when the hosted zone of the load balancer is not known yet",
+			publicDNS:     testPublicDNS,
+			lb:            infrav1.LoadBalancer{DNSName: testLBDNSName},
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {},
+			wantErr:       true,
+		},
+		{
+			name:      "creates an alias record pointing at the load balancer",
+			publicDNS: testPublicDNS,
+			lb:        infrav1.LoadBalancer{DNSName: testLBDNSName, CanonicalHostedZoneID: testLBZoneID},
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(listRecordsInput()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []*route53.ResourceRecordSet{
+						{Name: aws.String("example.com."), Type: aws.String(route53.RRTypeSoa)},
+					},
+				}, nil)
+				m.ChangeResourceRecordSets(changeRecordInput(route53.ChangeActionUpsert, aliasRecord(testRecordName+".", testLBDNSName))).
+					Return(&route53.ChangeResourceRecordSetsOutput{}, nil)
+			},
+		},
+		{
+			name:      "returns an error when the record points at another load balancer",
+			publicDNS: testPublicDNS,
+			lb:        infrav1.LoadBalancer{DNSName: testLBDNSName, CanonicalHostedZoneID: testLBZoneID},
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(listRecordsInput()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []*route53.ResourceRecordSet{
+						aliasRecord(testRecordName+".", "other-apiserver.elb.us-east-1.amazonaws.com."),
+					},
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "returns an error when the record isn't an alias",
+			publicDNS: testPublicDNS,
+			lb:        infrav1.LoadBalancer{DNSName: testLBDNSName, CanonicalHostedZoneID: testLBZoneID},
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(listRecordsInput()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []*route53.ResourceRecordSet{
+						{
+							Name:            aws.String(testRecordName + "."),
+							Type:            aws.String(route53.RRTypeA),
+							ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("192.0.2.10")}},
+						},
+					},
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "does nothing when the record already points at the load balancer",
+			publicDNS: testPublicDNS,
+			lb:        infrav1.LoadBalancer{DNSName: testLBDNSName, CanonicalHostedZoneID: testLBZoneID},
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(listRecordsInput()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []*route53.ResourceRecordSet{
+						aliasRecord(testRecordName+".", "dualstack."+testLBDNSName+"."),
+					},
+				}, nil)
+			},
+		},
+		{
+			name:      "returns an error when the record can't be upserted",
+			publicDNS: testPublicDNS,
+			lb:        infrav1.LoadBalancer{DNSName: testLBDNSName, CanonicalHostedZoneID: testLBZoneID},
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(listRecordsInput()).Return(&route53.ListResourceRecordSetsOutput{}, nil)
+				m.ChangeResourceRecordSets(gomock.AssignableToTypeOf(&route53.ChangeResourceRecordSetsInput{})).
+					Return(nil, awserr.New(route53.ErrCodeInvalidChangeBatch, "record name is not permitted in the zone", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			route53Mock := mock_route53iface.NewMockRoute53API(mockCtrl)
+			tt.expectRoute53(route53Mock.EXPECT())
+
+			s := &Service{
+				scope:         newClusterScope(t, tt.publicDNS, tt.lb),
+				Route53Client: route53Mock,
+			}
+
+			err := s.ReconcilePublicDNSRecord()
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestDeletePublicDNSRecord(t *testing.T) {
+	tests := []struct {
+		name          string
+		publicDNS     *infrav1.PublicDNS
+		expectRoute53 func(m *mock_route53iface.MockRoute53APIMockRecorder)
+		wantErr       bool
+	}{
+		{
+			name:          "does nothing when no public DNS is configured",
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {},
+		},
+		{
+			name:      "deletes the alias record of the load balancer",
+			publicDNS: testPublicDNS,
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(listRecordsInput()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []*route53.ResourceRecordSet{
+						aliasRecord(testRecordName+".", testLBDNSName+"."),
+					},
+				}, nil)
+				m.ChangeResourceRecordSets(changeRecordInput(route53.ChangeActionDelete, aliasRecord(testRecordName+".", testLBDNSName+"."))).
+					Return(&route53.ChangeResourceRecordSetsOutput{}, nil)
+			},
+		},
+		{
+			name:      "does nothing when the record doesn't exist",
+			publicDNS: testPublicDNS,
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(listRecordsInput()).Return(&route53.ListResourceRecordSetsOutput{}, nil)
+			},
+		},
+		{
+			name:      "leaves records pointing elsewhere in place",
+			publicDNS: testPublicDNS,
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(listRecordsInput()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []*route53.ResourceRecordSet{
+						aliasRecord(testRecordName+".", "other-apiserver.elb.us-east-1.amazonaws.com."),
+					},
+				}, nil)
+			},
+		},
+		{
+			name:      "does nothing when the hosted zone doesn't exist",
+			publicDNS: testPublicDNS,
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(listRecordsInput()).
+					Return(nil, awserr.New(route53.ErrCodeNoSuchHostedZone, "no hosted zone found", nil))
+			},
+		},
+		{
+			name:      "returns an error when the record can't be deleted",
+			publicDNS: testPublicDNS,
+			expectRoute53: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(listRecordsInput()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []*route53.ResourceRecordSet{
+						aliasRecord(testRecordName+".", testLBDNSName+"."),
+					},
+				}, nil)
+				m.ChangeResourceRecordSets(gomock.AssignableToTypeOf(&route53.ChangeResourceRecordSetsInput{})).
+					Return(nil, awserr.New("AccessDenied", "not authorized to perform route53:ChangeResourceRecordSets", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			route53Mock := mock_route53iface.NewMockRoute53API(mockCtrl)
+			tt.expectRoute53(route53Mock.EXPECT())
+
+			s := &Service{
+				scope:         newClusterScope(t, tt.publicDNS, infrav1.LoadBalancer{DNSName: testLBDNSName, CanonicalHostedZoneID: testLBZoneID}),
+				Route53Client: route53Mock,
+			}
+
+			err := s.DeletePublicDNSRecord()
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func newClusterScope(t *testing.T, publicDNS *infrav1.PublicDNS, lb infrav1.LoadBalancer) *scope.ClusterScope {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: testClusterName, Namespace: "default"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: testClusterName, Namespace: "default"},
+			Spec: infrav1.AWSClusterSpec{
+				PublicDNS: publicDNS,
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					APIServerELB: lb,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
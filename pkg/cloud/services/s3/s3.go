@@ -18,6 +18,7 @@ package s3
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -38,6 +40,9 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/util/system"
 )
 
+// bootDiagnosticsKeyPrefix is the prefix of the keys of the boot diagnostics of the machines in the bucket.
+const bootDiagnosticsKeyPrefix = "boot-diagnostics"
+
 // Service holds a collection of interfaces.
 // The interfaces are broken down like this to group functions together.
 // One alternative is to have a large list of functions from the ec2 client.
@@ -49,7 +54,11 @@ type Service struct {
 
 // NewService returns a new service given the api clients.
 func NewService(s3Scope scope.S3Scope) *Service {
-	s3Client := scope.NewS3Client(s3Scope, s3Scope, s3Scope, s3Scope.InfraCluster())
+	var region string
+	if bucket := s3Scope.Bucket(); bucket != nil {
+		region = bucket.Region
+	}
+	s3Client := scope.NewS3Client(s3Scope, s3Scope, s3Scope, s3Scope.InfraCluster(), region)
 	STSClient := scope.NewSTSClient(s3Scope, s3Scope, s3Scope, s3Scope.InfraCluster())
 
 	return &Service{
@@ -66,10 +75,17 @@ func (s *Service) ReconcileBucket() error {
 
 	bucketName := s.bucketName()
 
-	if err := s.createBucketIfNotExist(bucketName); err != nil {
+	owned, err := s.createBucketIfNotExist(bucketName)
+	if err != nil {
 		return errors.Wrap(err, "ensuring bucket exists")
 	}
 
+	// A bucket owned by another account is used as is.
+	if !owned {
+		s.scope.Debug("Bucket is owned by another account, skipping tagging and policy", "bucket_name", bucketName)
+		return nil
+	}
+
 	if err := s.tagBucket(bucketName); err != nil {
 		return errors.Wrap(err, "tagging bucket")
 	}
@@ -92,8 +108,21 @@ func (s *Service) DeleteBucket() error {
 
 	log.Info("Deleting S3 Bucket")
 
-	_, err := s.S3Client.DeleteBucket(&s3.DeleteBucketInput{
-		Bucket: aws.String(bucketName),
+	accountID, err := s.STSClient.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return errors.Wrap(err, "getting account ID")
+	}
+
+	// The boot diagnostics of the machines are kept until the cluster is deleted.
+	if err := s.deleteBootDiagnostics(bucketName, accountID.Account); err != nil {
+		return err
+	}
+
+	// A bucket owned by another account is never deleted: S3 denies the request when the bucket isn't owned by the
+	// expected account.
+	_, err = s.S3Client.DeleteBucket(&s3.DeleteBucketInput{
+		Bucket:              aws.String(bucketName),
+		ExpectedBucketOwner: accountID.Account,
 	})
 	if err == nil {
 		return nil
@@ -109,6 +138,8 @@ func (s *Service) DeleteBucket() error {
 		log.Info("Bucket already removed")
 	case "BucketNotEmpty":
 		log.Info("Bucket not empty, skipping removal")
+	case "AccessDenied":
+		log.Info("Bucket is owned by another account, skipping removal")
 	default:
 		return errors.Wrap(aerr, "deleting S3 bucket")
 	}
@@ -116,6 +147,57 @@ func (s *Service) DeleteBucket() error {
 	return nil
 }
 
+// deleteBootDiagnostics deletes the boot diagnostics of all the machines from the bucket, unless it is owned by
+// another account.
+func (s *Service) deleteBootDiagnostics(bucketName string, accountID *string) error {
+	var objects []*s3.ObjectIdentifier
+	err := s.S3Client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket:              aws.String(bucketName),
+		Prefix:              aws.String(bootDiagnosticsKeyPrefix + "/"),
+		ExpectedBucketOwner: accountID,
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			objects = append(objects, &s3.ObjectIdentifier{Key: object.Key})
+		}
+		return true
+	})
+	if err != nil {
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			return errors.Wrap(err, "listing boot diagnostics objects")
+		}
+
+		switch aerr.Code() {
+		case s3.ErrCodeNoSuchBucket, "AccessDenied":
+			return nil
+		default:
+			return errors.Wrap(aerr, "listing boot diagnostics objects")
+		}
+	}
+
+	// DeleteObjects deletes up to 1000 objects per request.
+	const maxObjectsPerRequest = 1000
+	for start := 0; start < len(objects); start += maxObjectsPerRequest {
+		end := min(start+maxObjectsPerRequest, len(objects))
+
+		s.scope.Info("Deleting boot diagnostics objects", "bucket_name", bucketName, "count", end-start)
+
+		out, err := s.S3Client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket:              aws.String(bucketName),
+			Delete:              &s3.Delete{Objects: objects[start:end], Quiet: aws.Bool(true)},
+			ExpectedBucketOwner: accountID,
+		})
+		if err != nil {
+			return errors.Wrap(err, "deleting boot diagnostics objects")
+		}
+		if len(out.Errors) > 0 {
+			return errors.Errorf("failed to delete boot diagnostics object %q: %s", aws.StringValue(out.Errors[0].Key), aws.StringValue(out.Errors[0].Message))
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) Create(m *scope.MachineScope, data []byte) (string, error) {
 	if !s.bucketManagementEnabled() {
 		return "", errors.New("requested object creation but bucket management is not enabled")
@@ -134,13 +216,8 @@ func (s *Service) Create(m *scope.MachineScope, data []byte) (string, error) {
 
 	s.scope.Info("Creating object", "bucket_name", bucket, "key", key)
 
-	if _, err := s.S3Client.PutObject(&s3.PutObjectInput{
-		Body:                 aws.ReadSeekCloser(bytes.NewReader(data)),
-		Bucket:               aws.String(bucket),
-		Key:                  aws.String(key),
-		ServerSideEncryption: aws.String("aws:kms"),
-	}); err != nil {
-		return "", errors.Wrap(err, "putting object")
+	if err := s.putObject(bucket, key, data); err != nil {
+		return "", err
 	}
 
 	if exp := s.scope.Bucket().PresignedURLDuration; exp != nil {
@@ -161,6 +238,67 @@ func (s *Service) Create(m *scope.MachineScope, data []byte) (string, error) {
 	return objectURL.String(), nil
 }
 
+// CreateBootDiagnostics uploads the boot diagnostics files of a machine to the bucket, and returns the location
+// they were uploaded to.
+func (s *Service) CreateBootDiagnostics(m *scope.MachineScope, files map[string][]byte) (string, error) {
+	if !s.bucketManagementEnabled() {
+		return "", errors.New("requested object creation but bucket management is not enabled")
+	}
+
+	if m == nil {
+		return "", errors.New("machine scope can't be nil")
+	}
+
+	bucket := s.bucketName()
+	prefix := s.bootDiagnosticsPrefix(m)
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		key := path.Join(prefix, name)
+		s.scope.Info("Creating boot diagnostics object", "bucket_name", bucket, "key", key)
+		if err := s.putObject(bucket, key, files[name]); err != nil {
+			return "", err
+		}
+	}
+
+	location := &url.URL{
+		Scheme: "s3",
+		Host:   bucket,
+		Path:   prefix + "/",
+	}
+
+	return location.String(), nil
+}
+
+func (s *Service) putObject(bucket, key string, data []byte) error {
+	input := &s3.PutObjectInput{
+		Body:                 aws.ReadSeekCloser(bytes.NewReader(data)),
+		Bucket:               aws.String(bucket),
+		Key:                  aws.String(key),
+		ServerSideEncryption: aws.String("aws:kms"),
+	}
+
+	if encryptionContext := s.scope.Bucket().EncryptionContext; len(encryptionContext) > 0 {
+		// S3 expects the encryption context as base64-encoded JSON.
+		rawEncryptionContext, err := json.Marshal(encryptionContext)
+		if err != nil {
+			return errors.Wrap(err, "marshaling encryption context")
+		}
+		input.SSEKMSEncryptionContext = aws.String(base64.StdEncoding.EncodeToString(rawEncryptionContext))
+	}
+
+	if _, err := s.S3Client.PutObject(input); err != nil {
+		return errors.Wrap(err, "putting object")
+	}
+
+	return nil
+}
+
 func (s *Service) Delete(m *scope.MachineScope) error {
 	if !s.bucketManagementEnabled() {
 		return errors.New("requested object creation but bucket management is not enabled")
@@ -222,24 +360,30 @@ func (s *Service) Delete(m *scope.MachineScope) error {
 	return nil
 }
 
-func (s *Service) createBucketIfNotExist(bucketName string) error {
+// createBucketIfNotExist creates the bucket if it doesn't exist yet, and returns whether the bucket is owned by the
+// account of the cluster.
+func (s *Service) createBucketIfNotExist(bucketName string) (bool, error) {
 	input := &s3.CreateBucketInput{
 		Bucket: aws.String(bucketName),
-		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
-			LocationConstraint: aws.String(s.scope.Region()),
-		},
+	}
+
+	// Buckets in us-east-1 must be created without a location constraint.
+	if region := s.bucketRegion(); region != endpoints.UsEast1RegionID {
+		input.CreateBucketConfiguration = &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String(region),
+		}
 	}
 
 	_, err := s.S3Client.CreateBucket(input)
 	if err == nil {
 		s.scope.Info("Created bucket", "bucket_name", bucketName)
 
-		return nil
+		return true, nil
 	}
 
 	aerr, ok := err.(awserr.Error)
 	if !ok {
-		return errors.Wrap(err, "creating S3 bucket")
+		return false, errors.Wrap(err, "creating S3 bucket")
 	}
 
 	switch aerr.Code() {
@@ -247,9 +391,35 @@ func (s *Service) createBucketIfNotExist(bucketName string) error {
 	//
 	// TODO: This will fail if bucket is shared with other cluster.
 	case s3.ErrCodeBucketAlreadyOwnedByYou:
+		return true, nil
+	// If bucket already exists in another account, it can only be used if it's accessible.
+	case s3.ErrCodeBucketAlreadyExists:
+		return false, s.ensureBucketAccessible(bucketName)
+	default:
+		return false, errors.Wrap(aerr, "creating S3 bucket")
+	}
+}
+
+func (s *Service) ensureBucketAccessible(bucketName string) error {
+	_, err := s.S3Client.HeadBucket(&s3.HeadBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err == nil {
 		return nil
+	}
+
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return errors.Wrap(err, "checking S3 bucket access")
+	}
+
+	switch aerr.Code() {
+	case "Forbidden":
+		return errors.Errorf("bucket %q already exists and is not accessible", bucketName)
+	case "MovedPermanently", "BadRequest":
+		return errors.Errorf("bucket %q already exists in a region other than %q", bucketName, s.bucketRegion())
 	default:
-		return errors.Wrap(aerr, "creating S3 bucket")
+		return errors.Wrap(aerr, "checking S3 bucket access")
 	}
 }
 
@@ -317,7 +487,7 @@ func (s *Service) bucketPolicy(bucketName string) (string, error) {
 	}
 
 	bucket := s.scope.Bucket()
-	partition := system.GetPartitionFromRegion(s.scope.Region())
+	partition := system.GetPartitionFromRegion(s.bucketRegion())
 
 	statements := []iam.StatementEntry{
 		{
@@ -383,7 +553,18 @@ func (s *Service) bucketName() string {
 	return s.scope.Bucket().Name
 }
 
+func (s *Service) bucketRegion() string {
+	if region := s.scope.Bucket().Region; region != "" {
+		return region
+	}
+	return s.scope.Region()
+}
+
 func (s *Service) bootstrapDataKey(m *scope.MachineScope) string {
 	// Use machine name as object key.
 	return path.Join(m.Role(), m.Name())
 }
+
+func (s *Service) bootDiagnosticsPrefix(m *scope.MachineScope) string {
+	return path.Join(bootDiagnosticsKeyPrefix, m.Name())
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package s3_test
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -108,6 +110,78 @@ func TestReconcileBucket(t *testing.T) {
 		}
 	})
 
+	t.Run("creates_bucket_with_configured_name_in_configured_region", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBucketName := "org-bootstrap-data"
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{
+			Name:   expectedBucketName,
+			Region: "eu-central-1",
+		})
+
+		input := &s3svc.CreateBucketInput{
+			Bucket: aws.String(expectedBucketName),
+			CreateBucketConfiguration: &s3svc.CreateBucketConfiguration{
+				LocationConstraint: aws.String("eu-central-1"),
+			},
+		}
+
+		s3Mock.EXPECT().CreateBucket(gomock.Eq(input)).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any()).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileBucket(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("creates_bucket_in_us_east_1_without_location_constraint", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBucketName := "org-bootstrap-data"
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{
+			Name:   expectedBucketName,
+			Region: "us-east-1",
+		})
+
+		input := &s3svc.CreateBucketInput{
+			Bucket: aws.String(expectedBucketName),
+		}
+
+		s3Mock.EXPECT().CreateBucket(gomock.Eq(input)).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any()).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileBucket(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("uses_existing_bucket_owned_by_another_account_when_accessible", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{
+			Name:   "org-bootstrap-data",
+			Region: "eu-central-1",
+		})
+
+		err := awserr.New(s3svc.ErrCodeBucketAlreadyExists, "err", errors.New("err"))
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any()).Return(nil, err).Times(1)
+		s3Mock.EXPECT().HeadBucket(gomock.Eq(&s3svc.HeadBucketInput{
+			Bucket: aws.String("org-bootstrap-data"),
+		})).Return(&s3svc.HeadBucketOutput{}, nil).Times(1)
+		// The bucket of another account is neither tagged nor given a policy.
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any()).Times(0)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any()).Times(0)
+
+		if err := svc.ReconcileBucket(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
 	t.Run("hashes_default_bucket_name_if_name_exceeds_maximum_length", func(t *testing.T) {
 		t.Parallel()
 
@@ -276,6 +350,37 @@ func TestReconcileBucket(t *testing.T) {
 			}
 		})
 
+		t.Run("existing_bucket_is_not_accessible", func(t *testing.T) {
+			t.Parallel()
+
+			svc, s3Mock := testService(t, &infrav1.S3Bucket{
+				Name: "org-bootstrap-data",
+			})
+
+			s3Mock.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New(s3svc.ErrCodeBucketAlreadyExists, "", nil)).Times(1)
+			s3Mock.EXPECT().HeadBucket(gomock.Any()).Return(nil, awserr.New("Forbidden", "", nil)).Times(1)
+
+			if err := svc.ReconcileBucket(); err == nil {
+				t.Fatalf("Expected error")
+			}
+		})
+
+		t.Run("existing_bucket_is_in_another_region", func(t *testing.T) {
+			t.Parallel()
+
+			svc, s3Mock := testService(t, &infrav1.S3Bucket{
+				Name:   "org-bootstrap-data",
+				Region: "eu-central-1",
+			})
+
+			s3Mock.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New(s3svc.ErrCodeBucketAlreadyExists, "", nil)).Times(1)
+			s3Mock.EXPECT().HeadBucket(gomock.Any()).Return(nil, awserr.New("MovedPermanently", "", nil)).Times(1)
+
+			if err := svc.ReconcileBucket(); err == nil {
+				t.Fatalf("Expected error")
+			}
+		})
+
 		t.Run("generating_bucket_policy_fails", func(t *testing.T) {
 			t.Parallel()
 
@@ -333,9 +438,11 @@ func TestDeleteBucket(t *testing.T) {
 		})
 
 		input := &s3svc.DeleteBucketInput{
-			Bucket: aws.String(bucketName),
+			Bucket:              aws.String(bucketName),
+			ExpectedBucketOwner: aws.String("foo"),
 		}
 
+		s3Mock.EXPECT().ListObjectsV2Pages(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		s3Mock.EXPECT().DeleteBucket(input).Return(nil, nil).Times(1)
 
 		if err := svc.DeleteBucket(); err != nil {
@@ -350,6 +457,7 @@ func TestDeleteBucket(t *testing.T) {
 
 			svc, s3Mock := testService(t, &infrav1.S3Bucket{})
 
+			s3Mock.EXPECT().ListObjectsV2Pages(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			s3Mock.EXPECT().DeleteBucket(gomock.Any()).Return(nil, errors.New("err")).Times(1)
 
 			if err := svc.DeleteBucket(); err == nil {
@@ -362,6 +470,7 @@ func TestDeleteBucket(t *testing.T) {
 
 			svc, s3Mock := testService(t, &infrav1.S3Bucket{})
 
+			s3Mock.EXPECT().ListObjectsV2Pages(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			s3Mock.EXPECT().DeleteBucket(gomock.Any()).Return(nil, awserr.New("foo", "", nil)).Times(1)
 
 			if err := svc.DeleteBucket(); err == nil {
@@ -375,6 +484,7 @@ func TestDeleteBucket(t *testing.T) {
 
 		svc, s3Mock := testService(t, &infrav1.S3Bucket{})
 
+		s3Mock.EXPECT().ListObjectsV2Pages(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		s3Mock.EXPECT().DeleteBucket(gomock.Any()).Return(nil, awserr.New(s3svc.ErrCodeNoSuchBucket, "", nil)).Times(1)
 
 		if err := svc.DeleteBucket(); err != nil {
@@ -382,17 +492,105 @@ func TestDeleteBucket(t *testing.T) {
 		}
 	})
 
+	t.Run("skips_bucket_removal_when_bucket_is_owned_by_another_account", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{})
+
+		s3Mock.EXPECT().ListObjectsV2Pages(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		s3Mock.EXPECT().DeleteBucket(gomock.Any()).Return(nil, awserr.New("AccessDenied", "", nil)).Times(1)
+
+		if err := svc.DeleteBucket(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
 	t.Run("skips_bucket_removal_when_bucket_is_not_empty", func(t *testing.T) {
 		t.Parallel()
 
 		svc, s3Mock := testService(t, &infrav1.S3Bucket{})
 
+		s3Mock.EXPECT().ListObjectsV2Pages(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		s3Mock.EXPECT().DeleteBucket(gomock.Any()).Return(nil, awserr.New("BucketNotEmpty", "", nil)).Times(1)
 
 		if err := svc.DeleteBucket(); err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
 	})
+
+	t.Run("deletes_boot_diagnostics_before_bucket", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{
+			Name: bucketName,
+		})
+
+		listInput := &s3svc.ListObjectsV2Input{
+			Bucket:              aws.String(bucketName),
+			Prefix:              aws.String("boot-diagnostics/"),
+			ExpectedBucketOwner: aws.String("foo"),
+		}
+		deleteObjectsInput := &s3svc.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3svc.Delete{
+				Objects: []*s3svc.ObjectIdentifier{
+					{Key: aws.String("boot-diagnostics/machine-1/console-output.txt")},
+					{Key: aws.String("boot-diagnostics/machine-1/console-screenshot.jpg")},
+				},
+				Quiet: aws.Bool(true),
+			},
+			ExpectedBucketOwner: aws.String("foo"),
+		}
+		deleteBucketInput := &s3svc.DeleteBucketInput{
+			Bucket:              aws.String(bucketName),
+			ExpectedBucketOwner: aws.String("foo"),
+		}
+
+		gomock.InOrder(
+			s3Mock.EXPECT().ListObjectsV2Pages(listInput, gomock.Any()).DoAndReturn(func(_ *s3svc.ListObjectsV2Input, fn func(*s3svc.ListObjectsV2Output, bool) bool) error {
+				fn(&s3svc.ListObjectsV2Output{Contents: []*s3svc.Object{{Key: aws.String("boot-diagnostics/machine-1/console-output.txt")}}}, false)
+				fn(&s3svc.ListObjectsV2Output{Contents: []*s3svc.Object{{Key: aws.String("boot-diagnostics/machine-1/console-screenshot.jpg")}}}, true)
+				return nil
+			}).Times(1),
+			s3Mock.EXPECT().DeleteObjects(deleteObjectsInput).Return(&s3svc.DeleteObjectsOutput{}, nil).Times(1),
+			s3Mock.EXPECT().DeleteBucket(deleteBucketInput).Return(nil, nil).Times(1),
+		)
+
+		if err := svc.DeleteBucket(); err != nil {
+			t.Fatalf("Unexpected error, got: %v", err)
+		}
+	})
+
+	t.Run("returns_error_when_boot_diagnostics_removal_fails", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{})
+
+		s3Mock.EXPECT().ListObjectsV2Pages(gomock.Any(), gomock.Any()).DoAndReturn(func(_ *s3svc.ListObjectsV2Input, fn func(*s3svc.ListObjectsV2Output, bool) bool) error {
+			fn(&s3svc.ListObjectsV2Output{Contents: []*s3svc.Object{{Key: aws.String("boot-diagnostics/machine-1/console-output.txt")}}}, true)
+			return nil
+		}).Times(1)
+		s3Mock.EXPECT().DeleteObjects(gomock.Any()).Return(&s3svc.DeleteObjectsOutput{
+			Errors: []*s3svc.Error{{Key: aws.String("boot-diagnostics/machine-1/console-output.txt"), Message: aws.String("Access Denied")}},
+		}, nil).Times(1)
+
+		if err := svc.DeleteBucket(); err == nil {
+			t.Fatalf("Expected error")
+		}
+	})
+
+	t.Run("skips_boot_diagnostics_removal_when_bucket_is_owned_by_another_account", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{})
+
+		s3Mock.EXPECT().ListObjectsV2Pages(gomock.Any(), gomock.Any()).Return(awserr.New("AccessDenied", "", nil)).Times(1)
+		s3Mock.EXPECT().DeleteBucket(gomock.Any()).Return(nil, awserr.New("AccessDenied", "", nil)).Times(1)
+
+		if err := svc.DeleteBucket(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
 }
 
 func TestCreateObject(t *testing.T) {
@@ -484,6 +682,55 @@ func TestCreateObject(t *testing.T) {
 		})
 	})
 
+	t.Run("sets_configured_encryption_context", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{
+			Name: bucketName,
+			EncryptionContext: map[string]string{
+				"cluster": "test",
+				"purpose": "bootstrap",
+			},
+		})
+
+		machineScope := &scope.MachineScope{
+			Machine: &clusterv1.Machine{},
+			AWSMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			},
+		}
+
+		s3Mock.EXPECT().PutObject(gomock.Any()).Do(func(putObjectInput *s3svc.PutObjectInput) {
+			if putObjectInput.SSEKMSEncryptionContext == nil {
+				t.Fatalf("Expected encryption context to be set")
+			}
+
+			rawEncryptionContext, err := base64.StdEncoding.DecodeString(*putObjectInput.SSEKMSEncryptionContext)
+			if err != nil {
+				t.Fatalf("Decoding encryption context: %v", err)
+			}
+
+			encryptionContext := map[string]string{}
+			if err := json.Unmarshal(rawEncryptionContext, &encryptionContext); err != nil {
+				t.Fatalf("Unmarshaling encryption context: %v", err)
+			}
+
+			expectedEncryptionContext := map[string]string{
+				"cluster": "test",
+				"purpose": "bootstrap",
+			}
+			if !reflect.DeepEqual(encryptionContext, expectedEncryptionContext) {
+				t.Fatalf("Unexpected encryption context %v, expected %v", encryptionContext, expectedEncryptionContext)
+			}
+		}).Return(nil, nil).Times(1)
+
+		if _, err := svc.Create(machineScope, []byte("foo")); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
 	t.Run("is_idempotent", func(t *testing.T) {
 		t.Parallel()
 
@@ -605,6 +852,115 @@ func TestCreateObject(t *testing.T) {
 	})
 }
 
+func TestCreateBootDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bucketName  = "foo"
+		machineName = "aws-test1"
+	)
+
+	t.Run("uploads_files_under_machine_prefix", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{
+			Name:            bucketName,
+			BootDiagnostics: &infrav1.BootDiagnostics{},
+		})
+
+		machineScope := &scope.MachineScope{
+			Machine: &clusterv1.Machine{},
+			AWSMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: machineName,
+				},
+			},
+		}
+
+		files := map[string][]byte{
+			"console-output.txt":     []byte("kernel panic"),
+			"console-screenshot.jpg": []byte("jpeg"),
+		}
+
+		uploaded := map[string][]byte{}
+		s3Mock.EXPECT().PutObject(gomock.Any()).Do(func(putObjectInput *s3svc.PutObjectInput) {
+			if *putObjectInput.Bucket != bucketName {
+				t.Errorf("Expected object to be created in bucket %q, got %q", bucketName, *putObjectInput.Bucket)
+			}
+
+			data, err := io.ReadAll(putObjectInput.Body)
+			if err != nil {
+				t.Errorf("Reading put object body: %v", err)
+			}
+			uploaded[*putObjectInput.Key] = data
+		}).Return(nil, nil).Times(2)
+
+		location, err := svc.CreateBootDiagnostics(machineScope, files)
+		if err != nil {
+			t.Fatalf("Unexpected error, got: %v", err)
+		}
+
+		expectedUploaded := map[string][]byte{
+			"boot-diagnostics/aws-test1/console-output.txt":     []byte("kernel panic"),
+			"boot-diagnostics/aws-test1/console-screenshot.jpg": []byte("jpeg"),
+		}
+		if !reflect.DeepEqual(uploaded, expectedUploaded) {
+			t.Errorf("Unexpected uploaded objects %v, expected %v", uploaded, expectedUploaded)
+		}
+
+		if expectedLocation := "s3://foo/boot-diagnostics/aws-test1/"; location != expectedLocation {
+			t.Errorf("Expected location %q, got %q", expectedLocation, location)
+		}
+	})
+
+	t.Run("returns_error_when_upload_fails", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &infrav1.S3Bucket{
+			Name: bucketName,
+		})
+
+		machineScope := &scope.MachineScope{
+			Machine: &clusterv1.Machine{},
+			AWSMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: machineName,
+				},
+			},
+		}
+
+		s3Mock.EXPECT().PutObject(gomock.Any()).Return(nil, errors.New("putting object")).Times(1)
+
+		location, err := svc.CreateBootDiagnostics(machineScope, map[string][]byte{"console-output.txt": []byte("kernel panic")})
+		if err == nil {
+			t.Fatalf("Expected error")
+		}
+
+		if location != "" {
+			t.Fatalf("Expected empty location when upload error occurs")
+		}
+	})
+
+	t.Run("bucket_management_is_disabled_clusterwide", func(t *testing.T) {
+		t.Parallel()
+
+		svc, _ := testService(t, nil)
+
+		machineScope := &scope.MachineScope{
+			Machine: &clusterv1.Machine{},
+			AWSMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: machineName,
+				},
+			},
+		}
+
+		if _, err := svc.CreateBootDiagnostics(machineScope, map[string][]byte{"console-output.txt": []byte("kernel panic")}); err == nil {
+			t.Fatalf("Expected error")
+		}
+	})
+}
+
 func TestDeleteObject(t *testing.T) {
 	t.Parallel()
 
@@ -26,8 +26,10 @@ import (
 	"github.com/pkg/errors"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/net"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
@@ -56,6 +58,12 @@ const (
 
 // ReconcileSecurityGroups will reconcile security groups against the Service object.
 func (s *Service) ReconcileSecurityGroups() error {
+	// The security groups are part of the network, which the network service reports as paused.
+	if annotations.Has(s.scope.InfraCluster(), infrav1.PauseNetworkAnnotation) {
+		s.scope.Info("Reconciliation of the security groups is paused", "annotation", infrav1.PauseNetworkAnnotation)
+		return nil
+	}
+
 	s.scope.Debug("Reconciling security groups")
 
 	if s.scope.Network().SecurityGroups == nil {
@@ -132,7 +140,7 @@ func (s *Service) ReconcileSecurityGroups() error {
 			// Make sure tags are up to date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getSecurityGroupTagParams(existing.Name, existing.ID, role)
-				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithPolicy(s.scope.TagReconcilePolicy(), s.scope.InfraCluster(), s.scope))
 				if err := tagsBuilder.Ensure(existing.Tags); err != nil {
 					return false, err
 				}
@@ -158,45 +166,346 @@ func (s *Service) ReconcileSecurityGroups() error {
 			// skip rule reconciliation, as we expect the in-cluster cloud integration to manage them
 			continue
 		}
-		current := sg.IngressRules
-
 		want, err := s.getSecurityGroupIngressRules(role)
 		if err != nil {
 			return err
 		}
 
-		toRevoke := current.Difference(want)
-		if len(toRevoke) > 0 {
-			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-				if err := s.revokeSecurityGroupIngressRules(sg.ID, toRevoke); err != nil {
-					return false, err
-				}
-				return true, nil
-			}, awserrors.GroupNotFound); err != nil {
-				return errors.Wrapf(err, "failed to revoke security group ingress rules for %q", sg.ID)
+		if err := s.reconcileIngressRules(sg, role, want); err != nil {
+			return err
+		}
+
+		if err := s.reconcileEgressRules(sg, role); err != nil {
+			return err
+		}
+	}
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.ClusterSecurityGroupsReadyCondition)
+	return nil
+}
+
+// reconcileIngressRules revokes and authorizes the ingress rules of a security group so they match the wanted ones.
+// With the additive reconcile strategy, only rules created by CAPA are revoked.
+func (s *Service) reconcileIngressRules(sg infrav1.SecurityGroup, role infrav1.SecurityGroupRole, want infrav1.IngressRules) error {
+	toRevoke, toAuthorize := ingressRulesDiff(sg.IngressRules, want)
+	if s.securityGroupReconcileStrategy(role) == infrav1.SecurityGroupRoleReconcileStrategyAdditive {
+		var err error
+		if toRevoke, err = s.ownedRules(sg.ID, toRevoke, false); err != nil {
+			return err
+		}
+	}
+
+	if len(toRevoke) > 0 {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if err := s.revokeSecurityGroupIngressRules(sg.ID, toRevoke); err != nil {
+				return false, err
 			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return errors.Wrapf(err, "failed to revoke security group ingress rules for %q", sg.ID)
+		}
 
-			s.scope.Debug("Revoked ingress rules from security group", "revoked-ingress-rules", toRevoke, "security-group-id", sg.ID)
+		s.scope.Debug("Revoked ingress rules from security group", "revoked-ingress-rules", toRevoke, "security-group-id", sg.ID)
+	}
+
+	if len(toAuthorize) > 0 {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if err := s.authorizeSecurityGroupIngressRules(sg.ID, role, toAuthorize); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return err
 		}
 
-		toAuthorize := want.Difference(current)
-		if len(toAuthorize) > 0 {
-			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-				if err := s.authorizeSecurityGroupIngressRules(sg.ID, toAuthorize); err != nil {
-					return false, err
-				}
-				return true, nil
-			}, awserrors.GroupNotFound); err != nil {
-				return err
+		s.scope.Debug("Authorized ingress rules in security group", "authorized-ingress-rules", toAuthorize, "security-group-id", sg.ID)
+	}
+
+	return nil
+}
+
+// reconcileEgressRules replaces the egress rules of a security group with the ones configured for its role.
+// Security groups of roles without egress rules keep the default egress rules allowing all outbound traffic,
+// which are restored when the egress rules of the role are removed.
+func (s *Service) reconcileEgressRules(sg infrav1.SecurityGroup, role infrav1.SecurityGroupRole) error {
+	rules := s.scope.SecurityGroupEgressRules()[role]
+	if len(rules) == 0 {
+		return s.restoreDefaultEgressRules(sg.ID, role)
+	}
+
+	return s.syncEgressRules(sg.ID, role, rules, false)
+}
+
+// restoreDefaultEgressRules restores the default egress rules of a security group whose egress rules were
+// replaced by CAPA, and revokes the egress rules CAPA created. Security groups without egress rules created by
+// CAPA are left as they are.
+func (s *Service) restoreDefaultEgressRules(id string, role infrav1.SecurityGroupRole) error {
+	defaults, err := s.egressRulesToIngressRules(s.defaultEgressRules())
+	if err != nil {
+		return err
+	}
+	current, err := s.describeSecurityGroupEgressRules(id)
+	if err != nil {
+		return err
+	}
+
+	toRevoke, toAuthorize := ingressRulesDiff(current, defaults)
+	if toRevoke, err = s.ownedRules(id, toRevoke, true); err != nil {
+		return err
+	}
+	if len(toRevoke) == 0 {
+		return nil
+	}
+
+	return s.updateEgressRules(id, role, toRevoke, toAuthorize)
+}
+
+// defaultEgressRules returns the egress rules EC2 sets on a new security group, which allow all outbound traffic.
+func (s *Service) defaultEgressRules() infrav1.EgressRules {
+	rule := infrav1.EgressRule{
+		Protocol:   infrav1.SecurityGroupProtocolAll,
+		CidrBlocks: []string{services.AnyIPv4CidrBlock},
+	}
+	if s.scope.VPC().IsIPv6Enabled() {
+		rule.IPv6CidrBlocks = []string{services.AnyIPv6CidrBlock}
+	}
+	return infrav1.EgressRules{rule}
+}
+
+// ReconcileEKSNodeEgressRules keeps the egress rules added to the security group of the EKS managed nodes in sync
+// with the given ones. The security group is created by EKS, so besides its default egress rules allowing all
+// outbound traffic, only the egress rules added by CAPA are revoked. The default egress rules are restored once
+// the egress rules are removed.
+func (s *Service) ReconcileEKSNodeEgressRules(rules infrav1.EgressRules) error {
+	sg, ok := s.scope.SecurityGroups()[infrav1.SecurityGroupNode]
+	if !ok || !s.isEKSOwned(sg) {
+		// The security group is only known once the EKS cluster is created.
+		return nil
+	}
+
+	if len(rules) == 0 {
+		return s.restoreDefaultEgressRules(sg.ID, infrav1.SecurityGroupNode)
+	}
+
+	return s.syncEgressRules(sg.ID, infrav1.SecurityGroupNode, append(eksNodeClusterEgressRules(), rules...), true)
+}
+
+// eksNodeClusterEgressRules returns the egress rules the security group of the EKS managed nodes keeps once its
+// default egress rules are revoked. EKS attaches this security group to both the nodes and the network interfaces
+// of the control plane, so allowing all outbound traffic to the security group itself keeps the traffic between
+// the nodes, and between the nodes and the Kubernetes API, allowed.
+func eksNodeClusterEgressRules() infrav1.EgressRules {
+	return infrav1.EgressRules{
+		{
+			Description:                   "EKS nodes and control plane",
+			Protocol:                      infrav1.SecurityGroupProtocolAll,
+			DestinationSecurityGroupRoles: []infrav1.SecurityGroupRole{infrav1.SecurityGroupNode},
+		},
+	}
+}
+
+// syncEgressRules revokes and authorizes the egress rules of a security group so they match the wanted ones.
+// When ownedOnly is set, only the egress rules created by CAPA and the default egress rules are revoked.
+func (s *Service) syncEgressRules(id string, role infrav1.SecurityGroupRole, rules infrav1.EgressRules, ownedOnly bool) error {
+	wanted, err := s.egressRulesToIngressRules(rules)
+	if err != nil {
+		return err
+	}
+	current, err := s.describeSecurityGroupEgressRules(id)
+	if err != nil {
+		return err
+	}
+
+	toRevoke, toAuthorize := ingressRulesDiff(current, wanted)
+	if ownedOnly {
+		owned, err := s.ownedRules(id, toRevoke, true)
+		if err != nil {
+			return err
+		}
+		defaults, err := s.egressRulesToIngressRules(s.defaultEgressRules())
+		if err != nil {
+			return err
+		}
+		defaults = defaults.Expand()
+		var revocable infrav1.IngressRules
+		for _, rule := range toRevoke {
+			if len(infrav1.IngressRules{rule}.Difference(owned)) == 0 || len(infrav1.IngressRules{rule}.Difference(defaults)) == 0 {
+				revocable = append(revocable, rule)
+			}
+		}
+		toRevoke = revocable
+	}
+
+	return s.updateEgressRules(id, role, toRevoke, toAuthorize)
+}
+
+// egressRulesToIngressRules returns the given egress rules as ingress rules whose CIDR blocks and security groups
+// are destinations, which is how EC2 handles them. It returns an error when a destination security group role
+// has no security group, so a rule is never authorized without its destination.
+func (s *Service) egressRulesToIngressRules(rules infrav1.EgressRules) (infrav1.IngressRules, error) {
+	out := make(infrav1.IngressRules, 0, len(rules))
+	for _, rule := range rules {
+		securityGroupIDs := sets.New[string]()
+		for _, destinationSGRole := range rule.DestinationSecurityGroupRoles {
+			sg, ok := s.scope.SecurityGroups()[destinationSGRole]
+			if !ok || sg.ID == "" {
+				return nil, errors.Errorf("egress rule %q: security group for role %q not found", rule.Description, destinationSGRole)
 			}
+			securityGroupIDs.Insert(sg.ID)
+		}
+		out = append(out, infrav1.IngressRule{
+			Description:            rule.Description,
+			Protocol:               rule.Protocol,
+			FromPort:               rule.FromPort,
+			ToPort:                 rule.ToPort,
+			CidrBlocks:             rule.CidrBlocks,
+			IPv6CidrBlocks:         rule.IPv6CidrBlocks,
+			SourceSecurityGroupIDs: sets.List[string](securityGroupIDs),
+		})
+	}
+	return out, nil
+}
 
-			s.scope.Debug("Authorized ingress rules in security group", "authorized-ingress-rules", toAuthorize, "security-group-id", sg.ID)
+// updateEgressRules authorizes the given egress rules in a security group before revoking the given ones, so the
+// outbound traffic allowed by both isn't interrupted. The rules only differing from an authorized rule by their
+// description are revoked first, as EC2 refuses to authorize a rule that already exists.
+func (s *Service) updateEgressRules(id string, role infrav1.SecurityGroupRole, toRevoke, toAuthorize infrav1.IngressRules) error {
+	var revokeFirst, revokeLast infrav1.IngressRules
+	for _, rule := range toRevoke {
+		if len(withoutDescriptions(infrav1.IngressRules{rule}).Difference(withoutDescriptions(toAuthorize))) == 0 {
+			revokeFirst = append(revokeFirst, rule)
+		} else {
+			revokeLast = append(revokeLast, rule)
 		}
 	}
-	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.ClusterSecurityGroupsReadyCondition)
+
+	if err := s.revokeEgressRules(id, revokeFirst); err != nil {
+		return err
+	}
+
+	if len(toAuthorize) > 0 {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if err := s.authorizeSecurityGroupEgressRules(id, role, toAuthorize); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return err
+		}
+
+		s.scope.Debug("Authorized egress rules in security group", "authorized-egress-rules", toAuthorize, "security-group-id", id)
+	}
+
+	return s.revokeEgressRules(id, revokeLast)
+}
+
+// revokeEgressRules revokes the given egress rules from a security group.
+func (s *Service) revokeEgressRules(id string, rules infrav1.IngressRules) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if err := s.revokeSecurityGroupEgressRules(id, rules); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, awserrors.GroupNotFound); err != nil {
+		return errors.Wrapf(err, "failed to revoke security group egress rules for %q", id)
+	}
+
+	s.scope.Debug("Revoked egress rules from security group", "revoked-egress-rules", rules, "security-group-id", id)
 	return nil
 }
 
+// withoutDescriptions returns a copy of the given rules without their descriptions.
+func withoutDescriptions(rules infrav1.IngressRules) infrav1.IngressRules {
+	out := make(infrav1.IngressRules, 0, len(rules))
+	for _, rule := range rules {
+		rule.Description = ""
+		out = append(out, rule)
+	}
+	return out
+}
+
+// describeSecurityGroupEgressRules returns the egress rules of a security group.
+func (s *Service) describeSecurityGroupEgressRules(id string) (infrav1.IngressRules, error) {
+	out, err := s.EC2Client.DescribeSecurityGroupsWithContext(context.TODO(), &ec2.DescribeSecurityGroupsInput{
+		GroupIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe security group %q", id)
+	}
+
+	var rules infrav1.IngressRules
+	for _, sg := range out.SecurityGroups {
+		for _, permission := range sg.IpPermissionsEgress {
+			rules = append(rules, ingressRulesFromSDKType(permission)...)
+		}
+	}
+	return rules, nil
+}
+
+// ingressRulesDiff returns the rules to revoke from and to authorize in a security group to go from the current
+// ingress rules to the wanted ones. EC2 reports a rule with several sources as one rule per source, so the
+// rules are compared per source CIDR block or source security group.
+func ingressRulesDiff(current, want infrav1.IngressRules) (toRevoke, toAuthorize infrav1.IngressRules) {
+	current = current.Expand()
+
+	var expanded infrav1.IngressRules
+	for _, rule := range want.Expand() {
+		if len(infrav1.IngressRules{rule}.Difference(expanded)) == 0 {
+			// The same source is allowed by several wanted rules, authorizing it twice would fail.
+			continue
+		}
+		expanded = append(expanded, rule)
+	}
+
+	return current.Difference(expanded), expanded.Difference(current)
+}
+
+// securityGroupReconcileStrategy returns how the ingress rules of the security group with the given role are reconciled.
+func (s *Service) securityGroupReconcileStrategy(role infrav1.SecurityGroupRole) infrav1.SecurityGroupRoleReconcileStrategy {
+	if strategy, ok := s.scope.SecurityGroupReconcileStrategies()[role]; ok {
+		return strategy
+	}
+	return infrav1.SecurityGroupRoleReconcileStrategyManaged
+}
+
+// ownedRules returns the given ingress or egress rules of a security group that were created by CAPA,
+// which are identified by the cluster tag set on the security group rules when authorizing them.
+func (s *Service) ownedRules(id string, rules infrav1.IngressRules, egress bool) (infrav1.IngressRules, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	input := &ec2.DescribeSecurityGroupRulesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.SecurityGroupID(id),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	}
+
+	var owned infrav1.IngressRules
+	if err := s.EC2Client.DescribeSecurityGroupRulesPagesWithContext(context.TODO(), input, func(out *ec2.DescribeSecurityGroupRulesOutput, _ bool) bool {
+		for _, rule := range out.SecurityGroupRules {
+			if aws.BoolValue(rule.IsEgress) == egress {
+				owned = append(owned, ingressRuleFromSDKSecurityGroupRule(rule))
+			}
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to describe security group %q rules", id)
+	}
+
+	var out infrav1.IngressRules
+	for _, rule := range rules {
+		if len(infrav1.IngressRules{rule}.Difference(owned)) == 0 {
+			out = append(out, rule)
+		}
+	}
+	return out, nil
+}
+
 func (s *Service) securityGroupIsAnOverride(securityGroupID string) bool {
 	for _, overrideID := range s.scope.SecurityGroupOverrides() {
 		if overrideID == securityGroupID {
@@ -449,8 +758,15 @@ func (s *Service) createSecurityGroup(role infrav1.SecurityGroupRole, input *ec2
 	return nil
 }
 
-func (s *Service) authorizeSecurityGroupIngressRules(id string, rules infrav1.IngressRules) error {
-	input := &ec2.AuthorizeSecurityGroupIngressInput{GroupId: aws.String(id)}
+func (s *Service) authorizeSecurityGroupIngressRules(id string, role infrav1.SecurityGroupRole, rules infrav1.IngressRules) error {
+	// Tag the rules, so they can be told apart from rules added by other means.
+	ruleTags := s.getSecurityGroupRuleTagParams(role)
+	input := &ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId: aws.String(id),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeSecurityGroupRule, ruleTags),
+		},
+	}
 	for i := range rules {
 		rule := rules[i]
 		input.IpPermissions = append(input.IpPermissions, ingressRuleToSDKType(s.scope, &rule))
@@ -480,6 +796,28 @@ func (s *Service) revokeSecurityGroupIngressRules(id string, rules infrav1.Ingre
 	return nil
 }
 
+func (s *Service) authorizeSecurityGroupEgressRules(id string, role infrav1.SecurityGroupRole, rules infrav1.IngressRules) error {
+	// Tag the rules, so they can be told apart from rules added by other means.
+	ruleTags := s.getSecurityGroupRuleTagParams(role)
+	input := &ec2.AuthorizeSecurityGroupEgressInput{
+		GroupId: aws.String(id),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeSecurityGroupRule, ruleTags),
+		},
+	}
+	for i := range rules {
+		rule := rules[i]
+		input.IpPermissions = append(input.IpPermissions, ingressRuleToSDKType(s.scope, &rule))
+	}
+	if _, err := s.EC2Client.AuthorizeSecurityGroupEgressWithContext(context.TODO(), input); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAuthorizeSecurityGroupEgressRules", "Failed to authorize security group egress rules %v for SecurityGroup %q: %v", rules, id, err)
+		return errors.Wrapf(err, "failed to authorize security group %q egress rules: %v", id, rules)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAuthorizeSecurityGroupEgressRules", "Authorized security group egress rules %v for SecurityGroup %q", rules, id)
+	return nil
+}
+
 func (s *Service) revokeSecurityGroupEgressRules(id string, rules infrav1.IngressRules) error {
 	input := &ec2.RevokeSecurityGroupEgressInput{GroupId: aws.String(id)}
 	for i := range rules {
@@ -548,6 +886,7 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 			},
 		}
 	}
+	cniRules = append(cniRules, s.getCNIPodCIDRIngressRules()...)
 	cidrBlocks := []string{services.AnyIPv4CidrBlock}
 	switch role {
 	case infrav1.SecurityGroupBastion:
@@ -565,8 +904,8 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 			{
 				Description: "Kubernetes API",
 				Protocol:    infrav1.SecurityGroupProtocolTCP,
-				FromPort:    infrav1.DefaultAPIServerPort,
-				ToPort:      infrav1.DefaultAPIServerPort,
+				FromPort:    s.scope.ControlPlaneLoadBalancer().TargetPort(),
+				ToPort:      s.scope.ControlPlaneLoadBalancer().TargetPort(),
 				SourceSecurityGroupIDs: []string{
 					s.scope.SecurityGroups()[infrav1.SecurityGroupAPIServerLB].ID,
 					s.scope.SecurityGroups()[infrav1.SecurityGroupControlPlane].ID,
@@ -591,6 +930,9 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 		if s.scope.Bastion().Enabled {
 			rules = append(rules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupBastion].ID))
 		}
+		if s.scope.InstanceConnectEndpoint() != nil {
+			rules = append(rules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupInstanceConnectEndpoint].ID))
+		}
 
 		ingressRules := s.scope.AdditionalControlPlaneIngressRules()
 		for i := range ingressRules {
@@ -637,6 +979,9 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 		if s.scope.Bastion().Enabled {
 			rules = append(rules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupBastion].ID))
 		}
+		if s.scope.InstanceConnectEndpoint() != nil {
+			rules = append(rules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupInstanceConnectEndpoint].ID))
+		}
 		if s.scope.VPC().IsIPv6Enabled() {
 			rules = append(rules, infrav1.IngressRule{
 				Description:    "Node Port Services IPv6",
@@ -648,12 +993,14 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 		}
 		return append(cniRules, rules...), nil
 	case infrav1.SecurityGroupEKSNodeAdditional:
+		rules := infrav1.IngressRules{}
 		if s.scope.Bastion().Enabled {
-			return infrav1.IngressRules{
-				s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupBastion].ID),
-			}, nil
+			rules = append(rules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupBastion].ID))
 		}
-		return infrav1.IngressRules{}, nil
+		if s.scope.InstanceConnectEndpoint() != nil {
+			rules = append(rules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupInstanceConnectEndpoint].ID))
+		}
+		return rules, nil
 	case infrav1.SecurityGroupAPIServerLB:
 		kubeletRules := s.getIngressRulesToAllowKubeletToAccessTheControlPlaneLB()
 		customIngressRules := s.getControlPlaneLBIngressRules()
@@ -705,6 +1052,22 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 			return rules, nil
 		}
 		return infrav1.IngressRules{}, nil
+	case infrav1.SecurityGroupInstanceConnectEndpoint:
+		// The endpoint only opens connections to the instances, which allow SSH from its security group.
+		return infrav1.IngressRules{}, nil
+	case infrav1.SecurityGroupEFS:
+		return infrav1.IngressRules{
+			{
+				Description: "NFS",
+				Protocol:    infrav1.SecurityGroupProtocolTCP,
+				FromPort:    2049,
+				ToPort:      2049,
+				SourceSecurityGroupIDs: []string{
+					s.scope.SecurityGroups()[infrav1.SecurityGroupControlPlane].ID,
+					s.scope.SecurityGroups()[infrav1.SecurityGroupNode].ID,
+				},
+			},
+		}, nil
 	}
 
 	return nil, errors.Errorf("Cannot determine ingress rules for unknown security group role %q", role)
@@ -728,6 +1091,15 @@ func (s *Service) getDefaultSecurityGroup(role infrav1.SecurityGroupRole) *ec2.S
 	}
 }
 
+func (s *Service) getSecurityGroupRuleTagParams(role infrav1.SecurityGroupRole) infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Role:        aws.String(string(role)),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
 func (s *Service) getSecurityGroupTagParams(name, id string, role infrav1.SecurityGroupRole) infrav1.BuildParams {
 	additional := s.scope.AdditionalTags()
 
@@ -744,6 +1116,13 @@ func (s *Service) getSecurityGroupTagParams(name, id string, role infrav1.Securi
 			"tag", cloudProviderTag, "name", name, "role", role, "id", id)
 	}
 
+	// Let Karpenter discover the node security group of a managed VPC.
+	if role == infrav1.SecurityGroupNode && s.scope.VPC().IsManaged(s.scope.Name()) {
+		if value := s.scope.KarpenterDiscovery().TagValue(s.scope.KubernetesClusterName()); value != "" {
+			additional[infrav1.KarpenterDiscoveryTagKey] = value
+		}
+	}
+
 	return infrav1.BuildParams{
 		ClusterName: s.scope.Name(),
 		Lifecycle:   infrav1.ResourceLifecycleOwned,
@@ -861,6 +1240,26 @@ func ingressRulesFromSDKType(v *ec2.IpPermission) (res infrav1.IngressRules) {
 	return res
 }
 
+func ingressRuleFromSDKSecurityGroupRule(v *ec2.SecurityGroupRule) infrav1.IngressRule {
+	rule := ingressRuleFromSDKProtocol(&ec2.IpPermission{
+		IpProtocol: v.IpProtocol,
+		FromPort:   v.FromPort,
+		ToPort:     v.ToPort,
+	})
+	rule.Description = aws.StringValue(v.Description)
+
+	switch {
+	case v.CidrIpv4 != nil:
+		rule.CidrBlocks = []string{*v.CidrIpv4}
+	case v.CidrIpv6 != nil:
+		rule.IPv6CidrBlocks = []string{*v.CidrIpv6}
+	case v.ReferencedGroupInfo != nil && v.ReferencedGroupInfo.GroupId != nil:
+		rule.SourceSecurityGroupIDs = []string{*v.ReferencedGroupInfo.GroupId}
+	}
+
+	return rule
+}
+
 func ingressRuleFromSDKProtocol(v *ec2.IpPermission) infrav1.IngressRule {
 	// Ports are only well-defined for TCP and UDP protocols, but EC2 overloads the port range
 	// in the case of ICMP(v6) traffic to indicate which codes are allowed. For all other protocols,
@@ -883,6 +1282,35 @@ func ingressRuleFromSDKProtocol(v *ec2.IpPermission) infrav1.IngressRule {
 	}
 }
 
+// getCNIPodCIDRIngressRules returns the CNI ingress rules opened from the pod CIDR blocks, if any, for CNIs
+// routing pod traffic between nodes without encapsulation.
+func (s *Service) getCNIPodCIDRIngressRules() infrav1.IngressRules {
+	var ipv4CidrBlocks, ipv6CidrBlocks []string
+	for _, cidr := range s.scope.PodCIDRBlocks() {
+		if net.IsIPv6CIDRString(cidr) {
+			ipv6CidrBlocks = append(ipv6CidrBlocks, cidr)
+		} else {
+			ipv4CidrBlocks = append(ipv4CidrBlocks, cidr)
+		}
+	}
+	if len(ipv4CidrBlocks) == 0 && len(ipv6CidrBlocks) == 0 {
+		return nil
+	}
+
+	rules := make(infrav1.IngressRules, 0, len(s.scope.CNIIngressRules()))
+	for _, r := range s.scope.CNIIngressRules() {
+		rules = append(rules, infrav1.IngressRule{
+			Description:    r.Description,
+			Protocol:       r.Protocol,
+			FromPort:       r.FromPort,
+			ToPort:         r.ToPort,
+			CidrBlocks:     ipv4CidrBlocks,
+			IPv6CidrBlocks: ipv6CidrBlocks,
+		})
+	}
+	return rules
+}
+
 // getIngressRulesToAllowKubeletToAccessTheControlPlaneLB returns ingress rules required in the control plane LB.
 // The control plane LB will be accessed by in-cluster components like the kubelet, that means allowing the NatGateway IPs
 // when using an internet-facing LB, or the VPC CIDR when using an internal LB.
@@ -919,6 +1347,18 @@ func (s *Service) getControlPlaneLBIngressRules() infrav1.IngressRules {
 		return s.scope.ControlPlaneLoadBalancer().IngressRules
 	}
 
+	if s.scope.ControlPlaneLoadBalancer() != nil && len(s.scope.ControlPlaneLoadBalancer().AllowedCIDRBlocks) > 0 {
+		return infrav1.IngressRules{
+			{
+				Description: "Kubernetes API",
+				Protocol:    infrav1.SecurityGroupProtocolTCP,
+				FromPort:    int64(s.scope.APIServerPort()),
+				ToPort:      int64(s.scope.APIServerPort()),
+				CidrBlocks:  s.scope.ControlPlaneLoadBalancer().AllowedCIDRBlocks,
+			},
+		}
+	}
+
 	// If no custom ingress rules have been defined we allow all traffic so that the MC can access the WC API
 	return s.getIngressRuleToAllowAnyIPInTheAPIServer()
 }
@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
@@ -855,6 +856,10 @@ func TestReconcileSecurityGroups(t *testing.T) {
 			}
 
 			tc.expect(ec2Mock.EXPECT())
+			// The egress rules of the security groups are described to check whether their default egress rules
+			// need to be restored.
+			ec2Mock.EXPECT().DescribeSecurityGroupsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+				Return(&ec2.DescribeSecurityGroupsOutput{}, nil).AnyTimes()
 
 			s := NewService(cs, testSecurityGroupRoles)
 			s.EC2Client = ec2Mock
@@ -870,6 +875,81 @@ func TestReconcileSecurityGroups(t *testing.T) {
 	}
 }
 
+func TestReconcileSecurityGroupsPaused(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: "vpc-securitygroups"}},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	s := NewService(cs, testSecurityGroupRoles)
+	s.EC2Client = ec2Mock
+
+	// No AWS API is called while the network is paused.
+	annotations.Set(cs.AWSCluster, infrav1.PauseNetworkAnnotation, "")
+	g.Expect(s.ReconcileSecurityGroups()).To(Succeed())
+
+	// The security groups are reconciled again once the annotation is removed.
+	annotations.Delete(cs.AWSCluster, infrav1.PauseNetworkAnnotation)
+	ec2Mock.EXPECT().DescribeSecurityGroupsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+		Return(nil, errors.New("reconciling the security groups"))
+	g.Expect(s.ReconcileSecurityGroups()).NotTo(Succeed())
+}
+
+func TestControlPlaneSecurityGroupUsesLoadBalancerPort(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+					Port:              aws.Int64(8443),
+					AllowedCIDRBlocks: []string{"192.168.0.0/16"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	g := NewGomegaWithT(t)
+	s := NewService(cs, testSecurityGroupRoles)
+
+	rules, err := s.getSecurityGroupIngressRules(infrav1.SecurityGroupControlPlane)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rules[0].Description).To(Equal("Kubernetes API"))
+	g.Expect(rules[0].FromPort).To(Equal(int64(8443)))
+	g.Expect(rules[0].ToPort).To(Equal(int64(8443)))
+
+	rules, err = s.getSecurityGroupIngressRules(infrav1.SecurityGroupAPIServerLB)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rules).To(ContainElement(infrav1.IngressRule{
+		Description: "Kubernetes API",
+		Protocol:    infrav1.SecurityGroupProtocolTCP,
+		FromPort:    8443,
+		ToPort:      8443,
+		CidrBlocks:  []string{"192.168.0.0/16"},
+	}))
+}
+
 func TestControlPlaneSecurityGroupNotOpenToAnyCIDR(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = infrav1.AddToScheme(scheme)
@@ -898,6 +978,161 @@ func TestControlPlaneSecurityGroupNotOpenToAnyCIDR(t *testing.T) {
 	}
 }
 
+func TestBastionSecurityGroupIngressRules(t *testing.T) {
+	tests := []struct {
+		name          string
+		bastion       infrav1.Bastion
+		expectedRules infrav1.IngressRules
+	}{
+		{
+			name: "SSH ingress is scoped to the allowed CIDR blocks",
+			bastion: infrav1.Bastion{
+				Enabled:           true,
+				AllowedCIDRBlocks: []string{"192.168.0.0/16", "10.1.2.3/32"},
+			},
+			expectedRules: infrav1.IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"192.168.0.0/16", "10.1.2.3/32"},
+				},
+			},
+		},
+		{
+			name: "SSH ingress is open when the allowed CIDR blocks are defaulted",
+			bastion: infrav1.Bastion{
+				Enabled:           true,
+				AllowedCIDRBlocks: []string{services.AnyIPv4CidrBlock},
+			},
+			expectedRules: infrav1.IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{services.AnyIPv4CidrBlock},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						Bastion: tc.bastion,
+					},
+				},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			s := NewService(cs, testSecurityGroupRoles)
+			rules, err := s.getSecurityGroupIngressRules(infrav1.SecurityGroupBastion)
+			if err != nil {
+				t.Fatalf("Failed to lookup bastion security group ingress rules: %v", err)
+			}
+
+			g.Expect(rules).To(Equal(tc.expectedRules))
+		})
+	}
+}
+
+func TestInstanceConnectEndpointSSHIngressRules(t *testing.T) {
+	sshRule := infrav1.IngressRule{
+		Description:            "SSH",
+		Protocol:               infrav1.SecurityGroupProtocolTCP,
+		FromPort:               22,
+		ToPort:                 22,
+		SourceSecurityGroupIDs: []string{"sg-instance-connect-endpoint"},
+	}
+
+	tests := []struct {
+		name     string
+		role     infrav1.SecurityGroupRole
+		endpoint *infrav1.InstanceConnectEndpointSpec
+		want     bool
+	}{
+		{
+			name:     "node allows SSH from the endpoint security group",
+			role:     infrav1.SecurityGroupNode,
+			endpoint: &infrav1.InstanceConnectEndpointSpec{},
+			want:     true,
+		},
+		{
+			name:     "control plane allows SSH from the endpoint security group",
+			role:     infrav1.SecurityGroupControlPlane,
+			endpoint: &infrav1.InstanceConnectEndpointSpec{},
+			want:     true,
+		},
+		{
+			name:     "EKS node additional allows SSH from the endpoint security group",
+			role:     infrav1.SecurityGroupEKSNodeAdditional,
+			endpoint: &infrav1.InstanceConnectEndpointSpec{},
+			want:     true,
+		},
+		{
+			name: "node doesn't allow SSH without an endpoint",
+			role: infrav1.SecurityGroupNode,
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							InstanceConnectEndpoint: tc.endpoint,
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+								infrav1.SecurityGroupInstanceConnectEndpoint: {ID: "sg-instance-connect-endpoint"},
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			rules, err := s.getSecurityGroupIngressRules(tc.role)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			if tc.want {
+				g.Expect(rules).To(ContainElement(sshRule))
+			} else {
+				g.Expect(rules).NotTo(ContainElement(sshRule))
+			}
+		})
+	}
+}
+
 func TestAdditionalControlPlaneSecurityGroup(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = infrav1.AddToScheme(scheme)
@@ -1078,6 +1313,101 @@ func TestAdditionalControlPlaneSecurityGroup(t *testing.T) {
 	}
 }
 
+func TestCNIPodCIDRIngressRules(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	cniRule := infrav1.CNIIngressRule{
+		Description: "bgp (calico)",
+		Protocol:    infrav1.SecurityGroupProtocolTCP,
+		FromPort:    179,
+		ToPort:      179,
+	}
+	sgSourcedRule := infrav1.IngressRule{
+		Description:            "bgp (calico)",
+		Protocol:               infrav1.SecurityGroupProtocolTCP,
+		FromPort:               179,
+		ToPort:                 179,
+		SourceSecurityGroupIDs: []string{"cp-sg-id", "node-sg-id"},
+	}
+
+	testCases := []struct {
+		name          string
+		podCIDRBlocks []string
+		expectedRules infrav1.IngressRules
+	}{
+		{
+			name:          "CNI rules are only sourced from the cluster security groups without pod CIDR blocks",
+			expectedRules: infrav1.IngressRules{sgSourcedRule},
+		},
+		{
+			name:          "CNI rules are opened from the pod CIDR blocks",
+			podCIDRBlocks: []string{"100.64.0.0/16", "2001:db8::/56", "100.65.0.0/16"},
+			expectedRules: infrav1.IngressRules{
+				sgSourcedRule,
+				{
+					Description:    "bgp (calico)",
+					Protocol:       infrav1.SecurityGroupProtocolTCP,
+					FromPort:       179,
+					ToPort:         179,
+					CidrBlocks:     []string{"100.64.0.0/16", "100.65.0.0/16"},
+					IPv6CidrBlocks: []string{"2001:db8::/56"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							CNI: &infrav1.CNISpec{
+								CNIIngressRules: infrav1.CNIIngressRules{cniRule},
+							},
+						},
+						PodCIDRBlocks: tc.podCIDRBlocks,
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+								infrav1.SecurityGroupControlPlane: {
+									ID: "cp-sg-id",
+								},
+								infrav1.SecurityGroupNode: {
+									ID: "node-sg-id",
+								},
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			for _, role := range []infrav1.SecurityGroupRole{infrav1.SecurityGroupControlPlane, infrav1.SecurityGroupNode} {
+				rules, err := s.getSecurityGroupIngressRules(role)
+				g.Expect(err).NotTo(HaveOccurred())
+
+				var cniRules infrav1.IngressRules
+				for _, rule := range rules {
+					if rule.Description == cniRule.Description {
+						cniRules = append(cniRules, rule)
+					}
+				}
+				g.Expect(cniRules).To(Equal(tc.expectedRules), "unexpected CNI rules for role %s", role)
+			}
+		})
+	}
+}
+
 func TestControlPlaneLoadBalancerIngressRules(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = infrav1.AddToScheme(scheme)
@@ -1212,6 +1542,43 @@ func TestControlPlaneLoadBalancerIngressRules(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "allowed CIDR blocks are used when no ingress rules are defined",
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType:  infrav1.LoadBalancerTypeNLB,
+						AllowedCIDRBlocks: []string{"192.168.0.0/16", "172.16.0.0/12"},
+					},
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{
+							CidrBlock: "10.0.0.0/16",
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						NatGatewaysIPs: []string{"1.2.3.4"},
+					},
+				},
+			},
+			expectedIngresRules: infrav1.IngressRules{
+				infrav1.IngressRule{
+					Description: "Kubernetes API",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    6443,
+					ToPort:      6443,
+					CidrBlocks:  []string{"1.2.3.4/32"},
+				},
+				infrav1.IngressRule{
+					Description: "Kubernetes API",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    6443,
+					ToPort:      6443,
+					CidrBlocks:  []string{"192.168.0.0/16", "172.16.0.0/12"},
+				},
+			},
+		},
 		{
 			name: "when no ingress rules are passed while using internal LB",
 			awsCluster: &infrav1.AWSCluster{
@@ -1651,6 +2018,962 @@ func TestIngressRulesFromSDKType(t *testing.T) {
 	}
 }
 
+func TestIngressRulesDiff(t *testing.T) {
+	kubeletRule := infrav1.IngressRule{
+		Description:            "Kubelet API",
+		Protocol:               infrav1.SecurityGroupProtocolTCP,
+		FromPort:               10250,
+		ToPort:                 10250,
+		SourceSecurityGroupIDs: []string{"sg-source-1", "sg-source-2"},
+	}
+	kubeletPermission := func(groupIDs ...string) *ec2.IpPermission {
+		permission := &ec2.IpPermission{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(10250),
+			ToPort:     aws.Int64(10250),
+		}
+		for _, groupID := range groupIDs {
+			permission.UserIdGroupPairs = append(permission.UserIdGroupPairs, &ec2.UserIdGroupPair{
+				Description: aws.String("Kubelet API"),
+				UserId:      aws.String("aws-user-id-1"),
+				GroupId:     aws.String(groupID),
+			})
+		}
+		return permission
+	}
+	kubeletRuleFrom := func(groupID string) infrav1.IngressRule {
+		return infrav1.IngressRule{
+			Description:            "Kubelet API",
+			Protocol:               infrav1.SecurityGroupProtocolTCP,
+			FromPort:               10250,
+			ToPort:                 10250,
+			SourceSecurityGroupIDs: []string{groupID},
+		}
+	}
+
+	tests := []struct {
+		name                string
+		current             infrav1.IngressRules
+		want                infrav1.IngressRules
+		expectedToRevoke    infrav1.IngressRules
+		expectedToAuthorize infrav1.IngressRules
+	}{
+		{
+			name:    "rule sourced from security groups is created",
+			current: nil,
+			want:    infrav1.IngressRules{kubeletRule},
+			expectedToAuthorize: infrav1.IngressRules{
+				kubeletRuleFrom("sg-source-1"),
+				kubeletRuleFrom("sg-source-2"),
+			},
+		},
+		{
+			name:    "rule sourced from security groups matches the rules reported by EC2",
+			current: ingressRulesFromSDKType(kubeletPermission("sg-source-2", "sg-source-1")),
+			want:    infrav1.IngressRules{kubeletRule},
+		},
+		{
+			name:    "source security group is removed",
+			current: ingressRulesFromSDKType(kubeletPermission("sg-source-1", "sg-source-2", "sg-source-3")),
+			want:    infrav1.IngressRules{kubeletRule},
+			expectedToRevoke: infrav1.IngressRules{
+				kubeletRuleFrom("sg-source-3"),
+			},
+		},
+		{
+			name:    "source security group is replaced",
+			current: ingressRulesFromSDKType(kubeletPermission("sg-source-1", "sg-source-3")),
+			want:    infrav1.IngressRules{kubeletRule},
+			expectedToRevoke: infrav1.IngressRules{
+				kubeletRuleFrom("sg-source-3"),
+			},
+			expectedToAuthorize: infrav1.IngressRules{
+				kubeletRuleFrom("sg-source-2"),
+			},
+		},
+		{
+			name:    "source security group allowed by several rules is authorized once",
+			current: nil,
+			want: infrav1.IngressRules{
+				kubeletRule,
+				kubeletRuleFrom("sg-source-1"),
+			},
+			expectedToAuthorize: infrav1.IngressRules{
+				kubeletRuleFrom("sg-source-1"),
+				kubeletRuleFrom("sg-source-2"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			toRevoke, toAuthorize := ingressRulesDiff(tc.current, tc.want)
+
+			g.Expect(toRevoke).To(Equal(tc.expectedToRevoke))
+			g.Expect(toAuthorize).To(Equal(tc.expectedToAuthorize))
+		})
+	}
+}
+
+func TestReconcileIngressRulesStrategy(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	capaRule := infrav1.IngressRule{
+		Description: "Node Port Services",
+		Protocol:    infrav1.SecurityGroupProtocolTCP,
+		FromPort:    30000,
+		ToPort:      32767,
+		CidrBlocks:  []string{"0.0.0.0/0"},
+	}
+	staleRule := infrav1.IngressRule{
+		Description: "Node Port Services",
+		Protocol:    infrav1.SecurityGroupProtocolTCP,
+		FromPort:    30000,
+		ToPort:      32767,
+		CidrBlocks:  []string{"10.0.0.0/16"},
+	}
+	foreignRule := infrav1.IngressRule{
+		Description: "break-glass",
+		Protocol:    infrav1.SecurityGroupProtocolTCP,
+		FromPort:    22,
+		ToPort:      22,
+		CidrBlocks:  []string{"192.168.1.1/32"},
+	}
+	describeOwnedRules := func(m *mocks.MockEC2APIMockRecorder, rules ...*ec2.SecurityGroupRule) {
+		m.DescribeSecurityGroupRulesPagesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeSecurityGroupRulesInput{
+			Filters: []*ec2.Filter{
+				filter.EC2.SecurityGroupID("sg-node"),
+				filter.EC2.ClusterOwned("test-cluster"),
+			},
+		}), gomock.Any()).DoAndReturn(func(_ context.Context, _ *ec2.DescribeSecurityGroupRulesInput, fn func(*ec2.DescribeSecurityGroupRulesOutput, bool) bool, _ ...request.Option) error {
+			fn(&ec2.DescribeSecurityGroupRulesOutput{SecurityGroupRules: rules}, true)
+			return nil
+		})
+	}
+
+	testCases := []struct {
+		name     string
+		strategy infrav1.SecurityGroupRoleReconcileStrategy
+		current  infrav1.IngressRules
+		want     infrav1.IngressRules
+		expect   func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name:    "foreign rules are revoked by default",
+			current: infrav1.IngressRules{capaRule, foreignRule},
+			want:    infrav1.IngressRules{capaRule},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.RevokeSecurityGroupIngressWithContext(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupIngressInput{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []*ec2.IpPermission{
+						{
+							IpProtocol: aws.String("tcp"),
+							FromPort:   aws.Int64(22),
+							ToPort:     aws.Int64(22),
+							IpRanges: []*ec2.IpRange{
+								{
+									CidrIp:      aws.String("192.168.1.1/32"),
+									Description: aws.String("break-glass"),
+								},
+							},
+						},
+					},
+				})).Return(&ec2.RevokeSecurityGroupIngressOutput{}, nil)
+			},
+		},
+		{
+			name:     "foreign rules are revoked with the managed strategy",
+			strategy: infrav1.SecurityGroupRoleReconcileStrategyManaged,
+			current:  infrav1.IngressRules{capaRule, foreignRule},
+			want:     infrav1.IngressRules{capaRule},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.RevokeSecurityGroupIngressWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.RevokeSecurityGroupIngressInput{})).
+					Return(&ec2.RevokeSecurityGroupIngressOutput{}, nil)
+			},
+		},
+		{
+			name:     "foreign rules are kept with the additive strategy",
+			strategy: infrav1.SecurityGroupRoleReconcileStrategyAdditive,
+			current:  infrav1.IngressRules{capaRule, foreignRule},
+			want:     infrav1.IngressRules{capaRule},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeOwnedRules(m, &ec2.SecurityGroupRule{
+					Description: aws.String("Node Port Services"),
+					IpProtocol:  aws.String("tcp"),
+					FromPort:    aws.Int64(30000),
+					ToPort:      aws.Int64(32767),
+					CidrIpv4:    aws.String("0.0.0.0/0"),
+					IsEgress:    aws.Bool(false),
+				})
+			},
+		},
+		{
+			name:     "stale rules created by CAPA are revoked with the additive strategy",
+			strategy: infrav1.SecurityGroupRoleReconcileStrategyAdditive,
+			current:  infrav1.IngressRules{staleRule, foreignRule},
+			want:     infrav1.IngressRules{capaRule},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeOwnedRules(m, &ec2.SecurityGroupRule{
+					Description: aws.String("Node Port Services"),
+					IpProtocol:  aws.String("tcp"),
+					FromPort:    aws.Int64(30000),
+					ToPort:      aws.Int64(32767),
+					CidrIpv4:    aws.String("10.0.0.0/16"),
+					IsEgress:    aws.Bool(false),
+				})
+				m.RevokeSecurityGroupIngressWithContext(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupIngressInput{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []*ec2.IpPermission{
+						{
+							IpProtocol: aws.String("tcp"),
+							FromPort:   aws.Int64(30000),
+							ToPort:     aws.Int64(32767),
+							IpRanges: []*ec2.IpRange{
+								{
+									CidrIp:      aws.String("10.0.0.0/16"),
+									Description: aws.String("Node Port Services"),
+								},
+							},
+						},
+					},
+				})).Return(&ec2.RevokeSecurityGroupIngressOutput{}, nil)
+				m.AuthorizeSecurityGroupIngressWithContext(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupIngressInput{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []*ec2.IpPermission{
+						{
+							IpProtocol: aws.String("tcp"),
+							FromPort:   aws.Int64(30000),
+							ToPort:     aws.Int64(32767),
+							IpRanges: []*ec2.IpRange{
+								{
+									CidrIp:      aws.String("0.0.0.0/0"),
+									Description: aws.String("Node Port Services"),
+								},
+							},
+						},
+					},
+					TagSpecifications: []*ec2.TagSpecification{
+						{
+							ResourceType: aws.String("security-group-rule"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+									Value: aws.String("owned"),
+								},
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+									Value: aws.String("node"),
+								},
+							},
+						},
+					},
+				})).Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			networkSpec := infrav1.NetworkSpec{}
+			if tc.strategy != "" {
+				networkSpec.SecurityGroupReconcileStrategies = map[infrav1.SecurityGroupRole]infrav1.SecurityGroupRoleReconcileStrategy{
+					infrav1.SecurityGroupNode: tc.strategy,
+				}
+			}
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: networkSpec,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			sg := infrav1.SecurityGroup{
+				ID:           "sg-node",
+				Name:         "test-cluster-node",
+				IngressRules: tc.current,
+			}
+			g.Expect(s.reconcileIngressRules(sg, infrav1.SecurityGroupNode, tc.want)).To(Succeed())
+		})
+	}
+}
+
+func TestReconcileEgressRules(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	httpsRule := infrav1.EgressRule{
+		Description: "HTTPS",
+		Protocol:    infrav1.SecurityGroupProtocolTCP,
+		FromPort:    443,
+		ToPort:      443,
+		CidrBlocks:  []string{"10.0.0.0/8"},
+	}
+	defaultEgress := &ec2.IpPermission{
+		IpProtocol: aws.String("-1"),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+	}
+	httpsEgress := &ec2.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(443),
+		ToPort:     aws.Int64(443),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8"), Description: aws.String("HTTPS")}},
+	}
+	describeEgress := func(m *mocks.MockEC2APIMockRecorder, permissions ...*ec2.IpPermission) {
+		m.DescribeSecurityGroupsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeSecurityGroupsInput{
+			GroupIds: aws.StringSlice([]string{"sg-node"}),
+		})).Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []*ec2.SecurityGroup{
+				{
+					GroupId:             aws.String("sg-node"),
+					GroupName:           aws.String("test-cluster-node"),
+					IpPermissionsEgress: permissions,
+				},
+			},
+		}, nil)
+	}
+	describeOwnedRules := func(m *mocks.MockEC2APIMockRecorder, rules ...*ec2.SecurityGroupRule) {
+		m.DescribeSecurityGroupRulesPagesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeSecurityGroupRulesInput{
+			Filters: []*ec2.Filter{
+				filter.EC2.SecurityGroupID("sg-node"),
+				filter.EC2.ClusterOwned("test-cluster"),
+			},
+		}), gomock.Any()).DoAndReturn(func(_ context.Context, _ *ec2.DescribeSecurityGroupRulesInput, fn func(*ec2.DescribeSecurityGroupRulesOutput, bool) bool, _ ...request.Option) error {
+			fn(&ec2.DescribeSecurityGroupRulesOutput{SecurityGroupRules: rules}, true)
+			return nil
+		})
+	}
+	ruleTags := []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String("security-group-rule"),
+			Tags: []*ec2.Tag{
+				{
+					Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+					Value: aws.String("owned"),
+				},
+				{
+					Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+					Value: aws.String("node"),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		rules  map[infrav1.SecurityGroupRole]infrav1.EgressRules
+		expect func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name: "default egress is kept without egress rules",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, defaultEgress)
+			},
+		},
+		{
+			name: "default egress is kept when only other roles have egress rules",
+			rules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+				infrav1.SecurityGroupControlPlane: {httpsRule},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, defaultEgress)
+			},
+		},
+		{
+			name: "default egress is restored when the egress rules are removed",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, httpsEgress)
+				describeOwnedRules(m, &ec2.SecurityGroupRule{
+					Description: aws.String("HTTPS"),
+					IpProtocol:  aws.String("tcp"),
+					FromPort:    aws.Int64(443),
+					ToPort:      aws.Int64(443),
+					CidrIpv4:    aws.String("10.0.0.0/8"),
+					IsEgress:    aws.Bool(true),
+				})
+				authorize := m.AuthorizeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId:           aws.String("sg-node"),
+					IpPermissions:     []*ec2.IpPermission{defaultEgress},
+					TagSpecifications: ruleTags,
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+				m.RevokeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId:       aws.String("sg-node"),
+					IpPermissions: []*ec2.IpPermission{httpsEgress},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil).After(authorize)
+			},
+		},
+		{
+			name: "egress rules not created by CAPA are left untouched without egress rules",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, httpsEgress)
+				describeOwnedRules(m)
+			},
+		},
+		{
+			name: "default egress is replaced by the egress rules",
+			rules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+				infrav1.SecurityGroupNode: {httpsRule},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, defaultEgress)
+				authorize := m.AuthorizeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId:           aws.String("sg-node"),
+					IpPermissions:     []*ec2.IpPermission{httpsEgress},
+					TagSpecifications: ruleTags,
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+				m.RevokeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId:       aws.String("sg-node"),
+					IpPermissions: []*ec2.IpPermission{defaultEgress},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil).After(authorize)
+			},
+		},
+		{
+			name: "egress rule whose description changed is revoked before being authorized again",
+			rules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+				infrav1.SecurityGroupNode: {httpsRule},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				oldHTTPSEgress := &ec2.IpPermission{
+					IpProtocol: aws.String("tcp"),
+					FromPort:   aws.Int64(443),
+					ToPort:     aws.Int64(443),
+					IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8"), Description: aws.String("old")}},
+				}
+				describeEgress(m, oldHTTPSEgress)
+				revoke := m.RevokeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId:       aws.String("sg-node"),
+					IpPermissions: []*ec2.IpPermission{oldHTTPSEgress},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+				m.AuthorizeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId:           aws.String("sg-node"),
+					IpPermissions:     []*ec2.IpPermission{httpsEgress},
+					TagSpecifications: ruleTags,
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil).After(revoke)
+			},
+		},
+		{
+			name: "egress rules already in place are left untouched",
+			rules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+				infrav1.SecurityGroupNode: {httpsRule},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, httpsEgress)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							SecurityGroupEgressRules: tc.rules,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(cs, testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			sg := infrav1.SecurityGroup{
+				ID:   "sg-node",
+				Name: "test-cluster-node",
+			}
+			g.Expect(s.reconcileEgressRules(sg, infrav1.SecurityGroupNode)).To(Succeed())
+		})
+	}
+}
+
+func TestReconcileEKSNodeEgressRules(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	proxyRule := infrav1.EgressRule{
+		Description: "Egress proxy",
+		Protocol:    infrav1.SecurityGroupProtocolTCP,
+		FromPort:    3128,
+		ToPort:      3128,
+		CidrBlocks:  []string{"10.1.0.10/32"},
+	}
+	defaultEgress := &ec2.IpPermission{
+		IpProtocol: aws.String("-1"),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+	}
+	proxyEgress := &ec2.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(3128),
+		ToPort:     aws.Int64(3128),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.1.0.10/32"), Description: aws.String("Egress proxy")}},
+	}
+	foreignEgress := &ec2.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(443),
+		ToPort:     aws.Int64(443),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.2.0.0/16")}},
+	}
+	// The nodes keep reaching each other and the control plane, which share the security group.
+	clusterEgress := &ec2.IpPermission{
+		IpProtocol:       aws.String("-1"),
+		UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-eks-node"), Description: aws.String("EKS nodes and control plane")}},
+	}
+	clusterSecurityGroupRule := &ec2.SecurityGroupRule{
+		Description:         aws.String("EKS nodes and control plane"),
+		IpProtocol:          aws.String("-1"),
+		ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{GroupId: aws.String("sg-eks-node")},
+		IsEgress:            aws.Bool(true),
+	}
+	proxySecurityGroupRule := &ec2.SecurityGroupRule{
+		Description: aws.String("Egress proxy"),
+		IpProtocol:  aws.String("tcp"),
+		FromPort:    aws.Int64(3128),
+		ToPort:      aws.Int64(3128),
+		CidrIpv4:    aws.String("10.1.0.10/32"),
+		IsEgress:    aws.Bool(true),
+	}
+	eksNodeSecurityGroup := infrav1.SecurityGroup{
+		ID:   "sg-eks-node",
+		Name: "eks-cluster-sg-test-cluster",
+		Tags: infrav1.Tags{"aws:eks:cluster-name": "test-cluster"},
+	}
+	describeEgress := func(m *mocks.MockEC2APIMockRecorder, permissions ...*ec2.IpPermission) {
+		m.DescribeSecurityGroupsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeSecurityGroupsInput{
+			GroupIds: aws.StringSlice([]string{"sg-eks-node"}),
+		})).Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []*ec2.SecurityGroup{
+				{
+					GroupId:             aws.String("sg-eks-node"),
+					IpPermissionsEgress: permissions,
+				},
+			},
+		}, nil)
+	}
+	describeOwnedRules := func(m *mocks.MockEC2APIMockRecorder, rules ...*ec2.SecurityGroupRule) {
+		m.DescribeSecurityGroupRulesPagesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeSecurityGroupRulesInput{
+			Filters: []*ec2.Filter{
+				filter.EC2.SecurityGroupID("sg-eks-node"),
+				filter.EC2.ClusterOwned("test-cluster"),
+			},
+		}), gomock.Any()).DoAndReturn(func(_ context.Context, _ *ec2.DescribeSecurityGroupRulesInput, fn func(*ec2.DescribeSecurityGroupRulesOutput, bool) bool, _ ...request.Option) error {
+			fn(&ec2.DescribeSecurityGroupRulesOutput{SecurityGroupRules: rules}, true)
+			return nil
+		})
+	}
+
+	ruleTags := []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String("security-group-rule"),
+			Tags: []*ec2.Tag{
+				{
+					Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+					Value: aws.String("owned"),
+				},
+				{
+					Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+					Value: aws.String("node"),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		securityGroups map[infrav1.SecurityGroupRole]infrav1.SecurityGroup
+		rules          infrav1.EgressRules
+		expect         func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name:  "nothing is done before EKS creates the node security group",
+			rules: infrav1.EgressRules{proxyRule},
+		},
+		{
+			name: "security groups not created by EKS are left untouched",
+			securityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+				infrav1.SecurityGroupNode: {ID: "sg-node", Name: "test-cluster-node"},
+			},
+			rules: infrav1.EgressRules{proxyRule},
+		},
+		{
+			name: "proxy egress rules replace the default egress rule of the node security group",
+			securityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+				infrav1.SecurityGroupNode: eksNodeSecurityGroup,
+			},
+			rules: infrav1.EgressRules{proxyRule},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, defaultEgress)
+				// The default egress rule was created by EKS, it is revoked nonetheless.
+				describeOwnedRules(m)
+				authorize := m.AuthorizeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId:           aws.String("sg-eks-node"),
+					IpPermissions:     []*ec2.IpPermission{clusterEgress, proxyEgress},
+					TagSpecifications: ruleTags,
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+				m.RevokeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId:       aws.String("sg-eks-node"),
+					IpPermissions: []*ec2.IpPermission{defaultEgress},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil).After(authorize)
+			},
+		},
+		{
+			name: "egress rules not created by CAPA are kept",
+			securityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+				infrav1.SecurityGroupNode: eksNodeSecurityGroup,
+			},
+			rules: infrav1.EgressRules{proxyRule},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, clusterEgress, proxyEgress, foreignEgress)
+				describeOwnedRules(m, clusterSecurityGroupRule, proxySecurityGroupRule)
+			},
+		},
+		{
+			name: "proxy egress rules already in place are left untouched",
+			securityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+				infrav1.SecurityGroupNode: eksNodeSecurityGroup,
+			},
+			rules: infrav1.EgressRules{proxyRule},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, clusterEgress, proxyEgress)
+			},
+		},
+		{
+			name: "traffic between the nodes and the control plane is allowed again when its rule is missing",
+			securityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+				infrav1.SecurityGroupNode: eksNodeSecurityGroup,
+			},
+			rules: infrav1.EgressRules{proxyRule},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, proxyEgress)
+				m.AuthorizeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId:           aws.String("sg-eks-node"),
+					IpPermissions:     []*ec2.IpPermission{clusterEgress},
+					TagSpecifications: ruleTags,
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+		{
+			name: "default egress rule is restored once the proxy egress rules are removed",
+			securityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+				infrav1.SecurityGroupNode: eksNodeSecurityGroup,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, clusterEgress, proxyEgress)
+				describeOwnedRules(m, clusterSecurityGroupRule, proxySecurityGroupRule)
+				authorize := m.AuthorizeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId:           aws.String("sg-eks-node"),
+					IpPermissions:     []*ec2.IpPermission{defaultEgress},
+					TagSpecifications: ruleTags,
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+				m.RevokeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId:       aws.String("sg-eks-node"),
+					IpPermissions: []*ec2.IpPermission{clusterEgress, proxyEgress},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil).After(authorize)
+			},
+		},
+		{
+			name: "proxy egress rules removed from the spec are revoked",
+			securityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+				infrav1.SecurityGroupNode: eksNodeSecurityGroup,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, defaultEgress, proxyEgress)
+				describeOwnedRules(m, proxySecurityGroupRule)
+				m.RevokeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId:       aws.String("sg-eks-node"),
+					IpPermissions: []*ec2.IpPermission{proxyEgress},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							SecurityGroups: tc.securityGroups,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(cs, testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.ReconcileEKSNodeEgressRules(tc.rules)).To(Succeed())
+		})
+	}
+}
+
+func TestReconcileLoadBalancerEgressRules(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	apiServerRule := infrav1.EgressRule{
+		Description:                   "Kubernetes API",
+		Protocol:                      infrav1.SecurityGroupProtocolTCP,
+		FromPort:                      6443,
+		ToPort:                        6443,
+		DestinationSecurityGroupRoles: []infrav1.SecurityGroupRole{infrav1.SecurityGroupControlPlane},
+	}
+	defaultEgress := &ec2.IpPermission{
+		IpProtocol: aws.String("-1"),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+	}
+	apiServerEgress := &ec2.IpPermission{
+		IpProtocol:       aws.String("tcp"),
+		FromPort:         aws.Int64(6443),
+		ToPort:           aws.Int64(6443),
+		UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-controlplane"), Description: aws.String("Kubernetes API")}},
+	}
+	describeEgress := func(m *mocks.MockEC2APIMockRecorder, permissions ...*ec2.IpPermission) {
+		m.DescribeSecurityGroupsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeSecurityGroupsInput{
+			GroupIds: aws.StringSlice([]string{"sg-apiserver-lb"}),
+		})).Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []*ec2.SecurityGroup{
+				{
+					GroupId:             aws.String("sg-apiserver-lb"),
+					GroupName:           aws.String("test-cluster-apiserver-lb"),
+					IpPermissionsEgress: permissions,
+				},
+			},
+		}, nil)
+	}
+
+	testCases := []struct {
+		name    string
+		rules   map[infrav1.SecurityGroupRole]infrav1.EgressRules
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "open egress is kept when not configured",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, defaultEgress)
+			},
+		},
+		{
+			name: "open egress is replaced by egress to the control plane security group",
+			rules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+				infrav1.SecurityGroupAPIServerLB: {apiServerRule},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, defaultEgress)
+				m.RevokeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId:       aws.String("sg-apiserver-lb"),
+					IpPermissions: []*ec2.IpPermission{defaultEgress},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+				m.AuthorizeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId:       aws.String("sg-apiserver-lb"),
+					IpPermissions: []*ec2.IpPermission{apiServerEgress},
+					TagSpecifications: []*ec2.TagSpecification{
+						{
+							ResourceType: aws.String("security-group-rule"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+									Value: aws.String("owned"),
+								},
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+									Value: aws.String("apiserver-lb"),
+								},
+							},
+						},
+					},
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+		{
+			name: "egress to the control plane security group already in place is left untouched",
+			rules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+				infrav1.SecurityGroupAPIServerLB: {apiServerRule},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeEgress(m, apiServerEgress)
+			},
+		},
+		{
+			name: "egress to a security group role without security group is rejected",
+			rules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+				infrav1.SecurityGroupAPIServerLB: {
+					{
+						Description:                   "SSH",
+						Protocol:                      infrav1.SecurityGroupProtocolTCP,
+						FromPort:                      22,
+						ToPort:                        22,
+						DestinationSecurityGroupRoles: []infrav1.SecurityGroupRole{infrav1.SecurityGroupBastion},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							SecurityGroupEgressRules: tc.rules,
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+								infrav1.SecurityGroupControlPlane: {ID: "sg-controlplane"},
+								infrav1.SecurityGroupAPIServerLB:  {ID: "sg-apiserver-lb"},
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(cs, testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			sg := infrav1.SecurityGroup{
+				ID:   "sg-apiserver-lb",
+				Name: "test-cluster-apiserver-lb",
+			}
+			err = s.reconcileEgressRules(sg, infrav1.SecurityGroupAPIServerLB)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestSecurityGroupTagParamsKarpenterDiscovery(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	testCases := []struct {
+		name      string
+		vpc       infrav1.VPCSpec
+		discovery *infrav1.KarpenterDiscoverySpec
+		role      infrav1.SecurityGroupRole
+		expected  string
+	}{
+		{
+			name: "no tag when not configured",
+			role: infrav1.SecurityGroupNode,
+		},
+		{
+			name:      "node security group is tagged with the cluster name by default",
+			discovery: &infrav1.KarpenterDiscoverySpec{},
+			role:      infrav1.SecurityGroupNode,
+			expected:  "test-cluster",
+		},
+		{
+			name:      "node security group is tagged with a custom value",
+			discovery: &infrav1.KarpenterDiscoverySpec{Value: "karpenter"},
+			role:      infrav1.SecurityGroupNode,
+			expected:  "karpenter",
+		},
+		{
+			name:      "other security groups aren't tagged",
+			discovery: &infrav1.KarpenterDiscoverySpec{},
+			role:      infrav1.SecurityGroupControlPlane,
+		},
+		{
+			name:      "node security group of an unmanaged VPC isn't tagged",
+			vpc:       infrav1.VPCSpec{ID: "vpc-unmanaged"},
+			discovery: &infrav1.KarpenterDiscoverySpec{},
+			role:      infrav1.SecurityGroupNode,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC:                tc.vpc,
+							KarpenterDiscovery: tc.discovery,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			tags := infrav1.Build(s.getSecurityGroupTagParams("test-cluster-sg", "sg-1", tc.role))
+			if tc.expected == "" {
+				g.Expect(tags).NotTo(HaveKey(infrav1.KarpenterDiscoveryTagKey))
+				return
+			}
+			g.Expect(tags).To(HaveKeyWithValue(infrav1.KarpenterDiscoveryTagKey, tc.expected))
+		})
+	}
+}
+
 var processSecurityGroupsPage = func(ctx context.Context, _, y interface{}, requestOptions ...request.Option) {
 	funcType := y.(func(out *ec2.DescribeSecurityGroupsOutput, last bool) bool)
 	funcType(&ec2.DescribeSecurityGroupsOutput{
@@ -20,7 +20,7 @@ const (
 	bastionBashScript = `{{.Header}}
 
 BASTION_BOOTSTRAP_FILE=bastion_bootstrap.sh
-BASTION_BOOTSTRAP=https://s3.amazonaws.com/aws-quickstart/quickstart-linux-bastion/scripts/bastion_bootstrap.sh
+BASTION_BOOTSTRAP=https://s3.{{.DNSSuffix}}/aws-quickstart/quickstart-linux-bastion/scripts/bastion_bootstrap.sh
 
 curl -s -o $BASTION_BOOTSTRAP_FILE $BASTION_BOOTSTRAP
 chmod +x $BASTION_BOOTSTRAP_FILE
@@ -36,10 +36,17 @@ pip install --upgrade pip &> /dev/null
 // BastionInput defines the context to generate a bastion instance user data.
 type BastionInput struct {
 	baseUserData
+
+	// DNSSuffix is the DNS suffix of the endpoints of the AWS services in the partition of the cluster.
+	// It defaults to the suffix of the commercial partition.
+	DNSSuffix string
 }
 
 // NewBastion returns the user data string to be used on a bastion instance.
 func NewBastion(input *BastionInput) (string, error) {
 	input.Header = defaultHeader
+	if input.DNSSuffix == "" {
+		input.DNSSuffix = "amazonaws.com"
+	}
 	return generate("bastion", bastionBashScript, input)
 }
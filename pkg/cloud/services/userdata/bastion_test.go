@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/system"
+)
+
+func TestNewBastion(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *BastionInput
+		expected string
+	}{
+		{
+			name:     "downloads the bootstrap script from the commercial S3 endpoint by default",
+			input:    &BastionInput{},
+			expected: "BASTION_BOOTSTRAP=https://s3.amazonaws.com/aws-quickstart/",
+		},
+		{
+			name:     "downloads the bootstrap script from the S3 endpoint of an isolated region",
+			input:    &BastionInput{DNSSuffix: system.GetDNSSuffixFromPartition(system.GetPartitionFromRegion("us-iso-east-1"))},
+			expected: "BASTION_BOOTSTRAP=https://s3.c2s.ic.gov/aws-quickstart/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			userData, err := NewBastion(tt.input)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(userData).To(ContainSubstring(tt.expected))
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// eksBootstrapScriptRegex matches an invocation of the EKS bootstrap script.
+	eksBootstrapScriptRegex = regexp.MustCompile(`(^|[\s/])bootstrap\.sh(\s|$)`)
+	// kubeletExtraArgsRegex matches the kubelet extra arguments passed to the EKS bootstrap script.
+	kubeletExtraArgsRegex = regexp.MustCompile(`--kubelet-extra-args\s+(?:'([^']*)'|"([^"]*)")`)
+)
+
+// AddKubeletExtraArgs adds kubelet arguments to the invocation of the EKS bootstrap script in the bootstrap data.
+// The arguments are merged with the kubelet extra arguments already passed to the script, the given ones taking
+// precedence. An error is returned if the bootstrap data doesn't invoke the EKS bootstrap script.
+func AddKubeletExtraArgs(data []byte, args map[string]string) ([]byte, error) {
+	for key, value := range args {
+		if strings.ContainsAny(key+value, "'\" \t\n") {
+			return nil, errors.Errorf("kubelet argument %q must not contain quotes or whitespace", key)
+		}
+	}
+
+	found := false
+	lines := strings.Split(string(data), "\n")
+	for i, line := range lines {
+		if !eksBootstrapScriptRegex.MatchString(line) {
+			continue
+		}
+		found = true
+
+		match := kubeletExtraArgsRegex.FindStringSubmatchIndex(line)
+		if match == nil {
+			lines[i] = fmt.Sprintf("%s --kubelet-extra-args '%s'", strings.TrimRight(line, " "), renderKubeletArgs(args))
+			continue
+		}
+
+		// The existing arguments are either single or double quoted.
+		var existing string
+		if match[2] >= 0 {
+			existing = line[match[2]:match[3]]
+		} else {
+			existing = line[match[4]:match[5]]
+		}
+		merged := parseKubeletArgs(existing)
+		for key, value := range args {
+			merged[key] = value
+		}
+		lines[i] = fmt.Sprintf("%s--kubelet-extra-args '%s'%s", line[:match[0]], renderKubeletArgs(merged), line[match[1]:])
+	}
+
+	if !found {
+		return nil, errors.New("bootstrap data doesn't invoke the EKS bootstrap script")
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// parseKubeletArgs parses kubelet arguments of the form --key=value, arguments without a value are
+// kept with an empty value.
+func parseKubeletArgs(args string) map[string]string {
+	parsed := map[string]string{}
+	for _, arg := range strings.Fields(args) {
+		key, value, _ := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		parsed[key] = value
+	}
+	return parsed
+}
+
+// renderKubeletArgs renders kubelet arguments sorted by key, so the bootstrap data is stable.
+func renderKubeletArgs(args map[string]string) string {
+	keys := make([]string, 0, len(args))
+	for key := range args {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	rendered := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if args[key] == "" {
+			rendered = append(rendered, "--"+key)
+			continue
+		}
+		rendered = append(rendered, fmt.Sprintf("--%s=%s", key, args[key]))
+	}
+	return strings.Join(rendered, " ")
+}
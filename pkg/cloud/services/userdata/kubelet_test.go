@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestAddKubeletExtraArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		args     map[string]string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "adds the kubelet extra args to the bootstrap script",
+			data: `#cloud-config
+runcmd:
+  - /etc/eks/bootstrap.sh test-cluster
+`,
+			args: map[string]string{
+				"max-pods":        "58",
+				"system-reserved": "cpu=100m,memory=200Mi",
+			},
+			expected: `#cloud-config
+runcmd:
+  - /etc/eks/bootstrap.sh test-cluster --kubelet-extra-args '--max-pods=58 --system-reserved=cpu=100m,memory=200Mi'
+`,
+		},
+		{
+			name: "merges with the kubelet extra args of the bootstrap config",
+			data: `#cloud-config
+runcmd:
+  - /etc/eks/bootstrap.sh test-cluster --kubelet-extra-args '--max-pods=29 --node-labels=role=worker' --use-max-pods false
+`,
+			args: map[string]string{
+				"max-pods": "58",
+			},
+			expected: `#cloud-config
+runcmd:
+  - /etc/eks/bootstrap.sh test-cluster --kubelet-extra-args '--max-pods=58 --node-labels=role=worker' --use-max-pods false
+`,
+		},
+		{
+			name: "adds the kubelet extra args to a custom bootstrap script invocation",
+			data: `#!/bin/bash
+set -o xtrace
+/etc/eks/bootstrap.sh test-cluster --kubelet-extra-args "--v=2"
+`,
+			args: map[string]string{
+				"max-pods": "58",
+			},
+			expected: `#!/bin/bash
+set -o xtrace
+/etc/eks/bootstrap.sh test-cluster --kubelet-extra-args '--max-pods=58 --v=2'
+`,
+		},
+		{
+			name: "fails if the bootstrap script isn't invoked",
+			data: `#cloud-config
+runcmd:
+  - kubeadm join --config /run/kubeadm/kubeadm-join-config.yaml
+`,
+			args: map[string]string{
+				"max-pods": "58",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails if an argument contains quotes",
+			data: `#cloud-config
+runcmd:
+  - /etc/eks/bootstrap.sh test-cluster
+`,
+			args: map[string]string{
+				"node-labels": "role='worker'",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			data, err := AddKubeletExtraArgs([]byte(tt.data), tt.args)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(data)).To(Equal(tt.expected))
+
+			// Adding the same arguments again must not change the bootstrap data.
+			again, err := AddKubeletExtraArgs(data, tt.args)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(again)).To(Equal(tt.expected))
+		})
+	}
+}
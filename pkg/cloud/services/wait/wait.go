@@ -32,6 +32,28 @@ import (
  implement waits manually here.
 */
 
+// pendingError is returned when a long-running AWS operation has been started but isn't done yet, and its
+// completion is checked on a later reconcile instead of blocking on a waiter.
+type pendingError struct {
+	msg string
+}
+
+func (e *pendingError) Error() string {
+	return e.msg
+}
+
+// NewPending returns an error telling the caller to requeue the reconcile until the operation described by the
+// message is done.
+func NewPending(msg string) error {
+	return &pendingError{msg: msg}
+}
+
+// IsPending returns true if the error, or one it wraps, has been returned by NewPending.
+func IsPending(err error) bool {
+	var pending *pendingError
+	return errors.As(err, &pending)
+}
+
 // NewBackoff creates a new API Machinery backoff parameter set suitable
 // for use with AWS services.
 func NewBackoff() wait.Backoff {
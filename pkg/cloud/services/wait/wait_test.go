@@ -18,6 +18,7 @@ package wait_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -147,3 +148,38 @@ func TestWaitForWithRetryable(t *testing.T) {
 		})
 	}
 }
+
+func TestIsPending(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "pending error",
+			err:  NewPending("instance is terminating"),
+			want: true,
+		},
+		{
+			name: "wrapped pending error",
+			err:  fmt.Errorf("failed to delete bastion: %w", NewPending("instance is terminating")),
+			want: true,
+		},
+		{
+			name: "other error",
+			err:  errNonRetryable,
+			want: false,
+		},
+		{
+			name: "nil error",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPending(tt.err); got != tt.want {
+				t.Errorf("IsPending() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
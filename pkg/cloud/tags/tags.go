@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -27,8 +28,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 )
 
 var (
@@ -46,6 +49,14 @@ type BuilderOption func(*Builder)
 type Builder struct {
 	params    *infrav1.BuildParams
 	applyFunc func(params *infrav1.BuildParams) error
+	policy    infrav1.TagReconcilePolicy
+	recorder  runtime.Object
+	drift     DriftRecorder
+}
+
+// DriftRecorder records the tags of the resources of a cluster that were removed or changed outside of Cluster API.
+type DriftRecorder interface {
+	RecordTagDrift(resourceID string, keys []string)
 }
 
 // New creates a new TagsBuilder with the specified build parameters
@@ -78,16 +89,62 @@ func (b *Builder) Apply() error {
 }
 
 // Ensure applies the tags if the current tags differ from the params.
+// With the observe policy, only the ownership tag of the cluster is re-applied, and the other tags that differ
+// from the params are reported by a warning event and recorded by the drift recorder.
 func (b *Builder) Ensure(current infrav1.Tags) error {
 	if b.params == nil {
 		return ErrBuildParamsRequired
 	}
-	if diff := computeDiff(current, *b.params); len(diff) > 0 {
+	diff := computeDiff(current, *b.params)
+	if len(diff) == 0 {
+		return nil
+	}
+	if b.policy != infrav1.TagReconcilePolicyObserve {
 		return b.Apply()
 	}
+
+	clusterTagKey := infrav1.ClusterTagKey(b.params.ClusterName)
+	if _, ok := diff[clusterTagKey]; ok && b.params.ClusterName != "" {
+		if b.applyFunc == nil {
+			return ErrApplyFuncRequired
+		}
+		if err := b.applyFunc(&infrav1.BuildParams{
+			ClusterName: b.params.ClusterName,
+			ResourceID:  b.params.ResourceID,
+			Lifecycle:   b.params.Lifecycle,
+		}); err != nil {
+			return fmt.Errorf("failed applying tags: %w", err)
+		}
+		delete(diff, clusterTagKey)
+	}
+
+	if len(diff) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(diff))
+	for key := range diff {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if b.recorder != nil {
+		record.Warnf(b.recorder, "TagsDrifted", "Tags %s of %q were removed or changed outside of Cluster API", strings.Join(keys, ", "), b.params.ResourceID)
+	}
+	if b.drift != nil {
+		b.drift.RecordTagDrift(b.params.ResourceID, keys)
+	}
 	return nil
 }
 
+// WithPolicy sets the tag reconcile policy applied by Ensure. The drifted tags are reported by an event on the given
+// object, and recorded by the given recorder.
+func WithPolicy(policy infrav1.TagReconcilePolicy, obj runtime.Object, drift DriftRecorder) BuilderOption {
+	return func(b *Builder) {
+		b.policy = policy
+		b.recorder = obj
+		b.drift = drift
+	}
+}
+
 // WithEC2 is used to denote that the tags builder will be using EC2.
 func WithEC2(ec2client ec2iface.EC2API) BuilderOption {
 	return func(b *Builder) {
@@ -191,6 +191,79 @@ func TestTagsEnsureWithEC2(t *testing.T) {
 	}
 }
 
+func TestTagsEnsureWithPolicy(t *testing.T) {
+	ownershipTag := &ec2.Tag{
+		Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/testcluster"),
+		Value: aws.String("owned"),
+	}
+	tests := []struct {
+		name          string
+		policy        infrav1.TagReconcilePolicy
+		current       infrav1.Tags
+		expect        func(m *mocks.MockEC2APIMockRecorder)
+		expectedDrift map[string][]string
+	}{
+		{
+			name:    "Should re-apply all the tags when the policy is enforce",
+			policy:  infrav1.TagReconcilePolicyEnforce,
+			current: infrav1.Tags{"Name": "test"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.CreateTagsWithContext(context.TODO(), gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{""}),
+					Tags:      tags,
+				})).Return(nil, nil)
+			},
+		},
+		{
+			name:    "Should only re-apply the ownership tag when the policy is observe",
+			policy:  infrav1.TagReconcilePolicyObserve,
+			current: infrav1.Tags{"Name": "test"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.CreateTagsWithContext(context.TODO(), gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{""}),
+					Tags:      []*ec2.Tag{ownershipTag},
+				})).Return(nil, nil)
+			},
+			expectedDrift: map[string][]string{"": {"k1", "sigs.k8s.io/cluster-api-provider-aws/role"}},
+		},
+		{
+			name:   "Should not re-apply the other tags when the policy is observe",
+			policy: infrav1.TagReconcilePolicyObserve,
+			current: infrav1.Tags{
+				"Name": "changed",
+				"sigs.k8s.io/cluster-api-provider-aws/cluster/testcluster": "owned",
+			},
+			expectedDrift: map[string][]string{"": {"Name", "k1", "sigs.k8s.io/cluster-api-provider-aws/role"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			drift := testDriftRecorder{}
+			builder := New(&bp, WithEC2(ec2Mock), WithPolicy(tc.policy, &infrav1.AWSCluster{}, drift))
+			g.Expect(builder.Ensure(tc.current)).To(Succeed())
+			if tc.expectedDrift == nil {
+				g.Expect(drift).To(BeEmpty())
+			} else {
+				g.Expect(drift).To(Equal(testDriftRecorder(tc.expectedDrift)))
+			}
+		})
+	}
+}
+
+type testDriftRecorder map[string][]string
+
+func (r testDriftRecorder) RecordTagDrift(resourceID string, keys []string) {
+	r[resourceID] = keys
+}
+
 func TestTagsEnsureWithEKS(t *testing.T) {
 	tests := []struct {
 		name    string
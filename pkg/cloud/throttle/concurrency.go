@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/metrics"
+)
+
+// readOnlyOperationPrefixes are the operation prefixes of requests that do not mutate resources.
+var readOnlyOperationPrefixes = []string{"Describe", "Get", "List"}
+
+// ConcurrencyGate bounds the number of in-flight mutating requests.
+// Waiting requests are admitted in the order they arrived.
+type ConcurrencyGate struct {
+	name  string
+	limit int
+
+	mu       sync.Mutex
+	inFlight int
+	waiters  list.List
+	acquired sync.Map
+}
+
+// NewConcurrencyGate will create a concurrency gate that allows up to limit requests in flight.
+// The name is used to report the queue depth of the gate.
+func NewConcurrencyGate(name string, limit int) *ConcurrencyGate {
+	return &ConcurrencyGate{
+		name:  name,
+		limit: limit,
+	}
+}
+
+// Acquire will wait for a free slot, or until the context is done.
+func (g *ConcurrencyGate) Acquire(ctx context.Context) error {
+	g.mu.Lock()
+	if g.inFlight < g.limit && g.waiters.Len() == 0 {
+		g.inFlight++
+		g.mu.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	elem := g.waiters.PushBack(ready)
+	g.recordQueueDepth()
+	g.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		g.mu.Lock()
+		select {
+		case <-ready:
+			// The slot was handed over while the context was being cancelled, pass it on.
+			g.mu.Unlock()
+			g.Release()
+		default:
+			g.waiters.Remove(elem)
+			g.recordQueueDepth()
+			g.mu.Unlock()
+		}
+		return ctx.Err()
+	}
+}
+
+// Release will free a slot, handing it over to the oldest waiter if there is one.
+func (g *ConcurrencyGate) Release() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if front := g.waiters.Front(); front != nil {
+		g.waiters.Remove(front)
+		g.recordQueueDepth()
+		close(front.Value.(chan struct{}))
+		return
+	}
+	g.inFlight--
+}
+
+// LimitRequest will wait for a free slot before each attempt of a mutating request is signed and sent.
+func (g *ConcurrencyGate) LimitRequest(r *request.Request) {
+	if !isMutatingRequest(r) {
+		return
+	}
+	if err := g.Acquire(r.Context()); err != nil {
+		r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while waiting for a concurrency slot", err)
+		return
+	}
+	g.acquired.Store(r, struct{}{})
+}
+
+// ReleaseRequest will free the slot held by a request once an attempt has completed, so the slot isn't held while the
+// request waits to be retried.
+func (g *ConcurrencyGate) ReleaseRequest(r *request.Request) {
+	if _, ok := g.acquired.LoadAndDelete(r); ok {
+		g.Release()
+	}
+}
+
+// Close will delete the queue depth metric of the gate.
+func (g *ConcurrencyGate) Close() {
+	metrics.DeleteRequestQueueDepth(g.name)
+}
+
+func (g *ConcurrencyGate) recordQueueDepth() {
+	metrics.RecordRequestQueueDepth(g.name, g.waiters.Len())
+}
+
+func isMutatingRequest(r *request.Request) bool {
+	if r.Operation == nil {
+		return false
+	}
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(r.Operation.Name, prefix) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	. "github.com/onsi/gomega"
+)
+
+func newTestRequest(operation string) *request.Request {
+	return request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{Name: operation}, nil, nil)
+}
+
+func queueDepth(g *ConcurrencyGate) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.waiters.Len()
+}
+
+func TestConcurrencyGateLimitsInFlightRequests(t *testing.T) {
+	g := NewWithT(t)
+	gate := NewConcurrencyGate("test", 2)
+
+	var inFlight, maxInFlight int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := newTestRequest("RunInstances")
+			gate.LimitRequest(r)
+			if r.Error != nil {
+				return
+			}
+			current := atomic.AddInt32(&inFlight, 1)
+			for {
+				observed := atomic.LoadInt32(&maxInFlight)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+			gate.ReleaseRequest(r)
+		}()
+	}
+	wg.Wait()
+
+	g.Expect(atomic.LoadInt32(&maxInFlight)).To(BeNumerically("<=", 2))
+	g.Expect(gate.inFlight).To(BeZero())
+}
+
+func TestConcurrencyGateAdmitsWaitersInOrder(t *testing.T) {
+	g := NewWithT(t)
+	gate := NewConcurrencyGate("test", 1)
+	g.Expect(gate.Acquire(context.Background())).To(Succeed())
+
+	var mu sync.Mutex
+	order := []int{}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := gate.Acquire(context.Background()); err != nil {
+				return
+			}
+			mu.Lock()
+			order = append(order, i)
+			mu.Unlock()
+			gate.Release()
+		}(i)
+		g.Eventually(func() int { return queueDepth(gate) }).Should(Equal(i + 1))
+	}
+
+	gate.Release()
+	wg.Wait()
+
+	g.Expect(order).To(Equal([]int{0, 1, 2, 3, 4}))
+}
+
+func TestConcurrencyGateCancelledWaiter(t *testing.T) {
+	g := NewWithT(t)
+	gate := NewConcurrencyGate("test", 1)
+	g.Expect(gate.Acquire(context.Background())).To(Succeed())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error)
+	go func() {
+		errCh <- gate.Acquire(ctx)
+	}()
+	g.Eventually(func() int { return queueDepth(gate) }).Should(Equal(1))
+
+	cancel()
+	g.Expect(<-errCh).To(MatchError(context.Canceled))
+	g.Expect(queueDepth(gate)).To(BeZero())
+
+	gate.Release()
+	g.Expect(gate.Acquire(context.Background())).To(Succeed())
+}
+
+func TestConcurrencyGateIgnoresReadOnlyRequests(t *testing.T) {
+	g := NewWithT(t)
+	gate := NewConcurrencyGate("test", 1)
+	g.Expect(gate.Acquire(context.Background())).To(Succeed())
+
+	for _, operation := range []string{"DescribeInstances", "GetConsoleOutput", "ListTagsForResource"} {
+		r := newTestRequest(operation)
+		gate.LimitRequest(r)
+		g.Expect(r.Error).NotTo(HaveOccurred())
+		gate.ReleaseRequest(r)
+	}
+
+	g.Expect(gate.inFlight).To(Equal(1))
+}
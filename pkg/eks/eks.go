@@ -18,9 +18,11 @@ package eks
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/version"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/hash"
 )
@@ -29,6 +31,10 @@ const (
 	resourcePrefix = "capa_"
 )
 
+// amiReleaseVersionRegex matches the release versions of the EKS optimized AMIs, e.g. 1.28.5-20240227,
+// which are made of the Kubernetes version and the build date of the AMI.
+var amiReleaseVersionRegex = regexp.MustCompile(`^(\d+\.\d+\.\d+)-\d{8}$`)
+
 // GenerateEKSName generates a name of an EKS resources.
 func GenerateEKSName(resourceName, namespace string, maxLength int) (string, error) {
 	escapedName := strings.ReplaceAll(resourceName, ".", "_")
@@ -46,3 +52,12 @@ func GenerateEKSName(resourceName, namespace string, maxLength int) (string, err
 
 	return fmt.Sprintf("%s%s", resourcePrefix, hashedName), nil
 }
+
+// ParseAMIReleaseVersion returns the Kubernetes version of a release version of the EKS optimized AMIs.
+func ParseAMIReleaseVersion(releaseVersion string) (*version.Version, error) {
+	match := amiReleaseVersionRegex.FindStringSubmatch(releaseVersion)
+	if match == nil {
+		return nil, errors.Errorf("invalid AMI release version %q, expected a version like 1.28.5-20240227", releaseVersion)
+	}
+	return version.ParseGeneric(match[1])
+}
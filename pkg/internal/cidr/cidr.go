@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"sort"
 
 	"github.com/pkg/errors"
 )
@@ -65,6 +66,55 @@ func SplitIntoSubnetsIPv4(cidrBlock string, numSubnets int) ([]*net.IPNet, error
 	return subnets, nil
 }
 
+// SplitIntoSubnetsIPv4WithPrefixLengths carves subnets with the given prefix lengths out of an IPv4 CIDR.
+// Larger subnets are allocated first so that each subnet is aligned on its own size, and the
+// subnets are returned in the same order as the requested prefix lengths.
+func SplitIntoSubnetsIPv4WithPrefixLengths(cidrBlock string, prefixLengths []int) ([]*net.IPNet, error) {
+	_, parent, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse CIDR")
+	}
+
+	ip4 := parent.IP.To4()
+	if ip4 == nil {
+		return nil, errors.Errorf("unexpected IP address type: %s", parent)
+	}
+	networkLen, addrLen := parent.Mask.Size()
+
+	order := make([]int, len(prefixLengths))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return prefixLengths[order[a]] < prefixLengths[order[b]]
+	})
+
+	next := uint64(binary.BigEndian.Uint32(ip4))
+	end := next + uint64(1)<<uint(addrLen-networkLen)
+	subnets := make([]*net.IPNet, len(prefixLengths))
+	for _, i := range order {
+		prefixLength := prefixLengths[i]
+		if prefixLength < networkLen || prefixLength > addrLen {
+			return nil, errors.Errorf("cidr %s cannot accommodate a /%d subnet", cidrBlock, prefixLength)
+		}
+
+		size := uint64(1) << uint(addrLen-prefixLength)
+		if next+size > end {
+			return nil, errors.Errorf("cidr %s cannot accommodate subnets with prefix lengths %v", cidrBlock, prefixLengths)
+		}
+
+		subnetIP := make(net.IP, len(ip4))
+		binary.BigEndian.PutUint32(subnetIP, uint32(next))
+		subnets[i] = &net.IPNet{
+			IP:   subnetIP,
+			Mask: net.CIDRMask(prefixLength, addrLen),
+		}
+		next += size
+	}
+
+	return subnets, nil
+}
+
 const subnetIDLocation = 7
 
 // SplitIntoSubnetsIPv6 splits a IPv6 address into a specified number of subnets.
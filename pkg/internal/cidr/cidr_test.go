@@ -93,6 +93,80 @@ func TestSplitIntoSubnetsIPv4(t *testing.T) {
 	}
 }
 
+func TestSplitIntoSubnetsIPv4WithPrefixLengths(t *testing.T) {
+	RegisterTestingT(t)
+	tests := []struct {
+		name          string
+		cidrblock     string
+		prefixLengths []int
+		expected      []*net.IPNet
+		expectErr     bool
+	}{
+		{
+			name:          "larger subnets are allocated first and returned in request order",
+			cidrblock:     "10.0.0.0/16",
+			prefixLengths: []int{24, 19, 24, 19},
+			expected: []*net.IPNet{
+				{
+					IP:   net.IPv4(10, 0, 64, 0).To4(),
+					Mask: net.IPv4Mask(255, 255, 255, 0),
+				},
+				{
+					IP:   net.IPv4(10, 0, 0, 0).To4(),
+					Mask: net.IPv4Mask(255, 255, 224, 0),
+				},
+				{
+					IP:   net.IPv4(10, 0, 65, 0).To4(),
+					Mask: net.IPv4Mask(255, 255, 255, 0),
+				},
+				{
+					IP:   net.IPv4(10, 0, 32, 0).To4(),
+					Mask: net.IPv4Mask(255, 255, 224, 0),
+				},
+			},
+		},
+		{
+			name:          "subnets that fill the cidr exactly",
+			cidrblock:     "10.0.0.0/24",
+			prefixLengths: []int{25, 25},
+			expected: []*net.IPNet{
+				{
+					IP:   net.IPv4(10, 0, 0, 0).To4(),
+					Mask: net.IPv4Mask(255, 255, 255, 128),
+				},
+				{
+					IP:   net.IPv4(10, 0, 0, 128).To4(),
+					Mask: net.IPv4Mask(255, 255, 255, 128),
+				},
+			},
+		},
+		{
+			name:          "subnets that overflow the cidr",
+			cidrblock:     "10.0.0.0/24",
+			prefixLengths: []int{25, 25, 26},
+			expectErr:     true,
+		},
+		{
+			name:          "subnet larger than the cidr",
+			cidrblock:     "10.0.0.0/24",
+			prefixLengths: []int{23},
+			expectErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := SplitIntoSubnetsIPv4WithPrefixLengths(tc.cidrblock, tc.prefixLengths)
+			if tc.expectErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal(tc.expected))
+		})
+	}
+}
+
 var (
 	block = "2001:db8:1234:1a00::/56"
 )
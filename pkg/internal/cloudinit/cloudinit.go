@@ -0,0 +1,222 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cloudinit edits the cloud-config bootstrap data generated by the kubeadm bootstrap provider.
+package cloudinit
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// cloudConfigHeader is the header of the cloud-config documents.
+	cloudConfigHeader = "#cloud-config"
+	// jinjaHeader is the header of the cloud-config documents cloud-init renders as Jinja templates.
+	jinjaHeader = "## template: jinja"
+
+	kubeadmAPIGroupPrefix = "kubeadm.k8s.io/"
+)
+
+var (
+	// literalContentRegex matches the content key of a write_files entry holding a literal block scalar.
+	literalContentRegex = regexp.MustCompile(`^(\s*(?:-\s+)?)content:\s*\|[-+0-9]*\s*$`)
+	// nodeConfigurationKindRegex matches the kind of the kubeadm configurations registering a node.
+	nodeConfigurationKindRegex = regexp.MustCompile(`^kind:\s*["']?(InitConfiguration|JoinConfiguration)["']?\s*$`)
+	// kubeadmAPIVersionRegex matches the apiVersion of the kubeadm configurations.
+	kubeadmAPIVersionRegex = regexp.MustCompile(`^apiVersion:\s*["']?` + regexp.QuoteMeta(kubeadmAPIGroupPrefix))
+	// nodeRegistrationRegex matches the nodeRegistration key of a kubeadm configuration, and its inline value.
+	nodeRegistrationRegex = regexp.MustCompile(`^nodeRegistration:\s*(.*?)\s*$`)
+	// nameRegex matches the name key of the nodeRegistration of a kubeadm configuration.
+	nameRegex = regexp.MustCompile(`^name:(\s|$)`)
+)
+
+// SetKubeadmNodeName sets the nodeRegistration.name of the kubeadm InitConfiguration and JoinConfiguration written
+// by the given cloud-config bootstrap data to the given name, which kubeadm passes to the kubelet as its
+// --hostname-override argument. The name can reference the instance data through Jinja expressions, so only
+// cloud-configs rendered as Jinja templates are edited, which the kubeadm bootstrap provider always generates.
+// Only the lines of the name are changed, and bootstrap data which isn't such a cloud-config or doesn't write any
+// kubeadm configuration in a literal block is returned unchanged, along with false.
+func SetKubeadmNodeName(userData []byte, name string) ([]byte, bool) {
+	lines := strings.Split(string(userData), "\n")
+	if !hasHeaders(lines) {
+		return userData, false
+	}
+
+	updated := false
+	out := make([]string, 0, len(lines)+2)
+	for i := 0; i < len(lines); i++ {
+		out = append(out, lines[i])
+		match := literalContentRegex.FindStringSubmatch(lines[i])
+		if match == nil {
+			continue
+		}
+
+		// The lines of the block are indented further than the content key, or blank.
+		keyIndent := len(match[1])
+		end := i + 1
+		for end < len(lines) && (strings.TrimSpace(lines[end]) == "" || indentOf(lines[end]) > keyIndent) {
+			end++
+		}
+		block, blockUpdated := setBlockNodeName(lines[i+1:end], name)
+		out = append(out, block...)
+		updated = updated || blockUpdated
+		i = end - 1
+	}
+
+	if !updated {
+		return userData, false
+	}
+	return []byte(strings.Join(out, "\n")), true
+}
+
+// hasHeaders returns whether the leading comment lines of the bootstrap data hold both the cloud-config and the
+// Jinja template headers.
+func hasHeaders(lines []string) bool {
+	cloudConfig, jinja := false, false
+	for _, line := range lines {
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+		cloudConfig = cloudConfig || strings.TrimSpace(line) == cloudConfigHeader
+		jinja = jinja || strings.TrimSpace(line) == jinjaHeader
+	}
+	return cloudConfig && jinja
+}
+
+// setBlockNodeName sets the node name of the kubeadm configurations in the lines of a literal block, and returns
+// whether it holds any.
+func setBlockNodeName(block []string, name string) ([]string, bool) {
+	indent := -1
+	for _, line := range block {
+		if strings.TrimSpace(line) != "" {
+			indent = indentOf(line)
+			break
+		}
+	}
+	if indent < 0 {
+		return block, false
+	}
+	prefix := strings.Repeat(" ", indent)
+
+	// The lines are edited without the indentation of the block, documents being split on their separators.
+	var docs [][]string
+	var doc []string
+	for _, line := range block {
+		line = strings.TrimPrefix(line, prefix)
+		if strings.HasPrefix(line, "---") {
+			docs = append(docs, doc)
+			doc = nil
+		}
+		doc = append(doc, line)
+	}
+	docs = append(docs, doc)
+
+	updated := false
+	var out []string
+	for _, doc := range docs {
+		if docUpdated := setDocumentNodeName(&doc, name); docUpdated {
+			updated = true
+		}
+		for _, line := range doc {
+			if strings.TrimSpace(line) == "" {
+				out = append(out, line)
+				continue
+			}
+			out = append(out, prefix+line)
+		}
+	}
+	if !updated {
+		return block, false
+	}
+	return out, true
+}
+
+// setDocumentNodeName sets the node name of a YAML document if it is a kubeadm configuration registering a node,
+// and returns whether it is one.
+func setDocumentNodeName(doc *[]string, name string) bool {
+	lines := *doc
+	apiVersion, kind := false, false
+	nodeRegistration := -1
+	for i, line := range lines {
+		switch {
+		case kubeadmAPIVersionRegex.MatchString(line):
+			apiVersion = true
+		case nodeConfigurationKindRegex.MatchString(line):
+			kind = true
+		case nodeRegistrationRegex.MatchString(line):
+			nodeRegistration = i
+		}
+	}
+	if !apiVersion || !kind {
+		return false
+	}
+
+	// The name is double quoted, as cloud-init renders the Jinja expressions before parsing the YAML, and they
+	// commonly hold single quoted strings.
+	nameLine := fmt.Sprintf("name: %q", name)
+
+	// Without a nodeRegistration, one is added at the end of the document, before its trailing blank lines.
+	if nodeRegistration < 0 {
+		end := len(lines)
+		for end > 0 && strings.TrimSpace(lines[end-1]) == "" {
+			end--
+		}
+		added := append([]string{}, lines[:end]...)
+		added = append(added, "nodeRegistration:", "  "+nameLine)
+		*doc = append(added, lines[end:]...)
+		return true
+	}
+
+	// An inline nodeRegistration can only be edited when it is empty.
+	switch inline := nodeRegistrationRegex.FindStringSubmatch(lines[nodeRegistration])[1]; inline {
+	case "", "{}":
+	default:
+		return false
+	}
+
+	childIndent := -1
+	end := nodeRegistration + 1
+	for ; end < len(lines); end++ {
+		if strings.TrimSpace(lines[end]) == "" {
+			continue
+		}
+		if indentOf(lines[end]) == 0 {
+			break
+		}
+		if childIndent < 0 {
+			childIndent = indentOf(lines[end])
+		}
+		if indentOf(lines[end]) == childIndent && nameRegex.MatchString(strings.TrimLeft(lines[end], " ")) {
+			lines[end] = strings.Repeat(" ", childIndent) + nameLine
+			return true
+		}
+	}
+	if childIndent < 0 {
+		childIndent = 2
+	}
+
+	added := append([]string{}, lines[:nodeRegistration]...)
+	added = append(added, "nodeRegistration:", strings.Repeat(" ", childIndent)+nameLine)
+	*doc = append(added, lines[nodeRegistration+1:]...)
+	return true
+}
+
+// indentOf returns the number of leading spaces of a line.
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+const nodeName = "{{ ds.meta_data.instance_id }}.ec2.internal"
+
+func TestSetKubeadmNodeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		userData string
+		expected string
+		updated  bool
+	}{
+		{
+			name: "sets the node name of the init configuration",
+			userData: `## template: jinja
+#cloud-config
+
+write_files:
+-   path: /run/kubeadm/kubeadm.yaml
+    owner: root:root
+    permissions: '0640'
+    content: |
+      ---
+      apiVersion: kubeadm.k8s.io/v1beta3
+      kind: ClusterConfiguration
+      clusterName: test
+      ---
+      apiVersion: kubeadm.k8s.io/v1beta3
+      kind: InitConfiguration
+      nodeRegistration:
+        kubeletExtraArgs:
+          cloud-provider: external
+        name: '{{ ds.meta_data.local_hostname }}'
+runcmd:
+  - kubeadm init --config /run/kubeadm/kubeadm.yaml
+`,
+			expected: `## template: jinja
+#cloud-config
+
+write_files:
+-   path: /run/kubeadm/kubeadm.yaml
+    owner: root:root
+    permissions: '0640'
+    content: |
+      ---
+      apiVersion: kubeadm.k8s.io/v1beta3
+      kind: ClusterConfiguration
+      clusterName: test
+      ---
+      apiVersion: kubeadm.k8s.io/v1beta3
+      kind: InitConfiguration
+      nodeRegistration:
+        kubeletExtraArgs:
+          cloud-provider: external
+        name: "{{ ds.meta_data.instance_id }}.ec2.internal"
+runcmd:
+  - kubeadm init --config /run/kubeadm/kubeadm.yaml
+`,
+			updated: true,
+		},
+		{
+			name: "adds the node name to a join configuration without one",
+			userData: `## template: jinja
+#cloud-config
+write_files:
+- path: /run/kubeadm/kubeadm-join-config.yaml
+  content: |
+    apiVersion: kubeadm.k8s.io/v1beta3
+    kind: JoinConfiguration
+    discovery:
+      bootstrapToken:
+        token: abcdef.0123456789abcdef
+
+- path: /etc/motd
+  content: kubeadm.k8s.io/v1beta3
+- path: /etc/encoded
+  encoding: base64
+  content: a3ViZWFkbS5rOHMuaW8vdjFiZXRhMw==
+`,
+			expected: `## template: jinja
+#cloud-config
+write_files:
+- path: /run/kubeadm/kubeadm-join-config.yaml
+  content: |
+    apiVersion: kubeadm.k8s.io/v1beta3
+    kind: JoinConfiguration
+    discovery:
+      bootstrapToken:
+        token: abcdef.0123456789abcdef
+    nodeRegistration:
+      name: "{{ ds.meta_data.instance_id }}.ec2.internal"
+
+- path: /etc/motd
+  content: kubeadm.k8s.io/v1beta3
+- path: /etc/encoded
+  encoding: base64
+  content: a3ViZWFkbS5rOHMuaW8vdjFiZXRhMw==
+`,
+			updated: true,
+		},
+		{
+			name: "adds the node name to a node registration without one",
+			userData: `## template: jinja
+#cloud-config
+write_files:
+- path: /run/kubeadm/kubeadm-join-config.yaml
+  content: |
+    apiVersion: kubeadm.k8s.io/v1beta3
+    kind: JoinConfiguration
+    nodeRegistration:
+        taints: []
+`,
+			expected: `## template: jinja
+#cloud-config
+write_files:
+- path: /run/kubeadm/kubeadm-join-config.yaml
+  content: |
+    apiVersion: kubeadm.k8s.io/v1beta3
+    kind: JoinConfiguration
+    nodeRegistration:
+        name: "{{ ds.meta_data.instance_id }}.ec2.internal"
+        taints: []
+`,
+			updated: true,
+		},
+		{
+			name: "leaves a cloud-config which isn't a jinja template alone",
+			userData: `#cloud-config
+write_files:
+- path: /run/kubeadm/kubeadm-join-config.yaml
+  content: |
+    apiVersion: kubeadm.k8s.io/v1beta3
+    kind: JoinConfiguration
+`,
+			expected: `#cloud-config
+write_files:
+- path: /run/kubeadm/kubeadm-join-config.yaml
+  content: |
+    apiVersion: kubeadm.k8s.io/v1beta3
+    kind: JoinConfiguration
+`,
+		},
+		{
+			name: "leaves a cloud-config without kubeadm configuration alone",
+			userData: `## template: jinja
+#cloud-config
+write_files:
+- path: /etc/motd
+  content: |
+    hello
+`,
+			expected: `## template: jinja
+#cloud-config
+write_files:
+- path: /etc/motd
+  content: |
+    hello
+`,
+		},
+		{
+			name:     "leaves bootstrap data which isn't a cloud-config alone",
+			userData: `{"ignition":{"version":"3.4.0"}}`,
+			expected: `{"ignition":{"version":"3.4.0"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			out, updated := SetKubeadmNodeName([]byte(tt.userData), nodeName)
+			g.Expect(updated).To(Equal(tt.updated))
+			g.Expect(string(out)).To(Equal(tt.expected))
+		})
+	}
+}
@@ -22,11 +22,16 @@ import (
 	"html/template"
 	"mime/multipart"
 	"net/textproto"
+	"path"
+	"sort"
 	"strings"
 )
 
 const (
 	includePart = "file:///etc/secret-userdata.txt\n"
+
+	// BootstrapParametersFile is the path on the instance the additional bootstrap parameters are written to.
+	BootstrapParametersFile = "/etc/cluster-api/bootstrap-parameters.env"
 )
 
 var (
@@ -38,6 +43,11 @@ var (
 		"content-type": {"text/cloud-boothook"},
 	}
 
+	// cloud-init detects the type of text/plain parts from their content.
+	userDataType = textproto.MIMEHeader{
+		"content-type": {"text/plain"},
+	}
+
 	multipartHeader = strings.Join([]string{
 		"MIME-Version: 1.0",
 		"Content-Type: multipart/mixed; boundary=\"%s\"",
@@ -98,3 +108,55 @@ func GenerateInitDocument(secretPrefix string, chunks int32, region string, endp
 
 	return buf.Bytes(), nil
 }
+
+// GenerateBootstrapParametersDocument wraps the given UserData in a MIME document
+// whose first part writes the bootstrap parameters to BootstrapParametersFile
+// as shell variable assignments, so that the bootstrap process can resolve them.
+func GenerateBootstrapParametersDocument(userData []byte, parameters map[string]string) ([]byte, error) {
+	var buf bytes.Buffer
+	mpWriter := multipart.NewWriter(&buf)
+	buf.WriteString(fmt.Sprintf(multipartHeader, mpWriter.Boundary()))
+	scriptWriter, err := mpWriter.CreatePart(boothookType)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	if _, err := scriptWriter.Write([]byte(bootstrapParametersScript(parameters))); err != nil {
+		return []byte{}, err
+	}
+
+	userDataWriter, err := mpWriter.CreatePart(userDataType)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	if _, err := userDataWriter.Write(userData); err != nil {
+		return []byte{}, err
+	}
+
+	if err := mpWriter.Close(); err != nil {
+		return []byte{}, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func bootstrapParametersScript(parameters map[string]string) string {
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var script strings.Builder
+	script.WriteString("#!/bin/sh\n")
+	script.WriteString("umask 077\n")
+	fmt.Fprintf(&script, "mkdir -p %s\n", path.Dir(BootstrapParametersFile))
+	fmt.Fprintf(&script, "cat > %s <<'EOF'\n", BootstrapParametersFile)
+	for _, name := range names {
+		fmt.Fprintf(&script, "%s='%s'\n", name, strings.ReplaceAll(parameters[name], "'", `'\''`))
+	}
+	script.WriteString("EOF\n")
+
+	return script.String()
+}
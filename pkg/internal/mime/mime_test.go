@@ -18,6 +18,9 @@ package mime
 
 import (
 	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net/mail"
 	"testing"
 )
@@ -30,3 +33,61 @@ func TestGenerateInitDocument(t *testing.T) {
 		t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
 	}
 }
+
+func TestGenerateBootstrapParametersDocument(t *testing.T) {
+	userData := []byte("#cloud-config\nruncmd: []\n")
+	parameters := map[string]string{
+		"JOIN_TOKEN":  "arn:aws:secretsmanager:us-east-1:123456789012:secret:cluster/join-token-AbCdEf",
+		"CA_KEY_PATH": "/cluster/ca-key",
+	}
+
+	doc, err := GenerateBootstrapParametersDocument(userData, parameters)
+	if err != nil {
+		t.Fatalf("Failed to generate MIME doc: %v", err)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewBuffer(doc))
+	if err != nil {
+		t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
+	}
+
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("Cannot parse content type: %v", err)
+	}
+
+	var parts []string
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Cannot read MIME part: %v", err)
+		}
+		body, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("Cannot read MIME part: %v", err)
+		}
+		parts = append(parts, string(body))
+	}
+
+	if len(parts) != 2 {
+		t.Fatalf("Expected 2 MIME parts, got %d", len(parts))
+	}
+
+	expectedScript := "#!/bin/sh\n" +
+		"umask 077\n" +
+		"mkdir -p /etc/cluster-api\n" +
+		"cat > /etc/cluster-api/bootstrap-parameters.env <<'EOF'\n" +
+		"CA_KEY_PATH='/cluster/ca-key'\n" +
+		"JOIN_TOKEN='arn:aws:secretsmanager:us-east-1:123456789012:secret:cluster/join-token-AbCdEf'\n" +
+		"EOF\n"
+	if parts[0] != expectedScript {
+		t.Errorf("Unexpected bootstrap parameters script:\n%s", parts[0])
+	}
+	if parts[1] != string(userData) {
+		t.Errorf("Unexpected user data:\n%s", parts[1])
+	}
+}
@@ -144,6 +144,20 @@ func (mr *MockClusterScoperMockRecorder) Debug(arg0 interface{}, arg1 ...interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Debug", reflect.TypeOf((*MockClusterScoper)(nil).Debug), varargs...)
 }
 
+// DriftedOperations mocks base method.
+func (m *MockClusterScoper) DriftedOperations() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DriftedOperations")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// DriftedOperations indicates an expected call of DriftedOperations.
+func (mr *MockClusterScoperMockRecorder) DriftedOperations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DriftedOperations", reflect.TypeOf((*MockClusterScoper)(nil).DriftedOperations))
+}
+
 // Error mocks base method.
 func (m *MockClusterScoper) Error(arg0 error, arg1 string, arg2 ...interface{}) {
 	m.ctrl.T.Helper()
@@ -304,6 +318,32 @@ func (mr *MockClusterScoperMockRecorder) PatchObject() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchObject", reflect.TypeOf((*MockClusterScoper)(nil).PatchObject))
 }
 
+// ReconcileMode mocks base method.
+func (m *MockClusterScoper) ReconcileMode() v1beta2.ReconcileMode {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileMode")
+	ret0, _ := ret[0].(v1beta2.ReconcileMode)
+	return ret0
+}
+
+// ReconcileMode indicates an expected call of ReconcileMode.
+func (mr *MockClusterScoperMockRecorder) ReconcileMode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileMode", reflect.TypeOf((*MockClusterScoper)(nil).ReconcileMode))
+}
+
+// RecordDrift mocks base method.
+func (m *MockClusterScoper) RecordDrift(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordDrift", arg0)
+}
+
+// RecordDrift indicates an expected call of RecordDrift.
+func (mr *MockClusterScoperMockRecorder) RecordDrift(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDrift", reflect.TypeOf((*MockClusterScoper)(nil).RecordDrift), arg0)
+}
+
 // Region mocks base method.
 func (m *MockClusterScoper) Region() string {
 	m.ctrl.T.Helper()
@@ -32,6 +32,8 @@ const (
 	defaultNamespace = "capa-system"
 	// inClusterNamespacePath is the file the default namespace to be used for namespaced API operations is placed at.
 	inClusterNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+	// defaultDNSSuffix is the DNS suffix of the endpoints of the AWS services in the commercial partition.
+	defaultDNSSuffix = "amazonaws.com"
 )
 
 // GetManagerNamespace return the namespace where the controller is running.
@@ -85,3 +87,14 @@ func GetPartitionFromRegion(region string) string {
 		return endpoints.AwsPartitionID
 	}
 }
+
+// GetDNSSuffixFromPartition returns the DNS suffix of the endpoints of the AWS services in the partition, for example
+// amazonaws.com.cn in the aws-cn partition or c2s.ic.gov in the aws-iso partition.
+func GetDNSSuffixFromPartition(partition string) string {
+	for _, p := range endpoints.DefaultPartitions() {
+		if p.ID() == partition {
+			return p.DNSSuffix()
+		}
+	}
+	return defaultDNSSuffix
+}
@@ -68,3 +68,47 @@ func TestGetNamespaceFromFile(t *testing.T) {
 	g.Expect(GetNamespaceFromFile(nsPath)).To(Equal("different-ns"))
 	g.Expect(os.Remove(nsPath)).NotTo(HaveOccurred())
 }
+
+func TestGetDNSSuffixFromPartition(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Region   string
+		Expected string
+	}{
+		{
+			Name:     "commercial region",
+			Region:   "eu-west-1",
+			Expected: "amazonaws.com",
+		},
+		{
+			Name:     "GovCloud region",
+			Region:   "us-gov-west-1",
+			Expected: "amazonaws.com",
+		},
+		{
+			Name:     "China region",
+			Region:   "cn-north-1",
+			Expected: "amazonaws.com.cn",
+		},
+		{
+			Name:     "isolated region",
+			Region:   "us-iso-east-1",
+			Expected: "c2s.ic.gov",
+		},
+		{
+			Name:     "secret isolated region",
+			Region:   "us-isob-east-1",
+			Expected: "sc2s.sgov.gov",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(GetDNSSuffixFromPartition(GetPartitionFromRegion(c.Region))).To(Equal(c.Expected))
+		})
+	}
+
+	g := NewWithT(t)
+	g.Expect(GetDNSSuffixFromPartition("unknown")).To(Equal("amazonaws.com"))
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package workload provides helpers to deal with the API server of workload clusters.
+package workload
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"syscall"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsUnreachable returns true if the error reports that the API server of the workload cluster could not be reached
+// or is temporarily unavailable, as happens while its control plane is upgraded.
+func IsUnreachable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var aggregate kerrors.Aggregate
+	if errors.As(err, &aggregate) {
+		for _, err := range aggregate.Errors() {
+			if IsUnreachable(err) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if apierrors.IsServiceUnavailable(err) || apierrors.IsTimeout(err) || apierrors.IsServerTimeout(err) || apierrors.IsTooManyRequests(err) {
+		return true
+	}
+
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	switch {
+	case errors.As(err, &opErr), errors.As(err, &dnsErr):
+		return true
+	case errors.Is(err, syscall.ECONNREFUSED), errors.Is(err, syscall.ECONNRESET):
+		return true
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return true
+	case errors.Is(err, context.DeadlineExceeded):
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// RetryWhileUnreachable calls fn, retrying it with backoff as long as it fails because the workload cluster is
+// unreachable. It returns the last error of fn.
+func RetryWhileUnreachable(backoff wait.Backoff, fn func() error) error {
+	var lastErr error
+	_ = wait.ExponentialBackoff(backoff, func() (bool, error) {
+		lastErr = fn()
+		if lastErr != nil && IsUnreachable(lastErr) {
+			return false, nil
+		}
+		return true, nil
+	})
+	return lastErr
+}
+
+// DeleteIfExists deletes the object from the workload cluster, such as a default workload replaced by another
+// mechanism. It returns false, without error, if the object doesn't exist.
+func DeleteIfExists(ctx context.Context, c client.Client, obj client.Object) (bool, error) {
+	if err := c.Delete(ctx, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
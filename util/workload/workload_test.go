@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIsUnreachable(t *testing.T) {
+	connectionRefused := &url.Error{
+		Op:  "Get",
+		URL: "https://api.example.com:6443/api",
+		Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "no error",
+			want: false,
+		},
+		{
+			name: "connection refused",
+			err:  fmt.Errorf("getting configmap: %w", connectionRefused),
+			want: true,
+		},
+		{
+			name: "unknown host",
+			err:  &net.DNSError{Err: "no such host", Name: "api.example.com", IsNotFound: true},
+			want: true,
+		},
+		{
+			name: "service unavailable",
+			err:  apierrors.NewServiceUnavailable("etcd leader changed"),
+			want: true,
+		},
+		{
+			name: "server timeout",
+			err:  apierrors.NewServerTimeout(schema.GroupResource{Resource: "configmaps"}, "get", 1),
+			want: true,
+		},
+		{
+			name: "unreachable error in an aggregate",
+			err:  kerrors.NewAggregate([]error{errors.New("invalid mapping"), connectionRefused}),
+			want: true,
+		},
+		{
+			name: "not found",
+			err:  apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "aws-auth"),
+			want: false,
+		},
+		{
+			name: "forbidden",
+			err:  apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "aws-auth", errors.New("denied")),
+			want: false,
+		},
+		{
+			name: "other error",
+			err:  errors.New("mapping iam role: invalid ARN"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(IsUnreachable(tt.err)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestRetryWhileUnreachable(t *testing.T) {
+	backoff := wait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 3}
+	unreachable := apierrors.NewServiceUnavailable("unavailable")
+
+	t.Run("retries until the workload cluster is reachable", func(t *testing.T) {
+		g := NewWithT(t)
+		calls := 0
+		err := RetryWhileUnreachable(backoff, func() error {
+			calls++
+			if calls < 2 {
+				return unreachable
+			}
+			return nil
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(calls).To(Equal(2))
+	})
+
+	t.Run("returns the last error when the workload cluster stays unreachable", func(t *testing.T) {
+		g := NewWithT(t)
+		calls := 0
+		err := RetryWhileUnreachable(backoff, func() error {
+			calls++
+			return unreachable
+		})
+		g.Expect(IsUnreachable(err)).To(BeTrue())
+		g.Expect(calls).To(Equal(3))
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		g := NewWithT(t)
+		calls := 0
+		err := RetryWhileUnreachable(backoff, func() error {
+			calls++
+			return errors.New("invalid mapping")
+		})
+		g.Expect(err).To(MatchError("invalid mapping"))
+		g.Expect(calls).To(Equal(1))
+	})
+}
+
+func TestDeleteIfExists(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(scheme)
+	ds := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "kube-proxy"}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ds.DeepCopy()).Build()
+
+	deleted, err := DeleteIfExists(context.TODO(), c, ds.DeepCopy())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(deleted).To(BeTrue())
+	g.Expect(apierrors.IsNotFound(c.Get(context.TODO(), client.ObjectKeyFromObject(ds), &appsv1.DaemonSet{}))).To(BeTrue())
+
+	deleted, err = DeleteIfExists(context.TODO(), c, ds.DeepCopy())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(deleted).To(BeFalse())
+}